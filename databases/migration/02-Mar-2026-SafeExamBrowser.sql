@@ -0,0 +1,22 @@
+-- Migration: Safe Exam Browser configuration per LMS assignment
+-- Date: 02-Mar-2026
+-- Description: Store uploaded .seb files with their Config Key and the approved
+-- Browser Exam Keys. TestSessionService calls for sessions of an assignment with
+-- an active row must carry matching X-SafeExamBrowser-* headers.
+
+CREATE TABLE IF NOT EXISTS assignment_seb_config (
+    id BIGSERIAL PRIMARY KEY,
+    lms_assignment_id BIGINT NOT NULL UNIQUE,
+    file_name VARCHAR(255),
+    config_raw BYTEA NOT NULL,
+    config_key CHAR(64) NOT NULL,
+    browser_exam_keys JSONB NOT NULL DEFAULT '[]'::jsonb,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_seb_config_active
+    ON assignment_seb_config (lms_assignment_id)
+    WHERE is_active = TRUE;
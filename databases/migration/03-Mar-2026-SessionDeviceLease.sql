@@ -0,0 +1,34 @@
+-- Migration: Single active device per test session
+-- Date: 03-Mar-2026
+-- Description: Each ongoing session is leased to one device. Attempts from other
+-- devices are kept as 'rejected' rows until a proctor approves a transfer, which
+-- closes the old lease as 'transferred'. Rows are never deleted (audit trail).
+
+CREATE TABLE IF NOT EXISTS test_session_device_lease (
+    id BIGSERIAL PRIMARY KEY,
+    id_test_session INT NOT NULL,
+    device_id VARCHAR(128) NOT NULL,
+    user_agent TEXT,
+    ip_address VARCHAR(64),
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    attempt_count INT NOT NULL DEFAULT 1,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    released_at TIMESTAMPTZ,
+    approved_by INT,
+    note TEXT,
+    CONSTRAINT chk_device_lease_status CHECK (status IN ('active', 'transferred', 'rejected'))
+);
+
+-- Only one active lease per session
+CREATE UNIQUE INDEX IF NOT EXISTS uq_device_lease_active
+    ON test_session_device_lease (id_test_session)
+    WHERE status = 'active';
+
+-- Repeated attempts from the same foreign device collapse into one row
+CREATE UNIQUE INDEX IF NOT EXISTS uq_device_lease_rejected
+    ON test_session_device_lease (id_test_session, device_id)
+    WHERE status = 'rejected';
+
+CREATE INDEX IF NOT EXISTS idx_device_lease_session
+    ON test_session_device_lease (id_test_session, issued_at);
@@ -0,0 +1,54 @@
+-- Migration: IP / network allow-lists per school and assignment
+-- Date: 04-Mar-2026
+-- Description: TestSessionService write calls are only accepted from the listed
+-- networks. An assignment list takes precedence over its school list; without any
+-- entry access is unrestricted. Admins can grant per-session overrides and every
+-- rejected call is logged.
+
+CREATE TABLE IF NOT EXISTS network_allowlist (
+    id BIGSERIAL PRIMARY KEY,
+    lms_school_id BIGINT,
+    lms_assignment_id BIGINT,
+    cidr CIDR NOT NULL,
+    label VARCHAR(255),
+    created_by INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_network_allowlist_scope CHECK (
+        (lms_school_id IS NOT NULL AND lms_assignment_id IS NULL) OR
+        (lms_school_id IS NULL AND lms_assignment_id IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_network_allowlist_school
+    ON network_allowlist (lms_school_id) WHERE lms_school_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_network_allowlist_assignment
+    ON network_allowlist (lms_assignment_id) WHERE lms_assignment_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS network_allowlist_override (
+    id BIGSERIAL PRIMARY KEY,
+    id_test_session INT NOT NULL,
+    reason TEXT NOT NULL,
+    granted_by INT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_network_override_session
+    ON network_allowlist_override (id_test_session);
+
+CREATE TABLE IF NOT EXISTS network_access_denied_log (
+    id BIGSERIAL PRIMARY KEY,
+    id_test_session INT,
+    user_id INT,
+    lms_school_id BIGINT,
+    lms_assignment_id BIGINT,
+    method VARCHAR(255) NOT NULL,
+    client_ip VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_network_denied_assignment
+    ON network_access_denied_log (lms_assignment_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_network_denied_school
+    ON network_access_denied_log (lms_school_id, created_at DESC);
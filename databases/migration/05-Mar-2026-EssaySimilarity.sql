@@ -0,0 +1,27 @@
+-- Migration: Essay similarity / plagiarism check
+-- Date: 05-Mar-2026
+-- Description: Results of the offline essay similarity engine. Each essay answer of
+-- an assignment is compared with the other answers to the same soal ('peer') and
+-- with soal.jawaban_essay_key ('answer_key'). A run replaces the previous results
+-- of the assignment (or of one soal) and is shown in the grading view.
+
+CREATE TABLE IF NOT EXISTS essay_similarity (
+    id BIGSERIAL PRIMARY KEY,
+    id_jawaban INT NOT NULL,
+    id_soal INT NOT NULL,
+    lms_assignment_id BIGINT NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    matched_id_jawaban INT,
+    similarity DOUBLE PRECISION NOT NULL,
+    containment DOUBLE PRECISION NOT NULL,
+    matched_passages JSONB NOT NULL DEFAULT '[]'::jsonb,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_essay_similarity_source CHECK (source IN ('peer', 'answer_key')),
+    CONSTRAINT chk_essay_similarity_match CHECK ((source = 'peer') = (matched_id_jawaban IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_essay_similarity_jawaban
+    ON essay_similarity (id_jawaban, similarity DESC);
+
+CREATE INDEX IF NOT EXISTS idx_essay_similarity_assignment
+    ON essay_similarity (lms_assignment_id, id_soal);
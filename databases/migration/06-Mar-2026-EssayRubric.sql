@@ -0,0 +1,55 @@
+-- Migration: Rubric-based essay grading
+-- Date: 06-Mar-2026
+-- Description: Essay soal can carry a rubric (criteria with levels and point values)
+-- and a passing score that replaces the hard-coded >= 60 rule. Graders select one
+-- level per criterion; the chosen levels are copied into jawaban_rubric_score so the
+-- breakdown stays readable when the rubric is edited later.
+
+CREATE TABLE IF NOT EXISTS soal_rubric (
+    id_soal INT PRIMARY KEY,
+    passing_score DOUBLE PRECISION NOT NULL DEFAULT 60,
+    updated_by INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_soal_rubric_passing_score CHECK (passing_score >= 0 AND passing_score <= 100)
+);
+
+CREATE TABLE IF NOT EXISTS soal_rubric_criterion (
+    id BIGSERIAL PRIMARY KEY,
+    id_soal INT NOT NULL REFERENCES soal_rubric(id_soal) ON DELETE CASCADE,
+    nama VARCHAR(255) NOT NULL,
+    deskripsi TEXT,
+    urutan INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_soal_rubric_criterion_soal
+    ON soal_rubric_criterion (id_soal, urutan);
+
+CREATE TABLE IF NOT EXISTS soal_rubric_level (
+    id BIGSERIAL PRIMARY KEY,
+    id_criterion BIGINT NOT NULL REFERENCES soal_rubric_criterion(id) ON DELETE CASCADE,
+    label VARCHAR(100) NOT NULL,
+    deskripsi TEXT,
+    point DOUBLE PRECISION NOT NULL,
+    urutan INT NOT NULL DEFAULT 0,
+    CONSTRAINT chk_soal_rubric_level_point CHECK (point >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_soal_rubric_level_criterion
+    ON soal_rubric_level (id_criterion, urutan);
+
+CREATE TABLE IF NOT EXISTS jawaban_rubric_score (
+    id BIGSERIAL PRIMARY KEY,
+    id_jawaban INT NOT NULL,
+    id_criterion BIGINT NOT NULL,
+    id_level BIGINT NOT NULL,
+    nama_kriteria VARCHAR(255) NOT NULL,
+    label_level VARCHAR(100) NOT NULL,
+    point DOUBLE PRECISION NOT NULL,
+    max_point DOUBLE PRECISION NOT NULL,
+    feedback TEXT,
+    urutan INT NOT NULL DEFAULT 0,
+    graded_by INT,
+    graded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_jawaban_rubric_score UNIQUE (id_jawaban, id_criterion)
+);
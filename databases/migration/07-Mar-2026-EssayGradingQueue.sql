@@ -0,0 +1,57 @@
+-- Migration: Essay grading queue
+-- Date: 07-Mar-2026
+-- Description: Per-assignment grading settings (blind mode, double marking and the
+-- discrepancy that sends an essay to moderation), grader assignments with the mark
+-- each grader submitted, and the moderation queue for essays whose two marks disagree.
+
+CREATE TABLE IF NOT EXISTS grading_config (
+    lms_assignment_id BIGINT PRIMARY KEY,
+    blind_mode BOOLEAN NOT NULL DEFAULT FALSE,
+    double_marking BOOLEAN NOT NULL DEFAULT FALSE,
+    discrepancy_threshold DOUBLE PRECISION NOT NULL DEFAULT 15,
+    updated_by INT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_grading_config_threshold CHECK (discrepancy_threshold >= 0 AND discrepancy_threshold <= 100)
+);
+
+CREATE TABLE IF NOT EXISTS essay_grading_task (
+    id BIGSERIAL PRIMARY KEY,
+    id_jawaban INT NOT NULL,
+    lms_assignment_id BIGINT NOT NULL,
+    grader_id INT NOT NULL,
+    marker_slot SMALLINT NOT NULL DEFAULT 1,
+    status VARCHAR(20) NOT NULL DEFAULT 'assigned',
+    score DOUBLE PRECISION,
+    feedback TEXT,
+    rubric_scores JSONB,
+    assigned_by INT,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    submitted_at TIMESTAMPTZ,
+    CONSTRAINT chk_essay_grading_task_slot CHECK (marker_slot IN (1, 2)),
+    CONSTRAINT chk_essay_grading_task_status CHECK (status IN ('assigned', 'submitted')),
+    CONSTRAINT chk_essay_grading_task_score CHECK (score IS NULL OR (score >= 0 AND score <= 100)),
+    CONSTRAINT uq_essay_grading_task_slot UNIQUE (id_jawaban, marker_slot),
+    CONSTRAINT uq_essay_grading_task_grader UNIQUE (id_jawaban, grader_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_essay_grading_task_grader
+    ON essay_grading_task (grader_id, status);
+
+CREATE INDEX IF NOT EXISTS idx_essay_grading_task_assignment
+    ON essay_grading_task (lms_assignment_id);
+
+CREATE TABLE IF NOT EXISTS essay_moderation (
+    id_jawaban INT PRIMARY KEY,
+    lms_assignment_id BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    discrepancy DOUBLE PRECISION NOT NULL,
+    final_score DOUBLE PRECISION,
+    moderator_id INT,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    CONSTRAINT chk_essay_moderation_status CHECK (status IN ('pending', 'resolved'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_essay_moderation_assignment
+    ON essay_moderation (lms_assignment_id, status);
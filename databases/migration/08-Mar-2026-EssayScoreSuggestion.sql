@@ -0,0 +1,35 @@
+-- Migration: Essay score suggestions
+-- Date: 08-Mar-2026
+-- Description: Essay soal can list keywords (with synonyms and weights) that a good answer
+-- mentions. The scoring assistant compares each answer with the keywords and with
+-- jawaban_essay_key and stores a suggested score with the matches it found. Agreement
+-- with teachers is measured against the final nilai_essay, so it covers every grading path.
+
+CREATE TABLE IF NOT EXISTS soal_essay_keyword (
+    id BIGSERIAL PRIMARY KEY,
+    id_soal INT NOT NULL,
+    keyword VARCHAR(255) NOT NULL,
+    synonyms JSONB NOT NULL DEFAULT '[]'::jsonb,
+    weight DOUBLE PRECISION NOT NULL DEFAULT 1,
+    urutan INT NOT NULL DEFAULT 0,
+    CONSTRAINT chk_soal_essay_keyword_weight CHECK (weight > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_soal_essay_keyword_soal
+    ON soal_essay_keyword (id_soal, urutan);
+
+CREATE TABLE IF NOT EXISTS essay_score_suggestion (
+    id_jawaban INT PRIMARY KEY,
+    id_soal INT NOT NULL,
+    suggested_score DOUBLE PRECISION NOT NULL,
+    keyword_coverage DOUBLE PRECISION,
+    reference_overlap DOUBLE PRECISION,
+    keyword_matches JSONB NOT NULL DEFAULT '[]'::jsonb,
+    missing_keywords JSONB NOT NULL DEFAULT '[]'::jsonb,
+    reference_passages JSONB NOT NULL DEFAULT '[]'::jsonb,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_essay_score_suggestion_score CHECK (suggested_score >= 0 AND suggested_score <= 100)
+);
+
+CREATE INDEX IF NOT EXISTS idx_essay_score_suggestion_soal
+    ON essay_score_suggestion (id_soal);
@@ -0,0 +1,56 @@
+-- Migration: Add schema support for short-answer / cloze questions
+-- Date: 09-Mar-2026
+-- Description: A short-answer question stores its blanks as JSON, each blank with the
+-- accepted answers, the normalization rules and an optional regex. The student's answer
+-- is stored as a JSON array with one response per blank.
+
+-- 1) Extend question type enum
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_type t
+        WHERE t.typname = 'question_type_enum'
+    ) AND NOT EXISTS (
+        SELECT 1
+        FROM pg_type t
+        JOIN pg_enum e ON t.oid = e.enumtypid
+        WHERE t.typname = 'question_type_enum' AND e.enumlabel = 'short_answer'
+    ) THEN
+        ALTER TYPE question_type_enum ADD VALUE 'short_answer';
+    END IF;
+END
+$$;
+
+-- 2) English schema tables
+ALTER TABLE IF EXISTS questions
+    ADD COLUMN IF NOT EXISTS short_answer_blanks JSONB;
+
+ALTER TABLE IF EXISTS student_answers
+    ADD COLUMN IF NOT EXISTS short_answer_responses JSONB;
+
+-- 3) Legacy runtime tables (only when they are actual tables, not compatibility views)
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'soal' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE soal ADD COLUMN IF NOT EXISTS jawaban_short_answer JSONB;
+    END IF;
+END
+$$;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'jawaban_siswa' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE jawaban_siswa ADD COLUMN IF NOT EXISTS jawaban_short_answer JSONB;
+    END IF;
+END
+$$;
@@ -0,0 +1,56 @@
+-- Migration: Add schema support for numeric-response questions
+-- Date: 10-Mar-2026
+-- Description: A numeric question stores its answer key as JSON (value, absolute and
+-- relative tolerance, unit and significant figures). The student's answer is kept as
+-- typed, unit included, so it can be re-checked if the key changes.
+
+-- 1) Extend question type enum
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_type t
+        WHERE t.typname = 'question_type_enum'
+    ) AND NOT EXISTS (
+        SELECT 1
+        FROM pg_type t
+        JOIN pg_enum e ON t.oid = e.enumtypid
+        WHERE t.typname = 'question_type_enum' AND e.enumlabel = 'numeric'
+    ) THEN
+        ALTER TYPE question_type_enum ADD VALUE 'numeric';
+    END IF;
+END
+$$;
+
+-- 2) English schema tables
+ALTER TABLE IF EXISTS questions
+    ADD COLUMN IF NOT EXISTS numeric_answer_key JSONB;
+
+ALTER TABLE IF EXISTS student_answers
+    ADD COLUMN IF NOT EXISTS numeric_response TEXT;
+
+-- 3) Legacy runtime tables (only when they are actual tables, not compatibility views)
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'soal' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE soal ADD COLUMN IF NOT EXISTS jawaban_numeric JSONB;
+    END IF;
+END
+$$;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'jawaban_siswa' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE jawaban_siswa ADD COLUMN IF NOT EXISTS jawaban_numeric TEXT;
+    END IF;
+END
+$$;
@@ -0,0 +1,45 @@
+-- Migration: Move answer options of choice questions into a child table
+-- Date: 11-Mar-2026
+-- Description: Multiple-choice and complex multiple-choice questions can have between 2 and
+-- 10 options labelled A..J (e.g. true/false or the five-option national exam format). The
+-- options live one per row; opsi_a..opsi_d keep a copy of the first four for older readers.
+-- Correct answers stay as labels in jawaban_benar / jawaban_benar_complex.
+
+-- soal_opsi is the only copy of the options; drop the English duplicate an earlier run created
+DROP TABLE IF EXISTS question_options;
+
+-- No foreign key to soal because soal may be a compatibility view.
+CREATE TABLE IF NOT EXISTS soal_opsi (
+    id SERIAL PRIMARY KEY,
+    id_soal INTEGER NOT NULL,
+    label CHAR(1) NOT NULL CHECK (label BETWEEN 'A' AND 'J'),
+    teks TEXT NOT NULL,
+    urutan INTEGER NOT NULL DEFAULT 0,
+    UNIQUE (id_soal, label)
+);
+
+CREATE INDEX IF NOT EXISTS idx_soal_opsi_soal ON soal_opsi (id_soal, urutan);
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'soal'
+    ) THEN
+        INSERT INTO soal_opsi (id_soal, label, teks, urutan)
+        SELECT s.id, o.label, o.teks, o.urutan
+        FROM soal s
+        CROSS JOIN LATERAL (VALUES
+            ('A', s.opsi_a, 1),
+            ('B', s.opsi_b, 2),
+            ('C', s.opsi_c, 3),
+            ('D', s.opsi_d, 4)
+        ) AS o(label, teks, urutan)
+        WHERE s.question_type::text IN ('multiple_choice', 'multiple_choices_complex')
+          AND o.teks IS NOT NULL AND o.teks <> '' AND o.teks <> '-'
+        ON CONFLICT (id_soal, label) DO NOTHING;
+    END IF;
+END
+$$;
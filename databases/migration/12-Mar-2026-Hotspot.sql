@@ -0,0 +1,56 @@
+-- Migration: Add schema support for hotspot (image region) questions
+-- Date: 12-Mar-2026
+-- Description: A hotspot question stores its answer key as JSON: the urutan of the question
+-- image and the correct regions (rectangles or polygons) in coordinates relative to the
+-- image size. The student's answer is the list of points placed on the image, also JSON.
+
+-- 1) Extend question type enum
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_type t
+        WHERE t.typname = 'question_type_enum'
+    ) AND NOT EXISTS (
+        SELECT 1
+        FROM pg_type t
+        JOIN pg_enum e ON t.oid = e.enumtypid
+        WHERE t.typname = 'question_type_enum' AND e.enumlabel = 'hotspot'
+    ) THEN
+        ALTER TYPE question_type_enum ADD VALUE 'hotspot';
+    END IF;
+END
+$$;
+
+-- 2) English schema tables
+ALTER TABLE IF EXISTS questions
+    ADD COLUMN IF NOT EXISTS hotspot_answer_key JSONB;
+
+ALTER TABLE IF EXISTS student_answers
+    ADD COLUMN IF NOT EXISTS hotspot_response JSONB;
+
+-- 3) Legacy runtime tables (only when they are actual tables, not compatibility views)
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'soal' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE soal ADD COLUMN IF NOT EXISTS jawaban_hotspot JSONB;
+    END IF;
+END
+$$;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'jawaban_siswa' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE jawaban_siswa ADD COLUMN IF NOT EXISTS jawaban_hotspot JSONB;
+    END IF;
+END
+$$;
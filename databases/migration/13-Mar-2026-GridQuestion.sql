@@ -0,0 +1,59 @@
+-- Migration: Add schema support for grid (matrix) questions
+-- Date: 13-Mar-2026
+-- Description: A grid question is a table of statements, each marked in one of the columns
+-- (e.g. Benar / Salah). The answer key (columns, rows and the correct column of each row) and
+-- the student's pick per row are stored as JSON. Grid answers earn partial credit, kept as a
+-- percentage in nilai_parsial next to is_correct.
+
+-- 1) Extend question type enum
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_type t
+        WHERE t.typname = 'question_type_enum'
+    ) AND NOT EXISTS (
+        SELECT 1
+        FROM pg_type t
+        JOIN pg_enum e ON t.oid = e.enumtypid
+        WHERE t.typname = 'question_type_enum' AND e.enumlabel = 'grid'
+    ) THEN
+        ALTER TYPE question_type_enum ADD VALUE 'grid';
+    END IF;
+END
+$$;
+
+-- 2) English schema tables
+ALTER TABLE IF EXISTS questions
+    ADD COLUMN IF NOT EXISTS grid_answer_key JSONB;
+
+ALTER TABLE IF EXISTS student_answers
+    ADD COLUMN IF NOT EXISTS grid_response JSONB,
+    ADD COLUMN IF NOT EXISTS partial_score NUMERIC(5,2);
+
+-- 3) Legacy runtime tables (only when they are actual tables, not compatibility views)
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'soal' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE soal ADD COLUMN IF NOT EXISTS jawaban_grid JSONB;
+    END IF;
+END
+$$;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'jawaban_siswa' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE jawaban_siswa ADD COLUMN IF NOT EXISTS jawaban_grid JSONB;
+        ALTER TABLE jawaban_siswa ADD COLUMN IF NOT EXISTS nilai_parsial NUMERIC(5,2);
+    END IF;
+END
+$$;
@@ -0,0 +1,41 @@
+-- Migration: Add audio and video media to questions
+-- Date: 14-Mar-2026
+-- Description: Listening-comprehension questions carry audio or video clips next to their
+-- images. Each clip keeps its duration and how many times a student may play it per session
+-- (0 = unlimited). Plays are counted per session question in sesi_media_putar so the limit is
+-- enforced by the server, and students stream clips through the gateway instead of fetching
+-- file_path directly.
+
+-- soal_media is the only copy of the clips; drop the English duplicate an earlier run created
+DROP TABLE IF EXISTS question_media;
+
+-- No foreign keys to soal or test_session_soal because they may be compatibility views.
+CREATE TABLE IF NOT EXISTS soal_media (
+    id SERIAL PRIMARY KEY,
+    id_soal INTEGER NOT NULL,
+    jenis VARCHAR(10) NOT NULL CHECK (jenis IN ('audio', 'video')),
+    nama_file VARCHAR(255) NOT NULL,
+    file_path VARCHAR(500) NOT NULL,
+    file_size INTEGER NOT NULL,
+    mime_type VARCHAR(50) NOT NULL,
+    durasi_detik NUMERIC(8,2) NOT NULL DEFAULT 0,
+    max_putar INTEGER NOT NULL DEFAULT 0 CHECK (max_putar >= 0),
+    urutan INTEGER NOT NULL DEFAULT 1,
+    keterangan VARCHAR(255),
+    cloud_id VARCHAR(255),
+    public_id VARCHAR(500),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_soal_media_soal ON soal_media (id_soal, urutan);
+
+CREATE TABLE IF NOT EXISTS sesi_media_putar (
+    id SERIAL PRIMARY KEY,
+    id_test_session_soal INTEGER NOT NULL,
+    id_media INTEGER NOT NULL,
+    jumlah_putar INTEGER NOT NULL DEFAULT 0 CHECK (jumlah_putar >= 0),
+    terakhir_diputar TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (id_test_session_soal, id_media)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sesi_media_putar_media ON sesi_media_putar (id_media);
@@ -0,0 +1,31 @@
+-- Migration: Add a content format to questions
+-- Date: 15-Mar-2026
+-- Description: Pertanyaan, options and pembahasan can be written as plain text, Markdown with
+-- LaTeX formulas, or text with embedded MathML. The source is stored as typed and validated
+-- against its format on save; the API renders sanitized HTML from it. Existing questions are
+-- plain text.
+
+-- 1) English schema tables
+ALTER TABLE IF EXISTS questions
+    ADD COLUMN IF NOT EXISTS content_format VARCHAR(20) NOT NULL DEFAULT 'plain'
+    CHECK (content_format IN ('plain', 'markdown_latex', 'mathml'));
+
+-- 2) Legacy runtime tables (only when they are actual tables, not compatibility views)
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'soal' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE soal ADD COLUMN IF NOT EXISTS format_konten VARCHAR(20) NOT NULL DEFAULT 'plain';
+        IF NOT EXISTS (
+            SELECT 1 FROM pg_constraint WHERE conname = 'soal_format_konten_check'
+        ) THEN
+            ALTER TABLE soal ADD CONSTRAINT soal_format_konten_check
+                CHECK (format_konten IN ('plain', 'markdown_latex', 'mathml'));
+        END IF;
+    END IF;
+END
+$$;
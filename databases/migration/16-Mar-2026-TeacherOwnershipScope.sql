@@ -0,0 +1,44 @@
+-- Migration: Teacher ownership scoping for materi, questions and results
+-- Date: 16-Mar-2026
+-- Description: Teachers only see and edit materi they own (materi.owner_user_id) or that are
+-- shared with them through materi_grants, and only results of classes they teach. Teaching
+-- assignments are synced from the LMS (class_teacher_joined / class_teacher_left) into
+-- class_teachers. Superadmins are not scoped.
+
+-- 1) Sharing / co-owner grants
+CREATE TABLE IF NOT EXISTS materi_grants (
+    id BIGSERIAL PRIMARY KEY,
+    id_materi INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    permission VARCHAR(10) NOT NULL CHECK (permission IN ('view', 'edit')),
+    granted_by INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_materi_grant UNIQUE (id_materi, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_materi_grants_user ON materi_grants (user_id);
+
+-- 2) Teaching assignments (LMS class id + LMS user id, same keys as class_students)
+CREATE TABLE IF NOT EXISTS class_teachers (
+    id SERIAL PRIMARY KEY,
+    lms_class_id BIGINT NOT NULL,
+    lms_user_id BIGINT NOT NULL,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_class_teachers_lms_pair UNIQUE (lms_class_id, lms_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_class_teachers_user ON class_teachers (lms_user_id);
+
+-- 3) Scoped list lookups (only when materi is an actual table, not a compatibility view)
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'materi' AND c.relkind IN ('r', 'p')
+    ) THEN
+        CREATE INDEX IF NOT EXISTS idx_materi_owner_user ON materi (owner_user_id);
+    END IF;
+END
+$$;
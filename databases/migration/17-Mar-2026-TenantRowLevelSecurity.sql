@@ -0,0 +1,179 @@
+-- Migration: Tenant isolation by school with row-level security
+-- Date: 17-Mar-2026
+-- Description: Questions, sessions and answers of one school must never be visible to another.
+-- The service sets two settings on every connection before it runs a statement (util/tenant):
+--   app.current_school_id  the LMS school of the caller (lms_school_id claim), '' when unknown
+--   app.cross_tenant       'on' for superadmins and internal jobs such as LMS sync
+-- Materi, soal, soal_drag_drop and test_session carry a school_id checked by the tenant_isolation
+-- policy; their child tables are visible only when the parent row is. A connection that set
+-- neither value sees nothing. Policies are FORCEd so they also hold for the table owner, but
+-- superusers and BYPASSRLS roles still skip them: run the service as an ordinary role (the
+-- service refuses to start on one unless DB_TENANT_REQUIRE_RLS=false).
+-- When the legacy names are compatibility views the English tables underneath are protected.
+
+-- 1) Policy and trigger helpers
+CREATE OR REPLACE FUNCTION cbt_tenant_visible(row_school_id BIGINT) RETURNS BOOLEAN AS $$
+    SELECT current_setting('app.cross_tenant', true) = 'on'
+        OR row_school_id = NULLIF(current_setting('app.current_school_id', true), '')::BIGINT
+$$ LANGUAGE sql STABLE;
+
+-- TG_ARGV[0] looks up the school of the parent row from NEW ($1); the caller's school is the
+-- fallback so rows created by a tenant always belong to it.
+CREATE OR REPLACE FUNCTION cbt_fill_school_id() RETURNS TRIGGER AS $$
+DECLARE
+    parent_school_id BIGINT;
+BEGIN
+    IF NEW.school_id IS NULL AND TG_NARGS > 0 THEN
+        EXECUTE TG_ARGV[0] INTO parent_school_id USING NEW;
+        NEW.school_id := parent_school_id;
+    END IF;
+    IF NEW.school_id IS NULL THEN
+        NEW.school_id := NULLIF(current_setting('app.current_school_id', true), '')::BIGINT;
+    END IF;
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+-- Migration helper: a child table row is visible only when the parent row it references is.
+-- Skipped when the child does not exist or is itself a compatibility view.
+CREATE OR REPLACE FUNCTION cbt_tenant_protect_child(child TEXT, fk TEXT, parent TEXT) RETURNS VOID AS $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = child AND c.relkind IN ('r', 'p')
+    ) THEN
+        RETURN;
+    END IF;
+
+    EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', child);
+    EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', child);
+    EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', child);
+    EXECUTE format(
+        'CREATE POLICY tenant_isolation ON %1$I USING (EXISTS (SELECT 1 FROM %3$I p WHERE p.id = %1$I.%2$I)) WITH CHECK (EXISTS (SELECT 1 FROM %3$I p WHERE p.id = %1$I.%2$I))',
+        child, fk, parent);
+END
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+    english BOOLEAN;
+    t_materi TEXT;
+    t_soal TEXT;
+    t_drag_drop TEXT;
+    t_session TEXT;
+    t_subject TEXT;
+    c_materi TEXT;
+    c_subject TEXT;
+    parent_lookup TEXT;
+    protected_table TEXT;
+    child RECORD;
+BEGIN
+    SELECT EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'materi' AND c.relkind = 'v'
+    ) INTO english;
+
+    t_materi := CASE WHEN english THEN 'materials' ELSE 'materi' END;
+    t_soal := CASE WHEN english THEN 'questions' ELSE 'soal' END;
+    t_drag_drop := CASE WHEN english THEN 'drag_drop_questions' ELSE 'soal_drag_drop' END;
+    t_session := CASE WHEN english THEN 'exam_sessions' ELSE 'test_session' END;
+    t_subject := CASE WHEN english THEN 'subjects' ELSE 'mata_pelajaran' END;
+    c_materi := CASE WHEN english THEN 'material_id' ELSE 'id_materi' END;
+    c_subject := CASE WHEN english THEN 'subject_id' ELSE 'id_mata_pelajaran' END;
+
+    -- 2) School columns, backfilled before the policies start filtering
+    EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS school_id BIGINT', t_materi);
+    EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS school_id BIGINT', t_soal);
+    EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS school_id BIGINT', t_drag_drop);
+    EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS school_id BIGINT', t_session);
+
+    EXECUTE format('CREATE INDEX IF NOT EXISTS %I ON %I (school_id)', 'idx_' || t_soal || '_school', t_soal);
+    EXECUTE format('CREATE INDEX IF NOT EXISTS %I ON %I (school_id)', 'idx_' || t_drag_drop || '_school', t_drag_drop);
+    EXECUTE format('CREATE INDEX IF NOT EXISTS %I ON %I (school_id)', 'idx_' || t_session || '_school', t_session);
+
+    EXECUTE format($q$
+        UPDATE %1$I m
+        SET school_id = COALESCE(
+            (SELECT c.school_id FROM classes c WHERE c.id = m.lms_class_id),
+            (SELECT s.lms_school_id FROM %2$I s WHERE s.id = m.%3$I))
+        WHERE m.school_id IS NULL
+    $q$, t_materi, t_subject, c_subject);
+
+    EXECUTE format($q$
+        UPDATE %1$I q SET school_id = m.school_id
+        FROM %2$I m
+        WHERE m.id = q.%3$I AND q.school_id IS NULL
+    $q$, t_soal, t_materi, c_materi);
+
+    EXECUTE format($q$
+        UPDATE %1$I q SET school_id = m.school_id
+        FROM %2$I m
+        WHERE m.id = q.%3$I AND q.school_id IS NULL
+    $q$, t_drag_drop, t_materi, c_materi);
+
+    EXECUTE format($q$
+        UPDATE %1$I ts
+        SET school_id = COALESCE(
+            (SELECT c.school_id FROM classes c WHERE c.id = ts.lms_class_id),
+            (SELECT s.lms_school_id FROM %2$I s WHERE s.id = ts.%3$I))
+        WHERE ts.school_id IS NULL
+    $q$, t_session, t_subject, c_subject);
+
+    -- 3) New rows take the school of their parent, or of the caller
+    parent_lookup := format(
+        'SELECT COALESCE((SELECT c.school_id FROM classes c WHERE c.id = ($1).lms_class_id), (SELECT s.lms_school_id FROM %I s WHERE s.id = ($1).%I))',
+        t_subject, c_subject);
+    EXECUTE format('DROP TRIGGER IF EXISTS trg_fill_school_id ON %I', t_materi);
+    EXECUTE format('CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON %I FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id(%L)', t_materi, parent_lookup);
+    EXECUTE format('DROP TRIGGER IF EXISTS trg_fill_school_id ON %I', t_session);
+    EXECUTE format('CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON %I FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id(%L)', t_session, parent_lookup);
+
+    parent_lookup := format('SELECT school_id FROM %I WHERE id = ($1).%I', t_materi, c_materi);
+    EXECUTE format('DROP TRIGGER IF EXISTS trg_fill_school_id ON %I', t_soal);
+    EXECUTE format('CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON %I FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id(%L)', t_soal, parent_lookup);
+    EXECUTE format('DROP TRIGGER IF EXISTS trg_fill_school_id ON %I', t_drag_drop);
+    EXECUTE format('CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON %I FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id(%L)', t_drag_drop, parent_lookup);
+
+    -- 4) Policies on the tables that carry a school
+    FOREACH protected_table IN ARRAY ARRAY[t_materi, t_soal, t_drag_drop, t_session] LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', protected_table);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', protected_table);
+        EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', protected_table);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (cbt_tenant_visible(school_id)) WITH CHECK (cbt_tenant_visible(school_id))', protected_table);
+    END LOOP;
+
+    -- 5) Child tables follow their parent row (whose own policy already applies)
+    FOR child IN
+        SELECT *
+        FROM (VALUES
+            ('soal_gambar', 'question_images', 'id_soal', 'question_id', t_soal),
+            ('soal_opsi', 'soal_opsi', 'id_soal', 'id_soal', t_soal),
+            ('soal_media', 'soal_media', 'id_soal', 'id_soal', t_soal),
+            ('drag_item', 'drag_items', 'id_soal_drag_drop', 'drag_drop_question_id', t_drag_drop),
+            ('drag_slot', 'drag_slots', 'id_soal_drag_drop', 'drag_drop_question_id', t_drag_drop),
+            ('test_session_soal', 'exam_session_questions', 'id_test_session', 'exam_session_id', t_session)
+        ) AS v(legacy_name, english_name, legacy_fk, english_fk, parent_name)
+    LOOP
+        PERFORM cbt_tenant_protect_child(
+            CASE WHEN english THEN child.english_name ELSE child.legacy_name END,
+            CASE WHEN english THEN child.english_fk ELSE child.legacy_fk END,
+            child.parent_name);
+    END LOOP;
+
+    -- Grandchildren: correct drag pairs follow their item, answers follow their session question
+    PERFORM cbt_tenant_protect_child(
+        CASE WHEN english THEN 'drag_correct_answers' ELSE 'drag_correct_answer' END,
+        CASE WHEN english THEN 'drag_item_id' ELSE 'id_drag_item' END,
+        CASE WHEN english THEN 'drag_items' ELSE 'drag_item' END);
+    PERFORM cbt_tenant_protect_child(
+        CASE WHEN english THEN 'student_answers' ELSE 'jawaban_siswa' END,
+        CASE WHEN english THEN 'exam_session_question_id' ELSE 'id_test_session_soal' END,
+        CASE WHEN english THEN 'exam_session_questions' ELSE 'test_session_soal' END);
+END
+$$;
+
+DROP FUNCTION IF EXISTS cbt_tenant_protect_child(TEXT, TEXT, TEXT);
@@ -0,0 +1,25 @@
+-- Migration: Service-to-service API keys
+-- Date: 18-Mar-2026
+-- Description: Machine clients (LMS sync, integrations) authenticate with an X-API-Key
+-- header instead of a user JWT. Only the SHA-256 of a key is stored; the plaintext is shown
+-- once when the key is created. Scopes name what a key may call (sync:read, sync:write,
+-- catalog:write, rpc:<service> or rpc:*) and lms_school_id limits it to one school.
+-- Keys are looked up before a tenant is known, so the table is not under row-level security.
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes JSONB NOT NULL DEFAULT '[]'::jsonb,
+    lms_school_id BIGINT,
+    created_by INT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    revoked_by INT,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_active
+    ON api_keys (created_at DESC) WHERE revoked_at IS NULL;
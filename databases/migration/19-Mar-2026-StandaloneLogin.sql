@@ -0,0 +1,37 @@
+-- Migration: Standalone login for deployments without the LMS
+-- Date: 19-Mar-2026
+-- Description: With AUTH_MODE=local (or both) users log in with AuthService.Login against
+-- users.password_hash and receive CBT-signed tokens. Every login is an auth_sessions row;
+-- its access tokens stop working once the session is revoked (Logout, ChangePassword,
+-- refresh token reuse). Refresh tokens are single use: RefreshToken marks the presented
+-- one rotated and stores its successor. Only SHA-256 hashes of refresh tokens are kept.
+-- users.school_id is the tenant of a local user (AUTH_LOCAL_SCHOOL_ID when NULL).
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS school_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_users_school ON users (school_id);
+
+CREATE TABLE IF NOT EXISTS auth_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    user_agent VARCHAR(255),
+    client_ip VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    revoke_reason VARCHAR(32)
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_user_active
+    ON auth_sessions (user_id) WHERE revoked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS auth_refresh_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    session_id BIGINT NOT NULL REFERENCES auth_sessions (id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    rotated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_refresh_tokens_session
+    ON auth_refresh_tokens (session_id);
@@ -0,0 +1,40 @@
+-- Migration: QR-card and PIN login for exam labs
+-- Date: 20-Mar-2026
+-- Description: With AUTH_LAB_LOGIN=true staff issue one credential per scheduled or ongoing
+-- test session (LabLoginService.IssueLabCredentials). It is printed as a QR card holding a
+-- signed one-time code and a 6-digit PIN that is unique among the assignment's usable
+-- credentials. Only HMAC digests (keyed with JWT_SECRET) of the PIN and of the QR nonce are
+-- stored. LabLogin marks the credential used and opens an auth_sessions row without a
+-- refresh token; revoking the credential ends that session too. school_id is the school of
+-- the test session, the same derivation as test_session.school_id.
+
+CREATE TABLE IF NOT EXISTS lab_login_credentials (
+    id SERIAL PRIMARY KEY,
+    test_session_id INT NOT NULL,
+    user_id INT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    lms_assignment_id BIGINT NOT NULL,
+    school_id BIGINT,
+    pin_hash CHAR(64) NOT NULL,
+    qr_nonce_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    auth_session_id BIGINT REFERENCES auth_sessions (id) ON DELETE SET NULL,
+    created_by INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A PIN identifies one usable credential within its assignment
+CREATE UNIQUE INDEX IF NOT EXISTS idx_lab_login_credentials_active_pin
+    ON lab_login_credentials (lms_assignment_id, pin_hash)
+    WHERE used_at IS NULL AND revoked_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_lab_login_credentials_session
+    ON lab_login_credentials (test_session_id);
+
+-- Issuing and revoking run inside the caller's school; LabLogin runs cross-tenant
+ALTER TABLE lab_login_credentials ENABLE ROW LEVEL SECURITY;
+ALTER TABLE lab_login_credentials FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON lab_login_credentials;
+CREATE POLICY tenant_isolation ON lab_login_credentials
+    USING (cbt_tenant_visible(school_id)) WITH CHECK (cbt_tenant_visible(school_id));
@@ -0,0 +1,73 @@
+-- Migration: Configurable rate limit policies
+-- Date: 21-Mar-2026
+-- Description: The rate limiter picks a policy per call instead of hard-coded method
+-- mappings. rate_limit_method_groups puts methods (or a whole service with
+-- '/base.<Service>/*') into groups; methods without a row are in 'default'. A policy limits
+-- one group, optionally only for a role, a school plan (school_plans) or one school; the
+-- most specific enabled policy wins, and groups without a matching policy fall back to the
+-- 'default' group. limit_value NULL keeps the user's own user_limits value (RATE_LIMIT_*
+-- defaults); burst is headroom on top of the limit. exam_exempt lifts the limit while the
+-- user has an ongoing test session. user_limits.is_override marks values set with
+-- SetUserLimit, which win over policies; rows from before this migration are not overrides
+-- and follow a policy once one covers them. Policies are global configuration, so none of
+-- these tables is under row-level security.
+
+CREATE TABLE IF NOT EXISTS rate_limit_method_groups (
+    method VARCHAR(200) PRIMARY KEY,
+    method_group VARCHAR(24) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS school_plans (
+    school_id BIGINT PRIMARY KEY,
+    plan VARCHAR(50) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS rate_limit_policies (
+    id SERIAL PRIMARY KEY,
+    method_group VARCHAR(24) NOT NULL DEFAULT 'default',
+    role VARCHAR(20),
+    school_plan VARCHAR(50),
+    school_id BIGINT,
+    limit_type VARCHAR(50) NOT NULL,
+    limit_value INT CHECK (limit_value >= 0),
+    burst INT NOT NULL DEFAULT 0 CHECK (burst >= 0),
+    exam_exempt BOOLEAN NOT NULL DEFAULT FALSE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One policy per group and audience
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rate_limit_policies_scope
+    ON rate_limit_policies (method_group, COALESCE(role, ''), COALESCE(school_plan, ''), COALESCE(school_id, 0));
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'user_limits' AND c.relkind IN ('r', 'p')
+    ) THEN
+        ALTER TABLE user_limits ADD COLUMN IF NOT EXISTS is_override BOOLEAN NOT NULL DEFAULT FALSE;
+    END IF;
+END $$;
+
+-- Exam traffic was never rate limited; it now has its own high limit and is exempt during
+-- an ongoing session. Question authoring keeps its daily quota.
+INSERT INTO rate_limit_method_groups (method, method_group) VALUES
+    ('/base.TestSessionService/GetTestSession', 'exam'),
+    ('/base.TestSessionService/GetTestQuestions', 'exam'),
+    ('/base.TestSessionService/SubmitAnswer', 'exam'),
+    ('/base.SoalService/CreateSoal', 'authoring')
+ON CONFLICT (method) DO NOTHING;
+
+INSERT INTO rate_limit_policies (method_group, role, limit_type, limit_value, burst, exam_exempt)
+SELECT 'exam', 'student', 'api_requests_per_hour', 6000, 600, TRUE
+WHERE NOT EXISTS (SELECT 1 FROM rate_limit_policies WHERE method_group = 'exam' AND role = 'student');
+
+INSERT INTO rate_limit_policies (method_group, limit_type, limit_value)
+SELECT 'authoring', 'questions_per_day', NULL
+WHERE NOT EXISTS (SELECT 1 FROM rate_limit_policies WHERE method_group = 'authoring' AND role IS NULL);
@@ -0,0 +1,59 @@
+-- Migration: Append-only audit log
+-- Date: 22-Mar-2026
+-- Description: Every authenticated call that changes data leaves at least one audit_logs
+-- row, written by the audit interceptor once the call is done: who made it (actor_id and
+-- actor_role, or api_key_id for service keys), the method, the resource it touched, the
+-- gRPC status and the request ID (x-request-id, echoed back to the client). Usecases such
+-- as GradeEssayAnswer and ReorderSoal record before/after with only the changed fields;
+-- other calls store the request with secrets redacted. Rows cannot be updated or deleted,
+-- not even by the table owner; drop the trigger deliberately to prune old entries.
+-- school_id is the caller's school (NULL for cross-tenant callers), so a school only sees
+-- its own entries.
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor_id INT,
+    actor_role VARCHAR(20),
+    api_key_id INT,
+    school_id BIGINT,
+    method VARCHAR(200) NOT NULL,
+    resource_type VARCHAR(50) NOT NULL,
+    resource_id VARCHAR(100),
+    before_data JSONB,
+    after_data JSONB,
+    request_data JSONB,
+    request_id VARCHAR(100),
+    client_ip VARCHAR(64),
+    status VARCHAR(32) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_occurred_at ON audit_logs (occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs (actor_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_resource ON audit_logs (resource_type, resource_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_request_id ON audit_logs (request_id);
+
+CREATE OR REPLACE FUNCTION cbt_audit_logs_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only (% rejected)', TG_OP;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_audit_logs_append_only ON audit_logs;
+CREATE TRIGGER trg_audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW
+    EXECUTE PROCEDURE cbt_audit_logs_append_only();
+
+DROP TRIGGER IF EXISTS trg_audit_logs_no_truncate ON audit_logs;
+CREATE TRIGGER trg_audit_logs_no_truncate
+    BEFORE TRUNCATE ON audit_logs
+    FOR EACH STATEMENT
+    EXECUTE PROCEDURE cbt_audit_logs_append_only();
+
+-- Entries are written cross-tenant; reads are limited to the caller's school
+ALTER TABLE audit_logs ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_logs FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON audit_logs;
+CREATE POLICY tenant_isolation ON audit_logs
+    USING (cbt_tenant_visible(school_id)) WITH CHECK (cbt_tenant_visible(school_id));
@@ -0,0 +1,92 @@
+-- Migration: Tenant isolation for exam security and essay grading tables
+-- Date: 23-Mar-2026
+-- Description: The SEB, network, device lease and essay grading tables are keyed by LMS
+-- assignment, session or answer ids only, so a caller of one school could read or change
+-- another school's rows by guessing an id. Each table gets a school_id, backfilled and filled
+-- on insert from the row it belongs to (the same school as test_session.school_id, or the
+-- caller's school when the parent is not visible), and the tenant_isolation policy of
+-- 17-Mar-2026-TenantRowLevelSecurity.sql.
+
+DO $$
+DECLARE
+    english BOOLEAN;
+    t_session TEXT;
+    t_session_soal TEXT;
+    t_answer TEXT;
+    c_session TEXT;
+    c_session_soal TEXT;
+    by_assignment TEXT;
+    by_session TEXT;
+    by_answer TEXT;
+    protected RECORD;
+BEGIN
+    SELECT EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'test_session' AND c.relkind = 'v'
+    ) INTO english;
+
+    t_session := CASE WHEN english THEN 'exam_sessions' ELSE 'test_session' END;
+    t_session_soal := CASE WHEN english THEN 'exam_session_questions' ELSE 'test_session_soal' END;
+    t_answer := CASE WHEN english THEN 'student_answers' ELSE 'jawaban_siswa' END;
+    c_session := CASE WHEN english THEN 'exam_session_id' ELSE 'id_test_session' END;
+    c_session_soal := CASE WHEN english THEN 'exam_session_question_id' ELSE 'id_test_session_soal' END;
+
+    -- Lookups of the school a row belongs to, from the record in $1
+    by_assignment := format(
+        '(SELECT ts.school_id FROM %I ts WHERE ts.lms_assignment_id = ($1).lms_assignment_id AND ts.school_id IS NOT NULL ORDER BY ts.id LIMIT 1)',
+        t_session);
+    by_session := format(
+        '(SELECT ts.school_id FROM %I ts WHERE ts.id = ($1).id_test_session)',
+        t_session);
+    by_answer := format(
+        '(SELECT ts.school_id FROM %I js JOIN %I tss ON tss.id = js.%I JOIN %I ts ON ts.id = tss.%I WHERE js.id = ($1).id_jawaban)',
+        t_answer, t_session_soal, c_session_soal, t_session, c_session);
+
+    FOR protected IN
+        SELECT *
+        FROM (VALUES
+            ('assignment_seb_config', by_assignment),
+            ('network_allowlist', format('COALESCE(($1).lms_school_id, %s)', by_assignment)),
+            ('network_access_denied_log', format('COALESCE(($1).lms_school_id, %s, %s)', by_session, by_assignment)),
+            ('test_session_device_lease', by_session),
+            ('grading_config', by_assignment),
+            ('essay_grading_task', by_answer),
+            ('essay_moderation', by_answer),
+            ('jawaban_rubric_score', by_answer),
+            ('essay_score_suggestion', by_answer),
+            ('essay_similarity', by_answer)
+        ) AS v(table_name, school_lookup)
+    LOOP
+        IF NOT EXISTS (
+            SELECT 1
+            FROM pg_class c
+            JOIN pg_namespace n ON n.oid = c.relnamespace
+            WHERE n.nspname = 'public' AND c.relname = protected.table_name AND c.relkind IN ('r', 'p')
+        ) THEN
+            CONTINUE;
+        END IF;
+
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS school_id BIGINT', protected.table_name);
+        EXECUTE format('CREATE INDEX IF NOT EXISTS %I ON %I (school_id)', 'idx_' || protected.table_name || '_school', protected.table_name);
+
+        -- Backfill before the policy starts filtering; the lookup reads the row as $1
+        EXECUTE format(
+            'UPDATE %1$I r SET school_id = (SELECT %2$s FROM (SELECT r.*) s) WHERE r.school_id IS NULL',
+            protected.table_name, replace(protected.school_lookup, '($1)', 's'));
+
+        EXECUTE format('DROP TRIGGER IF EXISTS trg_fill_school_id ON %I', protected.table_name);
+        EXECUTE format(
+            'CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON %I FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id(%L)',
+            protected.table_name, 'SELECT ' || protected.school_lookup);
+
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', protected.table_name);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', protected.table_name);
+        EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', protected.table_name);
+        EXECUTE format(
+            'CREATE POLICY tenant_isolation ON %I USING (cbt_tenant_visible(school_id)) WITH CHECK (cbt_tenant_visible(school_id))',
+            protected.table_name);
+    END LOOP;
+END
+$$;
@@ -0,0 +1,9 @@
+-- Migration: One stream per recorded media play
+-- Date: 24-Mar-2026
+-- Description: A recorded play of a limited clip used to open a window of its duration plus
+-- a grace period in which the clip could be streamed any number of times. Each play now gets
+-- a one-shot stream nonce: only its SHA-256 is stored, a new play replaces it, and the first
+-- stream request of the play claims it.
+
+ALTER TABLE sesi_media_putar ADD COLUMN IF NOT EXISTS stream_nonce_hash VARCHAR(64);
+ALTER TABLE sesi_media_putar ADD COLUMN IF NOT EXISTS stream_dipakai_pada TIMESTAMPTZ;
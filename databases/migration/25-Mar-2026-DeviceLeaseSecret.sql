@@ -0,0 +1,28 @@
+-- Migration: Server-issued device lease secrets
+-- Date: 25-Mar-2026
+-- Description: A device was recognized by the X-Device-Id it sent, so anyone who learned the
+-- id could take over a leased session. The server now issues each device a random lease
+-- secret and keeps only its SHA-256: the active lease holds the secret of its device, and a
+-- rejected row holds the secret of the device waiting for a transfer, which the new lease
+-- takes over when a proctor approves it. The approved row is closed as 'approved' so it is
+-- not offered for approval again. Leases issued before this migration have no secret and go
+-- to the next request of their device id.
+
+ALTER TABLE test_session_device_lease ADD COLUMN IF NOT EXISTS secret_hash VARCHAR(64);
+
+ALTER TABLE test_session_device_lease DROP CONSTRAINT IF EXISTS chk_device_lease_status;
+ALTER TABLE test_session_device_lease ADD CONSTRAINT chk_device_lease_status
+    CHECK (status IN ('active', 'transferred', 'rejected', 'approved'));
+
+-- Rejected rows whose device was already given the lease by a transfer
+UPDATE test_session_device_lease r
+SET status = 'approved',
+    released_at = l.issued_at,
+    approved_by = l.approved_by
+FROM test_session_device_lease l
+WHERE r.status = 'rejected'
+  AND l.id_test_session = r.id_test_session
+  AND l.device_id = r.device_id
+  AND l.status IN ('active', 'transferred')
+  AND l.approved_by IS NOT NULL
+  AND l.issued_at >= r.issued_at;
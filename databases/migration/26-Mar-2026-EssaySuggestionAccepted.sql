@@ -0,0 +1,16 @@
+-- Migration: Accepted essay score suggestions
+-- Date: 26-Mar-2026
+-- Description: Suggestion agreement counted a suggestion as accepted when the final
+-- nilai_essay happened to equal it, which also counted teachers who typed the same score.
+-- Every final grade now records whether it was given with accept_suggestion, and
+-- recomputing a suggestion to a different score clears the flag. Grades given before this
+-- migration keep the old inference.
+
+ALTER TABLE essay_score_suggestion ADD COLUMN IF NOT EXISTS accepted BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE essay_score_suggestion ess
+SET accepted = TRUE
+FROM jawaban_siswa js
+WHERE js.id = ess.id_jawaban
+  AND js.nilai_essay IS NOT NULL
+  AND ABS(js.nilai_essay - ess.suggested_score) < 0.005;
@@ -0,0 +1,113 @@
+-- Migration: Tenant isolation for the remaining per-school tables
+-- Date: 27-Mar-2026
+-- Description: Grants, class teachers, rubrics, essay keywords, media plays, lab credentials,
+-- login sessions and API keys had no tenant_isolation policy, so a repository bug or a raw
+-- query could still return another school's rows. Tables without a school follow the row
+-- they belong to, like the child tables of 17-Mar-2026-TenantRowLevelSecurity.sql; the rest
+-- are checked on their own school column.
+-- Logins and API key lookups run before any tenant is known and use the cross-tenant
+-- setting (tenant.System), as lab login already does. auth_sessions gets a school_id: the
+-- school the session was opened for, else the user's, so revoking a lab credential under
+-- the teacher's school still ends its session.
+
+-- Migration helper: a child table row is visible only when the parent row it references is.
+-- Skipped when the child does not exist or is itself a compatibility view.
+CREATE OR REPLACE FUNCTION cbt_tenant_protect_child(child TEXT, fk TEXT, parent TEXT) RETURNS VOID AS $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = child AND c.relkind IN ('r', 'p')
+    ) THEN
+        RETURN;
+    END IF;
+
+    EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', child);
+    EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', child);
+    EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', child);
+    EXECUTE format(
+        'CREATE POLICY tenant_isolation ON %1$I USING (EXISTS (SELECT 1 FROM %3$I p WHERE p.id = %1$I.%2$I)) WITH CHECK (EXISTS (SELECT 1 FROM %3$I p WHERE p.id = %1$I.%2$I))',
+        child, fk, parent);
+END
+$$ LANGUAGE plpgsql;
+
+-- Migration helper: the table is checked on its own school column
+CREATE OR REPLACE FUNCTION cbt_tenant_protect_school(table_name TEXT, school_column TEXT) RETURNS VOID AS $$
+BEGIN
+    EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', table_name);
+    EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', table_name);
+    EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', table_name);
+    EXECUTE format(
+        'CREATE POLICY tenant_isolation ON %1$I USING (cbt_tenant_visible(%2$I)) WITH CHECK (cbt_tenant_visible(%2$I))',
+        table_name, school_column);
+END
+$$ LANGUAGE plpgsql;
+
+-- 1) Login sessions carry the school they were opened for
+ALTER TABLE auth_sessions ADD COLUMN IF NOT EXISTS school_id BIGINT;
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_school ON auth_sessions (school_id);
+
+UPDATE lab_login_credentials lc
+SET school_id = ts.school_id
+FROM test_session ts
+WHERE ts.id = lc.test_session_id AND lc.school_id IS NULL;
+
+UPDATE auth_sessions s
+SET school_id = COALESCE(
+    (SELECT lc.school_id FROM lab_login_credentials lc WHERE lc.auth_session_id = s.id AND lc.school_id IS NOT NULL LIMIT 1),
+    (SELECT u.school_id FROM users u WHERE u.id = s.user_id))
+WHERE s.school_id IS NULL;
+
+DROP TRIGGER IF EXISTS trg_fill_school_id ON auth_sessions;
+CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON auth_sessions
+    FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id('SELECT school_id FROM users WHERE id = ($1).user_id');
+
+DROP TRIGGER IF EXISTS trg_fill_school_id ON lab_login_credentials;
+CREATE TRIGGER trg_fill_school_id BEFORE INSERT ON lab_login_credentials
+    FOR EACH ROW EXECUTE PROCEDURE cbt_fill_school_id('SELECT school_id FROM test_session WHERE id = ($1).test_session_id');
+
+-- 2) Policies
+DO $$
+DECLARE
+    english BOOLEAN;
+    t_materi TEXT;
+    t_soal TEXT;
+    t_session_soal TEXT;
+BEGIN
+    SELECT EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'materi' AND c.relkind = 'v'
+    ) INTO english;
+
+    t_materi := CASE WHEN english THEN 'materials' ELSE 'materi' END;
+    t_soal := CASE WHEN english THEN 'questions' ELSE 'soal' END;
+    t_session_soal := CASE WHEN english THEN 'exam_session_questions' ELSE 'test_session_soal' END;
+
+    PERFORM cbt_tenant_protect_child('materi_grants', 'id_materi', t_materi);
+    PERFORM cbt_tenant_protect_child('soal_rubric', 'id_soal', t_soal);
+    PERFORM cbt_tenant_protect_child('soal_rubric_criterion', 'id_soal', t_soal);
+    PERFORM cbt_tenant_protect_child('soal_rubric_level', 'id_criterion', 'soal_rubric_criterion');
+    PERFORM cbt_tenant_protect_child('soal_essay_keyword', 'id_soal', t_soal);
+    PERFORM cbt_tenant_protect_child('sesi_media_putar', 'id_test_session_soal', t_session_soal);
+    PERFORM cbt_tenant_protect_child('auth_refresh_tokens', 'session_id', 'auth_sessions');
+END
+$$;
+
+-- A class teacher follows the school of the class; classes not synced yet stay cross-tenant
+ALTER TABLE class_teachers ENABLE ROW LEVEL SECURITY;
+ALTER TABLE class_teachers FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON class_teachers;
+CREATE POLICY tenant_isolation ON class_teachers
+    USING (cbt_tenant_visible((SELECT c.school_id FROM classes c WHERE c.id = class_teachers.lms_class_id)))
+    WITH CHECK (cbt_tenant_visible((SELECT c.school_id FROM classes c WHERE c.id = class_teachers.lms_class_id)));
+
+-- Keys without a school are superadmin keys, visible only across tenants
+SELECT cbt_tenant_protect_school('lab_login_credentials', 'school_id');
+SELECT cbt_tenant_protect_school('auth_sessions', 'school_id');
+SELECT cbt_tenant_protect_school('api_keys', 'lms_school_id');
+
+DROP FUNCTION IF EXISTS cbt_tenant_protect_child(TEXT, TEXT, TEXT);
+DROP FUNCTION IF EXISTS cbt_tenant_protect_school(TEXT, TEXT);
@@ -0,0 +1,62 @@
+-- Migration: Audit entries belong to the school of the row they changed
+-- Date: 28-Mar-2026
+-- Description: Cross-tenant callers (superadmins, API keys without a school) left
+-- audit_logs.school_id NULL, so a school admin could not see who changed their own answer
+-- keys or grades. Entries recorded by a usecase (with before/after data) now take the school
+-- of the row they name when the caller has none; entries with only the request keep the
+-- caller's school, since their resource_id is not always a row of the resource type.
+-- Like 17-Mar-2026-TenantRowLevelSecurity.sql, the lookups use whichever of the legacy and
+-- English tables is the real one.
+
+DO $$
+DECLARE
+    english BOOLEAN;
+BEGIN
+    SELECT EXISTS (
+        SELECT 1
+        FROM pg_class c
+        JOIN pg_namespace n ON n.oid = c.relnamespace
+        WHERE n.nspname = 'public' AND c.relname = 'materi' AND c.relkind = 'v'
+    ) INTO english;
+
+    EXECUTE format($f$
+        CREATE OR REPLACE FUNCTION cbt_audit_fill_school_id() RETURNS TRIGGER AS $body$
+        DECLARE
+            row_id BIGINT;
+        BEGIN
+            IF NEW.school_id IS NOT NULL
+                OR (NEW.before_data IS NULL AND NEW.after_data IS NULL)
+                OR NEW.resource_id IS NULL OR NEW.resource_id !~ '^[0-9]{1,18}$' THEN
+                RETURN NEW;
+            END IF;
+            row_id := NEW.resource_id::BIGINT;
+
+            NEW.school_id := CASE NEW.resource_type
+                WHEN 'soal' THEN (SELECT q.school_id FROM %1$I q WHERE q.id = row_id)
+                WHEN 'materi' THEN (SELECT m.school_id FROM %2$I m WHERE m.id = row_id)
+                WHEN 'essay_answer' THEN (
+                    SELECT s.school_id
+                    FROM %3$I a
+                    JOIN %4$I sq ON sq.id = a.%5$I
+                    JOIN %6$I s ON s.id = sq.%7$I
+                    WHERE a.id = row_id)
+            END;
+            RETURN NEW;
+        END
+        $body$ LANGUAGE plpgsql
+    $f$,
+        CASE WHEN english THEN 'questions' ELSE 'soal' END,
+        CASE WHEN english THEN 'materials' ELSE 'materi' END,
+        CASE WHEN english THEN 'student_answers' ELSE 'jawaban_siswa' END,
+        CASE WHEN english THEN 'exam_session_questions' ELSE 'test_session_soal' END,
+        CASE WHEN english THEN 'exam_session_question_id' ELSE 'id_test_session_soal' END,
+        CASE WHEN english THEN 'exam_sessions' ELSE 'test_session' END,
+        CASE WHEN english THEN 'exam_session_id' ELSE 'id_test_session' END);
+END
+$$;
+
+DROP TRIGGER IF EXISTS trg_audit_logs_fill_school_id ON audit_logs;
+CREATE TRIGGER trg_audit_logs_fill_school_id
+    BEFORE INSERT ON audit_logs
+    FOR EACH ROW
+    EXECUTE PROCEDURE cbt_audit_fill_school_id();
@@ -31,6 +31,7 @@ const (
 	JawabanOption_B               JawabanOption = 2
 	JawabanOption_C               JawabanOption = 3
 	JawabanOption_D               JawabanOption = 4
+	JawabanOption_E               JawabanOption = 5 // Options after E are only sent as labels, see SoalOpsi
 )
 
 // Enum value maps for JawabanOption.
@@ -41,6 +42,7 @@ var (
 		2: "B",
 		3: "C",
 		4: "D",
+		5: "E",
 	}
 	JawabanOption_value = map[string]int32{
 		"JAWABAN_INVALID": 0,
@@ -48,6 +50,7 @@ var (
 		"B":               2,
 		"C":               3,
 		"D":               4,
+		"E":               5,
 	}
 )
 
@@ -148,6 +151,10 @@ const (
 	QuestionType_DRAG_DROP                QuestionType = 2
 	QuestionType_ESSAY                    QuestionType = 3
 	QuestionType_MULTIPLE_CHOICES_COMPLEX QuestionType = 4
+	QuestionType_SHORT_ANSWER             QuestionType = 5
+	QuestionType_NUMERIC                  QuestionType = 6
+	QuestionType_HOTSPOT                  QuestionType = 7
+	QuestionType_GRID                     QuestionType = 8
 )
 
 // Enum value maps for QuestionType.
@@ -158,6 +165,10 @@ var (
 		2: "DRAG_DROP",
 		3: "ESSAY",
 		4: "MULTIPLE_CHOICES_COMPLEX",
+		5: "SHORT_ANSWER",
+		6: "NUMERIC",
+		7: "HOTSPOT",
+		8: "GRID",
 	}
 	QuestionType_value = map[string]int32{
 		"QUESTION_TYPE_INVALID":    0,
@@ -165,6 +176,10 @@ var (
 		"DRAG_DROP":                2,
 		"ESSAY":                    3,
 		"MULTIPLE_CHOICES_COMPLEX": 4,
+		"SHORT_ANSWER":             5,
+		"NUMERIC":                  6,
+		"HOTSPOT":                  7,
+		"GRID":                     8,
 	}
 )
 
@@ -245,6 +260,156 @@ func (DragDropType) EnumDescriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{3}
 }
 
+// Region shape of a hotspot answer key
+type HotspotShape int32
+
+const (
+	HotspotShape_HOTSPOT_SHAPE_INVALID HotspotShape = 0
+	HotspotShape_HOTSPOT_RECTANGLE     HotspotShape = 1
+	HotspotShape_HOTSPOT_POLYGON       HotspotShape = 2
+)
+
+// Enum value maps for HotspotShape.
+var (
+	HotspotShape_name = map[int32]string{
+		0: "HOTSPOT_SHAPE_INVALID",
+		1: "HOTSPOT_RECTANGLE",
+		2: "HOTSPOT_POLYGON",
+	}
+	HotspotShape_value = map[string]int32{
+		"HOTSPOT_SHAPE_INVALID": 0,
+		"HOTSPOT_RECTANGLE":     1,
+		"HOTSPOT_POLYGON":       2,
+	}
+)
+
+func (x HotspotShape) Enum() *HotspotShape {
+	p := new(HotspotShape)
+	*p = x
+	return p
+}
+
+func (x HotspotShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HotspotShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_cbt_proto_enumTypes[4].Descriptor()
+}
+
+func (HotspotShape) Type() protoreflect.EnumType {
+	return &file_cbt_proto_enumTypes[4]
+}
+
+func (x HotspotShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HotspotShape.Descriptor instead.
+func (HotspotShape) EnumDescriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{4}
+}
+
+// Kind of a question media clip
+type MediaJenis int32
+
+const (
+	MediaJenis_MEDIA_JENIS_INVALID MediaJenis = 0
+	MediaJenis_MEDIA_AUDIO         MediaJenis = 1
+	MediaJenis_MEDIA_VIDEO         MediaJenis = 2
+)
+
+// Enum value maps for MediaJenis.
+var (
+	MediaJenis_name = map[int32]string{
+		0: "MEDIA_JENIS_INVALID",
+		1: "MEDIA_AUDIO",
+		2: "MEDIA_VIDEO",
+	}
+	MediaJenis_value = map[string]int32{
+		"MEDIA_JENIS_INVALID": 0,
+		"MEDIA_AUDIO":         1,
+		"MEDIA_VIDEO":         2,
+	}
+)
+
+func (x MediaJenis) Enum() *MediaJenis {
+	p := new(MediaJenis)
+	*p = x
+	return p
+}
+
+func (x MediaJenis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaJenis) Descriptor() protoreflect.EnumDescriptor {
+	return file_cbt_proto_enumTypes[5].Descriptor()
+}
+
+func (MediaJenis) Type() protoreflect.EnumType {
+	return &file_cbt_proto_enumTypes[5]
+}
+
+func (x MediaJenis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaJenis.Descriptor instead.
+func (MediaJenis) EnumDescriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{5}
+}
+
+// Format pertanyaan, options and pembahasan are written in
+type ContentFormat int32
+
+const (
+	ContentFormat_CONTENT_FORMAT_PLAIN          ContentFormat = 0
+	ContentFormat_CONTENT_FORMAT_MARKDOWN_LATEX ContentFormat = 1 // Markdown with $...$, $$...$$, \(...\) or \[...\] formulas
+	ContentFormat_CONTENT_FORMAT_MATHML         ContentFormat = 2 // Plain text with <math> elements
+)
+
+// Enum value maps for ContentFormat.
+var (
+	ContentFormat_name = map[int32]string{
+		0: "CONTENT_FORMAT_PLAIN",
+		1: "CONTENT_FORMAT_MARKDOWN_LATEX",
+		2: "CONTENT_FORMAT_MATHML",
+	}
+	ContentFormat_value = map[string]int32{
+		"CONTENT_FORMAT_PLAIN":          0,
+		"CONTENT_FORMAT_MARKDOWN_LATEX": 1,
+		"CONTENT_FORMAT_MATHML":         2,
+	}
+)
+
+func (x ContentFormat) Enum() *ContentFormat {
+	p := new(ContentFormat)
+	*p = x
+	return p
+}
+
+func (x ContentFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_cbt_proto_enumTypes[6].Descriptor()
+}
+
+func (ContentFormat) Type() protoreflect.EnumType {
+	return &file_cbt_proto_enumTypes[6]
+}
+
+func (x ContentFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentFormat.Descriptor instead.
+func (ContentFormat) EnumDescriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{6}
+}
+
 type QuestionSelectionMode int32
 
 const (
@@ -278,11 +443,11 @@ func (x QuestionSelectionMode) String() string {
 }
 
 func (QuestionSelectionMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_cbt_proto_enumTypes[4].Descriptor()
+	return file_cbt_proto_enumTypes[7].Descriptor()
 }
 
 func (QuestionSelectionMode) Type() protoreflect.EnumType {
-	return &file_cbt_proto_enumTypes[4]
+	return &file_cbt_proto_enumTypes[7]
 }
 
 func (x QuestionSelectionMode) Number() protoreflect.EnumNumber {
@@ -291,7 +456,7 @@ func (x QuestionSelectionMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QuestionSelectionMode.Descriptor instead.
 func (QuestionSelectionMode) EnumDescriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{4}
+	return file_cbt_proto_rawDescGZIP(), []int{7}
 }
 
 type UserRole int32
@@ -333,11 +498,11 @@ func (x UserRole) String() string {
 }
 
 func (UserRole) Descriptor() protoreflect.EnumDescriptor {
-	return file_cbt_proto_enumTypes[5].Descriptor()
+	return file_cbt_proto_enumTypes[8].Descriptor()
 }
 
 func (UserRole) Type() protoreflect.EnumType {
-	return &file_cbt_proto_enumTypes[5]
+	return &file_cbt_proto_enumTypes[8]
 }
 
 func (x UserRole) Number() protoreflect.EnumNumber {
@@ -346,7 +511,7 @@ func (x UserRole) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserRole.Descriptor instead.
 func (UserRole) EnumDescriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{5}
+	return file_cbt_proto_rawDescGZIP(), []int{8}
 }
 
 type MessageStatusResponse struct {
@@ -674,15 +839,16 @@ func (x *LoginRequest) GetPassword() string {
 }
 
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken     string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	User             *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Success          bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	RefreshExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -757,6 +923,13 @@ func (x *LoginResponse) GetMessage() string {
 	return ""
 }
 
+func (x *LoginResponse) GetRefreshExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return nil
+}
+
 type UserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -1230,14 +1403,15 @@ func (x *RefreshTokenRequest) GetRefreshToken() string {
 }
 
 type RefreshTokenResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken     string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Success          bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	RefreshExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=refresh_expires_at,json=refreshExpiresAt,proto3" json:"refresh_expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RefreshTokenResponse) Reset() {
@@ -1305,23 +1479,84 @@ func (x *RefreshTokenResponse) GetMessage() string {
 	return ""
 }
 
+func (x *RefreshTokenResponse) GetRefreshExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshExpiresAt
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_cbt_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
 type UserLimit struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LimitType     string                 `protobuf:"bytes,3,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`
-	LimitValue    int32                  `protobuf:"varint,4,opt,name=limit_value,json=limitValue,proto3" json:"limit_value,omitempty"`
-	CurrentUsed   int32                  `protobuf:"varint,5,opt,name=current_used,json=currentUsed,proto3" json:"current_used,omitempty"`
-	ResetAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LimitType   string                 `protobuf:"bytes,3,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`
+	LimitValue  int32                  `protobuf:"varint,4,opt,name=limit_value,json=limitValue,proto3" json:"limit_value,omitempty"`
+	CurrentUsed int32                  `protobuf:"varint,5,opt,name=current_used,json=currentUsed,proto3" json:"current_used,omitempty"`
+	ResetAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set with SetUserLimit; wins over rate limit policies
+	IsOverride    bool `protobuf:"varint,9,opt,name=is_override,json=isOverride,proto3" json:"is_override,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserLimit) Reset() {
 	*x = UserLimit{}
-	mi := &file_cbt_proto_msgTypes[15]
+	mi := &file_cbt_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1333,7 +1568,7 @@ func (x *UserLimit) String() string {
 func (*UserLimit) ProtoMessage() {}
 
 func (x *UserLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[15]
+	mi := &file_cbt_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1581,7 @@ func (x *UserLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimit.ProtoReflect.Descriptor instead.
 func (*UserLimit) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{15}
+	return file_cbt_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UserLimit) GetId() int32 {
@@ -1405,6 +1640,13 @@ func (x *UserLimit) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserLimit) GetIsOverride() bool {
+	if x != nil {
+		return x.IsOverride
+	}
+	return false
+}
+
 type UserLimitUsage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1420,7 +1662,7 @@ type UserLimitUsage struct {
 
 func (x *UserLimitUsage) Reset() {
 	*x = UserLimitUsage{}
-	mi := &file_cbt_proto_msgTypes[16]
+	mi := &file_cbt_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1432,7 +1674,7 @@ func (x *UserLimitUsage) String() string {
 func (*UserLimitUsage) ProtoMessage() {}
 
 func (x *UserLimitUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[16]
+	mi := &file_cbt_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,7 +1687,7 @@ func (x *UserLimitUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimitUsage.ProtoReflect.Descriptor instead.
 func (*UserLimitUsage) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{16}
+	return file_cbt_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UserLimitUsage) GetId() int32 {
@@ -1506,7 +1748,7 @@ type GetUserLimitsRequest struct {
 
 func (x *GetUserLimitsRequest) Reset() {
 	*x = GetUserLimitsRequest{}
-	mi := &file_cbt_proto_msgTypes[17]
+	mi := &file_cbt_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1518,7 +1760,7 @@ func (x *GetUserLimitsRequest) String() string {
 func (*GetUserLimitsRequest) ProtoMessage() {}
 
 func (x *GetUserLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[17]
+	mi := &file_cbt_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1773,7 @@ func (x *GetUserLimitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLimitsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{17}
+	return file_cbt_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserLimitsRequest) GetUserId() int32 {
@@ -1552,7 +1794,7 @@ type GetUserLimitsResponse struct {
 
 func (x *GetUserLimitsResponse) Reset() {
 	*x = GetUserLimitsResponse{}
-	mi := &file_cbt_proto_msgTypes[18]
+	mi := &file_cbt_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1564,7 +1806,7 @@ func (x *GetUserLimitsResponse) String() string {
 func (*GetUserLimitsResponse) ProtoMessage() {}
 
 func (x *GetUserLimitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[18]
+	mi := &file_cbt_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,7 +1819,7 @@ func (x *GetUserLimitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLimitsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserLimitsResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{18}
+	return file_cbt_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetUserLimitsResponse) GetLimits() []*UserLimit {
@@ -1602,17 +1844,19 @@ func (x *GetUserLimitsResponse) GetMessage() string {
 }
 
 type SetUserLimitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LimitType     string                 `protobuf:"bytes,2,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`
-	LimitValue    int32                  `protobuf:"varint,3,opt,name=limit_value,json=limitValue,proto3" json:"limit_value,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LimitType  string                 `protobuf:"bytes,2,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`
+	LimitValue int32                  `protobuf:"varint,3,opt,name=limit_value,json=limitValue,proto3" json:"limit_value,omitempty"`
+	// Drop the per-user value and follow the rate limit policies again
+	UsePolicy     bool `protobuf:"varint,4,opt,name=use_policy,json=usePolicy,proto3" json:"use_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetUserLimitRequest) Reset() {
 	*x = SetUserLimitRequest{}
-	mi := &file_cbt_proto_msgTypes[19]
+	mi := &file_cbt_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1624,7 +1868,7 @@ func (x *SetUserLimitRequest) String() string {
 func (*SetUserLimitRequest) ProtoMessage() {}
 
 func (x *SetUserLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[19]
+	mi := &file_cbt_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,7 +1881,7 @@ func (x *SetUserLimitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetUserLimitRequest.ProtoReflect.Descriptor instead.
 func (*SetUserLimitRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{19}
+	return file_cbt_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SetUserLimitRequest) GetUserId() int32 {
@@ -1661,6 +1905,13 @@ func (x *SetUserLimitRequest) GetLimitValue() int32 {
 	return 0
 }
 
+func (x *SetUserLimitRequest) GetUsePolicy() bool {
+	if x != nil {
+		return x.UsePolicy
+	}
+	return false
+}
+
 type ResetUserLimitRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1671,7 +1922,7 @@ type ResetUserLimitRequest struct {
 
 func (x *ResetUserLimitRequest) Reset() {
 	*x = ResetUserLimitRequest{}
-	mi := &file_cbt_proto_msgTypes[20]
+	mi := &file_cbt_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1683,7 +1934,7 @@ func (x *ResetUserLimitRequest) String() string {
 func (*ResetUserLimitRequest) ProtoMessage() {}
 
 func (x *ResetUserLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[20]
+	mi := &file_cbt_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +1947,7 @@ func (x *ResetUserLimitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetUserLimitRequest.ProtoReflect.Descriptor instead.
 func (*ResetUserLimitRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{20}
+	return file_cbt_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ResetUserLimitRequest) GetUserId() int32 {
@@ -1724,7 +1975,7 @@ type UserLimitResponse struct {
 
 func (x *UserLimitResponse) Reset() {
 	*x = UserLimitResponse{}
-	mi := &file_cbt_proto_msgTypes[21]
+	mi := &file_cbt_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1736,7 +1987,7 @@ func (x *UserLimitResponse) String() string {
 func (*UserLimitResponse) ProtoMessage() {}
 
 func (x *UserLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[21]
+	mi := &file_cbt_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1749,7 +2000,7 @@ func (x *UserLimitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimitResponse.ProtoReflect.Descriptor instead.
 func (*UserLimitResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{21}
+	return file_cbt_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UserLimitResponse) GetLimit() *UserLimit {
@@ -1784,7 +2035,7 @@ type GetUserLimitUsageHistoryRequest struct {
 
 func (x *GetUserLimitUsageHistoryRequest) Reset() {
 	*x = GetUserLimitUsageHistoryRequest{}
-	mi := &file_cbt_proto_msgTypes[22]
+	mi := &file_cbt_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1796,7 +2047,7 @@ func (x *GetUserLimitUsageHistoryRequest) String() string {
 func (*GetUserLimitUsageHistoryRequest) ProtoMessage() {}
 
 func (x *GetUserLimitUsageHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[22]
+	mi := &file_cbt_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1809,7 +2060,7 @@ func (x *GetUserLimitUsageHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLimitUsageHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetUserLimitUsageHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{22}
+	return file_cbt_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetUserLimitUsageHistoryRequest) GetUserId() int32 {
@@ -1844,7 +2095,7 @@ type GetUserLimitUsageHistoryResponse struct {
 
 func (x *GetUserLimitUsageHistoryResponse) Reset() {
 	*x = GetUserLimitUsageHistoryResponse{}
-	mi := &file_cbt_proto_msgTypes[23]
+	mi := &file_cbt_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1856,7 +2107,7 @@ func (x *GetUserLimitUsageHistoryResponse) String() string {
 func (*GetUserLimitUsageHistoryResponse) ProtoMessage() {}
 
 func (x *GetUserLimitUsageHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[23]
+	mi := &file_cbt_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1869,7 +2120,7 @@ func (x *GetUserLimitUsageHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserLimitUsageHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetUserLimitUsageHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{23}
+	return file_cbt_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetUserLimitUsageHistoryResponse) GetHistory() []*UserLimitUsage {
@@ -1893,32 +2144,43 @@ func (x *GetUserLimitUsageHistoryResponse) GetMessage() string {
 	return ""
 }
 
-type MataPelajaran struct {
+// Empty role, school_plan and school_id match everyone. Without has_limit_value the user's
+// own limit applies, plus burst.
+type RateLimitPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
-	LmsSubjectId  int64                  `protobuf:"varint,3,opt,name=lms_subject_id,json=lmsSubjectId,proto3" json:"lms_subject_id,omitempty"`
-	LmsSchoolId   int64                  `protobuf:"varint,4,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
-	LmsClassId    int64                  `protobuf:"varint,5,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	MethodGroup   string                 `protobuf:"bytes,2,opt,name=method_group,json=methodGroup,proto3" json:"method_group,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	SchoolPlan    string                 `protobuf:"bytes,4,opt,name=school_plan,json=schoolPlan,proto3" json:"school_plan,omitempty"`
+	SchoolId      int64                  `protobuf:"varint,5,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	LimitType     string                 `protobuf:"bytes,6,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`
+	LimitValue    int32                  `protobuf:"varint,7,opt,name=limit_value,json=limitValue,proto3" json:"limit_value,omitempty"`
+	HasLimitValue bool                   `protobuf:"varint,8,opt,name=has_limit_value,json=hasLimitValue,proto3" json:"has_limit_value,omitempty"`
+	Burst         int32                  `protobuf:"varint,9,opt,name=burst,proto3" json:"burst,omitempty"`
+	ExamExempt    bool                   `protobuf:"varint,10,opt,name=exam_exempt,json=examExempt,proto3" json:"exam_exempt,omitempty"`
+	// Disabled policies are kept but never picked
+	Disabled      bool                   `protobuf:"varint,11,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MataPelajaran) Reset() {
-	*x = MataPelajaran{}
-	mi := &file_cbt_proto_msgTypes[24]
+func (x *RateLimitPolicy) Reset() {
+	*x = RateLimitPolicy{}
+	mi := &file_cbt_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MataPelajaran) String() string {
+func (x *RateLimitPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MataPelajaran) ProtoMessage() {}
+func (*RateLimitPolicy) ProtoMessage() {}
 
-func (x *MataPelajaran) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[24]
+func (x *RateLimitPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,111 +2191,124 @@ func (x *MataPelajaran) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MataPelajaran.ProtoReflect.Descriptor instead.
-func (*MataPelajaran) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RateLimitPolicy.ProtoReflect.Descriptor instead.
+func (*RateLimitPolicy) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MataPelajaran) GetId() int32 {
+func (x *RateLimitPolicy) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MataPelajaran) GetNama() string {
+func (x *RateLimitPolicy) GetMethodGroup() string {
 	if x != nil {
-		return x.Nama
+		return x.MethodGroup
 	}
 	return ""
 }
 
-func (x *MataPelajaran) GetLmsSubjectId() int64 {
+func (x *RateLimitPolicy) GetRole() string {
 	if x != nil {
-		return x.LmsSubjectId
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-func (x *MataPelajaran) GetLmsSchoolId() int64 {
+func (x *RateLimitPolicy) GetSchoolPlan() string {
 	if x != nil {
-		return x.LmsSchoolId
+		return x.SchoolPlan
 	}
-	return 0
+	return ""
 }
 
-func (x *MataPelajaran) GetLmsClassId() int64 {
+func (x *RateLimitPolicy) GetSchoolId() int64 {
 	if x != nil {
-		return x.LmsClassId
+		return x.SchoolId
 	}
 	return 0
 }
 
-type CreateMataPelajaranRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nama          string                 `protobuf:"bytes,1,opt,name=nama,proto3" json:"nama,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RateLimitPolicy) GetLimitType() string {
+	if x != nil {
+		return x.LimitType
+	}
+	return ""
 }
 
-func (x *CreateMataPelajaranRequest) Reset() {
-	*x = CreateMataPelajaranRequest{}
-	mi := &file_cbt_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RateLimitPolicy) GetLimitValue() int32 {
+	if x != nil {
+		return x.LimitValue
+	}
+	return 0
 }
 
-func (x *CreateMataPelajaranRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RateLimitPolicy) GetHasLimitValue() bool {
+	if x != nil {
+		return x.HasLimitValue
+	}
+	return false
 }
 
-func (*CreateMataPelajaranRequest) ProtoMessage() {}
+func (x *RateLimitPolicy) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
 
-func (x *CreateMataPelajaranRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[25]
+func (x *RateLimitPolicy) GetExamExempt() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExamExempt
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CreateMataPelajaranRequest.ProtoReflect.Descriptor instead.
-func (*CreateMataPelajaranRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{25}
+func (x *RateLimitPolicy) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-func (x *CreateMataPelajaranRequest) GetNama() string {
+func (x *RateLimitPolicy) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Nama
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type GetMataPelajaranRequest struct {
+func (x *RateLimitPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RateLimitMethodGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	MethodGroup   string                 `protobuf:"bytes,2,opt,name=method_group,json=methodGroup,proto3" json:"method_group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMataPelajaranRequest) Reset() {
-	*x = GetMataPelajaranRequest{}
+func (x *RateLimitMethodGroup) Reset() {
+	*x = RateLimitMethodGroup{}
 	mi := &file_cbt_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMataPelajaranRequest) String() string {
+func (x *RateLimitMethodGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMataPelajaranRequest) ProtoMessage() {}
+func (*RateLimitMethodGroup) ProtoMessage() {}
 
-func (x *GetMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+func (x *RateLimitMethodGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_cbt_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2045,40 +2320,47 @@ func (x *GetMataPelajaranRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMataPelajaranRequest.ProtoReflect.Descriptor instead.
-func (*GetMataPelajaranRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimitMethodGroup.ProtoReflect.Descriptor instead.
+func (*RateLimitMethodGroup) Descriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetMataPelajaranRequest) GetId() int32 {
+func (x *RateLimitMethodGroup) GetMethod() string {
 	if x != nil {
-		return x.Id
+		return x.Method
 	}
-	return 0
+	return ""
 }
 
-type UpdateMataPelajaranRequest struct {
+func (x *RateLimitMethodGroup) GetMethodGroup() string {
+	if x != nil {
+		return x.MethodGroup
+	}
+	return ""
+}
+
+type SchoolPlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	SchoolId      int64                  `protobuf:"varint,1,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	Plan          string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMataPelajaranRequest) Reset() {
-	*x = UpdateMataPelajaranRequest{}
+func (x *SchoolPlan) Reset() {
+	*x = SchoolPlan{}
 	mi := &file_cbt_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMataPelajaranRequest) String() string {
+func (x *SchoolPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMataPelajaranRequest) ProtoMessage() {}
+func (*SchoolPlan) ProtoMessage() {}
 
-func (x *UpdateMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+func (x *SchoolPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_cbt_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2090,46 +2372,45 @@ func (x *UpdateMataPelajaranRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMataPelajaranRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMataPelajaranRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SchoolPlan.ProtoReflect.Descriptor instead.
+func (*SchoolPlan) Descriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateMataPelajaranRequest) GetId() int32 {
+func (x *SchoolPlan) GetSchoolId() int64 {
 	if x != nil {
-		return x.Id
+		return x.SchoolId
 	}
 	return 0
 }
 
-func (x *UpdateMataPelajaranRequest) GetNama() string {
+func (x *SchoolPlan) GetPlan() string {
 	if x != nil {
-		return x.Nama
+		return x.Plan
 	}
 	return ""
 }
 
-type DeleteMataPelajaranRequest struct {
+type ListRateLimitPoliciesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMataPelajaranRequest) Reset() {
-	*x = DeleteMataPelajaranRequest{}
+func (x *ListRateLimitPoliciesRequest) Reset() {
+	*x = ListRateLimitPoliciesRequest{}
 	mi := &file_cbt_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMataPelajaranRequest) String() string {
+func (x *ListRateLimitPoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMataPelajaranRequest) ProtoMessage() {}
+func (*ListRateLimitPoliciesRequest) ProtoMessage() {}
 
-func (x *DeleteMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRateLimitPoliciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cbt_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2141,39 +2422,36 @@ func (x *DeleteMataPelajaranRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMataPelajaranRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMataPelajaranRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRateLimitPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRateLimitPoliciesRequest) Descriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteMataPelajaranRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type MataPelajaranResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MataPelajaran *MataPelajaran         `protobuf:"bytes,1,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"`
+type ListRateLimitPoliciesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Policies      []*RateLimitPolicy      `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	MethodGroups  []*RateLimitMethodGroup `protobuf:"bytes,2,rep,name=method_groups,json=methodGroups,proto3" json:"method_groups,omitempty"`
+	SchoolPlans   []*SchoolPlan           `protobuf:"bytes,3,rep,name=school_plans,json=schoolPlans,proto3" json:"school_plans,omitempty"`
+	Success       bool                    `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                  `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MataPelajaranResponse) Reset() {
-	*x = MataPelajaranResponse{}
+func (x *ListRateLimitPoliciesResponse) Reset() {
+	*x = ListRateLimitPoliciesResponse{}
 	mi := &file_cbt_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MataPelajaranResponse) String() string {
+func (x *ListRateLimitPoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MataPelajaranResponse) ProtoMessage() {}
+func (*ListRateLimitPoliciesResponse) ProtoMessage() {}
 
-func (x *MataPelajaranResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRateLimitPoliciesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cbt_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2185,39 +2463,67 @@ func (x *MataPelajaranResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MataPelajaranResponse.ProtoReflect.Descriptor instead.
-func (*MataPelajaranResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRateLimitPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRateLimitPoliciesResponse) Descriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MataPelajaranResponse) GetMataPelajaran() *MataPelajaran {
+func (x *ListRateLimitPoliciesResponse) GetPolicies() []*RateLimitPolicy {
 	if x != nil {
-		return x.MataPelajaran
+		return x.Policies
 	}
 	return nil
 }
 
-type ListMataPelajaranResponse struct {
+func (x *ListRateLimitPoliciesResponse) GetMethodGroups() []*RateLimitMethodGroup {
+	if x != nil {
+		return x.MethodGroups
+	}
+	return nil
+}
+
+func (x *ListRateLimitPoliciesResponse) GetSchoolPlans() []*SchoolPlan {
+	if x != nil {
+		return x.SchoolPlans
+	}
+	return nil
+}
+
+func (x *ListRateLimitPoliciesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListRateLimitPoliciesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SaveRateLimitPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MataPelajaran []*MataPelajaran       `protobuf:"bytes,1,rep,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"`
+	Policy        *RateLimitPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMataPelajaranResponse) Reset() {
-	*x = ListMataPelajaranResponse{}
+func (x *SaveRateLimitPolicyRequest) Reset() {
+	*x = SaveRateLimitPolicyRequest{}
 	mi := &file_cbt_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMataPelajaranResponse) String() string {
+func (x *SaveRateLimitPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMataPelajaranResponse) ProtoMessage() {}
+func (*SaveRateLimitPolicyRequest) ProtoMessage() {}
 
-func (x *ListMataPelajaranResponse) ProtoReflect() protoreflect.Message {
+func (x *SaveRateLimitPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cbt_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,54 +2535,41 @@ func (x *ListMataPelajaranResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMataPelajaranResponse.ProtoReflect.Descriptor instead.
-func (*ListMataPelajaranResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveRateLimitPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SaveRateLimitPolicyRequest) Descriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListMataPelajaranResponse) GetMataPelajaran() []*MataPelajaran {
+func (x *SaveRateLimitPolicyRequest) GetPolicy() *RateLimitPolicy {
 	if x != nil {
-		return x.MataPelajaran
+		return x.Policy
 	}
 	return nil
 }
 
-type Materi struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MataPelajaran        *MataPelajaran         `protobuf:"bytes,2,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"` // Nested, bukan hanya ID
-	Tingkat              *Tingkat               `protobuf:"bytes,3,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
-	Nama                 string                 `protobuf:"bytes,4,opt,name=nama,proto3" json:"nama,omitempty"`
-	IsActive             bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	DefaultDurasiMenit   int32                  `protobuf:"varint,6,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
-	DefaultJumlahSoal    int32                  `protobuf:"varint,7,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
-	JumlahSoalReal       int32                  `protobuf:"varint,8,opt,name=jumlah_soal_real,json=jumlahSoalReal,proto3" json:"jumlah_soal_real,omitempty"` // Actual question count from database
-	LmsModuleId          int64                  `protobuf:"varint,9,opt,name=lms_module_id,json=lmsModuleId,proto3" json:"lms_module_id,omitempty"`
-	LmsClassId           int64                  `protobuf:"varint,10,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	OwnerUserId          int64                  `protobuf:"varint,11,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"` // ID dari guru pembuat materi
-	SchoolId             int64                  `protobuf:"varint,12,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`            // Sekolah pemilik materi (multi-tenancy)
-	Labels               []string               `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`                                 // Tags / labels untuk materi
-	LmsBookId            int64                  `protobuf:"varint,14,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
-	LmsTeacherMaterialId int64                  `protobuf:"varint,15,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
-	RandomizeQuestions   bool                   `protobuf:"varint,16,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type RateLimitPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *RateLimitPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Materi) Reset() {
-	*x = Materi{}
+func (x *RateLimitPolicyResponse) Reset() {
+	*x = RateLimitPolicyResponse{}
 	mi := &file_cbt_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Materi) String() string {
+func (x *RateLimitPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Materi) ProtoMessage() {}
+func (*RateLimitPolicyResponse) ProtoMessage() {}
 
-func (x *Materi) ProtoReflect() protoreflect.Message {
+func (x *RateLimitPolicyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cbt_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2288,156 +2581,8618 @@ func (x *Materi) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Materi.ProtoReflect.Descriptor instead.
-func (*Materi) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimitPolicyResponse.ProtoReflect.Descriptor instead.
+func (*RateLimitPolicyResponse) Descriptor() ([]byte, []int) {
 	return file_cbt_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Materi) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Materi) GetMataPelajaran() *MataPelajaran {
+func (x *RateLimitPolicyResponse) GetPolicy() *RateLimitPolicy {
 	if x != nil {
-		return x.MataPelajaran
+		return x.Policy
 	}
 	return nil
 }
 
-func (x *Materi) GetTingkat() *Tingkat {
+func (x *RateLimitPolicyResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Tingkat
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *Materi) GetNama() string {
+func (x *RateLimitPolicyResponse) GetMessage() string {
 	if x != nil {
-		return x.Nama
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Materi) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
+type DeleteRateLimitPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Materi) GetDefaultDurasiMenit() int32 {
-	if x != nil {
-		return x.DefaultDurasiMenit
-	}
-	return 0
+func (x *DeleteRateLimitPolicyRequest) Reset() {
+	*x = DeleteRateLimitPolicyRequest{}
+	mi := &file_cbt_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Materi) GetDefaultJumlahSoal() int32 {
-	if x != nil {
-		return x.DefaultJumlahSoal
-	}
-	return 0
+func (x *DeleteRateLimitPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Materi) GetJumlahSoalReal() int32 {
-	if x != nil {
-		return x.JumlahSoalReal
-	}
-	return 0
-}
+func (*DeleteRateLimitPolicyRequest) ProtoMessage() {}
 
-func (x *Materi) GetLmsModuleId() int64 {
+func (x *DeleteRateLimitPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[32]
 	if x != nil {
-		return x.LmsModuleId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Materi) GetLmsClassId() int64 {
-	if x != nil {
-		return x.LmsClassId
-	}
-	return 0
+// Deprecated: Use DeleteRateLimitPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRateLimitPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Materi) GetOwnerUserId() int64 {
+func (x *DeleteRateLimitPolicyRequest) GetId() int32 {
 	if x != nil {
-		return x.OwnerUserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Materi) GetSchoolId() int64 {
-	if x != nil {
-		return x.SchoolId
-	}
-	return 0
+type SetRateLimitMethodGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full method name, or "/base.<Service>/*"
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Empty moves the method back to the default group
+	MethodGroup   string `protobuf:"bytes,2,opt,name=method_group,json=methodGroup,proto3" json:"method_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Materi) GetLabels() []string {
-	if x != nil {
+func (x *SetRateLimitMethodGroupRequest) Reset() {
+	*x = SetRateLimitMethodGroupRequest{}
+	mi := &file_cbt_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRateLimitMethodGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRateLimitMethodGroupRequest) ProtoMessage() {}
+
+func (x *SetRateLimitMethodGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRateLimitMethodGroupRequest.ProtoReflect.Descriptor instead.
+func (*SetRateLimitMethodGroupRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetRateLimitMethodGroupRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SetRateLimitMethodGroupRequest) GetMethodGroup() string {
+	if x != nil {
+		return x.MethodGroup
+	}
+	return ""
+}
+
+type SetSchoolPlanRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SchoolId int64                  `protobuf:"varint,1,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`
+	// Empty removes the plan
+	Plan          string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSchoolPlanRequest) Reset() {
+	*x = SetSchoolPlanRequest{}
+	mi := &file_cbt_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSchoolPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSchoolPlanRequest) ProtoMessage() {}
+
+func (x *SetSchoolPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSchoolPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetSchoolPlanRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetSchoolPlanRequest) GetSchoolId() int64 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *SetSchoolPlanRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+type MataPelajaran struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	LmsSubjectId  int64                  `protobuf:"varint,3,opt,name=lms_subject_id,json=lmsSubjectId,proto3" json:"lms_subject_id,omitempty"`
+	LmsSchoolId   int64                  `protobuf:"varint,4,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	LmsClassId    int64                  `protobuf:"varint,5,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MataPelajaran) Reset() {
+	*x = MataPelajaran{}
+	mi := &file_cbt_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MataPelajaran) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MataPelajaran) ProtoMessage() {}
+
+func (x *MataPelajaran) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MataPelajaran.ProtoReflect.Descriptor instead.
+func (*MataPelajaran) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MataPelajaran) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MataPelajaran) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *MataPelajaran) GetLmsSubjectId() int64 {
+	if x != nil {
+		return x.LmsSubjectId
+	}
+	return 0
+}
+
+func (x *MataPelajaran) GetLmsSchoolId() int64 {
+	if x != nil {
+		return x.LmsSchoolId
+	}
+	return 0
+}
+
+func (x *MataPelajaran) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+type CreateMataPelajaranRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nama          string                 `protobuf:"bytes,1,opt,name=nama,proto3" json:"nama,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMataPelajaranRequest) Reset() {
+	*x = CreateMataPelajaranRequest{}
+	mi := &file_cbt_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMataPelajaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMataPelajaranRequest) ProtoMessage() {}
+
+func (x *CreateMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMataPelajaranRequest.ProtoReflect.Descriptor instead.
+func (*CreateMataPelajaranRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateMataPelajaranRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+type GetMataPelajaranRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMataPelajaranRequest) Reset() {
+	*x = GetMataPelajaranRequest{}
+	mi := &file_cbt_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMataPelajaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMataPelajaranRequest) ProtoMessage() {}
+
+func (x *GetMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMataPelajaranRequest.ProtoReflect.Descriptor instead.
+func (*GetMataPelajaranRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetMataPelajaranRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateMataPelajaranRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMataPelajaranRequest) Reset() {
+	*x = UpdateMataPelajaranRequest{}
+	mi := &file_cbt_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMataPelajaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMataPelajaranRequest) ProtoMessage() {}
+
+func (x *UpdateMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMataPelajaranRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMataPelajaranRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateMataPelajaranRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMataPelajaranRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+type DeleteMataPelajaranRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMataPelajaranRequest) Reset() {
+	*x = DeleteMataPelajaranRequest{}
+	mi := &file_cbt_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMataPelajaranRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMataPelajaranRequest) ProtoMessage() {}
+
+func (x *DeleteMataPelajaranRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMataPelajaranRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMataPelajaranRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteMataPelajaranRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MataPelajaranResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MataPelajaran *MataPelajaran         `protobuf:"bytes,1,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MataPelajaranResponse) Reset() {
+	*x = MataPelajaranResponse{}
+	mi := &file_cbt_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MataPelajaranResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MataPelajaranResponse) ProtoMessage() {}
+
+func (x *MataPelajaranResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MataPelajaranResponse.ProtoReflect.Descriptor instead.
+func (*MataPelajaranResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MataPelajaranResponse) GetMataPelajaran() *MataPelajaran {
+	if x != nil {
+		return x.MataPelajaran
+	}
+	return nil
+}
+
+type ListMataPelajaranResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MataPelajaran []*MataPelajaran       `protobuf:"bytes,1,rep,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMataPelajaranResponse) Reset() {
+	*x = ListMataPelajaranResponse{}
+	mi := &file_cbt_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMataPelajaranResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMataPelajaranResponse) ProtoMessage() {}
+
+func (x *ListMataPelajaranResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMataPelajaranResponse.ProtoReflect.Descriptor instead.
+func (*ListMataPelajaranResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListMataPelajaranResponse) GetMataPelajaran() []*MataPelajaran {
+	if x != nil {
+		return x.MataPelajaran
+	}
+	return nil
+}
+
+type Materi struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MataPelajaran        *MataPelajaran         `protobuf:"bytes,2,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"` // Nested, bukan hanya ID
+	Tingkat              *Tingkat               `protobuf:"bytes,3,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
+	Nama                 string                 `protobuf:"bytes,4,opt,name=nama,proto3" json:"nama,omitempty"`
+	IsActive             bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DefaultDurasiMenit   int32                  `protobuf:"varint,6,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
+	DefaultJumlahSoal    int32                  `protobuf:"varint,7,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
+	JumlahSoalReal       int32                  `protobuf:"varint,8,opt,name=jumlah_soal_real,json=jumlahSoalReal,proto3" json:"jumlah_soal_real,omitempty"` // Actual question count from database
+	LmsModuleId          int64                  `protobuf:"varint,9,opt,name=lms_module_id,json=lmsModuleId,proto3" json:"lms_module_id,omitempty"`
+	LmsClassId           int64                  `protobuf:"varint,10,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	OwnerUserId          int64                  `protobuf:"varint,11,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"` // ID dari guru pembuat materi
+	SchoolId             int64                  `protobuf:"varint,12,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`            // Sekolah pemilik materi (multi-tenancy)
+	Labels               []string               `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`                                 // Tags / labels untuk materi
+	LmsBookId            int64                  `protobuf:"varint,14,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
+	LmsTeacherMaterialId int64                  `protobuf:"varint,15,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
+	RandomizeQuestions   bool                   `protobuf:"varint,16,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Materi) Reset() {
+	*x = Materi{}
+	mi := &file_cbt_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Materi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Materi) ProtoMessage() {}
+
+func (x *Materi) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Materi.ProtoReflect.Descriptor instead.
+func (*Materi) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Materi) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Materi) GetMataPelajaran() *MataPelajaran {
+	if x != nil {
+		return x.MataPelajaran
+	}
+	return nil
+}
+
+func (x *Materi) GetTingkat() *Tingkat {
+	if x != nil {
+		return x.Tingkat
+	}
+	return nil
+}
+
+func (x *Materi) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *Materi) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Materi) GetDefaultDurasiMenit() int32 {
+	if x != nil {
+		return x.DefaultDurasiMenit
+	}
+	return 0
+}
+
+func (x *Materi) GetDefaultJumlahSoal() int32 {
+	if x != nil {
+		return x.DefaultJumlahSoal
+	}
+	return 0
+}
+
+func (x *Materi) GetJumlahSoalReal() int32 {
+	if x != nil {
+		return x.JumlahSoalReal
+	}
+	return 0
+}
+
+func (x *Materi) GetLmsModuleId() int64 {
+	if x != nil {
+		return x.LmsModuleId
+	}
+	return 0
+}
+
+func (x *Materi) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *Materi) GetOwnerUserId() int64 {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *Materi) GetSchoolId() int64 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *Materi) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Materi) GetLmsBookId() int64 {
+	if x != nil {
+		return x.LmsBookId
+	}
+	return 0
+}
+
+func (x *Materi) GetLmsTeacherMaterialId() int64 {
+	if x != nil {
+		return x.LmsTeacherMaterialId
+	}
+	return 0
+}
+
+func (x *Materi) GetRandomizeQuestions() bool {
+	if x != nil {
+		return x.RandomizeQuestions
+	}
+	return false
+}
+
+type CreateMateriRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	IdMataPelajaran      int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	IdTingkat            int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Nama                 string                 `protobuf:"bytes,3,opt,name=nama,proto3" json:"nama,omitempty"`
+	IsActive             bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DefaultDurasiMenit   int32                  `protobuf:"varint,5,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
+	DefaultJumlahSoal    int32                  `protobuf:"varint,6,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
+	LmsClassId           int64                  `protobuf:"varint,7,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope for the materi
+	Labels               []string               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`                              // Optional labels/tags for the materi
+	LmsModuleId          int64                  `protobuf:"varint,9,opt,name=lms_module_id,json=lmsModuleId,proto3" json:"lms_module_id,omitempty"`
+	LmsBookId            int64                  `protobuf:"varint,10,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
+	LmsTeacherMaterialId int64                  `protobuf:"varint,11,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
+	RandomizeQuestions   bool                   `protobuf:"varint,12,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateMateriRequest) Reset() {
+	*x = CreateMateriRequest{}
+	mi := &file_cbt_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMateriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMateriRequest) ProtoMessage() {}
+
+func (x *CreateMateriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMateriRequest.ProtoReflect.Descriptor instead.
+func (*CreateMateriRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateMateriRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *CreateMateriRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateMateriRequest) GetDefaultDurasiMenit() int32 {
+	if x != nil {
+		return x.DefaultDurasiMenit
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetDefaultJumlahSoal() int32 {
+	if x != nil {
+		return x.DefaultJumlahSoal
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateMateriRequest) GetLmsModuleId() int64 {
+	if x != nil {
+		return x.LmsModuleId
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetLmsBookId() int64 {
+	if x != nil {
+		return x.LmsBookId
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetLmsTeacherMaterialId() int64 {
+	if x != nil {
+		return x.LmsTeacherMaterialId
+	}
+	return 0
+}
+
+func (x *CreateMateriRequest) GetRandomizeQuestions() bool {
+	if x != nil {
+		return x.RandomizeQuestions
+	}
+	return false
+}
+
+type CreateMateriSuperadminRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	IdMataPelajaran    int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	IdTingkat          int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Nama               string                 `protobuf:"bytes,3,opt,name=nama,proto3" json:"nama,omitempty"`
+	IsActive           bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DefaultDurasiMenit int32                  `protobuf:"varint,5,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
+	DefaultJumlahSoal  int32                  `protobuf:"varint,6,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
+	Labels             []string               `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	LmsBookId          int64                  `protobuf:"varint,8,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
+	RandomizeQuestions bool                   `protobuf:"varint,9,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateMateriSuperadminRequest) Reset() {
+	*x = CreateMateriSuperadminRequest{}
+	mi := &file_cbt_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMateriSuperadminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMateriSuperadminRequest) ProtoMessage() {}
+
+func (x *CreateMateriSuperadminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMateriSuperadminRequest.ProtoReflect.Descriptor instead.
+func (*CreateMateriSuperadminRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateMateriSuperadminRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *CreateMateriSuperadminRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *CreateMateriSuperadminRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *CreateMateriSuperadminRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateMateriSuperadminRequest) GetDefaultDurasiMenit() int32 {
+	if x != nil {
+		return x.DefaultDurasiMenit
+	}
+	return 0
+}
+
+func (x *CreateMateriSuperadminRequest) GetDefaultJumlahSoal() int32 {
+	if x != nil {
+		return x.DefaultJumlahSoal
+	}
+	return 0
+}
+
+func (x *CreateMateriSuperadminRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateMateriSuperadminRequest) GetLmsBookId() int64 {
+	if x != nil {
+		return x.LmsBookId
+	}
+	return 0
+}
+
+func (x *CreateMateriSuperadminRequest) GetRandomizeQuestions() bool {
+	if x != nil {
+		return x.RandomizeQuestions
+	}
+	return false
+}
+
+type CreateMateriTeacherRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	IdMataPelajaran      int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	IdTingkat            int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Nama                 string                 `protobuf:"bytes,3,opt,name=nama,proto3" json:"nama,omitempty"`
+	IsActive             bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DefaultDurasiMenit   int32                  `protobuf:"varint,5,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
+	DefaultJumlahSoal    int32                  `protobuf:"varint,6,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
+	LmsClassId           int64                  `protobuf:"varint,7,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	Labels               []string               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	LmsTeacherMaterialId int64                  `protobuf:"varint,9,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
+	RandomizeQuestions   bool                   `protobuf:"varint,10,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateMateriTeacherRequest) Reset() {
+	*x = CreateMateriTeacherRequest{}
+	mi := &file_cbt_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMateriTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMateriTeacherRequest) ProtoMessage() {}
+
+func (x *CreateMateriTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMateriTeacherRequest.ProtoReflect.Descriptor instead.
+func (*CreateMateriTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateMateriTeacherRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *CreateMateriTeacherRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *CreateMateriTeacherRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *CreateMateriTeacherRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateMateriTeacherRequest) GetDefaultDurasiMenit() int32 {
+	if x != nil {
+		return x.DefaultDurasiMenit
+	}
+	return 0
+}
+
+func (x *CreateMateriTeacherRequest) GetDefaultJumlahSoal() int32 {
+	if x != nil {
+		return x.DefaultJumlahSoal
+	}
+	return 0
+}
+
+func (x *CreateMateriTeacherRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *CreateMateriTeacherRequest) GetLabels() []string {
+	if x != nil {
 		return x.Labels
 	}
 	return nil
 }
 
-func (x *Materi) GetLmsBookId() int64 {
+func (x *CreateMateriTeacherRequest) GetLmsTeacherMaterialId() int64 {
+	if x != nil {
+		return x.LmsTeacherMaterialId
+	}
+	return 0
+}
+
+func (x *CreateMateriTeacherRequest) GetRandomizeQuestions() bool {
+	if x != nil {
+		return x.RandomizeQuestions
+	}
+	return false
+}
+
+type GetMateriRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMateriRequest) Reset() {
+	*x = GetMateriRequest{}
+	mi := &file_cbt_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMateriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMateriRequest) ProtoMessage() {}
+
+func (x *GetMateriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMateriRequest.ProtoReflect.Descriptor instead.
+func (*GetMateriRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetMateriRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateMateriRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdMataPelajaran      int32                  `protobuf:"varint,2,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	IdTingkat            int32                  `protobuf:"varint,3,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Nama                 string                 `protobuf:"bytes,4,opt,name=nama,proto3" json:"nama,omitempty"`
+	IsActive             bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	DefaultDurasiMenit   int32                  `protobuf:"varint,6,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
+	DefaultJumlahSoal    int32                  `protobuf:"varint,7,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
+	LmsModuleId          int64                  `protobuf:"varint,8,opt,name=lms_module_id,json=lmsModuleId,proto3" json:"lms_module_id,omitempty"`
+	LmsBookId            int64                  `protobuf:"varint,9,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
+	LmsTeacherMaterialId int64                  `protobuf:"varint,10,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
+	RandomizeQuestions   bool                   `protobuf:"varint,11,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateMateriRequest) Reset() {
+	*x = UpdateMateriRequest{}
+	mi := &file_cbt_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMateriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMateriRequest) ProtoMessage() {}
+
+func (x *UpdateMateriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMateriRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMateriRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateMateriRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *UpdateMateriRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateMateriRequest) GetDefaultDurasiMenit() int32 {
+	if x != nil {
+		return x.DefaultDurasiMenit
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetDefaultJumlahSoal() int32 {
+	if x != nil {
+		return x.DefaultJumlahSoal
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetLmsModuleId() int64 {
+	if x != nil {
+		return x.LmsModuleId
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetLmsBookId() int64 {
+	if x != nil {
+		return x.LmsBookId
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetLmsTeacherMaterialId() int64 {
+	if x != nil {
+		return x.LmsTeacherMaterialId
+	}
+	return 0
+}
+
+func (x *UpdateMateriRequest) GetRandomizeQuestions() bool {
+	if x != nil {
+		return x.RandomizeQuestions
+	}
+	return false
+}
+
+type DeleteMateriRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMateriRequest) Reset() {
+	*x = DeleteMateriRequest{}
+	mi := &file_cbt_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMateriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMateriRequest) ProtoMessage() {}
+
+func (x *DeleteMateriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMateriRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMateriRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteMateriRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MateriResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materi        *Materi                `protobuf:"bytes,1,opt,name=materi,proto3" json:"materi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MateriResponse) Reset() {
+	*x = MateriResponse{}
+	mi := &file_cbt_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MateriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MateriResponse) ProtoMessage() {}
+
+func (x *MateriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MateriResponse.ProtoReflect.Descriptor instead.
+func (*MateriResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MateriResponse) GetMateri() *Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+type ListMateriRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IdMataPelajaran int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	IdTingkat       int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Pagination      *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMateriRequest) Reset() {
+	*x = ListMateriRequest{}
+	mi := &file_cbt_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMateriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMateriRequest) ProtoMessage() {}
+
+func (x *ListMateriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMateriRequest.ProtoReflect.Descriptor instead.
+func (*ListMateriRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMateriRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *ListMateriRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *ListMateriRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListMateriResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materi        []*Materi              `protobuf:"bytes,1,rep,name=materi,proto3" json:"materi,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMateriResponse) Reset() {
+	*x = ListMateriResponse{}
+	mi := &file_cbt_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMateriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMateriResponse) ProtoMessage() {}
+
+func (x *ListMateriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMateriResponse.ProtoReflect.Descriptor instead.
+func (*ListMateriResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListMateriResponse) GetMateri() []*Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+func (x *ListMateriResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// MateriShare gives a teacher other than the owner access to a materi and its questions
+type MateriShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Permission    string                 `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"` // view or edit
+	GrantedBy     int32                  `protobuf:"varint,5,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MateriShare) Reset() {
+	*x = MateriShare{}
+	mi := &file_cbt_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MateriShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MateriShare) ProtoMessage() {}
+
+func (x *MateriShare) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MateriShare.ProtoReflect.Descriptor instead.
+func (*MateriShare) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MateriShare) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *MateriShare) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MateriShare) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *MateriShare) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *MateriShare) GetGrantedBy() int32 {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return 0
+}
+
+func (x *MateriShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ShareMateriRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"` // view or edit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareMateriRequest) Reset() {
+	*x = ShareMateriRequest{}
+	mi := &file_cbt_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareMateriRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareMateriRequest) ProtoMessage() {}
+
+func (x *ShareMateriRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareMateriRequest.ProtoReflect.Descriptor instead.
+func (*ShareMateriRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ShareMateriRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *ShareMateriRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShareMateriRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type ShareMateriResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         *MateriShare           `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareMateriResponse) Reset() {
+	*x = ShareMateriResponse{}
+	mi := &file_cbt_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareMateriResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareMateriResponse) ProtoMessage() {}
+
+func (x *ShareMateriResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareMateriResponse.ProtoReflect.Descriptor instead.
+func (*ShareMateriResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ShareMateriResponse) GetShare() *MateriShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type RevokeMateriShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMateriShareRequest) Reset() {
+	*x = RevokeMateriShareRequest{}
+	mi := &file_cbt_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMateriShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMateriShareRequest) ProtoMessage() {}
+
+func (x *RevokeMateriShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMateriShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMateriShareRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RevokeMateriShareRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *RevokeMateriShareRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListMateriSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMateriSharesRequest) Reset() {
+	*x = ListMateriSharesRequest{}
+	mi := &file_cbt_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMateriSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMateriSharesRequest) ProtoMessage() {}
+
+func (x *ListMateriSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMateriSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMateriSharesRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListMateriSharesRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+type ListMateriSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*MateriShare         `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMateriSharesResponse) Reset() {
+	*x = ListMateriSharesResponse{}
+	mi := &file_cbt_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMateriSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMateriSharesResponse) ProtoMessage() {}
+
+func (x *ListMateriSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMateriSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMateriSharesResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListMateriSharesResponse) GetShares() []*MateriShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type Tingkat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	LmsLevelId    int64                  `protobuf:"varint,3,opt,name=lms_level_id,json=lmsLevelId,proto3" json:"lms_level_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tingkat) Reset() {
+	*x = Tingkat{}
+	mi := &file_cbt_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tingkat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tingkat) ProtoMessage() {}
+
+func (x *Tingkat) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tingkat.ProtoReflect.Descriptor instead.
+func (*Tingkat) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Tingkat) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tingkat) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+func (x *Tingkat) GetLmsLevelId() int64 {
+	if x != nil {
+		return x.LmsLevelId
+	}
+	return 0
+}
+
+type CreateTingkatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nama          string                 `protobuf:"bytes,1,opt,name=nama,proto3" json:"nama,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTingkatRequest) Reset() {
+	*x = CreateTingkatRequest{}
+	mi := &file_cbt_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTingkatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTingkatRequest) ProtoMessage() {}
+
+func (x *CreateTingkatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTingkatRequest.ProtoReflect.Descriptor instead.
+func (*CreateTingkatRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateTingkatRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+type GetTingkatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTingkatRequest) Reset() {
+	*x = GetTingkatRequest{}
+	mi := &file_cbt_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTingkatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTingkatRequest) ProtoMessage() {}
+
+func (x *GetTingkatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTingkatRequest.ProtoReflect.Descriptor instead.
+func (*GetTingkatRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTingkatRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateTingkatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTingkatRequest) Reset() {
+	*x = UpdateTingkatRequest{}
+	mi := &file_cbt_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTingkatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTingkatRequest) ProtoMessage() {}
+
+func (x *UpdateTingkatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTingkatRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTingkatRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateTingkatRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTingkatRequest) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
+}
+
+type DeleteTingkatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTingkatRequest) Reset() {
+	*x = DeleteTingkatRequest{}
+	mi := &file_cbt_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTingkatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTingkatRequest) ProtoMessage() {}
+
+func (x *DeleteTingkatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTingkatRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTingkatRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteTingkatRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TingkatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tingkat       *Tingkat               `protobuf:"bytes,1,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TingkatResponse) Reset() {
+	*x = TingkatResponse{}
+	mi := &file_cbt_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TingkatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TingkatResponse) ProtoMessage() {}
+
+func (x *TingkatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TingkatResponse.ProtoReflect.Descriptor instead.
+func (*TingkatResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TingkatResponse) GetTingkat() *Tingkat {
+	if x != nil {
+		return x.Tingkat
+	}
+	return nil
+}
+
+type ListTingkatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tingkat       []*Tingkat             `protobuf:"bytes,1,rep,name=tingkat,proto3" json:"tingkat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTingkatResponse) Reset() {
+	*x = ListTingkatResponse{}
+	mi := &file_cbt_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTingkatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTingkatResponse) ProtoMessage() {}
+
+func (x *ListTingkatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTingkatResponse.ProtoReflect.Descriptor instead.
+func (*ListTingkatResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListTingkatResponse) GetTingkat() []*Tingkat {
+	if x != nil {
+		return x.Tingkat
+	}
+	return nil
+}
+
+// Image metadata for soal
+type SoalGambar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NamaFile      string                 `protobuf:"bytes,2,opt,name=nama_file,json=namaFile,proto3" json:"nama_file,omitempty"`
+	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileSize      int32                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Urutan        int32                  `protobuf:"varint,6,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,7,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	CloudId       string                 `protobuf:"bytes,8,opt,name=cloud_id,json=cloudId,proto3" json:"cloud_id,omitempty"`
+	PublicId      string                 `protobuf:"bytes,9,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalGambar) Reset() {
+	*x = SoalGambar{}
+	mi := &file_cbt_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalGambar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalGambar) ProtoMessage() {}
+
+func (x *SoalGambar) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalGambar.ProtoReflect.Descriptor instead.
+func (*SoalGambar) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SoalGambar) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalGambar) GetNamaFile() string {
+	if x != nil {
+		return x.NamaFile
+	}
+	return ""
+}
+
+func (x *SoalGambar) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *SoalGambar) GetFileSize() int32 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *SoalGambar) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *SoalGambar) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *SoalGambar) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+func (x *SoalGambar) GetCloudId() string {
+	if x != nil {
+		return x.CloudId
+	}
+	return ""
+}
+
+func (x *SoalGambar) GetPublicId() string {
+	if x != nil {
+		return x.PublicId
+	}
+	return ""
+}
+
+func (x *SoalGambar) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Full soal with answer (for admin/teacher only)
+type SoalFull struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Materi                    *Materi                `protobuf:"bytes,2,opt,name=materi,proto3" json:"materi,omitempty"`
+	Pertanyaan                string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	OpsiA                     string                 `protobuf:"bytes,4,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
+	OpsiB                     string                 `protobuf:"bytes,5,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
+	OpsiC                     string                 `protobuf:"bytes,6,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
+	OpsiD                     string                 `protobuf:"bytes,7,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
+	JawabanBenar              JawabanOption          `protobuf:"varint,8,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
+	Pembahasan                string                 `protobuf:"bytes,9,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	Gambar                    []*SoalGambar          `protobuf:"bytes,10,rep,name=gambar,proto3" json:"gambar,omitempty"`
+	LmsClassId                int64                  `protobuf:"varint,11,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	QuestionType              QuestionType           `protobuf:"varint,12,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
+	JawabanBenarComplex       []JawabanOption        `protobuf:"varint,13,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
+	Point                     float64                `protobuf:"fixed64,14,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan                    int32                  `protobuf:"varint,15,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	ShortAnswerBlanks         []*ShortAnswerBlank    `protobuf:"bytes,16,rep,name=short_answer_blanks,json=shortAnswerBlanks,proto3" json:"short_answer_blanks,omitempty"`
+	NumericAnswer             *NumericAnswerKey      `protobuf:"bytes,17,opt,name=numeric_answer,json=numericAnswer,proto3" json:"numeric_answer,omitempty"`
+	Opsi                      []*SoalOpsi            `protobuf:"bytes,18,rep,name=opsi,proto3" json:"opsi,omitempty"`
+	JawabanBenarLabel         string                 `protobuf:"bytes,19,opt,name=jawaban_benar_label,json=jawabanBenarLabel,proto3" json:"jawaban_benar_label,omitempty"`
+	JawabanBenarComplexLabels []string               `protobuf:"bytes,20,rep,name=jawaban_benar_complex_labels,json=jawabanBenarComplexLabels,proto3" json:"jawaban_benar_complex_labels,omitempty"`
+	HotspotAnswer             *HotspotAnswerKey      `protobuf:"bytes,21,opt,name=hotspot_answer,json=hotspotAnswer,proto3" json:"hotspot_answer,omitempty"`
+	GridAnswer                *GridAnswerKey         `protobuf:"bytes,22,opt,name=grid_answer,json=gridAnswer,proto3" json:"grid_answer,omitempty"`
+	Media                     []*SoalMedia           `protobuf:"bytes,23,rep,name=media,proto3" json:"media,omitempty"`
+	ContentFormat             ContentFormat          `protobuf:"varint,24,opt,name=content_format,json=contentFormat,proto3,enum=base.ContentFormat" json:"content_format,omitempty"`
+	// Sanitized HTML of pertanyaan and pembahasan; LaTeX is left in span.math for the client to typeset
+	PertanyaanHtml string `protobuf:"bytes,25,opt,name=pertanyaan_html,json=pertanyaanHtml,proto3" json:"pertanyaan_html,omitempty"`
+	PembahasanHtml string `protobuf:"bytes,26,opt,name=pembahasan_html,json=pembahasanHtml,proto3" json:"pembahasan_html,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SoalFull) Reset() {
+	*x = SoalFull{}
+	mi := &file_cbt_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalFull) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalFull) ProtoMessage() {}
+
+func (x *SoalFull) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalFull.ProtoReflect.Descriptor instead.
+func (*SoalFull) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SoalFull) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalFull) GetMateri() *Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+func (x *SoalFull) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *SoalFull) GetOpsiA() string {
+	if x != nil {
+		return x.OpsiA
+	}
+	return ""
+}
+
+func (x *SoalFull) GetOpsiB() string {
+	if x != nil {
+		return x.OpsiB
+	}
+	return ""
+}
+
+func (x *SoalFull) GetOpsiC() string {
+	if x != nil {
+		return x.OpsiC
+	}
+	return ""
+}
+
+func (x *SoalFull) GetOpsiD() string {
+	if x != nil {
+		return x.OpsiD
+	}
+	return ""
+}
+
+func (x *SoalFull) GetJawabanBenar() JawabanOption {
+	if x != nil {
+		return x.JawabanBenar
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *SoalFull) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *SoalFull) GetGambar() []*SoalGambar {
+	if x != nil {
+		return x.Gambar
+	}
+	return nil
+}
+
+func (x *SoalFull) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *SoalFull) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_INVALID
+}
+
+func (x *SoalFull) GetJawabanBenarComplex() []JawabanOption {
+	if x != nil {
+		return x.JawabanBenarComplex
+	}
+	return nil
+}
+
+func (x *SoalFull) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *SoalFull) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *SoalFull) GetShortAnswerBlanks() []*ShortAnswerBlank {
+	if x != nil {
+		return x.ShortAnswerBlanks
+	}
+	return nil
+}
+
+func (x *SoalFull) GetNumericAnswer() *NumericAnswerKey {
+	if x != nil {
+		return x.NumericAnswer
+	}
+	return nil
+}
+
+func (x *SoalFull) GetOpsi() []*SoalOpsi {
+	if x != nil {
+		return x.Opsi
+	}
+	return nil
+}
+
+func (x *SoalFull) GetJawabanBenarLabel() string {
+	if x != nil {
+		return x.JawabanBenarLabel
+	}
+	return ""
+}
+
+func (x *SoalFull) GetJawabanBenarComplexLabels() []string {
+	if x != nil {
+		return x.JawabanBenarComplexLabels
+	}
+	return nil
+}
+
+func (x *SoalFull) GetHotspotAnswer() *HotspotAnswerKey {
+	if x != nil {
+		return x.HotspotAnswer
+	}
+	return nil
+}
+
+func (x *SoalFull) GetGridAnswer() *GridAnswerKey {
+	if x != nil {
+		return x.GridAnswer
+	}
+	return nil
+}
+
+func (x *SoalFull) GetMedia() []*SoalMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *SoalFull) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+func (x *SoalFull) GetPertanyaanHtml() string {
+	if x != nil {
+		return x.PertanyaanHtml
+	}
+	return ""
+}
+
+func (x *SoalFull) GetPembahasanHtml() string {
+	if x != nil {
+		return x.PembahasanHtml
+	}
+	return ""
+}
+
+// Soal for student (no answer exposed)
+type SoalForStudent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"` // Question number in test
+	Pertanyaan     string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	OpsiA          string                 `protobuf:"bytes,4,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
+	OpsiB          string                 `protobuf:"bytes,5,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
+	OpsiC          string                 `protobuf:"bytes,6,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
+	OpsiD          string                 `protobuf:"bytes,7,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
+	JawabanDipilih JawabanOption          `protobuf:"varint,8,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"` // If already answered
+	IsAnswered     bool                   `protobuf:"varint,9,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
+	Materi         *Materi                `protobuf:"bytes,10,opt,name=materi,proto3" json:"materi,omitempty"`
+	Gambar         []*SoalGambar          `protobuf:"bytes,11,rep,name=gambar,proto3" json:"gambar,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SoalForStudent) Reset() {
+	*x = SoalForStudent{}
+	mi := &file_cbt_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalForStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalForStudent) ProtoMessage() {}
+
+func (x *SoalForStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalForStudent.ProtoReflect.Descriptor instead.
+func (*SoalForStudent) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SoalForStudent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalForStudent) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SoalForStudent) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *SoalForStudent) GetOpsiA() string {
+	if x != nil {
+		return x.OpsiA
+	}
+	return ""
+}
+
+func (x *SoalForStudent) GetOpsiB() string {
+	if x != nil {
+		return x.OpsiB
+	}
+	return ""
+}
+
+func (x *SoalForStudent) GetOpsiC() string {
+	if x != nil {
+		return x.OpsiC
+	}
+	return ""
+}
+
+func (x *SoalForStudent) GetOpsiD() string {
+	if x != nil {
+		return x.OpsiD
+	}
+	return ""
+}
+
+func (x *SoalForStudent) GetJawabanDipilih() JawabanOption {
+	if x != nil {
+		return x.JawabanDipilih
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *SoalForStudent) GetIsAnswered() bool {
+	if x != nil {
+		return x.IsAnswered
+	}
+	return false
+}
+
+func (x *SoalForStudent) GetMateri() *Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+func (x *SoalForStudent) GetGambar() []*SoalGambar {
+	if x != nil {
+		return x.Gambar
+	}
+	return nil
+}
+
+type CreateSoalRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri            int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	IdTingkat           int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Pertanyaan          string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	OpsiA               string                 `protobuf:"bytes,4,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
+	OpsiB               string                 `protobuf:"bytes,5,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
+	OpsiC               string                 `protobuf:"bytes,6,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
+	OpsiD               string                 `protobuf:"bytes,7,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
+	JawabanBenar        JawabanOption          `protobuf:"varint,8,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
+	Pembahasan          string                 `protobuf:"bytes,9,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	ImageBytes          [][]byte               `protobuf:"bytes,10,rep,name=image_bytes,json=imageBytes,proto3" json:"image_bytes,omitempty"`
+	LmsClassId          int64                  `protobuf:"varint,11,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope for the question
+	QuestionType        QuestionType           `protobuf:"varint,12,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
+	JawabanBenarComplex []JawabanOption        `protobuf:"varint,13,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
+	Point               float64                `protobuf:"fixed64,14,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan              int32                  `protobuf:"varint,15,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	ShortAnswerBlanks   []*ShortAnswerBlank    `protobuf:"bytes,16,rep,name=short_answer_blanks,json=shortAnswerBlanks,proto3" json:"short_answer_blanks,omitempty"`
+	NumericAnswer       *NumericAnswerKey      `protobuf:"bytes,17,opt,name=numeric_answer,json=numericAnswer,proto3" json:"numeric_answer,omitempty"`
+	// Option texts labelled A, B, C, ... in order; replaces opsi_a..opsi_d when set
+	Opsi []string `protobuf:"bytes,18,rep,name=opsi,proto3" json:"opsi,omitempty"`
+	// Take precedence over jawaban_benar / jawaban_benar_complex, needed for labels after E
+	JawabanBenarLabel         string            `protobuf:"bytes,19,opt,name=jawaban_benar_label,json=jawabanBenarLabel,proto3" json:"jawaban_benar_label,omitempty"`
+	JawabanBenarComplexLabels []string          `protobuf:"bytes,20,rep,name=jawaban_benar_complex_labels,json=jawabanBenarComplexLabels,proto3" json:"jawaban_benar_complex_labels,omitempty"`
+	HotspotAnswer             *HotspotAnswerKey `protobuf:"bytes,21,opt,name=hotspot_answer,json=hotspotAnswer,proto3" json:"hotspot_answer,omitempty"`
+	GridAnswer                *GridAnswerKey    `protobuf:"bytes,22,opt,name=grid_answer,json=gridAnswer,proto3" json:"grid_answer,omitempty"`
+	ContentFormat             ContentFormat     `protobuf:"varint,23,opt,name=content_format,json=contentFormat,proto3,enum=base.ContentFormat" json:"content_format,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CreateSoalRequest) Reset() {
+	*x = CreateSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSoalRequest) ProtoMessage() {}
+
+func (x *CreateSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateSoalRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *CreateSoalRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *CreateSoalRequest) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetOpsiA() string {
+	if x != nil {
+		return x.OpsiA
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetOpsiB() string {
+	if x != nil {
+		return x.OpsiB
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetOpsiC() string {
+	if x != nil {
+		return x.OpsiC
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetOpsiD() string {
+	if x != nil {
+		return x.OpsiD
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetJawabanBenar() JawabanOption {
+	if x != nil {
+		return x.JawabanBenar
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *CreateSoalRequest) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetImageBytes() [][]byte {
+	if x != nil {
+		return x.ImageBytes
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *CreateSoalRequest) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_INVALID
+}
+
+func (x *CreateSoalRequest) GetJawabanBenarComplex() []JawabanOption {
+	if x != nil {
+		return x.JawabanBenarComplex
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *CreateSoalRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *CreateSoalRequest) GetShortAnswerBlanks() []*ShortAnswerBlank {
+	if x != nil {
+		return x.ShortAnswerBlanks
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetNumericAnswer() *NumericAnswerKey {
+	if x != nil {
+		return x.NumericAnswer
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetOpsi() []string {
+	if x != nil {
+		return x.Opsi
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetJawabanBenarLabel() string {
+	if x != nil {
+		return x.JawabanBenarLabel
+	}
+	return ""
+}
+
+func (x *CreateSoalRequest) GetJawabanBenarComplexLabels() []string {
+	if x != nil {
+		return x.JawabanBenarComplexLabels
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetHotspotAnswer() *HotspotAnswerKey {
+	if x != nil {
+		return x.HotspotAnswer
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetGridAnswer() *GridAnswerKey {
+	if x != nil {
+		return x.GridAnswer
+	}
+	return nil
+}
+
+func (x *CreateSoalRequest) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+type GetSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSoalRequest) Reset() {
+	*x = GetSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSoalRequest) ProtoMessage() {}
+
+func (x *GetSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSoalRequest.ProtoReflect.Descriptor instead.
+func (*GetSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetSoalRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateSoalRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdMateri            int32                  `protobuf:"varint,2,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	IdTingkat           int32                  `protobuf:"varint,3,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Pertanyaan          string                 `protobuf:"bytes,4,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	OpsiA               string                 `protobuf:"bytes,5,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
+	OpsiB               string                 `protobuf:"bytes,6,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
+	OpsiC               string                 `protobuf:"bytes,7,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
+	OpsiD               string                 `protobuf:"bytes,8,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
+	JawabanBenar        JawabanOption          `protobuf:"varint,9,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
+	Pembahasan          string                 `protobuf:"bytes,10,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	ImageBytes          [][]byte               `protobuf:"bytes,11,rep,name=image_bytes,json=imageBytes,proto3" json:"image_bytes,omitempty"`
+	QuestionType        QuestionType           `protobuf:"varint,12,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
+	JawabanBenarComplex []JawabanOption        `protobuf:"varint,13,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
+	Point               float64                `protobuf:"fixed64,14,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan              int32                  `protobuf:"varint,15,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	ShortAnswerBlanks   []*ShortAnswerBlank    `protobuf:"bytes,16,rep,name=short_answer_blanks,json=shortAnswerBlanks,proto3" json:"short_answer_blanks,omitempty"`
+	NumericAnswer       *NumericAnswerKey      `protobuf:"bytes,17,opt,name=numeric_answer,json=numericAnswer,proto3" json:"numeric_answer,omitempty"`
+	// Option texts labelled A, B, C, ... in order; replaces opsi_a..opsi_d when set
+	Opsi []string `protobuf:"bytes,18,rep,name=opsi,proto3" json:"opsi,omitempty"`
+	// Take precedence over jawaban_benar / jawaban_benar_complex, needed for labels after E
+	JawabanBenarLabel         string            `protobuf:"bytes,19,opt,name=jawaban_benar_label,json=jawabanBenarLabel,proto3" json:"jawaban_benar_label,omitempty"`
+	JawabanBenarComplexLabels []string          `protobuf:"bytes,20,rep,name=jawaban_benar_complex_labels,json=jawabanBenarComplexLabels,proto3" json:"jawaban_benar_complex_labels,omitempty"`
+	HotspotAnswer             *HotspotAnswerKey `protobuf:"bytes,21,opt,name=hotspot_answer,json=hotspotAnswer,proto3" json:"hotspot_answer,omitempty"`
+	GridAnswer                *GridAnswerKey    `protobuf:"bytes,22,opt,name=grid_answer,json=gridAnswer,proto3" json:"grid_answer,omitempty"`
+	ContentFormat             ContentFormat     `protobuf:"varint,23,opt,name=content_format,json=contentFormat,proto3,enum=base.ContentFormat" json:"content_format,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *UpdateSoalRequest) Reset() {
+	*x = UpdateSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSoalRequest) ProtoMessage() {}
+
+func (x *UpdateSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateSoalRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSoalRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *UpdateSoalRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *UpdateSoalRequest) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetOpsiA() string {
+	if x != nil {
+		return x.OpsiA
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetOpsiB() string {
+	if x != nil {
+		return x.OpsiB
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetOpsiC() string {
+	if x != nil {
+		return x.OpsiC
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetOpsiD() string {
+	if x != nil {
+		return x.OpsiD
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetJawabanBenar() JawabanOption {
+	if x != nil {
+		return x.JawabanBenar
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *UpdateSoalRequest) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetImageBytes() [][]byte {
+	if x != nil {
+		return x.ImageBytes
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_INVALID
+}
+
+func (x *UpdateSoalRequest) GetJawabanBenarComplex() []JawabanOption {
+	if x != nil {
+		return x.JawabanBenarComplex
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *UpdateSoalRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *UpdateSoalRequest) GetShortAnswerBlanks() []*ShortAnswerBlank {
+	if x != nil {
+		return x.ShortAnswerBlanks
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetNumericAnswer() *NumericAnswerKey {
+	if x != nil {
+		return x.NumericAnswer
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetOpsi() []string {
+	if x != nil {
+		return x.Opsi
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetJawabanBenarLabel() string {
+	if x != nil {
+		return x.JawabanBenarLabel
+	}
+	return ""
+}
+
+func (x *UpdateSoalRequest) GetJawabanBenarComplexLabels() []string {
+	if x != nil {
+		return x.JawabanBenarComplexLabels
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetHotspotAnswer() *HotspotAnswerKey {
+	if x != nil {
+		return x.HotspotAnswer
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetGridAnswer() *GridAnswerKey {
+	if x != nil {
+		return x.GridAnswer
+	}
+	return nil
+}
+
+func (x *UpdateSoalRequest) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+type SoalOrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalOrderItem) Reset() {
+	*x = SoalOrderItem{}
+	mi := &file_cbt_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalOrderItem) ProtoMessage() {}
+
+func (x *SoalOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalOrderItem.ProtoReflect.Descriptor instead.
+func (*SoalOrderItem) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SoalOrderItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalOrderItem) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+type ReorderSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	Items         []*SoalOrderItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSoalRequest) Reset() {
+	*x = ReorderSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSoalRequest) ProtoMessage() {}
+
+func (x *ReorderSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSoalRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReorderSoalRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *ReorderSoalRequest) GetItems() []*SoalOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSoalRequest) Reset() {
+	*x = DeleteSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSoalRequest) ProtoMessage() {}
+
+func (x *DeleteSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteSoalRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Soal          *SoalFull              `protobuf:"bytes,1,opt,name=soal,proto3" json:"soal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalResponse) Reset() {
+	*x = SoalResponse{}
+	mi := &file_cbt_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalResponse) ProtoMessage() {}
+
+func (x *SoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalResponse.ProtoReflect.Descriptor instead.
+func (*SoalResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SoalResponse) GetSoal() *SoalFull {
+	if x != nil {
+		return x.Soal
+	}
+	return nil
+}
+
+type ListSoalRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri        int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	IdTingkat       int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	IdMataPelajaran int32                  `protobuf:"varint,3,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LmsClassId      int64                  `protobuf:"varint,5,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Filter by class
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSoalRequest) Reset() {
+	*x = ListSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoalRequest) ProtoMessage() {}
+
+func (x *ListSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoalRequest.ProtoReflect.Descriptor instead.
+func (*ListSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListSoalRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *ListSoalRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *ListSoalRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *ListSoalRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSoalRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+type ListSoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Soal          []*SoalFull            `protobuf:"bytes,1,rep,name=soal,proto3" json:"soal,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoalResponse) Reset() {
+	*x = ListSoalResponse{}
+	mi := &file_cbt_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoalResponse) ProtoMessage() {}
+
+func (x *ListSoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoalResponse.ProtoReflect.Descriptor instead.
+func (*ListSoalResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListSoalResponse) GetSoal() []*SoalFull {
+	if x != nil {
+		return x.Soal
+	}
+	return nil
+}
+
+func (x *ListSoalResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UploadImageToSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	ImageBytes    []byte                 `protobuf:"bytes,2,opt,name=image_bytes,json=imageBytes,proto3" json:"image_bytes,omitempty"`
+	NamaFile      string                 `protobuf:"bytes,3,opt,name=nama_file,json=namaFile,proto3" json:"nama_file,omitempty"`
+	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,5,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageToSoalRequest) Reset() {
+	*x = UploadImageToSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageToSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageToSoalRequest) ProtoMessage() {}
+
+func (x *UploadImageToSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageToSoalRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageToSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UploadImageToSoalRequest) GetIdSoal() int32 {
+	if x != nil {
+		return x.IdSoal
+	}
+	return 0
+}
+
+func (x *UploadImageToSoalRequest) GetImageBytes() []byte {
+	if x != nil {
+		return x.ImageBytes
+	}
+	return nil
+}
+
+func (x *UploadImageToSoalRequest) GetNamaFile() string {
+	if x != nil {
+		return x.NamaFile
+	}
+	return ""
+}
+
+func (x *UploadImageToSoalRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *UploadImageToSoalRequest) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gambar        *SoalGambar            `protobuf:"bytes,1,opt,name=gambar,proto3" json:"gambar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	mi := &file_cbt_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UploadImageResponse) GetGambar() *SoalGambar {
+	if x != nil {
+		return x.Gambar
+	}
+	return nil
+}
+
+type DeleteImageFromSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdGambar      int32                  `protobuf:"varint,1,opt,name=id_gambar,json=idGambar,proto3" json:"id_gambar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImageFromSoalRequest) Reset() {
+	*x = DeleteImageFromSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImageFromSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImageFromSoalRequest) ProtoMessage() {}
+
+func (x *DeleteImageFromSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImageFromSoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImageFromSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteImageFromSoalRequest) GetIdGambar() int32 {
+	if x != nil {
+		return x.IdGambar
+	}
+	return 0
+}
+
+type UpdateImageInSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdGambar      int32                  `protobuf:"varint,1,opt,name=id_gambar,json=idGambar,proto3" json:"id_gambar,omitempty"`
+	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,3,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateImageInSoalRequest) Reset() {
+	*x = UpdateImageInSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateImageInSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateImageInSoalRequest) ProtoMessage() {}
+
+func (x *UpdateImageInSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateImageInSoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateImageInSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateImageInSoalRequest) GetIdGambar() int32 {
+	if x != nil {
+		return x.IdGambar
+	}
+	return 0
+}
+
+func (x *UpdateImageInSoalRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *UpdateImageInSoalRequest) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+// Audio or video clip metadata for soal (for admin/teacher only)
+type SoalMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Jenis         MediaJenis             `protobuf:"varint,2,opt,name=jenis,proto3,enum=base.MediaJenis" json:"jenis,omitempty"`
+	NamaFile      string                 `protobuf:"bytes,3,opt,name=nama_file,json=namaFile,proto3" json:"nama_file,omitempty"`
+	FilePath      string                 `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileSize      int32                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	MimeType      string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	DurasiDetik   float64                `protobuf:"fixed64,7,opt,name=durasi_detik,json=durasiDetik,proto3" json:"durasi_detik,omitempty"`
+	MaxPutar      int32                  `protobuf:"varint,8,opt,name=max_putar,json=maxPutar,proto3" json:"max_putar,omitempty"` // Plays allowed per session, 0 = unlimited
+	Urutan        int32                  `protobuf:"varint,9,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,10,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	CloudId       string                 `protobuf:"bytes,11,opt,name=cloud_id,json=cloudId,proto3" json:"cloud_id,omitempty"`
+	PublicId      string                 `protobuf:"bytes,12,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalMedia) Reset() {
+	*x = SoalMedia{}
+	mi := &file_cbt_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalMedia) ProtoMessage() {}
+
+func (x *SoalMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalMedia.ProtoReflect.Descriptor instead.
+func (*SoalMedia) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SoalMedia) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalMedia) GetJenis() MediaJenis {
+	if x != nil {
+		return x.Jenis
+	}
+	return MediaJenis_MEDIA_JENIS_INVALID
+}
+
+func (x *SoalMedia) GetNamaFile() string {
+	if x != nil {
+		return x.NamaFile
+	}
+	return ""
+}
+
+func (x *SoalMedia) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *SoalMedia) GetFileSize() int32 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *SoalMedia) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *SoalMedia) GetDurasiDetik() float64 {
+	if x != nil {
+		return x.DurasiDetik
+	}
+	return 0
+}
+
+func (x *SoalMedia) GetMaxPutar() int32 {
+	if x != nil {
+		return x.MaxPutar
+	}
+	return 0
+}
+
+func (x *SoalMedia) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *SoalMedia) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+func (x *SoalMedia) GetCloudId() string {
+	if x != nil {
+		return x.CloudId
+	}
+	return ""
+}
+
+func (x *SoalMedia) GetPublicId() string {
+	if x != nil {
+		return x.PublicId
+	}
+	return ""
+}
+
+func (x *SoalMedia) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type UploadMediaToSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	MediaBytes    []byte                 `protobuf:"bytes,2,opt,name=media_bytes,json=mediaBytes,proto3" json:"media_bytes,omitempty"`
+	NamaFile      string                 `protobuf:"bytes,3,opt,name=nama_file,json=namaFile,proto3" json:"nama_file,omitempty"`
+	Jenis         MediaJenis             `protobuf:"varint,4,opt,name=jenis,proto3,enum=base.MediaJenis" json:"jenis,omitempty"`
+	DurasiDetik   float64                `protobuf:"fixed64,5,opt,name=durasi_detik,json=durasiDetik,proto3" json:"durasi_detik,omitempty"` // Used when the duration cannot be measured on upload
+	MaxPutar      int32                  `protobuf:"varint,6,opt,name=max_putar,json=maxPutar,proto3" json:"max_putar,omitempty"`
+	Urutan        int32                  `protobuf:"varint,7,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,8,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaToSoalRequest) Reset() {
+	*x = UploadMediaToSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaToSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaToSoalRequest) ProtoMessage() {}
+
+func (x *UploadMediaToSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaToSoalRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaToSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UploadMediaToSoalRequest) GetIdSoal() int32 {
+	if x != nil {
+		return x.IdSoal
+	}
+	return 0
+}
+
+func (x *UploadMediaToSoalRequest) GetMediaBytes() []byte {
+	if x != nil {
+		return x.MediaBytes
+	}
+	return nil
+}
+
+func (x *UploadMediaToSoalRequest) GetNamaFile() string {
+	if x != nil {
+		return x.NamaFile
+	}
+	return ""
+}
+
+func (x *UploadMediaToSoalRequest) GetJenis() MediaJenis {
+	if x != nil {
+		return x.Jenis
+	}
+	return MediaJenis_MEDIA_JENIS_INVALID
+}
+
+func (x *UploadMediaToSoalRequest) GetDurasiDetik() float64 {
+	if x != nil {
+		return x.DurasiDetik
+	}
+	return 0
+}
+
+func (x *UploadMediaToSoalRequest) GetMaxPutar() int32 {
+	if x != nil {
+		return x.MaxPutar
+	}
+	return 0
+}
+
+func (x *UploadMediaToSoalRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *UploadMediaToSoalRequest) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+type UploadMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Media         *SoalMedia             `protobuf:"bytes,1,opt,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaResponse) Reset() {
+	*x = UploadMediaResponse{}
+	mi := &file_cbt_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaResponse) ProtoMessage() {}
+
+func (x *UploadMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaResponse.ProtoReflect.Descriptor instead.
+func (*UploadMediaResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UploadMediaResponse) GetMedia() *SoalMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type DeleteMediaFromSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMedia       int32                  `protobuf:"varint,1,opt,name=id_media,json=idMedia,proto3" json:"id_media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMediaFromSoalRequest) Reset() {
+	*x = DeleteMediaFromSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMediaFromSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMediaFromSoalRequest) ProtoMessage() {}
+
+func (x *DeleteMediaFromSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMediaFromSoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMediaFromSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteMediaFromSoalRequest) GetIdMedia() int32 {
+	if x != nil {
+		return x.IdMedia
+	}
+	return 0
+}
+
+type UpdateMediaInSoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMedia       int32                  `protobuf:"varint,1,opt,name=id_media,json=idMedia,proto3" json:"id_media,omitempty"`
+	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	MaxPutar      int32                  `protobuf:"varint,3,opt,name=max_putar,json=maxPutar,proto3" json:"max_putar,omitempty"`
+	DurasiDetik   float64                `protobuf:"fixed64,4,opt,name=durasi_detik,json=durasiDetik,proto3" json:"durasi_detik,omitempty"` // 0 keeps the stored duration
+	Keterangan    string                 `protobuf:"bytes,5,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMediaInSoalRequest) Reset() {
+	*x = UpdateMediaInSoalRequest{}
+	mi := &file_cbt_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMediaInSoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMediaInSoalRequest) ProtoMessage() {}
+
+func (x *UpdateMediaInSoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMediaInSoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMediaInSoalRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateMediaInSoalRequest) GetIdMedia() int32 {
+	if x != nil {
+		return x.IdMedia
+	}
+	return 0
+}
+
+func (x *UpdateMediaInSoalRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+func (x *UpdateMediaInSoalRequest) GetMaxPutar() int32 {
+	if x != nil {
+		return x.MaxPutar
+	}
+	return 0
+}
+
+func (x *UpdateMediaInSoalRequest) GetDurasiDetik() float64 {
+	if x != nil {
+		return x.DurasiDetik
+	}
+	return 0
+}
+
+func (x *UpdateMediaInSoalRequest) GetKeterangan() string {
+	if x != nil {
+		return x.Keterangan
+	}
+	return ""
+}
+
+// Draggable item
+type DragItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DragItem) Reset() {
+	*x = DragItem{}
+	mi := &file_cbt_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DragItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DragItem) ProtoMessage() {}
+
+func (x *DragItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DragItem.ProtoReflect.Descriptor instead.
+func (*DragItem) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DragItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DragItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DragItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *DragItem) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+// Drop zone/slot
+type DragSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DragSlot) Reset() {
+	*x = DragSlot{}
+	mi := &file_cbt_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DragSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DragSlot) ProtoMessage() {}
+
+func (x *DragSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DragSlot.ProtoReflect.Descriptor instead.
+func (*DragSlot) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DragSlot) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DragSlot) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DragSlot) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *DragSlot) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+// Correct answer mapping (item -> slot)
+type DragCorrectAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	SlotId        int32                  `protobuf:"varint,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DragCorrectAnswer) Reset() {
+	*x = DragCorrectAnswer{}
+	mi := &file_cbt_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DragCorrectAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DragCorrectAnswer) ProtoMessage() {}
+
+func (x *DragCorrectAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DragCorrectAnswer.ProtoReflect.Descriptor instead.
+func (*DragCorrectAnswer) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DragCorrectAnswer) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *DragCorrectAnswer) GetSlotId() int32 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+// Correct answer by urutan (for create/update requests)
+type DragCorrectAnswerByUrutan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemUrutan    int32                  `protobuf:"varint,1,opt,name=item_urutan,json=itemUrutan,proto3" json:"item_urutan,omitempty"`
+	SlotUrutan    int32                  `protobuf:"varint,2,opt,name=slot_urutan,json=slotUrutan,proto3" json:"slot_urutan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DragCorrectAnswerByUrutan) Reset() {
+	*x = DragCorrectAnswerByUrutan{}
+	mi := &file_cbt_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DragCorrectAnswerByUrutan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DragCorrectAnswerByUrutan) ProtoMessage() {}
+
+func (x *DragCorrectAnswerByUrutan) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DragCorrectAnswerByUrutan.ProtoReflect.Descriptor instead.
+func (*DragCorrectAnswerByUrutan) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DragCorrectAnswerByUrutan) GetItemUrutan() int32 {
+	if x != nil {
+		return x.ItemUrutan
+	}
+	return 0
+}
+
+func (x *DragCorrectAnswerByUrutan) GetSlotUrutan() int32 {
+	if x != nil {
+		return x.SlotUrutan
+	}
+	return 0
+}
+
+// Full drag-drop question (admin view with answers)
+type SoalDragDropFull struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Materi         *Materi                `protobuf:"bytes,2,opt,name=materi,proto3" json:"materi,omitempty"`
+	Pertanyaan     string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	DragType       DragDropType           `protobuf:"varint,4,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
+	Items          []*DragItem            `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Slots          []*DragSlot            `protobuf:"bytes,6,rep,name=slots,proto3" json:"slots,omitempty"`
+	CorrectAnswers []*DragCorrectAnswer   `protobuf:"bytes,7,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Pembahasan     string                 `protobuf:"bytes,8,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	IsActive       bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LmsClassId     int64                  `protobuf:"varint,12,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	Point          float64                `protobuf:"fixed64,13,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan         int32                  `protobuf:"varint,14,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SoalDragDropFull) Reset() {
+	*x = SoalDragDropFull{}
+	mi := &file_cbt_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalDragDropFull) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalDragDropFull) ProtoMessage() {}
+
+func (x *SoalDragDropFull) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalDragDropFull.ProtoReflect.Descriptor instead.
+func (*SoalDragDropFull) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SoalDragDropFull) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalDragDropFull) GetMateri() *Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+func (x *SoalDragDropFull) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *SoalDragDropFull) GetDragType() DragDropType {
+	if x != nil {
+		return x.DragType
+	}
+	return DragDropType_DRAG_TYPE_INVALID
+}
+
+func (x *SoalDragDropFull) GetItems() []*DragItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SoalDragDropFull) GetSlots() []*DragSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SoalDragDropFull) GetCorrectAnswers() []*DragCorrectAnswer {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+func (x *SoalDragDropFull) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *SoalDragDropFull) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SoalDragDropFull) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SoalDragDropFull) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SoalDragDropFull) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *SoalDragDropFull) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *SoalDragDropFull) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+// Drag-drop for student (no correct answers exposed)
+type SoalDragDropForStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Pertanyaan    string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	DragType      DragDropType           `protobuf:"varint,4,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
+	Items         []*DragItem            `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Slots         []*DragSlot            `protobuf:"bytes,6,rep,name=slots,proto3" json:"slots,omitempty"`
+	Materi        *Materi                `protobuf:"bytes,7,opt,name=materi,proto3" json:"materi,omitempty"`
+	UserAnswer    map[int32]int32        `protobuf:"bytes,8,rep,name=user_answer,json=userAnswer,proto3" json:"user_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // item_id -> slot_id
+	IsAnswered    bool                   `protobuf:"varint,9,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalDragDropForStudent) Reset() {
+	*x = SoalDragDropForStudent{}
+	mi := &file_cbt_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalDragDropForStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalDragDropForStudent) ProtoMessage() {}
+
+func (x *SoalDragDropForStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalDragDropForStudent.ProtoReflect.Descriptor instead.
+func (*SoalDragDropForStudent) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SoalDragDropForStudent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalDragDropForStudent) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SoalDragDropForStudent) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *SoalDragDropForStudent) GetDragType() DragDropType {
+	if x != nil {
+		return x.DragType
+	}
+	return DragDropType_DRAG_TYPE_INVALID
+}
+
+func (x *SoalDragDropForStudent) GetItems() []*DragItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SoalDragDropForStudent) GetSlots() []*DragSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SoalDragDropForStudent) GetMateri() *Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+func (x *SoalDragDropForStudent) GetUserAnswer() map[int32]int32 {
+	if x != nil {
+		return x.UserAnswer
+	}
+	return nil
+}
+
+func (x *SoalDragDropForStudent) GetIsAnswered() bool {
+	if x != nil {
+		return x.IsAnswered
+	}
+	return false
+}
+
+// Unified question for mixed test sessions
+type QuestionForStudent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	NomorUrut    int32                  `protobuf:"varint,1,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	QuestionType QuestionType           `protobuf:"varint,2,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
+	Materi       *Materi                `protobuf:"bytes,3,opt,name=materi,proto3" json:"materi,omitempty"`
+	IsAnswered   bool                   `protobuf:"varint,4,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
+	// Multiple choice fields (only populated when question_type = MULTIPLE_CHOICE)
+	McId             int32         `protobuf:"varint,5,opt,name=mc_id,json=mcId,proto3" json:"mc_id,omitempty"`
+	McPertanyaan     string        `protobuf:"bytes,6,opt,name=mc_pertanyaan,json=mcPertanyaan,proto3" json:"mc_pertanyaan,omitempty"`
+	McOpsiA          string        `protobuf:"bytes,7,opt,name=mc_opsi_a,json=mcOpsiA,proto3" json:"mc_opsi_a,omitempty"`
+	McOpsiB          string        `protobuf:"bytes,8,opt,name=mc_opsi_b,json=mcOpsiB,proto3" json:"mc_opsi_b,omitempty"`
+	McOpsiC          string        `protobuf:"bytes,9,opt,name=mc_opsi_c,json=mcOpsiC,proto3" json:"mc_opsi_c,omitempty"`
+	McOpsiD          string        `protobuf:"bytes,10,opt,name=mc_opsi_d,json=mcOpsiD,proto3" json:"mc_opsi_d,omitempty"`
+	McJawabanDipilih JawabanOption `protobuf:"varint,11,opt,name=mc_jawaban_dipilih,json=mcJawabanDipilih,proto3,enum=base.JawabanOption" json:"mc_jawaban_dipilih,omitempty"`
+	McGambar         []*SoalGambar `protobuf:"bytes,12,rep,name=mc_gambar,json=mcGambar,proto3" json:"mc_gambar,omitempty"`
+	// Drag-drop fields (only populated when question_type = DRAG_DROP)
+	DdId         int32           `protobuf:"varint,13,opt,name=dd_id,json=ddId,proto3" json:"dd_id,omitempty"`
+	DdPertanyaan string          `protobuf:"bytes,14,opt,name=dd_pertanyaan,json=ddPertanyaan,proto3" json:"dd_pertanyaan,omitempty"`
+	DdDragType   DragDropType    `protobuf:"varint,15,opt,name=dd_drag_type,json=ddDragType,proto3,enum=base.DragDropType" json:"dd_drag_type,omitempty"`
+	DdItems      []*DragItem     `protobuf:"bytes,16,rep,name=dd_items,json=ddItems,proto3" json:"dd_items,omitempty"`
+	DdSlots      []*DragSlot     `protobuf:"bytes,17,rep,name=dd_slots,json=ddSlots,proto3" json:"dd_slots,omitempty"`
+	DdUserAnswer map[int32]int32 `protobuf:"bytes,18,rep,name=dd_user_answer,json=ddUserAnswer,proto3" json:"dd_user_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // item_id -> slot_id
+	// Essay fields (only populated when question_type = ESSAY)
+	EssayId         int32  `protobuf:"varint,19,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
+	EssayPertanyaan string `protobuf:"bytes,20,opt,name=essay_pertanyaan,json=essayPertanyaan,proto3" json:"essay_pertanyaan,omitempty"`
+	EssayJawaban    string `protobuf:"bytes,21,opt,name=essay_jawaban,json=essayJawaban,proto3" json:"essay_jawaban,omitempty"`
+	// Multiple choices complex fields (only populated when question_type = MULTIPLE_CHOICES_COMPLEX)
+	MccId             int32           `protobuf:"varint,22,opt,name=mcc_id,json=mccId,proto3" json:"mcc_id,omitempty"`
+	MccPertanyaan     string          `protobuf:"bytes,23,opt,name=mcc_pertanyaan,json=mccPertanyaan,proto3" json:"mcc_pertanyaan,omitempty"`
+	MccOpsiA          string          `protobuf:"bytes,24,opt,name=mcc_opsi_a,json=mccOpsiA,proto3" json:"mcc_opsi_a,omitempty"`
+	MccOpsiB          string          `protobuf:"bytes,25,opt,name=mcc_opsi_b,json=mccOpsiB,proto3" json:"mcc_opsi_b,omitempty"`
+	MccOpsiC          string          `protobuf:"bytes,26,opt,name=mcc_opsi_c,json=mccOpsiC,proto3" json:"mcc_opsi_c,omitempty"`
+	MccOpsiD          string          `protobuf:"bytes,27,opt,name=mcc_opsi_d,json=mccOpsiD,proto3" json:"mcc_opsi_d,omitempty"`
+	MccJawabanDipilih []JawabanOption `protobuf:"varint,28,rep,packed,name=mcc_jawaban_dipilih,json=mccJawabanDipilih,proto3,enum=base.JawabanOption" json:"mcc_jawaban_dipilih,omitempty"`
+	MccGambar         []*SoalGambar   `protobuf:"bytes,29,rep,name=mcc_gambar,json=mccGambar,proto3" json:"mcc_gambar,omitempty"`
+	// Short answer / cloze fields (only populated when question_type = SHORT_ANSWER)
+	SaId         int32         `protobuf:"varint,30,opt,name=sa_id,json=saId,proto3" json:"sa_id,omitempty"`
+	SaPertanyaan string        `protobuf:"bytes,31,opt,name=sa_pertanyaan,json=saPertanyaan,proto3" json:"sa_pertanyaan,omitempty"` // Cloze blanks are marked {{1}}, {{2}}, ...
+	SaBlankCount int32         `protobuf:"varint,32,opt,name=sa_blank_count,json=saBlankCount,proto3" json:"sa_blank_count,omitempty"`
+	SaJawaban    []string      `protobuf:"bytes,33,rep,name=sa_jawaban,json=saJawaban,proto3" json:"sa_jawaban,omitempty"`
+	SaGambar     []*SoalGambar `protobuf:"bytes,34,rep,name=sa_gambar,json=saGambar,proto3" json:"sa_gambar,omitempty"`
+	// Numeric fields (only populated when question_type = NUMERIC)
+	NumId         int32         `protobuf:"varint,35,opt,name=num_id,json=numId,proto3" json:"num_id,omitempty"`
+	NumPertanyaan string        `protobuf:"bytes,36,opt,name=num_pertanyaan,json=numPertanyaan,proto3" json:"num_pertanyaan,omitempty"`
+	NumJawaban    string        `protobuf:"bytes,37,opt,name=num_jawaban,json=numJawaban,proto3" json:"num_jawaban,omitempty"`
+	NumGambar     []*SoalGambar `protobuf:"bytes,38,rep,name=num_gambar,json=numGambar,proto3" json:"num_gambar,omitempty"`
+	// Every option of a choice question, also when it has more or fewer than four
+	McOpsi                  []*SoalOpsi `protobuf:"bytes,39,rep,name=mc_opsi,json=mcOpsi,proto3" json:"mc_opsi,omitempty"`
+	McJawabanDipilihLabel   string      `protobuf:"bytes,40,opt,name=mc_jawaban_dipilih_label,json=mcJawabanDipilihLabel,proto3" json:"mc_jawaban_dipilih_label,omitempty"`
+	MccOpsi                 []*SoalOpsi `protobuf:"bytes,41,rep,name=mcc_opsi,json=mccOpsi,proto3" json:"mcc_opsi,omitempty"`
+	MccJawabanDipilihLabels []string    `protobuf:"bytes,42,rep,name=mcc_jawaban_dipilih_labels,json=mccJawabanDipilihLabels,proto3" json:"mcc_jawaban_dipilih_labels,omitempty"`
+	// Hotspot fields (only populated when question_type = HOTSPOT)
+	HsId          int32           `protobuf:"varint,43,opt,name=hs_id,json=hsId,proto3" json:"hs_id,omitempty"`
+	HsPertanyaan  string          `protobuf:"bytes,44,opt,name=hs_pertanyaan,json=hsPertanyaan,proto3" json:"hs_pertanyaan,omitempty"`
+	HsGambar      []*SoalGambar   `protobuf:"bytes,45,rep,name=hs_gambar,json=hsGambar,proto3" json:"hs_gambar,omitempty"`
+	HsImageUrutan int32           `protobuf:"varint,46,opt,name=hs_image_urutan,json=hsImageUrutan,proto3" json:"hs_image_urutan,omitempty"` // Urutan of the gambar to place points on
+	HsMaxPoints   int32           `protobuf:"varint,47,opt,name=hs_max_points,json=hsMaxPoints,proto3" json:"hs_max_points,omitempty"`
+	HsJawaban     []*HotspotPoint `protobuf:"bytes,48,rep,name=hs_jawaban,json=hsJawaban,proto3" json:"hs_jawaban,omitempty"`
+	// Grid fields (only populated when question_type = GRID)
+	GridId         int32         `protobuf:"varint,49,opt,name=grid_id,json=gridId,proto3" json:"grid_id,omitempty"`
+	GridPertanyaan string        `protobuf:"bytes,50,opt,name=grid_pertanyaan,json=gridPertanyaan,proto3" json:"grid_pertanyaan,omitempty"`
+	GridRows       []string      `protobuf:"bytes,51,rep,name=grid_rows,json=gridRows,proto3" json:"grid_rows,omitempty"`
+	GridColumns    []string      `protobuf:"bytes,52,rep,name=grid_columns,json=gridColumns,proto3" json:"grid_columns,omitempty"`
+	GridJawaban    []int32       `protobuf:"varint,53,rep,packed,name=grid_jawaban,json=gridJawaban,proto3" json:"grid_jawaban,omitempty"` // Column index per row, -1 = not answered
+	GridGambar     []*SoalGambar `protobuf:"bytes,54,rep,name=grid_gambar,json=gridGambar,proto3" json:"grid_gambar,omitempty"`
+	// Audio and video clips, for every question type except DRAG_DROP
+	Media []*QuestionMedia `protobuf:"bytes,55,rep,name=media,proto3" json:"media,omitempty"`
+	// Sanitized HTML of the question's pertanyaan, option HTML is in mc_opsi / mcc_opsi
+	ContentFormat  ContentFormat `protobuf:"varint,56,opt,name=content_format,json=contentFormat,proto3,enum=base.ContentFormat" json:"content_format,omitempty"`
+	PertanyaanHtml string        `protobuf:"bytes,57,opt,name=pertanyaan_html,json=pertanyaanHtml,proto3" json:"pertanyaan_html,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuestionForStudent) Reset() {
+	*x = QuestionForStudent{}
+	mi := &file_cbt_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionForStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionForStudent) ProtoMessage() {}
+
+func (x *QuestionForStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionForStudent.ProtoReflect.Descriptor instead.
+func (*QuestionForStudent) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *QuestionForStudent) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_INVALID
+}
+
+func (x *QuestionForStudent) GetMateri() *Materi {
+	if x != nil {
+		return x.Materi
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetIsAnswered() bool {
+	if x != nil {
+		return x.IsAnswered
+	}
+	return false
+}
+
+func (x *QuestionForStudent) GetMcId() int32 {
+	if x != nil {
+		return x.McId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetMcPertanyaan() string {
+	if x != nil {
+		return x.McPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMcOpsiA() string {
+	if x != nil {
+		return x.McOpsiA
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMcOpsiB() string {
+	if x != nil {
+		return x.McOpsiB
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMcOpsiC() string {
+	if x != nil {
+		return x.McOpsiC
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMcOpsiD() string {
+	if x != nil {
+		return x.McOpsiD
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMcJawabanDipilih() JawabanOption {
+	if x != nil {
+		return x.McJawabanDipilih
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *QuestionForStudent) GetMcGambar() []*SoalGambar {
+	if x != nil {
+		return x.McGambar
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetDdId() int32 {
+	if x != nil {
+		return x.DdId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetDdPertanyaan() string {
+	if x != nil {
+		return x.DdPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetDdDragType() DragDropType {
+	if x != nil {
+		return x.DdDragType
+	}
+	return DragDropType_DRAG_TYPE_INVALID
+}
+
+func (x *QuestionForStudent) GetDdItems() []*DragItem {
+	if x != nil {
+		return x.DdItems
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetDdSlots() []*DragSlot {
+	if x != nil {
+		return x.DdSlots
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetDdUserAnswer() map[int32]int32 {
+	if x != nil {
+		return x.DdUserAnswer
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetEssayId() int32 {
+	if x != nil {
+		return x.EssayId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetEssayPertanyaan() string {
+	if x != nil {
+		return x.EssayPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetEssayJawaban() string {
+	if x != nil {
+		return x.EssayJawaban
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccId() int32 {
+	if x != nil {
+		return x.MccId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetMccPertanyaan() string {
+	if x != nil {
+		return x.MccPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccOpsiA() string {
+	if x != nil {
+		return x.MccOpsiA
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccOpsiB() string {
+	if x != nil {
+		return x.MccOpsiB
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccOpsiC() string {
+	if x != nil {
+		return x.MccOpsiC
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccOpsiD() string {
+	if x != nil {
+		return x.MccOpsiD
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccJawabanDipilih() []JawabanOption {
+	if x != nil {
+		return x.MccJawabanDipilih
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetMccGambar() []*SoalGambar {
+	if x != nil {
+		return x.MccGambar
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetSaId() int32 {
+	if x != nil {
+		return x.SaId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetSaPertanyaan() string {
+	if x != nil {
+		return x.SaPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetSaBlankCount() int32 {
+	if x != nil {
+		return x.SaBlankCount
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetSaJawaban() []string {
+	if x != nil {
+		return x.SaJawaban
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetSaGambar() []*SoalGambar {
+	if x != nil {
+		return x.SaGambar
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetNumId() int32 {
+	if x != nil {
+		return x.NumId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetNumPertanyaan() string {
+	if x != nil {
+		return x.NumPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetNumJawaban() string {
+	if x != nil {
+		return x.NumJawaban
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetNumGambar() []*SoalGambar {
+	if x != nil {
+		return x.NumGambar
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetMcOpsi() []*SoalOpsi {
+	if x != nil {
+		return x.McOpsi
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetMcJawabanDipilihLabel() string {
+	if x != nil {
+		return x.McJawabanDipilihLabel
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetMccOpsi() []*SoalOpsi {
+	if x != nil {
+		return x.MccOpsi
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetMccJawabanDipilihLabels() []string {
+	if x != nil {
+		return x.MccJawabanDipilihLabels
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetHsId() int32 {
+	if x != nil {
+		return x.HsId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetHsPertanyaan() string {
+	if x != nil {
+		return x.HsPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetHsGambar() []*SoalGambar {
+	if x != nil {
+		return x.HsGambar
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetHsImageUrutan() int32 {
+	if x != nil {
+		return x.HsImageUrutan
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetHsMaxPoints() int32 {
+	if x != nil {
+		return x.HsMaxPoints
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetHsJawaban() []*HotspotPoint {
+	if x != nil {
+		return x.HsJawaban
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetGridId() int32 {
+	if x != nil {
+		return x.GridId
+	}
+	return 0
+}
+
+func (x *QuestionForStudent) GetGridPertanyaan() string {
+	if x != nil {
+		return x.GridPertanyaan
+	}
+	return ""
+}
+
+func (x *QuestionForStudent) GetGridRows() []string {
+	if x != nil {
+		return x.GridRows
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetGridColumns() []string {
+	if x != nil {
+		return x.GridColumns
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetGridJawaban() []int32 {
+	if x != nil {
+		return x.GridJawaban
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetGridGambar() []*SoalGambar {
+	if x != nil {
+		return x.GridGambar
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetMedia() []*QuestionMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *QuestionForStudent) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+func (x *QuestionForStudent) GetPertanyaanHtml() string {
+	if x != nil {
+		return x.PertanyaanHtml
+	}
+	return ""
+}
+
+type CreateSoalDragDropRequest struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	IdMateri       int32                        `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	IdTingkat      int32                        `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Pertanyaan     string                       `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	DragType       DragDropType                 `protobuf:"varint,4,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
+	Items          []*DragItem                  `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Slots          []*DragSlot                  `protobuf:"bytes,6,rep,name=slots,proto3" json:"slots,omitempty"`
+	CorrectAnswers []*DragCorrectAnswerByUrutan `protobuf:"bytes,7,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Pembahasan     string                       `protobuf:"bytes,8,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	LmsClassId     int64                        `protobuf:"varint,9,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope
+	Point          float64                      `protobuf:"fixed64,10,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan         int32                        `protobuf:"varint,11,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSoalDragDropRequest) Reset() {
+	*x = CreateSoalDragDropRequest{}
+	mi := &file_cbt_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSoalDragDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSoalDragDropRequest) ProtoMessage() {}
+
+func (x *CreateSoalDragDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSoalDragDropRequest.ProtoReflect.Descriptor instead.
+func (*CreateSoalDragDropRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateSoalDragDropRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *CreateSoalDragDropRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *CreateSoalDragDropRequest) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *CreateSoalDragDropRequest) GetDragType() DragDropType {
+	if x != nil {
+		return x.DragType
+	}
+	return DragDropType_DRAG_TYPE_INVALID
+}
+
+func (x *CreateSoalDragDropRequest) GetItems() []*DragItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateSoalDragDropRequest) GetSlots() []*DragSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *CreateSoalDragDropRequest) GetCorrectAnswers() []*DragCorrectAnswerByUrutan {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+func (x *CreateSoalDragDropRequest) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *CreateSoalDragDropRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *CreateSoalDragDropRequest) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *CreateSoalDragDropRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+type GetSoalDragDropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSoalDragDropRequest) Reset() {
+	*x = GetSoalDragDropRequest{}
+	mi := &file_cbt_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSoalDragDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSoalDragDropRequest) ProtoMessage() {}
+
+func (x *GetSoalDragDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSoalDragDropRequest.ProtoReflect.Descriptor instead.
+func (*GetSoalDragDropRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetSoalDragDropRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateSoalDragDropRequest struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Id             int32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdMateri       int32                        `protobuf:"varint,2,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	IdTingkat      int32                        `protobuf:"varint,3,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Pertanyaan     string                       `protobuf:"bytes,4,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	DragType       DragDropType                 `protobuf:"varint,5,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
+	Items          []*DragItem                  `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	Slots          []*DragSlot                  `protobuf:"bytes,7,rep,name=slots,proto3" json:"slots,omitempty"`
+	CorrectAnswers []*DragCorrectAnswerByUrutan `protobuf:"bytes,8,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Pembahasan     string                       `protobuf:"bytes,9,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	IsActive       bool                         `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Point          float64                      `protobuf:"fixed64,11,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan         int32                        `protobuf:"varint,12,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateSoalDragDropRequest) Reset() {
+	*x = UpdateSoalDragDropRequest{}
+	mi := &file_cbt_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSoalDragDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSoalDragDropRequest) ProtoMessage() {}
+
+func (x *UpdateSoalDragDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSoalDragDropRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSoalDragDropRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateSoalDragDropRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSoalDragDropRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *UpdateSoalDragDropRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *UpdateSoalDragDropRequest) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *UpdateSoalDragDropRequest) GetDragType() DragDropType {
+	if x != nil {
+		return x.DragType
+	}
+	return DragDropType_DRAG_TYPE_INVALID
+}
+
+func (x *UpdateSoalDragDropRequest) GetItems() []*DragItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UpdateSoalDragDropRequest) GetSlots() []*DragSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *UpdateSoalDragDropRequest) GetCorrectAnswers() []*DragCorrectAnswerByUrutan {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+func (x *UpdateSoalDragDropRequest) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *UpdateSoalDragDropRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateSoalDragDropRequest) GetPoint() float64 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *UpdateSoalDragDropRequest) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+type SoalDragDropOrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalDragDropOrderItem) Reset() {
+	*x = SoalDragDropOrderItem{}
+	mi := &file_cbt_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalDragDropOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalDragDropOrderItem) ProtoMessage() {}
+
+func (x *SoalDragDropOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalDragDropOrderItem.ProtoReflect.Descriptor instead.
+func (*SoalDragDropOrderItem) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SoalDragDropOrderItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SoalDragDropOrderItem) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
+}
+
+type ReorderSoalDragDropRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	IdMateri      int32                    `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	Items         []*SoalDragDropOrderItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSoalDragDropRequest) Reset() {
+	*x = ReorderSoalDragDropRequest{}
+	mi := &file_cbt_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSoalDragDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSoalDragDropRequest) ProtoMessage() {}
+
+func (x *ReorderSoalDragDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSoalDragDropRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSoalDragDropRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ReorderSoalDragDropRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *ReorderSoalDragDropRequest) GetItems() []*SoalDragDropOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteSoalDragDropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSoalDragDropRequest) Reset() {
+	*x = DeleteSoalDragDropRequest{}
+	mi := &file_cbt_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSoalDragDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSoalDragDropRequest) ProtoMessage() {}
+
+func (x *DeleteSoalDragDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSoalDragDropRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSoalDragDropRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteSoalDragDropRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SoalDragDropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Soal          *SoalDragDropFull      `protobuf:"bytes,1,opt,name=soal,proto3" json:"soal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoalDragDropResponse) Reset() {
+	*x = SoalDragDropResponse{}
+	mi := &file_cbt_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoalDragDropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoalDragDropResponse) ProtoMessage() {}
+
+func (x *SoalDragDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoalDragDropResponse.ProtoReflect.Descriptor instead.
+func (*SoalDragDropResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SoalDragDropResponse) GetSoal() *SoalDragDropFull {
+	if x != nil {
+		return x.Soal
+	}
+	return nil
+}
+
+type ListSoalDragDropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
+	IdTingkat     int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LmsClassId    int64                  `protobuf:"varint,4,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Filter by class
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoalDragDropRequest) Reset() {
+	*x = ListSoalDragDropRequest{}
+	mi := &file_cbt_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoalDragDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoalDragDropRequest) ProtoMessage() {}
+
+func (x *ListSoalDragDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoalDragDropRequest.ProtoReflect.Descriptor instead.
+func (*ListSoalDragDropRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListSoalDragDropRequest) GetIdMateri() int32 {
+	if x != nil {
+		return x.IdMateri
+	}
+	return 0
+}
+
+func (x *ListSoalDragDropRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *ListSoalDragDropRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSoalDragDropRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+type ListSoalDragDropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Soal          []*SoalDragDropFull    `protobuf:"bytes,1,rep,name=soal,proto3" json:"soal,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSoalDragDropResponse) Reset() {
+	*x = ListSoalDragDropResponse{}
+	mi := &file_cbt_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSoalDragDropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSoalDragDropResponse) ProtoMessage() {}
+
+func (x *ListSoalDragDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSoalDragDropResponse.ProtoReflect.Descriptor instead.
+func (*ListSoalDragDropResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListSoalDragDropResponse) GetSoal() []*SoalDragDropFull {
+	if x != nil {
+		return x.Soal
+	}
+	return nil
+}
+
+func (x *ListSoalDragDropResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type TestSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                  // From JWT, not input
+	NamaPeserta   string                 `protobuf:"bytes,4,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"` // Participant's name
+	Tingkat       *Tingkat               `protobuf:"bytes,5,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
+	MataPelajaran *MataPelajaran         `protobuf:"bytes,6,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"` // Nested object
+	WaktuMulai    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=waktu_mulai,json=waktuMulai,proto3" json:"waktu_mulai,omitempty"`
+	WaktuSelesai  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=waktu_selesai,json=waktuSelesai,proto3" json:"waktu_selesai,omitempty"`
+	BatasWaktu    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=batas_waktu,json=batasWaktu,proto3" json:"batas_waktu,omitempty"` // ADDED
+	DurasiMenit   int32                  `protobuf:"varint,10,opt,name=durasi_menit,json=durasiMenit,proto3" json:"durasi_menit,omitempty"`
+	NilaiAkhir    float64                `protobuf:"fixed64,11,opt,name=nilai_akhir,json=nilaiAkhir,proto3" json:"nilai_akhir,omitempty"`
+	JumlahBenar   int32                  `protobuf:"varint,12,opt,name=jumlah_benar,json=jumlahBenar,proto3" json:"jumlah_benar,omitempty"`
+	TotalSoal     int32                  `protobuf:"varint,13,opt,name=total_soal,json=totalSoal,proto3" json:"total_soal,omitempty"`
+	Status        TestStatus             `protobuf:"varint,14,opt,name=status,proto3,enum=base.TestStatus" json:"status,omitempty"`
+	LmsClassId    int64                  `protobuf:"varint,15,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSession) Reset() {
+	*x = TestSession{}
+	mi := &file_cbt_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSession) ProtoMessage() {}
+
+func (x *TestSession) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSession.ProtoReflect.Descriptor instead.
+func (*TestSession) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TestSession) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TestSession) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *TestSession) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *TestSession) GetNamaPeserta() string {
+	if x != nil {
+		return x.NamaPeserta
+	}
+	return ""
+}
+
+func (x *TestSession) GetTingkat() *Tingkat {
+	if x != nil {
+		return x.Tingkat
+	}
+	return nil
+}
+
+func (x *TestSession) GetMataPelajaran() *MataPelajaran {
+	if x != nil {
+		return x.MataPelajaran
+	}
+	return nil
+}
+
+func (x *TestSession) GetWaktuMulai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WaktuMulai
+	}
+	return nil
+}
+
+func (x *TestSession) GetWaktuSelesai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WaktuSelesai
+	}
+	return nil
+}
+
+func (x *TestSession) GetBatasWaktu() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BatasWaktu
+	}
+	return nil
+}
+
+func (x *TestSession) GetDurasiMenit() int32 {
+	if x != nil {
+		return x.DurasiMenit
+	}
+	return 0
+}
+
+func (x *TestSession) GetNilaiAkhir() float64 {
+	if x != nil {
+		return x.NilaiAkhir
+	}
+	return 0
+}
+
+func (x *TestSession) GetJumlahBenar() int32 {
+	if x != nil {
+		return x.JumlahBenar
+	}
+	return 0
+}
+
+func (x *TestSession) GetTotalSoal() int32 {
+	if x != nil {
+		return x.TotalSoal
+	}
+	return 0
+}
+
+func (x *TestSession) GetStatus() TestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TestStatus_STATUS_INVALID
+}
+
+func (x *TestSession) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+type CreateTestSessionRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	IdTingkat            int32                  `protobuf:"varint,1,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	IdMataPelajaran      int32                  `protobuf:"varint,2,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	DurasiMenit          int32                  `protobuf:"varint,3,opt,name=durasi_menit,json=durasiMenit,proto3" json:"durasi_menit,omitempty"`
+	JumlahSoal           int32                  `protobuf:"varint,4,opt,name=jumlah_soal,json=jumlahSoal,proto3" json:"jumlah_soal,omitempty"`                                                               // ADDED - critical!
+	LmsClassId           int64                  `protobuf:"varint,5,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`                                                             // Class scope
+	IncludeQuestionTypes []QuestionType         `protobuf:"varint,6,rep,packed,name=include_question_types,json=includeQuestionTypes,proto3,enum=base.QuestionType" json:"include_question_types,omitempty"` // Optional type filter (defaults to all)
+	SelectionMode        QuestionSelectionMode  `protobuf:"varint,7,opt,name=selection_mode,json=selectionMode,proto3,enum=base.QuestionSelectionMode" json:"selection_mode,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateTestSessionRequest) Reset() {
+	*x = CreateTestSessionRequest{}
+	mi := &file_cbt_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTestSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTestSessionRequest) ProtoMessage() {}
+
+func (x *CreateTestSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTestSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateTestSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateTestSessionRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *CreateTestSessionRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *CreateTestSessionRequest) GetDurasiMenit() int32 {
+	if x != nil {
+		return x.DurasiMenit
+	}
+	return 0
+}
+
+func (x *CreateTestSessionRequest) GetJumlahSoal() int32 {
+	if x != nil {
+		return x.JumlahSoal
+	}
+	return 0
+}
+
+func (x *CreateTestSessionRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *CreateTestSessionRequest) GetIncludeQuestionTypes() []QuestionType {
+	if x != nil {
+		return x.IncludeQuestionTypes
+	}
+	return nil
+}
+
+func (x *CreateTestSessionRequest) GetSelectionMode() QuestionSelectionMode {
+	if x != nil {
+		return x.SelectionMode
+	}
+	return QuestionSelectionMode_SELECTION_MODE_INVALID
+}
+
+type GetTestSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTestSessionRequest) Reset() {
+	*x = GetTestSessionRequest{}
+	mi := &file_cbt_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTestSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTestSessionRequest) ProtoMessage() {}
+
+func (x *GetTestSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTestSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetTestSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetTestSessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type TestSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestSession   *TestSession           `protobuf:"bytes,1,opt,name=test_session,json=testSession,proto3" json:"test_session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSessionResponse) Reset() {
+	*x = TestSessionResponse{}
+	mi := &file_cbt_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSessionResponse) ProtoMessage() {}
+
+func (x *TestSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSessionResponse.ProtoReflect.Descriptor instead.
+func (*TestSessionResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TestSessionResponse) GetTestSession() *TestSession {
+	if x != nil {
+		return x.TestSession
+	}
+	return nil
+}
+
+type ListTestSessionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IdTingkat       int32                  `protobuf:"varint,1,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
+	IdMataPelajaran int32                  `protobuf:"varint,2,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
+	Status          TestStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=base.TestStatus" json:"status,omitempty"`
+	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTestSessionsRequest) Reset() {
+	*x = ListTestSessionsRequest{}
+	mi := &file_cbt_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTestSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestSessionsRequest) ProtoMessage() {}
+
+func (x *ListTestSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTestSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListTestSessionsRequest) GetIdTingkat() int32 {
+	if x != nil {
+		return x.IdTingkat
+	}
+	return 0
+}
+
+func (x *ListTestSessionsRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *ListTestSessionsRequest) GetStatus() TestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TestStatus_STATUS_INVALID
+}
+
+func (x *ListTestSessionsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListTestSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestSessions  []*TestSession         `protobuf:"bytes,1,rep,name=test_sessions,json=testSessions,proto3" json:"test_sessions,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTestSessionsResponse) Reset() {
+	*x = ListTestSessionsResponse{}
+	mi := &file_cbt_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTestSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestSessionsResponse) ProtoMessage() {}
+
+func (x *ListTestSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTestSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListTestSessionsResponse) GetTestSessions() []*TestSession {
+	if x != nil {
+		return x.TestSessions
+	}
+	return nil
+}
+
+func (x *ListTestSessionsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetTestQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"` // Question number to fetch (1-20, etc.)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTestQuestionsRequest) Reset() {
+	*x = GetTestQuestionsRequest{}
+	mi := &file_cbt_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTestQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTestQuestionsRequest) ProtoMessage() {}
+
+func (x *GetTestQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTestQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTestQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetTestQuestionsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GetTestQuestionsRequest) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+type TestQuestionsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken     string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Questions        []*QuestionForStudent  `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"` // ALL questions for the session (mixed types)
+	TotalSoal        int32                  `protobuf:"varint,3,opt,name=total_soal,json=totalSoal,proto3" json:"total_soal,omitempty"`
+	CurrentNomorUrut int32                  `protobuf:"varint,4,opt,name=current_nomor_urut,json=currentNomorUrut,proto3" json:"current_nomor_urut,omitempty"`
+	DijawabCount     int32                  `protobuf:"varint,5,opt,name=dijawab_count,json=dijawabCount,proto3" json:"dijawab_count,omitempty"`
+	IsAnsweredStatus []bool                 `protobuf:"varint,6,rep,packed,name=is_answered_status,json=isAnsweredStatus,proto3" json:"is_answered_status,omitempty"` // Bool array for all questions (sidebar status)
+	BatasWaktu       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=batas_waktu,json=batasWaktu,proto3" json:"batas_waktu,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TestQuestionsResponse) Reset() {
+	*x = TestQuestionsResponse{}
+	mi := &file_cbt_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestQuestionsResponse) ProtoMessage() {}
+
+func (x *TestQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*TestQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *TestQuestionsResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *TestQuestionsResponse) GetQuestions() []*QuestionForStudent {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *TestQuestionsResponse) GetTotalSoal() int32 {
+	if x != nil {
+		return x.TotalSoal
+	}
+	return 0
+}
+
+func (x *TestQuestionsResponse) GetCurrentNomorUrut() int32 {
+	if x != nil {
+		return x.CurrentNomorUrut
+	}
+	return 0
+}
+
+func (x *TestQuestionsResponse) GetDijawabCount() int32 {
+	if x != nil {
+		return x.DijawabCount
+	}
+	return 0
+}
+
+func (x *TestQuestionsResponse) GetIsAnsweredStatus() []bool {
+	if x != nil {
+		return x.IsAnsweredStatus
+	}
+	return nil
+}
+
+func (x *TestQuestionsResponse) GetBatasWaktu() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BatasWaktu
+	}
+	return nil
+}
+
+type SubmitAnswerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	JawabanDipilih JawabanOption          `protobuf:"varint,3,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
+	JawabanLabel   string                 `protobuf:"bytes,4,opt,name=jawaban_label,json=jawabanLabel,proto3" json:"jawaban_label,omitempty"` // Takes precedence over jawaban_dipilih
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitAnswerRequest) Reset() {
+	*x = SubmitAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SubmitAnswerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitAnswerRequest) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitAnswerRequest) GetJawabanDipilih() JawabanOption {
+	if x != nil {
+		return x.JawabanDipilih
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *SubmitAnswerRequest) GetJawabanLabel() string {
+	if x != nil {
+		return x.JawabanLabel
+	}
+	return ""
+}
+
+type SubmitAnswerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	JawabanDipilih JawabanOption          `protobuf:"varint,3,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
+	IsCorrect      bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"` // Immediate feedback
+	DijawabPada    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	JawabanLabel   string                 `protobuf:"bytes,6,opt,name=jawaban_label,json=jawabanLabel,proto3" json:"jawaban_label,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitAnswerResponse) Reset() {
+	*x = SubmitAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SubmitAnswerResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitAnswerResponse) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitAnswerResponse) GetJawabanDipilih() JawabanOption {
+	if x != nil {
+		return x.JawabanDipilih
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *SubmitAnswerResponse) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *SubmitAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DijawabPada
+	}
+	return nil
+}
+
+func (x *SubmitAnswerResponse) GetJawabanLabel() string {
+	if x != nil {
+		return x.JawabanLabel
+	}
+	return ""
+}
+
+type SubmitComplexAnswerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	JawabanDipilih []JawabanOption        `protobuf:"varint,3,rep,packed,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
+	JawabanLabels  []string               `protobuf:"bytes,4,rep,name=jawaban_labels,json=jawabanLabels,proto3" json:"jawaban_labels,omitempty"` // Take precedence over jawaban_dipilih
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitComplexAnswerRequest) Reset() {
+	*x = SubmitComplexAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitComplexAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitComplexAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitComplexAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitComplexAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitComplexAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SubmitComplexAnswerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitComplexAnswerRequest) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitComplexAnswerRequest) GetJawabanDipilih() []JawabanOption {
+	if x != nil {
+		return x.JawabanDipilih
+	}
+	return nil
+}
+
+func (x *SubmitComplexAnswerRequest) GetJawabanLabels() []string {
+	if x != nil {
+		return x.JawabanLabels
+	}
+	return nil
+}
+
+type SubmitComplexAnswerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	JawabanDipilih []JawabanOption        `protobuf:"varint,3,rep,packed,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
+	IsCorrect      bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	DijawabPada    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	JawabanLabels  []string               `protobuf:"bytes,6,rep,name=jawaban_labels,json=jawabanLabels,proto3" json:"jawaban_labels,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitComplexAnswerResponse) Reset() {
+	*x = SubmitComplexAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitComplexAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitComplexAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitComplexAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitComplexAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitComplexAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SubmitComplexAnswerResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitComplexAnswerResponse) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitComplexAnswerResponse) GetJawabanDipilih() []JawabanOption {
+	if x != nil {
+		return x.JawabanDipilih
+	}
+	return nil
+}
+
+func (x *SubmitComplexAnswerResponse) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *SubmitComplexAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DijawabPada
+	}
+	return nil
+}
+
+func (x *SubmitComplexAnswerResponse) GetJawabanLabels() []string {
+	if x != nil {
+		return x.JawabanLabels
+	}
+	return nil
+}
+
+type SubmitDragDropAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Answer        map[int32]int32        `protobuf:"bytes,3,rep,name=answer,proto3" json:"answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // For ORDERING: position->itemId, For MATCHING: slotId->itemId
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDragDropAnswerRequest) Reset() {
+	*x = SubmitDragDropAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDragDropAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDragDropAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitDragDropAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDragDropAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDragDropAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SubmitDragDropAnswerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitDragDropAnswerRequest) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitDragDropAnswerRequest) GetAnswer() map[int32]int32 {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type SubmitDragDropAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Answer        map[int32]int32        `protobuf:"bytes,3,rep,name=answer,proto3" json:"answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	IsCorrect     bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDragDropAnswerResponse) Reset() {
+	*x = SubmitDragDropAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDragDropAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDragDropAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitDragDropAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDragDropAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDragDropAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SubmitDragDropAnswerResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitDragDropAnswerResponse) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitDragDropAnswerResponse) GetAnswer() map[int32]int32 {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *SubmitDragDropAnswerResponse) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *SubmitDragDropAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DijawabPada
+	}
+	return nil
+}
+
+type SubmitEssayAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	JawabanEssay  string                 `protobuf:"bytes,3,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitEssayAnswerRequest) Reset() {
+	*x = SubmitEssayAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitEssayAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitEssayAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitEssayAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitEssayAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitEssayAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SubmitEssayAnswerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitEssayAnswerRequest) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitEssayAnswerRequest) GetJawabanEssay() string {
+	if x != nil {
+		return x.JawabanEssay
+	}
+	return ""
+}
+
+type SubmitEssayAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	JawabanEssay  string                 `protobuf:"bytes,3,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
+	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitEssayAnswerResponse) Reset() {
+	*x = SubmitEssayAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitEssayAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitEssayAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitEssayAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitEssayAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitEssayAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SubmitEssayAnswerResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SubmitEssayAnswerResponse) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitEssayAnswerResponse) GetJawabanEssay() string {
+	if x != nil {
+		return x.JawabanEssay
+	}
+	return ""
+}
+
+func (x *SubmitEssayAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DijawabPada
+	}
+	return nil
+}
+
+type ClearAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAnswerRequest) Reset() {
+	*x = ClearAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAnswerRequest) ProtoMessage() {}
+
+func (x *ClearAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAnswerRequest.ProtoReflect.Descriptor instead.
+func (*ClearAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ClearAnswerRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ClearAnswerRequest) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+type ClearAnswerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	DibatalkanPada *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dibatalkan_pada,json=dibatalkanPada,proto3" json:"dibatalkan_pada,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearAnswerResponse) Reset() {
+	*x = ClearAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAnswerResponse) ProtoMessage() {}
+
+func (x *ClearAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAnswerResponse.ProtoReflect.Descriptor instead.
+func (*ClearAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ClearAnswerResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ClearAnswerResponse) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *ClearAnswerResponse) GetDibatalkanPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DibatalkanPada
+	}
+	return nil
+}
+
+type CompleteSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteSessionRequest) Reset() {
+	*x = CompleteSessionRequest{}
+	mi := &file_cbt_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSessionRequest) ProtoMessage() {}
+
+func (x *CompleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*CompleteSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CompleteSessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type GetTestResultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTestResultRequest) Reset() {
+	*x = GetTestResultRequest{}
+	mi := &file_cbt_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTestResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTestResultRequest) ProtoMessage() {}
+
+func (x *GetTestResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTestResultRequest.ProtoReflect.Descriptor instead.
+func (*GetTestResultRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetTestResultRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type JawabanDetail struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	NomorUrut                   int32                  `protobuf:"varint,1,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Pertanyaan                  string                 `protobuf:"bytes,2,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	OpsiA                       string                 `protobuf:"bytes,3,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
+	OpsiB                       string                 `protobuf:"bytes,4,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
+	OpsiC                       string                 `protobuf:"bytes,5,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
+	OpsiD                       string                 `protobuf:"bytes,6,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
+	JawabanDipilih              JawabanOption          `protobuf:"varint,7,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
+	JawabanBenar                JawabanOption          `protobuf:"varint,8,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
+	IsCorrect                   bool                   `protobuf:"varint,9,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	IsAnswered                  bool                   `protobuf:"varint,10,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
+	Pembahasan                  string                 `protobuf:"bytes,11,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
+	Gambar                      []*SoalGambar          `protobuf:"bytes,12,rep,name=gambar,proto3" json:"gambar,omitempty"`
+	QuestionType                QuestionType           `protobuf:"varint,13,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
+	DragType                    DragDropType           `protobuf:"varint,14,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
+	Items                       []*DragItem            `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
+	Slots                       []*DragSlot            `protobuf:"bytes,16,rep,name=slots,proto3" json:"slots,omitempty"`
+	UserDragAnswer              map[int32]int32        `protobuf:"bytes,17,rep,name=user_drag_answer,json=userDragAnswer,proto3" json:"user_drag_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	CorrectDragAnswer           map[int32]int32        `protobuf:"bytes,18,rep,name=correct_drag_answer,json=correctDragAnswer,proto3" json:"correct_drag_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	JawabanEssay                string                 `protobuf:"bytes,19,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
+	NilaiEssay                  float64                `protobuf:"fixed64,20,opt,name=nilai_essay,json=nilaiEssay,proto3" json:"nilai_essay,omitempty"`
+	FeedbackTeacher             string                 `protobuf:"bytes,21,opt,name=feedback_teacher,json=feedbackTeacher,proto3" json:"feedback_teacher,omitempty"`
+	JawabanDipilihComplex       []JawabanOption        `protobuf:"varint,22,rep,packed,name=jawaban_dipilih_complex,json=jawabanDipilihComplex,proto3,enum=base.JawabanOption" json:"jawaban_dipilih_complex,omitempty"`
+	JawabanBenarComplex         []JawabanOption        `protobuf:"varint,23,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
+	RubricScores                []*RubricScore         `protobuf:"bytes,24,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	JawabanShortAnswer          []string               `protobuf:"bytes,25,rep,name=jawaban_short_answer,json=jawabanShortAnswer,proto3" json:"jawaban_short_answer,omitempty"`
+	ShortAnswerBlanks           []*ShortAnswerBlank    `protobuf:"bytes,26,rep,name=short_answer_blanks,json=shortAnswerBlanks,proto3" json:"short_answer_blanks,omitempty"`
+	ShortAnswerBlankCorrect     []bool                 `protobuf:"varint,27,rep,packed,name=short_answer_blank_correct,json=shortAnswerBlankCorrect,proto3" json:"short_answer_blank_correct,omitempty"`
+	JawabanNumeric              string                 `protobuf:"bytes,28,opt,name=jawaban_numeric,json=jawabanNumeric,proto3" json:"jawaban_numeric,omitempty"`
+	NumericAnswer               *NumericAnswerKey      `protobuf:"bytes,29,opt,name=numeric_answer,json=numericAnswer,proto3" json:"numeric_answer,omitempty"`
+	Opsi                        []*SoalOpsi            `protobuf:"bytes,30,rep,name=opsi,proto3" json:"opsi,omitempty"`
+	JawabanDipilihLabel         string                 `protobuf:"bytes,31,opt,name=jawaban_dipilih_label,json=jawabanDipilihLabel,proto3" json:"jawaban_dipilih_label,omitempty"`
+	JawabanBenarLabel           string                 `protobuf:"bytes,32,opt,name=jawaban_benar_label,json=jawabanBenarLabel,proto3" json:"jawaban_benar_label,omitempty"`
+	JawabanDipilihComplexLabels []string               `protobuf:"bytes,33,rep,name=jawaban_dipilih_complex_labels,json=jawabanDipilihComplexLabels,proto3" json:"jawaban_dipilih_complex_labels,omitempty"`
+	JawabanBenarComplexLabels   []string               `protobuf:"bytes,34,rep,name=jawaban_benar_complex_labels,json=jawabanBenarComplexLabels,proto3" json:"jawaban_benar_complex_labels,omitempty"`
+	JawabanHotspot              []*HotspotPoint        `protobuf:"bytes,35,rep,name=jawaban_hotspot,json=jawabanHotspot,proto3" json:"jawaban_hotspot,omitempty"`
+	HotspotAnswer               *HotspotAnswerKey      `protobuf:"bytes,36,opt,name=hotspot_answer,json=hotspotAnswer,proto3" json:"hotspot_answer,omitempty"`
+	HotspotPointCorrect         []bool                 `protobuf:"varint,37,rep,packed,name=hotspot_point_correct,json=hotspotPointCorrect,proto3" json:"hotspot_point_correct,omitempty"` // One per point in jawaban_hotspot
+	JawabanGrid                 []int32                `protobuf:"varint,38,rep,packed,name=jawaban_grid,json=jawabanGrid,proto3" json:"jawaban_grid,omitempty"`
+	GridAnswer                  *GridAnswerKey         `protobuf:"bytes,39,opt,name=grid_answer,json=gridAnswer,proto3" json:"grid_answer,omitempty"`
+	GridRowCorrect              []bool                 `protobuf:"varint,40,rep,packed,name=grid_row_correct,json=gridRowCorrect,proto3" json:"grid_row_correct,omitempty"`
+	NilaiParsial                float64                `protobuf:"fixed64,41,opt,name=nilai_parsial,json=nilaiParsial,proto3" json:"nilai_parsial,omitempty"` // Percentage of the point earned, for partial-credit types
+	ContentFormat               ContentFormat          `protobuf:"varint,42,opt,name=content_format,json=contentFormat,proto3,enum=base.ContentFormat" json:"content_format,omitempty"`
+	PertanyaanHtml              string                 `protobuf:"bytes,43,opt,name=pertanyaan_html,json=pertanyaanHtml,proto3" json:"pertanyaan_html,omitempty"`
+	PembahasanHtml              string                 `protobuf:"bytes,44,opt,name=pembahasan_html,json=pembahasanHtml,proto3" json:"pembahasan_html,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *JawabanDetail) Reset() {
+	*x = JawabanDetail{}
+	mi := &file_cbt_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JawabanDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JawabanDetail) ProtoMessage() {}
+
+func (x *JawabanDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JawabanDetail.ProtoReflect.Descriptor instead.
+func (*JawabanDetail) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *JawabanDetail) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *JawabanDetail) GetPertanyaan() string {
+	if x != nil {
+		return x.Pertanyaan
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetOpsiA() string {
+	if x != nil {
+		return x.OpsiA
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetOpsiB() string {
+	if x != nil {
+		return x.OpsiB
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetOpsiC() string {
+	if x != nil {
+		return x.OpsiC
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetOpsiD() string {
+	if x != nil {
+		return x.OpsiD
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetJawabanDipilih() JawabanOption {
+	if x != nil {
+		return x.JawabanDipilih
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *JawabanDetail) GetJawabanBenar() JawabanOption {
+	if x != nil {
+		return x.JawabanBenar
+	}
+	return JawabanOption_JAWABAN_INVALID
+}
+
+func (x *JawabanDetail) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *JawabanDetail) GetIsAnswered() bool {
+	if x != nil {
+		return x.IsAnswered
+	}
+	return false
+}
+
+func (x *JawabanDetail) GetPembahasan() string {
+	if x != nil {
+		return x.Pembahasan
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetGambar() []*SoalGambar {
+	if x != nil {
+		return x.Gambar
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetQuestionType() QuestionType {
+	if x != nil {
+		return x.QuestionType
+	}
+	return QuestionType_QUESTION_TYPE_INVALID
+}
+
+func (x *JawabanDetail) GetDragType() DragDropType {
+	if x != nil {
+		return x.DragType
+	}
+	return DragDropType_DRAG_TYPE_INVALID
+}
+
+func (x *JawabanDetail) GetItems() []*DragItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetSlots() []*DragSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetUserDragAnswer() map[int32]int32 {
+	if x != nil {
+		return x.UserDragAnswer
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetCorrectDragAnswer() map[int32]int32 {
+	if x != nil {
+		return x.CorrectDragAnswer
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanEssay() string {
+	if x != nil {
+		return x.JawabanEssay
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetNilaiEssay() float64 {
+	if x != nil {
+		return x.NilaiEssay
+	}
+	return 0
+}
+
+func (x *JawabanDetail) GetFeedbackTeacher() string {
+	if x != nil {
+		return x.FeedbackTeacher
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetJawabanDipilihComplex() []JawabanOption {
+	if x != nil {
+		return x.JawabanDipilihComplex
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanBenarComplex() []JawabanOption {
+	if x != nil {
+		return x.JawabanBenarComplex
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanShortAnswer() []string {
+	if x != nil {
+		return x.JawabanShortAnswer
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetShortAnswerBlanks() []*ShortAnswerBlank {
+	if x != nil {
+		return x.ShortAnswerBlanks
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetShortAnswerBlankCorrect() []bool {
+	if x != nil {
+		return x.ShortAnswerBlankCorrect
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanNumeric() string {
+	if x != nil {
+		return x.JawabanNumeric
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetNumericAnswer() *NumericAnswerKey {
+	if x != nil {
+		return x.NumericAnswer
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetOpsi() []*SoalOpsi {
+	if x != nil {
+		return x.Opsi
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanDipilihLabel() string {
+	if x != nil {
+		return x.JawabanDipilihLabel
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetJawabanBenarLabel() string {
+	if x != nil {
+		return x.JawabanBenarLabel
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetJawabanDipilihComplexLabels() []string {
+	if x != nil {
+		return x.JawabanDipilihComplexLabels
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanBenarComplexLabels() []string {
+	if x != nil {
+		return x.JawabanBenarComplexLabels
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanHotspot() []*HotspotPoint {
+	if x != nil {
+		return x.JawabanHotspot
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetHotspotAnswer() *HotspotAnswerKey {
+	if x != nil {
+		return x.HotspotAnswer
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetHotspotPointCorrect() []bool {
+	if x != nil {
+		return x.HotspotPointCorrect
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetJawabanGrid() []int32 {
+	if x != nil {
+		return x.JawabanGrid
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetGridAnswer() *GridAnswerKey {
+	if x != nil {
+		return x.GridAnswer
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetGridRowCorrect() []bool {
+	if x != nil {
+		return x.GridRowCorrect
+	}
+	return nil
+}
+
+func (x *JawabanDetail) GetNilaiParsial() float64 {
+	if x != nil {
+		return x.NilaiParsial
+	}
+	return 0
+}
+
+func (x *JawabanDetail) GetContentFormat() ContentFormat {
+	if x != nil {
+		return x.ContentFormat
+	}
+	return ContentFormat_CONTENT_FORMAT_PLAIN
+}
+
+func (x *JawabanDetail) GetPertanyaanHtml() string {
+	if x != nil {
+		return x.PertanyaanHtml
+	}
+	return ""
+}
+
+func (x *JawabanDetail) GetPembahasanHtml() string {
+	if x != nil {
+		return x.PembahasanHtml
+	}
+	return ""
+}
+
+type GradeEssayAnswerRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AnswerId         int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Score            float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // Ignored when rubric_selections are given
+	Feedback         string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	RubricSelections []*RubricSelection     `protobuf:"bytes,4,rep,name=rubric_selections,json=rubricSelections,proto3" json:"rubric_selections,omitempty"` // One level per rubric criterion
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GradeEssayAnswerRequest) Reset() {
+	*x = GradeEssayAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeEssayAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeEssayAnswerRequest) ProtoMessage() {}
+
+func (x *GradeEssayAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeEssayAnswerRequest.ProtoReflect.Descriptor instead.
+func (*GradeEssayAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GradeEssayAnswerRequest) GetAnswerId() int32 {
+	if x != nil {
+		return x.AnswerId
+	}
+	return 0
+}
+
+func (x *GradeEssayAnswerRequest) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GradeEssayAnswerRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *GradeEssayAnswerRequest) GetRubricSelections() []*RubricSelection {
+	if x != nil {
+		return x.RubricSelections
+	}
+	return nil
+}
+
+type GradeEssayAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NilaiEssay    float64                `protobuf:"fixed64,3,opt,name=nilai_essay,json=nilaiEssay,proto3" json:"nilai_essay,omitempty"`
+	RubricScores  []*RubricScore         `protobuf:"bytes,4,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeEssayAnswerResponse) Reset() {
+	*x = GradeEssayAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeEssayAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeEssayAnswerResponse) ProtoMessage() {}
+
+func (x *GradeEssayAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeEssayAnswerResponse.ProtoReflect.Descriptor instead.
+func (*GradeEssayAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GradeEssayAnswerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GradeEssayAnswerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GradeEssayAnswerResponse) GetNilaiEssay() float64 {
+	if x != nil {
+		return x.NilaiEssay
+	}
+	return 0
+}
+
+func (x *GradeEssayAnswerResponse) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+type TestResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionInfo   *TestSession           `protobuf:"bytes,1,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	DetailJawaban []*JawabanDetail       `protobuf:"bytes,2,rep,name=detail_jawaban,json=detailJawaban,proto3" json:"detail_jawaban,omitempty"`
+	Tingkat       []*Tingkat             `protobuf:"bytes,3,rep,name=tingkat,proto3" json:"tingkat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestResultResponse) Reset() {
+	*x = TestResultResponse{}
+	mi := &file_cbt_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResultResponse) ProtoMessage() {}
+
+func (x *TestResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResultResponse.ProtoReflect.Descriptor instead.
+func (*TestResultResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *TestResultResponse) GetSessionInfo() *TestSession {
+	if x != nil {
+		return x.SessionInfo
+	}
+	return nil
+}
+
+func (x *TestResultResponse) GetDetailJawaban() []*JawabanDetail {
+	if x != nil {
+		return x.DetailJawaban
+	}
+	return nil
+}
+
+func (x *TestResultResponse) GetTingkat() []*Tingkat {
+	if x != nil {
+		return x.Tingkat
+	}
+	return nil
+}
+
+type StudentHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // From JWT, not input
+	Tingkatan       int32                  `protobuf:"varint,2,opt,name=tingkatan,proto3" json:"tingkatan,omitempty"`                                      // Optional filter
+	IdMataPelajaran int32                  `protobuf:"varint,3,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"` // Optional filter
+	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StudentHistoryRequest) Reset() {
+	*x = StudentHistoryRequest{}
+	mi := &file_cbt_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentHistoryRequest) ProtoMessage() {}
+
+func (x *StudentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*StudentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *StudentHistoryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StudentHistoryRequest) GetTingkatan() int32 {
+	if x != nil {
+		return x.Tingkatan
+	}
+	return 0
+}
+
+func (x *StudentHistoryRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *StudentHistoryRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type HistorySummary struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionToken          string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	MataPelajaran         *MataPelajaran         `protobuf:"bytes,3,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"`
+	Tingkat               *Tingkat               `protobuf:"bytes,4,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
+	WaktuMulai            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=waktu_mulai,json=waktuMulai,proto3" json:"waktu_mulai,omitempty"`
+	WaktuSelesai          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=waktu_selesai,json=waktuSelesai,proto3" json:"waktu_selesai,omitempty"`
+	DurasiPengerjaanDetik int32                  `protobuf:"varint,7,opt,name=durasi_pengerjaan_detik,json=durasiPengerjaanDetik,proto3" json:"durasi_pengerjaan_detik,omitempty"` // Actual time spent
+	NilaiAkhir            float64                `protobuf:"fixed64,8,opt,name=nilai_akhir,json=nilaiAkhir,proto3" json:"nilai_akhir,omitempty"`
+	JumlahBenar           int32                  `protobuf:"varint,9,opt,name=jumlah_benar,json=jumlahBenar,proto3" json:"jumlah_benar,omitempty"`
+	TotalSoal             int32                  `protobuf:"varint,10,opt,name=total_soal,json=totalSoal,proto3" json:"total_soal,omitempty"`
+	Status                TestStatus             `protobuf:"varint,11,opt,name=status,proto3,enum=base.TestStatus" json:"status,omitempty"`
+	NamaPeserta           string                 `protobuf:"bytes,12,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HistorySummary) Reset() {
+	*x = HistorySummary{}
+	mi := &file_cbt_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistorySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistorySummary) ProtoMessage() {}
+
+func (x *HistorySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistorySummary.ProtoReflect.Descriptor instead.
+func (*HistorySummary) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *HistorySummary) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HistorySummary) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *HistorySummary) GetMataPelajaran() *MataPelajaran {
+	if x != nil {
+		return x.MataPelajaran
+	}
+	return nil
+}
+
+func (x *HistorySummary) GetTingkat() *Tingkat {
+	if x != nil {
+		return x.Tingkat
+	}
+	return nil
+}
+
+func (x *HistorySummary) GetWaktuMulai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WaktuMulai
+	}
+	return nil
+}
+
+func (x *HistorySummary) GetWaktuSelesai() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WaktuSelesai
+	}
+	return nil
+}
+
+func (x *HistorySummary) GetDurasiPengerjaanDetik() int32 {
+	if x != nil {
+		return x.DurasiPengerjaanDetik
+	}
+	return 0
+}
+
+func (x *HistorySummary) GetNilaiAkhir() float64 {
+	if x != nil {
+		return x.NilaiAkhir
+	}
+	return 0
+}
+
+func (x *HistorySummary) GetJumlahBenar() int32 {
+	if x != nil {
+		return x.JumlahBenar
+	}
+	return 0
+}
+
+func (x *HistorySummary) GetTotalSoal() int32 {
+	if x != nil {
+		return x.TotalSoal
+	}
+	return 0
+}
+
+func (x *HistorySummary) GetStatus() TestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TestStatus_STATUS_INVALID
+}
+
+func (x *HistorySummary) GetNamaPeserta() string {
+	if x != nil {
+		return x.NamaPeserta
+	}
+	return ""
+}
+
+type StudentHistoryResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	History    []*HistorySummary      `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	Pagination *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Aggregate stats
+	RataRataNilai      float64 `protobuf:"fixed64,3,opt,name=rata_rata_nilai,json=rataRataNilai,proto3" json:"rata_rata_nilai,omitempty"`
+	TotalTestCompleted int32   `protobuf:"varint,4,opt,name=total_test_completed,json=totalTestCompleted,proto3" json:"total_test_completed,omitempty"`
+	User               *User   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Tingkatan          int32   `protobuf:"varint,6,opt,name=tingkatan,proto3" json:"tingkatan,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StudentHistoryResponse) Reset() {
+	*x = StudentHistoryResponse{}
+	mi := &file_cbt_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentHistoryResponse) ProtoMessage() {}
+
+func (x *StudentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*StudentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *StudentHistoryResponse) GetHistory() []*HistorySummary {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *StudentHistoryResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *StudentHistoryResponse) GetRataRataNilai() float64 {
+	if x != nil {
+		return x.RataRataNilai
+	}
+	return 0
+}
+
+func (x *StudentHistoryResponse) GetTotalTestCompleted() int32 {
+	if x != nil {
+		return x.TotalTestCompleted
+	}
+	return 0
+}
+
+func (x *StudentHistoryResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *StudentHistoryResponse) GetTingkatan() int32 {
+	if x != nil {
+		return x.Tingkatan
+	}
+	return 0
+}
+
+type ListStudentHistoriesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // Optional: filter by specific student
+	Tingkatan       int32                  `protobuf:"varint,2,opt,name=tingkatan,proto3" json:"tingkatan,omitempty"`                                      // Optional filter
+	IdMataPelajaran int32                  `protobuf:"varint,3,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"` // Optional filter
+	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListStudentHistoriesRequest) Reset() {
+	*x = ListStudentHistoriesRequest{}
+	mi := &file_cbt_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudentHistoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentHistoriesRequest) ProtoMessage() {}
+
+func (x *ListStudentHistoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentHistoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListStudentHistoriesRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListStudentHistoriesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListStudentHistoriesRequest) GetTingkatan() int32 {
+	if x != nil {
+		return x.Tingkatan
+	}
+	return 0
+}
+
+func (x *ListStudentHistoriesRequest) GetIdMataPelajaran() int32 {
+	if x != nil {
+		return x.IdMataPelajaran
+	}
+	return 0
+}
+
+func (x *ListStudentHistoriesRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListStudentHistoriesResponse struct {
+	state             protoimpl.MessageState    `protogen:"open.v1"`
+	HistoryPerStudent []*StudentHistoryWithUser `protobuf:"bytes,1,rep,name=history_per_student,json=historyPerStudent,proto3" json:"history_per_student,omitempty"`
+	Pagination        *PaginationResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListStudentHistoriesResponse) Reset() {
+	*x = ListStudentHistoriesResponse{}
+	mi := &file_cbt_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStudentHistoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStudentHistoriesResponse) ProtoMessage() {}
+
+func (x *ListStudentHistoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStudentHistoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListStudentHistoriesResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ListStudentHistoriesResponse) GetHistoryPerStudent() []*StudentHistoryWithUser {
+	if x != nil {
+		return x.HistoryPerStudent
+	}
+	return nil
+}
+
+func (x *ListStudentHistoriesResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type StudentHistoryWithUser struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	User               *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	History            []*HistorySummary      `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	RataRataNilai      float64                `protobuf:"fixed64,3,opt,name=rata_rata_nilai,json=rataRataNilai,proto3" json:"rata_rata_nilai,omitempty"`
+	TotalTestCompleted int32                  `protobuf:"varint,4,opt,name=total_test_completed,json=totalTestCompleted,proto3" json:"total_test_completed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StudentHistoryWithUser) Reset() {
+	*x = StudentHistoryWithUser{}
+	mi := &file_cbt_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentHistoryWithUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentHistoryWithUser) ProtoMessage() {}
+
+func (x *StudentHistoryWithUser) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentHistoryWithUser.ProtoReflect.Descriptor instead.
+func (*StudentHistoryWithUser) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *StudentHistoryWithUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *StudentHistoryWithUser) GetHistory() []*HistorySummary {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *StudentHistoryWithUser) GetRataRataNilai() float64 {
+	if x != nil {
+		return x.RataRataNilai
+	}
+	return 0
+}
+
+func (x *StudentHistoryWithUser) GetTotalTestCompleted() int32 {
+	if x != nil {
+		return x.TotalTestCompleted
+	}
+	return 0
+}
+
+type GetHistoryDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryDetailRequest) Reset() {
+	*x = GetHistoryDetailRequest{}
+	mi := &file_cbt_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryDetailRequest) ProtoMessage() {}
+
+func (x *GetHistoryDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryDetailRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetHistoryDetailRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type HistoryDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionInfo   *TestSession           `protobuf:"bytes,1,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
+	DetailJawaban []*JawabanDetail       `protobuf:"bytes,2,rep,name=detail_jawaban,json=detailJawaban,proto3" json:"detail_jawaban,omitempty"`
+	// Breakdown per materi
+	BreakdownMateri []*MateriBreakdown `protobuf:"bytes,3,rep,name=breakdown_materi,json=breakdownMateri,proto3" json:"breakdown_materi,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HistoryDetailResponse) Reset() {
+	*x = HistoryDetailResponse{}
+	mi := &file_cbt_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryDetailResponse) ProtoMessage() {}
+
+func (x *HistoryDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryDetailResponse.ProtoReflect.Descriptor instead.
+func (*HistoryDetailResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *HistoryDetailResponse) GetSessionInfo() *TestSession {
+	if x != nil {
+		return x.SessionInfo
+	}
+	return nil
+}
+
+func (x *HistoryDetailResponse) GetDetailJawaban() []*JawabanDetail {
+	if x != nil {
+		return x.DetailJawaban
+	}
+	return nil
+}
+
+func (x *HistoryDetailResponse) GetBreakdownMateri() []*MateriBreakdown {
+	if x != nil {
+		return x.BreakdownMateri
+	}
+	return nil
+}
+
+type MateriBreakdown struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NamaMateri      string                 `protobuf:"bytes,1,opt,name=nama_materi,json=namaMateri,proto3" json:"nama_materi,omitempty"`
+	JumlahSoal      int32                  `protobuf:"varint,2,opt,name=jumlah_soal,json=jumlahSoal,proto3" json:"jumlah_soal,omitempty"`
+	JumlahBenar     int32                  `protobuf:"varint,3,opt,name=jumlah_benar,json=jumlahBenar,proto3" json:"jumlah_benar,omitempty"`
+	PersentaseBenar float64                `protobuf:"fixed64,4,opt,name=persentase_benar,json=persentaseBenar,proto3" json:"persentase_benar,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MateriBreakdown) Reset() {
+	*x = MateriBreakdown{}
+	mi := &file_cbt_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MateriBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MateriBreakdown) ProtoMessage() {}
+
+func (x *MateriBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MateriBreakdown.ProtoReflect.Descriptor instead.
+func (*MateriBreakdown) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *MateriBreakdown) GetNamaMateri() string {
+	if x != nil {
+		return x.NamaMateri
+	}
+	return ""
+}
+
+func (x *MateriBreakdown) GetJumlahSoal() int32 {
+	if x != nil {
+		return x.JumlahSoal
+	}
+	return 0
+}
+
+func (x *MateriBreakdown) GetJumlahBenar() int32 {
+	if x != nil {
+		return x.JumlahBenar
+	}
+	return 0
+}
+
+func (x *MateriBreakdown) GetPersentaseBenar() float64 {
+	if x != nil {
+		return x.PersentaseBenar
+	}
+	return 0
+}
+
+type QuestionCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        []*TopicCount          `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionCountsResponse) Reset() {
+	*x = QuestionCountsResponse{}
+	mi := &file_cbt_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionCountsResponse) ProtoMessage() {}
+
+func (x *QuestionCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionCountsResponse.ProtoReflect.Descriptor instead.
+func (*QuestionCountsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *QuestionCountsResponse) GetCounts() []*TopicCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type TopicCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopicId       int32                  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicCount) Reset() {
+	*x = TopicCount{}
+	mi := &file_cbt_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCount) ProtoMessage() {}
+
+func (x *TopicCount) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicCount.ProtoReflect.Descriptor instead.
+func (*TopicCount) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *TopicCount) GetTopicId() int32 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *TopicCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListMyScheduledSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LmsClassId    int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyScheduledSessionsRequest) Reset() {
+	*x = ListMyScheduledSessionsRequest{}
+	mi := &file_cbt_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyScheduledSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyScheduledSessionsRequest) ProtoMessage() {}
+
+func (x *ListMyScheduledSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyScheduledSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyScheduledSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListMyScheduledSessionsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListMyScheduledSessionsRequest) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+type StartScheduledSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartScheduledSessionRequest) Reset() {
+	*x = StartScheduledSessionRequest{}
+	mi := &file_cbt_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartScheduledSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScheduledSessionRequest) ProtoMessage() {}
+
+func (x *StartScheduledSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScheduledSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartScheduledSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *StartScheduledSessionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type ClassData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LmsClassId    int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	LmsSchoolId   int64                  `protobuf:"varint,3,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassData) Reset() {
+	*x = ClassData{}
+	mi := &file_cbt_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassData) ProtoMessage() {}
+
+func (x *ClassData) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassData.ProtoReflect.Descriptor instead.
+func (*ClassData) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ClassData) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClassData) GetLmsClassId() int64 {
+	if x != nil {
+		return x.LmsClassId
+	}
+	return 0
+}
+
+func (x *ClassData) GetLmsSchoolId() int64 {
+	if x != nil {
+		return x.LmsSchoolId
+	}
+	return 0
+}
+
+func (x *ClassData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClassData) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ClassData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ClassData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LmsSchoolId   int64                  `protobuf:"varint,1,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassesRequest) Reset() {
+	*x = ListClassesRequest{}
+	mi := &file_cbt_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassesRequest) ProtoMessage() {}
+
+func (x *ListClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassesRequest.ProtoReflect.Descriptor instead.
+func (*ListClassesRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListClassesRequest) GetLmsSchoolId() int64 {
+	if x != nil {
+		return x.LmsSchoolId
+	}
+	return 0
+}
+
+type ListClassesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*ClassData           `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassesResponse) Reset() {
+	*x = ListClassesResponse{}
+	mi := &file_cbt_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassesResponse) ProtoMessage() {}
+
+func (x *ListClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[141]
 	if x != nil {
-		return x.LmsBookId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Materi) GetLmsTeacherMaterialId() int64 {
-	if x != nil {
-		return x.LmsTeacherMaterialId
-	}
-	return 0
+// Deprecated: Use ListClassesResponse.ProtoReflect.Descriptor instead.
+func (*ListClassesResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *Materi) GetRandomizeQuestions() bool {
+func (x *ListClassesResponse) GetClasses() []*ClassData {
 	if x != nil {
-		return x.RandomizeQuestions
+		return x.Classes
 	}
-	return false
+	return nil
 }
 
-type CreateMateriRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	IdMataPelajaran      int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	IdTingkat            int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Nama                 string                 `protobuf:"bytes,3,opt,name=nama,proto3" json:"nama,omitempty"`
-	IsActive             bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	DefaultDurasiMenit   int32                  `protobuf:"varint,5,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
-	DefaultJumlahSoal    int32                  `protobuf:"varint,6,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
-	LmsClassId           int64                  `protobuf:"varint,7,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope for the materi
-	Labels               []string               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`                              // Optional labels/tags for the materi
-	LmsModuleId          int64                  `protobuf:"varint,9,opt,name=lms_module_id,json=lmsModuleId,proto3" json:"lms_module_id,omitempty"`
-	LmsBookId            int64                  `protobuf:"varint,10,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
-	LmsTeacherMaterialId int64                  `protobuf:"varint,11,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
-	RandomizeQuestions   bool                   `protobuf:"varint,12,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ClassStudentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LmsClassId    int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	LmsUserId     int64                  `protobuf:"varint,3,opt,name=lms_user_id,json=lmsUserId,proto3" json:"lms_user_id,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMateriRequest) Reset() {
-	*x = CreateMateriRequest{}
-	mi := &file_cbt_proto_msgTypes[32]
+func (x *ClassStudentData) Reset() {
+	*x = ClassStudentData{}
+	mi := &file_cbt_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMateriRequest) String() string {
+func (x *ClassStudentData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMateriRequest) ProtoMessage() {}
+func (*ClassStudentData) ProtoMessage() {}
 
-func (x *CreateMateriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[32]
+func (x *ClassStudentData) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,125 +11203,155 @@ func (x *CreateMateriRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMateriRequest.ProtoReflect.Descriptor instead.
-func (*CreateMateriRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ClassStudentData.ProtoReflect.Descriptor instead.
+func (*ClassStudentData) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *CreateMateriRequest) GetIdMataPelajaran() int32 {
+func (x *ClassStudentData) GetId() int32 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateMateriRequest) GetIdTingkat() int32 {
+func (x *ClassStudentData) GetLmsClassId() int64 {
 	if x != nil {
-		return x.IdTingkat
+		return x.LmsClassId
 	}
 	return 0
 }
 
-func (x *CreateMateriRequest) GetNama() string {
+func (x *ClassStudentData) GetLmsUserId() int64 {
 	if x != nil {
-		return x.Nama
+		return x.LmsUserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateMateriRequest) GetIsActive() bool {
+func (x *ClassStudentData) GetJoinedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsActive
+		return x.JoinedAt
 	}
-	return false
+	return nil
 }
 
-func (x *CreateMateriRequest) GetDefaultDurasiMenit() int32 {
-	if x != nil {
-		return x.DefaultDurasiMenit
-	}
-	return 0
+type ListClassStudentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LmsClassId    int64                  `protobuf:"varint,1,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMateriRequest) GetDefaultJumlahSoal() int32 {
+func (x *ListClassStudentsRequest) Reset() {
+	*x = ListClassStudentsRequest{}
+	mi := &file_cbt_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassStudentsRequest) ProtoMessage() {}
+
+func (x *ListClassStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[143]
 	if x != nil {
-		return x.DefaultJumlahSoal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMateriRequest) GetLmsClassId() int64 {
+// Deprecated: Use ListClassStudentsRequest.ProtoReflect.Descriptor instead.
+func (*ListClassStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ListClassStudentsRequest) GetLmsClassId() int64 {
 	if x != nil {
 		return x.LmsClassId
 	}
 	return 0
 }
 
-func (x *CreateMateriRequest) GetLabels() []string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+type ListClassStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*ClassStudentData    `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMateriRequest) GetLmsModuleId() int64 {
-	if x != nil {
-		return x.LmsModuleId
-	}
-	return 0
+func (x *ListClassStudentsResponse) Reset() {
+	*x = ListClassStudentsResponse{}
+	mi := &file_cbt_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMateriRequest) GetLmsBookId() int64 {
-	if x != nil {
-		return x.LmsBookId
-	}
-	return 0
+func (x *ListClassStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateMateriRequest) GetLmsTeacherMaterialId() int64 {
+func (*ListClassStudentsResponse) ProtoMessage() {}
+
+func (x *ListClassStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[144]
 	if x != nil {
-		return x.LmsTeacherMaterialId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMateriRequest) GetRandomizeQuestions() bool {
+// Deprecated: Use ListClassStudentsResponse.ProtoReflect.Descriptor instead.
+func (*ListClassStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListClassStudentsResponse) GetStudents() []*ClassStudentData {
 	if x != nil {
-		return x.RandomizeQuestions
+		return x.Students
 	}
-	return false
+	return nil
 }
 
-type CreateMateriSuperadminRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	IdMataPelajaran    int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	IdTingkat          int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Nama               string                 `protobuf:"bytes,3,opt,name=nama,proto3" json:"nama,omitempty"`
-	IsActive           bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	DefaultDurasiMenit int32                  `protobuf:"varint,5,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
-	DefaultJumlahSoal  int32                  `protobuf:"varint,6,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
-	Labels             []string               `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
-	LmsBookId          int64                  `protobuf:"varint,8,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
-	RandomizeQuestions bool                   `protobuf:"varint,9,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SebConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	FileName        string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ConfigKey       string                 `protobuf:"bytes,3,opt,name=config_key,json=configKey,proto3" json:"config_key,omitempty"`                     // SHA256 of the canonical settings, expected in X-SafeExamBrowser-ConfigKeyHash
+	BrowserExamKeys []string               `protobuf:"bytes,4,rep,name=browser_exam_keys,json=browserExamKeys,proto3" json:"browser_exam_keys,omitempty"` // Approved SEB builds, expected in X-SafeExamBrowser-RequestHash
+	IsActive        bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateMateriSuperadminRequest) Reset() {
-	*x = CreateMateriSuperadminRequest{}
-	mi := &file_cbt_proto_msgTypes[33]
+func (x *SebConfig) Reset() {
+	*x = SebConfig{}
+	mi := &file_cbt_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMateriSuperadminRequest) String() string {
+func (x *SebConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMateriSuperadminRequest) ProtoMessage() {}
+func (*SebConfig) ProtoMessage() {}
 
-func (x *CreateMateriSuperadminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[33]
+func (x *SebConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,105 +11362,85 @@ func (x *CreateMateriSuperadminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMateriSuperadminRequest.ProtoReflect.Descriptor instead.
-func (*CreateMateriSuperadminRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *CreateMateriSuperadminRequest) GetIdMataPelajaran() int32 {
-	if x != nil {
-		return x.IdMataPelajaran
-	}
-	return 0
+// Deprecated: Use SebConfig.ProtoReflect.Descriptor instead.
+func (*SebConfig) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *CreateMateriSuperadminRequest) GetIdTingkat() int32 {
+func (x *SebConfig) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.IdTingkat
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *CreateMateriSuperadminRequest) GetNama() string {
+func (x *SebConfig) GetFileName() string {
 	if x != nil {
-		return x.Nama
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *CreateMateriSuperadminRequest) GetIsActive() bool {
+func (x *SebConfig) GetConfigKey() string {
 	if x != nil {
-		return x.IsActive
+		return x.ConfigKey
 	}
-	return false
+	return ""
 }
 
-func (x *CreateMateriSuperadminRequest) GetDefaultDurasiMenit() int32 {
+func (x *SebConfig) GetBrowserExamKeys() []string {
 	if x != nil {
-		return x.DefaultDurasiMenit
+		return x.BrowserExamKeys
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateMateriSuperadminRequest) GetDefaultJumlahSoal() int32 {
+func (x *SebConfig) GetIsActive() bool {
 	if x != nil {
-		return x.DefaultJumlahSoal
+		return x.IsActive
 	}
-	return 0
+	return false
 }
 
-func (x *CreateMateriSuperadminRequest) GetLabels() []string {
+func (x *SebConfig) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Labels
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *CreateMateriSuperadminRequest) GetLmsBookId() int64 {
-	if x != nil {
-		return x.LmsBookId
-	}
-	return 0
-}
-
-func (x *CreateMateriSuperadminRequest) GetRandomizeQuestions() bool {
+func (x *SebConfig) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RandomizeQuestions
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-type CreateMateriTeacherRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	IdMataPelajaran      int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	IdTingkat            int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Nama                 string                 `protobuf:"bytes,3,opt,name=nama,proto3" json:"nama,omitempty"`
-	IsActive             bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	DefaultDurasiMenit   int32                  `protobuf:"varint,5,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
-	DefaultJumlahSoal    int32                  `protobuf:"varint,6,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
-	LmsClassId           int64                  `protobuf:"varint,7,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	Labels               []string               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
-	LmsTeacherMaterialId int64                  `protobuf:"varint,9,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
-	RandomizeQuestions   bool                   `protobuf:"varint,10,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type UploadSebConfigRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	SebFile         []byte                 `protobuf:"bytes,2,opt,name=seb_file,json=sebFile,proto3" json:"seb_file,omitempty"` // Unencrypted .seb file (base64 in JSON)
+	FileName        string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	BrowserExamKeys []string               `protobuf:"bytes,4,rep,name=browser_exam_keys,json=browserExamKeys,proto3" json:"browser_exam_keys,omitempty"` // Optional, hex encoded
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateMateriTeacherRequest) Reset() {
-	*x = CreateMateriTeacherRequest{}
-	mi := &file_cbt_proto_msgTypes[34]
+func (x *UploadSebConfigRequest) Reset() {
+	*x = UploadSebConfigRequest{}
+	mi := &file_cbt_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMateriTeacherRequest) String() string {
+func (x *UploadSebConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMateriTeacherRequest) ProtoMessage() {}
+func (*UploadSebConfigRequest) ProtoMessage() {}
 
-func (x *CreateMateriTeacherRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[34]
+func (x *UploadSebConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2686,103 +11451,149 @@ func (x *CreateMateriTeacherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMateriTeacherRequest.ProtoReflect.Descriptor instead.
-func (*CreateMateriTeacherRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UploadSebConfigRequest.ProtoReflect.Descriptor instead.
+func (*UploadSebConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *CreateMateriTeacherRequest) GetIdMataPelajaran() int32 {
+func (x *UploadSebConfigRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *CreateMateriTeacherRequest) GetIdTingkat() int32 {
+func (x *UploadSebConfigRequest) GetSebFile() []byte {
 	if x != nil {
-		return x.IdTingkat
+		return x.SebFile
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateMateriTeacherRequest) GetNama() string {
+func (x *UploadSebConfigRequest) GetFileName() string {
 	if x != nil {
-		return x.Nama
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *CreateMateriTeacherRequest) GetIsActive() bool {
+func (x *UploadSebConfigRequest) GetBrowserExamKeys() []string {
 	if x != nil {
-		return x.IsActive
+		return x.BrowserExamKeys
 	}
-	return false
+	return nil
 }
 
-func (x *CreateMateriTeacherRequest) GetDefaultDurasiMenit() int32 {
-	if x != nil {
-		return x.DefaultDurasiMenit
-	}
-	return 0
+type GetSebConfigRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateMateriTeacherRequest) GetDefaultJumlahSoal() int32 {
+func (x *GetSebConfigRequest) Reset() {
+	*x = GetSebConfigRequest{}
+	mi := &file_cbt_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSebConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSebConfigRequest) ProtoMessage() {}
+
+func (x *GetSebConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[147]
 	if x != nil {
-		return x.DefaultJumlahSoal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMateriTeacherRequest) GetLmsClassId() int64 {
+// Deprecated: Use GetSebConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetSebConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetSebConfigRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.LmsClassId
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *CreateMateriTeacherRequest) GetLabels() []string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
+type DeleteSebConfigRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateMateriTeacherRequest) GetLmsTeacherMaterialId() int64 {
+func (x *DeleteSebConfigRequest) Reset() {
+	*x = DeleteSebConfigRequest{}
+	mi := &file_cbt_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSebConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSebConfigRequest) ProtoMessage() {}
+
+func (x *DeleteSebConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[148]
 	if x != nil {
-		return x.LmsTeacherMaterialId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateMateriTeacherRequest) GetRandomizeQuestions() bool {
+// Deprecated: Use DeleteSebConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSebConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *DeleteSebConfigRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.RandomizeQuestions
+		return x.LmsAssignmentId
 	}
-	return false
+	return 0
 }
 
-type GetMateriRequest struct {
+type SebConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SebConfig     *SebConfig             `protobuf:"bytes,1,opt,name=seb_config,json=sebConfig,proto3" json:"seb_config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMateriRequest) Reset() {
-	*x = GetMateriRequest{}
-	mi := &file_cbt_proto_msgTypes[35]
+func (x *SebConfigResponse) Reset() {
+	*x = SebConfigResponse{}
+	mi := &file_cbt_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMateriRequest) String() string {
+func (x *SebConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMateriRequest) ProtoMessage() {}
+func (*SebConfigResponse) ProtoMessage() {}
 
-func (x *GetMateriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[35]
+func (x *SebConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2793,50 +11604,50 @@ func (x *GetMateriRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMateriRequest.ProtoReflect.Descriptor instead.
-func (*GetMateriRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SebConfigResponse.ProtoReflect.Descriptor instead.
+func (*SebConfigResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *GetMateriRequest) GetId() int32 {
+func (x *SebConfigResponse) GetSebConfig() *SebConfig {
 	if x != nil {
-		return x.Id
+		return x.SebConfig
 	}
-	return 0
+	return nil
 }
 
-type UpdateMateriRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdMataPelajaran      int32                  `protobuf:"varint,2,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	IdTingkat            int32                  `protobuf:"varint,3,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Nama                 string                 `protobuf:"bytes,4,opt,name=nama,proto3" json:"nama,omitempty"`
-	IsActive             bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	DefaultDurasiMenit   int32                  `protobuf:"varint,6,opt,name=default_durasi_menit,json=defaultDurasiMenit,proto3" json:"default_durasi_menit,omitempty"`
-	DefaultJumlahSoal    int32                  `protobuf:"varint,7,opt,name=default_jumlah_soal,json=defaultJumlahSoal,proto3" json:"default_jumlah_soal,omitempty"`
-	LmsModuleId          int64                  `protobuf:"varint,8,opt,name=lms_module_id,json=lmsModuleId,proto3" json:"lms_module_id,omitempty"`
-	LmsBookId            int64                  `protobuf:"varint,9,opt,name=lms_book_id,json=lmsBookId,proto3" json:"lms_book_id,omitempty"`
-	LmsTeacherMaterialId int64                  `protobuf:"varint,10,opt,name=lms_teacher_material_id,json=lmsTeacherMaterialId,proto3" json:"lms_teacher_material_id,omitempty"`
-	RandomizeQuestions   bool                   `protobuf:"varint,11,opt,name=randomize_questions,json=randomizeQuestions,proto3" json:"randomize_questions,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type DeviceLease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                  // active, transferred, rejected, approved
+	AttemptCount  int32                  `protobuf:"varint,6,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"` // Attempts from a rejected device
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ReleasedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	ApprovedBy    int32                  `protobuf:"varint,10,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	Note          string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMateriRequest) Reset() {
-	*x = UpdateMateriRequest{}
-	mi := &file_cbt_proto_msgTypes[36]
+func (x *DeviceLease) Reset() {
+	*x = DeviceLease{}
+	mi := &file_cbt_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMateriRequest) String() string {
+func (x *DeviceLease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMateriRequest) ProtoMessage() {}
+func (*DeviceLease) ProtoMessage() {}
 
-func (x *UpdateMateriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[36]
+func (x *DeviceLease) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2847,110 +11658,110 @@ func (x *UpdateMateriRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMateriRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMateriRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DeviceLease.ProtoReflect.Descriptor instead.
+func (*DeviceLease) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *UpdateMateriRequest) GetId() int32 {
+func (x *DeviceLease) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateMateriRequest) GetIdMataPelajaran() int32 {
+func (x *DeviceLease) GetDeviceId() string {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.DeviceId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateMateriRequest) GetIdTingkat() int32 {
+func (x *DeviceLease) GetUserAgent() string {
 	if x != nil {
-		return x.IdTingkat
+		return x.UserAgent
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateMateriRequest) GetNama() string {
+func (x *DeviceLease) GetIpAddress() string {
 	if x != nil {
-		return x.Nama
+		return x.IpAddress
 	}
 	return ""
 }
 
-func (x *UpdateMateriRequest) GetIsActive() bool {
+func (x *DeviceLease) GetStatus() string {
 	if x != nil {
-		return x.IsActive
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-func (x *UpdateMateriRequest) GetDefaultDurasiMenit() int32 {
+func (x *DeviceLease) GetAttemptCount() int32 {
 	if x != nil {
-		return x.DefaultDurasiMenit
+		return x.AttemptCount
 	}
 	return 0
 }
 
-func (x *UpdateMateriRequest) GetDefaultJumlahSoal() int32 {
+func (x *DeviceLease) GetIssuedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DefaultJumlahSoal
+		return x.IssuedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateMateriRequest) GetLmsModuleId() int64 {
+func (x *DeviceLease) GetLastSeenAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LmsModuleId
+		return x.LastSeenAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateMateriRequest) GetLmsBookId() int64 {
+func (x *DeviceLease) GetReleasedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LmsBookId
+		return x.ReleasedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateMateriRequest) GetLmsTeacherMaterialId() int64 {
+func (x *DeviceLease) GetApprovedBy() int32 {
 	if x != nil {
-		return x.LmsTeacherMaterialId
+		return x.ApprovedBy
 	}
 	return 0
 }
 
-func (x *UpdateMateriRequest) GetRandomizeQuestions() bool {
+func (x *DeviceLease) GetNote() string {
 	if x != nil {
-		return x.RandomizeQuestions
+		return x.Note
 	}
-	return false
+	return ""
 }
 
-type DeleteMateriRequest struct {
+type ListDeviceLeasesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMateriRequest) Reset() {
-	*x = DeleteMateriRequest{}
-	mi := &file_cbt_proto_msgTypes[37]
+func (x *ListDeviceLeasesRequest) Reset() {
+	*x = ListDeviceLeasesRequest{}
+	mi := &file_cbt_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMateriRequest) String() string {
+func (x *ListDeviceLeasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMateriRequest) ProtoMessage() {}
+func (*ListDeviceLeasesRequest) ProtoMessage() {}
 
-func (x *DeleteMateriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[37]
+func (x *ListDeviceLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,40 +11772,144 @@ func (x *DeleteMateriRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMateriRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMateriRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListDeviceLeasesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeviceLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *DeleteMateriRequest) GetId() int32 {
+func (x *ListDeviceLeasesRequest) GetSessionToken() string {
 	if x != nil {
-		return x.Id
+		return x.SessionToken
 	}
-	return 0
+	return ""
 }
 
-type MateriResponse struct {
+type ListDeviceLeasesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Materi        *Materi                `protobuf:"bytes,1,opt,name=materi,proto3" json:"materi,omitempty"`
+	Leases        []*DeviceLease         `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MateriResponse) Reset() {
-	*x = MateriResponse{}
-	mi := &file_cbt_proto_msgTypes[38]
+func (x *ListDeviceLeasesResponse) Reset() {
+	*x = ListDeviceLeasesResponse{}
+	mi := &file_cbt_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceLeasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceLeasesResponse) ProtoMessage() {}
+
+func (x *ListDeviceLeasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceLeasesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeviceLeasesResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListDeviceLeasesResponse) GetLeases() []*DeviceLease {
+	if x != nil {
+		return x.Leases
+	}
+	return nil
+}
+
+type ApproveDeviceTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // Optional, defaults to the latest rejected device
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDeviceTransferRequest) Reset() {
+	*x = ApproveDeviceTransferRequest{}
+	mi := &file_cbt_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDeviceTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDeviceTransferRequest) ProtoMessage() {}
+
+func (x *ApproveDeviceTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDeviceTransferRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDeviceTransferRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ApproveDeviceTransferRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ApproveDeviceTransferRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ApproveDeviceTransferRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type DeviceLeaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lease         *DeviceLease           `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceLeaseResponse) Reset() {
+	*x = DeviceLeaseResponse{}
+	mi := &file_cbt_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MateriResponse) String() string {
+func (x *DeviceLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MateriResponse) ProtoMessage() {}
+func (*DeviceLeaseResponse) ProtoMessage() {}
 
-func (x *MateriResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[38]
+func (x *DeviceLeaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3005,42 +11920,45 @@ func (x *MateriResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MateriResponse.ProtoReflect.Descriptor instead.
-func (*MateriResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DeviceLeaseResponse.ProtoReflect.Descriptor instead.
+func (*DeviceLeaseResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *MateriResponse) GetMateri() *Materi {
+func (x *DeviceLeaseResponse) GetLease() *DeviceLease {
 	if x != nil {
-		return x.Materi
+		return x.Lease
 	}
 	return nil
 }
 
-type ListMateriRequest struct {
+type NetworkAllowlistEntry struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	IdMataPelajaran int32                  `protobuf:"varint,1,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	IdTingkat       int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Pagination      *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LmsSchoolId     int64                  `protobuf:"varint,2,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,3,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	Cidr            string                 `protobuf:"bytes,4,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Label           string                 `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListMateriRequest) Reset() {
-	*x = ListMateriRequest{}
-	mi := &file_cbt_proto_msgTypes[39]
+func (x *NetworkAllowlistEntry) Reset() {
+	*x = NetworkAllowlistEntry{}
+	mi := &file_cbt_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMateriRequest) String() string {
+func (x *NetworkAllowlistEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMateriRequest) ProtoMessage() {}
+func (*NetworkAllowlistEntry) ProtoMessage() {}
 
-func (x *ListMateriRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[39]
+func (x *NetworkAllowlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,55 +11969,78 @@ func (x *ListMateriRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMateriRequest.ProtoReflect.Descriptor instead.
-func (*ListMateriRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use NetworkAllowlistEntry.ProtoReflect.Descriptor instead.
+func (*NetworkAllowlistEntry) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *ListMateriRequest) GetIdMataPelajaran() int32 {
+func (x *NetworkAllowlistEntry) GetId() int64 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListMateriRequest) GetIdTingkat() int32 {
+func (x *NetworkAllowlistEntry) GetLmsSchoolId() int64 {
 	if x != nil {
-		return x.IdTingkat
+		return x.LmsSchoolId
 	}
 	return 0
 }
 
-func (x *ListMateriRequest) GetPagination() *PaginationRequest {
+func (x *NetworkAllowlistEntry) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.LmsAssignmentId
+	}
+	return 0
+}
+
+func (x *NetworkAllowlistEntry) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *NetworkAllowlistEntry) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NetworkAllowlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListMateriResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Materi        []*Materi              `protobuf:"bytes,1,rep,name=materi,proto3" json:"materi,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetNetworkAllowlistRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsSchoolId     int64                  `protobuf:"varint,1,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"` // Set either school or assignment
+	LmsAssignmentId int64                  `protobuf:"varint,2,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	Cidrs           []string               `protobuf:"bytes,3,rep,name=cidrs,proto3" json:"cidrs,omitempty"` // Empty list removes the restriction
+	Label           string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListMateriResponse) Reset() {
-	*x = ListMateriResponse{}
-	mi := &file_cbt_proto_msgTypes[40]
+func (x *SetNetworkAllowlistRequest) Reset() {
+	*x = SetNetworkAllowlistRequest{}
+	mi := &file_cbt_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMateriResponse) String() string {
+func (x *SetNetworkAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMateriResponse) ProtoMessage() {}
+func (*SetNetworkAllowlistRequest) ProtoMessage() {}
 
-func (x *ListMateriResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[40]
+func (x *SetNetworkAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3110,49 +12051,62 @@ func (x *ListMateriResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMateriResponse.ProtoReflect.Descriptor instead.
-func (*ListMateriResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SetNetworkAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*SetNetworkAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *ListMateriResponse) GetMateri() []*Materi {
+func (x *SetNetworkAllowlistRequest) GetLmsSchoolId() int64 {
 	if x != nil {
-		return x.Materi
+		return x.LmsSchoolId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListMateriResponse) GetPagination() *PaginationResponse {
+func (x *SetNetworkAllowlistRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.LmsAssignmentId
+	}
+	return 0
+}
+
+func (x *SetNetworkAllowlistRequest) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
 	}
 	return nil
 }
 
-type Tingkat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
-	LmsLevelId    int64                  `protobuf:"varint,3,opt,name=lms_level_id,json=lmsLevelId,proto3" json:"lms_level_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetNetworkAllowlistRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (x *Tingkat) Reset() {
-	*x = Tingkat{}
-	mi := &file_cbt_proto_msgTypes[41]
+type GetNetworkAllowlistRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsSchoolId     int64                  `protobuf:"varint,1,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,2,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNetworkAllowlistRequest) Reset() {
+	*x = GetNetworkAllowlistRequest{}
+	mi := &file_cbt_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tingkat) String() string {
+func (x *GetNetworkAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tingkat) ProtoMessage() {}
+func (*GetNetworkAllowlistRequest) ProtoMessage() {}
 
-func (x *Tingkat) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[41]
+func (x *GetNetworkAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3163,54 +12117,47 @@ func (x *Tingkat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tingkat.ProtoReflect.Descriptor instead.
-func (*Tingkat) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetNetworkAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *Tingkat) GetId() int32 {
+func (x *GetNetworkAllowlistRequest) GetLmsSchoolId() int64 {
 	if x != nil {
-		return x.Id
+		return x.LmsSchoolId
 	}
 	return 0
 }
 
-func (x *Tingkat) GetNama() string {
-	if x != nil {
-		return x.Nama
-	}
-	return ""
-}
-
-func (x *Tingkat) GetLmsLevelId() int64 {
+func (x *GetNetworkAllowlistRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.LmsLevelId
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-type CreateTingkatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nama          string                 `protobuf:"bytes,1,opt,name=nama,proto3" json:"nama,omitempty"`
+type NetworkAllowlistResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Entries       []*NetworkAllowlistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTingkatRequest) Reset() {
-	*x = CreateTingkatRequest{}
-	mi := &file_cbt_proto_msgTypes[42]
+func (x *NetworkAllowlistResponse) Reset() {
+	*x = NetworkAllowlistResponse{}
+	mi := &file_cbt_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTingkatRequest) String() string {
+func (x *NetworkAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTingkatRequest) ProtoMessage() {}
+func (*NetworkAllowlistResponse) ProtoMessage() {}
 
-func (x *CreateTingkatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[42]
+func (x *NetworkAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3221,40 +12168,42 @@ func (x *CreateTingkatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTingkatRequest.ProtoReflect.Descriptor instead.
-func (*CreateTingkatRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use NetworkAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*NetworkAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *CreateTingkatRequest) GetNama() string {
+func (x *NetworkAllowlistResponse) GetEntries() []*NetworkAllowlistEntry {
 	if x != nil {
-		return x.Nama
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type GetTingkatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GrantNetworkOverrideRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken     string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Reason           string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresInMinutes int32                  `protobuf:"varint,3,opt,name=expires_in_minutes,json=expiresInMinutes,proto3" json:"expires_in_minutes,omitempty"` // 0 = no expiry
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetTingkatRequest) Reset() {
-	*x = GetTingkatRequest{}
-	mi := &file_cbt_proto_msgTypes[43]
+func (x *GrantNetworkOverrideRequest) Reset() {
+	*x = GrantNetworkOverrideRequest{}
+	mi := &file_cbt_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTingkatRequest) String() string {
+func (x *GrantNetworkOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTingkatRequest) ProtoMessage() {}
+func (*GrantNetworkOverrideRequest) ProtoMessage() {}
 
-func (x *GetTingkatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[43]
+func (x *GrantNetworkOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3265,41 +12214,59 @@ func (x *GetTingkatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTingkatRequest.ProtoReflect.Descriptor instead.
-func (*GetTingkatRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GrantNetworkOverrideRequest.ProtoReflect.Descriptor instead.
+func (*GrantNetworkOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *GetTingkatRequest) GetId() int32 {
+func (x *GrantNetworkOverrideRequest) GetSessionToken() string {
 	if x != nil {
-		return x.Id
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GrantNetworkOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GrantNetworkOverrideRequest) GetExpiresInMinutes() int32 {
+	if x != nil {
+		return x.ExpiresInMinutes
 	}
 	return 0
 }
 
-type UpdateTingkatRequest struct {
+type NetworkOverrideResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy     int32                  `protobuf:"varint,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTingkatRequest) Reset() {
-	*x = UpdateTingkatRequest{}
-	mi := &file_cbt_proto_msgTypes[44]
+func (x *NetworkOverrideResponse) Reset() {
+	*x = NetworkOverrideResponse{}
+	mi := &file_cbt_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTingkatRequest) String() string {
+func (x *NetworkOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTingkatRequest) ProtoMessage() {}
+func (*NetworkOverrideResponse) ProtoMessage() {}
 
-func (x *UpdateTingkatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[44]
+func (x *NetworkOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,47 +12277,82 @@ func (x *UpdateTingkatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTingkatRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTingkatRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use NetworkOverrideResponse.ProtoReflect.Descriptor instead.
+func (*NetworkOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *UpdateTingkatRequest) GetId() int32 {
+func (x *NetworkOverrideResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateTingkatRequest) GetNama() string {
+func (x *NetworkOverrideResponse) GetSessionToken() string {
 	if x != nil {
-		return x.Nama
+		return x.SessionToken
 	}
 	return ""
 }
 
-type DeleteTingkatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NetworkOverrideResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *DeleteTingkatRequest) Reset() {
-	*x = DeleteTingkatRequest{}
-	mi := &file_cbt_proto_msgTypes[45]
+func (x *NetworkOverrideResponse) GetGrantedBy() int32 {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return 0
+}
+
+func (x *NetworkOverrideResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *NetworkOverrideResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type NetworkAccessDenial struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdTestSession   int32                  `protobuf:"varint,2,opt,name=id_test_session,json=idTestSession,proto3" json:"id_test_session,omitempty"`
+	UserId          int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LmsSchoolId     int64                  `protobuf:"varint,4,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,5,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	Method          string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	ClientIp        string                 `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NetworkAccessDenial) Reset() {
+	*x = NetworkAccessDenial{}
+	mi := &file_cbt_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTingkatRequest) String() string {
+func (x *NetworkAccessDenial) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTingkatRequest) ProtoMessage() {}
+func (*NetworkAccessDenial) ProtoMessage() {}
 
-func (x *DeleteTingkatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[45]
+func (x *NetworkAccessDenial) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3361,84 +12363,91 @@ func (x *DeleteTingkatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTingkatRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTingkatRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use NetworkAccessDenial.ProtoReflect.Descriptor instead.
+func (*NetworkAccessDenial) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *DeleteTingkatRequest) GetId() int32 {
+func (x *NetworkAccessDenial) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type TingkatResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tingkat       *Tingkat               `protobuf:"bytes,1,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NetworkAccessDenial) GetIdTestSession() int32 {
+	if x != nil {
+		return x.IdTestSession
+	}
+	return 0
 }
 
-func (x *TingkatResponse) Reset() {
-	*x = TingkatResponse{}
-	mi := &file_cbt_proto_msgTypes[46]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NetworkAccessDenial) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *TingkatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NetworkAccessDenial) GetLmsSchoolId() int64 {
+	if x != nil {
+		return x.LmsSchoolId
+	}
+	return 0
 }
 
-func (*TingkatResponse) ProtoMessage() {}
+func (x *NetworkAccessDenial) GetLmsAssignmentId() int64 {
+	if x != nil {
+		return x.LmsAssignmentId
+	}
+	return 0
+}
 
-func (x *TingkatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[46]
+func (x *NetworkAccessDenial) GetMethod() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Method
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TingkatResponse.ProtoReflect.Descriptor instead.
-func (*TingkatResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{46}
+func (x *NetworkAccessDenial) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
 }
 
-func (x *TingkatResponse) GetTingkat() *Tingkat {
+func (x *NetworkAccessDenial) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tingkat
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListTingkatResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tingkat       []*Tingkat             `protobuf:"bytes,1,rep,name=tingkat,proto3" json:"tingkat,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListNetworkAccessDenialsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsSchoolId     int64                  `protobuf:"varint,1,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,2,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	Pagination      *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListTingkatResponse) Reset() {
-	*x = ListTingkatResponse{}
-	mi := &file_cbt_proto_msgTypes[47]
+func (x *ListNetworkAccessDenialsRequest) Reset() {
+	*x = ListNetworkAccessDenialsRequest{}
+	mi := &file_cbt_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTingkatResponse) String() string {
+func (x *ListNetworkAccessDenialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTingkatResponse) ProtoMessage() {}
+func (*ListNetworkAccessDenialsRequest) ProtoMessage() {}
 
-func (x *ListTingkatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[47]
+func (x *ListNetworkAccessDenialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3449,50 +12458,55 @@ func (x *ListTingkatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTingkatResponse.ProtoReflect.Descriptor instead.
-func (*ListTingkatResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListNetworkAccessDenialsRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworkAccessDenialsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *ListTingkatResponse) GetTingkat() []*Tingkat {
+func (x *ListNetworkAccessDenialsRequest) GetLmsSchoolId() int64 {
 	if x != nil {
-		return x.Tingkat
+		return x.LmsSchoolId
+	}
+	return 0
+}
+
+func (x *ListNetworkAccessDenialsRequest) GetLmsAssignmentId() int64 {
+	if x != nil {
+		return x.LmsAssignmentId
+	}
+	return 0
+}
+
+func (x *ListNetworkAccessDenialsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-// Image metadata for soal
-type SoalGambar struct {
+type ListNetworkAccessDenialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NamaFile      string                 `protobuf:"bytes,2,opt,name=nama_file,json=namaFile,proto3" json:"nama_file,omitempty"`
-	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
-	FileSize      int32                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Urutan        int32                  `protobuf:"varint,6,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	Keterangan    string                 `protobuf:"bytes,7,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
-	CloudId       string                 `protobuf:"bytes,8,opt,name=cloud_id,json=cloudId,proto3" json:"cloud_id,omitempty"`
-	PublicId      string                 `protobuf:"bytes,9,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Denials       []*NetworkAccessDenial `protobuf:"bytes,1,rep,name=denials,proto3" json:"denials,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SoalGambar) Reset() {
-	*x = SoalGambar{}
-	mi := &file_cbt_proto_msgTypes[48]
+func (x *ListNetworkAccessDenialsResponse) Reset() {
+	*x = ListNetworkAccessDenialsResponse{}
+	mi := &file_cbt_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalGambar) String() string {
+func (x *ListNetworkAccessDenialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalGambar) ProtoMessage() {}
+func (*ListNetworkAccessDenialsResponse) ProtoMessage() {}
 
-func (x *SoalGambar) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[48]
+func (x *ListNetworkAccessDenialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3503,118 +12517,118 @@ func (x *SoalGambar) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalGambar.ProtoReflect.Descriptor instead.
-func (*SoalGambar) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListNetworkAccessDenialsResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworkAccessDenialsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *SoalGambar) GetId() int32 {
+func (x *ListNetworkAccessDenialsResponse) GetDenials() []*NetworkAccessDenial {
 	if x != nil {
-		return x.Id
+		return x.Denials
 	}
-	return 0
+	return nil
 }
 
-func (x *SoalGambar) GetNamaFile() string {
+func (x *ListNetworkAccessDenialsResponse) GetPagination() *PaginationResponse {
 	if x != nil {
-		return x.NamaFile
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *SoalGambar) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
+type AnalyzeCollusionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId   int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	SyncWindowSeconds int32                  `protobuf:"varint,2,opt,name=sync_window_seconds,json=syncWindowSeconds,proto3" json:"sync_window_seconds,omitempty"` // Answers this close together count as synchronized (default 10)
+	MinSharedWrong    int32                  `protobuf:"varint,3,opt,name=min_shared_wrong,json=minSharedWrong,proto3" json:"min_shared_wrong,omitempty"`          // Minimum identical wrong answers to report a pair (default 3)
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                                    // Maximum pairs returned (default 50)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SoalGambar) GetFileSize() int32 {
-	if x != nil {
-		return x.FileSize
-	}
-	return 0
+func (x *AnalyzeCollusionRequest) Reset() {
+	*x = AnalyzeCollusionRequest{}
+	mi := &file_cbt_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalGambar) GetMimeType() string {
-	if x != nil {
-		return x.MimeType
-	}
-	return ""
+func (x *AnalyzeCollusionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SoalGambar) GetUrutan() int32 {
+func (*AnalyzeCollusionRequest) ProtoMessage() {}
+
+func (x *AnalyzeCollusionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[164]
 	if x != nil {
-		return x.Urutan
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SoalGambar) GetKeterangan() string {
+// Deprecated: Use AnalyzeCollusionRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeCollusionRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *AnalyzeCollusionRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Keterangan
+		return x.LmsAssignmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalGambar) GetCloudId() string {
+func (x *AnalyzeCollusionRequest) GetSyncWindowSeconds() int32 {
 	if x != nil {
-		return x.CloudId
+		return x.SyncWindowSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalGambar) GetPublicId() string {
+func (x *AnalyzeCollusionRequest) GetMinSharedWrong() int32 {
 	if x != nil {
-		return x.PublicId
+		return x.MinSharedWrong
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalGambar) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AnalyzeCollusionRequest) GetLimit() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// Full soal with answer (for admin/teacher only)
-type SoalFull struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Materi              *Materi                `protobuf:"bytes,2,opt,name=materi,proto3" json:"materi,omitempty"`
-	Pertanyaan          string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	OpsiA               string                 `protobuf:"bytes,4,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
-	OpsiB               string                 `protobuf:"bytes,5,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
-	OpsiC               string                 `protobuf:"bytes,6,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
-	OpsiD               string                 `protobuf:"bytes,7,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
-	JawabanBenar        JawabanOption          `protobuf:"varint,8,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
-	Pembahasan          string                 `protobuf:"bytes,9,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	Gambar              []*SoalGambar          `protobuf:"bytes,10,rep,name=gambar,proto3" json:"gambar,omitempty"`
-	LmsClassId          int64                  `protobuf:"varint,11,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	QuestionType        QuestionType           `protobuf:"varint,12,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
-	JawabanBenarComplex []JawabanOption        `protobuf:"varint,13,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
-	Point               float64                `protobuf:"fixed64,14,opt,name=point,proto3" json:"point,omitempty"`
-	Urutan              int32                  `protobuf:"varint,15,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type CollusionSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdTestSession int32                  `protobuf:"varint,1,opt,name=id_test_session,json=idTestSession,proto3" json:"id_test_session,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	UserId        int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NamaPeserta   string                 `protobuf:"bytes,4,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SoalFull) Reset() {
-	*x = SoalFull{}
-	mi := &file_cbt_proto_msgTypes[49]
+func (x *CollusionSession) Reset() {
+	*x = CollusionSession{}
+	mi := &file_cbt_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalFull) String() string {
+func (x *CollusionSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalFull) ProtoMessage() {}
+func (*CollusionSession) ProtoMessage() {}
 
-func (x *SoalFull) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[49]
+func (x *CollusionSession) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3625,149 +12639,191 @@ func (x *SoalFull) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalFull.ProtoReflect.Descriptor instead.
-func (*SoalFull) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use CollusionSession.ProtoReflect.Descriptor instead.
+func (*CollusionSession) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *SoalFull) GetId() int32 {
+func (x *CollusionSession) GetIdTestSession() int32 {
 	if x != nil {
-		return x.Id
+		return x.IdTestSession
 	}
 	return 0
 }
 
-func (x *SoalFull) GetMateri() *Materi {
+func (x *CollusionSession) GetSessionToken() string {
 	if x != nil {
-		return x.Materi
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *SoalFull) GetPertanyaan() string {
+func (x *CollusionSession) GetUserId() int32 {
 	if x != nil {
-		return x.Pertanyaan
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalFull) GetOpsiA() string {
+func (x *CollusionSession) GetNamaPeserta() string {
 	if x != nil {
-		return x.OpsiA
+		return x.NamaPeserta
 	}
 	return ""
 }
 
-func (x *SoalFull) GetOpsiB() string {
-	if x != nil {
-		return x.OpsiB
-	}
-	return ""
+type CollusionEvidence struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuestionType   string                 `protobuf:"bytes,1,opt,name=question_type,json=questionType,proto3" json:"question_type,omitempty"`
+	IdSoal         int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	ResponseA      string                 `protobuf:"bytes,3,opt,name=response_a,json=responseA,proto3" json:"response_a,omitempty"`
+	ResponseB      string                 `protobuf:"bytes,4,opt,name=response_b,json=responseB,proto3" json:"response_b,omitempty"`
+	IsCorrect      bool                   `protobuf:"varint,5,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	AnsweredAtA    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=answered_at_a,json=answeredAtA,proto3" json:"answered_at_a,omitempty"`
+	AnsweredAtB    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=answered_at_b,json=answeredAtB,proto3" json:"answered_at_b,omitempty"`
+	TimeGapSeconds float64                `protobuf:"fixed64,8,opt,name=time_gap_seconds,json=timeGapSeconds,proto3" json:"time_gap_seconds,omitempty"`
+	IdenticalWrong bool                   `protobuf:"varint,9,opt,name=identical_wrong,json=identicalWrong,proto3" json:"identical_wrong,omitempty"`
+	Synchronized   bool                   `protobuf:"varint,10,opt,name=synchronized,proto3" json:"synchronized,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SoalFull) GetOpsiC() string {
-	if x != nil {
-		return x.OpsiC
-	}
-	return ""
+func (x *CollusionEvidence) Reset() {
+	*x = CollusionEvidence{}
+	mi := &file_cbt_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalFull) GetOpsiD() string {
+func (x *CollusionEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollusionEvidence) ProtoMessage() {}
+
+func (x *CollusionEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[166]
 	if x != nil {
-		return x.OpsiD
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SoalFull) GetJawabanBenar() JawabanOption {
+// Deprecated: Use CollusionEvidence.ProtoReflect.Descriptor instead.
+func (*CollusionEvidence) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *CollusionEvidence) GetQuestionType() string {
 	if x != nil {
-		return x.JawabanBenar
+		return x.QuestionType
 	}
-	return JawabanOption_JAWABAN_INVALID
+	return ""
 }
 
-func (x *SoalFull) GetPembahasan() string {
+func (x *CollusionEvidence) GetIdSoal() int32 {
 	if x != nil {
-		return x.Pembahasan
+		return x.IdSoal
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalFull) GetGambar() []*SoalGambar {
+func (x *CollusionEvidence) GetResponseA() string {
 	if x != nil {
-		return x.Gambar
+		return x.ResponseA
 	}
-	return nil
+	return ""
 }
 
-func (x *SoalFull) GetLmsClassId() int64 {
+func (x *CollusionEvidence) GetResponseB() string {
 	if x != nil {
-		return x.LmsClassId
+		return x.ResponseB
 	}
-	return 0
+	return ""
 }
 
-func (x *SoalFull) GetQuestionType() QuestionType {
+func (x *CollusionEvidence) GetIsCorrect() bool {
 	if x != nil {
-		return x.QuestionType
+		return x.IsCorrect
 	}
-	return QuestionType_QUESTION_TYPE_INVALID
+	return false
 }
 
-func (x *SoalFull) GetJawabanBenarComplex() []JawabanOption {
+func (x *CollusionEvidence) GetAnsweredAtA() *timestamppb.Timestamp {
 	if x != nil {
-		return x.JawabanBenarComplex
+		return x.AnsweredAtA
 	}
 	return nil
 }
 
-func (x *SoalFull) GetPoint() float64 {
+func (x *CollusionEvidence) GetAnsweredAtB() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Point
+		return x.AnsweredAtB
 	}
-	return 0
+	return nil
 }
 
-func (x *SoalFull) GetUrutan() int32 {
+func (x *CollusionEvidence) GetTimeGapSeconds() float64 {
 	if x != nil {
-		return x.Urutan
+		return x.TimeGapSeconds
 	}
 	return 0
 }
-
-// Soal for student (no answer exposed)
-type SoalForStudent struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"` // Question number in test
-	Pertanyaan     string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	OpsiA          string                 `protobuf:"bytes,4,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
-	OpsiB          string                 `protobuf:"bytes,5,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
-	OpsiC          string                 `protobuf:"bytes,6,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
-	OpsiD          string                 `protobuf:"bytes,7,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
-	JawabanDipilih JawabanOption          `protobuf:"varint,8,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"` // If already answered
-	IsAnswered     bool                   `protobuf:"varint,9,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
-	Materi         *Materi                `protobuf:"bytes,10,opt,name=materi,proto3" json:"materi,omitempty"`
-	Gambar         []*SoalGambar          `protobuf:"bytes,11,rep,name=gambar,proto3" json:"gambar,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+
+func (x *CollusionEvidence) GetIdenticalWrong() bool {
+	if x != nil {
+		return x.IdenticalWrong
+	}
+	return false
 }
 
-func (x *SoalForStudent) Reset() {
-	*x = SoalForStudent{}
-	mi := &file_cbt_proto_msgTypes[50]
+func (x *CollusionEvidence) GetSynchronized() bool {
+	if x != nil {
+		return x.Synchronized
+	}
+	return false
+}
+
+type CollusionPair struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	SessionA             *CollusionSession      `protobuf:"bytes,1,opt,name=session_a,json=sessionA,proto3" json:"session_a,omitempty"`
+	SessionB             *CollusionSession      `protobuf:"bytes,2,opt,name=session_b,json=sessionB,proto3" json:"session_b,omitempty"`
+	CommonItems          int32                  `protobuf:"varint,3,opt,name=common_items,json=commonItems,proto3" json:"common_items,omitempty"`
+	IdenticalAnswers     int32                  `protobuf:"varint,4,opt,name=identical_answers,json=identicalAnswers,proto3" json:"identical_answers,omitempty"`
+	SharedWrong          int32                  `protobuf:"varint,5,opt,name=shared_wrong,json=sharedWrong,proto3" json:"shared_wrong,omitempty"`                // Harpp-Hogan EEIC
+	Differences          int32                  `protobuf:"varint,6,opt,name=differences,proto3" json:"differences,omitempty"`                                   // Harpp-Hogan D
+	HarppHoganRatio      float64                `protobuf:"fixed64,7,opt,name=harpp_hogan_ratio,json=harppHoganRatio,proto3" json:"harpp_hogan_ratio,omitempty"` // EEIC / D
+	ExpectedSharedWrong  float64                `protobuf:"fixed64,8,opt,name=expected_shared_wrong,json=expectedSharedWrong,proto3" json:"expected_shared_wrong,omitempty"`
+	SharedWrongPValue    float64                `protobuf:"fixed64,9,opt,name=shared_wrong_p_value,json=sharedWrongPValue,proto3" json:"shared_wrong_p_value,omitempty"`
+	SynchronizedAnswers  int32                  `protobuf:"varint,10,opt,name=synchronized_answers,json=synchronizedAnswers,proto3" json:"synchronized_answers,omitempty"`
+	SynchronizedRatio    float64                `protobuf:"fixed64,11,opt,name=synchronized_ratio,json=synchronizedRatio,proto3" json:"synchronized_ratio,omitempty"`
+	MedianTimeGapSeconds float64                `protobuf:"fixed64,12,opt,name=median_time_gap_seconds,json=medianTimeGapSeconds,proto3" json:"median_time_gap_seconds,omitempty"`
+	Score                float64                `protobuf:"fixed64,13,opt,name=score,proto3" json:"score,omitempty"`
+	Flagged              bool                   `protobuf:"varint,14,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	Evidence             []*CollusionEvidence   `protobuf:"bytes,15,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CollusionPair) Reset() {
+	*x = CollusionPair{}
+	mi := &file_cbt_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalForStudent) String() string {
+func (x *CollusionPair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalForStudent) ProtoMessage() {}
+func (*CollusionPair) ProtoMessage() {}
 
-func (x *SoalForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[50]
+func (x *CollusionPair) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3778,124 +12834,142 @@ func (x *SoalForStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalForStudent.ProtoReflect.Descriptor instead.
-func (*SoalForStudent) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use CollusionPair.ProtoReflect.Descriptor instead.
+func (*CollusionPair) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *SoalForStudent) GetId() int32 {
+func (x *CollusionPair) GetSessionA() *CollusionSession {
 	if x != nil {
-		return x.Id
+		return x.SessionA
+	}
+	return nil
+}
+
+func (x *CollusionPair) GetSessionB() *CollusionSession {
+	if x != nil {
+		return x.SessionB
+	}
+	return nil
+}
+
+func (x *CollusionPair) GetCommonItems() int32 {
+	if x != nil {
+		return x.CommonItems
 	}
 	return 0
 }
 
-func (x *SoalForStudent) GetNomorUrut() int32 {
+func (x *CollusionPair) GetIdenticalAnswers() int32 {
 	if x != nil {
-		return x.NomorUrut
+		return x.IdenticalAnswers
 	}
 	return 0
 }
 
-func (x *SoalForStudent) GetPertanyaan() string {
+func (x *CollusionPair) GetSharedWrong() int32 {
 	if x != nil {
-		return x.Pertanyaan
+		return x.SharedWrong
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalForStudent) GetOpsiA() string {
+func (x *CollusionPair) GetDifferences() int32 {
 	if x != nil {
-		return x.OpsiA
+		return x.Differences
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalForStudent) GetOpsiB() string {
+func (x *CollusionPair) GetHarppHoganRatio() float64 {
 	if x != nil {
-		return x.OpsiB
+		return x.HarppHoganRatio
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalForStudent) GetOpsiC() string {
+func (x *CollusionPair) GetExpectedSharedWrong() float64 {
 	if x != nil {
-		return x.OpsiC
+		return x.ExpectedSharedWrong
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalForStudent) GetOpsiD() string {
+func (x *CollusionPair) GetSharedWrongPValue() float64 {
 	if x != nil {
-		return x.OpsiD
+		return x.SharedWrongPValue
 	}
-	return ""
+	return 0
 }
 
-func (x *SoalForStudent) GetJawabanDipilih() JawabanOption {
+func (x *CollusionPair) GetSynchronizedAnswers() int32 {
 	if x != nil {
-		return x.JawabanDipilih
+		return x.SynchronizedAnswers
 	}
-	return JawabanOption_JAWABAN_INVALID
+	return 0
 }
 
-func (x *SoalForStudent) GetIsAnswered() bool {
+func (x *CollusionPair) GetSynchronizedRatio() float64 {
 	if x != nil {
-		return x.IsAnswered
+		return x.SynchronizedRatio
 	}
-	return false
+	return 0
 }
 
-func (x *SoalForStudent) GetMateri() *Materi {
+func (x *CollusionPair) GetMedianTimeGapSeconds() float64 {
 	if x != nil {
-		return x.Materi
+		return x.MedianTimeGapSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *SoalForStudent) GetGambar() []*SoalGambar {
+func (x *CollusionPair) GetScore() float64 {
 	if x != nil {
-		return x.Gambar
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CollusionPair) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *CollusionPair) GetEvidence() []*CollusionEvidence {
+	if x != nil {
+		return x.Evidence
 	}
 	return nil
 }
 
-type CreateSoalRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	IdMateri            int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	IdTingkat           int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Pertanyaan          string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	OpsiA               string                 `protobuf:"bytes,4,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
-	OpsiB               string                 `protobuf:"bytes,5,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
-	OpsiC               string                 `protobuf:"bytes,6,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
-	OpsiD               string                 `protobuf:"bytes,7,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
-	JawabanBenar        JawabanOption          `protobuf:"varint,8,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
-	Pembahasan          string                 `protobuf:"bytes,9,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	ImageBytes          [][]byte               `protobuf:"bytes,10,rep,name=image_bytes,json=imageBytes,proto3" json:"image_bytes,omitempty"`
-	LmsClassId          int64                  `protobuf:"varint,11,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope for the question
-	QuestionType        QuestionType           `protobuf:"varint,12,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
-	JawabanBenarComplex []JawabanOption        `protobuf:"varint,13,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
-	Point               float64                `protobuf:"fixed64,14,opt,name=point,proto3" json:"point,omitempty"`
-	Urutan              int32                  `protobuf:"varint,15,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type CollusionReportResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	SessionCount    int32                  `protobuf:"varint,2,opt,name=session_count,json=sessionCount,proto3" json:"session_count,omitempty"`
+	PairsAnalyzed   int32                  `protobuf:"varint,3,opt,name=pairs_analyzed,json=pairsAnalyzed,proto3" json:"pairs_analyzed,omitempty"`
+	Pairs           []*CollusionPair       `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	GeneratedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateSoalRequest) Reset() {
-	*x = CreateSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[51]
+func (x *CollusionReportResponse) Reset() {
+	*x = CollusionReportResponse{}
+	mi := &file_cbt_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSoalRequest) String() string {
+func (x *CollusionReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSoalRequest) ProtoMessage() {}
+func (*CollusionReportResponse) ProtoMessage() {}
 
-func (x *CreateSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[51]
+func (x *CollusionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3906,196 +12980,270 @@ func (x *CreateSoalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSoalRequest.ProtoReflect.Descriptor instead.
-func (*CreateSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use CollusionReportResponse.ProtoReflect.Descriptor instead.
+func (*CollusionReportResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *CreateSoalRequest) GetIdMateri() int32 {
+func (x *CollusionReportResponse) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.IdMateri
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *CreateSoalRequest) GetIdTingkat() int32 {
+func (x *CollusionReportResponse) GetSessionCount() int32 {
 	if x != nil {
-		return x.IdTingkat
+		return x.SessionCount
 	}
 	return 0
 }
 
-func (x *CreateSoalRequest) GetPertanyaan() string {
+func (x *CollusionReportResponse) GetPairsAnalyzed() int32 {
 	if x != nil {
-		return x.Pertanyaan
+		return x.PairsAnalyzed
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSoalRequest) GetOpsiA() string {
+func (x *CollusionReportResponse) GetPairs() []*CollusionPair {
 	if x != nil {
-		return x.OpsiA
+		return x.Pairs
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSoalRequest) GetOpsiB() string {
+func (x *CollusionReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OpsiB
+		return x.GeneratedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSoalRequest) GetOpsiC() string {
+type RunEssaySimilarityCheckRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal          int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"` // Optional, 0 = all essay questions of the assignment
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RunEssaySimilarityCheckRequest) Reset() {
+	*x = RunEssaySimilarityCheckRequest{}
+	mi := &file_cbt_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunEssaySimilarityCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunEssaySimilarityCheckRequest) ProtoMessage() {}
+
+func (x *RunEssaySimilarityCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[169]
 	if x != nil {
-		return x.OpsiC
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSoalRequest) GetOpsiD() string {
+// Deprecated: Use RunEssaySimilarityCheckRequest.ProtoReflect.Descriptor instead.
+func (*RunEssaySimilarityCheckRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *RunEssaySimilarityCheckRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.OpsiD
+		return x.LmsAssignmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSoalRequest) GetJawabanBenar() JawabanOption {
+func (x *RunEssaySimilarityCheckRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.JawabanBenar
+		return x.IdSoal
 	}
-	return JawabanOption_JAWABAN_INVALID
+	return 0
 }
 
-func (x *CreateSoalRequest) GetPembahasan() string {
+type EssaySimilarityRunResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId   int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal            int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	AnswersCompared   int32                  `protobuf:"varint,3,opt,name=answers_compared,json=answersCompared,proto3" json:"answers_compared,omitempty"`
+	PairsCompared     int32                  `protobuf:"varint,4,opt,name=pairs_compared,json=pairsCompared,proto3" json:"pairs_compared,omitempty"`
+	SimilaritiesSaved int32                  `protobuf:"varint,5,opt,name=similarities_saved,json=similaritiesSaved,proto3" json:"similarities_saved,omitempty"`
+	FlaggedAnswers    int32                  `protobuf:"varint,6,opt,name=flagged_answers,json=flaggedAnswers,proto3" json:"flagged_answers,omitempty"`
+	ComputedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EssaySimilarityRunResponse) Reset() {
+	*x = EssaySimilarityRunResponse{}
+	mi := &file_cbt_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EssaySimilarityRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EssaySimilarityRunResponse) ProtoMessage() {}
+
+func (x *EssaySimilarityRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[170]
 	if x != nil {
-		return x.Pembahasan
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSoalRequest) GetImageBytes() [][]byte {
+// Deprecated: Use EssaySimilarityRunResponse.ProtoReflect.Descriptor instead.
+func (*EssaySimilarityRunResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *EssaySimilarityRunResponse) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.ImageBytes
+		return x.LmsAssignmentId
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateSoalRequest) GetLmsClassId() int64 {
+func (x *EssaySimilarityRunResponse) GetIdSoal() int32 {
 	if x != nil {
-		return x.LmsClassId
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *CreateSoalRequest) GetQuestionType() QuestionType {
+func (x *EssaySimilarityRunResponse) GetAnswersCompared() int32 {
 	if x != nil {
-		return x.QuestionType
+		return x.AnswersCompared
 	}
-	return QuestionType_QUESTION_TYPE_INVALID
+	return 0
 }
 
-func (x *CreateSoalRequest) GetJawabanBenarComplex() []JawabanOption {
+func (x *EssaySimilarityRunResponse) GetPairsCompared() int32 {
 	if x != nil {
-		return x.JawabanBenarComplex
+		return x.PairsCompared
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateSoalRequest) GetPoint() float64 {
+func (x *EssaySimilarityRunResponse) GetSimilaritiesSaved() int32 {
 	if x != nil {
-		return x.Point
+		return x.SimilaritiesSaved
 	}
 	return 0
 }
 
-func (x *CreateSoalRequest) GetUrutan() int32 {
+func (x *EssaySimilarityRunResponse) GetFlaggedAnswers() int32 {
 	if x != nil {
-		return x.Urutan
+		return x.FlaggedAnswers
 	}
 	return 0
 }
 
-type GetSoalRequest struct {
+func (x *EssaySimilarityRunResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetEssayGradingViewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnswerId      int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSoalRequest) Reset() {
-	*x = GetSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[52]
+func (x *GetEssayGradingViewRequest) Reset() {
+	*x = GetEssayGradingViewRequest{}
+	mi := &file_cbt_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSoalRequest) String() string {
+func (x *GetEssayGradingViewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSoalRequest) ProtoMessage() {}
+func (*GetEssayGradingViewRequest) ProtoMessage() {}
 
-func (x *GetSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[52]
+func (x *GetEssayGradingViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
 		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetSoalRequest.ProtoReflect.Descriptor instead.
-func (*GetSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *GetSoalRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type UpdateSoalRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdMateri            int32                  `protobuf:"varint,2,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	IdTingkat           int32                  `protobuf:"varint,3,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Pertanyaan          string                 `protobuf:"bytes,4,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	OpsiA               string                 `protobuf:"bytes,5,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
-	OpsiB               string                 `protobuf:"bytes,6,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
-	OpsiC               string                 `protobuf:"bytes,7,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
-	OpsiD               string                 `protobuf:"bytes,8,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
-	JawabanBenar        JawabanOption          `protobuf:"varint,9,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
-	Pembahasan          string                 `protobuf:"bytes,10,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	ImageBytes          [][]byte               `protobuf:"bytes,11,rep,name=image_bytes,json=imageBytes,proto3" json:"image_bytes,omitempty"`
-	QuestionType        QuestionType           `protobuf:"varint,12,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
-	JawabanBenarComplex []JawabanOption        `protobuf:"varint,13,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
-	Point               float64                `protobuf:"fixed64,14,opt,name=point,proto3" json:"point,omitempty"`
-	Urutan              int32                  `protobuf:"varint,15,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSoalRequest) Reset() {
-	*x = UpdateSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[53]
+// Deprecated: Use GetEssayGradingViewRequest.ProtoReflect.Descriptor instead.
+func (*GetEssayGradingViewRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetEssayGradingViewRequest) GetAnswerId() int32 {
+	if x != nil {
+		return x.AnswerId
+	}
+	return 0
+}
+
+type EssayAnswerForGrading struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AnswerId        int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	IdTestSession   int32                  `protobuf:"varint,2,opt,name=id_test_session,json=idTestSession,proto3" json:"id_test_session,omitempty"`
+	SessionToken    string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	UserId          int32                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NamaPeserta     string                 `protobuf:"bytes,5,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,6,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal          int32                  `protobuf:"varint,7,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	Pertanyaan      string                 `protobuf:"bytes,8,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
+	JawabanEssayKey string                 `protobuf:"bytes,9,opt,name=jawaban_essay_key,json=jawabanEssayKey,proto3" json:"jawaban_essay_key,omitempty"`
+	JawabanEssay    string                 `protobuf:"bytes,10,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
+	NilaiEssay      float64                `protobuf:"fixed64,11,opt,name=nilai_essay,json=nilaiEssay,proto3" json:"nilai_essay,omitempty"`
+	IsGraded        bool                   `protobuf:"varint,12,opt,name=is_graded,json=isGraded,proto3" json:"is_graded,omitempty"`
+	FeedbackTeacher string                 `protobuf:"bytes,13,opt,name=feedback_teacher,json=feedbackTeacher,proto3" json:"feedback_teacher,omitempty"`
+	DijawabPada     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	LmsClassId      int64                  `protobuf:"varint,15,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EssayAnswerForGrading) Reset() {
+	*x = EssayAnswerForGrading{}
+	mi := &file_cbt_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSoalRequest) String() string {
+func (x *EssayAnswerForGrading) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSoalRequest) ProtoMessage() {}
+func (*EssayAnswerForGrading) ProtoMessage() {}
 
-func (x *UpdateSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[53]
+func (x *EssayAnswerForGrading) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4106,139 +13254,144 @@ func (x *UpdateSoalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSoalRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use EssayAnswerForGrading.ProtoReflect.Descriptor instead.
+func (*EssayAnswerForGrading) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *UpdateSoalRequest) GetId() int32 {
+func (x *EssayAnswerForGrading) GetAnswerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.AnswerId
 	}
 	return 0
 }
 
-func (x *UpdateSoalRequest) GetIdMateri() int32 {
+func (x *EssayAnswerForGrading) GetIdTestSession() int32 {
 	if x != nil {
-		return x.IdMateri
+		return x.IdTestSession
 	}
 	return 0
 }
 
-func (x *UpdateSoalRequest) GetIdTingkat() int32 {
+func (x *EssayAnswerForGrading) GetSessionToken() string {
 	if x != nil {
-		return x.IdTingkat
+		return x.SessionToken
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateSoalRequest) GetPertanyaan() string {
+func (x *EssayAnswerForGrading) GetUserId() int32 {
 	if x != nil {
-		return x.Pertanyaan
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSoalRequest) GetOpsiA() string {
+func (x *EssayAnswerForGrading) GetNamaPeserta() string {
 	if x != nil {
-		return x.OpsiA
+		return x.NamaPeserta
 	}
 	return ""
 }
 
-func (x *UpdateSoalRequest) GetOpsiB() string {
+func (x *EssayAnswerForGrading) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.OpsiB
+		return x.LmsAssignmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSoalRequest) GetOpsiC() string {
+func (x *EssayAnswerForGrading) GetIdSoal() int32 {
 	if x != nil {
-		return x.OpsiC
+		return x.IdSoal
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateSoalRequest) GetOpsiD() string {
+func (x *EssayAnswerForGrading) GetPertanyaan() string {
 	if x != nil {
-		return x.OpsiD
+		return x.Pertanyaan
 	}
 	return ""
 }
 
-func (x *UpdateSoalRequest) GetJawabanBenar() JawabanOption {
+func (x *EssayAnswerForGrading) GetJawabanEssayKey() string {
 	if x != nil {
-		return x.JawabanBenar
+		return x.JawabanEssayKey
 	}
-	return JawabanOption_JAWABAN_INVALID
+	return ""
 }
 
-func (x *UpdateSoalRequest) GetPembahasan() string {
+func (x *EssayAnswerForGrading) GetJawabanEssay() string {
 	if x != nil {
-		return x.Pembahasan
+		return x.JawabanEssay
 	}
 	return ""
 }
 
-func (x *UpdateSoalRequest) GetImageBytes() [][]byte {
+func (x *EssayAnswerForGrading) GetNilaiEssay() float64 {
 	if x != nil {
-		return x.ImageBytes
+		return x.NilaiEssay
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateSoalRequest) GetQuestionType() QuestionType {
+func (x *EssayAnswerForGrading) GetIsGraded() bool {
 	if x != nil {
-		return x.QuestionType
+		return x.IsGraded
 	}
-	return QuestionType_QUESTION_TYPE_INVALID
+	return false
 }
 
-func (x *UpdateSoalRequest) GetJawabanBenarComplex() []JawabanOption {
+func (x *EssayAnswerForGrading) GetFeedbackTeacher() string {
 	if x != nil {
-		return x.JawabanBenarComplex
+		return x.FeedbackTeacher
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateSoalRequest) GetPoint() float64 {
+func (x *EssayAnswerForGrading) GetDijawabPada() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Point
+		return x.DijawabPada
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateSoalRequest) GetUrutan() int32 {
+func (x *EssayAnswerForGrading) GetLmsClassId() int64 {
 	if x != nil {
-		return x.Urutan
+		return x.LmsClassId
 	}
 	return 0
 }
 
-type SoalOrderItem struct {
+type MatchedPassage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	MatchedText   string                 `protobuf:"bytes,4,opt,name=matched_text,json=matchedText,proto3" json:"matched_text,omitempty"`
+	MatchedStart  int32                  `protobuf:"varint,5,opt,name=matched_start,json=matchedStart,proto3" json:"matched_start,omitempty"`
+	MatchedEnd    int32                  `protobuf:"varint,6,opt,name=matched_end,json=matchedEnd,proto3" json:"matched_end,omitempty"`
+	Words         int32                  `protobuf:"varint,7,opt,name=words,proto3" json:"words,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SoalOrderItem) Reset() {
-	*x = SoalOrderItem{}
-	mi := &file_cbt_proto_msgTypes[54]
+func (x *MatchedPassage) Reset() {
+	*x = MatchedPassage{}
+	mi := &file_cbt_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalOrderItem) String() string {
+func (x *MatchedPassage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalOrderItem) ProtoMessage() {}
+func (*MatchedPassage) ProtoMessage() {}
 
-func (x *SoalOrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[54]
+func (x *MatchedPassage) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4249,143 +13402,89 @@ func (x *SoalOrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalOrderItem.ProtoReflect.Descriptor instead.
-func (*SoalOrderItem) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use MatchedPassage.ProtoReflect.Descriptor instead.
+func (*MatchedPassage) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *SoalOrderItem) GetId() int32 {
+func (x *MatchedPassage) GetText() string {
 	if x != nil {
-		return x.Id
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *SoalOrderItem) GetUrutan() int32 {
+func (x *MatchedPassage) GetStart() int32 {
 	if x != nil {
-		return x.Urutan
+		return x.Start
 	}
 	return 0
 }
 
-type ReorderSoalRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	Items         []*SoalOrderItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ReorderSoalRequest) Reset() {
-	*x = ReorderSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[55]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ReorderSoalRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReorderSoalRequest) ProtoMessage() {}
-
-func (x *ReorderSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[55]
+func (x *MatchedPassage) GetEnd() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReorderSoalRequest.ProtoReflect.Descriptor instead.
-func (*ReorderSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{55}
+	return 0
 }
 
-func (x *ReorderSoalRequest) GetIdMateri() int32 {
+func (x *MatchedPassage) GetMatchedText() string {
 	if x != nil {
-		return x.IdMateri
+		return x.MatchedText
 	}
-	return 0
+	return ""
 }
 
-func (x *ReorderSoalRequest) GetItems() []*SoalOrderItem {
+func (x *MatchedPassage) GetMatchedStart() int32 {
 	if x != nil {
-		return x.Items
+		return x.MatchedStart
 	}
-	return nil
-}
-
-type DeleteSoalRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteSoalRequest) Reset() {
-	*x = DeleteSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteSoalRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*DeleteSoalRequest) ProtoMessage() {}
-
-func (x *DeleteSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[56]
+func (x *MatchedPassage) GetMatchedEnd() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MatchedEnd
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteSoalRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{56}
+	return 0
 }
 
-func (x *DeleteSoalRequest) GetId() int32 {
+func (x *MatchedPassage) GetWords() int32 {
 	if x != nil {
-		return x.Id
+		return x.Words
 	}
 	return 0
 }
 
-type SoalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Soal          *SoalFull              `protobuf:"bytes,1,opt,name=soal,proto3" json:"soal,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EssaySimilarity struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Source              string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // peer | answer_key
+	MatchedAnswerId     int32                  `protobuf:"varint,2,opt,name=matched_answer_id,json=matchedAnswerId,proto3" json:"matched_answer_id,omitempty"`
+	MatchedSessionToken string                 `protobuf:"bytes,3,opt,name=matched_session_token,json=matchedSessionToken,proto3" json:"matched_session_token,omitempty"`
+	MatchedNamaPeserta  string                 `protobuf:"bytes,4,opt,name=matched_nama_peserta,json=matchedNamaPeserta,proto3" json:"matched_nama_peserta,omitempty"`
+	Similarity          float64                `protobuf:"fixed64,5,opt,name=similarity,proto3" json:"similarity,omitempty"`   // Jaccard over 3-word shingles
+	Containment         float64                `protobuf:"fixed64,6,opt,name=containment,proto3" json:"containment,omitempty"` // Share of this answer found in the matched text
+	MatchedPassages     []*MatchedPassage      `protobuf:"bytes,7,rep,name=matched_passages,json=matchedPassages,proto3" json:"matched_passages,omitempty"`
+	ComputedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *SoalResponse) Reset() {
-	*x = SoalResponse{}
-	mi := &file_cbt_proto_msgTypes[57]
+func (x *EssaySimilarity) Reset() {
+	*x = EssaySimilarity{}
+	mi := &file_cbt_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalResponse) String() string {
+func (x *EssaySimilarity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalResponse) ProtoMessage() {}
+func (*EssaySimilarity) ProtoMessage() {}
 
-func (x *SoalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[57]
+func (x *EssaySimilarity) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4396,117 +13495,93 @@ func (x *SoalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalResponse.ProtoReflect.Descriptor instead.
-func (*SoalResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use EssaySimilarity.ProtoReflect.Descriptor instead.
+func (*EssaySimilarity) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *SoalResponse) GetSoal() *SoalFull {
+func (x *EssaySimilarity) GetSource() string {
 	if x != nil {
-		return x.Soal
+		return x.Source
 	}
-	return nil
-}
-
-type ListSoalRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	IdMateri        int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	IdTingkat       int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	IdMataPelajaran int32                  `protobuf:"varint,3,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	LmsClassId      int64                  `protobuf:"varint,5,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Filter by class
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *ListSoalRequest) Reset() {
-	*x = ListSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSoalRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListSoalRequest) ProtoMessage() {}
-
-func (x *ListSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[58]
+func (x *EssaySimilarity) GetMatchedAnswerId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MatchedAnswerId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListSoalRequest.ProtoReflect.Descriptor instead.
-func (*ListSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{58}
+func (x *EssaySimilarity) GetMatchedSessionToken() string {
+	if x != nil {
+		return x.MatchedSessionToken
+	}
+	return ""
 }
 
-func (x *ListSoalRequest) GetIdMateri() int32 {
+func (x *EssaySimilarity) GetMatchedNamaPeserta() string {
 	if x != nil {
-		return x.IdMateri
+		return x.MatchedNamaPeserta
 	}
-	return 0
+	return ""
 }
 
-func (x *ListSoalRequest) GetIdTingkat() int32 {
+func (x *EssaySimilarity) GetSimilarity() float64 {
 	if x != nil {
-		return x.IdTingkat
+		return x.Similarity
 	}
 	return 0
 }
 
-func (x *ListSoalRequest) GetIdMataPelajaran() int32 {
+func (x *EssaySimilarity) GetContainment() float64 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.Containment
 	}
 	return 0
 }
 
-func (x *ListSoalRequest) GetPagination() *PaginationRequest {
+func (x *EssaySimilarity) GetMatchedPassages() []*MatchedPassage {
 	if x != nil {
-		return x.Pagination
+		return x.MatchedPassages
 	}
 	return nil
 }
 
-func (x *ListSoalRequest) GetLmsClassId() int64 {
+func (x *EssaySimilarity) GetComputedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LmsClassId
+		return x.ComputedAt
 	}
-	return 0
+	return nil
 }
 
-type ListSoalResponse struct {
+type EssayGradingViewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Soal          []*SoalFull            `protobuf:"bytes,1,rep,name=soal,proto3" json:"soal,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Answer        *EssayAnswerForGrading `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	Similarities  []*EssaySimilarity     `protobuf:"bytes,2,rep,name=similarities,proto3" json:"similarities,omitempty"`
+	Rubric        *EssayRubric           `protobuf:"bytes,3,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	RubricScores  []*RubricScore         `protobuf:"bytes,4,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	Suggestion    *ScoreSuggestion       `protobuf:"bytes,5,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSoalResponse) Reset() {
-	*x = ListSoalResponse{}
-	mi := &file_cbt_proto_msgTypes[59]
+func (x *EssayGradingViewResponse) Reset() {
+	*x = EssayGradingViewResponse{}
+	mi := &file_cbt_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSoalResponse) String() string {
+func (x *EssayGradingViewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSoalResponse) ProtoMessage() {}
+func (*EssayGradingViewResponse) ProtoMessage() {}
 
-func (x *ListSoalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[59]
+func (x *EssayGradingViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4517,51 +13592,72 @@ func (x *ListSoalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSoalResponse.ProtoReflect.Descriptor instead.
-func (*ListSoalResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use EssayGradingViewResponse.ProtoReflect.Descriptor instead.
+func (*EssayGradingViewResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListSoalResponse) GetSoal() []*SoalFull {
+func (x *EssayGradingViewResponse) GetAnswer() *EssayAnswerForGrading {
 	if x != nil {
-		return x.Soal
+		return x.Answer
 	}
 	return nil
 }
 
-func (x *ListSoalResponse) GetPagination() *PaginationResponse {
+func (x *EssayGradingViewResponse) GetSimilarities() []*EssaySimilarity {
 	if x != nil {
-		return x.Pagination
+		return x.Similarities
 	}
 	return nil
 }
 
-type UploadImageToSoalRequest struct {
+func (x *EssayGradingViewResponse) GetRubric() *EssayRubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *EssayGradingViewResponse) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *EssayGradingViewResponse) GetSuggestion() *ScoreSuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+type RubricLevel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
-	ImageBytes    []byte                 `protobuf:"bytes,2,opt,name=image_bytes,json=imageBytes,proto3" json:"image_bytes,omitempty"`
-	NamaFile      string                 `protobuf:"bytes,3,opt,name=nama_file,json=namaFile,proto3" json:"nama_file,omitempty"`
-	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	Keterangan    string                 `protobuf:"bytes,5,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Deskripsi     string                 `protobuf:"bytes,3,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
+	Point         float64                `protobuf:"fixed64,4,opt,name=point,proto3" json:"point,omitempty"`
+	Urutan        int32                  `protobuf:"varint,5,opt,name=urutan,proto3" json:"urutan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageToSoalRequest) Reset() {
-	*x = UploadImageToSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[60]
+func (x *RubricLevel) Reset() {
+	*x = RubricLevel{}
+	mi := &file_cbt_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageToSoalRequest) String() string {
+func (x *RubricLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageToSoalRequest) ProtoMessage() {}
+func (*RubricLevel) ProtoMessage() {}
 
-func (x *UploadImageToSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[60]
+func (x *RubricLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4572,68 +13668,73 @@ func (x *UploadImageToSoalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageToSoalRequest.ProtoReflect.Descriptor instead.
-func (*UploadImageToSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use RubricLevel.ProtoReflect.Descriptor instead.
+func (*RubricLevel) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *UploadImageToSoalRequest) GetIdSoal() int32 {
+func (x *RubricLevel) GetId() int64 {
 	if x != nil {
-		return x.IdSoal
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UploadImageToSoalRequest) GetImageBytes() []byte {
+func (x *RubricLevel) GetLabel() string {
 	if x != nil {
-		return x.ImageBytes
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadImageToSoalRequest) GetNamaFile() string {
+func (x *RubricLevel) GetDeskripsi() string {
 	if x != nil {
-		return x.NamaFile
+		return x.Deskripsi
 	}
 	return ""
 }
 
-func (x *UploadImageToSoalRequest) GetUrutan() int32 {
+func (x *RubricLevel) GetPoint() float64 {
 	if x != nil {
-		return x.Urutan
+		return x.Point
 	}
 	return 0
 }
 
-func (x *UploadImageToSoalRequest) GetKeterangan() string {
+func (x *RubricLevel) GetUrutan() int32 {
 	if x != nil {
-		return x.Keterangan
+		return x.Urutan
 	}
-	return ""
+	return 0
 }
 
-type UploadImageResponse struct {
+type RubricCriterion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Gambar        *SoalGambar            `protobuf:"bytes,1,opt,name=gambar,proto3" json:"gambar,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nama          string                 `protobuf:"bytes,2,opt,name=nama,proto3" json:"nama,omitempty"`
+	Deskripsi     string                 `protobuf:"bytes,3,opt,name=deskripsi,proto3" json:"deskripsi,omitempty"`
+	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Levels        []*RubricLevel         `protobuf:"bytes,5,rep,name=levels,proto3" json:"levels,omitempty"`
+	MaxPoint      float64                `protobuf:"fixed64,6,opt,name=max_point,json=maxPoint,proto3" json:"max_point,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageResponse) Reset() {
-	*x = UploadImageResponse{}
-	mi := &file_cbt_proto_msgTypes[61]
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_cbt_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageResponse) String() string {
+func (x *RubricCriterion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageResponse) ProtoMessage() {}
+func (*RubricCriterion) ProtoMessage() {}
 
-func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[61]
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4644,86 +13745,79 @@ func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
-func (*UploadImageResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *UploadImageResponse) GetGambar() *SoalGambar {
+func (x *RubricCriterion) GetId() int64 {
 	if x != nil {
-		return x.Gambar
+		return x.Id
 	}
-	return nil
-}
-
-type DeleteImageFromSoalRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdGambar      int32                  `protobuf:"varint,1,opt,name=id_gambar,json=idGambar,proto3" json:"id_gambar,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *DeleteImageFromSoalRequest) Reset() {
-	*x = DeleteImageFromSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[62]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RubricCriterion) GetNama() string {
+	if x != nil {
+		return x.Nama
+	}
+	return ""
 }
 
-func (x *DeleteImageFromSoalRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RubricCriterion) GetDeskripsi() string {
+	if x != nil {
+		return x.Deskripsi
+	}
+	return ""
 }
 
-func (*DeleteImageFromSoalRequest) ProtoMessage() {}
-
-func (x *DeleteImageFromSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[62]
+func (x *RubricCriterion) GetUrutan() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Urutan
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteImageFromSoalRequest.ProtoReflect.Descriptor instead.
-func (*DeleteImageFromSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{62}
+func (x *RubricCriterion) GetLevels() []*RubricLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
 }
 
-func (x *DeleteImageFromSoalRequest) GetIdGambar() int32 {
+func (x *RubricCriterion) GetMaxPoint() float64 {
 	if x != nil {
-		return x.IdGambar
+		return x.MaxPoint
 	}
 	return 0
 }
 
-type UpdateImageInSoalRequest struct {
+type EssayRubric struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdGambar      int32                  `protobuf:"varint,1,opt,name=id_gambar,json=idGambar,proto3" json:"id_gambar,omitempty"`
-	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	Keterangan    string                 `protobuf:"bytes,3,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	PassingScore  float64                `protobuf:"fixed64,2,opt,name=passing_score,json=passingScore,proto3" json:"passing_score,omitempty"` // nilai_essay needed to count as correct
+	MaxPoint      float64                `protobuf:"fixed64,3,opt,name=max_point,json=maxPoint,proto3" json:"max_point,omitempty"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateImageInSoalRequest) Reset() {
-	*x = UpdateImageInSoalRequest{}
-	mi := &file_cbt_proto_msgTypes[63]
+func (x *EssayRubric) Reset() {
+	*x = EssayRubric{}
+	mi := &file_cbt_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateImageInSoalRequest) String() string {
+func (x *EssayRubric) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateImageInSoalRequest) ProtoMessage() {}
+func (*EssayRubric) ProtoMessage() {}
 
-func (x *UpdateImageInSoalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[63]
+func (x *EssayRubric) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4734,58 +13828,70 @@ func (x *UpdateImageInSoalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateImageInSoalRequest.ProtoReflect.Descriptor instead.
-func (*UpdateImageInSoalRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use EssayRubric.ProtoReflect.Descriptor instead.
+func (*EssayRubric) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *UpdateImageInSoalRequest) GetIdGambar() int32 {
+func (x *EssayRubric) GetIdSoal() int32 {
 	if x != nil {
-		return x.IdGambar
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *UpdateImageInSoalRequest) GetUrutan() int32 {
+func (x *EssayRubric) GetPassingScore() float64 {
 	if x != nil {
-		return x.Urutan
+		return x.PassingScore
 	}
 	return 0
 }
 
-func (x *UpdateImageInSoalRequest) GetKeterangan() string {
+func (x *EssayRubric) GetMaxPoint() float64 {
 	if x != nil {
-		return x.Keterangan
+		return x.MaxPoint
 	}
-	return ""
+	return 0
 }
 
-// Draggable item
-type DragItem struct {
+func (x *EssayRubric) GetCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *EssayRubric) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetEssayRubricRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	PassingScore  float64                `protobuf:"fixed64,2,opt,name=passing_score,json=passingScore,proto3" json:"passing_score,omitempty"` // 0 = default 60
+	Criteria      []*RubricCriterion     `protobuf:"bytes,3,rep,name=criteria,proto3" json:"criteria,omitempty"`                               // Empty list removes the rubric
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DragItem) Reset() {
-	*x = DragItem{}
-	mi := &file_cbt_proto_msgTypes[64]
+func (x *SetEssayRubricRequest) Reset() {
+	*x = SetEssayRubricRequest{}
+	mi := &file_cbt_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DragItem) String() string {
+func (x *SetEssayRubricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DragItem) ProtoMessage() {}
+func (*SetEssayRubricRequest) ProtoMessage() {}
 
-func (x *DragItem) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[64]
+func (x *SetEssayRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4796,65 +13902,54 @@ func (x *DragItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DragItem.ProtoReflect.Descriptor instead.
-func (*DragItem) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use SetEssayRubricRequest.ProtoReflect.Descriptor instead.
+func (*SetEssayRubricRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *DragItem) GetId() int32 {
+func (x *SetEssayRubricRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.Id
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *DragItem) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *DragItem) GetImageUrl() string {
+func (x *SetEssayRubricRequest) GetPassingScore() float64 {
 	if x != nil {
-		return x.ImageUrl
+		return x.PassingScore
 	}
-	return ""
+	return 0
 }
 
-func (x *DragItem) GetUrutan() int32 {
+func (x *SetEssayRubricRequest) GetCriteria() []*RubricCriterion {
 	if x != nil {
-		return x.Urutan
+		return x.Criteria
 	}
-	return 0
+	return nil
 }
 
-// Drop zone/slot
-type DragSlot struct {
+type GetEssayRubricRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Urutan        int32                  `protobuf:"varint,4,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DragSlot) Reset() {
-	*x = DragSlot{}
-	mi := &file_cbt_proto_msgTypes[65]
+func (x *GetEssayRubricRequest) Reset() {
+	*x = GetEssayRubricRequest{}
+	mi := &file_cbt_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DragSlot) String() string {
+func (x *GetEssayRubricRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DragSlot) ProtoMessage() {}
+func (*GetEssayRubricRequest) ProtoMessage() {}
 
-func (x *DragSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[65]
+func (x *GetEssayRubricRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4865,63 +13960,40 @@ func (x *DragSlot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DragSlot.ProtoReflect.Descriptor instead.
-func (*DragSlot) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *DragSlot) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *DragSlot) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *DragSlot) GetImageUrl() string {
-	if x != nil {
-		return x.ImageUrl
-	}
-	return ""
+// Deprecated: Use GetEssayRubricRequest.ProtoReflect.Descriptor instead.
+func (*GetEssayRubricRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *DragSlot) GetUrutan() int32 {
+func (x *GetEssayRubricRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.Urutan
+		return x.IdSoal
 	}
 	return 0
 }
 
-// Correct answer mapping (item -> slot)
-type DragCorrectAnswer struct {
+type EssayRubricResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	SlotId        int32                  `protobuf:"varint,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	Rubric        *EssayRubric           `protobuf:"bytes,1,opt,name=rubric,proto3" json:"rubric,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DragCorrectAnswer) Reset() {
-	*x = DragCorrectAnswer{}
-	mi := &file_cbt_proto_msgTypes[66]
+func (x *EssayRubricResponse) Reset() {
+	*x = EssayRubricResponse{}
+	mi := &file_cbt_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DragCorrectAnswer) String() string {
+func (x *EssayRubricResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DragCorrectAnswer) ProtoMessage() {}
+func (*EssayRubricResponse) ProtoMessage() {}
 
-func (x *DragCorrectAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[66]
+func (x *EssayRubricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4932,49 +14004,42 @@ func (x *DragCorrectAnswer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DragCorrectAnswer.ProtoReflect.Descriptor instead.
-func (*DragCorrectAnswer) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *DragCorrectAnswer) GetItemId() int32 {
-	if x != nil {
-		return x.ItemId
-	}
-	return 0
+// Deprecated: Use EssayRubricResponse.ProtoReflect.Descriptor instead.
+func (*EssayRubricResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *DragCorrectAnswer) GetSlotId() int32 {
+func (x *EssayRubricResponse) GetRubric() *EssayRubric {
 	if x != nil {
-		return x.SlotId
+		return x.Rubric
 	}
-	return 0
+	return nil
 }
 
-// Correct answer by urutan (for create/update requests)
-type DragCorrectAnswerByUrutan struct {
+type RubricSelection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ItemUrutan    int32                  `protobuf:"varint,1,opt,name=item_urutan,json=itemUrutan,proto3" json:"item_urutan,omitempty"`
-	SlotUrutan    int32                  `protobuf:"varint,2,opt,name=slot_urutan,json=slotUrutan,proto3" json:"slot_urutan,omitempty"`
+	CriterionId   int64                  `protobuf:"varint,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	LevelId       int64                  `protobuf:"varint,2,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	Feedback      string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DragCorrectAnswerByUrutan) Reset() {
-	*x = DragCorrectAnswerByUrutan{}
-	mi := &file_cbt_proto_msgTypes[67]
+func (x *RubricSelection) Reset() {
+	*x = RubricSelection{}
+	mi := &file_cbt_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DragCorrectAnswerByUrutan) String() string {
+func (x *RubricSelection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DragCorrectAnswerByUrutan) ProtoMessage() {}
+func (*RubricSelection) ProtoMessage() {}
 
-func (x *DragCorrectAnswerByUrutan) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[67]
+func (x *RubricSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4985,61 +14050,60 @@ func (x *DragCorrectAnswerByUrutan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DragCorrectAnswerByUrutan.ProtoReflect.Descriptor instead.
-func (*DragCorrectAnswerByUrutan) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use RubricSelection.ProtoReflect.Descriptor instead.
+func (*RubricSelection) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *DragCorrectAnswerByUrutan) GetItemUrutan() int32 {
+func (x *RubricSelection) GetCriterionId() int64 {
 	if x != nil {
-		return x.ItemUrutan
+		return x.CriterionId
 	}
 	return 0
 }
 
-func (x *DragCorrectAnswerByUrutan) GetSlotUrutan() int32 {
+func (x *RubricSelection) GetLevelId() int64 {
 	if x != nil {
-		return x.SlotUrutan
+		return x.LevelId
 	}
 	return 0
 }
 
-// Full drag-drop question (admin view with answers)
-type SoalDragDropFull struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Materi         *Materi                `protobuf:"bytes,2,opt,name=materi,proto3" json:"materi,omitempty"`
-	Pertanyaan     string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	DragType       DragDropType           `protobuf:"varint,4,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
-	Items          []*DragItem            `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	Slots          []*DragSlot            `protobuf:"bytes,6,rep,name=slots,proto3" json:"slots,omitempty"`
-	CorrectAnswers []*DragCorrectAnswer   `protobuf:"bytes,7,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
-	Pembahasan     string                 `protobuf:"bytes,8,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	IsActive       bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	LmsClassId     int64                  `protobuf:"varint,12,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	Point          float64                `protobuf:"fixed64,13,opt,name=point,proto3" json:"point,omitempty"`
-	Urutan         int32                  `protobuf:"varint,14,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *RubricSelection) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
 }
 
-func (x *SoalDragDropFull) Reset() {
-	*x = SoalDragDropFull{}
-	mi := &file_cbt_proto_msgTypes[68]
+type RubricScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CriterionId   int64                  `protobuf:"varint,1,opt,name=criterion_id,json=criterionId,proto3" json:"criterion_id,omitempty"`
+	NamaKriteria  string                 `protobuf:"bytes,2,opt,name=nama_kriteria,json=namaKriteria,proto3" json:"nama_kriteria,omitempty"`
+	LevelId       int64                  `protobuf:"varint,3,opt,name=level_id,json=levelId,proto3" json:"level_id,omitempty"`
+	LabelLevel    string                 `protobuf:"bytes,4,opt,name=label_level,json=labelLevel,proto3" json:"label_level,omitempty"`
+	Point         float64                `protobuf:"fixed64,5,opt,name=point,proto3" json:"point,omitempty"`
+	MaxPoint      float64                `protobuf:"fixed64,6,opt,name=max_point,json=maxPoint,proto3" json:"max_point,omitempty"`
+	Feedback      string                 `protobuf:"bytes,7,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricScore) Reset() {
+	*x = RubricScore{}
+	mi := &file_cbt_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalDragDropFull) String() string {
+func (x *RubricScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalDragDropFull) ProtoMessage() {}
+func (*RubricScore) ProtoMessage() {}
 
-func (x *SoalDragDropFull) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[68]
+func (x *RubricScore) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5050,140 +14114,161 @@ func (x *SoalDragDropFull) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalDragDropFull.ProtoReflect.Descriptor instead.
-func (*SoalDragDropFull) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use RubricScore.ProtoReflect.Descriptor instead.
+func (*RubricScore) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *SoalDragDropFull) GetId() int32 {
+func (x *RubricScore) GetCriterionId() int64 {
 	if x != nil {
-		return x.Id
+		return x.CriterionId
 	}
 	return 0
 }
 
-func (x *SoalDragDropFull) GetMateri() *Materi {
-	if x != nil {
-		return x.Materi
-	}
-	return nil
-}
-
-func (x *SoalDragDropFull) GetPertanyaan() string {
+func (x *RubricScore) GetNamaKriteria() string {
 	if x != nil {
-		return x.Pertanyaan
+		return x.NamaKriteria
 	}
 	return ""
 }
 
-func (x *SoalDragDropFull) GetDragType() DragDropType {
+func (x *RubricScore) GetLevelId() int64 {
 	if x != nil {
-		return x.DragType
+		return x.LevelId
 	}
-	return DragDropType_DRAG_TYPE_INVALID
+	return 0
 }
 
-func (x *SoalDragDropFull) GetItems() []*DragItem {
+func (x *RubricScore) GetLabelLevel() string {
 	if x != nil {
-		return x.Items
+		return x.LabelLevel
 	}
-	return nil
+	return ""
 }
 
-func (x *SoalDragDropFull) GetSlots() []*DragSlot {
+func (x *RubricScore) GetPoint() float64 {
 	if x != nil {
-		return x.Slots
+		return x.Point
 	}
-	return nil
+	return 0
 }
 
-func (x *SoalDragDropFull) GetCorrectAnswers() []*DragCorrectAnswer {
+func (x *RubricScore) GetMaxPoint() float64 {
 	if x != nil {
-		return x.CorrectAnswers
+		return x.MaxPoint
 	}
-	return nil
+	return 0
 }
 
-func (x *SoalDragDropFull) GetPembahasan() string {
+func (x *RubricScore) GetFeedback() string {
 	if x != nil {
-		return x.Pembahasan
+		return x.Feedback
 	}
 	return ""
 }
 
-func (x *SoalDragDropFull) GetIsActive() bool {
+type GradingConfig struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId      int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	BlindMode            bool                   `protobuf:"varint,2,opt,name=blind_mode,json=blindMode,proto3" json:"blind_mode,omitempty"`                                   // Hide student identity from teachers while grading
+	DoubleMarking        bool                   `protobuf:"varint,3,opt,name=double_marking,json=doubleMarking,proto3" json:"double_marking,omitempty"`                       // Every essay is marked by two graders
+	DiscrepancyThreshold float64                `protobuf:"fixed64,4,opt,name=discrepancy_threshold,json=discrepancyThreshold,proto3" json:"discrepancy_threshold,omitempty"` // Larger differences between marks go to moderation
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GradingConfig) Reset() {
+	*x = GradingConfig{}
+	mi := &file_cbt_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingConfig) ProtoMessage() {}
+
+func (x *GradingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[184]
 	if x != nil {
-		return x.IsActive
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *SoalDragDropFull) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use GradingConfig.ProtoReflect.Descriptor instead.
+func (*GradingConfig) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *GradingConfig) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.LmsAssignmentId
 	}
-	return nil
+	return 0
 }
 
-func (x *SoalDragDropFull) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GradingConfig) GetBlindMode() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.BlindMode
 	}
-	return nil
+	return false
 }
 
-func (x *SoalDragDropFull) GetLmsClassId() int64 {
+func (x *GradingConfig) GetDoubleMarking() bool {
 	if x != nil {
-		return x.LmsClassId
+		return x.DoubleMarking
 	}
-	return 0
+	return false
 }
 
-func (x *SoalDragDropFull) GetPoint() float64 {
+func (x *GradingConfig) GetDiscrepancyThreshold() float64 {
 	if x != nil {
-		return x.Point
+		return x.DiscrepancyThreshold
 	}
 	return 0
 }
 
-func (x *SoalDragDropFull) GetUrutan() int32 {
+func (x *GradingConfig) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Urutan
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-// Drag-drop for student (no correct answers exposed)
-type SoalDragDropForStudent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	Pertanyaan    string                 `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	DragType      DragDropType           `protobuf:"varint,4,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
-	Items         []*DragItem            `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	Slots         []*DragSlot            `protobuf:"bytes,6,rep,name=slots,proto3" json:"slots,omitempty"`
-	Materi        *Materi                `protobuf:"bytes,7,opt,name=materi,proto3" json:"materi,omitempty"`
-	UserAnswer    map[int32]int32        `protobuf:"bytes,8,rep,name=user_answer,json=userAnswer,proto3" json:"user_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // item_id -> slot_id
-	IsAnswered    bool                   `protobuf:"varint,9,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetGradingConfigRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId      int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	BlindMode            bool                   `protobuf:"varint,2,opt,name=blind_mode,json=blindMode,proto3" json:"blind_mode,omitempty"`
+	DoubleMarking        bool                   `protobuf:"varint,3,opt,name=double_marking,json=doubleMarking,proto3" json:"double_marking,omitempty"`
+	DiscrepancyThreshold float64                `protobuf:"fixed64,4,opt,name=discrepancy_threshold,json=discrepancyThreshold,proto3" json:"discrepancy_threshold,omitempty"` // 0 = default 15
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *SoalDragDropForStudent) Reset() {
-	*x = SoalDragDropForStudent{}
-	mi := &file_cbt_proto_msgTypes[69]
+func (x *SetGradingConfigRequest) Reset() {
+	*x = SetGradingConfigRequest{}
+	mi := &file_cbt_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalDragDropForStudent) String() string {
+func (x *SetGradingConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalDragDropForStudent) ProtoMessage() {}
+func (*SetGradingConfigRequest) ProtoMessage() {}
 
-func (x *SoalDragDropForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[69]
+func (x *SetGradingConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5194,129 +14279,105 @@ func (x *SoalDragDropForStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalDragDropForStudent.ProtoReflect.Descriptor instead.
-func (*SoalDragDropForStudent) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use SetGradingConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetGradingConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *SoalDragDropForStudent) GetId() int32 {
+func (x *SetGradingConfigRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *SoalDragDropForStudent) GetNomorUrut() int32 {
+func (x *SetGradingConfigRequest) GetBlindMode() bool {
 	if x != nil {
-		return x.NomorUrut
+		return x.BlindMode
 	}
-	return 0
+	return false
 }
 
-func (x *SoalDragDropForStudent) GetPertanyaan() string {
+func (x *SetGradingConfigRequest) GetDoubleMarking() bool {
 	if x != nil {
-		return x.Pertanyaan
+		return x.DoubleMarking
 	}
-	return ""
+	return false
 }
 
-func (x *SoalDragDropForStudent) GetDragType() DragDropType {
+func (x *SetGradingConfigRequest) GetDiscrepancyThreshold() float64 {
 	if x != nil {
-		return x.DragType
+		return x.DiscrepancyThreshold
 	}
-	return DragDropType_DRAG_TYPE_INVALID
+	return 0
 }
 
-func (x *SoalDragDropForStudent) GetItems() []*DragItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+type GetGradingConfigRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SoalDragDropForStudent) GetSlots() []*DragSlot {
-	if x != nil {
-		return x.Slots
-	}
-	return nil
+func (x *GetGradingConfigRequest) Reset() {
+	*x = GetGradingConfigRequest{}
+	mi := &file_cbt_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalDragDropForStudent) GetMateri() *Materi {
-	if x != nil {
-		return x.Materi
-	}
-	return nil
+func (x *GetGradingConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SoalDragDropForStudent) GetUserAnswer() map[int32]int32 {
+func (*GetGradingConfigRequest) ProtoMessage() {}
+
+func (x *GetGradingConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[186]
 	if x != nil {
-		return x.UserAnswer
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SoalDragDropForStudent) GetIsAnswered() bool {
-	if x != nil {
-		return x.IsAnswered
-	}
-	return false
+// Deprecated: Use GetGradingConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{186}
 }
 
-// Unified question for mixed test sessions
-type QuestionForStudent struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	NomorUrut    int32                  `protobuf:"varint,1,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	QuestionType QuestionType           `protobuf:"varint,2,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
-	Materi       *Materi                `protobuf:"bytes,3,opt,name=materi,proto3" json:"materi,omitempty"`
-	IsAnswered   bool                   `protobuf:"varint,4,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
-	// Multiple choice fields (only populated when question_type = MULTIPLE_CHOICE)
-	McId             int32         `protobuf:"varint,5,opt,name=mc_id,json=mcId,proto3" json:"mc_id,omitempty"`
-	McPertanyaan     string        `protobuf:"bytes,6,opt,name=mc_pertanyaan,json=mcPertanyaan,proto3" json:"mc_pertanyaan,omitempty"`
-	McOpsiA          string        `protobuf:"bytes,7,opt,name=mc_opsi_a,json=mcOpsiA,proto3" json:"mc_opsi_a,omitempty"`
-	McOpsiB          string        `protobuf:"bytes,8,opt,name=mc_opsi_b,json=mcOpsiB,proto3" json:"mc_opsi_b,omitempty"`
-	McOpsiC          string        `protobuf:"bytes,9,opt,name=mc_opsi_c,json=mcOpsiC,proto3" json:"mc_opsi_c,omitempty"`
-	McOpsiD          string        `protobuf:"bytes,10,opt,name=mc_opsi_d,json=mcOpsiD,proto3" json:"mc_opsi_d,omitempty"`
-	McJawabanDipilih JawabanOption `protobuf:"varint,11,opt,name=mc_jawaban_dipilih,json=mcJawabanDipilih,proto3,enum=base.JawabanOption" json:"mc_jawaban_dipilih,omitempty"`
-	McGambar         []*SoalGambar `protobuf:"bytes,12,rep,name=mc_gambar,json=mcGambar,proto3" json:"mc_gambar,omitempty"`
-	// Drag-drop fields (only populated when question_type = DRAG_DROP)
-	DdId         int32           `protobuf:"varint,13,opt,name=dd_id,json=ddId,proto3" json:"dd_id,omitempty"`
-	DdPertanyaan string          `protobuf:"bytes,14,opt,name=dd_pertanyaan,json=ddPertanyaan,proto3" json:"dd_pertanyaan,omitempty"`
-	DdDragType   DragDropType    `protobuf:"varint,15,opt,name=dd_drag_type,json=ddDragType,proto3,enum=base.DragDropType" json:"dd_drag_type,omitempty"`
-	DdItems      []*DragItem     `protobuf:"bytes,16,rep,name=dd_items,json=ddItems,proto3" json:"dd_items,omitempty"`
-	DdSlots      []*DragSlot     `protobuf:"bytes,17,rep,name=dd_slots,json=ddSlots,proto3" json:"dd_slots,omitempty"`
-	DdUserAnswer map[int32]int32 `protobuf:"bytes,18,rep,name=dd_user_answer,json=ddUserAnswer,proto3" json:"dd_user_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // item_id -> slot_id
-	// Essay fields (only populated when question_type = ESSAY)
-	EssayId         int32  `protobuf:"varint,19,opt,name=essay_id,json=essayId,proto3" json:"essay_id,omitempty"`
-	EssayPertanyaan string `protobuf:"bytes,20,opt,name=essay_pertanyaan,json=essayPertanyaan,proto3" json:"essay_pertanyaan,omitempty"`
-	EssayJawaban    string `protobuf:"bytes,21,opt,name=essay_jawaban,json=essayJawaban,proto3" json:"essay_jawaban,omitempty"`
-	// Multiple choices complex fields (only populated when question_type = MULTIPLE_CHOICES_COMPLEX)
-	MccId             int32           `protobuf:"varint,22,opt,name=mcc_id,json=mccId,proto3" json:"mcc_id,omitempty"`
-	MccPertanyaan     string          `protobuf:"bytes,23,opt,name=mcc_pertanyaan,json=mccPertanyaan,proto3" json:"mcc_pertanyaan,omitempty"`
-	MccOpsiA          string          `protobuf:"bytes,24,opt,name=mcc_opsi_a,json=mccOpsiA,proto3" json:"mcc_opsi_a,omitempty"`
-	MccOpsiB          string          `protobuf:"bytes,25,opt,name=mcc_opsi_b,json=mccOpsiB,proto3" json:"mcc_opsi_b,omitempty"`
-	MccOpsiC          string          `protobuf:"bytes,26,opt,name=mcc_opsi_c,json=mccOpsiC,proto3" json:"mcc_opsi_c,omitempty"`
-	MccOpsiD          string          `protobuf:"bytes,27,opt,name=mcc_opsi_d,json=mccOpsiD,proto3" json:"mcc_opsi_d,omitempty"`
-	MccJawabanDipilih []JawabanOption `protobuf:"varint,28,rep,packed,name=mcc_jawaban_dipilih,json=mccJawabanDipilih,proto3,enum=base.JawabanOption" json:"mcc_jawaban_dipilih,omitempty"`
-	MccGambar         []*SoalGambar   `protobuf:"bytes,29,rep,name=mcc_gambar,json=mccGambar,proto3" json:"mcc_gambar,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetGradingConfigRequest) GetLmsAssignmentId() int64 {
+	if x != nil {
+		return x.LmsAssignmentId
+	}
+	return 0
 }
 
-func (x *QuestionForStudent) Reset() {
-	*x = QuestionForStudent{}
-	mi := &file_cbt_proto_msgTypes[70]
+type GradingConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *GradingConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradingConfigResponse) Reset() {
+	*x = GradingConfigResponse{}
+	mi := &file_cbt_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuestionForStudent) String() string {
+func (x *GradingConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionForStudent) ProtoMessage() {}
+func (*GradingConfigResponse) ProtoMessage() {}
 
-func (x *QuestionForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[70]
+func (x *GradingConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5327,246 +14388,243 @@ func (x *QuestionForStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionForStudent.ProtoReflect.Descriptor instead.
-func (*QuestionForStudent) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use GradingConfigResponse.ProtoReflect.Descriptor instead.
+func (*GradingConfigResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *QuestionForStudent) GetNomorUrut() int32 {
+func (x *GradingConfigResponse) GetConfig() *GradingConfig {
 	if x != nil {
-		return x.NomorUrut
+		return x.Config
 	}
-	return 0
+	return nil
 }
 
-func (x *QuestionForStudent) GetQuestionType() QuestionType {
-	if x != nil {
-		return x.QuestionType
-	}
-	return QuestionType_QUESTION_TYPE_INVALID
+type GradingTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnswerId      int32                  `protobuf:"varint,2,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	GraderId      int32                  `protobuf:"varint,3,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	MarkerSlot    int32                  `protobuf:"varint,4,opt,name=marker_slot,json=markerSlot,proto3" json:"marker_slot,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // assigned, submitted
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"` // Hidden from other graders until the essay is final
+	HasScore      bool                   `protobuf:"varint,7,opt,name=has_score,json=hasScore,proto3" json:"has_score,omitempty"`
+	Feedback      string                 `protobuf:"bytes,8,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuestionForStudent) GetMateri() *Materi {
-	if x != nil {
-		return x.Materi
-	}
-	return nil
+func (x *GradingTask) Reset() {
+	*x = GradingTask{}
+	mi := &file_cbt_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuestionForStudent) GetIsAnswered() bool {
-	if x != nil {
-		return x.IsAnswered
-	}
-	return false
+func (x *GradingTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QuestionForStudent) GetMcId() int32 {
-	if x != nil {
-		return x.McId
-	}
-	return 0
-}
+func (*GradingTask) ProtoMessage() {}
 
-func (x *QuestionForStudent) GetMcPertanyaan() string {
+func (x *GradingTask) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[188]
 	if x != nil {
-		return x.McPertanyaan
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *QuestionForStudent) GetMcOpsiA() string {
-	if x != nil {
-		return x.McOpsiA
-	}
-	return ""
+// Deprecated: Use GradingTask.ProtoReflect.Descriptor instead.
+func (*GradingTask) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *QuestionForStudent) GetMcOpsiB() string {
+func (x *GradingTask) GetId() int64 {
 	if x != nil {
-		return x.McOpsiB
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *QuestionForStudent) GetMcOpsiC() string {
+func (x *GradingTask) GetAnswerId() int32 {
 	if x != nil {
-		return x.McOpsiC
+		return x.AnswerId
 	}
-	return ""
+	return 0
 }
 
-func (x *QuestionForStudent) GetMcOpsiD() string {
+func (x *GradingTask) GetGraderId() int32 {
 	if x != nil {
-		return x.McOpsiD
+		return x.GraderId
 	}
-	return ""
+	return 0
 }
 
-func (x *QuestionForStudent) GetMcJawabanDipilih() JawabanOption {
+func (x *GradingTask) GetMarkerSlot() int32 {
 	if x != nil {
-		return x.McJawabanDipilih
+		return x.MarkerSlot
 	}
-	return JawabanOption_JAWABAN_INVALID
+	return 0
 }
 
-func (x *QuestionForStudent) GetMcGambar() []*SoalGambar {
+func (x *GradingTask) GetStatus() string {
 	if x != nil {
-		return x.McGambar
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *QuestionForStudent) GetDdId() int32 {
+func (x *GradingTask) GetScore() float64 {
 	if x != nil {
-		return x.DdId
+		return x.Score
 	}
 	return 0
 }
 
-func (x *QuestionForStudent) GetDdPertanyaan() string {
+func (x *GradingTask) GetHasScore() bool {
 	if x != nil {
-		return x.DdPertanyaan
+		return x.HasScore
 	}
-	return ""
+	return false
 }
 
-func (x *QuestionForStudent) GetDdDragType() DragDropType {
+func (x *GradingTask) GetFeedback() string {
 	if x != nil {
-		return x.DdDragType
+		return x.Feedback
 	}
-	return DragDropType_DRAG_TYPE_INVALID
+	return ""
 }
 
-func (x *QuestionForStudent) GetDdItems() []*DragItem {
+func (x *GradingTask) GetAssignedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DdItems
+		return x.AssignedAt
 	}
 	return nil
 }
 
-func (x *QuestionForStudent) GetDdSlots() []*DragSlot {
+func (x *GradingTask) GetSubmittedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DdSlots
+		return x.SubmittedAt
 	}
 	return nil
 }
 
-func (x *QuestionForStudent) GetDdUserAnswer() map[int32]int32 {
-	if x != nil {
-		return x.DdUserAnswer
-	}
-	return nil
+type EssayModeration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // pending, resolved
+	Discrepancy   float64                `protobuf:"fixed64,2,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+	FinalScore    float64                `protobuf:"fixed64,3,opt,name=final_score,json=finalScore,proto3" json:"final_score,omitempty"`
+	ModeratorId   int32                  `protobuf:"varint,4,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuestionForStudent) GetEssayId() int32 {
-	if x != nil {
-		return x.EssayId
-	}
-	return 0
+func (x *EssayModeration) Reset() {
+	*x = EssayModeration{}
+	mi := &file_cbt_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuestionForStudent) GetEssayPertanyaan() string {
-	if x != nil {
-		return x.EssayPertanyaan
-	}
-	return ""
+func (x *EssayModeration) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QuestionForStudent) GetEssayJawaban() string {
-	if x != nil {
-		return x.EssayJawaban
-	}
-	return ""
-}
+func (*EssayModeration) ProtoMessage() {}
 
-func (x *QuestionForStudent) GetMccId() int32 {
+func (x *EssayModeration) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[189]
 	if x != nil {
-		return x.MccId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *QuestionForStudent) GetMccPertanyaan() string {
-	if x != nil {
-		return x.MccPertanyaan
-	}
-	return ""
+// Deprecated: Use EssayModeration.ProtoReflect.Descriptor instead.
+func (*EssayModeration) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *QuestionForStudent) GetMccOpsiA() string {
+func (x *EssayModeration) GetStatus() string {
 	if x != nil {
-		return x.MccOpsiA
+		return x.Status
 	}
 	return ""
 }
 
-func (x *QuestionForStudent) GetMccOpsiB() string {
+func (x *EssayModeration) GetDiscrepancy() float64 {
 	if x != nil {
-		return x.MccOpsiB
+		return x.Discrepancy
 	}
-	return ""
+	return 0
 }
 
-func (x *QuestionForStudent) GetMccOpsiC() string {
+func (x *EssayModeration) GetFinalScore() float64 {
 	if x != nil {
-		return x.MccOpsiC
+		return x.FinalScore
 	}
-	return ""
+	return 0
 }
 
-func (x *QuestionForStudent) GetMccOpsiD() string {
+func (x *EssayModeration) GetModeratorId() int32 {
 	if x != nil {
-		return x.MccOpsiD
+		return x.ModeratorId
 	}
-	return ""
+	return 0
 }
 
-func (x *QuestionForStudent) GetMccJawabanDipilih() []JawabanOption {
+func (x *EssayModeration) GetNote() string {
 	if x != nil {
-		return x.MccJawabanDipilih
+		return x.Note
 	}
-	return nil
+	return ""
 }
 
-func (x *QuestionForStudent) GetMccGambar() []*SoalGambar {
+func (x *EssayModeration) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MccGambar
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CreateSoalDragDropRequest struct {
-	state          protoimpl.MessageState       `protogen:"open.v1"`
-	IdMateri       int32                        `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	IdTingkat      int32                        `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Pertanyaan     string                       `protobuf:"bytes,3,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	DragType       DragDropType                 `protobuf:"varint,4,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
-	Items          []*DragItem                  `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	Slots          []*DragSlot                  `protobuf:"bytes,6,rep,name=slots,proto3" json:"slots,omitempty"`
-	CorrectAnswers []*DragCorrectAnswerByUrutan `protobuf:"bytes,7,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
-	Pembahasan     string                       `protobuf:"bytes,8,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	LmsClassId     int64                        `protobuf:"varint,9,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope
-	Point          float64                      `protobuf:"fixed64,10,opt,name=point,proto3" json:"point,omitempty"`
-	Urutan         int32                        `protobuf:"varint,11,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type PendingEssay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        *EssayAnswerForGrading `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	Tasks         []*GradingTask         `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Moderation    *EssayModeration       `protobuf:"bytes,3,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Suggestion    *ScoreSuggestion       `protobuf:"bytes,4,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSoalDragDropRequest) Reset() {
-	*x = CreateSoalDragDropRequest{}
-	mi := &file_cbt_proto_msgTypes[71]
+func (x *PendingEssay) Reset() {
+	*x = PendingEssay{}
+	mi := &file_cbt_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSoalDragDropRequest) String() string {
+func (x *PendingEssay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSoalDragDropRequest) ProtoMessage() {}
+func (*PendingEssay) ProtoMessage() {}
 
-func (x *CreateSoalDragDropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[71]
+func (x *PendingEssay) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5577,110 +14635,146 @@ func (x *CreateSoalDragDropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSoalDragDropRequest.ProtoReflect.Descriptor instead.
-func (*CreateSoalDragDropRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use PendingEssay.ProtoReflect.Descriptor instead.
+func (*PendingEssay) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *CreateSoalDragDropRequest) GetIdMateri() int32 {
+func (x *PendingEssay) GetAnswer() *EssayAnswerForGrading {
 	if x != nil {
-		return x.IdMateri
+		return x.Answer
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateSoalDragDropRequest) GetIdTingkat() int32 {
+func (x *PendingEssay) GetTasks() []*GradingTask {
 	if x != nil {
-		return x.IdTingkat
+		return x.Tasks
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateSoalDragDropRequest) GetPertanyaan() string {
+func (x *PendingEssay) GetModeration() *EssayModeration {
 	if x != nil {
-		return x.Pertanyaan
+		return x.Moderation
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSoalDragDropRequest) GetDragType() DragDropType {
+func (x *PendingEssay) GetSuggestion() *ScoreSuggestion {
 	if x != nil {
-		return x.DragType
+		return x.Suggestion
 	}
-	return DragDropType_DRAG_TYPE_INVALID
+	return nil
 }
 
-func (x *CreateSoalDragDropRequest) GetItems() []*DragItem {
+type ListPendingEssaysRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	LmsClassId      int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	IdSoal          int32                  `protobuf:"varint,3,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	GraderId        int32                  `protobuf:"varint,4,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"` // Essays still waiting for this grader's mark
+	InModeration    bool                   `protobuf:"varint,5,opt,name=in_moderation,json=inModeration,proto3" json:"in_moderation,omitempty"`
+	Pagination      *PaginationRequest     `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPendingEssaysRequest) Reset() {
+	*x = ListPendingEssaysRequest{}
+	mi := &file_cbt_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingEssaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingEssaysRequest) ProtoMessage() {}
+
+func (x *ListPendingEssaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[191]
 	if x != nil {
-		return x.Items
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSoalDragDropRequest) GetSlots() []*DragSlot {
+// Deprecated: Use ListPendingEssaysRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingEssaysRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListPendingEssaysRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Slots
+		return x.LmsAssignmentId
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateSoalDragDropRequest) GetCorrectAnswers() []*DragCorrectAnswerByUrutan {
+func (x *ListPendingEssaysRequest) GetLmsClassId() int64 {
 	if x != nil {
-		return x.CorrectAnswers
+		return x.LmsClassId
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateSoalDragDropRequest) GetPembahasan() string {
+func (x *ListPendingEssaysRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.Pembahasan
+		return x.IdSoal
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSoalDragDropRequest) GetLmsClassId() int64 {
+func (x *ListPendingEssaysRequest) GetGraderId() int32 {
 	if x != nil {
-		return x.LmsClassId
+		return x.GraderId
 	}
 	return 0
 }
 
-func (x *CreateSoalDragDropRequest) GetPoint() float64 {
+func (x *ListPendingEssaysRequest) GetInModeration() bool {
 	if x != nil {
-		return x.Point
+		return x.InModeration
 	}
-	return 0
+	return false
 }
 
-func (x *CreateSoalDragDropRequest) GetUrutan() int32 {
+func (x *ListPendingEssaysRequest) GetPagination() *PaginationRequest {
 	if x != nil {
-		return x.Urutan
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-type GetSoalDragDropRequest struct {
+type ListPendingEssaysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Essays        []*PendingEssay        `protobuf:"bytes,1,rep,name=essays,proto3" json:"essays,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSoalDragDropRequest) Reset() {
-	*x = GetSoalDragDropRequest{}
-	mi := &file_cbt_proto_msgTypes[72]
+func (x *ListPendingEssaysResponse) Reset() {
+	*x = ListPendingEssaysResponse{}
+	mi := &file_cbt_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSoalDragDropRequest) String() string {
+func (x *ListPendingEssaysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSoalDragDropRequest) ProtoMessage() {}
+func (*ListPendingEssaysResponse) ProtoMessage() {}
 
-func (x *GetSoalDragDropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[72]
+func (x *ListPendingEssaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5691,51 +14785,51 @@ func (x *GetSoalDragDropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSoalDragDropRequest.ProtoReflect.Descriptor instead.
-func (*GetSoalDragDropRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ListPendingEssaysResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingEssaysResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *GetSoalDragDropRequest) GetId() int32 {
+func (x *ListPendingEssaysResponse) GetEssays() []*PendingEssay {
 	if x != nil {
-		return x.Id
+		return x.Essays
 	}
-	return 0
+	return nil
 }
 
-type UpdateSoalDragDropRequest struct {
-	state          protoimpl.MessageState       `protogen:"open.v1"`
-	Id             int32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdMateri       int32                        `protobuf:"varint,2,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	IdTingkat      int32                        `protobuf:"varint,3,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Pertanyaan     string                       `protobuf:"bytes,4,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	DragType       DragDropType                 `protobuf:"varint,5,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
-	Items          []*DragItem                  `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
-	Slots          []*DragSlot                  `protobuf:"bytes,7,rep,name=slots,proto3" json:"slots,omitempty"`
-	CorrectAnswers []*DragCorrectAnswerByUrutan `protobuf:"bytes,8,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
-	Pembahasan     string                       `protobuf:"bytes,9,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	IsActive       bool                         `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Point          float64                      `protobuf:"fixed64,11,opt,name=point,proto3" json:"point,omitempty"`
-	Urutan         int32                        `protobuf:"varint,12,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListPendingEssaysResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-func (x *UpdateSoalDragDropRequest) Reset() {
-	*x = UpdateSoalDragDropRequest{}
-	mi := &file_cbt_proto_msgTypes[73]
+type AssignGradersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal          int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`                 // 0 = every essay soal of the assignment
+	AnswerIds       []int32                `protobuf:"varint,3,rep,packed,name=answer_ids,json=answerIds,proto3" json:"answer_ids,omitempty"` // Empty = every pending essay
+	GraderIds       []int32                `protobuf:"varint,4,rep,packed,name=grader_ids,json=graderIds,proto3" json:"grader_ids,omitempty"` // Essays are distributed round-robin
+	MarkerSlot      int32                  `protobuf:"varint,5,opt,name=marker_slot,json=markerSlot,proto3" json:"marker_slot,omitempty"`     // 1 = first marker (default), 2 = second marker
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignGradersRequest) Reset() {
+	*x = AssignGradersRequest{}
+	mi := &file_cbt_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSoalDragDropRequest) String() string {
+func (x *AssignGradersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSoalDragDropRequest) ProtoMessage() {}
+func (*AssignGradersRequest) ProtoMessage() {}
 
-func (x *UpdateSoalDragDropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[73]
+func (x *AssignGradersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5746,118 +14840,124 @@ func (x *UpdateSoalDragDropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSoalDragDropRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSoalDragDropRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use AssignGradersRequest.ProtoReflect.Descriptor instead.
+func (*AssignGradersRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *UpdateSoalDragDropRequest) GetId() int32 {
+func (x *AssignGradersRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *UpdateSoalDragDropRequest) GetIdMateri() int32 {
+func (x *AssignGradersRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.IdMateri
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *UpdateSoalDragDropRequest) GetIdTingkat() int32 {
+func (x *AssignGradersRequest) GetAnswerIds() []int32 {
 	if x != nil {
-		return x.IdTingkat
+		return x.AnswerIds
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateSoalDragDropRequest) GetPertanyaan() string {
+func (x *AssignGradersRequest) GetGraderIds() []int32 {
 	if x != nil {
-		return x.Pertanyaan
+		return x.GraderIds
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateSoalDragDropRequest) GetDragType() DragDropType {
+func (x *AssignGradersRequest) GetMarkerSlot() int32 {
 	if x != nil {
-		return x.DragType
+		return x.MarkerSlot
 	}
-	return DragDropType_DRAG_TYPE_INVALID
+	return 0
 }
 
-func (x *UpdateSoalDragDropRequest) GetItems() []*DragItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+type AssignGradersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*GradingTask         `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	AssignedCount int32                  `protobuf:"varint,2,opt,name=assigned_count,json=assignedCount,proto3" json:"assigned_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSoalDragDropRequest) GetSlots() []*DragSlot {
-	if x != nil {
-		return x.Slots
-	}
-	return nil
+func (x *AssignGradersResponse) Reset() {
+	*x = AssignGradersResponse{}
+	mi := &file_cbt_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSoalDragDropRequest) GetCorrectAnswers() []*DragCorrectAnswerByUrutan {
-	if x != nil {
-		return x.CorrectAnswers
-	}
-	return nil
+func (x *AssignGradersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateSoalDragDropRequest) GetPembahasan() string {
+func (*AssignGradersResponse) ProtoMessage() {}
+
+func (x *AssignGradersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[194]
 	if x != nil {
-		return x.Pembahasan
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSoalDragDropRequest) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
+// Deprecated: Use AssignGradersResponse.ProtoReflect.Descriptor instead.
+func (*AssignGradersResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *UpdateSoalDragDropRequest) GetPoint() float64 {
+func (x *AssignGradersResponse) GetTasks() []*GradingTask {
 	if x != nil {
-		return x.Point
+		return x.Tasks
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateSoalDragDropRequest) GetUrutan() int32 {
+func (x *AssignGradersResponse) GetAssignedCount() int32 {
 	if x != nil {
-		return x.Urutan
+		return x.AssignedCount
 	}
 	return 0
 }
 
-type SoalDragDropOrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Urutan        int32                  `protobuf:"varint,2,opt,name=urutan,proto3" json:"urutan,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SubmitEssayMarkRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AnswerId         int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Score            float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // Ignored when rubric_selections are given or the suggestion is accepted
+	Feedback         string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	RubricSelections []*RubricSelection     `protobuf:"bytes,4,rep,name=rubric_selections,json=rubricSelections,proto3" json:"rubric_selections,omitempty"`
+	AcceptSuggestion bool                   `protobuf:"varint,5,opt,name=accept_suggestion,json=acceptSuggestion,proto3" json:"accept_suggestion,omitempty"` // Use the stored suggested score as the mark
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SoalDragDropOrderItem) Reset() {
-	*x = SoalDragDropOrderItem{}
-	mi := &file_cbt_proto_msgTypes[74]
+func (x *SubmitEssayMarkRequest) Reset() {
+	*x = SubmitEssayMarkRequest{}
+	mi := &file_cbt_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalDragDropOrderItem) String() string {
+func (x *SubmitEssayMarkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalDragDropOrderItem) ProtoMessage() {}
+func (*SubmitEssayMarkRequest) ProtoMessage() {}
 
-func (x *SoalDragDropOrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[74]
+func (x *SubmitEssayMarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5868,48 +14968,72 @@ func (x *SoalDragDropOrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalDragDropOrderItem.ProtoReflect.Descriptor instead.
-func (*SoalDragDropOrderItem) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use SubmitEssayMarkRequest.ProtoReflect.Descriptor instead.
+func (*SubmitEssayMarkRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *SoalDragDropOrderItem) GetId() int32 {
+func (x *SubmitEssayMarkRequest) GetAnswerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.AnswerId
 	}
 	return 0
 }
 
-func (x *SoalDragDropOrderItem) GetUrutan() int32 {
+func (x *SubmitEssayMarkRequest) GetScore() float64 {
 	if x != nil {
-		return x.Urutan
+		return x.Score
 	}
 	return 0
 }
 
-type ReorderSoalDragDropRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	IdMateri      int32                    `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	Items         []*SoalDragDropOrderItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SubmitEssayMarkRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
 }
 
-func (x *ReorderSoalDragDropRequest) Reset() {
-	*x = ReorderSoalDragDropRequest{}
-	mi := &file_cbt_proto_msgTypes[75]
+func (x *SubmitEssayMarkRequest) GetRubricSelections() []*RubricSelection {
+	if x != nil {
+		return x.RubricSelections
+	}
+	return nil
+}
+
+func (x *SubmitEssayMarkRequest) GetAcceptSuggestion() bool {
+	if x != nil {
+		return x.AcceptSuggestion
+	}
+	return false
+}
+
+type ResolveModerationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AnswerId         int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Score            float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // Ignored when rubric_selections are given
+	Feedback         string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	RubricSelections []*RubricSelection     `protobuf:"bytes,4,rep,name=rubric_selections,json=rubricSelections,proto3" json:"rubric_selections,omitempty"`
+	Note             string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ResolveModerationRequest) Reset() {
+	*x = ResolveModerationRequest{}
+	mi := &file_cbt_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReorderSoalDragDropRequest) String() string {
+func (x *ResolveModerationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReorderSoalDragDropRequest) ProtoMessage() {}
+func (*ResolveModerationRequest) ProtoMessage() {}
 
-func (x *ReorderSoalDragDropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[75]
+func (x *ResolveModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5920,47 +15044,74 @@ func (x *ReorderSoalDragDropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReorderSoalDragDropRequest.ProtoReflect.Descriptor instead.
-func (*ReorderSoalDragDropRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ResolveModerationRequest.ProtoReflect.Descriptor instead.
+func (*ResolveModerationRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *ReorderSoalDragDropRequest) GetIdMateri() int32 {
+func (x *ResolveModerationRequest) GetAnswerId() int32 {
 	if x != nil {
-		return x.IdMateri
+		return x.AnswerId
 	}
 	return 0
 }
 
-func (x *ReorderSoalDragDropRequest) GetItems() []*SoalDragDropOrderItem {
+func (x *ResolveModerationRequest) GetScore() float64 {
 	if x != nil {
-		return x.Items
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ResolveModerationRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *ResolveModerationRequest) GetRubricSelections() []*RubricSelection {
+	if x != nil {
+		return x.RubricSelections
 	}
 	return nil
 }
 
-type DeleteSoalDragDropRequest struct {
+func (x *ResolveModerationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type EssayMarkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AnswerId      int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // finalized, awaiting_second_mark, moderation
+	NilaiEssay    float64                `protobuf:"fixed64,3,opt,name=nilai_essay,json=nilaiEssay,proto3" json:"nilai_essay,omitempty"`
+	Discrepancy   float64                `protobuf:"fixed64,4,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+	RubricScores  []*RubricScore         `protobuf:"bytes,5,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,6,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	SessionStatus string                 `protobuf:"bytes,7,opt,name=session_status,json=sessionStatus,proto3" json:"session_status,omitempty"` // grading_in_progress or graded once finalized
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSoalDragDropRequest) Reset() {
-	*x = DeleteSoalDragDropRequest{}
-	mi := &file_cbt_proto_msgTypes[76]
+func (x *EssayMarkResponse) Reset() {
+	*x = EssayMarkResponse{}
+	mi := &file_cbt_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSoalDragDropRequest) String() string {
+func (x *EssayMarkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSoalDragDropRequest) ProtoMessage() {}
+func (*EssayMarkResponse) ProtoMessage() {}
 
-func (x *DeleteSoalDragDropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[76]
+func (x *EssayMarkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5971,40 +15122,82 @@ func (x *DeleteSoalDragDropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSoalDragDropRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSoalDragDropRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use EssayMarkResponse.ProtoReflect.Descriptor instead.
+func (*EssayMarkResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *DeleteSoalDragDropRequest) GetId() int32 {
+func (x *EssayMarkResponse) GetAnswerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.AnswerId
 	}
 	return 0
 }
 
-type SoalDragDropResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Soal          *SoalDragDropFull      `protobuf:"bytes,1,opt,name=soal,proto3" json:"soal,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EssayMarkResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *SoalDragDropResponse) Reset() {
-	*x = SoalDragDropResponse{}
-	mi := &file_cbt_proto_msgTypes[77]
+func (x *EssayMarkResponse) GetNilaiEssay() float64 {
+	if x != nil {
+		return x.NilaiEssay
+	}
+	return 0
+}
+
+func (x *EssayMarkResponse) GetDiscrepancy() float64 {
+	if x != nil {
+		return x.Discrepancy
+	}
+	return 0
+}
+
+func (x *EssayMarkResponse) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *EssayMarkResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *EssayMarkResponse) GetSessionStatus() string {
+	if x != nil {
+		return x.SessionStatus
+	}
+	return ""
+}
+
+type GetGradingProgressRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGradingProgressRequest) Reset() {
+	*x = GetGradingProgressRequest{}
+	mi := &file_cbt_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SoalDragDropResponse) String() string {
+func (x *GetGradingProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SoalDragDropResponse) ProtoMessage() {}
+func (*GetGradingProgressRequest) ProtoMessage() {}
 
-func (x *SoalDragDropResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[77]
+func (x *GetGradingProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6015,43 +15208,48 @@ func (x *SoalDragDropResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SoalDragDropResponse.ProtoReflect.Descriptor instead.
-func (*SoalDragDropResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use GetGradingProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingProgressRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *SoalDragDropResponse) GetSoal() *SoalDragDropFull {
+func (x *GetGradingProgressRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.Soal
+		return x.LmsAssignmentId
 	}
-	return nil
-}
-
-type ListSoalDragDropRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IdMateri      int32                  `protobuf:"varint,1,opt,name=id_materi,json=idMateri,proto3" json:"id_materi,omitempty"`
-	IdTingkat     int32                  `protobuf:"varint,2,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	LmsClassId    int64                  `protobuf:"varint,4,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Filter by class
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *ListSoalDragDropRequest) Reset() {
-	*x = ListSoalDragDropRequest{}
-	mi := &file_cbt_proto_msgTypes[78]
+type GradingProgressResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId           int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	TotalEssays               int32                  `protobuf:"varint,2,opt,name=total_essays,json=totalEssays,proto3" json:"total_essays,omitempty"`
+	GradedEssays              int32                  `protobuf:"varint,3,opt,name=graded_essays,json=gradedEssays,proto3" json:"graded_essays,omitempty"`
+	PendingEssays             int32                  `protobuf:"varint,4,opt,name=pending_essays,json=pendingEssays,proto3" json:"pending_essays,omitempty"`
+	UnassignedEssays          int32                  `protobuf:"varint,5,opt,name=unassigned_essays,json=unassignedEssays,proto3" json:"unassigned_essays,omitempty"`
+	AwaitingSecondMark        int32                  `protobuf:"varint,6,opt,name=awaiting_second_mark,json=awaitingSecondMark,proto3" json:"awaiting_second_mark,omitempty"`
+	InModeration              int32                  `protobuf:"varint,7,opt,name=in_moderation,json=inModeration,proto3" json:"in_moderation,omitempty"`
+	SessionsGradingInProgress int32                  `protobuf:"varint,8,opt,name=sessions_grading_in_progress,json=sessionsGradingInProgress,proto3" json:"sessions_grading_in_progress,omitempty"`
+	SessionsGraded            int32                  `protobuf:"varint,9,opt,name=sessions_graded,json=sessionsGraded,proto3" json:"sessions_graded,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GradingProgressResponse) Reset() {
+	*x = GradingProgressResponse{}
+	mi := &file_cbt_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSoalDragDropRequest) String() string {
+func (x *GradingProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSoalDragDropRequest) ProtoMessage() {}
+func (*GradingProgressResponse) ProtoMessage() {}
 
-func (x *ListSoalDragDropRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[78]
+func (x *GradingProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6062,127 +15260,100 @@ func (x *ListSoalDragDropRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSoalDragDropRequest.ProtoReflect.Descriptor instead.
-func (*ListSoalDragDropRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use GradingProgressResponse.ProtoReflect.Descriptor instead.
+func (*GradingProgressResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *ListSoalDragDropRequest) GetIdMateri() int32 {
+func (x *GradingProgressResponse) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.IdMateri
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *ListSoalDragDropRequest) GetIdTingkat() int32 {
+func (x *GradingProgressResponse) GetTotalEssays() int32 {
 	if x != nil {
-		return x.IdTingkat
+		return x.TotalEssays
 	}
 	return 0
 }
 
-func (x *ListSoalDragDropRequest) GetPagination() *PaginationRequest {
+func (x *GradingProgressResponse) GetGradedEssays() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.GradedEssays
 	}
-	return nil
+	return 0
 }
 
-func (x *ListSoalDragDropRequest) GetLmsClassId() int64 {
+func (x *GradingProgressResponse) GetPendingEssays() int32 {
 	if x != nil {
-		return x.LmsClassId
+		return x.PendingEssays
 	}
 	return 0
 }
 
-type ListSoalDragDropResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Soal          []*SoalDragDropFull    `protobuf:"bytes,1,rep,name=soal,proto3" json:"soal,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListSoalDragDropResponse) Reset() {
-	*x = ListSoalDragDropResponse{}
-	mi := &file_cbt_proto_msgTypes[79]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListSoalDragDropResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GradingProgressResponse) GetUnassignedEssays() int32 {
+	if x != nil {
+		return x.UnassignedEssays
+	}
+	return 0
 }
 
-func (*ListSoalDragDropResponse) ProtoMessage() {}
-
-func (x *ListSoalDragDropResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[79]
+func (x *GradingProgressResponse) GetAwaitingSecondMark() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AwaitingSecondMark
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListSoalDragDropResponse.ProtoReflect.Descriptor instead.
-func (*ListSoalDragDropResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{79}
+func (x *GradingProgressResponse) GetInModeration() int32 {
+	if x != nil {
+		return x.InModeration
+	}
+	return 0
 }
 
-func (x *ListSoalDragDropResponse) GetSoal() []*SoalDragDropFull {
+func (x *GradingProgressResponse) GetSessionsGradingInProgress() int32 {
 	if x != nil {
-		return x.Soal
+		return x.SessionsGradingInProgress
 	}
-	return nil
+	return 0
 }
 
-func (x *ListSoalDragDropResponse) GetPagination() *PaginationResponse {
+func (x *GradingProgressResponse) GetSessionsGraded() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.SessionsGraded
 	}
-	return nil
+	return 0
 }
 
-type TestSession struct {
+type EssayKeyword struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SessionToken  string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                  // From JWT, not input
-	NamaPeserta   string                 `protobuf:"bytes,4,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"` // Participant's name
-	Tingkat       *Tingkat               `protobuf:"bytes,5,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
-	MataPelajaran *MataPelajaran         `protobuf:"bytes,6,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"` // Nested object
-	WaktuMulai    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=waktu_mulai,json=waktuMulai,proto3" json:"waktu_mulai,omitempty"`
-	WaktuSelesai  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=waktu_selesai,json=waktuSelesai,proto3" json:"waktu_selesai,omitempty"`
-	BatasWaktu    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=batas_waktu,json=batasWaktu,proto3" json:"batas_waktu,omitempty"` // ADDED
-	DurasiMenit   int32                  `protobuf:"varint,10,opt,name=durasi_menit,json=durasiMenit,proto3" json:"durasi_menit,omitempty"`
-	NilaiAkhir    float64                `protobuf:"fixed64,11,opt,name=nilai_akhir,json=nilaiAkhir,proto3" json:"nilai_akhir,omitempty"`
-	JumlahBenar   int32                  `protobuf:"varint,12,opt,name=jumlah_benar,json=jumlahBenar,proto3" json:"jumlah_benar,omitempty"`
-	TotalSoal     int32                  `protobuf:"varint,13,opt,name=total_soal,json=totalSoal,proto3" json:"total_soal,omitempty"`
-	Status        TestStatus             `protobuf:"varint,14,opt,name=status,proto3,enum=base.TestStatus" json:"status,omitempty"`
-	LmsClassId    int64                  `protobuf:"varint,15,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"` // Class scope
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keyword       string                 `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Synonyms      []string               `protobuf:"bytes,3,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"` // 0 = default 1
+	Urutan        int32                  `protobuf:"varint,5,opt,name=urutan,proto3" json:"urutan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestSession) Reset() {
-	*x = TestSession{}
-	mi := &file_cbt_proto_msgTypes[80]
+func (x *EssayKeyword) Reset() {
+	*x = EssayKeyword{}
+	mi := &file_cbt_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestSession) String() string {
+func (x *EssayKeyword) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestSession) ProtoMessage() {}
+func (*EssayKeyword) ProtoMessage() {}
 
-func (x *TestSession) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[80]
+func (x *EssayKeyword) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6193,144 +15364,165 @@ func (x *TestSession) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestSession.ProtoReflect.Descriptor instead.
-func (*TestSession) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use EssayKeyword.ProtoReflect.Descriptor instead.
+func (*EssayKeyword) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *TestSession) GetId() int32 {
+func (x *EssayKeyword) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *TestSession) GetSessionToken() string {
+func (x *EssayKeyword) GetKeyword() string {
 	if x != nil {
-		return x.SessionToken
+		return x.Keyword
 	}
 	return ""
 }
 
-func (x *TestSession) GetUser() *User {
+func (x *EssayKeyword) GetSynonyms() []string {
 	if x != nil {
-		return x.User
+		return x.Synonyms
 	}
 	return nil
 }
 
-func (x *TestSession) GetNamaPeserta() string {
+func (x *EssayKeyword) GetWeight() float64 {
 	if x != nil {
-		return x.NamaPeserta
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-func (x *TestSession) GetTingkat() *Tingkat {
+func (x *EssayKeyword) GetUrutan() int32 {
 	if x != nil {
-		return x.Tingkat
+		return x.Urutan
 	}
-	return nil
+	return 0
 }
 
-func (x *TestSession) GetMataPelajaran() *MataPelajaran {
-	if x != nil {
-		return x.MataPelajaran
-	}
-	return nil
+type SetEssayKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	Keywords      []*EssayKeyword        `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"` // Empty list removes the keywords
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestSession) GetWaktuMulai() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WaktuMulai
-	}
-	return nil
+func (x *SetEssayKeywordsRequest) Reset() {
+	*x = SetEssayKeywordsRequest{}
+	mi := &file_cbt_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestSession) GetWaktuSelesai() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WaktuSelesai
-	}
-	return nil
+func (x *SetEssayKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TestSession) GetBatasWaktu() *timestamppb.Timestamp {
+func (*SetEssayKeywordsRequest) ProtoMessage() {}
+
+func (x *SetEssayKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[201]
 	if x != nil {
-		return x.BatasWaktu
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TestSession) GetDurasiMenit() int32 {
-	if x != nil {
-		return x.DurasiMenit
-	}
-	return 0
+// Deprecated: Use SetEssayKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*SetEssayKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *TestSession) GetNilaiAkhir() float64 {
+func (x *SetEssayKeywordsRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.NilaiAkhir
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *TestSession) GetJumlahBenar() int32 {
+func (x *SetEssayKeywordsRequest) GetKeywords() []*EssayKeyword {
 	if x != nil {
-		return x.JumlahBenar
+		return x.Keywords
 	}
-	return 0
+	return nil
 }
 
-func (x *TestSession) GetTotalSoal() int32 {
-	if x != nil {
-		return x.TotalSoal
-	}
-	return 0
+type GetEssayKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestSession) GetStatus() TestStatus {
+func (x *GetEssayKeywordsRequest) Reset() {
+	*x = GetEssayKeywordsRequest{}
+	mi := &file_cbt_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEssayKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEssayKeywordsRequest) ProtoMessage() {}
+
+func (x *GetEssayKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[202]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TestStatus_STATUS_INVALID
+	return mi.MessageOf(x)
 }
 
-func (x *TestSession) GetLmsClassId() int64 {
+// Deprecated: Use GetEssayKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*GetEssayKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *GetEssayKeywordsRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.LmsClassId
+		return x.IdSoal
 	}
 	return 0
 }
 
-type CreateTestSessionRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	IdTingkat            int32                  `protobuf:"varint,1,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	IdMataPelajaran      int32                  `protobuf:"varint,2,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	DurasiMenit          int32                  `protobuf:"varint,3,opt,name=durasi_menit,json=durasiMenit,proto3" json:"durasi_menit,omitempty"`
-	JumlahSoal           int32                  `protobuf:"varint,4,opt,name=jumlah_soal,json=jumlahSoal,proto3" json:"jumlah_soal,omitempty"`                                                               // ADDED - critical!
-	LmsClassId           int64                  `protobuf:"varint,5,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`                                                             // Class scope
-	IncludeQuestionTypes []QuestionType         `protobuf:"varint,6,rep,packed,name=include_question_types,json=includeQuestionTypes,proto3,enum=base.QuestionType" json:"include_question_types,omitempty"` // Optional type filter (defaults to all)
-	SelectionMode        QuestionSelectionMode  `protobuf:"varint,7,opt,name=selection_mode,json=selectionMode,proto3,enum=base.QuestionSelectionMode" json:"selection_mode,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type EssayKeywordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IdSoal        int32                  `protobuf:"varint,1,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	Keywords      []*EssayKeyword        `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTestSessionRequest) Reset() {
-	*x = CreateTestSessionRequest{}
-	mi := &file_cbt_proto_msgTypes[81]
+func (x *EssayKeywordsResponse) Reset() {
+	*x = EssayKeywordsResponse{}
+	mi := &file_cbt_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTestSessionRequest) String() string {
+func (x *EssayKeywordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTestSessionRequest) ProtoMessage() {}
+func (*EssayKeywordsResponse) ProtoMessage() {}
 
-func (x *CreateTestSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[81]
+func (x *EssayKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6341,82 +15533,123 @@ func (x *CreateTestSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTestSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateTestSessionRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use EssayKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*EssayKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *CreateTestSessionRequest) GetIdTingkat() int32 {
+func (x *EssayKeywordsResponse) GetIdSoal() int32 {
 	if x != nil {
-		return x.IdTingkat
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *CreateTestSessionRequest) GetIdMataPelajaran() int32 {
+func (x *EssayKeywordsResponse) GetKeywords() []*EssayKeyword {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.Keywords
 	}
-	return 0
+	return nil
+}
+
+type KeywordMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	MatchedTerm   string                 `protobuf:"bytes,2,opt,name=matched_term,json=matchedTerm,proto3" json:"matched_term,omitempty"` // The keyword or synonym as written in the answer
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeywordMatch) Reset() {
+	*x = KeywordMatch{}
+	mi := &file_cbt_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordMatch) ProtoMessage() {}
+
+func (x *KeywordMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTestSessionRequest) GetDurasiMenit() int32 {
-	if x != nil {
-		return x.DurasiMenit
-	}
-	return 0
+// Deprecated: Use KeywordMatch.ProtoReflect.Descriptor instead.
+func (*KeywordMatch) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *CreateTestSessionRequest) GetJumlahSoal() int32 {
+func (x *KeywordMatch) GetKeyword() string {
 	if x != nil {
-		return x.JumlahSoal
+		return x.Keyword
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateTestSessionRequest) GetLmsClassId() int64 {
+func (x *KeywordMatch) GetMatchedTerm() string {
 	if x != nil {
-		return x.LmsClassId
+		return x.MatchedTerm
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateTestSessionRequest) GetIncludeQuestionTypes() []QuestionType {
+func (x *KeywordMatch) GetStart() int32 {
 	if x != nil {
-		return x.IncludeQuestionTypes
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateTestSessionRequest) GetSelectionMode() QuestionSelectionMode {
+func (x *KeywordMatch) GetEnd() int32 {
 	if x != nil {
-		return x.SelectionMode
+		return x.End
 	}
-	return QuestionSelectionMode_SELECTION_MODE_INVALID
+	return 0
 }
 
-type GetTestSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ScoreSuggestion struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SuggestedScore      float64                `protobuf:"fixed64,1,opt,name=suggested_score,json=suggestedScore,proto3" json:"suggested_score,omitempty"`
+	KeywordCoverage     float64                `protobuf:"fixed64,2,opt,name=keyword_coverage,json=keywordCoverage,proto3" json:"keyword_coverage,omitempty"`
+	HasKeywordCoverage  bool                   `protobuf:"varint,3,opt,name=has_keyword_coverage,json=hasKeywordCoverage,proto3" json:"has_keyword_coverage,omitempty"`
+	ReferenceOverlap    float64                `protobuf:"fixed64,4,opt,name=reference_overlap,json=referenceOverlap,proto3" json:"reference_overlap,omitempty"` // Share of the answer key's word pairs found in the answer
+	HasReferenceOverlap bool                   `protobuf:"varint,5,opt,name=has_reference_overlap,json=hasReferenceOverlap,proto3" json:"has_reference_overlap,omitempty"`
+	KeywordMatches      []*KeywordMatch        `protobuf:"bytes,6,rep,name=keyword_matches,json=keywordMatches,proto3" json:"keyword_matches,omitempty"`
+	MissingKeywords     []string               `protobuf:"bytes,7,rep,name=missing_keywords,json=missingKeywords,proto3" json:"missing_keywords,omitempty"`
+	ReferencePassages   []*MatchedPassage      `protobuf:"bytes,8,rep,name=reference_passages,json=referencePassages,proto3" json:"reference_passages,omitempty"`
+	ComputedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetTestSessionRequest) Reset() {
-	*x = GetTestSessionRequest{}
-	mi := &file_cbt_proto_msgTypes[82]
+func (x *ScoreSuggestion) Reset() {
+	*x = ScoreSuggestion{}
+	mi := &file_cbt_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTestSessionRequest) String() string {
+func (x *ScoreSuggestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTestSessionRequest) ProtoMessage() {}
+func (*ScoreSuggestion) ProtoMessage() {}
 
-func (x *GetTestSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[82]
+func (x *ScoreSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6427,87 +15660,97 @@ func (x *GetTestSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTestSessionRequest.ProtoReflect.Descriptor instead.
-func (*GetTestSessionRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use ScoreSuggestion.ProtoReflect.Descriptor instead.
+func (*ScoreSuggestion) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *GetTestSessionRequest) GetSessionToken() string {
+func (x *ScoreSuggestion) GetSuggestedScore() float64 {
 	if x != nil {
-		return x.SessionToken
+		return x.SuggestedScore
 	}
-	return ""
+	return 0
 }
 
-type TestSessionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TestSession   *TestSession           `protobuf:"bytes,1,opt,name=test_session,json=testSession,proto3" json:"test_session,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScoreSuggestion) GetKeywordCoverage() float64 {
+	if x != nil {
+		return x.KeywordCoverage
+	}
+	return 0
 }
 
-func (x *TestSessionResponse) Reset() {
-	*x = TestSessionResponse{}
-	mi := &file_cbt_proto_msgTypes[83]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScoreSuggestion) GetHasKeywordCoverage() bool {
+	if x != nil {
+		return x.HasKeywordCoverage
+	}
+	return false
 }
 
-func (x *TestSessionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScoreSuggestion) GetReferenceOverlap() float64 {
+	if x != nil {
+		return x.ReferenceOverlap
+	}
+	return 0
 }
 
-func (*TestSessionResponse) ProtoMessage() {}
+func (x *ScoreSuggestion) GetHasReferenceOverlap() bool {
+	if x != nil {
+		return x.HasReferenceOverlap
+	}
+	return false
+}
 
-func (x *TestSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[83]
+func (x *ScoreSuggestion) GetKeywordMatches() []*KeywordMatch {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.KeywordMatches
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TestSessionResponse.ProtoReflect.Descriptor instead.
-func (*TestSessionResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{83}
+func (x *ScoreSuggestion) GetMissingKeywords() []string {
+	if x != nil {
+		return x.MissingKeywords
+	}
+	return nil
 }
 
-func (x *TestSessionResponse) GetTestSession() *TestSession {
+func (x *ScoreSuggestion) GetReferencePassages() []*MatchedPassage {
 	if x != nil {
-		return x.TestSession
+		return x.ReferencePassages
 	}
 	return nil
 }
 
-type ListTestSessionsRequest struct {
+func (x *ScoreSuggestion) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GenerateScoreSuggestionsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	IdTingkat       int32                  `protobuf:"varint,1,opt,name=id_tingkat,json=idTingkat,proto3" json:"id_tingkat,omitempty"`
-	IdMataPelajaran int32                  `protobuf:"varint,2,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"`
-	Status          TestStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=base.TestStatus" json:"status,omitempty"`
-	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal          int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"` // 0 = every essay soal of the assignment
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListTestSessionsRequest) Reset() {
-	*x = ListTestSessionsRequest{}
-	mi := &file_cbt_proto_msgTypes[84]
+func (x *GenerateScoreSuggestionsRequest) Reset() {
+	*x = GenerateScoreSuggestionsRequest{}
+	mi := &file_cbt_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTestSessionsRequest) String() string {
+func (x *GenerateScoreSuggestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTestSessionsRequest) ProtoMessage() {}
+func (*GenerateScoreSuggestionsRequest) ProtoMessage() {}
 
-func (x *ListTestSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[84]
+func (x *GenerateScoreSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6518,62 +15761,51 @@ func (x *ListTestSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTestSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListTestSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use GenerateScoreSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateScoreSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *ListTestSessionsRequest) GetIdTingkat() int32 {
+func (x *GenerateScoreSuggestionsRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.IdTingkat
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *ListTestSessionsRequest) GetIdMataPelajaran() int32 {
+func (x *GenerateScoreSuggestionsRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.IdSoal
 	}
 	return 0
 }
 
-func (x *ListTestSessionsRequest) GetStatus() TestStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TestStatus_STATUS_INVALID
-}
-
-func (x *ListTestSessionsRequest) GetPagination() *PaginationRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-type ListTestSessionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TestSessions  []*TestSession         `protobuf:"bytes,1,rep,name=test_sessions,json=testSessions,proto3" json:"test_sessions,omitempty"`
-	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GenerateScoreSuggestionsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId  int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal           int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	AnswersScored    int32                  `protobuf:"varint,3,opt,name=answers_scored,json=answersScored,proto3" json:"answers_scored,omitempty"`
+	SkippedNoKeyData int32                  `protobuf:"varint,4,opt,name=skipped_no_key_data,json=skippedNoKeyData,proto3" json:"skipped_no_key_data,omitempty"` // Soal without keywords or answer key
+	ComputedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListTestSessionsResponse) Reset() {
-	*x = ListTestSessionsResponse{}
-	mi := &file_cbt_proto_msgTypes[85]
+func (x *GenerateScoreSuggestionsResponse) Reset() {
+	*x = GenerateScoreSuggestionsResponse{}
+	mi := &file_cbt_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTestSessionsResponse) String() string {
+func (x *GenerateScoreSuggestionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTestSessionsResponse) ProtoMessage() {}
+func (*GenerateScoreSuggestionsResponse) ProtoMessage() {}
 
-func (x *ListTestSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[85]
+func (x *GenerateScoreSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6584,48 +15816,69 @@ func (x *ListTestSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTestSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListTestSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use GenerateScoreSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateScoreSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *ListTestSessionsResponse) GetTestSessions() []*TestSession {
+func (x *GenerateScoreSuggestionsResponse) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.TestSessions
+		return x.LmsAssignmentId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListTestSessionsResponse) GetPagination() *PaginationResponse {
+func (x *GenerateScoreSuggestionsResponse) GetIdSoal() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.IdSoal
+	}
+	return 0
+}
+
+func (x *GenerateScoreSuggestionsResponse) GetAnswersScored() int32 {
+	if x != nil {
+		return x.AnswersScored
+	}
+	return 0
+}
+
+func (x *GenerateScoreSuggestionsResponse) GetSkippedNoKeyData() int32 {
+	if x != nil {
+		return x.SkippedNoKeyData
+	}
+	return 0
+}
+
+func (x *GenerateScoreSuggestionsResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
 	}
 	return nil
 }
 
-type GetTestQuestionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"` // Question number to fetch (1-20, etc.)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetSuggestionAgreementRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal          int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetTestQuestionsRequest) Reset() {
-	*x = GetTestQuestionsRequest{}
-	mi := &file_cbt_proto_msgTypes[86]
+func (x *GetSuggestionAgreementRequest) Reset() {
+	*x = GetSuggestionAgreementRequest{}
+	mi := &file_cbt_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTestQuestionsRequest) String() string {
+func (x *GetSuggestionAgreementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTestQuestionsRequest) ProtoMessage() {}
+func (*GetSuggestionAgreementRequest) ProtoMessage() {}
 
-func (x *GetTestQuestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[86]
+func (x *GetSuggestionAgreementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6636,53 +15889,54 @@ func (x *GetTestQuestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTestQuestionsRequest.ProtoReflect.Descriptor instead.
-func (*GetTestQuestionsRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GetSuggestionAgreementRequest.ProtoReflect.Descriptor instead.
+func (*GetSuggestionAgreementRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *GetTestQuestionsRequest) GetSessionToken() string {
+func (x *GetSuggestionAgreementRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.SessionToken
+		return x.LmsAssignmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTestQuestionsRequest) GetNomorUrut() int32 {
+func (x *GetSuggestionAgreementRequest) GetIdSoal() int32 {
 	if x != nil {
-		return x.NomorUrut
+		return x.IdSoal
 	}
 	return 0
 }
 
-type TestQuestionsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken     string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	Questions        []*QuestionForStudent  `protobuf:"bytes,2,rep,name=questions,proto3" json:"questions,omitempty"` // ALL questions for the session (mixed types)
-	TotalSoal        int32                  `protobuf:"varint,3,opt,name=total_soal,json=totalSoal,proto3" json:"total_soal,omitempty"`
-	CurrentNomorUrut int32                  `protobuf:"varint,4,opt,name=current_nomor_urut,json=currentNomorUrut,proto3" json:"current_nomor_urut,omitempty"`
-	DijawabCount     int32                  `protobuf:"varint,5,opt,name=dijawab_count,json=dijawabCount,proto3" json:"dijawab_count,omitempty"`
-	IsAnsweredStatus []bool                 `protobuf:"varint,6,rep,packed,name=is_answered_status,json=isAnsweredStatus,proto3" json:"is_answered_status,omitempty"` // Bool array for all questions (sidebar status)
-	BatasWaktu       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=batas_waktu,json=batasWaktu,proto3" json:"batas_waktu,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type SuggestionAgreementResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId        int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	IdSoal                 int32                  `protobuf:"varint,2,opt,name=id_soal,json=idSoal,proto3" json:"id_soal,omitempty"`
+	GradedWithSuggestion   int32                  `protobuf:"varint,3,opt,name=graded_with_suggestion,json=gradedWithSuggestion,proto3" json:"graded_with_suggestion,omitempty"`
+	Agreed                 int32                  `protobuf:"varint,4,opt,name=agreed,proto3" json:"agreed,omitempty"`                                          // Final score within the agreement tolerance of the suggestion
+	AcceptedExactly        int32                  `protobuf:"varint,5,opt,name=accepted_exactly,json=acceptedExactly,proto3" json:"accepted_exactly,omitempty"` // Final score given by accepting the suggestion
+	AgreementRate          float64                `protobuf:"fixed64,6,opt,name=agreement_rate,json=agreementRate,proto3" json:"agreement_rate,omitempty"`
+	MeanAbsoluteDifference float64                `protobuf:"fixed64,7,opt,name=mean_absolute_difference,json=meanAbsoluteDifference,proto3" json:"mean_absolute_difference,omitempty"`
+	Tolerance              float64                `protobuf:"fixed64,8,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *TestQuestionsResponse) Reset() {
-	*x = TestQuestionsResponse{}
-	mi := &file_cbt_proto_msgTypes[87]
+func (x *SuggestionAgreementResponse) Reset() {
+	*x = SuggestionAgreementResponse{}
+	mi := &file_cbt_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestQuestionsResponse) String() string {
+func (x *SuggestionAgreementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestQuestionsResponse) ProtoMessage() {}
+func (*SuggestionAgreementResponse) ProtoMessage() {}
 
-func (x *TestQuestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[87]
+func (x *SuggestionAgreementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6693,84 +15947,95 @@ func (x *TestQuestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestQuestionsResponse.ProtoReflect.Descriptor instead.
-func (*TestQuestionsResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use SuggestionAgreementResponse.ProtoReflect.Descriptor instead.
+func (*SuggestionAgreementResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *TestQuestionsResponse) GetSessionToken() string {
+func (x *SuggestionAgreementResponse) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.SessionToken
+		return x.LmsAssignmentId
 	}
-	return ""
+	return 0
 }
 
-func (x *TestQuestionsResponse) GetQuestions() []*QuestionForStudent {
+func (x *SuggestionAgreementResponse) GetIdSoal() int32 {
 	if x != nil {
-		return x.Questions
+		return x.IdSoal
 	}
-	return nil
+	return 0
 }
 
-func (x *TestQuestionsResponse) GetTotalSoal() int32 {
+func (x *SuggestionAgreementResponse) GetGradedWithSuggestion() int32 {
 	if x != nil {
-		return x.TotalSoal
+		return x.GradedWithSuggestion
 	}
 	return 0
 }
 
-func (x *TestQuestionsResponse) GetCurrentNomorUrut() int32 {
+func (x *SuggestionAgreementResponse) GetAgreed() int32 {
 	if x != nil {
-		return x.CurrentNomorUrut
+		return x.Agreed
 	}
 	return 0
 }
 
-func (x *TestQuestionsResponse) GetDijawabCount() int32 {
+func (x *SuggestionAgreementResponse) GetAcceptedExactly() int32 {
 	if x != nil {
-		return x.DijawabCount
+		return x.AcceptedExactly
 	}
 	return 0
 }
 
-func (x *TestQuestionsResponse) GetIsAnsweredStatus() []bool {
+func (x *SuggestionAgreementResponse) GetAgreementRate() float64 {
 	if x != nil {
-		return x.IsAnsweredStatus
+		return x.AgreementRate
 	}
-	return nil
+	return 0
 }
 
-func (x *TestQuestionsResponse) GetBatasWaktu() *timestamppb.Timestamp {
+func (x *SuggestionAgreementResponse) GetMeanAbsoluteDifference() float64 {
 	if x != nil {
-		return x.BatasWaktu
+		return x.MeanAbsoluteDifference
 	}
-	return nil
+	return 0
 }
 
-type SubmitAnswerRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	JawabanDipilih JawabanOption          `protobuf:"varint,3,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SuggestionAgreementResponse) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
 }
 
-func (x *SubmitAnswerRequest) Reset() {
-	*x = SubmitAnswerRequest{}
-	mi := &file_cbt_proto_msgTypes[88]
+// Short answer / cloze blank. The rules default to lenient matching: case, repeated
+// whitespace and diacritics are ignored unless the flag asks to keep them.
+type ShortAnswerBlank struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AcceptedAnswers []string               `protobuf:"bytes,1,rep,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+	CaseSensitive   bool                   `protobuf:"varint,2,opt,name=case_sensitive,json=caseSensitive,proto3" json:"case_sensitive,omitempty"`
+	ExactWhitespace bool                   `protobuf:"varint,3,opt,name=exact_whitespace,json=exactWhitespace,proto3" json:"exact_whitespace,omitempty"`
+	KeepDiacritics  bool                   `protobuf:"varint,4,opt,name=keep_diacritics,json=keepDiacritics,proto3" json:"keep_diacritics,omitempty"`
+	Regex           string                 `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"` // Optional, must match the whole response
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShortAnswerBlank) Reset() {
+	*x = ShortAnswerBlank{}
+	mi := &file_cbt_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAnswerRequest) String() string {
+func (x *ShortAnswerBlank) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAnswerRequest) ProtoMessage() {}
+func (*ShortAnswerBlank) ProtoMessage() {}
 
-func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[88]
+func (x *ShortAnswerBlank) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6781,58 +16046,70 @@ func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
-func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ShortAnswerBlank.ProtoReflect.Descriptor instead.
+func (*ShortAnswerBlank) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *SubmitAnswerRequest) GetSessionToken() string {
+func (x *ShortAnswerBlank) GetAcceptedAnswers() []string {
 	if x != nil {
-		return x.SessionToken
+		return x.AcceptedAnswers
 	}
-	return ""
+	return nil
 }
 
-func (x *SubmitAnswerRequest) GetNomorUrut() int32 {
+func (x *ShortAnswerBlank) GetCaseSensitive() bool {
 	if x != nil {
-		return x.NomorUrut
+		return x.CaseSensitive
 	}
-	return 0
+	return false
 }
 
-func (x *SubmitAnswerRequest) GetJawabanDipilih() JawabanOption {
+func (x *ShortAnswerBlank) GetExactWhitespace() bool {
 	if x != nil {
-		return x.JawabanDipilih
+		return x.ExactWhitespace
 	}
-	return JawabanOption_JAWABAN_INVALID
+	return false
 }
 
-type SubmitAnswerResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	JawabanDipilih JawabanOption          `protobuf:"varint,3,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
-	IsCorrect      bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"` // Immediate feedback
-	DijawabPada    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ShortAnswerBlank) GetKeepDiacritics() bool {
+	if x != nil {
+		return x.KeepDiacritics
+	}
+	return false
 }
 
-func (x *SubmitAnswerResponse) Reset() {
-	*x = SubmitAnswerResponse{}
-	mi := &file_cbt_proto_msgTypes[89]
+func (x *ShortAnswerBlank) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+type SubmitShortAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Jawaban       []string               `protobuf:"bytes,3,rep,name=jawaban,proto3" json:"jawaban,omitempty"` // One response per blank, in blank order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitShortAnswerRequest) Reset() {
+	*x = SubmitShortAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAnswerResponse) String() string {
+func (x *SubmitShortAnswerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAnswerResponse) ProtoMessage() {}
+func (*SubmitShortAnswerRequest) ProtoMessage() {}
 
-func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[89]
+func (x *SubmitShortAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6843,70 +16120,57 @@ func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAnswerResponse.ProtoReflect.Descriptor instead.
-func (*SubmitAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use SubmitShortAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitShortAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *SubmitAnswerResponse) GetSessionToken() string {
+func (x *SubmitShortAnswerRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *SubmitAnswerResponse) GetNomorUrut() int32 {
+func (x *SubmitShortAnswerRequest) GetNomorUrut() int32 {
 	if x != nil {
 		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *SubmitAnswerResponse) GetJawabanDipilih() JawabanOption {
-	if x != nil {
-		return x.JawabanDipilih
-	}
-	return JawabanOption_JAWABAN_INVALID
-}
-
-func (x *SubmitAnswerResponse) GetIsCorrect() bool {
-	if x != nil {
-		return x.IsCorrect
-	}
-	return false
-}
-
-func (x *SubmitAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+func (x *SubmitShortAnswerRequest) GetJawaban() []string {
 	if x != nil {
-		return x.DijawabPada
+		return x.Jawaban
 	}
 	return nil
 }
 
-type SubmitComplexAnswerRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	JawabanDipilih []JawabanOption        `protobuf:"varint,3,rep,packed,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SubmitShortAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Jawaban       []string               `protobuf:"bytes,3,rep,name=jawaban,proto3" json:"jawaban,omitempty"`
+	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitComplexAnswerRequest) Reset() {
-	*x = SubmitComplexAnswerRequest{}
-	mi := &file_cbt_proto_msgTypes[90]
+func (x *SubmitShortAnswerResponse) Reset() {
+	*x = SubmitShortAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitComplexAnswerRequest) String() string {
+func (x *SubmitShortAnswerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitComplexAnswerRequest) ProtoMessage() {}
+func (*SubmitShortAnswerResponse) ProtoMessage() {}
 
-func (x *SubmitComplexAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[90]
+func (x *SubmitShortAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6917,58 +16181,72 @@ func (x *SubmitComplexAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitComplexAnswerRequest.ProtoReflect.Descriptor instead.
-func (*SubmitComplexAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use SubmitShortAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitShortAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *SubmitComplexAnswerRequest) GetSessionToken() string {
+func (x *SubmitShortAnswerResponse) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *SubmitComplexAnswerRequest) GetNomorUrut() int32 {
+func (x *SubmitShortAnswerResponse) GetNomorUrut() int32 {
 	if x != nil {
 		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *SubmitComplexAnswerRequest) GetJawabanDipilih() []JawabanOption {
+func (x *SubmitShortAnswerResponse) GetJawaban() []string {
 	if x != nil {
-		return x.JawabanDipilih
+		return x.Jawaban
 	}
 	return nil
 }
 
-type SubmitComplexAnswerResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	JawabanDipilih []JawabanOption        `protobuf:"varint,3,rep,packed,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
-	IsCorrect      bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
-	DijawabPada    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SubmitShortAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DijawabPada
+	}
+	return nil
 }
 
-func (x *SubmitComplexAnswerResponse) Reset() {
-	*x = SubmitComplexAnswerResponse{}
-	mi := &file_cbt_proto_msgTypes[91]
+// Numeric answer key. A response is correct within the larger of the two tolerances;
+// with neither set it must equal the value.
+type NumericAnswerKey struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Value              float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	AbsTolerance       float64                `protobuf:"fixed64,2,opt,name=abs_tolerance,json=absTolerance,proto3" json:"abs_tolerance,omitempty"`
+	RelTolerance       float64                `protobuf:"fixed64,3,opt,name=rel_tolerance,json=relTolerance,proto3" json:"rel_tolerance,omitempty"` // Fraction of the value, e.g. 0.01 for 1%
+	Unit               string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	AcceptedUnits      []string               `protobuf:"bytes,5,rep,name=accepted_units,json=acceptedUnits,proto3" json:"accepted_units,omitempty"`
+	UnitRequired       bool                   `protobuf:"varint,6,opt,name=unit_required,json=unitRequired,proto3" json:"unit_required,omitempty"`
+	SignificantFigures int32                  `protobuf:"varint,7,opt,name=significant_figures,json=significantFigures,proto3" json:"significant_figures,omitempty"` // 0 = not checked
+	// "," or "." fixes the decimal separator of responses. Empty accepts both and rejects
+	// the ambiguous "1.000" and "1,000".
+	DecimalSeparator string `protobuf:"bytes,8,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NumericAnswerKey) Reset() {
+	*x = NumericAnswerKey{}
+	mi := &file_cbt_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitComplexAnswerResponse) String() string {
+func (x *NumericAnswerKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitComplexAnswerResponse) ProtoMessage() {}
+func (*NumericAnswerKey) ProtoMessage() {}
 
-func (x *SubmitComplexAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[91]
+func (x *NumericAnswerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6979,70 +16257,91 @@ func (x *SubmitComplexAnswerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitComplexAnswerResponse.ProtoReflect.Descriptor instead.
-func (*SubmitComplexAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use NumericAnswerKey.ProtoReflect.Descriptor instead.
+func (*NumericAnswerKey) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *SubmitComplexAnswerResponse) GetSessionToken() string {
+func (x *NumericAnswerKey) GetValue() float64 {
 	if x != nil {
-		return x.SessionToken
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *SubmitComplexAnswerResponse) GetNomorUrut() int32 {
+func (x *NumericAnswerKey) GetAbsTolerance() float64 {
 	if x != nil {
-		return x.NomorUrut
+		return x.AbsTolerance
 	}
 	return 0
 }
 
-func (x *SubmitComplexAnswerResponse) GetJawabanDipilih() []JawabanOption {
+func (x *NumericAnswerKey) GetRelTolerance() float64 {
 	if x != nil {
-		return x.JawabanDipilih
+		return x.RelTolerance
+	}
+	return 0
+}
+
+func (x *NumericAnswerKey) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *NumericAnswerKey) GetAcceptedUnits() []string {
+	if x != nil {
+		return x.AcceptedUnits
 	}
 	return nil
 }
 
-func (x *SubmitComplexAnswerResponse) GetIsCorrect() bool {
+func (x *NumericAnswerKey) GetUnitRequired() bool {
 	if x != nil {
-		return x.IsCorrect
+		return x.UnitRequired
 	}
 	return false
 }
 
-func (x *SubmitComplexAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+func (x *NumericAnswerKey) GetSignificantFigures() int32 {
 	if x != nil {
-		return x.DijawabPada
+		return x.SignificantFigures
 	}
-	return nil
+	return 0
 }
 
-type SubmitDragDropAnswerRequest struct {
+func (x *NumericAnswerKey) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+type SubmitNumericAnswerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	Answer        map[int32]int32        `protobuf:"bytes,3,rep,name=answer,proto3" json:"answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // For ORDERING: position->itemId, For MATCHING: slotId->itemId
+	Jawaban       string                 `protobuf:"bytes,3,opt,name=jawaban,proto3" json:"jawaban,omitempty"` // e.g. "9,8 m/s2"; see NumericAnswerKey.decimal_separator
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitDragDropAnswerRequest) Reset() {
-	*x = SubmitDragDropAnswerRequest{}
-	mi := &file_cbt_proto_msgTypes[92]
+func (x *SubmitNumericAnswerRequest) Reset() {
+	*x = SubmitNumericAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitDragDropAnswerRequest) String() string {
+func (x *SubmitNumericAnswerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitDragDropAnswerRequest) ProtoMessage() {}
+func (*SubmitNumericAnswerRequest) ProtoMessage() {}
 
-func (x *SubmitDragDropAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[92]
+func (x *SubmitNumericAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7053,58 +16352,57 @@ func (x *SubmitDragDropAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitDragDropAnswerRequest.ProtoReflect.Descriptor instead.
-func (*SubmitDragDropAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use SubmitNumericAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitNumericAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *SubmitDragDropAnswerRequest) GetSessionToken() string {
+func (x *SubmitNumericAnswerRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *SubmitDragDropAnswerRequest) GetNomorUrut() int32 {
+func (x *SubmitNumericAnswerRequest) GetNomorUrut() int32 {
 	if x != nil {
 		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *SubmitDragDropAnswerRequest) GetAnswer() map[int32]int32 {
+func (x *SubmitNumericAnswerRequest) GetJawaban() string {
 	if x != nil {
-		return x.Answer
+		return x.Jawaban
 	}
-	return nil
+	return ""
 }
 
-type SubmitDragDropAnswerResponse struct {
+type SubmitNumericAnswerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
 	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	Answer        map[int32]int32        `protobuf:"bytes,3,rep,name=answer,proto3" json:"answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	IsCorrect     bool                   `protobuf:"varint,4,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
-	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	Jawaban       string                 `protobuf:"bytes,3,opt,name=jawaban,proto3" json:"jawaban,omitempty"`
+	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitDragDropAnswerResponse) Reset() {
-	*x = SubmitDragDropAnswerResponse{}
-	mi := &file_cbt_proto_msgTypes[93]
+func (x *SubmitNumericAnswerResponse) Reset() {
+	*x = SubmitNumericAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitDragDropAnswerResponse) String() string {
+func (x *SubmitNumericAnswerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitDragDropAnswerResponse) ProtoMessage() {}
+func (*SubmitNumericAnswerResponse) ProtoMessage() {}
 
-func (x *SubmitDragDropAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[93]
+func (x *SubmitNumericAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7115,70 +16413,64 @@ func (x *SubmitDragDropAnswerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitDragDropAnswerResponse.ProtoReflect.Descriptor instead.
-func (*SubmitDragDropAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use SubmitNumericAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitNumericAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *SubmitDragDropAnswerResponse) GetSessionToken() string {
+func (x *SubmitNumericAnswerResponse) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *SubmitDragDropAnswerResponse) GetNomorUrut() int32 {
+func (x *SubmitNumericAnswerResponse) GetNomorUrut() int32 {
 	if x != nil {
 		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *SubmitDragDropAnswerResponse) GetAnswer() map[int32]int32 {
-	if x != nil {
-		return x.Answer
-	}
-	return nil
-}
-
-func (x *SubmitDragDropAnswerResponse) GetIsCorrect() bool {
+func (x *SubmitNumericAnswerResponse) GetJawaban() string {
 	if x != nil {
-		return x.IsCorrect
+		return x.Jawaban
 	}
-	return false
+	return ""
 }
 
-func (x *SubmitDragDropAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+func (x *SubmitNumericAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DijawabPada
 	}
 	return nil
 }
 
-type SubmitEssayAnswerRequest struct {
+// Answer option of a choice question. Labels run A, B, C, ... up to J in display order.
+type SoalOpsi struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	JawabanEssay  string                 `protobuf:"bytes,3,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Teks          string                 `protobuf:"bytes,2,opt,name=teks,proto3" json:"teks,omitempty"`
+	TeksHtml      string                 `protobuf:"bytes,3,opt,name=teks_html,json=teksHtml,proto3" json:"teks_html,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitEssayAnswerRequest) Reset() {
-	*x = SubmitEssayAnswerRequest{}
-	mi := &file_cbt_proto_msgTypes[94]
+func (x *SoalOpsi) Reset() {
+	*x = SoalOpsi{}
+	mi := &file_cbt_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitEssayAnswerRequest) String() string {
+func (x *SoalOpsi) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitEssayAnswerRequest) ProtoMessage() {}
+func (*SoalOpsi) ProtoMessage() {}
 
-func (x *SubmitEssayAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[94]
+func (x *SoalOpsi) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7189,57 +16481,115 @@ func (x *SubmitEssayAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitEssayAnswerRequest.ProtoReflect.Descriptor instead.
-func (*SubmitEssayAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use SoalOpsi.ProtoReflect.Descriptor instead.
+func (*SoalOpsi) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *SubmitEssayAnswerRequest) GetSessionToken() string {
+func (x *SoalOpsi) GetLabel() string {
 	if x != nil {
-		return x.SessionToken
+		return x.Label
 	}
 	return ""
 }
 
-func (x *SubmitEssayAnswerRequest) GetNomorUrut() int32 {
+func (x *SoalOpsi) GetTeks() string {
 	if x != nil {
-		return x.NomorUrut
+		return x.Teks
+	}
+	return ""
+}
+
+func (x *SoalOpsi) GetTeksHtml() string {
+	if x != nil {
+		return x.TeksHtml
+	}
+	return ""
+}
+
+// Position on a hotspot image as a fraction of its width and height, 0,0 is the top left
+type HotspotPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HotspotPoint) Reset() {
+	*x = HotspotPoint{}
+	mi := &file_cbt_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HotspotPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HotspotPoint) ProtoMessage() {}
+
+func (x *HotspotPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HotspotPoint.ProtoReflect.Descriptor instead.
+func (*HotspotPoint) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *HotspotPoint) GetX() float64 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-func (x *SubmitEssayAnswerRequest) GetJawabanEssay() string {
+func (x *HotspotPoint) GetY() float64 {
 	if x != nil {
-		return x.JawabanEssay
+		return x.Y
 	}
-	return ""
+	return 0
 }
 
-type SubmitEssayAnswerResponse struct {
+// Correct area of a hotspot image. Rectangles use x, y (top left), width and height;
+// polygons use points.
+type HotspotRegion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	JawabanEssay  string                 `protobuf:"bytes,3,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
-	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Shape         HotspotShape           `protobuf:"varint,2,opt,name=shape,proto3,enum=base.HotspotShape" json:"shape,omitempty"`
+	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Width         float64                `protobuf:"fixed64,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        float64                `protobuf:"fixed64,6,opt,name=height,proto3" json:"height,omitempty"`
+	Points        []*HotspotPoint        `protobuf:"bytes,7,rep,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitEssayAnswerResponse) Reset() {
-	*x = SubmitEssayAnswerResponse{}
-	mi := &file_cbt_proto_msgTypes[95]
+func (x *HotspotRegion) Reset() {
+	*x = HotspotRegion{}
+	mi := &file_cbt_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitEssayAnswerResponse) String() string {
+func (x *HotspotRegion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitEssayAnswerResponse) ProtoMessage() {}
+func (*HotspotRegion) ProtoMessage() {}
 
-func (x *SubmitEssayAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[95]
+func (x *HotspotRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7250,62 +16600,87 @@ func (x *SubmitEssayAnswerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitEssayAnswerResponse.ProtoReflect.Descriptor instead.
-func (*SubmitEssayAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use HotspotRegion.ProtoReflect.Descriptor instead.
+func (*HotspotRegion) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *SubmitEssayAnswerResponse) GetSessionToken() string {
+func (x *HotspotRegion) GetLabel() string {
 	if x != nil {
-		return x.SessionToken
+		return x.Label
 	}
 	return ""
 }
 
-func (x *SubmitEssayAnswerResponse) GetNomorUrut() int32 {
+func (x *HotspotRegion) GetShape() HotspotShape {
 	if x != nil {
-		return x.NomorUrut
+		return x.Shape
+	}
+	return HotspotShape_HOTSPOT_SHAPE_INVALID
+}
+
+func (x *HotspotRegion) GetX() float64 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-func (x *SubmitEssayAnswerResponse) GetJawabanEssay() string {
+func (x *HotspotRegion) GetY() float64 {
 	if x != nil {
-		return x.JawabanEssay
+		return x.Y
 	}
-	return ""
+	return 0
 }
 
-func (x *SubmitEssayAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+func (x *HotspotRegion) GetWidth() float64 {
 	if x != nil {
-		return x.DijawabPada
+		return x.Width
+	}
+	return 0
+}
+
+func (x *HotspotRegion) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *HotspotRegion) GetPoints() []*HotspotPoint {
+	if x != nil {
+		return x.Points
 	}
 	return nil
 }
 
-type ClearAnswerRequest struct {
+// Hotspot answer key. By default one point must fall in any region; with require_all every
+// region must be marked and no point may fall outside them.
+type HotspotAnswerKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	ImageUrutan   int32                  `protobuf:"varint,1,opt,name=image_urutan,json=imageUrutan,proto3" json:"image_urutan,omitempty"` // Urutan of the soal gambar the regions are drawn on
+	Regions       []*HotspotRegion       `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`
+	RequireAll    bool                   `protobuf:"varint,3,opt,name=require_all,json=requireAll,proto3" json:"require_all,omitempty"`
+	MaxPoints     int32                  `protobuf:"varint,4,opt,name=max_points,json=maxPoints,proto3" json:"max_points,omitempty"` // 0 = one point, or one per region with require_all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearAnswerRequest) Reset() {
-	*x = ClearAnswerRequest{}
-	mi := &file_cbt_proto_msgTypes[96]
+func (x *HotspotAnswerKey) Reset() {
+	*x = HotspotAnswerKey{}
+	mi := &file_cbt_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearAnswerRequest) String() string {
+func (x *HotspotAnswerKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearAnswerRequest) ProtoMessage() {}
+func (*HotspotAnswerKey) ProtoMessage() {}
 
-func (x *ClearAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[96]
+func (x *HotspotAnswerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7316,49 +16691,63 @@ func (x *ClearAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearAnswerRequest.ProtoReflect.Descriptor instead.
-func (*ClearAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use HotspotAnswerKey.ProtoReflect.Descriptor instead.
+func (*HotspotAnswerKey) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *ClearAnswerRequest) GetSessionToken() string {
+func (x *HotspotAnswerKey) GetImageUrutan() int32 {
 	if x != nil {
-		return x.SessionToken
+		return x.ImageUrutan
 	}
-	return ""
+	return 0
 }
 
-func (x *ClearAnswerRequest) GetNomorUrut() int32 {
+func (x *HotspotAnswerKey) GetRegions() []*HotspotRegion {
 	if x != nil {
-		return x.NomorUrut
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *HotspotAnswerKey) GetRequireAll() bool {
+	if x != nil {
+		return x.RequireAll
+	}
+	return false
+}
+
+func (x *HotspotAnswerKey) GetMaxPoints() int32 {
+	if x != nil {
+		return x.MaxPoints
 	}
 	return 0
 }
 
-type ClearAnswerResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	NomorUrut      int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	DibatalkanPada *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dibatalkan_pada,json=dibatalkanPada,proto3" json:"dibatalkan_pada,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SubmitHotspotAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Points        []*HotspotPoint        `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearAnswerResponse) Reset() {
-	*x = ClearAnswerResponse{}
-	mi := &file_cbt_proto_msgTypes[97]
+func (x *SubmitHotspotAnswerRequest) Reset() {
+	*x = SubmitHotspotAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearAnswerResponse) String() string {
+func (x *SubmitHotspotAnswerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearAnswerResponse) ProtoMessage() {}
+func (*SubmitHotspotAnswerRequest) ProtoMessage() {}
 
-func (x *ClearAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[97]
+func (x *SubmitHotspotAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7369,54 +16758,57 @@ func (x *ClearAnswerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearAnswerResponse.ProtoReflect.Descriptor instead.
-func (*ClearAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use SubmitHotspotAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitHotspotAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *ClearAnswerResponse) GetSessionToken() string {
+func (x *SubmitHotspotAnswerRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *ClearAnswerResponse) GetNomorUrut() int32 {
+func (x *SubmitHotspotAnswerRequest) GetNomorUrut() int32 {
 	if x != nil {
 		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *ClearAnswerResponse) GetDibatalkanPada() *timestamppb.Timestamp {
+func (x *SubmitHotspotAnswerRequest) GetPoints() []*HotspotPoint {
 	if x != nil {
-		return x.DibatalkanPada
+		return x.Points
 	}
 	return nil
 }
 
-type CompleteSessionRequest struct {
+type SubmitHotspotAnswerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Points        []*HotspotPoint        `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteSessionRequest) Reset() {
-	*x = CompleteSessionRequest{}
-	mi := &file_cbt_proto_msgTypes[98]
+func (x *SubmitHotspotAnswerResponse) Reset() {
+	*x = SubmitHotspotAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteSessionRequest) String() string {
+func (x *SubmitHotspotAnswerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteSessionRequest) ProtoMessage() {}
+func (*SubmitHotspotAnswerResponse) ProtoMessage() {}
 
-func (x *CompleteSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[98]
+func (x *SubmitHotspotAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7427,40 +16819,63 @@ func (x *CompleteSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteSessionRequest.ProtoReflect.Descriptor instead.
-func (*CompleteSessionRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use SubmitHotspotAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitHotspotAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *CompleteSessionRequest) GetSessionToken() string {
+func (x *SubmitHotspotAnswerResponse) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-type GetTestResultRequest struct {
+func (x *SubmitHotspotAnswerResponse) GetNomorUrut() int32 {
+	if x != nil {
+		return x.NomorUrut
+	}
+	return 0
+}
+
+func (x *SubmitHotspotAnswerResponse) GetPoints() []*HotspotPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *SubmitHotspotAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DijawabPada
+	}
+	return nil
+}
+
+// Statement of a grid question with the index of its correct column
+type GridRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Teks          string                 `protobuf:"bytes,1,opt,name=teks,proto3" json:"teks,omitempty"`
+	KolomBenar    int32                  `protobuf:"varint,2,opt,name=kolom_benar,json=kolomBenar,proto3" json:"kolom_benar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTestResultRequest) Reset() {
-	*x = GetTestResultRequest{}
-	mi := &file_cbt_proto_msgTypes[99]
+func (x *GridRow) Reset() {
+	*x = GridRow{}
+	mi := &file_cbt_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTestResultRequest) String() string {
+func (x *GridRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTestResultRequest) ProtoMessage() {}
+func (*GridRow) ProtoMessage() {}
 
-func (x *GetTestResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[99]
+func (x *GridRow) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7471,62 +16886,51 @@ func (x *GetTestResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTestResultRequest.ProtoReflect.Descriptor instead.
-func (*GetTestResultRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GridRow.ProtoReflect.Descriptor instead.
+func (*GridRow) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *GetTestResultRequest) GetSessionToken() string {
+func (x *GridRow) GetTeks() string {
 	if x != nil {
-		return x.SessionToken
+		return x.Teks
 	}
 	return ""
 }
 
-type JawabanDetail struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	NomorUrut             int32                  `protobuf:"varint,1,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
-	Pertanyaan            string                 `protobuf:"bytes,2,opt,name=pertanyaan,proto3" json:"pertanyaan,omitempty"`
-	OpsiA                 string                 `protobuf:"bytes,3,opt,name=opsi_a,json=opsiA,proto3" json:"opsi_a,omitempty"`
-	OpsiB                 string                 `protobuf:"bytes,4,opt,name=opsi_b,json=opsiB,proto3" json:"opsi_b,omitempty"`
-	OpsiC                 string                 `protobuf:"bytes,5,opt,name=opsi_c,json=opsiC,proto3" json:"opsi_c,omitempty"`
-	OpsiD                 string                 `protobuf:"bytes,6,opt,name=opsi_d,json=opsiD,proto3" json:"opsi_d,omitempty"`
-	JawabanDipilih        JawabanOption          `protobuf:"varint,7,opt,name=jawaban_dipilih,json=jawabanDipilih,proto3,enum=base.JawabanOption" json:"jawaban_dipilih,omitempty"`
-	JawabanBenar          JawabanOption          `protobuf:"varint,8,opt,name=jawaban_benar,json=jawabanBenar,proto3,enum=base.JawabanOption" json:"jawaban_benar,omitempty"`
-	IsCorrect             bool                   `protobuf:"varint,9,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
-	IsAnswered            bool                   `protobuf:"varint,10,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
-	Pembahasan            string                 `protobuf:"bytes,11,opt,name=pembahasan,proto3" json:"pembahasan,omitempty"`
-	Gambar                []*SoalGambar          `protobuf:"bytes,12,rep,name=gambar,proto3" json:"gambar,omitempty"`
-	QuestionType          QuestionType           `protobuf:"varint,13,opt,name=question_type,json=questionType,proto3,enum=base.QuestionType" json:"question_type,omitempty"`
-	DragType              DragDropType           `protobuf:"varint,14,opt,name=drag_type,json=dragType,proto3,enum=base.DragDropType" json:"drag_type,omitempty"`
-	Items                 []*DragItem            `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
-	Slots                 []*DragSlot            `protobuf:"bytes,16,rep,name=slots,proto3" json:"slots,omitempty"`
-	UserDragAnswer        map[int32]int32        `protobuf:"bytes,17,rep,name=user_drag_answer,json=userDragAnswer,proto3" json:"user_drag_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	CorrectDragAnswer     map[int32]int32        `protobuf:"bytes,18,rep,name=correct_drag_answer,json=correctDragAnswer,proto3" json:"correct_drag_answer,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	JawabanEssay          string                 `protobuf:"bytes,19,opt,name=jawaban_essay,json=jawabanEssay,proto3" json:"jawaban_essay,omitempty"`
-	NilaiEssay            float64                `protobuf:"fixed64,20,opt,name=nilai_essay,json=nilaiEssay,proto3" json:"nilai_essay,omitempty"`
-	FeedbackTeacher       string                 `protobuf:"bytes,21,opt,name=feedback_teacher,json=feedbackTeacher,proto3" json:"feedback_teacher,omitempty"`
-	JawabanDipilihComplex []JawabanOption        `protobuf:"varint,22,rep,packed,name=jawaban_dipilih_complex,json=jawabanDipilihComplex,proto3,enum=base.JawabanOption" json:"jawaban_dipilih_complex,omitempty"`
-	JawabanBenarComplex   []JawabanOption        `protobuf:"varint,23,rep,packed,name=jawaban_benar_complex,json=jawabanBenarComplex,proto3,enum=base.JawabanOption" json:"jawaban_benar_complex,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *GridRow) GetKolomBenar() int32 {
+	if x != nil {
+		return x.KolomBenar
+	}
+	return 0
 }
 
-func (x *JawabanDetail) Reset() {
-	*x = JawabanDetail{}
-	mi := &file_cbt_proto_msgTypes[100]
+// Grid answer key. Rows score on their own (3 of 4 right earns 75% of the point) unless
+// all_or_nothing is set.
+type GridAnswerKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       []string               `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"` // e.g. "Benar", "Salah"
+	Rows          []*GridRow             `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	AllOrNothing  bool                   `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GridAnswerKey) Reset() {
+	*x = GridAnswerKey{}
+	mi := &file_cbt_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JawabanDetail) String() string {
+func (x *GridAnswerKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JawabanDetail) ProtoMessage() {}
+func (*GridAnswerKey) ProtoMessage() {}
 
-func (x *JawabanDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[100]
+func (x *GridAnswerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7537,196 +16941,195 @@ func (x *JawabanDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JawabanDetail.ProtoReflect.Descriptor instead.
-func (*JawabanDetail) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *JawabanDetail) GetNomorUrut() int32 {
-	if x != nil {
-		return x.NomorUrut
-	}
-	return 0
+// Deprecated: Use GridAnswerKey.ProtoReflect.Descriptor instead.
+func (*GridAnswerKey) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *JawabanDetail) GetPertanyaan() string {
+func (x *GridAnswerKey) GetColumns() []string {
 	if x != nil {
-		return x.Pertanyaan
+		return x.Columns
 	}
-	return ""
+	return nil
 }
 
-func (x *JawabanDetail) GetOpsiA() string {
+func (x *GridAnswerKey) GetRows() []*GridRow {
 	if x != nil {
-		return x.OpsiA
+		return x.Rows
 	}
-	return ""
+	return nil
 }
 
-func (x *JawabanDetail) GetOpsiB() string {
+func (x *GridAnswerKey) GetAllOrNothing() bool {
 	if x != nil {
-		return x.OpsiB
+		return x.AllOrNothing
 	}
-	return ""
+	return false
 }
 
-func (x *JawabanDetail) GetOpsiC() string {
-	if x != nil {
-		return x.OpsiC
-	}
-	return ""
+type SubmitGridAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Jawaban       []int32                `protobuf:"varint,3,rep,packed,name=jawaban,proto3" json:"jawaban,omitempty"` // Column index per row in row order, -1 leaves a row blank
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JawabanDetail) GetOpsiD() string {
-	if x != nil {
-		return x.OpsiD
-	}
-	return ""
+func (x *SubmitGridAnswerRequest) Reset() {
+	*x = SubmitGridAnswerRequest{}
+	mi := &file_cbt_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *JawabanDetail) GetJawabanDipilih() JawabanOption {
-	if x != nil {
-		return x.JawabanDipilih
-	}
-	return JawabanOption_JAWABAN_INVALID
+func (x *SubmitGridAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JawabanDetail) GetJawabanBenar() JawabanOption {
-	if x != nil {
-		return x.JawabanBenar
-	}
-	return JawabanOption_JAWABAN_INVALID
-}
+func (*SubmitGridAnswerRequest) ProtoMessage() {}
 
-func (x *JawabanDetail) GetIsCorrect() bool {
+func (x *SubmitGridAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[224]
 	if x != nil {
-		return x.IsCorrect
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *JawabanDetail) GetIsAnswered() bool {
-	if x != nil {
-		return x.IsAnswered
-	}
-	return false
+// Deprecated: Use SubmitGridAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitGridAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *JawabanDetail) GetPembahasan() string {
+func (x *SubmitGridAnswerRequest) GetSessionToken() string {
 	if x != nil {
-		return x.Pembahasan
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *JawabanDetail) GetGambar() []*SoalGambar {
+func (x *SubmitGridAnswerRequest) GetNomorUrut() int32 {
 	if x != nil {
-		return x.Gambar
+		return x.NomorUrut
 	}
-	return nil
+	return 0
 }
 
-func (x *JawabanDetail) GetQuestionType() QuestionType {
+func (x *SubmitGridAnswerRequest) GetJawaban() []int32 {
 	if x != nil {
-		return x.QuestionType
+		return x.Jawaban
 	}
-	return QuestionType_QUESTION_TYPE_INVALID
+	return nil
 }
 
-func (x *JawabanDetail) GetDragType() DragDropType {
-	if x != nil {
-		return x.DragType
-	}
-	return DragDropType_DRAG_TYPE_INVALID
+type SubmitGridAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Jawaban       []int32                `protobuf:"varint,3,rep,packed,name=jawaban,proto3" json:"jawaban,omitempty"`
+	DijawabPada   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dijawab_pada,json=dijawabPada,proto3" json:"dijawab_pada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JawabanDetail) GetItems() []*DragItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+func (x *SubmitGridAnswerResponse) Reset() {
+	*x = SubmitGridAnswerResponse{}
+	mi := &file_cbt_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *JawabanDetail) GetSlots() []*DragSlot {
-	if x != nil {
-		return x.Slots
-	}
-	return nil
+func (x *SubmitGridAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JawabanDetail) GetUserDragAnswer() map[int32]int32 {
+func (*SubmitGridAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitGridAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[225]
 	if x != nil {
-		return x.UserDragAnswer
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *JawabanDetail) GetCorrectDragAnswer() map[int32]int32 {
-	if x != nil {
-		return x.CorrectDragAnswer
-	}
-	return nil
+// Deprecated: Use SubmitGridAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitGridAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *JawabanDetail) GetJawabanEssay() string {
+func (x *SubmitGridAnswerResponse) GetSessionToken() string {
 	if x != nil {
-		return x.JawabanEssay
+		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *JawabanDetail) GetNilaiEssay() float64 {
+func (x *SubmitGridAnswerResponse) GetNomorUrut() int32 {
 	if x != nil {
-		return x.NilaiEssay
+		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *JawabanDetail) GetFeedbackTeacher() string {
-	if x != nil {
-		return x.FeedbackTeacher
-	}
-	return ""
-}
-
-func (x *JawabanDetail) GetJawabanDipilihComplex() []JawabanOption {
+func (x *SubmitGridAnswerResponse) GetJawaban() []int32 {
 	if x != nil {
-		return x.JawabanDipilihComplex
+		return x.Jawaban
 	}
 	return nil
 }
 
-func (x *JawabanDetail) GetJawabanBenarComplex() []JawabanOption {
+func (x *SubmitGridAnswerResponse) GetDijawabPada() *timestamppb.Timestamp {
 	if x != nil {
-		return x.JawabanBenarComplex
+		return x.DijawabPada
 	}
 	return nil
 }
 
-type GradeEssayAnswerRequest struct {
+// Audio or video clip of a question as a student sees it. A clip without a play limit is
+// fetched from stream_url with range requests. A limited clip is fetched once from the
+// stream_url returned by RecordMediaPlay, which carries the play's one-shot nonce, and
+// cannot be seeked.
+type QuestionMedia struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AnswerId      int32                  `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
-	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
-	Feedback      string                 `protobuf:"bytes,3,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	IdMedia       int32                  `protobuf:"varint,1,opt,name=id_media,json=idMedia,proto3" json:"id_media,omitempty"`
+	Jenis         MediaJenis             `protobuf:"varint,2,opt,name=jenis,proto3,enum=base.MediaJenis" json:"jenis,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	DurasiDetik   float64                `protobuf:"fixed64,4,opt,name=durasi_detik,json=durasiDetik,proto3" json:"durasi_detik,omitempty"`
+	MaxPutar      int32                  `protobuf:"varint,5,opt,name=max_putar,json=maxPutar,proto3" json:"max_putar,omitempty"` // 0 = unlimited
+	JumlahPutar   int32                  `protobuf:"varint,6,opt,name=jumlah_putar,json=jumlahPutar,proto3" json:"jumlah_putar,omitempty"`
+	SisaPutar     int32                  `protobuf:"varint,7,opt,name=sisa_putar,json=sisaPutar,proto3" json:"sisa_putar,omitempty"` // -1 = unlimited
+	Urutan        int32                  `protobuf:"varint,8,opt,name=urutan,proto3" json:"urutan,omitempty"`
+	Keterangan    string                 `protobuf:"bytes,9,opt,name=keterangan,proto3" json:"keterangan,omitempty"`
+	StreamUrl     string                 `protobuf:"bytes,10,opt,name=stream_url,json=streamUrl,proto3" json:"stream_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GradeEssayAnswerRequest) Reset() {
-	*x = GradeEssayAnswerRequest{}
-	mi := &file_cbt_proto_msgTypes[101]
+func (x *QuestionMedia) Reset() {
+	*x = QuestionMedia{}
+	mi := &file_cbt_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GradeEssayAnswerRequest) String() string {
+func (x *QuestionMedia) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GradeEssayAnswerRequest) ProtoMessage() {}
+func (*QuestionMedia) ProtoMessage() {}
 
-func (x *GradeEssayAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[101]
+func (x *QuestionMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7737,108 +17140,105 @@ func (x *GradeEssayAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GradeEssayAnswerRequest.ProtoReflect.Descriptor instead.
-func (*GradeEssayAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use QuestionMedia.ProtoReflect.Descriptor instead.
+func (*QuestionMedia) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *GradeEssayAnswerRequest) GetAnswerId() int32 {
+func (x *QuestionMedia) GetIdMedia() int32 {
 	if x != nil {
-		return x.AnswerId
+		return x.IdMedia
 	}
 	return 0
 }
 
-func (x *GradeEssayAnswerRequest) GetScore() float64 {
+func (x *QuestionMedia) GetJenis() MediaJenis {
 	if x != nil {
-		return x.Score
+		return x.Jenis
 	}
-	return 0
+	return MediaJenis_MEDIA_JENIS_INVALID
 }
 
-func (x *GradeEssayAnswerRequest) GetFeedback() string {
+func (x *QuestionMedia) GetMimeType() string {
 	if x != nil {
-		return x.Feedback
+		return x.MimeType
 	}
 	return ""
 }
 
-type GradeEssayAnswerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *QuestionMedia) GetDurasiDetik() float64 {
+	if x != nil {
+		return x.DurasiDetik
+	}
+	return 0
 }
 
-func (x *GradeEssayAnswerResponse) Reset() {
-	*x = GradeEssayAnswerResponse{}
-	mi := &file_cbt_proto_msgTypes[102]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QuestionMedia) GetMaxPutar() int32 {
+	if x != nil {
+		return x.MaxPutar
+	}
+	return 0
 }
 
-func (x *GradeEssayAnswerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QuestionMedia) GetJumlahPutar() int32 {
+	if x != nil {
+		return x.JumlahPutar
+	}
+	return 0
 }
 
-func (*GradeEssayAnswerResponse) ProtoMessage() {}
-
-func (x *GradeEssayAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[102]
+func (x *QuestionMedia) GetSisaPutar() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SisaPutar
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GradeEssayAnswerResponse.ProtoReflect.Descriptor instead.
-func (*GradeEssayAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{102}
+func (x *QuestionMedia) GetUrutan() int32 {
+	if x != nil {
+		return x.Urutan
+	}
+	return 0
 }
 
-func (x *GradeEssayAnswerResponse) GetSuccess() bool {
+func (x *QuestionMedia) GetKeterangan() string {
 	if x != nil {
-		return x.Success
+		return x.Keterangan
 	}
-	return false
+	return ""
 }
 
-func (x *GradeEssayAnswerResponse) GetMessage() string {
+func (x *QuestionMedia) GetStreamUrl() string {
 	if x != nil {
-		return x.Message
+		return x.StreamUrl
 	}
 	return ""
 }
 
-type TestResultResponse struct {
+type RecordMediaPlayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionInfo   *TestSession           `protobuf:"bytes,1,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
-	DetailJawaban []*JawabanDetail       `protobuf:"bytes,2,rep,name=detail_jawaban,json=detailJawaban,proto3" json:"detail_jawaban,omitempty"`
-	Tingkat       []*Tingkat             `protobuf:"bytes,3,rep,name=tingkat,proto3" json:"tingkat,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	IdMedia       int32                  `protobuf:"varint,3,opt,name=id_media,json=idMedia,proto3" json:"id_media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TestResultResponse) Reset() {
-	*x = TestResultResponse{}
-	mi := &file_cbt_proto_msgTypes[103]
+func (x *RecordMediaPlayRequest) Reset() {
+	*x = RecordMediaPlayRequest{}
+	mi := &file_cbt_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TestResultResponse) String() string {
+func (x *RecordMediaPlayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestResultResponse) ProtoMessage() {}
+func (*RecordMediaPlayRequest) ProtoMessage() {}
 
-func (x *TestResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[103]
+func (x *RecordMediaPlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7849,57 +17249,56 @@ func (x *TestResultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestResultResponse.ProtoReflect.Descriptor instead.
-func (*TestResultResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use RecordMediaPlayRequest.ProtoReflect.Descriptor instead.
+func (*RecordMediaPlayRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *TestResultResponse) GetSessionInfo() *TestSession {
+func (x *RecordMediaPlayRequest) GetSessionToken() string {
 	if x != nil {
-		return x.SessionInfo
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *TestResultResponse) GetDetailJawaban() []*JawabanDetail {
+func (x *RecordMediaPlayRequest) GetNomorUrut() int32 {
 	if x != nil {
-		return x.DetailJawaban
+		return x.NomorUrut
 	}
-	return nil
+	return 0
 }
 
-func (x *TestResultResponse) GetTingkat() []*Tingkat {
+func (x *RecordMediaPlayRequest) GetIdMedia() int32 {
 	if x != nil {
-		return x.Tingkat
+		return x.IdMedia
 	}
-	return nil
+	return 0
 }
 
-type StudentHistoryRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // From JWT, not input
-	Tingkatan       int32                  `protobuf:"varint,2,opt,name=tingkatan,proto3" json:"tingkatan,omitempty"`                                      // Optional filter
-	IdMataPelajaran int32                  `protobuf:"varint,3,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"` // Optional filter
-	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RecordMediaPlayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	Media         *QuestionMedia         `protobuf:"bytes,3,opt,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentHistoryRequest) Reset() {
-	*x = StudentHistoryRequest{}
-	mi := &file_cbt_proto_msgTypes[104]
+func (x *RecordMediaPlayResponse) Reset() {
+	*x = RecordMediaPlayResponse{}
+	mi := &file_cbt_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentHistoryRequest) String() string {
+func (x *RecordMediaPlayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentHistoryRequest) ProtoMessage() {}
+func (*RecordMediaPlayResponse) ProtoMessage() {}
 
-func (x *StudentHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[104]
+func (x *RecordMediaPlayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7910,72 +17309,57 @@ func (x *StudentHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentHistoryRequest.ProtoReflect.Descriptor instead.
-func (*StudentHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *StudentHistoryRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use RecordMediaPlayResponse.ProtoReflect.Descriptor instead.
+func (*RecordMediaPlayResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *StudentHistoryRequest) GetTingkatan() int32 {
+func (x *RecordMediaPlayResponse) GetSessionToken() string {
 	if x != nil {
-		return x.Tingkatan
+		return x.SessionToken
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentHistoryRequest) GetIdMataPelajaran() int32 {
+func (x *RecordMediaPlayResponse) GetNomorUrut() int32 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.NomorUrut
 	}
 	return 0
 }
 
-func (x *StudentHistoryRequest) GetPagination() *PaginationRequest {
+func (x *RecordMediaPlayResponse) GetMedia() *QuestionMedia {
 	if x != nil {
-		return x.Pagination
+		return x.Media
 	}
 	return nil
 }
 
-type HistorySummary struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Id                    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SessionToken          string                 `protobuf:"bytes,2,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	MataPelajaran         *MataPelajaran         `protobuf:"bytes,3,opt,name=mata_pelajaran,json=mataPelajaran,proto3" json:"mata_pelajaran,omitempty"`
-	Tingkat               *Tingkat               `protobuf:"bytes,4,opt,name=tingkat,proto3" json:"tingkat,omitempty"`
-	WaktuMulai            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=waktu_mulai,json=waktuMulai,proto3" json:"waktu_mulai,omitempty"`
-	WaktuSelesai          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=waktu_selesai,json=waktuSelesai,proto3" json:"waktu_selesai,omitempty"`
-	DurasiPengerjaanDetik int32                  `protobuf:"varint,7,opt,name=durasi_pengerjaan_detik,json=durasiPengerjaanDetik,proto3" json:"durasi_pengerjaan_detik,omitempty"` // Actual time spent
-	NilaiAkhir            float64                `protobuf:"fixed64,8,opt,name=nilai_akhir,json=nilaiAkhir,proto3" json:"nilai_akhir,omitempty"`
-	JumlahBenar           int32                  `protobuf:"varint,9,opt,name=jumlah_benar,json=jumlahBenar,proto3" json:"jumlah_benar,omitempty"`
-	TotalSoal             int32                  `protobuf:"varint,10,opt,name=total_soal,json=totalSoal,proto3" json:"total_soal,omitempty"`
-	Status                TestStatus             `protobuf:"varint,11,opt,name=status,proto3,enum=base.TestStatus" json:"status,omitempty"`
-	NamaPeserta           string                 `protobuf:"bytes,12,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetMediaStreamSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	NomorUrut     int32                  `protobuf:"varint,2,opt,name=nomor_urut,json=nomorUrut,proto3" json:"nomor_urut,omitempty"`
+	IdMedia       int32                  `protobuf:"varint,3,opt,name=id_media,json=idMedia,proto3" json:"id_media,omitempty"`
+	PlayNonce     string                 `protobuf:"bytes,4,opt,name=play_nonce,json=playNonce,proto3" json:"play_nonce,omitempty"` // from the stream_url of RecordMediaPlay; required for limited clips
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HistorySummary) Reset() {
-	*x = HistorySummary{}
-	mi := &file_cbt_proto_msgTypes[105]
+func (x *GetMediaStreamSourceRequest) Reset() {
+	*x = GetMediaStreamSourceRequest{}
+	mi := &file_cbt_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistorySummary) String() string {
+func (x *GetMediaStreamSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistorySummary) ProtoMessage() {}
+func (*GetMediaStreamSourceRequest) ProtoMessage() {}
 
-func (x *HistorySummary) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[105]
+func (x *GetMediaStreamSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7986,123 +17370,130 @@ func (x *HistorySummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HistorySummary.ProtoReflect.Descriptor instead.
-func (*HistorySummary) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{105}
-}
-
-func (x *HistorySummary) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetMediaStreamSourceRequest.ProtoReflect.Descriptor instead.
+func (*GetMediaStreamSourceRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *HistorySummary) GetSessionToken() string {
+func (x *GetMediaStreamSourceRequest) GetSessionToken() string {
 	if x != nil {
 		return x.SessionToken
 	}
 	return ""
 }
 
-func (x *HistorySummary) GetMataPelajaran() *MataPelajaran {
+func (x *GetMediaStreamSourceRequest) GetNomorUrut() int32 {
 	if x != nil {
-		return x.MataPelajaran
+		return x.NomorUrut
 	}
-	return nil
+	return 0
 }
 
-func (x *HistorySummary) GetTingkat() *Tingkat {
+func (x *GetMediaStreamSourceRequest) GetIdMedia() int32 {
 	if x != nil {
-		return x.Tingkat
+		return x.IdMedia
 	}
-	return nil
+	return 0
 }
 
-func (x *HistorySummary) GetWaktuMulai() *timestamppb.Timestamp {
+func (x *GetMediaStreamSourceRequest) GetPlayNonce() string {
 	if x != nil {
-		return x.WaktuMulai
+		return x.PlayNonce
 	}
-	return nil
+	return ""
 }
 
-func (x *HistorySummary) GetWaktuSelesai() *timestamppb.Timestamp {
-	if x != nil {
-		return x.WaktuSelesai
-	}
-	return nil
+type GetMediaStreamSourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceUrl     string                 `protobuf:"bytes,1,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Seekable      bool                   `protobuf:"varint,3,opt,name=seekable,proto3" json:"seekable,omitempty"` // false for limited clips, which are sent in one response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HistorySummary) GetDurasiPengerjaanDetik() int32 {
-	if x != nil {
-		return x.DurasiPengerjaanDetik
-	}
-	return 0
+func (x *GetMediaStreamSourceResponse) Reset() {
+	*x = GetMediaStreamSourceResponse{}
+	mi := &file_cbt_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistorySummary) GetNilaiAkhir() float64 {
+func (x *GetMediaStreamSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediaStreamSourceResponse) ProtoMessage() {}
+
+func (x *GetMediaStreamSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[230]
 	if x != nil {
-		return x.NilaiAkhir
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *HistorySummary) GetJumlahBenar() int32 {
-	if x != nil {
-		return x.JumlahBenar
-	}
-	return 0
+// Deprecated: Use GetMediaStreamSourceResponse.ProtoReflect.Descriptor instead.
+func (*GetMediaStreamSourceResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *HistorySummary) GetTotalSoal() int32 {
+func (x *GetMediaStreamSourceResponse) GetSourceUrl() string {
 	if x != nil {
-		return x.TotalSoal
+		return x.SourceUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *HistorySummary) GetStatus() TestStatus {
+func (x *GetMediaStreamSourceResponse) GetMimeType() string {
 	if x != nil {
-		return x.Status
+		return x.MimeType
 	}
-	return TestStatus_STATUS_INVALID
+	return ""
 }
 
-func (x *HistorySummary) GetNamaPeserta() string {
+func (x *GetMediaStreamSourceResponse) GetSeekable() bool {
 	if x != nil {
-		return x.NamaPeserta
+		return x.Seekable
 	}
-	return ""
+	return false
 }
 
-type StudentHistoryResponse struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	History    []*HistorySummary      `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
-	Pagination *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// Aggregate stats
-	RataRataNilai      float64 `protobuf:"fixed64,3,opt,name=rata_rata_nilai,json=rataRataNilai,proto3" json:"rata_rata_nilai,omitempty"`
-	TotalTestCompleted int32   `protobuf:"varint,4,opt,name=total_test_completed,json=totalTestCompleted,proto3" json:"total_test_completed,omitempty"`
-	User               *User   `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
-	Tingkatan          int32   `protobuf:"varint,6,opt,name=tingkatan,proto3" json:"tingkatan,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	KeyPrefix     string                 `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`                                 // sync:read, sync:write, catalog:write, rpc:<service>, rpc:*
+	LmsSchoolId   int64                  `protobuf:"varint,5,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"` // 0 = every school
+	CreatedBy     int32                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentHistoryResponse) Reset() {
-	*x = StudentHistoryResponse{}
-	mi := &file_cbt_proto_msgTypes[106]
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_cbt_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentHistoryResponse) String() string {
+func (x *ApiKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentHistoryResponse) ProtoMessage() {}
+func (*ApiKey) ProtoMessage() {}
 
-func (x *StudentHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[106]
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8113,78 +17504,106 @@ func (x *StudentHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentHistoryResponse.ProtoReflect.Descriptor instead.
-func (*StudentHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *StudentHistoryResponse) GetHistory() []*HistorySummary {
+func (x *ApiKey) GetId() int32 {
 	if x != nil {
-		return x.History
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *StudentHistoryResponse) GetPagination() *PaginationResponse {
+func (x *ApiKey) GetName() string {
 	if x != nil {
-		return x.Pagination
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
 	}
 	return nil
 }
 
-func (x *StudentHistoryResponse) GetRataRataNilai() float64 {
+func (x *ApiKey) GetLmsSchoolId() int64 {
 	if x != nil {
-		return x.RataRataNilai
+		return x.LmsSchoolId
 	}
 	return 0
 }
 
-func (x *StudentHistoryResponse) GetTotalTestCompleted() int32 {
+func (x *ApiKey) GetCreatedBy() int32 {
 	if x != nil {
-		return x.TotalTestCompleted
+		return x.CreatedBy
 	}
 	return 0
 }
 
-func (x *StudentHistoryResponse) GetUser() *User {
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.User
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *StudentHistoryResponse) GetTingkatan() int32 {
+func (x *ApiKey) GetRevokedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Tingkatan
+		return x.RevokedAt
 	}
-	return 0
+	return nil
 }
 
-type ListStudentHistoriesRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // Optional: filter by specific student
-	Tingkatan       int32                  `protobuf:"varint,2,opt,name=tingkatan,proto3" json:"tingkatan,omitempty"`                                      // Optional filter
-	IdMataPelajaran int32                  `protobuf:"varint,3,opt,name=id_mata_pelajaran,json=idMataPelajaran,proto3" json:"id_mata_pelajaran,omitempty"` // Optional filter
-	Pagination      *PaginationRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
 }
 
-func (x *ListStudentHistoriesRequest) Reset() {
-	*x = ListStudentHistoriesRequest{}
-	mi := &file_cbt_proto_msgTypes[107]
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	LmsSchoolId   int64                  `protobuf:"varint,3,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`       // 0 = every school
+	ExpiresInDays int32                  `protobuf:"varint,4,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"` // 0 = no expiry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_cbt_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStudentHistoriesRequest) String() string {
+func (x *CreateApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStudentHistoriesRequest) ProtoMessage() {}
+func (*CreateApiKeyRequest) ProtoMessage() {}
 
-func (x *ListStudentHistoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[107]
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8195,62 +17614,62 @@ func (x *ListStudentHistoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStudentHistoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListStudentHistoriesRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *ListStudentHistoriesRequest) GetUserId() int32 {
+func (x *CreateApiKeyRequest) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListStudentHistoriesRequest) GetTingkatan() int32 {
+func (x *CreateApiKeyRequest) GetScopes() []string {
 	if x != nil {
-		return x.Tingkatan
+		return x.Scopes
 	}
-	return 0
+	return nil
 }
 
-func (x *ListStudentHistoriesRequest) GetIdMataPelajaran() int32 {
+func (x *CreateApiKeyRequest) GetLmsSchoolId() int64 {
 	if x != nil {
-		return x.IdMataPelajaran
+		return x.LmsSchoolId
 	}
 	return 0
 }
 
-func (x *ListStudentHistoriesRequest) GetPagination() *PaginationRequest {
+func (x *CreateApiKeyRequest) GetExpiresInDays() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.ExpiresInDays
 	}
-	return nil
+	return 0
 }
 
-type ListStudentHistoriesResponse struct {
-	state             protoimpl.MessageState    `protogen:"open.v1"`
-	HistoryPerStudent []*StudentHistoryWithUser `protobuf:"bytes,1,rep,name=history_per_student,json=historyPerStudent,proto3" json:"history_per_student,omitempty"`
-	Pagination        *PaginationResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStudentHistoriesResponse) Reset() {
-	*x = ListStudentHistoriesResponse{}
-	mi := &file_cbt_proto_msgTypes[108]
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_cbt_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStudentHistoriesResponse) String() string {
+func (x *CreateApiKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStudentHistoriesResponse) ProtoMessage() {}
+func (*CreateApiKeyResponse) ProtoMessage() {}
 
-func (x *ListStudentHistoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[108]
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8261,50 +17680,47 @@ func (x *ListStudentHistoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStudentHistoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListStudentHistoriesResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *ListStudentHistoriesResponse) GetHistoryPerStudent() []*StudentHistoryWithUser {
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
 	if x != nil {
-		return x.HistoryPerStudent
+		return x.ApiKey
 	}
 	return nil
 }
 
-func (x *ListStudentHistoriesResponse) GetPagination() *PaginationResponse {
+func (x *CreateApiKeyResponse) GetKey() string {
 	if x != nil {
-		return x.Pagination
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type StudentHistoryWithUser struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	User               *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	History            []*HistorySummary      `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
-	RataRataNilai      float64                `protobuf:"fixed64,3,opt,name=rata_rata_nilai,json=rataRataNilai,proto3" json:"rata_rata_nilai,omitempty"`
-	TotalTestCompleted int32                  `protobuf:"varint,4,opt,name=total_test_completed,json=totalTestCompleted,proto3" json:"total_test_completed,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ListApiKeysRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeRevoked bool                   `protobuf:"varint,1,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *StudentHistoryWithUser) Reset() {
-	*x = StudentHistoryWithUser{}
-	mi := &file_cbt_proto_msgTypes[109]
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_cbt_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentHistoryWithUser) String() string {
+func (x *ListApiKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentHistoryWithUser) ProtoMessage() {}
+func (*ListApiKeysRequest) ProtoMessage() {}
 
-func (x *StudentHistoryWithUser) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[109]
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8315,61 +17731,84 @@ func (x *StudentHistoryWithUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentHistoryWithUser.ProtoReflect.Descriptor instead.
-func (*StudentHistoryWithUser) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *StudentHistoryWithUser) GetUser() *User {
+func (x *ListApiKeysRequest) GetIncludeRevoked() bool {
 	if x != nil {
-		return x.User
+		return x.IncludeRevoked
 	}
-	return nil
+	return false
 }
 
-func (x *StudentHistoryWithUser) GetHistory() []*HistorySummary {
-	if x != nil {
-		return x.History
-	}
-	return nil
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentHistoryWithUser) GetRataRataNilai() float64 {
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_cbt_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[235]
 	if x != nil {
-		return x.RataRataNilai
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StudentHistoryWithUser) GetTotalTestCompleted() int32 {
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
 	if x != nil {
-		return x.TotalTestCompleted
+		return x.ApiKeys
 	}
-	return 0
+	return nil
 }
 
-type GetHistoryDetailRequest struct {
+type RevokeApiKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryDetailRequest) Reset() {
-	*x = GetHistoryDetailRequest{}
-	mi := &file_cbt_proto_msgTypes[110]
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_cbt_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryDetailRequest) String() string {
+func (x *RevokeApiKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryDetailRequest) ProtoMessage() {}
+func (*RevokeApiKeyRequest) ProtoMessage() {}
 
-func (x *GetHistoryDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[110]
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8380,104 +17819,134 @@ func (x *GetHistoryDetailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryDetailRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoryDetailRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *GetHistoryDetailRequest) GetSessionToken() string {
+func (x *RevokeApiKeyRequest) GetId() int32 {
 	if x != nil {
-		return x.SessionToken
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type HistoryDetailResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionInfo   *TestSession           `protobuf:"bytes,1,opt,name=session_info,json=sessionInfo,proto3" json:"session_info,omitempty"`
-	DetailJawaban []*JawabanDetail       `protobuf:"bytes,2,rep,name=detail_jawaban,json=detailJawaban,proto3" json:"detail_jawaban,omitempty"`
-	// Breakdown per materi
-	BreakdownMateri []*MateriBreakdown `protobuf:"bytes,3,rep,name=breakdown_materi,json=breakdownMateri,proto3" json:"breakdown_materi,omitempty"`
+type LabCredential struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NamaPeserta     string                 `protobuf:"bytes,3,opt,name=nama_peserta,json=namaPeserta,proto3" json:"nama_peserta,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,4,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	Pin             string                 `protobuf:"bytes,5,opt,name=pin,proto3" json:"pin,omitempty"`
+	QrCode          string                 `protobuf:"bytes,6,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *HistoryDetailResponse) Reset() {
-	*x = HistoryDetailResponse{}
-	mi := &file_cbt_proto_msgTypes[111]
+func (x *LabCredential) Reset() {
+	*x = LabCredential{}
+	mi := &file_cbt_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HistoryDetailResponse) String() string {
+func (x *LabCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HistoryDetailResponse) ProtoMessage() {}
-
-func (x *HistoryDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[111]
+func (*LabCredential) ProtoMessage() {}
+
+func (x *LabCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabCredential.ProtoReflect.Descriptor instead.
+func (*LabCredential) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *LabCredential) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LabCredential) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LabCredential) GetNamaPeserta() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NamaPeserta
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use HistoryDetailResponse.ProtoReflect.Descriptor instead.
-func (*HistoryDetailResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{111}
+func (x *LabCredential) GetLmsAssignmentId() int64 {
+	if x != nil {
+		return x.LmsAssignmentId
+	}
+	return 0
 }
 
-func (x *HistoryDetailResponse) GetSessionInfo() *TestSession {
+func (x *LabCredential) GetPin() string {
 	if x != nil {
-		return x.SessionInfo
+		return x.Pin
 	}
-	return nil
+	return ""
 }
 
-func (x *HistoryDetailResponse) GetDetailJawaban() []*JawabanDetail {
+func (x *LabCredential) GetQrCode() string {
 	if x != nil {
-		return x.DetailJawaban
+		return x.QrCode
 	}
-	return nil
+	return ""
 }
 
-func (x *HistoryDetailResponse) GetBreakdownMateri() []*MateriBreakdown {
+func (x *LabCredential) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BreakdownMateri
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type MateriBreakdown struct {
+type IssueLabCredentialsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	NamaMateri      string                 `protobuf:"bytes,1,opt,name=nama_materi,json=namaMateri,proto3" json:"nama_materi,omitempty"`
-	JumlahSoal      int32                  `protobuf:"varint,2,opt,name=jumlah_soal,json=jumlahSoal,proto3" json:"jumlah_soal,omitempty"`
-	JumlahBenar     int32                  `protobuf:"varint,3,opt,name=jumlah_benar,json=jumlahBenar,proto3" json:"jumlah_benar,omitempty"`
-	PersentaseBenar float64                `protobuf:"fixed64,4,opt,name=persentase_benar,json=persentaseBenar,proto3" json:"persentase_benar,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	UserIds         []int32                `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`         // empty = every student with an open session
+	ValidMinutes    int32                  `protobuf:"varint,3,opt,name=valid_minutes,json=validMinutes,proto3" json:"valid_minutes,omitempty"` // 0 = 12 hours
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MateriBreakdown) Reset() {
-	*x = MateriBreakdown{}
-	mi := &file_cbt_proto_msgTypes[112]
+func (x *IssueLabCredentialsRequest) Reset() {
+	*x = IssueLabCredentialsRequest{}
+	mi := &file_cbt_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MateriBreakdown) String() string {
+func (x *IssueLabCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MateriBreakdown) ProtoMessage() {}
+func (*IssueLabCredentialsRequest) ProtoMessage() {}
 
-func (x *MateriBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[112]
+func (x *IssueLabCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8488,61 +17957,54 @@ func (x *MateriBreakdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MateriBreakdown.ProtoReflect.Descriptor instead.
-func (*MateriBreakdown) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{112}
-}
-
-func (x *MateriBreakdown) GetNamaMateri() string {
-	if x != nil {
-		return x.NamaMateri
-	}
-	return ""
+// Deprecated: Use IssueLabCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*IssueLabCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *MateriBreakdown) GetJumlahSoal() int32 {
+func (x *IssueLabCredentialsRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.JumlahSoal
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *MateriBreakdown) GetJumlahBenar() int32 {
+func (x *IssueLabCredentialsRequest) GetUserIds() []int32 {
 	if x != nil {
-		return x.JumlahBenar
+		return x.UserIds
 	}
-	return 0
+	return nil
 }
 
-func (x *MateriBreakdown) GetPersentaseBenar() float64 {
+func (x *IssueLabCredentialsRequest) GetValidMinutes() int32 {
 	if x != nil {
-		return x.PersentaseBenar
+		return x.ValidMinutes
 	}
 	return 0
 }
 
-type QuestionCountsResponse struct {
+type IssueLabCredentialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Counts        []*TopicCount          `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	Credentials   []*LabCredential       `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuestionCountsResponse) Reset() {
-	*x = QuestionCountsResponse{}
-	mi := &file_cbt_proto_msgTypes[113]
+func (x *IssueLabCredentialsResponse) Reset() {
+	*x = IssueLabCredentialsResponse{}
+	mi := &file_cbt_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuestionCountsResponse) String() string {
+func (x *IssueLabCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuestionCountsResponse) ProtoMessage() {}
+func (*IssueLabCredentialsResponse) ProtoMessage() {}
 
-func (x *QuestionCountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[113]
+func (x *IssueLabCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8553,41 +18015,41 @@ func (x *QuestionCountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuestionCountsResponse.ProtoReflect.Descriptor instead.
-func (*QuestionCountsResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use IssueLabCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*IssueLabCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *QuestionCountsResponse) GetCounts() []*TopicCount {
+func (x *IssueLabCredentialsResponse) GetCredentials() []*LabCredential {
 	if x != nil {
-		return x.Counts
+		return x.Credentials
 	}
 	return nil
 }
 
-type TopicCount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TopicId       int32                  `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RevokeLabCredentialsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LmsAssignmentId int64                  `protobuf:"varint,1,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	UserIds         []int32                `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // empty = every credential of the assignment
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *TopicCount) Reset() {
-	*x = TopicCount{}
-	mi := &file_cbt_proto_msgTypes[114]
+func (x *RevokeLabCredentialsRequest) Reset() {
+	*x = RevokeLabCredentialsRequest{}
+	mi := &file_cbt_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopicCount) String() string {
+func (x *RevokeLabCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopicCount) ProtoMessage() {}
+func (*RevokeLabCredentialsRequest) ProtoMessage() {}
 
-func (x *TopicCount) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[114]
+func (x *RevokeLabCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8598,48 +18060,47 @@ func (x *TopicCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopicCount.ProtoReflect.Descriptor instead.
-func (*TopicCount) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use RevokeLabCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLabCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *TopicCount) GetTopicId() int32 {
+func (x *RevokeLabCredentialsRequest) GetLmsAssignmentId() int64 {
 	if x != nil {
-		return x.TopicId
+		return x.LmsAssignmentId
 	}
 	return 0
 }
 
-func (x *TopicCount) GetCount() int32 {
+func (x *RevokeLabCredentialsRequest) GetUserIds() []int32 {
 	if x != nil {
-		return x.Count
+		return x.UserIds
 	}
-	return 0
+	return nil
 }
 
-type ListMyScheduledSessionsRequest struct {
+type RevokeLabCredentialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	LmsClassId    int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyScheduledSessionsRequest) Reset() {
-	*x = ListMyScheduledSessionsRequest{}
-	mi := &file_cbt_proto_msgTypes[115]
+func (x *RevokeLabCredentialsResponse) Reset() {
+	*x = RevokeLabCredentialsResponse{}
+	mi := &file_cbt_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyScheduledSessionsRequest) String() string {
+func (x *RevokeLabCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyScheduledSessionsRequest) ProtoMessage() {}
+func (*RevokeLabCredentialsResponse) ProtoMessage() {}
 
-func (x *ListMyScheduledSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[115]
+func (x *RevokeLabCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8650,47 +18111,43 @@ func (x *ListMyScheduledSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyScheduledSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMyScheduledSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{115}
-}
-
-func (x *ListMyScheduledSessionsRequest) GetPagination() *PaginationRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+// Deprecated: Use RevokeLabCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLabCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *ListMyScheduledSessionsRequest) GetLmsClassId() int64 {
+func (x *RevokeLabCredentialsResponse) GetRevoked() int32 {
 	if x != nil {
-		return x.LmsClassId
+		return x.Revoked
 	}
 	return 0
 }
 
-type StartScheduledSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Either qr_code, or lms_assignment_id with pin
+type LabLoginRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	QrCode          string                 `protobuf:"bytes,1,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	LmsAssignmentId int64                  `protobuf:"varint,2,opt,name=lms_assignment_id,json=lmsAssignmentId,proto3" json:"lms_assignment_id,omitempty"`
+	Pin             string                 `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *StartScheduledSessionRequest) Reset() {
-	*x = StartScheduledSessionRequest{}
-	mi := &file_cbt_proto_msgTypes[116]
+func (x *LabLoginRequest) Reset() {
+	*x = LabLoginRequest{}
+	mi := &file_cbt_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartScheduledSessionRequest) String() string {
+func (x *LabLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartScheduledSessionRequest) ProtoMessage() {}
+func (*LabLoginRequest) ProtoMessage() {}
 
-func (x *StartScheduledSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[116]
+func (x *LabLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8701,46 +18158,57 @@ func (x *StartScheduledSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartScheduledSessionRequest.ProtoReflect.Descriptor instead.
-func (*StartScheduledSessionRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use LabLoginRequest.ProtoReflect.Descriptor instead.
+func (*LabLoginRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{242}
 }
 
-func (x *StartScheduledSessionRequest) GetSessionToken() string {
+func (x *LabLoginRequest) GetQrCode() string {
 	if x != nil {
-		return x.SessionToken
+		return x.QrCode
 	}
 	return ""
 }
 
-type ClassData struct {
+func (x *LabLoginRequest) GetLmsAssignmentId() int64 {
+	if x != nil {
+		return x.LmsAssignmentId
+	}
+	return 0
+}
+
+func (x *LabLoginRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type LabLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	LmsClassId    int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	LmsSchoolId   int64                  `protobuf:"varint,3,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	User          *User                  `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClassData) Reset() {
-	*x = ClassData{}
-	mi := &file_cbt_proto_msgTypes[117]
+func (x *LabLoginResponse) Reset() {
+	*x = LabLoginResponse{}
+	mi := &file_cbt_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassData) String() string {
+func (x *LabLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassData) ProtoMessage() {}
+func (*LabLoginResponse) ProtoMessage() {}
 
-func (x *ClassData) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[117]
+func (x *LabLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8751,82 +18219,76 @@ func (x *ClassData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassData.ProtoReflect.Descriptor instead.
-func (*ClassData) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{117}
-}
-
-func (x *ClassData) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ClassData) GetLmsClassId() int64 {
-	if x != nil {
-		return x.LmsClassId
-	}
-	return 0
-}
-
-func (x *ClassData) GetLmsSchoolId() int64 {
-	if x != nil {
-		return x.LmsSchoolId
-	}
-	return 0
+// Deprecated: Use LabLoginResponse.ProtoReflect.Descriptor instead.
+func (*LabLoginResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{243}
 }
 
-func (x *ClassData) GetName() string {
+func (x *LabLoginResponse) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-func (x *ClassData) GetIsActive() bool {
+func (x *LabLoginResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsActive
+		return x.ExpiresAt
 	}
-	return false
+	return nil
 }
 
-func (x *ClassData) GetCreatedAt() *timestamppb.Timestamp {
+func (x *LabLoginResponse) GetSessionToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *ClassData) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *LabLoginResponse) GetUser() *User {
 	if x != nil {
-		return x.UpdatedAt
+		return x.User
 	}
 	return nil
 }
 
-type ListClassesRequest struct {
+// before, after and request are JSON objects; before and after hold only changed fields
+type AuditLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	LmsSchoolId   int64                  `protobuf:"varint,1,opt,name=lms_school_id,json=lmsSchoolId,proto3" json:"lms_school_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ActorId       int32                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // 0 for API keys
+	ActorRole     string                 `protobuf:"bytes,4,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	ApiKeyId      int32                  `protobuf:"varint,5,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"` // 0 for users
+	SchoolId      int64                  `protobuf:"varint,6,opt,name=school_id,json=schoolId,proto3" json:"school_id,omitempty"`   // 0 for cross-tenant callers
+	Method        string                 `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,8,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,9,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Before        string                 `protobuf:"bytes,10,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,11,opt,name=after,proto3" json:"after,omitempty"`
+	Request       string                 `protobuf:"bytes,12,opt,name=request,proto3" json:"request,omitempty"`
+	RequestId     string                 `protobuf:"bytes,13,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,14,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Status        string                 `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"` // gRPC code, OK when the call succeeded
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClassesRequest) Reset() {
-	*x = ListClassesRequest{}
-	mi := &file_cbt_proto_msgTypes[118]
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_cbt_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClassesRequest) String() string {
+func (x *AuditLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClassesRequest) ProtoMessage() {}
+func (*AuditLog) ProtoMessage() {}
 
-func (x *ListClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[118]
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8837,87 +18299,145 @@ func (x *ListClassesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClassesRequest.ProtoReflect.Descriptor instead.
-func (*ListClassesRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *AuditLog) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditLog) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *AuditLog) GetApiKeyId() int32 {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetSchoolId() int64 {
+	if x != nil {
+		return x.SchoolId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
 }
 
-func (x *ListClassesRequest) GetLmsSchoolId() int64 {
+func (x *AuditLog) GetResourceId() string {
 	if x != nil {
-		return x.LmsSchoolId
+		return x.ResourceId
 	}
-	return 0
+	return ""
 }
 
-type ListClassesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Classes       []*ClassData           `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AuditLog) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
 }
 
-func (x *ListClassesResponse) Reset() {
-	*x = ListClassesResponse{}
-	mi := &file_cbt_proto_msgTypes[119]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditLog) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
 }
 
-func (x *ListClassesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditLog) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
 }
 
-func (*ListClassesResponse) ProtoMessage() {}
-
-func (x *ListClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[119]
+func (x *AuditLog) GetRequestId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RequestId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListClassesResponse.ProtoReflect.Descriptor instead.
-func (*ListClassesResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{119}
+func (x *AuditLog) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
 }
 
-func (x *ListClassesResponse) GetClasses() []*ClassData {
+func (x *AuditLog) GetStatus() string {
 	if x != nil {
-		return x.Classes
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type ClassStudentData struct {
+// Empty fields match every entry; from and to bound occurred_at, to exclusive
+type ListAuditLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	LmsClassId    int64                  `protobuf:"varint,2,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	LmsUserId     int64                  `protobuf:"varint,3,opt,name=lms_user_id,json=lmsUserId,proto3" json:"lms_user_id,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	ActorId       int32                  `protobuf:"varint,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClassStudentData) Reset() {
-	*x = ClassStudentData{}
-	mi := &file_cbt_proto_msgTypes[120]
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_cbt_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClassStudentData) String() string {
+func (x *ListAuditLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClassStudentData) ProtoMessage() {}
+func (*ListAuditLogsRequest) ProtoMessage() {}
 
-func (x *ClassStudentData) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[120]
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8928,105 +18448,83 @@ func (x *ClassStudentData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClassStudentData.ProtoReflect.Descriptor instead.
-func (*ClassStudentData) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *ClassStudentData) GetId() int32 {
+func (x *ListAuditLogsRequest) GetActorId() int32 {
 	if x != nil {
-		return x.Id
+		return x.ActorId
 	}
 	return 0
 }
 
-func (x *ClassStudentData) GetLmsClassId() int64 {
+func (x *ListAuditLogsRequest) GetResourceType() string {
 	if x != nil {
-		return x.LmsClassId
+		return x.ResourceType
 	}
-	return 0
+	return ""
 }
 
-func (x *ClassStudentData) GetLmsUserId() int64 {
+func (x *ListAuditLogsRequest) GetResourceId() string {
 	if x != nil {
-		return x.LmsUserId
+		return x.ResourceId
 	}
-	return 0
+	return ""
 }
 
-func (x *ClassStudentData) GetJoinedAt() *timestamppb.Timestamp {
+func (x *ListAuditLogsRequest) GetMethod() string {
 	if x != nil {
-		return x.JoinedAt
+		return x.Method
 	}
-	return nil
-}
-
-type ListClassStudentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LmsClassId    int64                  `protobuf:"varint,1,opt,name=lms_class_id,json=lmsClassId,proto3" json:"lms_class_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListClassStudentsRequest) Reset() {
-	*x = ListClassStudentsRequest{}
-	mi := &file_cbt_proto_msgTypes[121]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListClassStudentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListClassStudentsRequest) ProtoMessage() {}
-
-func (x *ListClassStudentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[121]
+func (x *ListAuditLogsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.From
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListClassStudentsRequest.ProtoReflect.Descriptor instead.
-func (*ListClassStudentsRequest) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{121}
+func (x *ListAuditLogsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
 }
 
-func (x *ListClassStudentsRequest) GetLmsClassId() int64 {
+func (x *ListAuditLogsRequest) GetPagination() *PaginationRequest {
 	if x != nil {
-		return x.LmsClassId
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-type ListClassStudentsResponse struct {
+type ListAuditLogsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*ClassStudentData    `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	AuditLogs     []*AuditLog            `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClassStudentsResponse) Reset() {
-	*x = ListClassStudentsResponse{}
-	mi := &file_cbt_proto_msgTypes[122]
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_cbt_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClassStudentsResponse) String() string {
+func (x *ListAuditLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClassStudentsResponse) ProtoMessage() {}
+func (*ListAuditLogsResponse) ProtoMessage() {}
 
-func (x *ListClassStudentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cbt_proto_msgTypes[122]
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cbt_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9037,14 +18535,21 @@ func (x *ListClassStudentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClassStudentsResponse.ProtoReflect.Descriptor instead.
-func (*ListClassStudentsResponse) Descriptor() ([]byte, []int) {
-	return file_cbt_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_cbt_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *ListClassStudentsResponse) GetStudents() []*ClassStudentData {
+func (x *ListAuditLogsResponse) GetAuditLogs() []*AuditLog {
 	if x != nil {
-		return x.Students
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -9080,7 +18585,7 @@ const file_cbt_proto_rawDesc = "" +
 	"\vlms_user_id\x18\t \x01(\x03R\tlmsUserIdJ\x04\b\b\x10\t\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xd9\x01\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xa3\x02\n" +
 	"\rLoginResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1e\n" +
@@ -9089,7 +18594,8 @@ const file_cbt_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
 	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x06 \x01(\tR\amessage\"b\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12H\n" +
+	"\x12refresh_expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10refreshExpiresAt\"b\n" +
 	"\fUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".base.UserR\x04user\x12\x18\n" +
@@ -9126,14 +18632,18 @@ const file_cbt_proto_rawDesc = "" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\":\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xc0\x01\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x8a\x02\n" +
 	"\x14RefreshTokenResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x129\n" +
 	"\n" +
 	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
 	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage\"\xc4\x02\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12H\n" +
+	"\x12refresh_expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10refreshExpiresAt\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\xe5\x02\n" +
 	"\tUserLimit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
@@ -9146,7 +18656,9 @@ const file_cbt_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf4\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1f\n" +
+	"\vis_override\x18\t \x01(\bR\n" +
+	"isOverride\"\xf4\x01\n" +
 	"\x0eUserLimitUsage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1d\n" +
@@ -9163,13 +18675,15 @@ const file_cbt_proto_rawDesc = "" +
 	"\x15GetUserLimitsResponse\x12'\n" +
 	"\x06limits\x18\x01 \x03(\v2\x0f.base.UserLimitR\x06limits\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"n\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x8d\x01\n" +
 	"\x13SetUserLimitRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
 	"limit_type\x18\x02 \x01(\tR\tlimitType\x12\x1f\n" +
 	"\vlimit_value\x18\x03 \x01(\x05R\n" +
-	"limitValue\"O\n" +
+	"limitValue\x12\x1d\n" +
+	"\n" +
+	"use_policy\x18\x04 \x01(\bR\tusePolicy\"O\n" +
 	"\x15ResetUserLimitRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -9186,7 +18700,56 @@ const file_cbt_proto_rawDesc = "" +
 	" GetUserLimitUsageHistoryResponse\x12.\n" +
 	"\ahistory\x18\x01 \x03(\v2\x14.base.UserLimitUsageR\ahistory\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x9f\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xc7\x03\n" +
+	"\x0fRateLimitPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12!\n" +
+	"\fmethod_group\x18\x02 \x01(\tR\vmethodGroup\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1f\n" +
+	"\vschool_plan\x18\x04 \x01(\tR\n" +
+	"schoolPlan\x12\x1b\n" +
+	"\tschool_id\x18\x05 \x01(\x03R\bschoolId\x12\x1d\n" +
+	"\n" +
+	"limit_type\x18\x06 \x01(\tR\tlimitType\x12\x1f\n" +
+	"\vlimit_value\x18\a \x01(\x05R\n" +
+	"limitValue\x12&\n" +
+	"\x0fhas_limit_value\x18\b \x01(\bR\rhasLimitValue\x12\x14\n" +
+	"\x05burst\x18\t \x01(\x05R\x05burst\x12\x1f\n" +
+	"\vexam_exempt\x18\n" +
+	" \x01(\bR\n" +
+	"examExempt\x12\x1a\n" +
+	"\bdisabled\x18\v \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Q\n" +
+	"\x14RateLimitMethodGroup\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12!\n" +
+	"\fmethod_group\x18\x02 \x01(\tR\vmethodGroup\"=\n" +
+	"\n" +
+	"SchoolPlan\x12\x1b\n" +
+	"\tschool_id\x18\x01 \x01(\x03R\bschoolId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\"\x1e\n" +
+	"\x1cListRateLimitPoliciesRequest\"\xfc\x01\n" +
+	"\x1dListRateLimitPoliciesResponse\x121\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x15.base.RateLimitPolicyR\bpolicies\x12?\n" +
+	"\rmethod_groups\x18\x02 \x03(\v2\x1a.base.RateLimitMethodGroupR\fmethodGroups\x123\n" +
+	"\fschool_plans\x18\x03 \x03(\v2\x10.base.SchoolPlanR\vschoolPlans\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"K\n" +
+	"\x1aSaveRateLimitPolicyRequest\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.base.RateLimitPolicyR\x06policy\"|\n" +
+	"\x17RateLimitPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.base.RateLimitPolicyR\x06policy\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\".\n" +
+	"\x1cDeleteRateLimitPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"[\n" +
+	"\x1eSetRateLimitMethodGroupRequest\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12!\n" +
+	"\fmethod_group\x18\x02 \x01(\tR\vmethodGroup\"G\n" +
+	"\x14SetSchoolPlanRequest\x12\x1b\n" +
+	"\tschool_id\x18\x01 \x01(\x03R\bschoolId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\"\x9f\x01\n" +
 	"\rMataPelajaran\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04nama\x18\x02 \x01(\tR\x04nama\x12$\n" +
@@ -9298,7 +18861,33 @@ const file_cbt_proto_rawDesc = "" +
 	"\x06materi\x18\x01 \x03(\v2\f.base.MateriR\x06materi\x128\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x18.base.PaginationResponseR\n" +
-	"pagination\"O\n" +
+	"pagination\"\xda\x01\n" +
+	"\vMateriShare\x12\x1b\n" +
+	"\tid_materi\x18\x01 \x01(\x05R\bidMateri\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x05 \x01(\x05R\tgrantedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"j\n" +
+	"\x12ShareMateriRequest\x12\x1b\n" +
+	"\tid_materi\x18\x01 \x01(\x05R\bidMateri\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\">\n" +
+	"\x13ShareMateriResponse\x12'\n" +
+	"\x05share\x18\x01 \x01(\v2\x11.base.MateriShareR\x05share\"P\n" +
+	"\x18RevokeMateriShareRequest\x12\x1b\n" +
+	"\tid_materi\x18\x01 \x01(\x05R\bidMateri\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"6\n" +
+	"\x17ListMateriSharesRequest\x12\x1b\n" +
+	"\tid_materi\x18\x01 \x01(\x05R\bidMateri\"E\n" +
+	"\x18ListMateriSharesResponse\x12)\n" +
+	"\x06shares\x18\x01 \x03(\v2\x11.base.MateriShareR\x06shares\"O\n" +
 	"\aTingkat\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04nama\x18\x02 \x01(\tR\x04nama\x12 \n" +
@@ -9332,7 +18921,7 @@ const file_cbt_proto_rawDesc = "" +
 	"\tpublic_id\x18\t \x01(\tR\bpublicId\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x92\x04\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd8\b\n" +
 	"\bSoalFull\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12$\n" +
 	"\x06materi\x18\x02 \x01(\v2\f.base.MateriR\x06materi\x12\x1e\n" +
@@ -9354,7 +18943,19 @@ const file_cbt_proto_rawDesc = "" +
 	"\rquestion_type\x18\f \x01(\x0e2\x12.base.QuestionTypeR\fquestionType\x12G\n" +
 	"\x15jawaban_benar_complex\x18\r \x03(\x0e2\x13.base.JawabanOptionR\x13jawabanBenarComplex\x12\x14\n" +
 	"\x05point\x18\x0e \x01(\x01R\x05point\x12\x16\n" +
-	"\x06urutan\x18\x0f \x01(\x05R\x06urutan\"\xea\x02\n" +
+	"\x06urutan\x18\x0f \x01(\x05R\x06urutan\x12F\n" +
+	"\x13short_answer_blanks\x18\x10 \x03(\v2\x16.base.ShortAnswerBlankR\x11shortAnswerBlanks\x12=\n" +
+	"\x0enumeric_answer\x18\x11 \x01(\v2\x16.base.NumericAnswerKeyR\rnumericAnswer\x12\"\n" +
+	"\x04opsi\x18\x12 \x03(\v2\x0e.base.SoalOpsiR\x04opsi\x12.\n" +
+	"\x13jawaban_benar_label\x18\x13 \x01(\tR\x11jawabanBenarLabel\x12?\n" +
+	"\x1cjawaban_benar_complex_labels\x18\x14 \x03(\tR\x19jawabanBenarComplexLabels\x12=\n" +
+	"\x0ehotspot_answer\x18\x15 \x01(\v2\x16.base.HotspotAnswerKeyR\rhotspotAnswer\x124\n" +
+	"\vgrid_answer\x18\x16 \x01(\v2\x13.base.GridAnswerKeyR\n" +
+	"gridAnswer\x12%\n" +
+	"\x05media\x18\x17 \x03(\v2\x0f.base.SoalMediaR\x05media\x12:\n" +
+	"\x0econtent_format\x18\x18 \x01(\x0e2\x13.base.ContentFormatR\rcontentFormat\x12'\n" +
+	"\x0fpertanyaan_html\x18\x19 \x01(\tR\x0epertanyaanHtml\x12'\n" +
+	"\x0fpembahasan_html\x18\x1a \x01(\tR\x0epembahasanHtml\"\xea\x02\n" +
 	"\x0eSoalForStudent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -9371,7 +18972,7 @@ const file_cbt_proto_rawDesc = "" +
 	"isAnswered\x12$\n" +
 	"\x06materi\x18\n" +
 	" \x01(\v2\f.base.MateriR\x06materi\x12(\n" +
-	"\x06gambar\x18\v \x03(\v2\x10.base.SoalGambarR\x06gambar\"\x98\x04\n" +
+	"\x06gambar\x18\v \x03(\v2\x10.base.SoalGambarR\x06gambar\"\xd5\a\n" +
 	"\x11CreateSoalRequest\x12\x1b\n" +
 	"\tid_materi\x18\x01 \x01(\x05R\bidMateri\x12\x1d\n" +
 	"\n" +
@@ -9395,9 +18996,18 @@ const file_cbt_proto_rawDesc = "" +
 	"\rquestion_type\x18\f \x01(\x0e2\x12.base.QuestionTypeR\fquestionType\x12G\n" +
 	"\x15jawaban_benar_complex\x18\r \x03(\x0e2\x13.base.JawabanOptionR\x13jawabanBenarComplex\x12\x14\n" +
 	"\x05point\x18\x0e \x01(\x01R\x05point\x12\x16\n" +
-	"\x06urutan\x18\x0f \x01(\x05R\x06urutan\" \n" +
+	"\x06urutan\x18\x0f \x01(\x05R\x06urutan\x12F\n" +
+	"\x13short_answer_blanks\x18\x10 \x03(\v2\x16.base.ShortAnswerBlankR\x11shortAnswerBlanks\x12=\n" +
+	"\x0enumeric_answer\x18\x11 \x01(\v2\x16.base.NumericAnswerKeyR\rnumericAnswer\x12\x12\n" +
+	"\x04opsi\x18\x12 \x03(\tR\x04opsi\x12.\n" +
+	"\x13jawaban_benar_label\x18\x13 \x01(\tR\x11jawabanBenarLabel\x12?\n" +
+	"\x1cjawaban_benar_complex_labels\x18\x14 \x03(\tR\x19jawabanBenarComplexLabels\x12=\n" +
+	"\x0ehotspot_answer\x18\x15 \x01(\v2\x16.base.HotspotAnswerKeyR\rhotspotAnswer\x124\n" +
+	"\vgrid_answer\x18\x16 \x01(\v2\x13.base.GridAnswerKeyR\n" +
+	"gridAnswer\x12:\n" +
+	"\x0econtent_format\x18\x17 \x01(\x0e2\x13.base.ContentFormatR\rcontentFormat\" \n" +
 	"\x0eGetSoalRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x86\x04\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xc3\a\n" +
 	"\x11UpdateSoalRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\tid_materi\x18\x02 \x01(\x05R\bidMateri\x12\x1d\n" +
@@ -9420,7 +19030,16 @@ const file_cbt_proto_rawDesc = "" +
 	"\rquestion_type\x18\f \x01(\x0e2\x12.base.QuestionTypeR\fquestionType\x12G\n" +
 	"\x15jawaban_benar_complex\x18\r \x03(\x0e2\x13.base.JawabanOptionR\x13jawabanBenarComplex\x12\x14\n" +
 	"\x05point\x18\x0e \x01(\x01R\x05point\x12\x16\n" +
-	"\x06urutan\x18\x0f \x01(\x05R\x06urutan\"7\n" +
+	"\x06urutan\x18\x0f \x01(\x05R\x06urutan\x12F\n" +
+	"\x13short_answer_blanks\x18\x10 \x03(\v2\x16.base.ShortAnswerBlankR\x11shortAnswerBlanks\x12=\n" +
+	"\x0enumeric_answer\x18\x11 \x01(\v2\x16.base.NumericAnswerKeyR\rnumericAnswer\x12\x12\n" +
+	"\x04opsi\x18\x12 \x03(\tR\x04opsi\x12.\n" +
+	"\x13jawaban_benar_label\x18\x13 \x01(\tR\x11jawabanBenarLabel\x12?\n" +
+	"\x1cjawaban_benar_complex_labels\x18\x14 \x03(\tR\x19jawabanBenarComplexLabels\x12=\n" +
+	"\x0ehotspot_answer\x18\x15 \x01(\v2\x16.base.HotspotAnswerKeyR\rhotspotAnswer\x124\n" +
+	"\vgrid_answer\x18\x16 \x01(\v2\x13.base.GridAnswerKeyR\n" +
+	"gridAnswer\x12:\n" +
+	"\x0econtent_format\x18\x17 \x01(\x0e2\x13.base.ContentFormatR\rcontentFormat\"7\n" +
 	"\rSoalOrderItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
 	"\x06urutan\x18\x02 \x01(\x05R\x06urutan\"\\\n" +
@@ -9464,6 +19083,48 @@ const file_cbt_proto_rawDesc = "" +
 	"\x06urutan\x18\x02 \x01(\x05R\x06urutan\x12\x1e\n" +
 	"\n" +
 	"keterangan\x18\x03 \x01(\tR\n" +
+	"keterangan\"\xa2\x03\n" +
+	"\tSoalMedia\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12&\n" +
+	"\x05jenis\x18\x02 \x01(\x0e2\x10.base.MediaJenisR\x05jenis\x12\x1b\n" +
+	"\tnama_file\x18\x03 \x01(\tR\bnamaFile\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x05R\bfileSize\x12\x1b\n" +
+	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x12!\n" +
+	"\fdurasi_detik\x18\a \x01(\x01R\vdurasiDetik\x12\x1b\n" +
+	"\tmax_putar\x18\b \x01(\x05R\bmaxPutar\x12\x16\n" +
+	"\x06urutan\x18\t \x01(\x05R\x06urutan\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\n" +
+	" \x01(\tR\n" +
+	"keterangan\x12\x19\n" +
+	"\bcloud_id\x18\v \x01(\tR\acloudId\x12\x1b\n" +
+	"\tpublic_id\x18\f \x01(\tR\bpublicId\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x91\x02\n" +
+	"\x18UploadMediaToSoalRequest\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\x12\x1f\n" +
+	"\vmedia_bytes\x18\x02 \x01(\fR\n" +
+	"mediaBytes\x12\x1b\n" +
+	"\tnama_file\x18\x03 \x01(\tR\bnamaFile\x12&\n" +
+	"\x05jenis\x18\x04 \x01(\x0e2\x10.base.MediaJenisR\x05jenis\x12!\n" +
+	"\fdurasi_detik\x18\x05 \x01(\x01R\vdurasiDetik\x12\x1b\n" +
+	"\tmax_putar\x18\x06 \x01(\x05R\bmaxPutar\x12\x16\n" +
+	"\x06urutan\x18\a \x01(\x05R\x06urutan\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\b \x01(\tR\n" +
+	"keterangan\"<\n" +
+	"\x13UploadMediaResponse\x12%\n" +
+	"\x05media\x18\x01 \x01(\v2\x0f.base.SoalMediaR\x05media\"7\n" +
+	"\x1aDeleteMediaFromSoalRequest\x12\x19\n" +
+	"\bid_media\x18\x01 \x01(\x05R\aidMedia\"\xad\x01\n" +
+	"\x18UpdateMediaInSoalRequest\x12\x19\n" +
+	"\bid_media\x18\x01 \x01(\x05R\aidMedia\x12\x16\n" +
+	"\x06urutan\x18\x02 \x01(\x05R\x06urutan\x12\x1b\n" +
+	"\tmax_putar\x18\x03 \x01(\x05R\bmaxPutar\x12!\n" +
+	"\fdurasi_detik\x18\x04 \x01(\x01R\vdurasiDetik\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\x05 \x01(\tR\n" +
 	"keterangan\"e\n" +
 	"\bDragItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
@@ -9523,7 +19184,7 @@ const file_cbt_proto_rawDesc = "" +
 	"isAnswered\x1a=\n" +
 	"\x0fUserAnswerEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xbf\t\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x97\x12\n" +
 	"\x12QuestionForStudent\x12\x1d\n" +
 	"\n" +
 	"nomor_urut\x18\x01 \x01(\x05R\tnomorUrut\x127\n" +
@@ -9562,7 +19223,40 @@ const file_cbt_proto_rawDesc = "" +
 	"mcc_opsi_d\x18\x1b \x01(\tR\bmccOpsiD\x12C\n" +
 	"\x13mcc_jawaban_dipilih\x18\x1c \x03(\x0e2\x13.base.JawabanOptionR\x11mccJawabanDipilih\x12/\n" +
 	"\n" +
-	"mcc_gambar\x18\x1d \x03(\v2\x10.base.SoalGambarR\tmccGambar\x1a?\n" +
+	"mcc_gambar\x18\x1d \x03(\v2\x10.base.SoalGambarR\tmccGambar\x12\x13\n" +
+	"\x05sa_id\x18\x1e \x01(\x05R\x04saId\x12#\n" +
+	"\rsa_pertanyaan\x18\x1f \x01(\tR\fsaPertanyaan\x12$\n" +
+	"\x0esa_blank_count\x18  \x01(\x05R\fsaBlankCount\x12\x1d\n" +
+	"\n" +
+	"sa_jawaban\x18! \x03(\tR\tsaJawaban\x12-\n" +
+	"\tsa_gambar\x18\" \x03(\v2\x10.base.SoalGambarR\bsaGambar\x12\x15\n" +
+	"\x06num_id\x18# \x01(\x05R\x05numId\x12%\n" +
+	"\x0enum_pertanyaan\x18$ \x01(\tR\rnumPertanyaan\x12\x1f\n" +
+	"\vnum_jawaban\x18% \x01(\tR\n" +
+	"numJawaban\x12/\n" +
+	"\n" +
+	"num_gambar\x18& \x03(\v2\x10.base.SoalGambarR\tnumGambar\x12'\n" +
+	"\amc_opsi\x18' \x03(\v2\x0e.base.SoalOpsiR\x06mcOpsi\x127\n" +
+	"\x18mc_jawaban_dipilih_label\x18( \x01(\tR\x15mcJawabanDipilihLabel\x12)\n" +
+	"\bmcc_opsi\x18) \x03(\v2\x0e.base.SoalOpsiR\amccOpsi\x12;\n" +
+	"\x1amcc_jawaban_dipilih_labels\x18* \x03(\tR\x17mccJawabanDipilihLabels\x12\x13\n" +
+	"\x05hs_id\x18+ \x01(\x05R\x04hsId\x12#\n" +
+	"\rhs_pertanyaan\x18, \x01(\tR\fhsPertanyaan\x12-\n" +
+	"\ths_gambar\x18- \x03(\v2\x10.base.SoalGambarR\bhsGambar\x12&\n" +
+	"\x0fhs_image_urutan\x18. \x01(\x05R\rhsImageUrutan\x12\"\n" +
+	"\rhs_max_points\x18/ \x01(\x05R\vhsMaxPoints\x121\n" +
+	"\n" +
+	"hs_jawaban\x180 \x03(\v2\x12.base.HotspotPointR\thsJawaban\x12\x17\n" +
+	"\agrid_id\x181 \x01(\x05R\x06gridId\x12'\n" +
+	"\x0fgrid_pertanyaan\x182 \x01(\tR\x0egridPertanyaan\x12\x1b\n" +
+	"\tgrid_rows\x183 \x03(\tR\bgridRows\x12!\n" +
+	"\fgrid_columns\x184 \x03(\tR\vgridColumns\x12!\n" +
+	"\fgrid_jawaban\x185 \x03(\x05R\vgridJawaban\x121\n" +
+	"\vgrid_gambar\x186 \x03(\v2\x10.base.SoalGambarR\n" +
+	"gridGambar\x12)\n" +
+	"\x05media\x187 \x03(\v2\x13.base.QuestionMediaR\x05media\x12:\n" +
+	"\x0econtent_format\x188 \x01(\x0e2\x13.base.ContentFormatR\rcontentFormat\x12'\n" +
+	"\x0fpertanyaan_html\x189 \x01(\tR\x0epertanyaanHtml\x1a?\n" +
 	"\x11DdUserAnswerEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xae\x03\n" +
@@ -9694,12 +19388,13 @@ const file_cbt_proto_rawDesc = "" +
 	"\rdijawab_count\x18\x05 \x01(\x05R\fdijawabCount\x12,\n" +
 	"\x12is_answered_status\x18\x06 \x03(\bR\x10isAnsweredStatus\x12;\n" +
 	"\vbatas_waktu\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"batasWaktu\"\x97\x01\n" +
+	"batasWaktu\"\xbc\x01\n" +
 	"\x13SubmitAnswerRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
 	"\n" +
 	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12<\n" +
-	"\x0fjawaban_dipilih\x18\x03 \x01(\x0e2\x13.base.JawabanOptionR\x0ejawabanDipilih\"\xf6\x01\n" +
+	"\x0fjawaban_dipilih\x18\x03 \x01(\x0e2\x13.base.JawabanOptionR\x0ejawabanDipilih\x12#\n" +
+	"\rjawaban_label\x18\x04 \x01(\tR\fjawabanLabel\"\x9b\x02\n" +
 	"\x14SubmitAnswerResponse\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
 	"\n" +
@@ -9707,12 +19402,14 @@ const file_cbt_proto_rawDesc = "" +
 	"\x0fjawaban_dipilih\x18\x03 \x01(\x0e2\x13.base.JawabanOptionR\x0ejawabanDipilih\x12\x1d\n" +
 	"\n" +
 	"is_correct\x18\x04 \x01(\bR\tisCorrect\x12=\n" +
-	"\fdijawab_pada\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\"\x9e\x01\n" +
+	"\fdijawab_pada\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\x12#\n" +
+	"\rjawaban_label\x18\x06 \x01(\tR\fjawabanLabel\"\xc5\x01\n" +
 	"\x1aSubmitComplexAnswerRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
 	"\n" +
 	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12<\n" +
-	"\x0fjawaban_dipilih\x18\x03 \x03(\x0e2\x13.base.JawabanOptionR\x0ejawabanDipilih\"\xfd\x01\n" +
+	"\x0fjawaban_dipilih\x18\x03 \x03(\x0e2\x13.base.JawabanOptionR\x0ejawabanDipilih\x12%\n" +
+	"\x0ejawaban_labels\x18\x04 \x03(\tR\rjawabanLabels\"\xa4\x02\n" +
 	"\x1bSubmitComplexAnswerResponse\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
 	"\n" +
@@ -9720,7 +19417,8 @@ const file_cbt_proto_rawDesc = "" +
 	"\x0fjawaban_dipilih\x18\x03 \x03(\x0e2\x13.base.JawabanOptionR\x0ejawabanDipilih\x12\x1d\n" +
 	"\n" +
 	"is_correct\x18\x04 \x01(\bR\tisCorrect\x12=\n" +
-	"\fdijawab_pada\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\"\xe3\x01\n" +
+	"\fdijawab_pada\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\x12%\n" +
+	"\x0ejawaban_labels\x18\x06 \x03(\tR\rjawabanLabels\"\xe3\x01\n" +
 	"\x1bSubmitDragDropAnswerRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
 	"\n" +
@@ -9763,7 +19461,7 @@ const file_cbt_proto_rawDesc = "" +
 	"\x16CompleteSessionRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\";\n" +
 	"\x14GetTestResultRequest\x12#\n" +
-	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\xa1\t\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\xec\x11\n" +
 	"\rJawabanDetail\x12\x1d\n" +
 	"\n" +
 	"nomor_urut\x18\x01 \x01(\x05R\tnomorUrut\x12\x1e\n" +
@@ -9796,20 +19494,46 @@ const file_cbt_proto_rawDesc = "" +
 	"nilaiEssay\x12)\n" +
 	"\x10feedback_teacher\x18\x15 \x01(\tR\x0ffeedbackTeacher\x12K\n" +
 	"\x17jawaban_dipilih_complex\x18\x16 \x03(\x0e2\x13.base.JawabanOptionR\x15jawabanDipilihComplex\x12G\n" +
-	"\x15jawaban_benar_complex\x18\x17 \x03(\x0e2\x13.base.JawabanOptionR\x13jawabanBenarComplex\x1aA\n" +
+	"\x15jawaban_benar_complex\x18\x17 \x03(\x0e2\x13.base.JawabanOptionR\x13jawabanBenarComplex\x126\n" +
+	"\rrubric_scores\x18\x18 \x03(\v2\x11.base.RubricScoreR\frubricScores\x120\n" +
+	"\x14jawaban_short_answer\x18\x19 \x03(\tR\x12jawabanShortAnswer\x12F\n" +
+	"\x13short_answer_blanks\x18\x1a \x03(\v2\x16.base.ShortAnswerBlankR\x11shortAnswerBlanks\x12;\n" +
+	"\x1ashort_answer_blank_correct\x18\x1b \x03(\bR\x17shortAnswerBlankCorrect\x12'\n" +
+	"\x0fjawaban_numeric\x18\x1c \x01(\tR\x0ejawabanNumeric\x12=\n" +
+	"\x0enumeric_answer\x18\x1d \x01(\v2\x16.base.NumericAnswerKeyR\rnumericAnswer\x12\"\n" +
+	"\x04opsi\x18\x1e \x03(\v2\x0e.base.SoalOpsiR\x04opsi\x122\n" +
+	"\x15jawaban_dipilih_label\x18\x1f \x01(\tR\x13jawabanDipilihLabel\x12.\n" +
+	"\x13jawaban_benar_label\x18  \x01(\tR\x11jawabanBenarLabel\x12C\n" +
+	"\x1ejawaban_dipilih_complex_labels\x18! \x03(\tR\x1bjawabanDipilihComplexLabels\x12?\n" +
+	"\x1cjawaban_benar_complex_labels\x18\" \x03(\tR\x19jawabanBenarComplexLabels\x12;\n" +
+	"\x0fjawaban_hotspot\x18# \x03(\v2\x12.base.HotspotPointR\x0ejawabanHotspot\x12=\n" +
+	"\x0ehotspot_answer\x18$ \x01(\v2\x16.base.HotspotAnswerKeyR\rhotspotAnswer\x122\n" +
+	"\x15hotspot_point_correct\x18% \x03(\bR\x13hotspotPointCorrect\x12!\n" +
+	"\fjawaban_grid\x18& \x03(\x05R\vjawabanGrid\x124\n" +
+	"\vgrid_answer\x18' \x01(\v2\x13.base.GridAnswerKeyR\n" +
+	"gridAnswer\x12(\n" +
+	"\x10grid_row_correct\x18( \x03(\bR\x0egridRowCorrect\x12#\n" +
+	"\rnilai_parsial\x18) \x01(\x01R\fnilaiParsial\x12:\n" +
+	"\x0econtent_format\x18* \x01(\x0e2\x13.base.ContentFormatR\rcontentFormat\x12'\n" +
+	"\x0fpertanyaan_html\x18+ \x01(\tR\x0epertanyaanHtml\x12'\n" +
+	"\x0fpembahasan_html\x18, \x01(\tR\x0epembahasanHtml\x1aA\n" +
 	"\x13UserDragAnswerEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aD\n" +
 	"\x16CorrectDragAnswerEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"h\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xac\x01\n" +
 	"\x17GradeEssayAnswerRequest\x12\x1b\n" +
 	"\tanswer_id\x18\x01 \x01(\x05R\banswerId\x12\x14\n" +
 	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1a\n" +
-	"\bfeedback\x18\x03 \x01(\tR\bfeedback\"N\n" +
+	"\bfeedback\x18\x03 \x01(\tR\bfeedback\x12B\n" +
+	"\x11rubric_selections\x18\x04 \x03(\v2\x15.base.RubricSelectionR\x10rubricSelections\"\xa7\x01\n" +
 	"\x18GradeEssayAnswerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xaf\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vnilai_essay\x18\x03 \x01(\x01R\n" +
+	"nilaiEssay\x126\n" +
+	"\rrubric_scores\x18\x04 \x03(\v2\x11.base.RubricScoreR\frubricScores\"\xaf\x01\n" +
 	"\x12TestResultResponse\x124\n" +
 	"\fsession_info\x18\x01 \x01(\v2\x11.base.TestSessionR\vsessionInfo\x12:\n" +
 	"\x0edetail_jawaban\x18\x02 \x03(\v2\x13.base.JawabanDetailR\rdetailJawaban\x12'\n" +
@@ -9918,13 +19642,662 @@ const file_cbt_proto_rawDesc = "" +
 	"\flms_class_id\x18\x01 \x01(\x03R\n" +
 	"lmsClassId\"O\n" +
 	"\x19ListClassStudentsResponse\x122\n" +
-	"\bstudents\x18\x01 \x03(\v2\x16.base.ClassStudentDataR\bstudents*@\n" +
+	"\bstudents\x18\x01 \x03(\v2\x16.base.ClassStudentDataR\bstudents\"\xb2\x02\n" +
+	"\tSebConfig\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"config_key\x18\x03 \x01(\tR\tconfigKey\x12*\n" +
+	"\x11browser_exam_keys\x18\x04 \x03(\tR\x0fbrowserExamKeys\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa8\x01\n" +
+	"\x16UploadSebConfigRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x19\n" +
+	"\bseb_file\x18\x02 \x01(\fR\asebFile\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12*\n" +
+	"\x11browser_exam_keys\x18\x04 \x03(\tR\x0fbrowserExamKeys\"A\n" +
+	"\x13GetSebConfigRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\"D\n" +
+	"\x16DeleteSebConfigRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\"C\n" +
+	"\x11SebConfigResponse\x12.\n" +
+	"\n" +
+	"seb_config\x18\x01 \x01(\v2\x0f.base.SebConfigR\tsebConfig\"\x9e\x03\n" +
+	"\vDeviceLease\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12#\n" +
+	"\rattempt_count\x18\x06 \x01(\x05R\fattemptCount\x127\n" +
+	"\tissued_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x12<\n" +
+	"\flast_seen_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12;\n" +
+	"\vreleased_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\x12\x1f\n" +
+	"\vapproved_by\x18\n" +
+	" \x01(\x05R\n" +
+	"approvedBy\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\">\n" +
+	"\x17ListDeviceLeasesRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"E\n" +
+	"\x18ListDeviceLeasesResponse\x12)\n" +
+	"\x06leases\x18\x01 \x03(\v2\x11.base.DeviceLeaseR\x06leases\"t\n" +
+	"\x1cApproveDeviceTransferRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\">\n" +
+	"\x13DeviceLeaseResponse\x12'\n" +
+	"\x05lease\x18\x01 \x01(\v2\x11.base.DeviceLeaseR\x05lease\"\xdc\x01\n" +
+	"\x15NetworkAllowlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\rlms_school_id\x18\x02 \x01(\x03R\vlmsSchoolId\x12*\n" +
+	"\x11lms_assignment_id\x18\x03 \x01(\x03R\x0flmsAssignmentId\x12\x12\n" +
+	"\x04cidr\x18\x04 \x01(\tR\x04cidr\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x98\x01\n" +
+	"\x1aSetNetworkAllowlistRequest\x12\"\n" +
+	"\rlms_school_id\x18\x01 \x01(\x03R\vlmsSchoolId\x12*\n" +
+	"\x11lms_assignment_id\x18\x02 \x01(\x03R\x0flmsAssignmentId\x12\x14\n" +
+	"\x05cidrs\x18\x03 \x03(\tR\x05cidrs\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\"l\n" +
+	"\x1aGetNetworkAllowlistRequest\x12\"\n" +
+	"\rlms_school_id\x18\x01 \x01(\x03R\vlmsSchoolId\x12*\n" +
+	"\x11lms_assignment_id\x18\x02 \x01(\x03R\x0flmsAssignmentId\"Q\n" +
+	"\x18NetworkAllowlistResponse\x125\n" +
+	"\aentries\x18\x01 \x03(\v2\x1b.base.NetworkAllowlistEntryR\aentries\"\x88\x01\n" +
+	"\x1bGrantNetworkOverrideRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12,\n" +
+	"\x12expires_in_minutes\x18\x03 \x01(\x05R\x10expiresInMinutes\"\xfb\x01\n" +
+	"\x17NetworkOverrideResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x04 \x01(\x05R\tgrantedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa6\x02\n" +
+	"\x13NetworkAccessDenial\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fid_test_session\x18\x02 \x01(\x05R\ridTestSession\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\"\n" +
+	"\rlms_school_id\x18\x04 \x01(\x03R\vlmsSchoolId\x12*\n" +
+	"\x11lms_assignment_id\x18\x05 \x01(\x03R\x0flmsAssignmentId\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x1b\n" +
+	"\tclient_ip\x18\a \x01(\tR\bclientIp\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaa\x01\n" +
+	"\x1fListNetworkAccessDenialsRequest\x12\"\n" +
+	"\rlms_school_id\x18\x01 \x01(\x03R\vlmsSchoolId\x12*\n" +
+	"\x11lms_assignment_id\x18\x02 \x01(\x03R\x0flmsAssignmentId\x127\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x17.base.PaginationRequestR\n" +
+	"pagination\"\x91\x01\n" +
+	" ListNetworkAccessDenialsResponse\x123\n" +
+	"\adenials\x18\x01 \x03(\v2\x19.base.NetworkAccessDenialR\adenials\x128\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x18.base.PaginationResponseR\n" +
+	"pagination\"\xb5\x01\n" +
+	"\x17AnalyzeCollusionRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12.\n" +
+	"\x13sync_window_seconds\x18\x02 \x01(\x05R\x11syncWindowSeconds\x12(\n" +
+	"\x10min_shared_wrong\x18\x03 \x01(\x05R\x0eminSharedWrong\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x9b\x01\n" +
+	"\x10CollusionSession\x12&\n" +
+	"\x0fid_test_session\x18\x01 \x01(\x05R\ridTestSession\x12#\n" +
+	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12!\n" +
+	"\fnama_peserta\x18\x04 \x01(\tR\vnamaPeserta\"\xa5\x03\n" +
+	"\x11CollusionEvidence\x12#\n" +
+	"\rquestion_type\x18\x01 \x01(\tR\fquestionType\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\x12\x1d\n" +
+	"\n" +
+	"response_a\x18\x03 \x01(\tR\tresponseA\x12\x1d\n" +
+	"\n" +
+	"response_b\x18\x04 \x01(\tR\tresponseB\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x05 \x01(\bR\tisCorrect\x12>\n" +
+	"\ranswered_at_a\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vansweredAtA\x12>\n" +
+	"\ranswered_at_b\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vansweredAtB\x12(\n" +
+	"\x10time_gap_seconds\x18\b \x01(\x01R\x0etimeGapSeconds\x12'\n" +
+	"\x0fidentical_wrong\x18\t \x01(\bR\x0eidenticalWrong\x12\"\n" +
+	"\fsynchronized\x18\n" +
+	" \x01(\bR\fsynchronized\"\x9d\x05\n" +
+	"\rCollusionPair\x123\n" +
+	"\tsession_a\x18\x01 \x01(\v2\x16.base.CollusionSessionR\bsessionA\x123\n" +
+	"\tsession_b\x18\x02 \x01(\v2\x16.base.CollusionSessionR\bsessionB\x12!\n" +
+	"\fcommon_items\x18\x03 \x01(\x05R\vcommonItems\x12+\n" +
+	"\x11identical_answers\x18\x04 \x01(\x05R\x10identicalAnswers\x12!\n" +
+	"\fshared_wrong\x18\x05 \x01(\x05R\vsharedWrong\x12 \n" +
+	"\vdifferences\x18\x06 \x01(\x05R\vdifferences\x12*\n" +
+	"\x11harpp_hogan_ratio\x18\a \x01(\x01R\x0fharppHoganRatio\x122\n" +
+	"\x15expected_shared_wrong\x18\b \x01(\x01R\x13expectedSharedWrong\x12/\n" +
+	"\x14shared_wrong_p_value\x18\t \x01(\x01R\x11sharedWrongPValue\x121\n" +
+	"\x14synchronized_answers\x18\n" +
+	" \x01(\x05R\x13synchronizedAnswers\x12-\n" +
+	"\x12synchronized_ratio\x18\v \x01(\x01R\x11synchronizedRatio\x125\n" +
+	"\x17median_time_gap_seconds\x18\f \x01(\x01R\x14medianTimeGapSeconds\x12\x14\n" +
+	"\x05score\x18\r \x01(\x01R\x05score\x12\x18\n" +
+	"\aflagged\x18\x0e \x01(\bR\aflagged\x123\n" +
+	"\bevidence\x18\x0f \x03(\v2\x17.base.CollusionEvidenceR\bevidence\"\xfb\x01\n" +
+	"\x17CollusionReportResponse\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12#\n" +
+	"\rsession_count\x18\x02 \x01(\x05R\fsessionCount\x12%\n" +
+	"\x0epairs_analyzed\x18\x03 \x01(\x05R\rpairsAnalyzed\x12)\n" +
+	"\x05pairs\x18\x04 \x03(\v2\x13.base.CollusionPairR\x05pairs\x12=\n" +
+	"\fgenerated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"e\n" +
+	"\x1eRunEssaySimilarityCheckRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\"\xc8\x02\n" +
+	"\x1aEssaySimilarityRunResponse\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\x12)\n" +
+	"\x10answers_compared\x18\x03 \x01(\x05R\x0fanswersCompared\x12%\n" +
+	"\x0epairs_compared\x18\x04 \x01(\x05R\rpairsCompared\x12-\n" +
+	"\x12similarities_saved\x18\x05 \x01(\x05R\x11similaritiesSaved\x12'\n" +
+	"\x0fflagged_answers\x18\x06 \x01(\x05R\x0eflaggedAnswers\x12;\n" +
+	"\vcomputed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"9\n" +
+	"\x1aGetEssayGradingViewRequest\x12\x1b\n" +
+	"\tanswer_id\x18\x01 \x01(\x05R\banswerId\"\xbd\x04\n" +
+	"\x15EssayAnswerForGrading\x12\x1b\n" +
+	"\tanswer_id\x18\x01 \x01(\x05R\banswerId\x12&\n" +
+	"\x0fid_test_session\x18\x02 \x01(\x05R\ridTestSession\x12#\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x05R\x06userId\x12!\n" +
+	"\fnama_peserta\x18\x05 \x01(\tR\vnamaPeserta\x12*\n" +
+	"\x11lms_assignment_id\x18\x06 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\a \x01(\x05R\x06idSoal\x12\x1e\n" +
+	"\n" +
+	"pertanyaan\x18\b \x01(\tR\n" +
+	"pertanyaan\x12*\n" +
+	"\x11jawaban_essay_key\x18\t \x01(\tR\x0fjawabanEssayKey\x12#\n" +
+	"\rjawaban_essay\x18\n" +
+	" \x01(\tR\fjawabanEssay\x12\x1f\n" +
+	"\vnilai_essay\x18\v \x01(\x01R\n" +
+	"nilaiEssay\x12\x1b\n" +
+	"\tis_graded\x18\f \x01(\bR\bisGraded\x12)\n" +
+	"\x10feedback_teacher\x18\r \x01(\tR\x0ffeedbackTeacher\x12=\n" +
+	"\fdijawab_pada\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\x12 \n" +
+	"\flms_class_id\x18\x0f \x01(\x03R\n" +
+	"lmsClassId\"\xcb\x01\n" +
+	"\x0eMatchedPassage\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\x12!\n" +
+	"\fmatched_text\x18\x04 \x01(\tR\vmatchedText\x12#\n" +
+	"\rmatched_start\x18\x05 \x01(\x05R\fmatchedStart\x12\x1f\n" +
+	"\vmatched_end\x18\x06 \x01(\x05R\n" +
+	"matchedEnd\x12\x14\n" +
+	"\x05words\x18\a \x01(\x05R\x05words\"\xfb\x02\n" +
+	"\x0fEssaySimilarity\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12*\n" +
+	"\x11matched_answer_id\x18\x02 \x01(\x05R\x0fmatchedAnswerId\x122\n" +
+	"\x15matched_session_token\x18\x03 \x01(\tR\x13matchedSessionToken\x120\n" +
+	"\x14matched_nama_peserta\x18\x04 \x01(\tR\x12matchedNamaPeserta\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x05 \x01(\x01R\n" +
+	"similarity\x12 \n" +
+	"\vcontainment\x18\x06 \x01(\x01R\vcontainment\x12?\n" +
+	"\x10matched_passages\x18\a \x03(\v2\x14.base.MatchedPassageR\x0fmatchedPassages\x12;\n" +
+	"\vcomputed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\xa4\x02\n" +
+	"\x18EssayGradingViewResponse\x123\n" +
+	"\x06answer\x18\x01 \x01(\v2\x1b.base.EssayAnswerForGradingR\x06answer\x129\n" +
+	"\fsimilarities\x18\x02 \x03(\v2\x15.base.EssaySimilarityR\fsimilarities\x12)\n" +
+	"\x06rubric\x18\x03 \x01(\v2\x11.base.EssayRubricR\x06rubric\x126\n" +
+	"\rrubric_scores\x18\x04 \x03(\v2\x11.base.RubricScoreR\frubricScores\x125\n" +
+	"\n" +
+	"suggestion\x18\x05 \x01(\v2\x15.base.ScoreSuggestionR\n" +
+	"suggestion\"\x7f\n" +
+	"\vRubricLevel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1c\n" +
+	"\tdeskripsi\x18\x03 \x01(\tR\tdeskripsi\x12\x14\n" +
+	"\x05point\x18\x04 \x01(\x01R\x05point\x12\x16\n" +
+	"\x06urutan\x18\x05 \x01(\x05R\x06urutan\"\xb3\x01\n" +
+	"\x0fRubricCriterion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04nama\x18\x02 \x01(\tR\x04nama\x12\x1c\n" +
+	"\tdeskripsi\x18\x03 \x01(\tR\tdeskripsi\x12\x16\n" +
+	"\x06urutan\x18\x04 \x01(\x05R\x06urutan\x12)\n" +
+	"\x06levels\x18\x05 \x03(\v2\x11.base.RubricLevelR\x06levels\x12\x1b\n" +
+	"\tmax_point\x18\x06 \x01(\x01R\bmaxPoint\"\xd6\x01\n" +
+	"\vEssayRubric\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\x12#\n" +
+	"\rpassing_score\x18\x02 \x01(\x01R\fpassingScore\x12\x1b\n" +
+	"\tmax_point\x18\x03 \x01(\x01R\bmaxPoint\x121\n" +
+	"\bcriteria\x18\x04 \x03(\v2\x15.base.RubricCriterionR\bcriteria\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x88\x01\n" +
+	"\x15SetEssayRubricRequest\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\x12#\n" +
+	"\rpassing_score\x18\x02 \x01(\x01R\fpassingScore\x121\n" +
+	"\bcriteria\x18\x03 \x03(\v2\x15.base.RubricCriterionR\bcriteria\"0\n" +
+	"\x15GetEssayRubricRequest\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\"@\n" +
+	"\x13EssayRubricResponse\x12)\n" +
+	"\x06rubric\x18\x01 \x01(\v2\x11.base.EssayRubricR\x06rubric\"k\n" +
+	"\x0fRubricSelection\x12!\n" +
+	"\fcriterion_id\x18\x01 \x01(\x03R\vcriterionId\x12\x19\n" +
+	"\blevel_id\x18\x02 \x01(\x03R\alevelId\x12\x1a\n" +
+	"\bfeedback\x18\x03 \x01(\tR\bfeedback\"\xe0\x01\n" +
+	"\vRubricScore\x12!\n" +
+	"\fcriterion_id\x18\x01 \x01(\x03R\vcriterionId\x12#\n" +
+	"\rnama_kriteria\x18\x02 \x01(\tR\fnamaKriteria\x12\x19\n" +
+	"\blevel_id\x18\x03 \x01(\x03R\alevelId\x12\x1f\n" +
+	"\vlabel_level\x18\x04 \x01(\tR\n" +
+	"labelLevel\x12\x14\n" +
+	"\x05point\x18\x05 \x01(\x01R\x05point\x12\x1b\n" +
+	"\tmax_point\x18\x06 \x01(\x01R\bmaxPoint\x12\x1a\n" +
+	"\bfeedback\x18\a \x01(\tR\bfeedback\"\xf1\x01\n" +
+	"\rGradingConfig\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x1d\n" +
+	"\n" +
+	"blind_mode\x18\x02 \x01(\bR\tblindMode\x12%\n" +
+	"\x0edouble_marking\x18\x03 \x01(\bR\rdoubleMarking\x123\n" +
+	"\x15discrepancy_threshold\x18\x04 \x01(\x01R\x14discrepancyThreshold\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc0\x01\n" +
+	"\x17SetGradingConfigRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x1d\n" +
+	"\n" +
+	"blind_mode\x18\x02 \x01(\bR\tblindMode\x12%\n" +
+	"\x0edouble_marking\x18\x03 \x01(\bR\rdoubleMarking\x123\n" +
+	"\x15discrepancy_threshold\x18\x04 \x01(\x01R\x14discrepancyThreshold\"E\n" +
+	"\x17GetGradingConfigRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\"D\n" +
+	"\x15GradingConfigResponse\x12+\n" +
+	"\x06config\x18\x01 \x01(\v2\x13.base.GradingConfigR\x06config\"\xdb\x02\n" +
+	"\vGradingTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tanswer_id\x18\x02 \x01(\x05R\banswerId\x12\x1b\n" +
+	"\tgrader_id\x18\x03 \x01(\x05R\bgraderId\x12\x1f\n" +
+	"\vmarker_slot\x18\x04 \x01(\x05R\n" +
+	"markerSlot\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\x12\x1b\n" +
+	"\thas_score\x18\a \x01(\bR\bhasScore\x12\x1a\n" +
+	"\bfeedback\x18\b \x01(\tR\bfeedback\x12;\n" +
+	"\vassigned_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x12=\n" +
+	"\fsubmitted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\xde\x01\n" +
+	"\x0fEssayModeration\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12 \n" +
+	"\vdiscrepancy\x18\x02 \x01(\x01R\vdiscrepancy\x12\x1f\n" +
+	"\vfinal_score\x18\x03 \x01(\x01R\n" +
+	"finalScore\x12!\n" +
+	"\fmoderator_id\x18\x04 \x01(\x05R\vmoderatorId\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xda\x01\n" +
+	"\fPendingEssay\x123\n" +
+	"\x06answer\x18\x01 \x01(\v2\x1b.base.EssayAnswerForGradingR\x06answer\x12'\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x11.base.GradingTaskR\x05tasks\x125\n" +
+	"\n" +
+	"moderation\x18\x03 \x01(\v2\x15.base.EssayModerationR\n" +
+	"moderation\x125\n" +
+	"\n" +
+	"suggestion\x18\x04 \x01(\v2\x15.base.ScoreSuggestionR\n" +
+	"suggestion\"\xfc\x01\n" +
+	"\x18ListPendingEssaysRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12 \n" +
+	"\flms_class_id\x18\x02 \x01(\x03R\n" +
+	"lmsClassId\x12\x17\n" +
+	"\aid_soal\x18\x03 \x01(\x05R\x06idSoal\x12\x1b\n" +
+	"\tgrader_id\x18\x04 \x01(\x05R\bgraderId\x12#\n" +
+	"\rin_moderation\x18\x05 \x01(\bR\finModeration\x127\n" +
+	"\n" +
+	"pagination\x18\x06 \x01(\v2\x17.base.PaginationRequestR\n" +
+	"pagination\"\x81\x01\n" +
+	"\x19ListPendingEssaysResponse\x12*\n" +
+	"\x06essays\x18\x01 \x03(\v2\x12.base.PendingEssayR\x06essays\x128\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x18.base.PaginationResponseR\n" +
+	"pagination\"\xba\x01\n" +
+	"\x14AssignGradersRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\x12\x1d\n" +
+	"\n" +
+	"answer_ids\x18\x03 \x03(\x05R\tanswerIds\x12\x1d\n" +
+	"\n" +
+	"grader_ids\x18\x04 \x03(\x05R\tgraderIds\x12\x1f\n" +
+	"\vmarker_slot\x18\x05 \x01(\x05R\n" +
+	"markerSlot\"g\n" +
+	"\x15AssignGradersResponse\x12'\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x11.base.GradingTaskR\x05tasks\x12%\n" +
+	"\x0eassigned_count\x18\x02 \x01(\x05R\rassignedCount\"\xd8\x01\n" +
+	"\x16SubmitEssayMarkRequest\x12\x1b\n" +
+	"\tanswer_id\x18\x01 \x01(\x05R\banswerId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1a\n" +
+	"\bfeedback\x18\x03 \x01(\tR\bfeedback\x12B\n" +
+	"\x11rubric_selections\x18\x04 \x03(\v2\x15.base.RubricSelectionR\x10rubricSelections\x12+\n" +
+	"\x11accept_suggestion\x18\x05 \x01(\bR\x10acceptSuggestion\"\xc1\x01\n" +
+	"\x18ResolveModerationRequest\x12\x1b\n" +
+	"\tanswer_id\x18\x01 \x01(\x05R\banswerId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1a\n" +
+	"\bfeedback\x18\x03 \x01(\tR\bfeedback\x12B\n" +
+	"\x11rubric_selections\x18\x04 \x03(\v2\x15.base.RubricSelectionR\x10rubricSelections\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"\x8f\x02\n" +
+	"\x11EssayMarkResponse\x12\x1b\n" +
+	"\tanswer_id\x18\x01 \x01(\x05R\banswerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vnilai_essay\x18\x03 \x01(\x01R\n" +
+	"nilaiEssay\x12 \n" +
+	"\vdiscrepancy\x18\x04 \x01(\x01R\vdiscrepancy\x126\n" +
+	"\rrubric_scores\x18\x05 \x03(\v2\x11.base.RubricScoreR\frubricScores\x12#\n" +
+	"\rsession_token\x18\x06 \x01(\tR\fsessionToken\x12%\n" +
+	"\x0esession_status\x18\a \x01(\tR\rsessionStatus\"G\n" +
+	"\x19GetGradingProgressRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\"\xa2\x03\n" +
+	"\x17GradingProgressResponse\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12!\n" +
+	"\ftotal_essays\x18\x02 \x01(\x05R\vtotalEssays\x12#\n" +
+	"\rgraded_essays\x18\x03 \x01(\x05R\fgradedEssays\x12%\n" +
+	"\x0epending_essays\x18\x04 \x01(\x05R\rpendingEssays\x12+\n" +
+	"\x11unassigned_essays\x18\x05 \x01(\x05R\x10unassignedEssays\x120\n" +
+	"\x14awaiting_second_mark\x18\x06 \x01(\x05R\x12awaitingSecondMark\x12#\n" +
+	"\rin_moderation\x18\a \x01(\x05R\finModeration\x12?\n" +
+	"\x1csessions_grading_in_progress\x18\b \x01(\x05R\x19sessionsGradingInProgress\x12'\n" +
+	"\x0fsessions_graded\x18\t \x01(\x05R\x0esessionsGraded\"\x84\x01\n" +
+	"\fEssayKeyword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\akeyword\x18\x02 \x01(\tR\akeyword\x12\x1a\n" +
+	"\bsynonyms\x18\x03 \x03(\tR\bsynonyms\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06urutan\x18\x05 \x01(\x05R\x06urutan\"b\n" +
+	"\x17SetEssayKeywordsRequest\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\x12.\n" +
+	"\bkeywords\x18\x02 \x03(\v2\x12.base.EssayKeywordR\bkeywords\"2\n" +
+	"\x17GetEssayKeywordsRequest\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\"`\n" +
+	"\x15EssayKeywordsResponse\x12\x17\n" +
+	"\aid_soal\x18\x01 \x01(\x05R\x06idSoal\x12.\n" +
+	"\bkeywords\x18\x02 \x03(\v2\x12.base.EssayKeywordR\bkeywords\"s\n" +
+	"\fKeywordMatch\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12!\n" +
+	"\fmatched_term\x18\x02 \x01(\tR\vmatchedTerm\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\"\xe2\x03\n" +
+	"\x0fScoreSuggestion\x12'\n" +
+	"\x0fsuggested_score\x18\x01 \x01(\x01R\x0esuggestedScore\x12)\n" +
+	"\x10keyword_coverage\x18\x02 \x01(\x01R\x0fkeywordCoverage\x120\n" +
+	"\x14has_keyword_coverage\x18\x03 \x01(\bR\x12hasKeywordCoverage\x12+\n" +
+	"\x11reference_overlap\x18\x04 \x01(\x01R\x10referenceOverlap\x122\n" +
+	"\x15has_reference_overlap\x18\x05 \x01(\bR\x13hasReferenceOverlap\x12;\n" +
+	"\x0fkeyword_matches\x18\x06 \x03(\v2\x12.base.KeywordMatchR\x0ekeywordMatches\x12)\n" +
+	"\x10missing_keywords\x18\a \x03(\tR\x0fmissingKeywords\x12C\n" +
+	"\x12reference_passages\x18\b \x03(\v2\x14.base.MatchedPassageR\x11referencePassages\x12;\n" +
+	"\vcomputed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"f\n" +
+	"\x1fGenerateScoreSuggestionsRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\"\xfa\x01\n" +
+	" GenerateScoreSuggestionsResponse\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\x12%\n" +
+	"\x0eanswers_scored\x18\x03 \x01(\x05R\ranswersScored\x12-\n" +
+	"\x13skipped_no_key_data\x18\x04 \x01(\x05R\x10skippedNoKeyData\x12;\n" +
+	"\vcomputed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"d\n" +
+	"\x1dGetSuggestionAgreementRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\"\xda\x02\n" +
+	"\x1bSuggestionAgreementResponse\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x17\n" +
+	"\aid_soal\x18\x02 \x01(\x05R\x06idSoal\x124\n" +
+	"\x16graded_with_suggestion\x18\x03 \x01(\x05R\x14gradedWithSuggestion\x12\x16\n" +
+	"\x06agreed\x18\x04 \x01(\x05R\x06agreed\x12)\n" +
+	"\x10accepted_exactly\x18\x05 \x01(\x05R\x0facceptedExactly\x12%\n" +
+	"\x0eagreement_rate\x18\x06 \x01(\x01R\ragreementRate\x128\n" +
+	"\x18mean_absolute_difference\x18\a \x01(\x01R\x16meanAbsoluteDifference\x12\x1c\n" +
+	"\ttolerance\x18\b \x01(\x01R\ttolerance\"\xce\x01\n" +
+	"\x10ShortAnswerBlank\x12)\n" +
+	"\x10accepted_answers\x18\x01 \x03(\tR\x0facceptedAnswers\x12%\n" +
+	"\x0ecase_sensitive\x18\x02 \x01(\bR\rcaseSensitive\x12)\n" +
+	"\x10exact_whitespace\x18\x03 \x01(\bR\x0fexactWhitespace\x12'\n" +
+	"\x0fkeep_diacritics\x18\x04 \x01(\bR\x0ekeepDiacritics\x12\x14\n" +
+	"\x05regex\x18\x05 \x01(\tR\x05regex\"x\n" +
+	"\x18SubmitShortAnswerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x18\n" +
+	"\ajawaban\x18\x03 \x03(\tR\ajawaban\"\xb8\x01\n" +
+	"\x19SubmitShortAnswerResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x18\n" +
+	"\ajawaban\x18\x03 \x03(\tR\ajawaban\x12=\n" +
+	"\fdijawab_pada\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\"\xb0\x02\n" +
+	"\x10NumericAnswerKey\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12#\n" +
+	"\rabs_tolerance\x18\x02 \x01(\x01R\fabsTolerance\x12#\n" +
+	"\rrel_tolerance\x18\x03 \x01(\x01R\frelTolerance\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12%\n" +
+	"\x0eaccepted_units\x18\x05 \x03(\tR\racceptedUnits\x12#\n" +
+	"\runit_required\x18\x06 \x01(\bR\funitRequired\x12/\n" +
+	"\x13significant_figures\x18\a \x01(\x05R\x12significantFigures\x12+\n" +
+	"\x11decimal_separator\x18\b \x01(\tR\x10decimalSeparator\"z\n" +
+	"\x1aSubmitNumericAnswerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x18\n" +
+	"\ajawaban\x18\x03 \x01(\tR\ajawaban\"\xba\x01\n" +
+	"\x1bSubmitNumericAnswerResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x18\n" +
+	"\ajawaban\x18\x03 \x01(\tR\ajawaban\x12=\n" +
+	"\fdijawab_pada\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\"Q\n" +
+	"\bSoalOpsi\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
+	"\x04teks\x18\x02 \x01(\tR\x04teks\x12\x1b\n" +
+	"\tteks_html\x18\x03 \x01(\tR\bteksHtml\"*\n" +
+	"\fHotspotPoint\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\"\xc5\x01\n" +
+	"\rHotspotRegion\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12(\n" +
+	"\x05shape\x18\x02 \x01(\x0e2\x12.base.HotspotShapeR\x05shape\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x01R\x01y\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x01R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x01R\x06height\x12*\n" +
+	"\x06points\x18\a \x03(\v2\x12.base.HotspotPointR\x06points\"\xa4\x01\n" +
+	"\x10HotspotAnswerKey\x12!\n" +
+	"\fimage_urutan\x18\x01 \x01(\x05R\vimageUrutan\x12-\n" +
+	"\aregions\x18\x02 \x03(\v2\x13.base.HotspotRegionR\aregions\x12\x1f\n" +
+	"\vrequire_all\x18\x03 \x01(\bR\n" +
+	"requireAll\x12\x1d\n" +
+	"\n" +
+	"max_points\x18\x04 \x01(\x05R\tmaxPoints\"\x8c\x01\n" +
+	"\x1aSubmitHotspotAnswerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12*\n" +
+	"\x06points\x18\x03 \x03(\v2\x12.base.HotspotPointR\x06points\"\xcc\x01\n" +
+	"\x1bSubmitHotspotAnswerResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12*\n" +
+	"\x06points\x18\x03 \x03(\v2\x12.base.HotspotPointR\x06points\x12=\n" +
+	"\fdijawab_pada\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\">\n" +
+	"\aGridRow\x12\x12\n" +
+	"\x04teks\x18\x01 \x01(\tR\x04teks\x12\x1f\n" +
+	"\vkolom_benar\x18\x02 \x01(\x05R\n" +
+	"kolomBenar\"r\n" +
+	"\rGridAnswerKey\x12\x18\n" +
+	"\acolumns\x18\x01 \x03(\tR\acolumns\x12!\n" +
+	"\x04rows\x18\x02 \x03(\v2\r.base.GridRowR\x04rows\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\"w\n" +
+	"\x17SubmitGridAnswerRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x18\n" +
+	"\ajawaban\x18\x03 \x03(\x05R\ajawaban\"\xb7\x01\n" +
+	"\x18SubmitGridAnswerResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x18\n" +
+	"\ajawaban\x18\x03 \x03(\x05R\ajawaban\x12=\n" +
+	"\fdijawab_pada\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdijawabPada\"\xc8\x02\n" +
+	"\rQuestionMedia\x12\x19\n" +
+	"\bid_media\x18\x01 \x01(\x05R\aidMedia\x12&\n" +
+	"\x05jenis\x18\x02 \x01(\x0e2\x10.base.MediaJenisR\x05jenis\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12!\n" +
+	"\fdurasi_detik\x18\x04 \x01(\x01R\vdurasiDetik\x12\x1b\n" +
+	"\tmax_putar\x18\x05 \x01(\x05R\bmaxPutar\x12!\n" +
+	"\fjumlah_putar\x18\x06 \x01(\x05R\vjumlahPutar\x12\x1d\n" +
+	"\n" +
+	"sisa_putar\x18\a \x01(\x05R\tsisaPutar\x12\x16\n" +
+	"\x06urutan\x18\b \x01(\x05R\x06urutan\x12\x1e\n" +
+	"\n" +
+	"keterangan\x18\t \x01(\tR\n" +
+	"keterangan\x12\x1d\n" +
+	"\n" +
+	"stream_url\x18\n" +
+	" \x01(\tR\tstreamUrl\"w\n" +
+	"\x16RecordMediaPlayRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x19\n" +
+	"\bid_media\x18\x03 \x01(\x05R\aidMedia\"\x88\x01\n" +
+	"\x17RecordMediaPlayResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12)\n" +
+	"\x05media\x18\x03 \x01(\v2\x13.base.QuestionMediaR\x05media\"\x9b\x01\n" +
+	"\x1bGetMediaStreamSourceRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x1d\n" +
+	"\n" +
+	"nomor_urut\x18\x02 \x01(\x05R\tnomorUrut\x12\x19\n" +
+	"\bid_media\x18\x03 \x01(\x05R\aidMedia\x12\x1d\n" +
+	"\n" +
+	"play_nonce\x18\x04 \x01(\tR\tplayNonce\"v\n" +
+	"\x1cGetMediaStreamSourceResponse\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x01 \x01(\tR\tsourceUrl\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x1a\n" +
+	"\bseekable\x18\x03 \x01(\bR\bseekable\"\x95\x03\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x03 \x01(\tR\tkeyPrefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12\"\n" +
+	"\rlms_school_id\x18\x05 \x01(\x03R\vlmsSchoolId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\x05R\tcreatedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\"\n" +
+	"\rlms_school_id\x18\x03 \x01(\x03R\vlmsSchoolId\x12&\n" +
+	"\x0fexpires_in_days\x18\x04 \x01(\x05R\rexpiresInDays\"O\n" +
+	"\x14CreateApiKeyResponse\x12%\n" +
+	"\aapi_key\x18\x01 \x01(\v2\f.base.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"=\n" +
+	"\x12ListApiKeysRequest\x12'\n" +
+	"\x0finclude_revoked\x18\x01 \x01(\bR\x0eincludeRevoked\">\n" +
+	"\x13ListApiKeysResponse\x12'\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\f.base.ApiKeyR\aapiKeys\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xed\x01\n" +
+	"\rLabCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12!\n" +
+	"\fnama_peserta\x18\x03 \x01(\tR\vnamaPeserta\x12*\n" +
+	"\x11lms_assignment_id\x18\x04 \x01(\x03R\x0flmsAssignmentId\x12\x10\n" +
+	"\x03pin\x18\x05 \x01(\tR\x03pin\x12\x17\n" +
+	"\aqr_code\x18\x06 \x01(\tR\x06qrCode\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x88\x01\n" +
+	"\x1aIssueLabCredentialsRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x05R\auserIds\x12#\n" +
+	"\rvalid_minutes\x18\x03 \x01(\x05R\fvalidMinutes\"T\n" +
+	"\x1bIssueLabCredentialsResponse\x125\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x13.base.LabCredentialR\vcredentials\"d\n" +
+	"\x1bRevokeLabCredentialsRequest\x12*\n" +
+	"\x11lms_assignment_id\x18\x01 \x01(\x03R\x0flmsAssignmentId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x05R\auserIds\"8\n" +
+	"\x1cRevokeLabCredentialsResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"h\n" +
+	"\x0fLabLoginRequest\x12\x17\n" +
+	"\aqr_code\x18\x01 \x01(\tR\x06qrCode\x12*\n" +
+	"\x11lms_assignment_id\x18\x02 \x01(\x03R\x0flmsAssignmentId\x12\x10\n" +
+	"\x03pin\x18\x03 \x01(\tR\x03pin\"\xa8\x01\n" +
+	"\x10LabLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12#\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\x12\x1e\n" +
+	"\x04user\x18\x04 \x01(\v2\n" +
+	".base.UserR\x04user\"\xc6\x03\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x05R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x04 \x01(\tR\tactorRole\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x05 \x01(\x05R\bapiKeyId\x12\x1b\n" +
+	"\tschool_id\x18\x06 \x01(\x03R\bschoolId\x12\x16\n" +
+	"\x06method\x18\a \x01(\tR\x06method\x12#\n" +
+	"\rresource_type\x18\b \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\t \x01(\tR\n" +
+	"resourceId\x12\x16\n" +
+	"\x06before\x18\n" +
+	" \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\v \x01(\tR\x05after\x12\x18\n" +
+	"\arequest\x18\f \x01(\tR\arequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\r \x01(\tR\trequestId\x12\x1b\n" +
+	"\tclient_ip\x18\x0e \x01(\tR\bclientIp\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\tR\x06status\"\xa4\x02\n" +
+	"\x14ListAuditLogsRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\x05R\aactorId\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x03 \x01(\tR\n" +
+	"resourceId\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x127\n" +
+	"\n" +
+	"pagination\x18\a \x01(\v2\x17.base.PaginationRequestR\n" +
+	"pagination\"\x80\x01\n" +
+	"\x15ListAuditLogsResponse\x12-\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x0e.base.AuditLogR\tauditLogs\x128\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x18.base.PaginationResponseR\n" +
+	"pagination*G\n" +
 	"\rJawabanOption\x12\x13\n" +
 	"\x0fJAWABAN_INVALID\x10\x00\x12\x05\n" +
 	"\x01A\x10\x01\x12\x05\n" +
 	"\x01B\x10\x02\x12\x05\n" +
 	"\x01C\x10\x03\x12\x05\n" +
-	"\x01D\x10\x04*}\n" +
+	"\x01D\x10\x04\x12\x05\n" +
+	"\x01E\x10\x05*}\n" +
 	"\n" +
 	"TestStatus\x12\x12\n" +
 	"\x0eSTATUS_INVALID\x10\x00\x12\v\n" +
@@ -9934,17 +20307,34 @@ const file_cbt_proto_rawDesc = "" +
 	"\tSCHEDULED\x10\x04\x12\x17\n" +
 	"\x13GRADING_IN_PROGRESS\x10\x05\x12\n" +
 	"\n" +
-	"\x06GRADED\x10\x06*v\n" +
+	"\x06GRADED\x10\x06*\xac\x01\n" +
 	"\fQuestionType\x12\x19\n" +
 	"\x15QUESTION_TYPE_INVALID\x10\x00\x12\x13\n" +
 	"\x0fMULTIPLE_CHOICE\x10\x01\x12\r\n" +
 	"\tDRAG_DROP\x10\x02\x12\t\n" +
 	"\x05ESSAY\x10\x03\x12\x1c\n" +
-	"\x18MULTIPLE_CHOICES_COMPLEX\x10\x04*A\n" +
+	"\x18MULTIPLE_CHOICES_COMPLEX\x10\x04\x12\x10\n" +
+	"\fSHORT_ANSWER\x10\x05\x12\v\n" +
+	"\aNUMERIC\x10\x06\x12\v\n" +
+	"\aHOTSPOT\x10\a\x12\b\n" +
+	"\x04GRID\x10\b*A\n" +
 	"\fDragDropType\x12\x15\n" +
 	"\x11DRAG_TYPE_INVALID\x10\x00\x12\f\n" +
 	"\bORDERING\x10\x01\x12\f\n" +
-	"\bMATCHING\x10\x02*L\n" +
+	"\bMATCHING\x10\x02*U\n" +
+	"\fHotspotShape\x12\x19\n" +
+	"\x15HOTSPOT_SHAPE_INVALID\x10\x00\x12\x15\n" +
+	"\x11HOTSPOT_RECTANGLE\x10\x01\x12\x13\n" +
+	"\x0fHOTSPOT_POLYGON\x10\x02*G\n" +
+	"\n" +
+	"MediaJenis\x12\x17\n" +
+	"\x13MEDIA_JENIS_INVALID\x10\x00\x12\x0f\n" +
+	"\vMEDIA_AUDIO\x10\x01\x12\x0f\n" +
+	"\vMEDIA_VIDEO\x10\x02*g\n" +
+	"\rContentFormat\x12\x18\n" +
+	"\x14CONTENT_FORMAT_PLAIN\x10\x00\x12!\n" +
+	"\x1dCONTENT_FORMAT_MARKDOWN_LATEX\x10\x01\x12\x19\n" +
+	"\x15CONTENT_FORMAT_MATHML\x10\x02*L\n" +
 	"\x15QuestionSelectionMode\x12\x1a\n" +
 	"\x16SELECTION_MODE_INVALID\x10\x00\x12\n" +
 	"\n" +
@@ -9958,13 +20348,17 @@ const file_cbt_proto_rawDesc = "" +
 	"\n" +
 	"SUPERADMIN\x10\x042L\n" +
 	"\x04Base\x12D\n" +
-	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x1b.base.MessageStatusResponse\"\x002I\n" +
+	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x1b.base.MessageStatusResponse\"\x002\xd5\x02\n" +
 	"\vAuthService\x12:\n" +
 	"\n" +
-	"GetProfile\x12\x16.google.protobuf.Empty\x1a\x12.base.UserResponse\"\x002\xb8\x01\n" +
+	"GetProfile\x12\x16.google.protobuf.Empty\x1a\x12.base.UserResponse\"\x00\x122\n" +
+	"\x05Login\x12\x12.base.LoginRequest\x1a\x13.base.LoginResponse\"\x00\x12G\n" +
+	"\fRefreshToken\x12\x19.base.RefreshTokenRequest\x1a\x1a.base.RefreshTokenResponse\"\x00\x12?\n" +
+	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x1b.base.MessageStatusResponse\"\x00\x12L\n" +
+	"\x0eChangePassword\x12\x1b.base.ChangePasswordRequest\x1a\x1b.base.MessageStatusResponse\"\x002\xb8\x01\n" +
 	"\x14MataPelajaranService\x12P\n" +
 	"\x10GetMataPelajaran\x12\x1d.base.GetMataPelajaranRequest\x1a\x1b.base.MataPelajaranResponse\"\x00\x12N\n" +
-	"\x11ListMataPelajaran\x12\x16.google.protobuf.Empty\x1a\x1f.base.ListMataPelajaranResponse\"\x002\x87\x04\n" +
+	"\x11ListMataPelajaran\x12\x16.google.protobuf.Empty\x1a\x1f.base.ListMataPelajaranResponse\"\x002\xf6\x05\n" +
 	"\rMateriService\x12A\n" +
 	"\fCreateMateri\x12\x19.base.CreateMateriRequest\x1a\x14.base.MateriResponse\"\x00\x12U\n" +
 	"\x16CreateMateriSuperadmin\x12#.base.CreateMateriSuperadminRequest\x1a\x14.base.MateriResponse\"\x00\x12O\n" +
@@ -9973,11 +20367,14 @@ const file_cbt_proto_rawDesc = "" +
 	"\fUpdateMateri\x12\x19.base.UpdateMateriRequest\x1a\x14.base.MateriResponse\"\x00\x12H\n" +
 	"\fDeleteMateri\x12\x19.base.DeleteMateriRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12A\n" +
 	"\n" +
-	"ListMateri\x12\x17.base.ListMateriRequest\x1a\x18.base.ListMateriResponse\"\x002\x94\x01\n" +
+	"ListMateri\x12\x17.base.ListMateriRequest\x1a\x18.base.ListMateriResponse\"\x00\x12D\n" +
+	"\vShareMateri\x12\x18.base.ShareMateriRequest\x1a\x19.base.ShareMateriResponse\"\x00\x12R\n" +
+	"\x11RevokeMateriShare\x12\x1e.base.RevokeMateriShareRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12S\n" +
+	"\x10ListMateriShares\x12\x1d.base.ListMateriSharesRequest\x1a\x1e.base.ListMateriSharesResponse\"\x002\x94\x01\n" +
 	"\x0eTingkatService\x12>\n" +
 	"\n" +
 	"GetTingkat\x12\x17.base.GetTingkatRequest\x1a\x15.base.TingkatResponse\"\x00\x12B\n" +
-	"\vListTingkat\x12\x16.google.protobuf.Empty\x1a\x19.base.ListTingkatResponse\"\x002\xdb\x05\n" +
+	"\vListTingkat\x12\x16.google.protobuf.Empty\x1a\x19.base.ListTingkatResponse\"\x002\xd9\a\n" +
 	"\vSoalService\x12;\n" +
 	"\n" +
 	"CreateSoal\x12\x17.base.CreateSoalRequest\x1a\x12.base.SoalResponse\"\x00\x125\n" +
@@ -9989,7 +20386,10 @@ const file_cbt_proto_rawDesc = "" +
 	"\bListSoal\x12\x15.base.ListSoalRequest\x1a\x16.base.ListSoalResponse\"\x00\x12P\n" +
 	"\x11UploadImageToSoal\x12\x1e.base.UploadImageToSoalRequest\x1a\x19.base.UploadImageResponse\"\x00\x12V\n" +
 	"\x13DeleteImageFromSoal\x12 .base.DeleteImageFromSoalRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12R\n" +
-	"\x11UpdateImageInSoal\x12\x1e.base.UpdateImageInSoalRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12R\n" +
+	"\x11UpdateImageInSoal\x12\x1e.base.UpdateImageInSoalRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12P\n" +
+	"\x11UploadMediaToSoal\x12\x1e.base.UploadMediaToSoalRequest\x1a\x19.base.UploadMediaResponse\"\x00\x12V\n" +
+	"\x13DeleteMediaFromSoal\x12 .base.DeleteMediaFromSoalRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12R\n" +
+	"\x11UpdateMediaInSoal\x12\x1e.base.UpdateMediaInSoalRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12R\n" +
 	"\x18GetQuestionCountsByTopic\x12\x16.google.protobuf.Empty\x1a\x1c.base.QuestionCountsResponse\"\x00\x12F\n" +
 	"\vReorderSoal\x12\x18.base.ReorderSoalRequest\x1a\x1b.base.MessageStatusResponse\"\x002\x91\x04\n" +
 	"\x13SoalDragDropService\x12S\n" +
@@ -9998,33 +20398,83 @@ const file_cbt_proto_rawDesc = "" +
 	"\x12UpdateSoalDragDrop\x12\x1f.base.UpdateSoalDragDropRequest\x1a\x1a.base.SoalDragDropResponse\"\x00\x12T\n" +
 	"\x12DeleteSoalDragDrop\x12\x1f.base.DeleteSoalDragDropRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12S\n" +
 	"\x10ListSoalDragDrop\x12\x1d.base.ListSoalDragDropRequest\x1a\x1e.base.ListSoalDragDropResponse\"\x00\x12V\n" +
-	"\x13ReorderSoalDragDrop\x12 .base.ReorderSoalDragDropRequest\x1a\x1b.base.MessageStatusResponse\"\x002\xa8\t\n" +
+	"\x13ReorderSoalDragDrop\x12 .base.ReorderSoalDragDropRequest\x1a\x1b.base.MessageStatusResponse\"\x002\xc4\r\n" +
 	"\x12TestSessionService\x12P\n" +
 	"\x11CreateTestSession\x12\x1e.base.CreateTestSessionRequest\x1a\x19.base.TestSessionResponse\"\x00\x12J\n" +
 	"\x0eGetTestSession\x12\x1b.base.GetTestSessionRequest\x1a\x19.base.TestSessionResponse\"\x00\x12P\n" +
 	"\x10GetTestQuestions\x12\x1d.base.GetTestQuestionsRequest\x1a\x1b.base.TestQuestionsResponse\"\x00\x12G\n" +
 	"\fSubmitAnswer\x12\x19.base.SubmitAnswerRequest\x1a\x1a.base.SubmitAnswerResponse\"\x00\x12\\\n" +
-	"\x13SubmitComplexAnswer\x12 .base.SubmitComplexAnswerRequest\x1a!.base.SubmitComplexAnswerResponse\"\x00\x12_\n" +
+	"\x13SubmitComplexAnswer\x12 .base.SubmitComplexAnswerRequest\x1a!.base.SubmitComplexAnswerResponse\"\x00\x12V\n" +
+	"\x11SubmitShortAnswer\x12\x1e.base.SubmitShortAnswerRequest\x1a\x1f.base.SubmitShortAnswerResponse\"\x00\x12\\\n" +
+	"\x13SubmitNumericAnswer\x12 .base.SubmitNumericAnswerRequest\x1a!.base.SubmitNumericAnswerResponse\"\x00\x12\\\n" +
+	"\x13SubmitHotspotAnswer\x12 .base.SubmitHotspotAnswerRequest\x1a!.base.SubmitHotspotAnswerResponse\"\x00\x12S\n" +
+	"\x10SubmitGridAnswer\x12\x1d.base.SubmitGridAnswerRequest\x1a\x1e.base.SubmitGridAnswerResponse\"\x00\x12_\n" +
 	"\x14SubmitDragDropAnswer\x12!.base.SubmitDragDropAnswerRequest\x1a\".base.SubmitDragDropAnswerResponse\"\x00\x12V\n" +
 	"\x11SubmitEssayAnswer\x12\x1e.base.SubmitEssayAnswerRequest\x1a\x1f.base.SubmitEssayAnswerResponse\"\x00\x12D\n" +
 	"\vClearAnswer\x12\x18.base.ClearAnswerRequest\x1a\x19.base.ClearAnswerResponse\"\x00\x12L\n" +
-	"\x0fCompleteSession\x12\x1c.base.CompleteSessionRequest\x1a\x19.base.TestSessionResponse\"\x00\x12G\n" +
+	"\x0fCompleteSession\x12\x1c.base.CompleteSessionRequest\x1a\x19.base.TestSessionResponse\"\x00\x12P\n" +
+	"\x0fRecordMediaPlay\x12\x1c.base.RecordMediaPlayRequest\x1a\x1d.base.RecordMediaPlayResponse\"\x00\x12_\n" +
+	"\x14GetMediaStreamSource\x12!.base.GetMediaStreamSourceRequest\x1a\".base.GetMediaStreamSourceResponse\"\x00\x12G\n" +
 	"\rGetTestResult\x12\x1a.base.GetTestResultRequest\x1a\x18.base.TestResultResponse\"\x00\x12S\n" +
 	"\x10GradeEssayAnswer\x12\x1d.base.GradeEssayAnswerRequest\x1a\x1e.base.GradeEssayAnswerResponse\"\x00\x12a\n" +
 	"\x17ListMyScheduledSessions\x12$.base.ListMyScheduledSessionsRequest\x1a\x1e.base.ListTestSessionsResponse\"\x00\x12X\n" +
 	"\x15StartScheduledSession\x12\".base.StartScheduledSessionRequest\x1a\x19.base.TestSessionResponse\"\x00\x12S\n" +
-	"\x10ListTestSessions\x12\x1d.base.ListTestSessionsRequest\x1a\x1e.base.ListTestSessionsResponse\"\x002\xb4\x01\n" +
+	"\x10ListTestSessions\x12\x1d.base.ListTestSessionsRequest\x1a\x1e.base.ListTestSessionsResponse\"\x002\x95\x02\n" +
 	"\x0eHistoryService\x12P\n" +
 	"\x11GetStudentHistory\x12\x1b.base.StudentHistoryRequest\x1a\x1c.base.StudentHistoryResponse\"\x00\x12P\n" +
-	"\x10GetHistoryDetail\x12\x1d.base.GetHistoryDetailRequest\x1a\x1b.base.HistoryDetailResponse\"\x002\xdf\x02\n" +
+	"\x10GetHistoryDetail\x12\x1d.base.GetHistoryDetailRequest\x1a\x1b.base.HistoryDetailResponse\"\x00\x12_\n" +
+	"\x14ListStudentHistories\x12!.base.ListStudentHistoriesRequest\x1a\".base.ListStudentHistoriesResponse\"\x002\xa5\x06\n" +
 	"\x10UserLimitService\x12J\n" +
 	"\rGetUserLimits\x12\x1a.base.GetUserLimitsRequest\x1a\x1b.base.GetUserLimitsResponse\"\x00\x12D\n" +
 	"\fSetUserLimit\x12\x19.base.SetUserLimitRequest\x1a\x17.base.UserLimitResponse\"\x00\x12L\n" +
 	"\x0eResetUserLimit\x12\x1b.base.ResetUserLimitRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12k\n" +
-	"\x18GetUserLimitUsageHistory\x12%.base.GetUserLimitUsageHistoryRequest\x1a&.base.GetUserLimitUsageHistoryResponse\"\x002\xb0\x01\n" +
+	"\x18GetUserLimitUsageHistory\x12%.base.GetUserLimitUsageHistoryRequest\x1a&.base.GetUserLimitUsageHistoryResponse\"\x00\x12b\n" +
+	"\x15ListRateLimitPolicies\x12\".base.ListRateLimitPoliciesRequest\x1a#.base.ListRateLimitPoliciesResponse\"\x00\x12X\n" +
+	"\x13SaveRateLimitPolicy\x12 .base.SaveRateLimitPolicyRequest\x1a\x1d.base.RateLimitPolicyResponse\"\x00\x12Z\n" +
+	"\x15DeleteRateLimitPolicy\x12\".base.DeleteRateLimitPolicyRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12^\n" +
+	"\x17SetRateLimitMethodGroup\x12$.base.SetRateLimitMethodGroupRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12J\n" +
+	"\rSetSchoolPlan\x12\x1a.base.SetSchoolPlanRequest\x1a\x1b.base.MessageStatusResponse\"\x002\xb0\x01\n" +
 	"\x10ClassSyncService\x12D\n" +
 	"\vListClasses\x12\x18.base.ListClassesRequest\x1a\x19.base.ListClassesResponse\"\x00\x12V\n" +
-	"\x11ListClassStudents\x12\x1e.base.ListClassStudentsRequest\x1a\x1f.base.ListClassStudentsResponse\"\x00B&Z$cbt-test-mini-project/gen/proto/baseb\x06proto3"
+	"\x11ListClassStudents\x12\x1e.base.ListClassStudentsRequest\x1a\x1f.base.ListClassStudentsResponse\"\x002\xf9\x06\n" +
+	"\x13ExamSecurityService\x12J\n" +
+	"\x0fUploadSebConfig\x12\x1c.base.UploadSebConfigRequest\x1a\x17.base.SebConfigResponse\"\x00\x12D\n" +
+	"\fGetSebConfig\x12\x19.base.GetSebConfigRequest\x1a\x17.base.SebConfigResponse\"\x00\x12N\n" +
+	"\x0fDeleteSebConfig\x12\x1c.base.DeleteSebConfigRequest\x1a\x1b.base.MessageStatusResponse\"\x00\x12S\n" +
+	"\x10ListDeviceLeases\x12\x1d.base.ListDeviceLeasesRequest\x1a\x1e.base.ListDeviceLeasesResponse\"\x00\x12X\n" +
+	"\x15ApproveDeviceTransfer\x12\".base.ApproveDeviceTransferRequest\x1a\x19.base.DeviceLeaseResponse\"\x00\x12Y\n" +
+	"\x13SetNetworkAllowlist\x12 .base.SetNetworkAllowlistRequest\x1a\x1e.base.NetworkAllowlistResponse\"\x00\x12Y\n" +
+	"\x13GetNetworkAllowlist\x12 .base.GetNetworkAllowlistRequest\x1a\x1e.base.NetworkAllowlistResponse\"\x00\x12Z\n" +
+	"\x14GrantNetworkOverride\x12!.base.GrantNetworkOverrideRequest\x1a\x1d.base.NetworkOverrideResponse\"\x00\x12k\n" +
+	"\x18ListNetworkAccessDenials\x12%.base.ListNetworkAccessDenialsRequest\x1a&.base.ListNetworkAccessDenialsResponse\"\x00\x12R\n" +
+	"\x10AnalyzeCollusion\x12\x1d.base.AnalyzeCollusionRequest\x1a\x1d.base.CollusionReportResponse\"\x002\x99\n" +
+	"\n" +
+	"\x0eGradingService\x12c\n" +
+	"\x17RunEssaySimilarityCheck\x12$.base.RunEssaySimilarityCheckRequest\x1a .base.EssaySimilarityRunResponse\"\x00\x12Y\n" +
+	"\x13GetEssayGradingView\x12 .base.GetEssayGradingViewRequest\x1a\x1e.base.EssayGradingViewResponse\"\x00\x12J\n" +
+	"\x0eSetEssayRubric\x12\x1b.base.SetEssayRubricRequest\x1a\x19.base.EssayRubricResponse\"\x00\x12J\n" +
+	"\x0eGetEssayRubric\x12\x1b.base.GetEssayRubricRequest\x1a\x19.base.EssayRubricResponse\"\x00\x12P\n" +
+	"\x10SetGradingConfig\x12\x1d.base.SetGradingConfigRequest\x1a\x1b.base.GradingConfigResponse\"\x00\x12P\n" +
+	"\x10GetGradingConfig\x12\x1d.base.GetGradingConfigRequest\x1a\x1b.base.GradingConfigResponse\"\x00\x12V\n" +
+	"\x11ListPendingEssays\x12\x1e.base.ListPendingEssaysRequest\x1a\x1f.base.ListPendingEssaysResponse\"\x00\x12J\n" +
+	"\rAssignGraders\x12\x1a.base.AssignGradersRequest\x1a\x1b.base.AssignGradersResponse\"\x00\x12J\n" +
+	"\x0fSubmitEssayMark\x12\x1c.base.SubmitEssayMarkRequest\x1a\x17.base.EssayMarkResponse\"\x00\x12N\n" +
+	"\x11ResolveModeration\x12\x1e.base.ResolveModerationRequest\x1a\x17.base.EssayMarkResponse\"\x00\x12V\n" +
+	"\x12GetGradingProgress\x12\x1f.base.GetGradingProgressRequest\x1a\x1d.base.GradingProgressResponse\"\x00\x12P\n" +
+	"\x10SetEssayKeywords\x12\x1d.base.SetEssayKeywordsRequest\x1a\x1b.base.EssayKeywordsResponse\"\x00\x12P\n" +
+	"\x10GetEssayKeywords\x12\x1d.base.GetEssayKeywordsRequest\x1a\x1b.base.EssayKeywordsResponse\"\x00\x12k\n" +
+	"\x18GenerateScoreSuggestions\x12%.base.GenerateScoreSuggestionsRequest\x1a&.base.GenerateScoreSuggestionsResponse\"\x00\x12b\n" +
+	"\x16GetSuggestionAgreement\x12#.base.GetSuggestionAgreementRequest\x1a!.base.SuggestionAgreementResponse\"\x002\xe8\x01\n" +
+	"\rApiKeyService\x12G\n" +
+	"\fCreateApiKey\x12\x19.base.CreateApiKeyRequest\x1a\x1a.base.CreateApiKeyResponse\"\x00\x12D\n" +
+	"\vListApiKeys\x12\x18.base.ListApiKeysRequest\x1a\x19.base.ListApiKeysResponse\"\x00\x12H\n" +
+	"\fRevokeApiKey\x12\x19.base.RevokeApiKeyRequest\x1a\x1b.base.MessageStatusResponse\"\x002\x8d\x02\n" +
+	"\x0fLabLoginService\x12\\\n" +
+	"\x13IssueLabCredentials\x12 .base.IssueLabCredentialsRequest\x1a!.base.IssueLabCredentialsResponse\"\x00\x12_\n" +
+	"\x14RevokeLabCredentials\x12!.base.RevokeLabCredentialsRequest\x1a\".base.RevokeLabCredentialsResponse\"\x00\x12;\n" +
+	"\bLabLogin\x12\x15.base.LabLoginRequest\x1a\x16.base.LabLoginResponse\"\x002]\n" +
+	"\x0fAuditLogService\x12J\n" +
+	"\rListAuditLogs\x12\x1a.base.ListAuditLogsRequest\x1a\x1b.base.ListAuditLogsResponse\"\x00B&Z$cbt-test-mini-project/gen/proto/baseb\x06proto3"
 
 var (
 	file_cbt_proto_rawDescOnce sync.Once
@@ -10038,406 +20488,780 @@ func file_cbt_proto_rawDescGZIP() []byte {
 	return file_cbt_proto_rawDescData
 }
 
-var file_cbt_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_cbt_proto_msgTypes = make([]protoimpl.MessageInfo, 129)
+var file_cbt_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_cbt_proto_msgTypes = make([]protoimpl.MessageInfo, 253)
 var file_cbt_proto_goTypes = []any{
 	(JawabanOption)(0),                       // 0: base.JawabanOption
 	(TestStatus)(0),                          // 1: base.TestStatus
 	(QuestionType)(0),                        // 2: base.QuestionType
 	(DragDropType)(0),                        // 3: base.DragDropType
-	(QuestionSelectionMode)(0),               // 4: base.QuestionSelectionMode
-	(UserRole)(0),                            // 5: base.UserRole
-	(*MessageStatusResponse)(nil),            // 6: base.MessageStatusResponse
-	(*PaginationRequest)(nil),                // 7: base.PaginationRequest
-	(*PaginationResponse)(nil),               // 8: base.PaginationResponse
-	(*User)(nil),                             // 9: base.User
-	(*LoginRequest)(nil),                     // 10: base.LoginRequest
-	(*LoginResponse)(nil),                    // 11: base.LoginResponse
-	(*UserResponse)(nil),                     // 12: base.UserResponse
-	(*ListUsersRequest)(nil),                 // 13: base.ListUsersRequest
-	(*ListUsersResponse)(nil),                // 14: base.ListUsersResponse
-	(*GetUserRequest)(nil),                   // 15: base.GetUserRequest
-	(*CreateUserRequest)(nil),                // 16: base.CreateUserRequest
-	(*UpdateUserRequest)(nil),                // 17: base.UpdateUserRequest
-	(*DeleteUserRequest)(nil),                // 18: base.DeleteUserRequest
-	(*RefreshTokenRequest)(nil),              // 19: base.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),             // 20: base.RefreshTokenResponse
-	(*UserLimit)(nil),                        // 21: base.UserLimit
-	(*UserLimitUsage)(nil),                   // 22: base.UserLimitUsage
-	(*GetUserLimitsRequest)(nil),             // 23: base.GetUserLimitsRequest
-	(*GetUserLimitsResponse)(nil),            // 24: base.GetUserLimitsResponse
-	(*SetUserLimitRequest)(nil),              // 25: base.SetUserLimitRequest
-	(*ResetUserLimitRequest)(nil),            // 26: base.ResetUserLimitRequest
-	(*UserLimitResponse)(nil),                // 27: base.UserLimitResponse
-	(*GetUserLimitUsageHistoryRequest)(nil),  // 28: base.GetUserLimitUsageHistoryRequest
-	(*GetUserLimitUsageHistoryResponse)(nil), // 29: base.GetUserLimitUsageHistoryResponse
-	(*MataPelajaran)(nil),                    // 30: base.MataPelajaran
-	(*CreateMataPelajaranRequest)(nil),       // 31: base.CreateMataPelajaranRequest
-	(*GetMataPelajaranRequest)(nil),          // 32: base.GetMataPelajaranRequest
-	(*UpdateMataPelajaranRequest)(nil),       // 33: base.UpdateMataPelajaranRequest
-	(*DeleteMataPelajaranRequest)(nil),       // 34: base.DeleteMataPelajaranRequest
-	(*MataPelajaranResponse)(nil),            // 35: base.MataPelajaranResponse
-	(*ListMataPelajaranResponse)(nil),        // 36: base.ListMataPelajaranResponse
-	(*Materi)(nil),                           // 37: base.Materi
-	(*CreateMateriRequest)(nil),              // 38: base.CreateMateriRequest
-	(*CreateMateriSuperadminRequest)(nil),    // 39: base.CreateMateriSuperadminRequest
-	(*CreateMateriTeacherRequest)(nil),       // 40: base.CreateMateriTeacherRequest
-	(*GetMateriRequest)(nil),                 // 41: base.GetMateriRequest
-	(*UpdateMateriRequest)(nil),              // 42: base.UpdateMateriRequest
-	(*DeleteMateriRequest)(nil),              // 43: base.DeleteMateriRequest
-	(*MateriResponse)(nil),                   // 44: base.MateriResponse
-	(*ListMateriRequest)(nil),                // 45: base.ListMateriRequest
-	(*ListMateriResponse)(nil),               // 46: base.ListMateriResponse
-	(*Tingkat)(nil),                          // 47: base.Tingkat
-	(*CreateTingkatRequest)(nil),             // 48: base.CreateTingkatRequest
-	(*GetTingkatRequest)(nil),                // 49: base.GetTingkatRequest
-	(*UpdateTingkatRequest)(nil),             // 50: base.UpdateTingkatRequest
-	(*DeleteTingkatRequest)(nil),             // 51: base.DeleteTingkatRequest
-	(*TingkatResponse)(nil),                  // 52: base.TingkatResponse
-	(*ListTingkatResponse)(nil),              // 53: base.ListTingkatResponse
-	(*SoalGambar)(nil),                       // 54: base.SoalGambar
-	(*SoalFull)(nil),                         // 55: base.SoalFull
-	(*SoalForStudent)(nil),                   // 56: base.SoalForStudent
-	(*CreateSoalRequest)(nil),                // 57: base.CreateSoalRequest
-	(*GetSoalRequest)(nil),                   // 58: base.GetSoalRequest
-	(*UpdateSoalRequest)(nil),                // 59: base.UpdateSoalRequest
-	(*SoalOrderItem)(nil),                    // 60: base.SoalOrderItem
-	(*ReorderSoalRequest)(nil),               // 61: base.ReorderSoalRequest
-	(*DeleteSoalRequest)(nil),                // 62: base.DeleteSoalRequest
-	(*SoalResponse)(nil),                     // 63: base.SoalResponse
-	(*ListSoalRequest)(nil),                  // 64: base.ListSoalRequest
-	(*ListSoalResponse)(nil),                 // 65: base.ListSoalResponse
-	(*UploadImageToSoalRequest)(nil),         // 66: base.UploadImageToSoalRequest
-	(*UploadImageResponse)(nil),              // 67: base.UploadImageResponse
-	(*DeleteImageFromSoalRequest)(nil),       // 68: base.DeleteImageFromSoalRequest
-	(*UpdateImageInSoalRequest)(nil),         // 69: base.UpdateImageInSoalRequest
-	(*DragItem)(nil),                         // 70: base.DragItem
-	(*DragSlot)(nil),                         // 71: base.DragSlot
-	(*DragCorrectAnswer)(nil),                // 72: base.DragCorrectAnswer
-	(*DragCorrectAnswerByUrutan)(nil),        // 73: base.DragCorrectAnswerByUrutan
-	(*SoalDragDropFull)(nil),                 // 74: base.SoalDragDropFull
-	(*SoalDragDropForStudent)(nil),           // 75: base.SoalDragDropForStudent
-	(*QuestionForStudent)(nil),               // 76: base.QuestionForStudent
-	(*CreateSoalDragDropRequest)(nil),        // 77: base.CreateSoalDragDropRequest
-	(*GetSoalDragDropRequest)(nil),           // 78: base.GetSoalDragDropRequest
-	(*UpdateSoalDragDropRequest)(nil),        // 79: base.UpdateSoalDragDropRequest
-	(*SoalDragDropOrderItem)(nil),            // 80: base.SoalDragDropOrderItem
-	(*ReorderSoalDragDropRequest)(nil),       // 81: base.ReorderSoalDragDropRequest
-	(*DeleteSoalDragDropRequest)(nil),        // 82: base.DeleteSoalDragDropRequest
-	(*SoalDragDropResponse)(nil),             // 83: base.SoalDragDropResponse
-	(*ListSoalDragDropRequest)(nil),          // 84: base.ListSoalDragDropRequest
-	(*ListSoalDragDropResponse)(nil),         // 85: base.ListSoalDragDropResponse
-	(*TestSession)(nil),                      // 86: base.TestSession
-	(*CreateTestSessionRequest)(nil),         // 87: base.CreateTestSessionRequest
-	(*GetTestSessionRequest)(nil),            // 88: base.GetTestSessionRequest
-	(*TestSessionResponse)(nil),              // 89: base.TestSessionResponse
-	(*ListTestSessionsRequest)(nil),          // 90: base.ListTestSessionsRequest
-	(*ListTestSessionsResponse)(nil),         // 91: base.ListTestSessionsResponse
-	(*GetTestQuestionsRequest)(nil),          // 92: base.GetTestQuestionsRequest
-	(*TestQuestionsResponse)(nil),            // 93: base.TestQuestionsResponse
-	(*SubmitAnswerRequest)(nil),              // 94: base.SubmitAnswerRequest
-	(*SubmitAnswerResponse)(nil),             // 95: base.SubmitAnswerResponse
-	(*SubmitComplexAnswerRequest)(nil),       // 96: base.SubmitComplexAnswerRequest
-	(*SubmitComplexAnswerResponse)(nil),      // 97: base.SubmitComplexAnswerResponse
-	(*SubmitDragDropAnswerRequest)(nil),      // 98: base.SubmitDragDropAnswerRequest
-	(*SubmitDragDropAnswerResponse)(nil),     // 99: base.SubmitDragDropAnswerResponse
-	(*SubmitEssayAnswerRequest)(nil),         // 100: base.SubmitEssayAnswerRequest
-	(*SubmitEssayAnswerResponse)(nil),        // 101: base.SubmitEssayAnswerResponse
-	(*ClearAnswerRequest)(nil),               // 102: base.ClearAnswerRequest
-	(*ClearAnswerResponse)(nil),              // 103: base.ClearAnswerResponse
-	(*CompleteSessionRequest)(nil),           // 104: base.CompleteSessionRequest
-	(*GetTestResultRequest)(nil),             // 105: base.GetTestResultRequest
-	(*JawabanDetail)(nil),                    // 106: base.JawabanDetail
-	(*GradeEssayAnswerRequest)(nil),          // 107: base.GradeEssayAnswerRequest
-	(*GradeEssayAnswerResponse)(nil),         // 108: base.GradeEssayAnswerResponse
-	(*TestResultResponse)(nil),               // 109: base.TestResultResponse
-	(*StudentHistoryRequest)(nil),            // 110: base.StudentHistoryRequest
-	(*HistorySummary)(nil),                   // 111: base.HistorySummary
-	(*StudentHistoryResponse)(nil),           // 112: base.StudentHistoryResponse
-	(*ListStudentHistoriesRequest)(nil),      // 113: base.ListStudentHistoriesRequest
-	(*ListStudentHistoriesResponse)(nil),     // 114: base.ListStudentHistoriesResponse
-	(*StudentHistoryWithUser)(nil),           // 115: base.StudentHistoryWithUser
-	(*GetHistoryDetailRequest)(nil),          // 116: base.GetHistoryDetailRequest
-	(*HistoryDetailResponse)(nil),            // 117: base.HistoryDetailResponse
-	(*MateriBreakdown)(nil),                  // 118: base.MateriBreakdown
-	(*QuestionCountsResponse)(nil),           // 119: base.QuestionCountsResponse
-	(*TopicCount)(nil),                       // 120: base.TopicCount
-	(*ListMyScheduledSessionsRequest)(nil),   // 121: base.ListMyScheduledSessionsRequest
-	(*StartScheduledSessionRequest)(nil),     // 122: base.StartScheduledSessionRequest
-	(*ClassData)(nil),                        // 123: base.ClassData
-	(*ListClassesRequest)(nil),               // 124: base.ListClassesRequest
-	(*ListClassesResponse)(nil),              // 125: base.ListClassesResponse
-	(*ClassStudentData)(nil),                 // 126: base.ClassStudentData
-	(*ListClassStudentsRequest)(nil),         // 127: base.ListClassStudentsRequest
-	(*ListClassStudentsResponse)(nil),        // 128: base.ListClassStudentsResponse
-	nil,                                      // 129: base.SoalDragDropForStudent.UserAnswerEntry
-	nil,                                      // 130: base.QuestionForStudent.DdUserAnswerEntry
-	nil,                                      // 131: base.SubmitDragDropAnswerRequest.AnswerEntry
-	nil,                                      // 132: base.SubmitDragDropAnswerResponse.AnswerEntry
-	nil,                                      // 133: base.JawabanDetail.UserDragAnswerEntry
-	nil,                                      // 134: base.JawabanDetail.CorrectDragAnswerEntry
-	(*timestamppb.Timestamp)(nil),            // 135: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 136: google.protobuf.Empty
+	(HotspotShape)(0),                        // 4: base.HotspotShape
+	(MediaJenis)(0),                          // 5: base.MediaJenis
+	(ContentFormat)(0),                       // 6: base.ContentFormat
+	(QuestionSelectionMode)(0),               // 7: base.QuestionSelectionMode
+	(UserRole)(0),                            // 8: base.UserRole
+	(*MessageStatusResponse)(nil),            // 9: base.MessageStatusResponse
+	(*PaginationRequest)(nil),                // 10: base.PaginationRequest
+	(*PaginationResponse)(nil),               // 11: base.PaginationResponse
+	(*User)(nil),                             // 12: base.User
+	(*LoginRequest)(nil),                     // 13: base.LoginRequest
+	(*LoginResponse)(nil),                    // 14: base.LoginResponse
+	(*UserResponse)(nil),                     // 15: base.UserResponse
+	(*ListUsersRequest)(nil),                 // 16: base.ListUsersRequest
+	(*ListUsersResponse)(nil),                // 17: base.ListUsersResponse
+	(*GetUserRequest)(nil),                   // 18: base.GetUserRequest
+	(*CreateUserRequest)(nil),                // 19: base.CreateUserRequest
+	(*UpdateUserRequest)(nil),                // 20: base.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                // 21: base.DeleteUserRequest
+	(*RefreshTokenRequest)(nil),              // 22: base.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),             // 23: base.RefreshTokenResponse
+	(*ChangePasswordRequest)(nil),            // 24: base.ChangePasswordRequest
+	(*UserLimit)(nil),                        // 25: base.UserLimit
+	(*UserLimitUsage)(nil),                   // 26: base.UserLimitUsage
+	(*GetUserLimitsRequest)(nil),             // 27: base.GetUserLimitsRequest
+	(*GetUserLimitsResponse)(nil),            // 28: base.GetUserLimitsResponse
+	(*SetUserLimitRequest)(nil),              // 29: base.SetUserLimitRequest
+	(*ResetUserLimitRequest)(nil),            // 30: base.ResetUserLimitRequest
+	(*UserLimitResponse)(nil),                // 31: base.UserLimitResponse
+	(*GetUserLimitUsageHistoryRequest)(nil),  // 32: base.GetUserLimitUsageHistoryRequest
+	(*GetUserLimitUsageHistoryResponse)(nil), // 33: base.GetUserLimitUsageHistoryResponse
+	(*RateLimitPolicy)(nil),                  // 34: base.RateLimitPolicy
+	(*RateLimitMethodGroup)(nil),             // 35: base.RateLimitMethodGroup
+	(*SchoolPlan)(nil),                       // 36: base.SchoolPlan
+	(*ListRateLimitPoliciesRequest)(nil),     // 37: base.ListRateLimitPoliciesRequest
+	(*ListRateLimitPoliciesResponse)(nil),    // 38: base.ListRateLimitPoliciesResponse
+	(*SaveRateLimitPolicyRequest)(nil),       // 39: base.SaveRateLimitPolicyRequest
+	(*RateLimitPolicyResponse)(nil),          // 40: base.RateLimitPolicyResponse
+	(*DeleteRateLimitPolicyRequest)(nil),     // 41: base.DeleteRateLimitPolicyRequest
+	(*SetRateLimitMethodGroupRequest)(nil),   // 42: base.SetRateLimitMethodGroupRequest
+	(*SetSchoolPlanRequest)(nil),             // 43: base.SetSchoolPlanRequest
+	(*MataPelajaran)(nil),                    // 44: base.MataPelajaran
+	(*CreateMataPelajaranRequest)(nil),       // 45: base.CreateMataPelajaranRequest
+	(*GetMataPelajaranRequest)(nil),          // 46: base.GetMataPelajaranRequest
+	(*UpdateMataPelajaranRequest)(nil),       // 47: base.UpdateMataPelajaranRequest
+	(*DeleteMataPelajaranRequest)(nil),       // 48: base.DeleteMataPelajaranRequest
+	(*MataPelajaranResponse)(nil),            // 49: base.MataPelajaranResponse
+	(*ListMataPelajaranResponse)(nil),        // 50: base.ListMataPelajaranResponse
+	(*Materi)(nil),                           // 51: base.Materi
+	(*CreateMateriRequest)(nil),              // 52: base.CreateMateriRequest
+	(*CreateMateriSuperadminRequest)(nil),    // 53: base.CreateMateriSuperadminRequest
+	(*CreateMateriTeacherRequest)(nil),       // 54: base.CreateMateriTeacherRequest
+	(*GetMateriRequest)(nil),                 // 55: base.GetMateriRequest
+	(*UpdateMateriRequest)(nil),              // 56: base.UpdateMateriRequest
+	(*DeleteMateriRequest)(nil),              // 57: base.DeleteMateriRequest
+	(*MateriResponse)(nil),                   // 58: base.MateriResponse
+	(*ListMateriRequest)(nil),                // 59: base.ListMateriRequest
+	(*ListMateriResponse)(nil),               // 60: base.ListMateriResponse
+	(*MateriShare)(nil),                      // 61: base.MateriShare
+	(*ShareMateriRequest)(nil),               // 62: base.ShareMateriRequest
+	(*ShareMateriResponse)(nil),              // 63: base.ShareMateriResponse
+	(*RevokeMateriShareRequest)(nil),         // 64: base.RevokeMateriShareRequest
+	(*ListMateriSharesRequest)(nil),          // 65: base.ListMateriSharesRequest
+	(*ListMateriSharesResponse)(nil),         // 66: base.ListMateriSharesResponse
+	(*Tingkat)(nil),                          // 67: base.Tingkat
+	(*CreateTingkatRequest)(nil),             // 68: base.CreateTingkatRequest
+	(*GetTingkatRequest)(nil),                // 69: base.GetTingkatRequest
+	(*UpdateTingkatRequest)(nil),             // 70: base.UpdateTingkatRequest
+	(*DeleteTingkatRequest)(nil),             // 71: base.DeleteTingkatRequest
+	(*TingkatResponse)(nil),                  // 72: base.TingkatResponse
+	(*ListTingkatResponse)(nil),              // 73: base.ListTingkatResponse
+	(*SoalGambar)(nil),                       // 74: base.SoalGambar
+	(*SoalFull)(nil),                         // 75: base.SoalFull
+	(*SoalForStudent)(nil),                   // 76: base.SoalForStudent
+	(*CreateSoalRequest)(nil),                // 77: base.CreateSoalRequest
+	(*GetSoalRequest)(nil),                   // 78: base.GetSoalRequest
+	(*UpdateSoalRequest)(nil),                // 79: base.UpdateSoalRequest
+	(*SoalOrderItem)(nil),                    // 80: base.SoalOrderItem
+	(*ReorderSoalRequest)(nil),               // 81: base.ReorderSoalRequest
+	(*DeleteSoalRequest)(nil),                // 82: base.DeleteSoalRequest
+	(*SoalResponse)(nil),                     // 83: base.SoalResponse
+	(*ListSoalRequest)(nil),                  // 84: base.ListSoalRequest
+	(*ListSoalResponse)(nil),                 // 85: base.ListSoalResponse
+	(*UploadImageToSoalRequest)(nil),         // 86: base.UploadImageToSoalRequest
+	(*UploadImageResponse)(nil),              // 87: base.UploadImageResponse
+	(*DeleteImageFromSoalRequest)(nil),       // 88: base.DeleteImageFromSoalRequest
+	(*UpdateImageInSoalRequest)(nil),         // 89: base.UpdateImageInSoalRequest
+	(*SoalMedia)(nil),                        // 90: base.SoalMedia
+	(*UploadMediaToSoalRequest)(nil),         // 91: base.UploadMediaToSoalRequest
+	(*UploadMediaResponse)(nil),              // 92: base.UploadMediaResponse
+	(*DeleteMediaFromSoalRequest)(nil),       // 93: base.DeleteMediaFromSoalRequest
+	(*UpdateMediaInSoalRequest)(nil),         // 94: base.UpdateMediaInSoalRequest
+	(*DragItem)(nil),                         // 95: base.DragItem
+	(*DragSlot)(nil),                         // 96: base.DragSlot
+	(*DragCorrectAnswer)(nil),                // 97: base.DragCorrectAnswer
+	(*DragCorrectAnswerByUrutan)(nil),        // 98: base.DragCorrectAnswerByUrutan
+	(*SoalDragDropFull)(nil),                 // 99: base.SoalDragDropFull
+	(*SoalDragDropForStudent)(nil),           // 100: base.SoalDragDropForStudent
+	(*QuestionForStudent)(nil),               // 101: base.QuestionForStudent
+	(*CreateSoalDragDropRequest)(nil),        // 102: base.CreateSoalDragDropRequest
+	(*GetSoalDragDropRequest)(nil),           // 103: base.GetSoalDragDropRequest
+	(*UpdateSoalDragDropRequest)(nil),        // 104: base.UpdateSoalDragDropRequest
+	(*SoalDragDropOrderItem)(nil),            // 105: base.SoalDragDropOrderItem
+	(*ReorderSoalDragDropRequest)(nil),       // 106: base.ReorderSoalDragDropRequest
+	(*DeleteSoalDragDropRequest)(nil),        // 107: base.DeleteSoalDragDropRequest
+	(*SoalDragDropResponse)(nil),             // 108: base.SoalDragDropResponse
+	(*ListSoalDragDropRequest)(nil),          // 109: base.ListSoalDragDropRequest
+	(*ListSoalDragDropResponse)(nil),         // 110: base.ListSoalDragDropResponse
+	(*TestSession)(nil),                      // 111: base.TestSession
+	(*CreateTestSessionRequest)(nil),         // 112: base.CreateTestSessionRequest
+	(*GetTestSessionRequest)(nil),            // 113: base.GetTestSessionRequest
+	(*TestSessionResponse)(nil),              // 114: base.TestSessionResponse
+	(*ListTestSessionsRequest)(nil),          // 115: base.ListTestSessionsRequest
+	(*ListTestSessionsResponse)(nil),         // 116: base.ListTestSessionsResponse
+	(*GetTestQuestionsRequest)(nil),          // 117: base.GetTestQuestionsRequest
+	(*TestQuestionsResponse)(nil),            // 118: base.TestQuestionsResponse
+	(*SubmitAnswerRequest)(nil),              // 119: base.SubmitAnswerRequest
+	(*SubmitAnswerResponse)(nil),             // 120: base.SubmitAnswerResponse
+	(*SubmitComplexAnswerRequest)(nil),       // 121: base.SubmitComplexAnswerRequest
+	(*SubmitComplexAnswerResponse)(nil),      // 122: base.SubmitComplexAnswerResponse
+	(*SubmitDragDropAnswerRequest)(nil),      // 123: base.SubmitDragDropAnswerRequest
+	(*SubmitDragDropAnswerResponse)(nil),     // 124: base.SubmitDragDropAnswerResponse
+	(*SubmitEssayAnswerRequest)(nil),         // 125: base.SubmitEssayAnswerRequest
+	(*SubmitEssayAnswerResponse)(nil),        // 126: base.SubmitEssayAnswerResponse
+	(*ClearAnswerRequest)(nil),               // 127: base.ClearAnswerRequest
+	(*ClearAnswerResponse)(nil),              // 128: base.ClearAnswerResponse
+	(*CompleteSessionRequest)(nil),           // 129: base.CompleteSessionRequest
+	(*GetTestResultRequest)(nil),             // 130: base.GetTestResultRequest
+	(*JawabanDetail)(nil),                    // 131: base.JawabanDetail
+	(*GradeEssayAnswerRequest)(nil),          // 132: base.GradeEssayAnswerRequest
+	(*GradeEssayAnswerResponse)(nil),         // 133: base.GradeEssayAnswerResponse
+	(*TestResultResponse)(nil),               // 134: base.TestResultResponse
+	(*StudentHistoryRequest)(nil),            // 135: base.StudentHistoryRequest
+	(*HistorySummary)(nil),                   // 136: base.HistorySummary
+	(*StudentHistoryResponse)(nil),           // 137: base.StudentHistoryResponse
+	(*ListStudentHistoriesRequest)(nil),      // 138: base.ListStudentHistoriesRequest
+	(*ListStudentHistoriesResponse)(nil),     // 139: base.ListStudentHistoriesResponse
+	(*StudentHistoryWithUser)(nil),           // 140: base.StudentHistoryWithUser
+	(*GetHistoryDetailRequest)(nil),          // 141: base.GetHistoryDetailRequest
+	(*HistoryDetailResponse)(nil),            // 142: base.HistoryDetailResponse
+	(*MateriBreakdown)(nil),                  // 143: base.MateriBreakdown
+	(*QuestionCountsResponse)(nil),           // 144: base.QuestionCountsResponse
+	(*TopicCount)(nil),                       // 145: base.TopicCount
+	(*ListMyScheduledSessionsRequest)(nil),   // 146: base.ListMyScheduledSessionsRequest
+	(*StartScheduledSessionRequest)(nil),     // 147: base.StartScheduledSessionRequest
+	(*ClassData)(nil),                        // 148: base.ClassData
+	(*ListClassesRequest)(nil),               // 149: base.ListClassesRequest
+	(*ListClassesResponse)(nil),              // 150: base.ListClassesResponse
+	(*ClassStudentData)(nil),                 // 151: base.ClassStudentData
+	(*ListClassStudentsRequest)(nil),         // 152: base.ListClassStudentsRequest
+	(*ListClassStudentsResponse)(nil),        // 153: base.ListClassStudentsResponse
+	(*SebConfig)(nil),                        // 154: base.SebConfig
+	(*UploadSebConfigRequest)(nil),           // 155: base.UploadSebConfigRequest
+	(*GetSebConfigRequest)(nil),              // 156: base.GetSebConfigRequest
+	(*DeleteSebConfigRequest)(nil),           // 157: base.DeleteSebConfigRequest
+	(*SebConfigResponse)(nil),                // 158: base.SebConfigResponse
+	(*DeviceLease)(nil),                      // 159: base.DeviceLease
+	(*ListDeviceLeasesRequest)(nil),          // 160: base.ListDeviceLeasesRequest
+	(*ListDeviceLeasesResponse)(nil),         // 161: base.ListDeviceLeasesResponse
+	(*ApproveDeviceTransferRequest)(nil),     // 162: base.ApproveDeviceTransferRequest
+	(*DeviceLeaseResponse)(nil),              // 163: base.DeviceLeaseResponse
+	(*NetworkAllowlistEntry)(nil),            // 164: base.NetworkAllowlistEntry
+	(*SetNetworkAllowlistRequest)(nil),       // 165: base.SetNetworkAllowlistRequest
+	(*GetNetworkAllowlistRequest)(nil),       // 166: base.GetNetworkAllowlistRequest
+	(*NetworkAllowlistResponse)(nil),         // 167: base.NetworkAllowlistResponse
+	(*GrantNetworkOverrideRequest)(nil),      // 168: base.GrantNetworkOverrideRequest
+	(*NetworkOverrideResponse)(nil),          // 169: base.NetworkOverrideResponse
+	(*NetworkAccessDenial)(nil),              // 170: base.NetworkAccessDenial
+	(*ListNetworkAccessDenialsRequest)(nil),  // 171: base.ListNetworkAccessDenialsRequest
+	(*ListNetworkAccessDenialsResponse)(nil), // 172: base.ListNetworkAccessDenialsResponse
+	(*AnalyzeCollusionRequest)(nil),          // 173: base.AnalyzeCollusionRequest
+	(*CollusionSession)(nil),                 // 174: base.CollusionSession
+	(*CollusionEvidence)(nil),                // 175: base.CollusionEvidence
+	(*CollusionPair)(nil),                    // 176: base.CollusionPair
+	(*CollusionReportResponse)(nil),          // 177: base.CollusionReportResponse
+	(*RunEssaySimilarityCheckRequest)(nil),   // 178: base.RunEssaySimilarityCheckRequest
+	(*EssaySimilarityRunResponse)(nil),       // 179: base.EssaySimilarityRunResponse
+	(*GetEssayGradingViewRequest)(nil),       // 180: base.GetEssayGradingViewRequest
+	(*EssayAnswerForGrading)(nil),            // 181: base.EssayAnswerForGrading
+	(*MatchedPassage)(nil),                   // 182: base.MatchedPassage
+	(*EssaySimilarity)(nil),                  // 183: base.EssaySimilarity
+	(*EssayGradingViewResponse)(nil),         // 184: base.EssayGradingViewResponse
+	(*RubricLevel)(nil),                      // 185: base.RubricLevel
+	(*RubricCriterion)(nil),                  // 186: base.RubricCriterion
+	(*EssayRubric)(nil),                      // 187: base.EssayRubric
+	(*SetEssayRubricRequest)(nil),            // 188: base.SetEssayRubricRequest
+	(*GetEssayRubricRequest)(nil),            // 189: base.GetEssayRubricRequest
+	(*EssayRubricResponse)(nil),              // 190: base.EssayRubricResponse
+	(*RubricSelection)(nil),                  // 191: base.RubricSelection
+	(*RubricScore)(nil),                      // 192: base.RubricScore
+	(*GradingConfig)(nil),                    // 193: base.GradingConfig
+	(*SetGradingConfigRequest)(nil),          // 194: base.SetGradingConfigRequest
+	(*GetGradingConfigRequest)(nil),          // 195: base.GetGradingConfigRequest
+	(*GradingConfigResponse)(nil),            // 196: base.GradingConfigResponse
+	(*GradingTask)(nil),                      // 197: base.GradingTask
+	(*EssayModeration)(nil),                  // 198: base.EssayModeration
+	(*PendingEssay)(nil),                     // 199: base.PendingEssay
+	(*ListPendingEssaysRequest)(nil),         // 200: base.ListPendingEssaysRequest
+	(*ListPendingEssaysResponse)(nil),        // 201: base.ListPendingEssaysResponse
+	(*AssignGradersRequest)(nil),             // 202: base.AssignGradersRequest
+	(*AssignGradersResponse)(nil),            // 203: base.AssignGradersResponse
+	(*SubmitEssayMarkRequest)(nil),           // 204: base.SubmitEssayMarkRequest
+	(*ResolveModerationRequest)(nil),         // 205: base.ResolveModerationRequest
+	(*EssayMarkResponse)(nil),                // 206: base.EssayMarkResponse
+	(*GetGradingProgressRequest)(nil),        // 207: base.GetGradingProgressRequest
+	(*GradingProgressResponse)(nil),          // 208: base.GradingProgressResponse
+	(*EssayKeyword)(nil),                     // 209: base.EssayKeyword
+	(*SetEssayKeywordsRequest)(nil),          // 210: base.SetEssayKeywordsRequest
+	(*GetEssayKeywordsRequest)(nil),          // 211: base.GetEssayKeywordsRequest
+	(*EssayKeywordsResponse)(nil),            // 212: base.EssayKeywordsResponse
+	(*KeywordMatch)(nil),                     // 213: base.KeywordMatch
+	(*ScoreSuggestion)(nil),                  // 214: base.ScoreSuggestion
+	(*GenerateScoreSuggestionsRequest)(nil),  // 215: base.GenerateScoreSuggestionsRequest
+	(*GenerateScoreSuggestionsResponse)(nil), // 216: base.GenerateScoreSuggestionsResponse
+	(*GetSuggestionAgreementRequest)(nil),    // 217: base.GetSuggestionAgreementRequest
+	(*SuggestionAgreementResponse)(nil),      // 218: base.SuggestionAgreementResponse
+	(*ShortAnswerBlank)(nil),                 // 219: base.ShortAnswerBlank
+	(*SubmitShortAnswerRequest)(nil),         // 220: base.SubmitShortAnswerRequest
+	(*SubmitShortAnswerResponse)(nil),        // 221: base.SubmitShortAnswerResponse
+	(*NumericAnswerKey)(nil),                 // 222: base.NumericAnswerKey
+	(*SubmitNumericAnswerRequest)(nil),       // 223: base.SubmitNumericAnswerRequest
+	(*SubmitNumericAnswerResponse)(nil),      // 224: base.SubmitNumericAnswerResponse
+	(*SoalOpsi)(nil),                         // 225: base.SoalOpsi
+	(*HotspotPoint)(nil),                     // 226: base.HotspotPoint
+	(*HotspotRegion)(nil),                    // 227: base.HotspotRegion
+	(*HotspotAnswerKey)(nil),                 // 228: base.HotspotAnswerKey
+	(*SubmitHotspotAnswerRequest)(nil),       // 229: base.SubmitHotspotAnswerRequest
+	(*SubmitHotspotAnswerResponse)(nil),      // 230: base.SubmitHotspotAnswerResponse
+	(*GridRow)(nil),                          // 231: base.GridRow
+	(*GridAnswerKey)(nil),                    // 232: base.GridAnswerKey
+	(*SubmitGridAnswerRequest)(nil),          // 233: base.SubmitGridAnswerRequest
+	(*SubmitGridAnswerResponse)(nil),         // 234: base.SubmitGridAnswerResponse
+	(*QuestionMedia)(nil),                    // 235: base.QuestionMedia
+	(*RecordMediaPlayRequest)(nil),           // 236: base.RecordMediaPlayRequest
+	(*RecordMediaPlayResponse)(nil),          // 237: base.RecordMediaPlayResponse
+	(*GetMediaStreamSourceRequest)(nil),      // 238: base.GetMediaStreamSourceRequest
+	(*GetMediaStreamSourceResponse)(nil),     // 239: base.GetMediaStreamSourceResponse
+	(*ApiKey)(nil),                           // 240: base.ApiKey
+	(*CreateApiKeyRequest)(nil),              // 241: base.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),             // 242: base.CreateApiKeyResponse
+	(*ListApiKeysRequest)(nil),               // 243: base.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),              // 244: base.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),              // 245: base.RevokeApiKeyRequest
+	(*LabCredential)(nil),                    // 246: base.LabCredential
+	(*IssueLabCredentialsRequest)(nil),       // 247: base.IssueLabCredentialsRequest
+	(*IssueLabCredentialsResponse)(nil),      // 248: base.IssueLabCredentialsResponse
+	(*RevokeLabCredentialsRequest)(nil),      // 249: base.RevokeLabCredentialsRequest
+	(*RevokeLabCredentialsResponse)(nil),     // 250: base.RevokeLabCredentialsResponse
+	(*LabLoginRequest)(nil),                  // 251: base.LabLoginRequest
+	(*LabLoginResponse)(nil),                 // 252: base.LabLoginResponse
+	(*AuditLog)(nil),                         // 253: base.AuditLog
+	(*ListAuditLogsRequest)(nil),             // 254: base.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),            // 255: base.ListAuditLogsResponse
+	nil,                                      // 256: base.SoalDragDropForStudent.UserAnswerEntry
+	nil,                                      // 257: base.QuestionForStudent.DdUserAnswerEntry
+	nil,                                      // 258: base.SubmitDragDropAnswerRequest.AnswerEntry
+	nil,                                      // 259: base.SubmitDragDropAnswerResponse.AnswerEntry
+	nil,                                      // 260: base.JawabanDetail.UserDragAnswerEntry
+	nil,                                      // 261: base.JawabanDetail.CorrectDragAnswerEntry
+	(*timestamppb.Timestamp)(nil),            // 262: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 263: google.protobuf.Empty
 }
 var file_cbt_proto_depIdxs = []int32{
-	5,   // 0: base.User.role:type_name -> base.UserRole
-	135, // 1: base.User.created_at:type_name -> google.protobuf.Timestamp
-	135, // 2: base.User.updated_at:type_name -> google.protobuf.Timestamp
-	9,   // 3: base.LoginResponse.user:type_name -> base.User
-	135, // 4: base.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
-	9,   // 5: base.UserResponse.user:type_name -> base.User
-	5,   // 6: base.ListUsersRequest.role:type_name -> base.UserRole
-	7,   // 7: base.ListUsersRequest.pagination:type_name -> base.PaginationRequest
-	9,   // 8: base.ListUsersResponse.users:type_name -> base.User
-	8,   // 9: base.ListUsersResponse.pagination:type_name -> base.PaginationResponse
-	5,   // 10: base.CreateUserRequest.role:type_name -> base.UserRole
-	5,   // 11: base.UpdateUserRequest.role:type_name -> base.UserRole
-	135, // 12: base.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	135, // 13: base.UserLimit.reset_at:type_name -> google.protobuf.Timestamp
-	135, // 14: base.UserLimit.created_at:type_name -> google.protobuf.Timestamp
-	135, // 15: base.UserLimit.updated_at:type_name -> google.protobuf.Timestamp
-	135, // 16: base.UserLimitUsage.created_at:type_name -> google.protobuf.Timestamp
-	21,  // 17: base.GetUserLimitsResponse.limits:type_name -> base.UserLimit
-	21,  // 18: base.UserLimitResponse.limit:type_name -> base.UserLimit
-	22,  // 19: base.GetUserLimitUsageHistoryResponse.history:type_name -> base.UserLimitUsage
-	30,  // 20: base.MataPelajaranResponse.mata_pelajaran:type_name -> base.MataPelajaran
-	30,  // 21: base.ListMataPelajaranResponse.mata_pelajaran:type_name -> base.MataPelajaran
-	30,  // 22: base.Materi.mata_pelajaran:type_name -> base.MataPelajaran
-	47,  // 23: base.Materi.tingkat:type_name -> base.Tingkat
-	37,  // 24: base.MateriResponse.materi:type_name -> base.Materi
-	7,   // 25: base.ListMateriRequest.pagination:type_name -> base.PaginationRequest
-	37,  // 26: base.ListMateriResponse.materi:type_name -> base.Materi
-	8,   // 27: base.ListMateriResponse.pagination:type_name -> base.PaginationResponse
-	47,  // 28: base.TingkatResponse.tingkat:type_name -> base.Tingkat
-	47,  // 29: base.ListTingkatResponse.tingkat:type_name -> base.Tingkat
-	135, // 30: base.SoalGambar.created_at:type_name -> google.protobuf.Timestamp
-	37,  // 31: base.SoalFull.materi:type_name -> base.Materi
-	0,   // 32: base.SoalFull.jawaban_benar:type_name -> base.JawabanOption
-	54,  // 33: base.SoalFull.gambar:type_name -> base.SoalGambar
-	2,   // 34: base.SoalFull.question_type:type_name -> base.QuestionType
-	0,   // 35: base.SoalFull.jawaban_benar_complex:type_name -> base.JawabanOption
-	0,   // 36: base.SoalForStudent.jawaban_dipilih:type_name -> base.JawabanOption
-	37,  // 37: base.SoalForStudent.materi:type_name -> base.Materi
-	54,  // 38: base.SoalForStudent.gambar:type_name -> base.SoalGambar
-	0,   // 39: base.CreateSoalRequest.jawaban_benar:type_name -> base.JawabanOption
-	2,   // 40: base.CreateSoalRequest.question_type:type_name -> base.QuestionType
-	0,   // 41: base.CreateSoalRequest.jawaban_benar_complex:type_name -> base.JawabanOption
-	0,   // 42: base.UpdateSoalRequest.jawaban_benar:type_name -> base.JawabanOption
-	2,   // 43: base.UpdateSoalRequest.question_type:type_name -> base.QuestionType
-	0,   // 44: base.UpdateSoalRequest.jawaban_benar_complex:type_name -> base.JawabanOption
-	60,  // 45: base.ReorderSoalRequest.items:type_name -> base.SoalOrderItem
-	55,  // 46: base.SoalResponse.soal:type_name -> base.SoalFull
-	7,   // 47: base.ListSoalRequest.pagination:type_name -> base.PaginationRequest
-	55,  // 48: base.ListSoalResponse.soal:type_name -> base.SoalFull
-	8,   // 49: base.ListSoalResponse.pagination:type_name -> base.PaginationResponse
-	54,  // 50: base.UploadImageResponse.gambar:type_name -> base.SoalGambar
-	37,  // 51: base.SoalDragDropFull.materi:type_name -> base.Materi
-	3,   // 52: base.SoalDragDropFull.drag_type:type_name -> base.DragDropType
-	70,  // 53: base.SoalDragDropFull.items:type_name -> base.DragItem
-	71,  // 54: base.SoalDragDropFull.slots:type_name -> base.DragSlot
-	72,  // 55: base.SoalDragDropFull.correct_answers:type_name -> base.DragCorrectAnswer
-	135, // 56: base.SoalDragDropFull.created_at:type_name -> google.protobuf.Timestamp
-	135, // 57: base.SoalDragDropFull.updated_at:type_name -> google.protobuf.Timestamp
-	3,   // 58: base.SoalDragDropForStudent.drag_type:type_name -> base.DragDropType
-	70,  // 59: base.SoalDragDropForStudent.items:type_name -> base.DragItem
-	71,  // 60: base.SoalDragDropForStudent.slots:type_name -> base.DragSlot
-	37,  // 61: base.SoalDragDropForStudent.materi:type_name -> base.Materi
-	129, // 62: base.SoalDragDropForStudent.user_answer:type_name -> base.SoalDragDropForStudent.UserAnswerEntry
-	2,   // 63: base.QuestionForStudent.question_type:type_name -> base.QuestionType
-	37,  // 64: base.QuestionForStudent.materi:type_name -> base.Materi
-	0,   // 65: base.QuestionForStudent.mc_jawaban_dipilih:type_name -> base.JawabanOption
-	54,  // 66: base.QuestionForStudent.mc_gambar:type_name -> base.SoalGambar
-	3,   // 67: base.QuestionForStudent.dd_drag_type:type_name -> base.DragDropType
-	70,  // 68: base.QuestionForStudent.dd_items:type_name -> base.DragItem
-	71,  // 69: base.QuestionForStudent.dd_slots:type_name -> base.DragSlot
-	130, // 70: base.QuestionForStudent.dd_user_answer:type_name -> base.QuestionForStudent.DdUserAnswerEntry
-	0,   // 71: base.QuestionForStudent.mcc_jawaban_dipilih:type_name -> base.JawabanOption
-	54,  // 72: base.QuestionForStudent.mcc_gambar:type_name -> base.SoalGambar
-	3,   // 73: base.CreateSoalDragDropRequest.drag_type:type_name -> base.DragDropType
-	70,  // 74: base.CreateSoalDragDropRequest.items:type_name -> base.DragItem
-	71,  // 75: base.CreateSoalDragDropRequest.slots:type_name -> base.DragSlot
-	73,  // 76: base.CreateSoalDragDropRequest.correct_answers:type_name -> base.DragCorrectAnswerByUrutan
-	3,   // 77: base.UpdateSoalDragDropRequest.drag_type:type_name -> base.DragDropType
-	70,  // 78: base.UpdateSoalDragDropRequest.items:type_name -> base.DragItem
-	71,  // 79: base.UpdateSoalDragDropRequest.slots:type_name -> base.DragSlot
-	73,  // 80: base.UpdateSoalDragDropRequest.correct_answers:type_name -> base.DragCorrectAnswerByUrutan
-	80,  // 81: base.ReorderSoalDragDropRequest.items:type_name -> base.SoalDragDropOrderItem
-	74,  // 82: base.SoalDragDropResponse.soal:type_name -> base.SoalDragDropFull
-	7,   // 83: base.ListSoalDragDropRequest.pagination:type_name -> base.PaginationRequest
-	74,  // 84: base.ListSoalDragDropResponse.soal:type_name -> base.SoalDragDropFull
-	8,   // 85: base.ListSoalDragDropResponse.pagination:type_name -> base.PaginationResponse
-	9,   // 86: base.TestSession.user:type_name -> base.User
-	47,  // 87: base.TestSession.tingkat:type_name -> base.Tingkat
-	30,  // 88: base.TestSession.mata_pelajaran:type_name -> base.MataPelajaran
-	135, // 89: base.TestSession.waktu_mulai:type_name -> google.protobuf.Timestamp
-	135, // 90: base.TestSession.waktu_selesai:type_name -> google.protobuf.Timestamp
-	135, // 91: base.TestSession.batas_waktu:type_name -> google.protobuf.Timestamp
-	1,   // 92: base.TestSession.status:type_name -> base.TestStatus
-	2,   // 93: base.CreateTestSessionRequest.include_question_types:type_name -> base.QuestionType
-	4,   // 94: base.CreateTestSessionRequest.selection_mode:type_name -> base.QuestionSelectionMode
-	86,  // 95: base.TestSessionResponse.test_session:type_name -> base.TestSession
-	1,   // 96: base.ListTestSessionsRequest.status:type_name -> base.TestStatus
-	7,   // 97: base.ListTestSessionsRequest.pagination:type_name -> base.PaginationRequest
-	86,  // 98: base.ListTestSessionsResponse.test_sessions:type_name -> base.TestSession
-	8,   // 99: base.ListTestSessionsResponse.pagination:type_name -> base.PaginationResponse
-	76,  // 100: base.TestQuestionsResponse.questions:type_name -> base.QuestionForStudent
-	135, // 101: base.TestQuestionsResponse.batas_waktu:type_name -> google.protobuf.Timestamp
-	0,   // 102: base.SubmitAnswerRequest.jawaban_dipilih:type_name -> base.JawabanOption
-	0,   // 103: base.SubmitAnswerResponse.jawaban_dipilih:type_name -> base.JawabanOption
-	135, // 104: base.SubmitAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
-	0,   // 105: base.SubmitComplexAnswerRequest.jawaban_dipilih:type_name -> base.JawabanOption
-	0,   // 106: base.SubmitComplexAnswerResponse.jawaban_dipilih:type_name -> base.JawabanOption
-	135, // 107: base.SubmitComplexAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
-	131, // 108: base.SubmitDragDropAnswerRequest.answer:type_name -> base.SubmitDragDropAnswerRequest.AnswerEntry
-	132, // 109: base.SubmitDragDropAnswerResponse.answer:type_name -> base.SubmitDragDropAnswerResponse.AnswerEntry
-	135, // 110: base.SubmitDragDropAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
-	135, // 111: base.SubmitEssayAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
-	135, // 112: base.ClearAnswerResponse.dibatalkan_pada:type_name -> google.protobuf.Timestamp
-	0,   // 113: base.JawabanDetail.jawaban_dipilih:type_name -> base.JawabanOption
-	0,   // 114: base.JawabanDetail.jawaban_benar:type_name -> base.JawabanOption
-	54,  // 115: base.JawabanDetail.gambar:type_name -> base.SoalGambar
-	2,   // 116: base.JawabanDetail.question_type:type_name -> base.QuestionType
-	3,   // 117: base.JawabanDetail.drag_type:type_name -> base.DragDropType
-	70,  // 118: base.JawabanDetail.items:type_name -> base.DragItem
-	71,  // 119: base.JawabanDetail.slots:type_name -> base.DragSlot
-	133, // 120: base.JawabanDetail.user_drag_answer:type_name -> base.JawabanDetail.UserDragAnswerEntry
-	134, // 121: base.JawabanDetail.correct_drag_answer:type_name -> base.JawabanDetail.CorrectDragAnswerEntry
-	0,   // 122: base.JawabanDetail.jawaban_dipilih_complex:type_name -> base.JawabanOption
-	0,   // 123: base.JawabanDetail.jawaban_benar_complex:type_name -> base.JawabanOption
-	86,  // 124: base.TestResultResponse.session_info:type_name -> base.TestSession
-	106, // 125: base.TestResultResponse.detail_jawaban:type_name -> base.JawabanDetail
-	47,  // 126: base.TestResultResponse.tingkat:type_name -> base.Tingkat
-	7,   // 127: base.StudentHistoryRequest.pagination:type_name -> base.PaginationRequest
-	30,  // 128: base.HistorySummary.mata_pelajaran:type_name -> base.MataPelajaran
-	47,  // 129: base.HistorySummary.tingkat:type_name -> base.Tingkat
-	135, // 130: base.HistorySummary.waktu_mulai:type_name -> google.protobuf.Timestamp
-	135, // 131: base.HistorySummary.waktu_selesai:type_name -> google.protobuf.Timestamp
-	1,   // 132: base.HistorySummary.status:type_name -> base.TestStatus
-	111, // 133: base.StudentHistoryResponse.history:type_name -> base.HistorySummary
-	8,   // 134: base.StudentHistoryResponse.pagination:type_name -> base.PaginationResponse
-	9,   // 135: base.StudentHistoryResponse.user:type_name -> base.User
-	7,   // 136: base.ListStudentHistoriesRequest.pagination:type_name -> base.PaginationRequest
-	115, // 137: base.ListStudentHistoriesResponse.history_per_student:type_name -> base.StudentHistoryWithUser
-	8,   // 138: base.ListStudentHistoriesResponse.pagination:type_name -> base.PaginationResponse
-	9,   // 139: base.StudentHistoryWithUser.user:type_name -> base.User
-	111, // 140: base.StudentHistoryWithUser.history:type_name -> base.HistorySummary
-	86,  // 141: base.HistoryDetailResponse.session_info:type_name -> base.TestSession
-	106, // 142: base.HistoryDetailResponse.detail_jawaban:type_name -> base.JawabanDetail
-	118, // 143: base.HistoryDetailResponse.breakdown_materi:type_name -> base.MateriBreakdown
-	120, // 144: base.QuestionCountsResponse.counts:type_name -> base.TopicCount
-	7,   // 145: base.ListMyScheduledSessionsRequest.pagination:type_name -> base.PaginationRequest
-	135, // 146: base.ClassData.created_at:type_name -> google.protobuf.Timestamp
-	135, // 147: base.ClassData.updated_at:type_name -> google.protobuf.Timestamp
-	123, // 148: base.ListClassesResponse.classes:type_name -> base.ClassData
-	135, // 149: base.ClassStudentData.joined_at:type_name -> google.protobuf.Timestamp
-	126, // 150: base.ListClassStudentsResponse.students:type_name -> base.ClassStudentData
-	136, // 151: base.Base.HealthCheck:input_type -> google.protobuf.Empty
-	136, // 152: base.AuthService.GetProfile:input_type -> google.protobuf.Empty
-	32,  // 153: base.MataPelajaranService.GetMataPelajaran:input_type -> base.GetMataPelajaranRequest
-	136, // 154: base.MataPelajaranService.ListMataPelajaran:input_type -> google.protobuf.Empty
-	38,  // 155: base.MateriService.CreateMateri:input_type -> base.CreateMateriRequest
-	39,  // 156: base.MateriService.CreateMateriSuperadmin:input_type -> base.CreateMateriSuperadminRequest
-	40,  // 157: base.MateriService.CreateMateriTeacher:input_type -> base.CreateMateriTeacherRequest
-	41,  // 158: base.MateriService.GetMateri:input_type -> base.GetMateriRequest
-	42,  // 159: base.MateriService.UpdateMateri:input_type -> base.UpdateMateriRequest
-	43,  // 160: base.MateriService.DeleteMateri:input_type -> base.DeleteMateriRequest
-	45,  // 161: base.MateriService.ListMateri:input_type -> base.ListMateriRequest
-	49,  // 162: base.TingkatService.GetTingkat:input_type -> base.GetTingkatRequest
-	136, // 163: base.TingkatService.ListTingkat:input_type -> google.protobuf.Empty
-	57,  // 164: base.SoalService.CreateSoal:input_type -> base.CreateSoalRequest
-	58,  // 165: base.SoalService.GetSoal:input_type -> base.GetSoalRequest
-	59,  // 166: base.SoalService.UpdateSoal:input_type -> base.UpdateSoalRequest
-	62,  // 167: base.SoalService.DeleteSoal:input_type -> base.DeleteSoalRequest
-	64,  // 168: base.SoalService.ListSoal:input_type -> base.ListSoalRequest
-	66,  // 169: base.SoalService.UploadImageToSoal:input_type -> base.UploadImageToSoalRequest
-	68,  // 170: base.SoalService.DeleteImageFromSoal:input_type -> base.DeleteImageFromSoalRequest
-	69,  // 171: base.SoalService.UpdateImageInSoal:input_type -> base.UpdateImageInSoalRequest
-	136, // 172: base.SoalService.GetQuestionCountsByTopic:input_type -> google.protobuf.Empty
-	61,  // 173: base.SoalService.ReorderSoal:input_type -> base.ReorderSoalRequest
-	77,  // 174: base.SoalDragDropService.CreateSoalDragDrop:input_type -> base.CreateSoalDragDropRequest
-	78,  // 175: base.SoalDragDropService.GetSoalDragDrop:input_type -> base.GetSoalDragDropRequest
-	79,  // 176: base.SoalDragDropService.UpdateSoalDragDrop:input_type -> base.UpdateSoalDragDropRequest
-	82,  // 177: base.SoalDragDropService.DeleteSoalDragDrop:input_type -> base.DeleteSoalDragDropRequest
-	84,  // 178: base.SoalDragDropService.ListSoalDragDrop:input_type -> base.ListSoalDragDropRequest
-	81,  // 179: base.SoalDragDropService.ReorderSoalDragDrop:input_type -> base.ReorderSoalDragDropRequest
-	87,  // 180: base.TestSessionService.CreateTestSession:input_type -> base.CreateTestSessionRequest
-	88,  // 181: base.TestSessionService.GetTestSession:input_type -> base.GetTestSessionRequest
-	92,  // 182: base.TestSessionService.GetTestQuestions:input_type -> base.GetTestQuestionsRequest
-	94,  // 183: base.TestSessionService.SubmitAnswer:input_type -> base.SubmitAnswerRequest
-	96,  // 184: base.TestSessionService.SubmitComplexAnswer:input_type -> base.SubmitComplexAnswerRequest
-	98,  // 185: base.TestSessionService.SubmitDragDropAnswer:input_type -> base.SubmitDragDropAnswerRequest
-	100, // 186: base.TestSessionService.SubmitEssayAnswer:input_type -> base.SubmitEssayAnswerRequest
-	102, // 187: base.TestSessionService.ClearAnswer:input_type -> base.ClearAnswerRequest
-	104, // 188: base.TestSessionService.CompleteSession:input_type -> base.CompleteSessionRequest
-	105, // 189: base.TestSessionService.GetTestResult:input_type -> base.GetTestResultRequest
-	107, // 190: base.TestSessionService.GradeEssayAnswer:input_type -> base.GradeEssayAnswerRequest
-	121, // 191: base.TestSessionService.ListMyScheduledSessions:input_type -> base.ListMyScheduledSessionsRequest
-	122, // 192: base.TestSessionService.StartScheduledSession:input_type -> base.StartScheduledSessionRequest
-	90,  // 193: base.TestSessionService.ListTestSessions:input_type -> base.ListTestSessionsRequest
-	110, // 194: base.HistoryService.GetStudentHistory:input_type -> base.StudentHistoryRequest
-	116, // 195: base.HistoryService.GetHistoryDetail:input_type -> base.GetHistoryDetailRequest
-	23,  // 196: base.UserLimitService.GetUserLimits:input_type -> base.GetUserLimitsRequest
-	25,  // 197: base.UserLimitService.SetUserLimit:input_type -> base.SetUserLimitRequest
-	26,  // 198: base.UserLimitService.ResetUserLimit:input_type -> base.ResetUserLimitRequest
-	28,  // 199: base.UserLimitService.GetUserLimitUsageHistory:input_type -> base.GetUserLimitUsageHistoryRequest
-	124, // 200: base.ClassSyncService.ListClasses:input_type -> base.ListClassesRequest
-	127, // 201: base.ClassSyncService.ListClassStudents:input_type -> base.ListClassStudentsRequest
-	6,   // 202: base.Base.HealthCheck:output_type -> base.MessageStatusResponse
-	12,  // 203: base.AuthService.GetProfile:output_type -> base.UserResponse
-	35,  // 204: base.MataPelajaranService.GetMataPelajaran:output_type -> base.MataPelajaranResponse
-	36,  // 205: base.MataPelajaranService.ListMataPelajaran:output_type -> base.ListMataPelajaranResponse
-	44,  // 206: base.MateriService.CreateMateri:output_type -> base.MateriResponse
-	44,  // 207: base.MateriService.CreateMateriSuperadmin:output_type -> base.MateriResponse
-	44,  // 208: base.MateriService.CreateMateriTeacher:output_type -> base.MateriResponse
-	44,  // 209: base.MateriService.GetMateri:output_type -> base.MateriResponse
-	44,  // 210: base.MateriService.UpdateMateri:output_type -> base.MateriResponse
-	6,   // 211: base.MateriService.DeleteMateri:output_type -> base.MessageStatusResponse
-	46,  // 212: base.MateriService.ListMateri:output_type -> base.ListMateriResponse
-	52,  // 213: base.TingkatService.GetTingkat:output_type -> base.TingkatResponse
-	53,  // 214: base.TingkatService.ListTingkat:output_type -> base.ListTingkatResponse
-	63,  // 215: base.SoalService.CreateSoal:output_type -> base.SoalResponse
-	63,  // 216: base.SoalService.GetSoal:output_type -> base.SoalResponse
-	63,  // 217: base.SoalService.UpdateSoal:output_type -> base.SoalResponse
-	6,   // 218: base.SoalService.DeleteSoal:output_type -> base.MessageStatusResponse
-	65,  // 219: base.SoalService.ListSoal:output_type -> base.ListSoalResponse
-	67,  // 220: base.SoalService.UploadImageToSoal:output_type -> base.UploadImageResponse
-	6,   // 221: base.SoalService.DeleteImageFromSoal:output_type -> base.MessageStatusResponse
-	6,   // 222: base.SoalService.UpdateImageInSoal:output_type -> base.MessageStatusResponse
-	119, // 223: base.SoalService.GetQuestionCountsByTopic:output_type -> base.QuestionCountsResponse
-	6,   // 224: base.SoalService.ReorderSoal:output_type -> base.MessageStatusResponse
-	83,  // 225: base.SoalDragDropService.CreateSoalDragDrop:output_type -> base.SoalDragDropResponse
-	83,  // 226: base.SoalDragDropService.GetSoalDragDrop:output_type -> base.SoalDragDropResponse
-	83,  // 227: base.SoalDragDropService.UpdateSoalDragDrop:output_type -> base.SoalDragDropResponse
-	6,   // 228: base.SoalDragDropService.DeleteSoalDragDrop:output_type -> base.MessageStatusResponse
-	85,  // 229: base.SoalDragDropService.ListSoalDragDrop:output_type -> base.ListSoalDragDropResponse
-	6,   // 230: base.SoalDragDropService.ReorderSoalDragDrop:output_type -> base.MessageStatusResponse
-	89,  // 231: base.TestSessionService.CreateTestSession:output_type -> base.TestSessionResponse
-	89,  // 232: base.TestSessionService.GetTestSession:output_type -> base.TestSessionResponse
-	93,  // 233: base.TestSessionService.GetTestQuestions:output_type -> base.TestQuestionsResponse
-	95,  // 234: base.TestSessionService.SubmitAnswer:output_type -> base.SubmitAnswerResponse
-	97,  // 235: base.TestSessionService.SubmitComplexAnswer:output_type -> base.SubmitComplexAnswerResponse
-	99,  // 236: base.TestSessionService.SubmitDragDropAnswer:output_type -> base.SubmitDragDropAnswerResponse
-	101, // 237: base.TestSessionService.SubmitEssayAnswer:output_type -> base.SubmitEssayAnswerResponse
-	103, // 238: base.TestSessionService.ClearAnswer:output_type -> base.ClearAnswerResponse
-	89,  // 239: base.TestSessionService.CompleteSession:output_type -> base.TestSessionResponse
-	109, // 240: base.TestSessionService.GetTestResult:output_type -> base.TestResultResponse
-	108, // 241: base.TestSessionService.GradeEssayAnswer:output_type -> base.GradeEssayAnswerResponse
-	91,  // 242: base.TestSessionService.ListMyScheduledSessions:output_type -> base.ListTestSessionsResponse
-	89,  // 243: base.TestSessionService.StartScheduledSession:output_type -> base.TestSessionResponse
-	91,  // 244: base.TestSessionService.ListTestSessions:output_type -> base.ListTestSessionsResponse
-	112, // 245: base.HistoryService.GetStudentHistory:output_type -> base.StudentHistoryResponse
-	117, // 246: base.HistoryService.GetHistoryDetail:output_type -> base.HistoryDetailResponse
-	24,  // 247: base.UserLimitService.GetUserLimits:output_type -> base.GetUserLimitsResponse
-	27,  // 248: base.UserLimitService.SetUserLimit:output_type -> base.UserLimitResponse
-	6,   // 249: base.UserLimitService.ResetUserLimit:output_type -> base.MessageStatusResponse
-	29,  // 250: base.UserLimitService.GetUserLimitUsageHistory:output_type -> base.GetUserLimitUsageHistoryResponse
-	125, // 251: base.ClassSyncService.ListClasses:output_type -> base.ListClassesResponse
-	128, // 252: base.ClassSyncService.ListClassStudents:output_type -> base.ListClassStudentsResponse
-	202, // [202:253] is the sub-list for method output_type
-	151, // [151:202] is the sub-list for method input_type
-	151, // [151:151] is the sub-list for extension type_name
-	151, // [151:151] is the sub-list for extension extendee
-	0,   // [0:151] is the sub-list for field type_name
+	8,   // 0: base.User.role:type_name -> base.UserRole
+	262, // 1: base.User.created_at:type_name -> google.protobuf.Timestamp
+	262, // 2: base.User.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 3: base.LoginResponse.user:type_name -> base.User
+	262, // 4: base.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	262, // 5: base.LoginResponse.refresh_expires_at:type_name -> google.protobuf.Timestamp
+	12,  // 6: base.UserResponse.user:type_name -> base.User
+	8,   // 7: base.ListUsersRequest.role:type_name -> base.UserRole
+	10,  // 8: base.ListUsersRequest.pagination:type_name -> base.PaginationRequest
+	12,  // 9: base.ListUsersResponse.users:type_name -> base.User
+	11,  // 10: base.ListUsersResponse.pagination:type_name -> base.PaginationResponse
+	8,   // 11: base.CreateUserRequest.role:type_name -> base.UserRole
+	8,   // 12: base.UpdateUserRequest.role:type_name -> base.UserRole
+	262, // 13: base.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	262, // 14: base.RefreshTokenResponse.refresh_expires_at:type_name -> google.protobuf.Timestamp
+	262, // 15: base.UserLimit.reset_at:type_name -> google.protobuf.Timestamp
+	262, // 16: base.UserLimit.created_at:type_name -> google.protobuf.Timestamp
+	262, // 17: base.UserLimit.updated_at:type_name -> google.protobuf.Timestamp
+	262, // 18: base.UserLimitUsage.created_at:type_name -> google.protobuf.Timestamp
+	25,  // 19: base.GetUserLimitsResponse.limits:type_name -> base.UserLimit
+	25,  // 20: base.UserLimitResponse.limit:type_name -> base.UserLimit
+	26,  // 21: base.GetUserLimitUsageHistoryResponse.history:type_name -> base.UserLimitUsage
+	262, // 22: base.RateLimitPolicy.created_at:type_name -> google.protobuf.Timestamp
+	262, // 23: base.RateLimitPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	34,  // 24: base.ListRateLimitPoliciesResponse.policies:type_name -> base.RateLimitPolicy
+	35,  // 25: base.ListRateLimitPoliciesResponse.method_groups:type_name -> base.RateLimitMethodGroup
+	36,  // 26: base.ListRateLimitPoliciesResponse.school_plans:type_name -> base.SchoolPlan
+	34,  // 27: base.SaveRateLimitPolicyRequest.policy:type_name -> base.RateLimitPolicy
+	34,  // 28: base.RateLimitPolicyResponse.policy:type_name -> base.RateLimitPolicy
+	44,  // 29: base.MataPelajaranResponse.mata_pelajaran:type_name -> base.MataPelajaran
+	44,  // 30: base.ListMataPelajaranResponse.mata_pelajaran:type_name -> base.MataPelajaran
+	44,  // 31: base.Materi.mata_pelajaran:type_name -> base.MataPelajaran
+	67,  // 32: base.Materi.tingkat:type_name -> base.Tingkat
+	51,  // 33: base.MateriResponse.materi:type_name -> base.Materi
+	10,  // 34: base.ListMateriRequest.pagination:type_name -> base.PaginationRequest
+	51,  // 35: base.ListMateriResponse.materi:type_name -> base.Materi
+	11,  // 36: base.ListMateriResponse.pagination:type_name -> base.PaginationResponse
+	262, // 37: base.MateriShare.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 38: base.ShareMateriResponse.share:type_name -> base.MateriShare
+	61,  // 39: base.ListMateriSharesResponse.shares:type_name -> base.MateriShare
+	67,  // 40: base.TingkatResponse.tingkat:type_name -> base.Tingkat
+	67,  // 41: base.ListTingkatResponse.tingkat:type_name -> base.Tingkat
+	262, // 42: base.SoalGambar.created_at:type_name -> google.protobuf.Timestamp
+	51,  // 43: base.SoalFull.materi:type_name -> base.Materi
+	0,   // 44: base.SoalFull.jawaban_benar:type_name -> base.JawabanOption
+	74,  // 45: base.SoalFull.gambar:type_name -> base.SoalGambar
+	2,   // 46: base.SoalFull.question_type:type_name -> base.QuestionType
+	0,   // 47: base.SoalFull.jawaban_benar_complex:type_name -> base.JawabanOption
+	219, // 48: base.SoalFull.short_answer_blanks:type_name -> base.ShortAnswerBlank
+	222, // 49: base.SoalFull.numeric_answer:type_name -> base.NumericAnswerKey
+	225, // 50: base.SoalFull.opsi:type_name -> base.SoalOpsi
+	228, // 51: base.SoalFull.hotspot_answer:type_name -> base.HotspotAnswerKey
+	232, // 52: base.SoalFull.grid_answer:type_name -> base.GridAnswerKey
+	90,  // 53: base.SoalFull.media:type_name -> base.SoalMedia
+	6,   // 54: base.SoalFull.content_format:type_name -> base.ContentFormat
+	0,   // 55: base.SoalForStudent.jawaban_dipilih:type_name -> base.JawabanOption
+	51,  // 56: base.SoalForStudent.materi:type_name -> base.Materi
+	74,  // 57: base.SoalForStudent.gambar:type_name -> base.SoalGambar
+	0,   // 58: base.CreateSoalRequest.jawaban_benar:type_name -> base.JawabanOption
+	2,   // 59: base.CreateSoalRequest.question_type:type_name -> base.QuestionType
+	0,   // 60: base.CreateSoalRequest.jawaban_benar_complex:type_name -> base.JawabanOption
+	219, // 61: base.CreateSoalRequest.short_answer_blanks:type_name -> base.ShortAnswerBlank
+	222, // 62: base.CreateSoalRequest.numeric_answer:type_name -> base.NumericAnswerKey
+	228, // 63: base.CreateSoalRequest.hotspot_answer:type_name -> base.HotspotAnswerKey
+	232, // 64: base.CreateSoalRequest.grid_answer:type_name -> base.GridAnswerKey
+	6,   // 65: base.CreateSoalRequest.content_format:type_name -> base.ContentFormat
+	0,   // 66: base.UpdateSoalRequest.jawaban_benar:type_name -> base.JawabanOption
+	2,   // 67: base.UpdateSoalRequest.question_type:type_name -> base.QuestionType
+	0,   // 68: base.UpdateSoalRequest.jawaban_benar_complex:type_name -> base.JawabanOption
+	219, // 69: base.UpdateSoalRequest.short_answer_blanks:type_name -> base.ShortAnswerBlank
+	222, // 70: base.UpdateSoalRequest.numeric_answer:type_name -> base.NumericAnswerKey
+	228, // 71: base.UpdateSoalRequest.hotspot_answer:type_name -> base.HotspotAnswerKey
+	232, // 72: base.UpdateSoalRequest.grid_answer:type_name -> base.GridAnswerKey
+	6,   // 73: base.UpdateSoalRequest.content_format:type_name -> base.ContentFormat
+	80,  // 74: base.ReorderSoalRequest.items:type_name -> base.SoalOrderItem
+	75,  // 75: base.SoalResponse.soal:type_name -> base.SoalFull
+	10,  // 76: base.ListSoalRequest.pagination:type_name -> base.PaginationRequest
+	75,  // 77: base.ListSoalResponse.soal:type_name -> base.SoalFull
+	11,  // 78: base.ListSoalResponse.pagination:type_name -> base.PaginationResponse
+	74,  // 79: base.UploadImageResponse.gambar:type_name -> base.SoalGambar
+	5,   // 80: base.SoalMedia.jenis:type_name -> base.MediaJenis
+	262, // 81: base.SoalMedia.created_at:type_name -> google.protobuf.Timestamp
+	5,   // 82: base.UploadMediaToSoalRequest.jenis:type_name -> base.MediaJenis
+	90,  // 83: base.UploadMediaResponse.media:type_name -> base.SoalMedia
+	51,  // 84: base.SoalDragDropFull.materi:type_name -> base.Materi
+	3,   // 85: base.SoalDragDropFull.drag_type:type_name -> base.DragDropType
+	95,  // 86: base.SoalDragDropFull.items:type_name -> base.DragItem
+	96,  // 87: base.SoalDragDropFull.slots:type_name -> base.DragSlot
+	97,  // 88: base.SoalDragDropFull.correct_answers:type_name -> base.DragCorrectAnswer
+	262, // 89: base.SoalDragDropFull.created_at:type_name -> google.protobuf.Timestamp
+	262, // 90: base.SoalDragDropFull.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 91: base.SoalDragDropForStudent.drag_type:type_name -> base.DragDropType
+	95,  // 92: base.SoalDragDropForStudent.items:type_name -> base.DragItem
+	96,  // 93: base.SoalDragDropForStudent.slots:type_name -> base.DragSlot
+	51,  // 94: base.SoalDragDropForStudent.materi:type_name -> base.Materi
+	256, // 95: base.SoalDragDropForStudent.user_answer:type_name -> base.SoalDragDropForStudent.UserAnswerEntry
+	2,   // 96: base.QuestionForStudent.question_type:type_name -> base.QuestionType
+	51,  // 97: base.QuestionForStudent.materi:type_name -> base.Materi
+	0,   // 98: base.QuestionForStudent.mc_jawaban_dipilih:type_name -> base.JawabanOption
+	74,  // 99: base.QuestionForStudent.mc_gambar:type_name -> base.SoalGambar
+	3,   // 100: base.QuestionForStudent.dd_drag_type:type_name -> base.DragDropType
+	95,  // 101: base.QuestionForStudent.dd_items:type_name -> base.DragItem
+	96,  // 102: base.QuestionForStudent.dd_slots:type_name -> base.DragSlot
+	257, // 103: base.QuestionForStudent.dd_user_answer:type_name -> base.QuestionForStudent.DdUserAnswerEntry
+	0,   // 104: base.QuestionForStudent.mcc_jawaban_dipilih:type_name -> base.JawabanOption
+	74,  // 105: base.QuestionForStudent.mcc_gambar:type_name -> base.SoalGambar
+	74,  // 106: base.QuestionForStudent.sa_gambar:type_name -> base.SoalGambar
+	74,  // 107: base.QuestionForStudent.num_gambar:type_name -> base.SoalGambar
+	225, // 108: base.QuestionForStudent.mc_opsi:type_name -> base.SoalOpsi
+	225, // 109: base.QuestionForStudent.mcc_opsi:type_name -> base.SoalOpsi
+	74,  // 110: base.QuestionForStudent.hs_gambar:type_name -> base.SoalGambar
+	226, // 111: base.QuestionForStudent.hs_jawaban:type_name -> base.HotspotPoint
+	74,  // 112: base.QuestionForStudent.grid_gambar:type_name -> base.SoalGambar
+	235, // 113: base.QuestionForStudent.media:type_name -> base.QuestionMedia
+	6,   // 114: base.QuestionForStudent.content_format:type_name -> base.ContentFormat
+	3,   // 115: base.CreateSoalDragDropRequest.drag_type:type_name -> base.DragDropType
+	95,  // 116: base.CreateSoalDragDropRequest.items:type_name -> base.DragItem
+	96,  // 117: base.CreateSoalDragDropRequest.slots:type_name -> base.DragSlot
+	98,  // 118: base.CreateSoalDragDropRequest.correct_answers:type_name -> base.DragCorrectAnswerByUrutan
+	3,   // 119: base.UpdateSoalDragDropRequest.drag_type:type_name -> base.DragDropType
+	95,  // 120: base.UpdateSoalDragDropRequest.items:type_name -> base.DragItem
+	96,  // 121: base.UpdateSoalDragDropRequest.slots:type_name -> base.DragSlot
+	98,  // 122: base.UpdateSoalDragDropRequest.correct_answers:type_name -> base.DragCorrectAnswerByUrutan
+	105, // 123: base.ReorderSoalDragDropRequest.items:type_name -> base.SoalDragDropOrderItem
+	99,  // 124: base.SoalDragDropResponse.soal:type_name -> base.SoalDragDropFull
+	10,  // 125: base.ListSoalDragDropRequest.pagination:type_name -> base.PaginationRequest
+	99,  // 126: base.ListSoalDragDropResponse.soal:type_name -> base.SoalDragDropFull
+	11,  // 127: base.ListSoalDragDropResponse.pagination:type_name -> base.PaginationResponse
+	12,  // 128: base.TestSession.user:type_name -> base.User
+	67,  // 129: base.TestSession.tingkat:type_name -> base.Tingkat
+	44,  // 130: base.TestSession.mata_pelajaran:type_name -> base.MataPelajaran
+	262, // 131: base.TestSession.waktu_mulai:type_name -> google.protobuf.Timestamp
+	262, // 132: base.TestSession.waktu_selesai:type_name -> google.protobuf.Timestamp
+	262, // 133: base.TestSession.batas_waktu:type_name -> google.protobuf.Timestamp
+	1,   // 134: base.TestSession.status:type_name -> base.TestStatus
+	2,   // 135: base.CreateTestSessionRequest.include_question_types:type_name -> base.QuestionType
+	7,   // 136: base.CreateTestSessionRequest.selection_mode:type_name -> base.QuestionSelectionMode
+	111, // 137: base.TestSessionResponse.test_session:type_name -> base.TestSession
+	1,   // 138: base.ListTestSessionsRequest.status:type_name -> base.TestStatus
+	10,  // 139: base.ListTestSessionsRequest.pagination:type_name -> base.PaginationRequest
+	111, // 140: base.ListTestSessionsResponse.test_sessions:type_name -> base.TestSession
+	11,  // 141: base.ListTestSessionsResponse.pagination:type_name -> base.PaginationResponse
+	101, // 142: base.TestQuestionsResponse.questions:type_name -> base.QuestionForStudent
+	262, // 143: base.TestQuestionsResponse.batas_waktu:type_name -> google.protobuf.Timestamp
+	0,   // 144: base.SubmitAnswerRequest.jawaban_dipilih:type_name -> base.JawabanOption
+	0,   // 145: base.SubmitAnswerResponse.jawaban_dipilih:type_name -> base.JawabanOption
+	262, // 146: base.SubmitAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	0,   // 147: base.SubmitComplexAnswerRequest.jawaban_dipilih:type_name -> base.JawabanOption
+	0,   // 148: base.SubmitComplexAnswerResponse.jawaban_dipilih:type_name -> base.JawabanOption
+	262, // 149: base.SubmitComplexAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	258, // 150: base.SubmitDragDropAnswerRequest.answer:type_name -> base.SubmitDragDropAnswerRequest.AnswerEntry
+	259, // 151: base.SubmitDragDropAnswerResponse.answer:type_name -> base.SubmitDragDropAnswerResponse.AnswerEntry
+	262, // 152: base.SubmitDragDropAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	262, // 153: base.SubmitEssayAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	262, // 154: base.ClearAnswerResponse.dibatalkan_pada:type_name -> google.protobuf.Timestamp
+	0,   // 155: base.JawabanDetail.jawaban_dipilih:type_name -> base.JawabanOption
+	0,   // 156: base.JawabanDetail.jawaban_benar:type_name -> base.JawabanOption
+	74,  // 157: base.JawabanDetail.gambar:type_name -> base.SoalGambar
+	2,   // 158: base.JawabanDetail.question_type:type_name -> base.QuestionType
+	3,   // 159: base.JawabanDetail.drag_type:type_name -> base.DragDropType
+	95,  // 160: base.JawabanDetail.items:type_name -> base.DragItem
+	96,  // 161: base.JawabanDetail.slots:type_name -> base.DragSlot
+	260, // 162: base.JawabanDetail.user_drag_answer:type_name -> base.JawabanDetail.UserDragAnswerEntry
+	261, // 163: base.JawabanDetail.correct_drag_answer:type_name -> base.JawabanDetail.CorrectDragAnswerEntry
+	0,   // 164: base.JawabanDetail.jawaban_dipilih_complex:type_name -> base.JawabanOption
+	0,   // 165: base.JawabanDetail.jawaban_benar_complex:type_name -> base.JawabanOption
+	192, // 166: base.JawabanDetail.rubric_scores:type_name -> base.RubricScore
+	219, // 167: base.JawabanDetail.short_answer_blanks:type_name -> base.ShortAnswerBlank
+	222, // 168: base.JawabanDetail.numeric_answer:type_name -> base.NumericAnswerKey
+	225, // 169: base.JawabanDetail.opsi:type_name -> base.SoalOpsi
+	226, // 170: base.JawabanDetail.jawaban_hotspot:type_name -> base.HotspotPoint
+	228, // 171: base.JawabanDetail.hotspot_answer:type_name -> base.HotspotAnswerKey
+	232, // 172: base.JawabanDetail.grid_answer:type_name -> base.GridAnswerKey
+	6,   // 173: base.JawabanDetail.content_format:type_name -> base.ContentFormat
+	191, // 174: base.GradeEssayAnswerRequest.rubric_selections:type_name -> base.RubricSelection
+	192, // 175: base.GradeEssayAnswerResponse.rubric_scores:type_name -> base.RubricScore
+	111, // 176: base.TestResultResponse.session_info:type_name -> base.TestSession
+	131, // 177: base.TestResultResponse.detail_jawaban:type_name -> base.JawabanDetail
+	67,  // 178: base.TestResultResponse.tingkat:type_name -> base.Tingkat
+	10,  // 179: base.StudentHistoryRequest.pagination:type_name -> base.PaginationRequest
+	44,  // 180: base.HistorySummary.mata_pelajaran:type_name -> base.MataPelajaran
+	67,  // 181: base.HistorySummary.tingkat:type_name -> base.Tingkat
+	262, // 182: base.HistorySummary.waktu_mulai:type_name -> google.protobuf.Timestamp
+	262, // 183: base.HistorySummary.waktu_selesai:type_name -> google.protobuf.Timestamp
+	1,   // 184: base.HistorySummary.status:type_name -> base.TestStatus
+	136, // 185: base.StudentHistoryResponse.history:type_name -> base.HistorySummary
+	11,  // 186: base.StudentHistoryResponse.pagination:type_name -> base.PaginationResponse
+	12,  // 187: base.StudentHistoryResponse.user:type_name -> base.User
+	10,  // 188: base.ListStudentHistoriesRequest.pagination:type_name -> base.PaginationRequest
+	140, // 189: base.ListStudentHistoriesResponse.history_per_student:type_name -> base.StudentHistoryWithUser
+	11,  // 190: base.ListStudentHistoriesResponse.pagination:type_name -> base.PaginationResponse
+	12,  // 191: base.StudentHistoryWithUser.user:type_name -> base.User
+	136, // 192: base.StudentHistoryWithUser.history:type_name -> base.HistorySummary
+	111, // 193: base.HistoryDetailResponse.session_info:type_name -> base.TestSession
+	131, // 194: base.HistoryDetailResponse.detail_jawaban:type_name -> base.JawabanDetail
+	143, // 195: base.HistoryDetailResponse.breakdown_materi:type_name -> base.MateriBreakdown
+	145, // 196: base.QuestionCountsResponse.counts:type_name -> base.TopicCount
+	10,  // 197: base.ListMyScheduledSessionsRequest.pagination:type_name -> base.PaginationRequest
+	262, // 198: base.ClassData.created_at:type_name -> google.protobuf.Timestamp
+	262, // 199: base.ClassData.updated_at:type_name -> google.protobuf.Timestamp
+	148, // 200: base.ListClassesResponse.classes:type_name -> base.ClassData
+	262, // 201: base.ClassStudentData.joined_at:type_name -> google.protobuf.Timestamp
+	151, // 202: base.ListClassStudentsResponse.students:type_name -> base.ClassStudentData
+	262, // 203: base.SebConfig.created_at:type_name -> google.protobuf.Timestamp
+	262, // 204: base.SebConfig.updated_at:type_name -> google.protobuf.Timestamp
+	154, // 205: base.SebConfigResponse.seb_config:type_name -> base.SebConfig
+	262, // 206: base.DeviceLease.issued_at:type_name -> google.protobuf.Timestamp
+	262, // 207: base.DeviceLease.last_seen_at:type_name -> google.protobuf.Timestamp
+	262, // 208: base.DeviceLease.released_at:type_name -> google.protobuf.Timestamp
+	159, // 209: base.ListDeviceLeasesResponse.leases:type_name -> base.DeviceLease
+	159, // 210: base.DeviceLeaseResponse.lease:type_name -> base.DeviceLease
+	262, // 211: base.NetworkAllowlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	164, // 212: base.NetworkAllowlistResponse.entries:type_name -> base.NetworkAllowlistEntry
+	262, // 213: base.NetworkOverrideResponse.expires_at:type_name -> google.protobuf.Timestamp
+	262, // 214: base.NetworkOverrideResponse.created_at:type_name -> google.protobuf.Timestamp
+	262, // 215: base.NetworkAccessDenial.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 216: base.ListNetworkAccessDenialsRequest.pagination:type_name -> base.PaginationRequest
+	170, // 217: base.ListNetworkAccessDenialsResponse.denials:type_name -> base.NetworkAccessDenial
+	11,  // 218: base.ListNetworkAccessDenialsResponse.pagination:type_name -> base.PaginationResponse
+	262, // 219: base.CollusionEvidence.answered_at_a:type_name -> google.protobuf.Timestamp
+	262, // 220: base.CollusionEvidence.answered_at_b:type_name -> google.protobuf.Timestamp
+	174, // 221: base.CollusionPair.session_a:type_name -> base.CollusionSession
+	174, // 222: base.CollusionPair.session_b:type_name -> base.CollusionSession
+	175, // 223: base.CollusionPair.evidence:type_name -> base.CollusionEvidence
+	176, // 224: base.CollusionReportResponse.pairs:type_name -> base.CollusionPair
+	262, // 225: base.CollusionReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	262, // 226: base.EssaySimilarityRunResponse.computed_at:type_name -> google.protobuf.Timestamp
+	262, // 227: base.EssayAnswerForGrading.dijawab_pada:type_name -> google.protobuf.Timestamp
+	182, // 228: base.EssaySimilarity.matched_passages:type_name -> base.MatchedPassage
+	262, // 229: base.EssaySimilarity.computed_at:type_name -> google.protobuf.Timestamp
+	181, // 230: base.EssayGradingViewResponse.answer:type_name -> base.EssayAnswerForGrading
+	183, // 231: base.EssayGradingViewResponse.similarities:type_name -> base.EssaySimilarity
+	187, // 232: base.EssayGradingViewResponse.rubric:type_name -> base.EssayRubric
+	192, // 233: base.EssayGradingViewResponse.rubric_scores:type_name -> base.RubricScore
+	214, // 234: base.EssayGradingViewResponse.suggestion:type_name -> base.ScoreSuggestion
+	185, // 235: base.RubricCriterion.levels:type_name -> base.RubricLevel
+	186, // 236: base.EssayRubric.criteria:type_name -> base.RubricCriterion
+	262, // 237: base.EssayRubric.updated_at:type_name -> google.protobuf.Timestamp
+	186, // 238: base.SetEssayRubricRequest.criteria:type_name -> base.RubricCriterion
+	187, // 239: base.EssayRubricResponse.rubric:type_name -> base.EssayRubric
+	262, // 240: base.GradingConfig.updated_at:type_name -> google.protobuf.Timestamp
+	193, // 241: base.GradingConfigResponse.config:type_name -> base.GradingConfig
+	262, // 242: base.GradingTask.assigned_at:type_name -> google.protobuf.Timestamp
+	262, // 243: base.GradingTask.submitted_at:type_name -> google.protobuf.Timestamp
+	262, // 244: base.EssayModeration.created_at:type_name -> google.protobuf.Timestamp
+	181, // 245: base.PendingEssay.answer:type_name -> base.EssayAnswerForGrading
+	197, // 246: base.PendingEssay.tasks:type_name -> base.GradingTask
+	198, // 247: base.PendingEssay.moderation:type_name -> base.EssayModeration
+	214, // 248: base.PendingEssay.suggestion:type_name -> base.ScoreSuggestion
+	10,  // 249: base.ListPendingEssaysRequest.pagination:type_name -> base.PaginationRequest
+	199, // 250: base.ListPendingEssaysResponse.essays:type_name -> base.PendingEssay
+	11,  // 251: base.ListPendingEssaysResponse.pagination:type_name -> base.PaginationResponse
+	197, // 252: base.AssignGradersResponse.tasks:type_name -> base.GradingTask
+	191, // 253: base.SubmitEssayMarkRequest.rubric_selections:type_name -> base.RubricSelection
+	191, // 254: base.ResolveModerationRequest.rubric_selections:type_name -> base.RubricSelection
+	192, // 255: base.EssayMarkResponse.rubric_scores:type_name -> base.RubricScore
+	209, // 256: base.SetEssayKeywordsRequest.keywords:type_name -> base.EssayKeyword
+	209, // 257: base.EssayKeywordsResponse.keywords:type_name -> base.EssayKeyword
+	213, // 258: base.ScoreSuggestion.keyword_matches:type_name -> base.KeywordMatch
+	182, // 259: base.ScoreSuggestion.reference_passages:type_name -> base.MatchedPassage
+	262, // 260: base.ScoreSuggestion.computed_at:type_name -> google.protobuf.Timestamp
+	262, // 261: base.GenerateScoreSuggestionsResponse.computed_at:type_name -> google.protobuf.Timestamp
+	262, // 262: base.SubmitShortAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	262, // 263: base.SubmitNumericAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	4,   // 264: base.HotspotRegion.shape:type_name -> base.HotspotShape
+	226, // 265: base.HotspotRegion.points:type_name -> base.HotspotPoint
+	227, // 266: base.HotspotAnswerKey.regions:type_name -> base.HotspotRegion
+	226, // 267: base.SubmitHotspotAnswerRequest.points:type_name -> base.HotspotPoint
+	226, // 268: base.SubmitHotspotAnswerResponse.points:type_name -> base.HotspotPoint
+	262, // 269: base.SubmitHotspotAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	231, // 270: base.GridAnswerKey.rows:type_name -> base.GridRow
+	262, // 271: base.SubmitGridAnswerResponse.dijawab_pada:type_name -> google.protobuf.Timestamp
+	5,   // 272: base.QuestionMedia.jenis:type_name -> base.MediaJenis
+	235, // 273: base.RecordMediaPlayResponse.media:type_name -> base.QuestionMedia
+	262, // 274: base.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	262, // 275: base.ApiKey.revoked_at:type_name -> google.protobuf.Timestamp
+	262, // 276: base.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	262, // 277: base.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	240, // 278: base.CreateApiKeyResponse.api_key:type_name -> base.ApiKey
+	240, // 279: base.ListApiKeysResponse.api_keys:type_name -> base.ApiKey
+	262, // 280: base.LabCredential.expires_at:type_name -> google.protobuf.Timestamp
+	246, // 281: base.IssueLabCredentialsResponse.credentials:type_name -> base.LabCredential
+	262, // 282: base.LabLoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	12,  // 283: base.LabLoginResponse.user:type_name -> base.User
+	262, // 284: base.AuditLog.occurred_at:type_name -> google.protobuf.Timestamp
+	262, // 285: base.ListAuditLogsRequest.from:type_name -> google.protobuf.Timestamp
+	262, // 286: base.ListAuditLogsRequest.to:type_name -> google.protobuf.Timestamp
+	10,  // 287: base.ListAuditLogsRequest.pagination:type_name -> base.PaginationRequest
+	253, // 288: base.ListAuditLogsResponse.audit_logs:type_name -> base.AuditLog
+	11,  // 289: base.ListAuditLogsResponse.pagination:type_name -> base.PaginationResponse
+	263, // 290: base.Base.HealthCheck:input_type -> google.protobuf.Empty
+	263, // 291: base.AuthService.GetProfile:input_type -> google.protobuf.Empty
+	13,  // 292: base.AuthService.Login:input_type -> base.LoginRequest
+	22,  // 293: base.AuthService.RefreshToken:input_type -> base.RefreshTokenRequest
+	263, // 294: base.AuthService.Logout:input_type -> google.protobuf.Empty
+	24,  // 295: base.AuthService.ChangePassword:input_type -> base.ChangePasswordRequest
+	46,  // 296: base.MataPelajaranService.GetMataPelajaran:input_type -> base.GetMataPelajaranRequest
+	263, // 297: base.MataPelajaranService.ListMataPelajaran:input_type -> google.protobuf.Empty
+	52,  // 298: base.MateriService.CreateMateri:input_type -> base.CreateMateriRequest
+	53,  // 299: base.MateriService.CreateMateriSuperadmin:input_type -> base.CreateMateriSuperadminRequest
+	54,  // 300: base.MateriService.CreateMateriTeacher:input_type -> base.CreateMateriTeacherRequest
+	55,  // 301: base.MateriService.GetMateri:input_type -> base.GetMateriRequest
+	56,  // 302: base.MateriService.UpdateMateri:input_type -> base.UpdateMateriRequest
+	57,  // 303: base.MateriService.DeleteMateri:input_type -> base.DeleteMateriRequest
+	59,  // 304: base.MateriService.ListMateri:input_type -> base.ListMateriRequest
+	62,  // 305: base.MateriService.ShareMateri:input_type -> base.ShareMateriRequest
+	64,  // 306: base.MateriService.RevokeMateriShare:input_type -> base.RevokeMateriShareRequest
+	65,  // 307: base.MateriService.ListMateriShares:input_type -> base.ListMateriSharesRequest
+	69,  // 308: base.TingkatService.GetTingkat:input_type -> base.GetTingkatRequest
+	263, // 309: base.TingkatService.ListTingkat:input_type -> google.protobuf.Empty
+	77,  // 310: base.SoalService.CreateSoal:input_type -> base.CreateSoalRequest
+	78,  // 311: base.SoalService.GetSoal:input_type -> base.GetSoalRequest
+	79,  // 312: base.SoalService.UpdateSoal:input_type -> base.UpdateSoalRequest
+	82,  // 313: base.SoalService.DeleteSoal:input_type -> base.DeleteSoalRequest
+	84,  // 314: base.SoalService.ListSoal:input_type -> base.ListSoalRequest
+	86,  // 315: base.SoalService.UploadImageToSoal:input_type -> base.UploadImageToSoalRequest
+	88,  // 316: base.SoalService.DeleteImageFromSoal:input_type -> base.DeleteImageFromSoalRequest
+	89,  // 317: base.SoalService.UpdateImageInSoal:input_type -> base.UpdateImageInSoalRequest
+	91,  // 318: base.SoalService.UploadMediaToSoal:input_type -> base.UploadMediaToSoalRequest
+	93,  // 319: base.SoalService.DeleteMediaFromSoal:input_type -> base.DeleteMediaFromSoalRequest
+	94,  // 320: base.SoalService.UpdateMediaInSoal:input_type -> base.UpdateMediaInSoalRequest
+	263, // 321: base.SoalService.GetQuestionCountsByTopic:input_type -> google.protobuf.Empty
+	81,  // 322: base.SoalService.ReorderSoal:input_type -> base.ReorderSoalRequest
+	102, // 323: base.SoalDragDropService.CreateSoalDragDrop:input_type -> base.CreateSoalDragDropRequest
+	103, // 324: base.SoalDragDropService.GetSoalDragDrop:input_type -> base.GetSoalDragDropRequest
+	104, // 325: base.SoalDragDropService.UpdateSoalDragDrop:input_type -> base.UpdateSoalDragDropRequest
+	107, // 326: base.SoalDragDropService.DeleteSoalDragDrop:input_type -> base.DeleteSoalDragDropRequest
+	109, // 327: base.SoalDragDropService.ListSoalDragDrop:input_type -> base.ListSoalDragDropRequest
+	106, // 328: base.SoalDragDropService.ReorderSoalDragDrop:input_type -> base.ReorderSoalDragDropRequest
+	112, // 329: base.TestSessionService.CreateTestSession:input_type -> base.CreateTestSessionRequest
+	113, // 330: base.TestSessionService.GetTestSession:input_type -> base.GetTestSessionRequest
+	117, // 331: base.TestSessionService.GetTestQuestions:input_type -> base.GetTestQuestionsRequest
+	119, // 332: base.TestSessionService.SubmitAnswer:input_type -> base.SubmitAnswerRequest
+	121, // 333: base.TestSessionService.SubmitComplexAnswer:input_type -> base.SubmitComplexAnswerRequest
+	220, // 334: base.TestSessionService.SubmitShortAnswer:input_type -> base.SubmitShortAnswerRequest
+	223, // 335: base.TestSessionService.SubmitNumericAnswer:input_type -> base.SubmitNumericAnswerRequest
+	229, // 336: base.TestSessionService.SubmitHotspotAnswer:input_type -> base.SubmitHotspotAnswerRequest
+	233, // 337: base.TestSessionService.SubmitGridAnswer:input_type -> base.SubmitGridAnswerRequest
+	123, // 338: base.TestSessionService.SubmitDragDropAnswer:input_type -> base.SubmitDragDropAnswerRequest
+	125, // 339: base.TestSessionService.SubmitEssayAnswer:input_type -> base.SubmitEssayAnswerRequest
+	127, // 340: base.TestSessionService.ClearAnswer:input_type -> base.ClearAnswerRequest
+	129, // 341: base.TestSessionService.CompleteSession:input_type -> base.CompleteSessionRequest
+	236, // 342: base.TestSessionService.RecordMediaPlay:input_type -> base.RecordMediaPlayRequest
+	238, // 343: base.TestSessionService.GetMediaStreamSource:input_type -> base.GetMediaStreamSourceRequest
+	130, // 344: base.TestSessionService.GetTestResult:input_type -> base.GetTestResultRequest
+	132, // 345: base.TestSessionService.GradeEssayAnswer:input_type -> base.GradeEssayAnswerRequest
+	146, // 346: base.TestSessionService.ListMyScheduledSessions:input_type -> base.ListMyScheduledSessionsRequest
+	147, // 347: base.TestSessionService.StartScheduledSession:input_type -> base.StartScheduledSessionRequest
+	115, // 348: base.TestSessionService.ListTestSessions:input_type -> base.ListTestSessionsRequest
+	135, // 349: base.HistoryService.GetStudentHistory:input_type -> base.StudentHistoryRequest
+	141, // 350: base.HistoryService.GetHistoryDetail:input_type -> base.GetHistoryDetailRequest
+	138, // 351: base.HistoryService.ListStudentHistories:input_type -> base.ListStudentHistoriesRequest
+	27,  // 352: base.UserLimitService.GetUserLimits:input_type -> base.GetUserLimitsRequest
+	29,  // 353: base.UserLimitService.SetUserLimit:input_type -> base.SetUserLimitRequest
+	30,  // 354: base.UserLimitService.ResetUserLimit:input_type -> base.ResetUserLimitRequest
+	32,  // 355: base.UserLimitService.GetUserLimitUsageHistory:input_type -> base.GetUserLimitUsageHistoryRequest
+	37,  // 356: base.UserLimitService.ListRateLimitPolicies:input_type -> base.ListRateLimitPoliciesRequest
+	39,  // 357: base.UserLimitService.SaveRateLimitPolicy:input_type -> base.SaveRateLimitPolicyRequest
+	41,  // 358: base.UserLimitService.DeleteRateLimitPolicy:input_type -> base.DeleteRateLimitPolicyRequest
+	42,  // 359: base.UserLimitService.SetRateLimitMethodGroup:input_type -> base.SetRateLimitMethodGroupRequest
+	43,  // 360: base.UserLimitService.SetSchoolPlan:input_type -> base.SetSchoolPlanRequest
+	149, // 361: base.ClassSyncService.ListClasses:input_type -> base.ListClassesRequest
+	152, // 362: base.ClassSyncService.ListClassStudents:input_type -> base.ListClassStudentsRequest
+	155, // 363: base.ExamSecurityService.UploadSebConfig:input_type -> base.UploadSebConfigRequest
+	156, // 364: base.ExamSecurityService.GetSebConfig:input_type -> base.GetSebConfigRequest
+	157, // 365: base.ExamSecurityService.DeleteSebConfig:input_type -> base.DeleteSebConfigRequest
+	160, // 366: base.ExamSecurityService.ListDeviceLeases:input_type -> base.ListDeviceLeasesRequest
+	162, // 367: base.ExamSecurityService.ApproveDeviceTransfer:input_type -> base.ApproveDeviceTransferRequest
+	165, // 368: base.ExamSecurityService.SetNetworkAllowlist:input_type -> base.SetNetworkAllowlistRequest
+	166, // 369: base.ExamSecurityService.GetNetworkAllowlist:input_type -> base.GetNetworkAllowlistRequest
+	168, // 370: base.ExamSecurityService.GrantNetworkOverride:input_type -> base.GrantNetworkOverrideRequest
+	171, // 371: base.ExamSecurityService.ListNetworkAccessDenials:input_type -> base.ListNetworkAccessDenialsRequest
+	173, // 372: base.ExamSecurityService.AnalyzeCollusion:input_type -> base.AnalyzeCollusionRequest
+	178, // 373: base.GradingService.RunEssaySimilarityCheck:input_type -> base.RunEssaySimilarityCheckRequest
+	180, // 374: base.GradingService.GetEssayGradingView:input_type -> base.GetEssayGradingViewRequest
+	188, // 375: base.GradingService.SetEssayRubric:input_type -> base.SetEssayRubricRequest
+	189, // 376: base.GradingService.GetEssayRubric:input_type -> base.GetEssayRubricRequest
+	194, // 377: base.GradingService.SetGradingConfig:input_type -> base.SetGradingConfigRequest
+	195, // 378: base.GradingService.GetGradingConfig:input_type -> base.GetGradingConfigRequest
+	200, // 379: base.GradingService.ListPendingEssays:input_type -> base.ListPendingEssaysRequest
+	202, // 380: base.GradingService.AssignGraders:input_type -> base.AssignGradersRequest
+	204, // 381: base.GradingService.SubmitEssayMark:input_type -> base.SubmitEssayMarkRequest
+	205, // 382: base.GradingService.ResolveModeration:input_type -> base.ResolveModerationRequest
+	207, // 383: base.GradingService.GetGradingProgress:input_type -> base.GetGradingProgressRequest
+	210, // 384: base.GradingService.SetEssayKeywords:input_type -> base.SetEssayKeywordsRequest
+	211, // 385: base.GradingService.GetEssayKeywords:input_type -> base.GetEssayKeywordsRequest
+	215, // 386: base.GradingService.GenerateScoreSuggestions:input_type -> base.GenerateScoreSuggestionsRequest
+	217, // 387: base.GradingService.GetSuggestionAgreement:input_type -> base.GetSuggestionAgreementRequest
+	241, // 388: base.ApiKeyService.CreateApiKey:input_type -> base.CreateApiKeyRequest
+	243, // 389: base.ApiKeyService.ListApiKeys:input_type -> base.ListApiKeysRequest
+	245, // 390: base.ApiKeyService.RevokeApiKey:input_type -> base.RevokeApiKeyRequest
+	247, // 391: base.LabLoginService.IssueLabCredentials:input_type -> base.IssueLabCredentialsRequest
+	249, // 392: base.LabLoginService.RevokeLabCredentials:input_type -> base.RevokeLabCredentialsRequest
+	251, // 393: base.LabLoginService.LabLogin:input_type -> base.LabLoginRequest
+	254, // 394: base.AuditLogService.ListAuditLogs:input_type -> base.ListAuditLogsRequest
+	9,   // 395: base.Base.HealthCheck:output_type -> base.MessageStatusResponse
+	15,  // 396: base.AuthService.GetProfile:output_type -> base.UserResponse
+	14,  // 397: base.AuthService.Login:output_type -> base.LoginResponse
+	23,  // 398: base.AuthService.RefreshToken:output_type -> base.RefreshTokenResponse
+	9,   // 399: base.AuthService.Logout:output_type -> base.MessageStatusResponse
+	9,   // 400: base.AuthService.ChangePassword:output_type -> base.MessageStatusResponse
+	49,  // 401: base.MataPelajaranService.GetMataPelajaran:output_type -> base.MataPelajaranResponse
+	50,  // 402: base.MataPelajaranService.ListMataPelajaran:output_type -> base.ListMataPelajaranResponse
+	58,  // 403: base.MateriService.CreateMateri:output_type -> base.MateriResponse
+	58,  // 404: base.MateriService.CreateMateriSuperadmin:output_type -> base.MateriResponse
+	58,  // 405: base.MateriService.CreateMateriTeacher:output_type -> base.MateriResponse
+	58,  // 406: base.MateriService.GetMateri:output_type -> base.MateriResponse
+	58,  // 407: base.MateriService.UpdateMateri:output_type -> base.MateriResponse
+	9,   // 408: base.MateriService.DeleteMateri:output_type -> base.MessageStatusResponse
+	60,  // 409: base.MateriService.ListMateri:output_type -> base.ListMateriResponse
+	63,  // 410: base.MateriService.ShareMateri:output_type -> base.ShareMateriResponse
+	9,   // 411: base.MateriService.RevokeMateriShare:output_type -> base.MessageStatusResponse
+	66,  // 412: base.MateriService.ListMateriShares:output_type -> base.ListMateriSharesResponse
+	72,  // 413: base.TingkatService.GetTingkat:output_type -> base.TingkatResponse
+	73,  // 414: base.TingkatService.ListTingkat:output_type -> base.ListTingkatResponse
+	83,  // 415: base.SoalService.CreateSoal:output_type -> base.SoalResponse
+	83,  // 416: base.SoalService.GetSoal:output_type -> base.SoalResponse
+	83,  // 417: base.SoalService.UpdateSoal:output_type -> base.SoalResponse
+	9,   // 418: base.SoalService.DeleteSoal:output_type -> base.MessageStatusResponse
+	85,  // 419: base.SoalService.ListSoal:output_type -> base.ListSoalResponse
+	87,  // 420: base.SoalService.UploadImageToSoal:output_type -> base.UploadImageResponse
+	9,   // 421: base.SoalService.DeleteImageFromSoal:output_type -> base.MessageStatusResponse
+	9,   // 422: base.SoalService.UpdateImageInSoal:output_type -> base.MessageStatusResponse
+	92,  // 423: base.SoalService.UploadMediaToSoal:output_type -> base.UploadMediaResponse
+	9,   // 424: base.SoalService.DeleteMediaFromSoal:output_type -> base.MessageStatusResponse
+	9,   // 425: base.SoalService.UpdateMediaInSoal:output_type -> base.MessageStatusResponse
+	144, // 426: base.SoalService.GetQuestionCountsByTopic:output_type -> base.QuestionCountsResponse
+	9,   // 427: base.SoalService.ReorderSoal:output_type -> base.MessageStatusResponse
+	108, // 428: base.SoalDragDropService.CreateSoalDragDrop:output_type -> base.SoalDragDropResponse
+	108, // 429: base.SoalDragDropService.GetSoalDragDrop:output_type -> base.SoalDragDropResponse
+	108, // 430: base.SoalDragDropService.UpdateSoalDragDrop:output_type -> base.SoalDragDropResponse
+	9,   // 431: base.SoalDragDropService.DeleteSoalDragDrop:output_type -> base.MessageStatusResponse
+	110, // 432: base.SoalDragDropService.ListSoalDragDrop:output_type -> base.ListSoalDragDropResponse
+	9,   // 433: base.SoalDragDropService.ReorderSoalDragDrop:output_type -> base.MessageStatusResponse
+	114, // 434: base.TestSessionService.CreateTestSession:output_type -> base.TestSessionResponse
+	114, // 435: base.TestSessionService.GetTestSession:output_type -> base.TestSessionResponse
+	118, // 436: base.TestSessionService.GetTestQuestions:output_type -> base.TestQuestionsResponse
+	120, // 437: base.TestSessionService.SubmitAnswer:output_type -> base.SubmitAnswerResponse
+	122, // 438: base.TestSessionService.SubmitComplexAnswer:output_type -> base.SubmitComplexAnswerResponse
+	221, // 439: base.TestSessionService.SubmitShortAnswer:output_type -> base.SubmitShortAnswerResponse
+	224, // 440: base.TestSessionService.SubmitNumericAnswer:output_type -> base.SubmitNumericAnswerResponse
+	230, // 441: base.TestSessionService.SubmitHotspotAnswer:output_type -> base.SubmitHotspotAnswerResponse
+	234, // 442: base.TestSessionService.SubmitGridAnswer:output_type -> base.SubmitGridAnswerResponse
+	124, // 443: base.TestSessionService.SubmitDragDropAnswer:output_type -> base.SubmitDragDropAnswerResponse
+	126, // 444: base.TestSessionService.SubmitEssayAnswer:output_type -> base.SubmitEssayAnswerResponse
+	128, // 445: base.TestSessionService.ClearAnswer:output_type -> base.ClearAnswerResponse
+	114, // 446: base.TestSessionService.CompleteSession:output_type -> base.TestSessionResponse
+	237, // 447: base.TestSessionService.RecordMediaPlay:output_type -> base.RecordMediaPlayResponse
+	239, // 448: base.TestSessionService.GetMediaStreamSource:output_type -> base.GetMediaStreamSourceResponse
+	134, // 449: base.TestSessionService.GetTestResult:output_type -> base.TestResultResponse
+	133, // 450: base.TestSessionService.GradeEssayAnswer:output_type -> base.GradeEssayAnswerResponse
+	116, // 451: base.TestSessionService.ListMyScheduledSessions:output_type -> base.ListTestSessionsResponse
+	114, // 452: base.TestSessionService.StartScheduledSession:output_type -> base.TestSessionResponse
+	116, // 453: base.TestSessionService.ListTestSessions:output_type -> base.ListTestSessionsResponse
+	137, // 454: base.HistoryService.GetStudentHistory:output_type -> base.StudentHistoryResponse
+	142, // 455: base.HistoryService.GetHistoryDetail:output_type -> base.HistoryDetailResponse
+	139, // 456: base.HistoryService.ListStudentHistories:output_type -> base.ListStudentHistoriesResponse
+	28,  // 457: base.UserLimitService.GetUserLimits:output_type -> base.GetUserLimitsResponse
+	31,  // 458: base.UserLimitService.SetUserLimit:output_type -> base.UserLimitResponse
+	9,   // 459: base.UserLimitService.ResetUserLimit:output_type -> base.MessageStatusResponse
+	33,  // 460: base.UserLimitService.GetUserLimitUsageHistory:output_type -> base.GetUserLimitUsageHistoryResponse
+	38,  // 461: base.UserLimitService.ListRateLimitPolicies:output_type -> base.ListRateLimitPoliciesResponse
+	40,  // 462: base.UserLimitService.SaveRateLimitPolicy:output_type -> base.RateLimitPolicyResponse
+	9,   // 463: base.UserLimitService.DeleteRateLimitPolicy:output_type -> base.MessageStatusResponse
+	9,   // 464: base.UserLimitService.SetRateLimitMethodGroup:output_type -> base.MessageStatusResponse
+	9,   // 465: base.UserLimitService.SetSchoolPlan:output_type -> base.MessageStatusResponse
+	150, // 466: base.ClassSyncService.ListClasses:output_type -> base.ListClassesResponse
+	153, // 467: base.ClassSyncService.ListClassStudents:output_type -> base.ListClassStudentsResponse
+	158, // 468: base.ExamSecurityService.UploadSebConfig:output_type -> base.SebConfigResponse
+	158, // 469: base.ExamSecurityService.GetSebConfig:output_type -> base.SebConfigResponse
+	9,   // 470: base.ExamSecurityService.DeleteSebConfig:output_type -> base.MessageStatusResponse
+	161, // 471: base.ExamSecurityService.ListDeviceLeases:output_type -> base.ListDeviceLeasesResponse
+	163, // 472: base.ExamSecurityService.ApproveDeviceTransfer:output_type -> base.DeviceLeaseResponse
+	167, // 473: base.ExamSecurityService.SetNetworkAllowlist:output_type -> base.NetworkAllowlistResponse
+	167, // 474: base.ExamSecurityService.GetNetworkAllowlist:output_type -> base.NetworkAllowlistResponse
+	169, // 475: base.ExamSecurityService.GrantNetworkOverride:output_type -> base.NetworkOverrideResponse
+	172, // 476: base.ExamSecurityService.ListNetworkAccessDenials:output_type -> base.ListNetworkAccessDenialsResponse
+	177, // 477: base.ExamSecurityService.AnalyzeCollusion:output_type -> base.CollusionReportResponse
+	179, // 478: base.GradingService.RunEssaySimilarityCheck:output_type -> base.EssaySimilarityRunResponse
+	184, // 479: base.GradingService.GetEssayGradingView:output_type -> base.EssayGradingViewResponse
+	190, // 480: base.GradingService.SetEssayRubric:output_type -> base.EssayRubricResponse
+	190, // 481: base.GradingService.GetEssayRubric:output_type -> base.EssayRubricResponse
+	196, // 482: base.GradingService.SetGradingConfig:output_type -> base.GradingConfigResponse
+	196, // 483: base.GradingService.GetGradingConfig:output_type -> base.GradingConfigResponse
+	201, // 484: base.GradingService.ListPendingEssays:output_type -> base.ListPendingEssaysResponse
+	203, // 485: base.GradingService.AssignGraders:output_type -> base.AssignGradersResponse
+	206, // 486: base.GradingService.SubmitEssayMark:output_type -> base.EssayMarkResponse
+	206, // 487: base.GradingService.ResolveModeration:output_type -> base.EssayMarkResponse
+	208, // 488: base.GradingService.GetGradingProgress:output_type -> base.GradingProgressResponse
+	212, // 489: base.GradingService.SetEssayKeywords:output_type -> base.EssayKeywordsResponse
+	212, // 490: base.GradingService.GetEssayKeywords:output_type -> base.EssayKeywordsResponse
+	216, // 491: base.GradingService.GenerateScoreSuggestions:output_type -> base.GenerateScoreSuggestionsResponse
+	218, // 492: base.GradingService.GetSuggestionAgreement:output_type -> base.SuggestionAgreementResponse
+	242, // 493: base.ApiKeyService.CreateApiKey:output_type -> base.CreateApiKeyResponse
+	244, // 494: base.ApiKeyService.ListApiKeys:output_type -> base.ListApiKeysResponse
+	9,   // 495: base.ApiKeyService.RevokeApiKey:output_type -> base.MessageStatusResponse
+	248, // 496: base.LabLoginService.IssueLabCredentials:output_type -> base.IssueLabCredentialsResponse
+	250, // 497: base.LabLoginService.RevokeLabCredentials:output_type -> base.RevokeLabCredentialsResponse
+	252, // 498: base.LabLoginService.LabLogin:output_type -> base.LabLoginResponse
+	255, // 499: base.AuditLogService.ListAuditLogs:output_type -> base.ListAuditLogsResponse
+	395, // [395:500] is the sub-list for method output_type
+	290, // [290:395] is the sub-list for method input_type
+	290, // [290:290] is the sub-list for extension type_name
+	290, // [290:290] is the sub-list for extension extendee
+	0,   // [0:290] is the sub-list for field type_name
 }
 
 func init() { file_cbt_proto_init() }
@@ -10450,10 +21274,10 @@ func file_cbt_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cbt_proto_rawDesc), len(file_cbt_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   129,
+			NumEnums:      9,
+			NumMessages:   253,
 			NumExtensions: 0,
-			NumServices:   11,
+			NumServices:   16,
 		},
 		GoTypes:           file_cbt_proto_goTypes,
 		DependencyIndexes: file_cbt_proto_depIdxs,
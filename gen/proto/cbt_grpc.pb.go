@@ -122,7 +122,11 @@ var Base_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AuthService_GetProfile_FullMethodName = "/base.AuthService/GetProfile"
+	AuthService_GetProfile_FullMethodName     = "/base.AuthService/GetProfile"
+	AuthService_Login_FullMethodName          = "/base.AuthService/Login"
+	AuthService_RefreshToken_FullMethodName   = "/base.AuthService/RefreshToken"
+	AuthService_Logout_FullMethodName         = "/base.AuthService/Logout"
+	AuthService_ChangePassword_FullMethodName = "/base.AuthService/ChangePassword"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -130,6 +134,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthServiceClient interface {
 	GetProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserResponse, error)
+	// Standalone login (AUTH_MODE=local or both) with CBT-signed tokens
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 }
 
 type authServiceClient struct {
@@ -150,11 +159,56 @@ func (c *authServiceClient) GetProfile(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, AuthService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
 type AuthServiceServer interface {
 	GetProfile(context.Context, *emptypb.Empty) (*UserResponse, error)
+	// Standalone login (AUTH_MODE=local or both) with CBT-signed tokens
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *emptypb.Empty) (*MessageStatusResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*MessageStatusResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -168,6 +222,18 @@ type UnimplementedAuthServiceServer struct{}
 func (UnimplementedAuthServiceServer) GetProfile(context.Context, *emptypb.Empty) (*UserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfile not implemented")
 }
+func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *emptypb.Empty) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangePassword not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -207,6 +273,78 @@ func _AuthService_GetProfile_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -218,6 +356,22 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfile",
 			Handler:    _AuthService_GetProfile_Handler,
 		},
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AuthService_ChangePassword_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cbt.proto",
@@ -371,6 +525,9 @@ const (
 	MateriService_UpdateMateri_FullMethodName           = "/base.MateriService/UpdateMateri"
 	MateriService_DeleteMateri_FullMethodName           = "/base.MateriService/DeleteMateri"
 	MateriService_ListMateri_FullMethodName             = "/base.MateriService/ListMateri"
+	MateriService_ShareMateri_FullMethodName            = "/base.MateriService/ShareMateri"
+	MateriService_RevokeMateriShare_FullMethodName      = "/base.MateriService/RevokeMateriShare"
+	MateriService_ListMateriShares_FullMethodName       = "/base.MateriService/ListMateriShares"
 )
 
 // MateriServiceClient is the client API for MateriService service.
@@ -384,6 +541,9 @@ type MateriServiceClient interface {
 	UpdateMateri(ctx context.Context, in *UpdateMateriRequest, opts ...grpc.CallOption) (*MateriResponse, error)
 	DeleteMateri(ctx context.Context, in *DeleteMateriRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 	ListMateri(ctx context.Context, in *ListMateriRequest, opts ...grpc.CallOption) (*ListMateriResponse, error)
+	ShareMateri(ctx context.Context, in *ShareMateriRequest, opts ...grpc.CallOption) (*ShareMateriResponse, error)
+	RevokeMateriShare(ctx context.Context, in *RevokeMateriShareRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	ListMateriShares(ctx context.Context, in *ListMateriSharesRequest, opts ...grpc.CallOption) (*ListMateriSharesResponse, error)
 }
 
 type materiServiceClient struct {
@@ -464,6 +624,36 @@ func (c *materiServiceClient) ListMateri(ctx context.Context, in *ListMateriRequ
 	return out, nil
 }
 
+func (c *materiServiceClient) ShareMateri(ctx context.Context, in *ShareMateriRequest, opts ...grpc.CallOption) (*ShareMateriResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareMateriResponse)
+	err := c.cc.Invoke(ctx, MateriService_ShareMateri_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materiServiceClient) RevokeMateriShare(ctx context.Context, in *RevokeMateriShareRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, MateriService_RevokeMateriShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *materiServiceClient) ListMateriShares(ctx context.Context, in *ListMateriSharesRequest, opts ...grpc.CallOption) (*ListMateriSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMateriSharesResponse)
+	err := c.cc.Invoke(ctx, MateriService_ListMateriShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MateriServiceServer is the server API for MateriService service.
 // All implementations must embed UnimplementedMateriServiceServer
 // for forward compatibility.
@@ -475,6 +665,9 @@ type MateriServiceServer interface {
 	UpdateMateri(context.Context, *UpdateMateriRequest) (*MateriResponse, error)
 	DeleteMateri(context.Context, *DeleteMateriRequest) (*MessageStatusResponse, error)
 	ListMateri(context.Context, *ListMateriRequest) (*ListMateriResponse, error)
+	ShareMateri(context.Context, *ShareMateriRequest) (*ShareMateriResponse, error)
+	RevokeMateriShare(context.Context, *RevokeMateriShareRequest) (*MessageStatusResponse, error)
+	ListMateriShares(context.Context, *ListMateriSharesRequest) (*ListMateriSharesResponse, error)
 	mustEmbedUnimplementedMateriServiceServer()
 }
 
@@ -506,6 +699,15 @@ func (UnimplementedMateriServiceServer) DeleteMateri(context.Context, *DeleteMat
 func (UnimplementedMateriServiceServer) ListMateri(context.Context, *ListMateriRequest) (*ListMateriResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMateri not implemented")
 }
+func (UnimplementedMateriServiceServer) ShareMateri(context.Context, *ShareMateriRequest) (*ShareMateriResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareMateri not implemented")
+}
+func (UnimplementedMateriServiceServer) RevokeMateriShare(context.Context, *RevokeMateriShareRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeMateriShare not implemented")
+}
+func (UnimplementedMateriServiceServer) ListMateriShares(context.Context, *ListMateriSharesRequest) (*ListMateriSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMateriShares not implemented")
+}
 func (UnimplementedMateriServiceServer) mustEmbedUnimplementedMateriServiceServer() {}
 func (UnimplementedMateriServiceServer) testEmbeddedByValue()                       {}
 
@@ -653,6 +855,60 @@ func _MateriService_ListMateri_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MateriService_ShareMateri_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareMateriRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MateriServiceServer).ShareMateri(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MateriService_ShareMateri_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MateriServiceServer).ShareMateri(ctx, req.(*ShareMateriRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MateriService_RevokeMateriShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMateriShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MateriServiceServer).RevokeMateriShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MateriService_RevokeMateriShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MateriServiceServer).RevokeMateriShare(ctx, req.(*RevokeMateriShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MateriService_ListMateriShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMateriSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MateriServiceServer).ListMateriShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MateriService_ListMateriShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MateriServiceServer).ListMateriShares(ctx, req.(*ListMateriSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MateriService_ServiceDesc is the grpc.ServiceDesc for MateriService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -688,6 +944,18 @@ var MateriService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMateri",
 			Handler:    _MateriService_ListMateri_Handler,
 		},
+		{
+			MethodName: "ShareMateri",
+			Handler:    _MateriService_ShareMateri_Handler,
+		},
+		{
+			MethodName: "RevokeMateriShare",
+			Handler:    _MateriService_RevokeMateriShare_Handler,
+		},
+		{
+			MethodName: "ListMateriShares",
+			Handler:    _MateriService_ListMateriShares_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cbt.proto",
@@ -842,6 +1110,9 @@ const (
 	SoalService_UploadImageToSoal_FullMethodName        = "/base.SoalService/UploadImageToSoal"
 	SoalService_DeleteImageFromSoal_FullMethodName      = "/base.SoalService/DeleteImageFromSoal"
 	SoalService_UpdateImageInSoal_FullMethodName        = "/base.SoalService/UpdateImageInSoal"
+	SoalService_UploadMediaToSoal_FullMethodName        = "/base.SoalService/UploadMediaToSoal"
+	SoalService_DeleteMediaFromSoal_FullMethodName      = "/base.SoalService/DeleteMediaFromSoal"
+	SoalService_UpdateMediaInSoal_FullMethodName        = "/base.SoalService/UpdateMediaInSoal"
 	SoalService_GetQuestionCountsByTopic_FullMethodName = "/base.SoalService/GetQuestionCountsByTopic"
 	SoalService_ReorderSoal_FullMethodName              = "/base.SoalService/ReorderSoal"
 )
@@ -858,6 +1129,9 @@ type SoalServiceClient interface {
 	UploadImageToSoal(ctx context.Context, in *UploadImageToSoalRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
 	DeleteImageFromSoal(ctx context.Context, in *DeleteImageFromSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 	UpdateImageInSoal(ctx context.Context, in *UpdateImageInSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	UploadMediaToSoal(ctx context.Context, in *UploadMediaToSoalRequest, opts ...grpc.CallOption) (*UploadMediaResponse, error)
+	DeleteMediaFromSoal(ctx context.Context, in *DeleteMediaFromSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	UpdateMediaInSoal(ctx context.Context, in *UpdateMediaInSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 	GetQuestionCountsByTopic(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuestionCountsResponse, error)
 	ReorderSoal(ctx context.Context, in *ReorderSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 }
@@ -950,6 +1224,36 @@ func (c *soalServiceClient) UpdateImageInSoal(ctx context.Context, in *UpdateIma
 	return out, nil
 }
 
+func (c *soalServiceClient) UploadMediaToSoal(ctx context.Context, in *UploadMediaToSoalRequest, opts ...grpc.CallOption) (*UploadMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadMediaResponse)
+	err := c.cc.Invoke(ctx, SoalService_UploadMediaToSoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *soalServiceClient) DeleteMediaFromSoal(ctx context.Context, in *DeleteMediaFromSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, SoalService_DeleteMediaFromSoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *soalServiceClient) UpdateMediaInSoal(ctx context.Context, in *UpdateMediaInSoalRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, SoalService_UpdateMediaInSoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *soalServiceClient) GetQuestionCountsByTopic(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuestionCountsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QuestionCountsResponse)
@@ -982,6 +1286,9 @@ type SoalServiceServer interface {
 	UploadImageToSoal(context.Context, *UploadImageToSoalRequest) (*UploadImageResponse, error)
 	DeleteImageFromSoal(context.Context, *DeleteImageFromSoalRequest) (*MessageStatusResponse, error)
 	UpdateImageInSoal(context.Context, *UpdateImageInSoalRequest) (*MessageStatusResponse, error)
+	UploadMediaToSoal(context.Context, *UploadMediaToSoalRequest) (*UploadMediaResponse, error)
+	DeleteMediaFromSoal(context.Context, *DeleteMediaFromSoalRequest) (*MessageStatusResponse, error)
+	UpdateMediaInSoal(context.Context, *UpdateMediaInSoalRequest) (*MessageStatusResponse, error)
 	GetQuestionCountsByTopic(context.Context, *emptypb.Empty) (*QuestionCountsResponse, error)
 	ReorderSoal(context.Context, *ReorderSoalRequest) (*MessageStatusResponse, error)
 	mustEmbedUnimplementedSoalServiceServer()
@@ -1018,6 +1325,15 @@ func (UnimplementedSoalServiceServer) DeleteImageFromSoal(context.Context, *Dele
 func (UnimplementedSoalServiceServer) UpdateImageInSoal(context.Context, *UpdateImageInSoalRequest) (*MessageStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateImageInSoal not implemented")
 }
+func (UnimplementedSoalServiceServer) UploadMediaToSoal(context.Context, *UploadMediaToSoalRequest) (*UploadMediaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadMediaToSoal not implemented")
+}
+func (UnimplementedSoalServiceServer) DeleteMediaFromSoal(context.Context, *DeleteMediaFromSoalRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMediaFromSoal not implemented")
+}
+func (UnimplementedSoalServiceServer) UpdateMediaInSoal(context.Context, *UpdateMediaInSoalRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMediaInSoal not implemented")
+}
 func (UnimplementedSoalServiceServer) GetQuestionCountsByTopic(context.Context, *emptypb.Empty) (*QuestionCountsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetQuestionCountsByTopic not implemented")
 }
@@ -1189,6 +1505,60 @@ func _SoalService_UpdateImageInSoal_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SoalService_UploadMediaToSoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMediaToSoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SoalServiceServer).UploadMediaToSoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SoalService_UploadMediaToSoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SoalServiceServer).UploadMediaToSoal(ctx, req.(*UploadMediaToSoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SoalService_DeleteMediaFromSoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMediaFromSoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SoalServiceServer).DeleteMediaFromSoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SoalService_DeleteMediaFromSoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SoalServiceServer).DeleteMediaFromSoal(ctx, req.(*DeleteMediaFromSoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SoalService_UpdateMediaInSoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMediaInSoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SoalServiceServer).UpdateMediaInSoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SoalService_UpdateMediaInSoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SoalServiceServer).UpdateMediaInSoal(ctx, req.(*UpdateMediaInSoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SoalService_GetQuestionCountsByTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1264,6 +1634,18 @@ var SoalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateImageInSoal",
 			Handler:    _SoalService_UpdateImageInSoal_Handler,
 		},
+		{
+			MethodName: "UploadMediaToSoal",
+			Handler:    _SoalService_UploadMediaToSoal_Handler,
+		},
+		{
+			MethodName: "DeleteMediaFromSoal",
+			Handler:    _SoalService_DeleteMediaFromSoal_Handler,
+		},
+		{
+			MethodName: "UpdateMediaInSoal",
+			Handler:    _SoalService_UpdateMediaInSoal_Handler,
+		},
 		{
 			MethodName: "GetQuestionCountsByTopic",
 			Handler:    _SoalService_GetQuestionCountsByTopic_Handler,
@@ -1575,10 +1957,16 @@ const (
 	TestSessionService_GetTestQuestions_FullMethodName        = "/base.TestSessionService/GetTestQuestions"
 	TestSessionService_SubmitAnswer_FullMethodName            = "/base.TestSessionService/SubmitAnswer"
 	TestSessionService_SubmitComplexAnswer_FullMethodName     = "/base.TestSessionService/SubmitComplexAnswer"
+	TestSessionService_SubmitShortAnswer_FullMethodName       = "/base.TestSessionService/SubmitShortAnswer"
+	TestSessionService_SubmitNumericAnswer_FullMethodName     = "/base.TestSessionService/SubmitNumericAnswer"
+	TestSessionService_SubmitHotspotAnswer_FullMethodName     = "/base.TestSessionService/SubmitHotspotAnswer"
+	TestSessionService_SubmitGridAnswer_FullMethodName        = "/base.TestSessionService/SubmitGridAnswer"
 	TestSessionService_SubmitDragDropAnswer_FullMethodName    = "/base.TestSessionService/SubmitDragDropAnswer"
 	TestSessionService_SubmitEssayAnswer_FullMethodName       = "/base.TestSessionService/SubmitEssayAnswer"
 	TestSessionService_ClearAnswer_FullMethodName             = "/base.TestSessionService/ClearAnswer"
 	TestSessionService_CompleteSession_FullMethodName         = "/base.TestSessionService/CompleteSession"
+	TestSessionService_RecordMediaPlay_FullMethodName         = "/base.TestSessionService/RecordMediaPlay"
+	TestSessionService_GetMediaStreamSource_FullMethodName    = "/base.TestSessionService/GetMediaStreamSource"
 	TestSessionService_GetTestResult_FullMethodName           = "/base.TestSessionService/GetTestResult"
 	TestSessionService_GradeEssayAnswer_FullMethodName        = "/base.TestSessionService/GradeEssayAnswer"
 	TestSessionService_ListMyScheduledSessions_FullMethodName = "/base.TestSessionService/ListMyScheduledSessions"
@@ -1597,10 +1985,18 @@ type TestSessionServiceClient interface {
 	GetTestQuestions(ctx context.Context, in *GetTestQuestionsRequest, opts ...grpc.CallOption) (*TestQuestionsResponse, error)
 	SubmitAnswer(ctx context.Context, in *SubmitAnswerRequest, opts ...grpc.CallOption) (*SubmitAnswerResponse, error)
 	SubmitComplexAnswer(ctx context.Context, in *SubmitComplexAnswerRequest, opts ...grpc.CallOption) (*SubmitComplexAnswerResponse, error)
+	SubmitShortAnswer(ctx context.Context, in *SubmitShortAnswerRequest, opts ...grpc.CallOption) (*SubmitShortAnswerResponse, error)
+	SubmitNumericAnswer(ctx context.Context, in *SubmitNumericAnswerRequest, opts ...grpc.CallOption) (*SubmitNumericAnswerResponse, error)
+	SubmitHotspotAnswer(ctx context.Context, in *SubmitHotspotAnswerRequest, opts ...grpc.CallOption) (*SubmitHotspotAnswerResponse, error)
+	SubmitGridAnswer(ctx context.Context, in *SubmitGridAnswerRequest, opts ...grpc.CallOption) (*SubmitGridAnswerResponse, error)
 	SubmitDragDropAnswer(ctx context.Context, in *SubmitDragDropAnswerRequest, opts ...grpc.CallOption) (*SubmitDragDropAnswerResponse, error)
 	SubmitEssayAnswer(ctx context.Context, in *SubmitEssayAnswerRequest, opts ...grpc.CallOption) (*SubmitEssayAnswerResponse, error)
 	ClearAnswer(ctx context.Context, in *ClearAnswerRequest, opts ...grpc.CallOption) (*ClearAnswerResponse, error)
 	CompleteSession(ctx context.Context, in *CompleteSessionRequest, opts ...grpc.CallOption) (*TestSessionResponse, error)
+	// Listening media: a play is recorded before the clip is streamed through the gateway.
+	// GetMediaStreamSource serves only the gateway's stream endpoint and refuses other callers.
+	RecordMediaPlay(ctx context.Context, in *RecordMediaPlayRequest, opts ...grpc.CallOption) (*RecordMediaPlayResponse, error)
+	GetMediaStreamSource(ctx context.Context, in *GetMediaStreamSourceRequest, opts ...grpc.CallOption) (*GetMediaStreamSourceResponse, error)
 	// Results & review
 	GetTestResult(ctx context.Context, in *GetTestResultRequest, opts ...grpc.CallOption) (*TestResultResponse, error)
 	GradeEssayAnswer(ctx context.Context, in *GradeEssayAnswerRequest, opts ...grpc.CallOption) (*GradeEssayAnswerResponse, error)
@@ -1669,57 +2065,117 @@ func (c *testSessionServiceClient) SubmitComplexAnswer(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *testSessionServiceClient) SubmitDragDropAnswer(ctx context.Context, in *SubmitDragDropAnswerRequest, opts ...grpc.CallOption) (*SubmitDragDropAnswerResponse, error) {
+func (c *testSessionServiceClient) SubmitShortAnswer(ctx context.Context, in *SubmitShortAnswerRequest, opts ...grpc.CallOption) (*SubmitShortAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SubmitDragDropAnswerResponse)
-	err := c.cc.Invoke(ctx, TestSessionService_SubmitDragDropAnswer_FullMethodName, in, out, cOpts...)
+	out := new(SubmitShortAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_SubmitShortAnswer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *testSessionServiceClient) SubmitEssayAnswer(ctx context.Context, in *SubmitEssayAnswerRequest, opts ...grpc.CallOption) (*SubmitEssayAnswerResponse, error) {
+func (c *testSessionServiceClient) SubmitNumericAnswer(ctx context.Context, in *SubmitNumericAnswerRequest, opts ...grpc.CallOption) (*SubmitNumericAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SubmitEssayAnswerResponse)
-	err := c.cc.Invoke(ctx, TestSessionService_SubmitEssayAnswer_FullMethodName, in, out, cOpts...)
+	out := new(SubmitNumericAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_SubmitNumericAnswer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *testSessionServiceClient) ClearAnswer(ctx context.Context, in *ClearAnswerRequest, opts ...grpc.CallOption) (*ClearAnswerResponse, error) {
+func (c *testSessionServiceClient) SubmitHotspotAnswer(ctx context.Context, in *SubmitHotspotAnswerRequest, opts ...grpc.CallOption) (*SubmitHotspotAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ClearAnswerResponse)
-	err := c.cc.Invoke(ctx, TestSessionService_ClearAnswer_FullMethodName, in, out, cOpts...)
+	out := new(SubmitHotspotAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_SubmitHotspotAnswer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *testSessionServiceClient) CompleteSession(ctx context.Context, in *CompleteSessionRequest, opts ...grpc.CallOption) (*TestSessionResponse, error) {
+func (c *testSessionServiceClient) SubmitGridAnswer(ctx context.Context, in *SubmitGridAnswerRequest, opts ...grpc.CallOption) (*SubmitGridAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TestSessionResponse)
-	err := c.cc.Invoke(ctx, TestSessionService_CompleteSession_FullMethodName, in, out, cOpts...)
+	out := new(SubmitGridAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_SubmitGridAnswer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *testSessionServiceClient) GetTestResult(ctx context.Context, in *GetTestResultRequest, opts ...grpc.CallOption) (*TestResultResponse, error) {
+func (c *testSessionServiceClient) SubmitDragDropAnswer(ctx context.Context, in *SubmitDragDropAnswerRequest, opts ...grpc.CallOption) (*SubmitDragDropAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(TestResultResponse)
-	err := c.cc.Invoke(ctx, TestSessionService_GetTestResult_FullMethodName, in, out, cOpts...)
+	out := new(SubmitDragDropAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_SubmitDragDropAnswer_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *testSessionServiceClient) GradeEssayAnswer(ctx context.Context, in *GradeEssayAnswerRequest, opts ...grpc.CallOption) (*GradeEssayAnswerResponse, error) {
+func (c *testSessionServiceClient) SubmitEssayAnswer(ctx context.Context, in *SubmitEssayAnswerRequest, opts ...grpc.CallOption) (*SubmitEssayAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitEssayAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_SubmitEssayAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSessionServiceClient) ClearAnswer(ctx context.Context, in *ClearAnswerRequest, opts ...grpc.CallOption) (*ClearAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearAnswerResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_ClearAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSessionServiceClient) CompleteSession(ctx context.Context, in *CompleteSessionRequest, opts ...grpc.CallOption) (*TestSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestSessionResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_CompleteSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSessionServiceClient) RecordMediaPlay(ctx context.Context, in *RecordMediaPlayRequest, opts ...grpc.CallOption) (*RecordMediaPlayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordMediaPlayResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_RecordMediaPlay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSessionServiceClient) GetMediaStreamSource(ctx context.Context, in *GetMediaStreamSourceRequest, opts ...grpc.CallOption) (*GetMediaStreamSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMediaStreamSourceResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_GetMediaStreamSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSessionServiceClient) GetTestResult(ctx context.Context, in *GetTestResultRequest, opts ...grpc.CallOption) (*TestResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestResultResponse)
+	err := c.cc.Invoke(ctx, TestSessionService_GetTestResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *testSessionServiceClient) GradeEssayAnswer(ctx context.Context, in *GradeEssayAnswerRequest, opts ...grpc.CallOption) (*GradeEssayAnswerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GradeEssayAnswerResponse)
 	err := c.cc.Invoke(ctx, TestSessionService_GradeEssayAnswer_FullMethodName, in, out, cOpts...)
@@ -1770,10 +2226,18 @@ type TestSessionServiceServer interface {
 	GetTestQuestions(context.Context, *GetTestQuestionsRequest) (*TestQuestionsResponse, error)
 	SubmitAnswer(context.Context, *SubmitAnswerRequest) (*SubmitAnswerResponse, error)
 	SubmitComplexAnswer(context.Context, *SubmitComplexAnswerRequest) (*SubmitComplexAnswerResponse, error)
+	SubmitShortAnswer(context.Context, *SubmitShortAnswerRequest) (*SubmitShortAnswerResponse, error)
+	SubmitNumericAnswer(context.Context, *SubmitNumericAnswerRequest) (*SubmitNumericAnswerResponse, error)
+	SubmitHotspotAnswer(context.Context, *SubmitHotspotAnswerRequest) (*SubmitHotspotAnswerResponse, error)
+	SubmitGridAnswer(context.Context, *SubmitGridAnswerRequest) (*SubmitGridAnswerResponse, error)
 	SubmitDragDropAnswer(context.Context, *SubmitDragDropAnswerRequest) (*SubmitDragDropAnswerResponse, error)
 	SubmitEssayAnswer(context.Context, *SubmitEssayAnswerRequest) (*SubmitEssayAnswerResponse, error)
 	ClearAnswer(context.Context, *ClearAnswerRequest) (*ClearAnswerResponse, error)
 	CompleteSession(context.Context, *CompleteSessionRequest) (*TestSessionResponse, error)
+	// Listening media: a play is recorded before the clip is streamed through the gateway.
+	// GetMediaStreamSource serves only the gateway's stream endpoint and refuses other callers.
+	RecordMediaPlay(context.Context, *RecordMediaPlayRequest) (*RecordMediaPlayResponse, error)
+	GetMediaStreamSource(context.Context, *GetMediaStreamSourceRequest) (*GetMediaStreamSourceResponse, error)
 	// Results & review
 	GetTestResult(context.Context, *GetTestResultRequest) (*TestResultResponse, error)
 	GradeEssayAnswer(context.Context, *GradeEssayAnswerRequest) (*GradeEssayAnswerResponse, error)
@@ -1807,6 +2271,18 @@ func (UnimplementedTestSessionServiceServer) SubmitAnswer(context.Context, *Subm
 func (UnimplementedTestSessionServiceServer) SubmitComplexAnswer(context.Context, *SubmitComplexAnswerRequest) (*SubmitComplexAnswerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitComplexAnswer not implemented")
 }
+func (UnimplementedTestSessionServiceServer) SubmitShortAnswer(context.Context, *SubmitShortAnswerRequest) (*SubmitShortAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitShortAnswer not implemented")
+}
+func (UnimplementedTestSessionServiceServer) SubmitNumericAnswer(context.Context, *SubmitNumericAnswerRequest) (*SubmitNumericAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitNumericAnswer not implemented")
+}
+func (UnimplementedTestSessionServiceServer) SubmitHotspotAnswer(context.Context, *SubmitHotspotAnswerRequest) (*SubmitHotspotAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitHotspotAnswer not implemented")
+}
+func (UnimplementedTestSessionServiceServer) SubmitGridAnswer(context.Context, *SubmitGridAnswerRequest) (*SubmitGridAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitGridAnswer not implemented")
+}
 func (UnimplementedTestSessionServiceServer) SubmitDragDropAnswer(context.Context, *SubmitDragDropAnswerRequest) (*SubmitDragDropAnswerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SubmitDragDropAnswer not implemented")
 }
@@ -1819,6 +2295,12 @@ func (UnimplementedTestSessionServiceServer) ClearAnswer(context.Context, *Clear
 func (UnimplementedTestSessionServiceServer) CompleteSession(context.Context, *CompleteSessionRequest) (*TestSessionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteSession not implemented")
 }
+func (UnimplementedTestSessionServiceServer) RecordMediaPlay(context.Context, *RecordMediaPlayRequest) (*RecordMediaPlayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordMediaPlay not implemented")
+}
+func (UnimplementedTestSessionServiceServer) GetMediaStreamSource(context.Context, *GetMediaStreamSourceRequest) (*GetMediaStreamSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMediaStreamSource not implemented")
+}
 func (UnimplementedTestSessionServiceServer) GetTestResult(context.Context, *GetTestResultRequest) (*TestResultResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTestResult not implemented")
 }
@@ -1945,6 +2427,78 @@ func _TestSessionService_SubmitComplexAnswer_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TestSessionService_SubmitShortAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitShortAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSessionServiceServer).SubmitShortAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TestSessionService_SubmitShortAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSessionServiceServer).SubmitShortAnswer(ctx, req.(*SubmitShortAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSessionService_SubmitNumericAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitNumericAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSessionServiceServer).SubmitNumericAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TestSessionService_SubmitNumericAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSessionServiceServer).SubmitNumericAnswer(ctx, req.(*SubmitNumericAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSessionService_SubmitHotspotAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitHotspotAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSessionServiceServer).SubmitHotspotAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TestSessionService_SubmitHotspotAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSessionServiceServer).SubmitHotspotAnswer(ctx, req.(*SubmitHotspotAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSessionService_SubmitGridAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitGridAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSessionServiceServer).SubmitGridAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TestSessionService_SubmitGridAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSessionServiceServer).SubmitGridAnswer(ctx, req.(*SubmitGridAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TestSessionService_SubmitDragDropAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitDragDropAnswerRequest)
 	if err := dec(in); err != nil {
@@ -2017,6 +2571,42 @@ func _TestSessionService_CompleteSession_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TestSessionService_RecordMediaPlay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMediaPlayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSessionServiceServer).RecordMediaPlay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TestSessionService_RecordMediaPlay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSessionServiceServer).RecordMediaPlay(ctx, req.(*RecordMediaPlayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TestSessionService_GetMediaStreamSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMediaStreamSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TestSessionServiceServer).GetMediaStreamSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TestSessionService_GetMediaStreamSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TestSessionServiceServer).GetMediaStreamSource(ctx, req.(*GetMediaStreamSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TestSessionService_GetTestResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTestResultRequest)
 	if err := dec(in); err != nil {
@@ -2134,6 +2724,22 @@ var TestSessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitComplexAnswer",
 			Handler:    _TestSessionService_SubmitComplexAnswer_Handler,
 		},
+		{
+			MethodName: "SubmitShortAnswer",
+			Handler:    _TestSessionService_SubmitShortAnswer_Handler,
+		},
+		{
+			MethodName: "SubmitNumericAnswer",
+			Handler:    _TestSessionService_SubmitNumericAnswer_Handler,
+		},
+		{
+			MethodName: "SubmitHotspotAnswer",
+			Handler:    _TestSessionService_SubmitHotspotAnswer_Handler,
+		},
+		{
+			MethodName: "SubmitGridAnswer",
+			Handler:    _TestSessionService_SubmitGridAnswer_Handler,
+		},
 		{
 			MethodName: "SubmitDragDropAnswer",
 			Handler:    _TestSessionService_SubmitDragDropAnswer_Handler,
@@ -2150,6 +2756,14 @@ var TestSessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteSession",
 			Handler:    _TestSessionService_CompleteSession_Handler,
 		},
+		{
+			MethodName: "RecordMediaPlay",
+			Handler:    _TestSessionService_RecordMediaPlay_Handler,
+		},
+		{
+			MethodName: "GetMediaStreamSource",
+			Handler:    _TestSessionService_GetMediaStreamSource_Handler,
+		},
 		{
 			MethodName: "GetTestResult",
 			Handler:    _TestSessionService_GetTestResult_Handler,
@@ -2176,8 +2790,9 @@ var TestSessionService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	HistoryService_GetStudentHistory_FullMethodName = "/base.HistoryService/GetStudentHistory"
-	HistoryService_GetHistoryDetail_FullMethodName  = "/base.HistoryService/GetHistoryDetail"
+	HistoryService_GetStudentHistory_FullMethodName    = "/base.HistoryService/GetStudentHistory"
+	HistoryService_GetHistoryDetail_FullMethodName     = "/base.HistoryService/GetHistoryDetail"
+	HistoryService_ListStudentHistories_FullMethodName = "/base.HistoryService/ListStudentHistories"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -2186,6 +2801,8 @@ const (
 type HistoryServiceClient interface {
 	GetStudentHistory(ctx context.Context, in *StudentHistoryRequest, opts ...grpc.CallOption) (*StudentHistoryResponse, error)
 	GetHistoryDetail(ctx context.Context, in *GetHistoryDetailRequest, opts ...grpc.CallOption) (*HistoryDetailResponse, error)
+	// Staff: histories per student; teachers only see the classes they teach
+	ListStudentHistories(ctx context.Context, in *ListStudentHistoriesRequest, opts ...grpc.CallOption) (*ListStudentHistoriesResponse, error)
 }
 
 type historyServiceClient struct {
@@ -2216,12 +2833,24 @@ func (c *historyServiceClient) GetHistoryDetail(ctx context.Context, in *GetHist
 	return out, nil
 }
 
+func (c *historyServiceClient) ListStudentHistories(ctx context.Context, in *ListStudentHistoriesRequest, opts ...grpc.CallOption) (*ListStudentHistoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentHistoriesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ListStudentHistories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility.
 type HistoryServiceServer interface {
 	GetStudentHistory(context.Context, *StudentHistoryRequest) (*StudentHistoryResponse, error)
 	GetHistoryDetail(context.Context, *GetHistoryDetailRequest) (*HistoryDetailResponse, error)
+	// Staff: histories per student; teachers only see the classes they teach
+	ListStudentHistories(context.Context, *ListStudentHistoriesRequest) (*ListStudentHistoriesResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -2238,6 +2867,9 @@ func (UnimplementedHistoryServiceServer) GetStudentHistory(context.Context, *Stu
 func (UnimplementedHistoryServiceServer) GetHistoryDetail(context.Context, *GetHistoryDetailRequest) (*HistoryDetailResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetHistoryDetail not implemented")
 }
+func (UnimplementedHistoryServiceServer) ListStudentHistories(context.Context, *ListStudentHistoriesRequest) (*ListStudentHistoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStudentHistories not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 func (UnimplementedHistoryServiceServer) testEmbeddedByValue()                        {}
 
@@ -2295,6 +2927,24 @@ func _HistoryService_GetHistoryDetail_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ListStudentHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListStudentHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ListStudentHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListStudentHistories(ctx, req.(*ListStudentHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2310,6 +2960,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistoryDetail",
 			Handler:    _HistoryService_GetHistoryDetail_Handler,
 		},
+		{
+			MethodName: "ListStudentHistories",
+			Handler:    _HistoryService_ListStudentHistories_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cbt.proto",
@@ -2320,6 +2974,11 @@ const (
 	UserLimitService_SetUserLimit_FullMethodName             = "/base.UserLimitService/SetUserLimit"
 	UserLimitService_ResetUserLimit_FullMethodName           = "/base.UserLimitService/ResetUserLimit"
 	UserLimitService_GetUserLimitUsageHistory_FullMethodName = "/base.UserLimitService/GetUserLimitUsageHistory"
+	UserLimitService_ListRateLimitPolicies_FullMethodName    = "/base.UserLimitService/ListRateLimitPolicies"
+	UserLimitService_SaveRateLimitPolicy_FullMethodName      = "/base.UserLimitService/SaveRateLimitPolicy"
+	UserLimitService_DeleteRateLimitPolicy_FullMethodName    = "/base.UserLimitService/DeleteRateLimitPolicy"
+	UserLimitService_SetRateLimitMethodGroup_FullMethodName  = "/base.UserLimitService/SetRateLimitMethodGroup"
+	UserLimitService_SetSchoolPlan_FullMethodName            = "/base.UserLimitService/SetSchoolPlan"
 )
 
 // UserLimitServiceClient is the client API for UserLimitService service.
@@ -2330,6 +2989,13 @@ type UserLimitServiceClient interface {
 	SetUserLimit(ctx context.Context, in *SetUserLimitRequest, opts ...grpc.CallOption) (*UserLimitResponse, error)
 	ResetUserLimit(ctx context.Context, in *ResetUserLimitRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 	GetUserLimitUsageHistory(ctx context.Context, in *GetUserLimitUsageHistoryRequest, opts ...grpc.CallOption) (*GetUserLimitUsageHistoryResponse, error)
+	// Rate limit policies per role, school plan, school and method group
+	ListRateLimitPolicies(ctx context.Context, in *ListRateLimitPoliciesRequest, opts ...grpc.CallOption) (*ListRateLimitPoliciesResponse, error)
+	// Changes apply to every school and are refused for school admins
+	SaveRateLimitPolicy(ctx context.Context, in *SaveRateLimitPolicyRequest, opts ...grpc.CallOption) (*RateLimitPolicyResponse, error)
+	DeleteRateLimitPolicy(ctx context.Context, in *DeleteRateLimitPolicyRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	SetRateLimitMethodGroup(ctx context.Context, in *SetRateLimitMethodGroupRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	SetSchoolPlan(ctx context.Context, in *SetSchoolPlanRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
 }
 
 type userLimitServiceClient struct {
@@ -2380,6 +3046,56 @@ func (c *userLimitServiceClient) GetUserLimitUsageHistory(ctx context.Context, i
 	return out, nil
 }
 
+func (c *userLimitServiceClient) ListRateLimitPolicies(ctx context.Context, in *ListRateLimitPoliciesRequest, opts ...grpc.CallOption) (*ListRateLimitPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRateLimitPoliciesResponse)
+	err := c.cc.Invoke(ctx, UserLimitService_ListRateLimitPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userLimitServiceClient) SaveRateLimitPolicy(ctx context.Context, in *SaveRateLimitPolicyRequest, opts ...grpc.CallOption) (*RateLimitPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateLimitPolicyResponse)
+	err := c.cc.Invoke(ctx, UserLimitService_SaveRateLimitPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userLimitServiceClient) DeleteRateLimitPolicy(ctx context.Context, in *DeleteRateLimitPolicyRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, UserLimitService_DeleteRateLimitPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userLimitServiceClient) SetRateLimitMethodGroup(ctx context.Context, in *SetRateLimitMethodGroupRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, UserLimitService_SetRateLimitMethodGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userLimitServiceClient) SetSchoolPlan(ctx context.Context, in *SetSchoolPlanRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, UserLimitService_SetSchoolPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserLimitServiceServer is the server API for UserLimitService service.
 // All implementations must embed UnimplementedUserLimitServiceServer
 // for forward compatibility.
@@ -2388,6 +3104,13 @@ type UserLimitServiceServer interface {
 	SetUserLimit(context.Context, *SetUserLimitRequest) (*UserLimitResponse, error)
 	ResetUserLimit(context.Context, *ResetUserLimitRequest) (*MessageStatusResponse, error)
 	GetUserLimitUsageHistory(context.Context, *GetUserLimitUsageHistoryRequest) (*GetUserLimitUsageHistoryResponse, error)
+	// Rate limit policies per role, school plan, school and method group
+	ListRateLimitPolicies(context.Context, *ListRateLimitPoliciesRequest) (*ListRateLimitPoliciesResponse, error)
+	// Changes apply to every school and are refused for school admins
+	SaveRateLimitPolicy(context.Context, *SaveRateLimitPolicyRequest) (*RateLimitPolicyResponse, error)
+	DeleteRateLimitPolicy(context.Context, *DeleteRateLimitPolicyRequest) (*MessageStatusResponse, error)
+	SetRateLimitMethodGroup(context.Context, *SetRateLimitMethodGroupRequest) (*MessageStatusResponse, error)
+	SetSchoolPlan(context.Context, *SetSchoolPlanRequest) (*MessageStatusResponse, error)
 	mustEmbedUnimplementedUserLimitServiceServer()
 }
 
@@ -2410,6 +3133,21 @@ func (UnimplementedUserLimitServiceServer) ResetUserLimit(context.Context, *Rese
 func (UnimplementedUserLimitServiceServer) GetUserLimitUsageHistory(context.Context, *GetUserLimitUsageHistoryRequest) (*GetUserLimitUsageHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserLimitUsageHistory not implemented")
 }
+func (UnimplementedUserLimitServiceServer) ListRateLimitPolicies(context.Context, *ListRateLimitPoliciesRequest) (*ListRateLimitPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRateLimitPolicies not implemented")
+}
+func (UnimplementedUserLimitServiceServer) SaveRateLimitPolicy(context.Context, *SaveRateLimitPolicyRequest) (*RateLimitPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveRateLimitPolicy not implemented")
+}
+func (UnimplementedUserLimitServiceServer) DeleteRateLimitPolicy(context.Context, *DeleteRateLimitPolicyRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRateLimitPolicy not implemented")
+}
+func (UnimplementedUserLimitServiceServer) SetRateLimitMethodGroup(context.Context, *SetRateLimitMethodGroupRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRateLimitMethodGroup not implemented")
+}
+func (UnimplementedUserLimitServiceServer) SetSchoolPlan(context.Context, *SetSchoolPlanRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSchoolPlan not implemented")
+}
 func (UnimplementedUserLimitServiceServer) mustEmbedUnimplementedUserLimitServiceServer() {}
 func (UnimplementedUserLimitServiceServer) testEmbeddedByValue()                          {}
 
@@ -2503,37 +3241,147 @@ func _UserLimitService_GetUserLimitUsageHistory_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserLimitService_ServiceDesc is the grpc.ServiceDesc for UserLimitService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserLimitService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "base.UserLimitService",
-	HandlerType: (*UserLimitServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetUserLimits",
-			Handler:    _UserLimitService_GetUserLimits_Handler,
-		},
-		{
-			MethodName: "SetUserLimit",
-			Handler:    _UserLimitService_SetUserLimit_Handler,
-		},
-		{
-			MethodName: "ResetUserLimit",
-			Handler:    _UserLimitService_ResetUserLimit_Handler,
-		},
-		{
-			MethodName: "GetUserLimitUsageHistory",
-			Handler:    _UserLimitService_GetUserLimitUsageHistory_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cbt.proto",
+func _UserLimitService_ListRateLimitPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRateLimitPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserLimitServiceServer).ListRateLimitPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserLimitService_ListRateLimitPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserLimitServiceServer).ListRateLimitPolicies(ctx, req.(*ListRateLimitPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-const (
-	ClassSyncService_ListClasses_FullMethodName       = "/base.ClassSyncService/ListClasses"
-	ClassSyncService_ListClassStudents_FullMethodName = "/base.ClassSyncService/ListClassStudents"
+func _UserLimitService_SaveRateLimitPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRateLimitPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserLimitServiceServer).SaveRateLimitPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserLimitService_SaveRateLimitPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserLimitServiceServer).SaveRateLimitPolicy(ctx, req.(*SaveRateLimitPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserLimitService_DeleteRateLimitPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateLimitPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserLimitServiceServer).DeleteRateLimitPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserLimitService_DeleteRateLimitPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserLimitServiceServer).DeleteRateLimitPolicy(ctx, req.(*DeleteRateLimitPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserLimitService_SetRateLimitMethodGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRateLimitMethodGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserLimitServiceServer).SetRateLimitMethodGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserLimitService_SetRateLimitMethodGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserLimitServiceServer).SetRateLimitMethodGroup(ctx, req.(*SetRateLimitMethodGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserLimitService_SetSchoolPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSchoolPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserLimitServiceServer).SetSchoolPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserLimitService_SetSchoolPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserLimitServiceServer).SetSchoolPlan(ctx, req.(*SetSchoolPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserLimitService_ServiceDesc is the grpc.ServiceDesc for UserLimitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserLimitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.UserLimitService",
+	HandlerType: (*UserLimitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUserLimits",
+			Handler:    _UserLimitService_GetUserLimits_Handler,
+		},
+		{
+			MethodName: "SetUserLimit",
+			Handler:    _UserLimitService_SetUserLimit_Handler,
+		},
+		{
+			MethodName: "ResetUserLimit",
+			Handler:    _UserLimitService_ResetUserLimit_Handler,
+		},
+		{
+			MethodName: "GetUserLimitUsageHistory",
+			Handler:    _UserLimitService_GetUserLimitUsageHistory_Handler,
+		},
+		{
+			MethodName: "ListRateLimitPolicies",
+			Handler:    _UserLimitService_ListRateLimitPolicies_Handler,
+		},
+		{
+			MethodName: "SaveRateLimitPolicy",
+			Handler:    _UserLimitService_SaveRateLimitPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRateLimitPolicy",
+			Handler:    _UserLimitService_DeleteRateLimitPolicy_Handler,
+		},
+		{
+			MethodName: "SetRateLimitMethodGroup",
+			Handler:    _UserLimitService_SetRateLimitMethodGroup_Handler,
+		},
+		{
+			MethodName: "SetSchoolPlan",
+			Handler:    _UserLimitService_SetSchoolPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbt.proto",
+}
+
+const (
+	ClassSyncService_ListClasses_FullMethodName       = "/base.ClassSyncService/ListClasses"
+	ClassSyncService_ListClassStudents_FullMethodName = "/base.ClassSyncService/ListClassStudents"
 )
 
 // ClassSyncServiceClient is the client API for ClassSyncService service.
@@ -2670,3 +3518,1573 @@ var ClassSyncService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cbt.proto",
 }
+
+const (
+	ExamSecurityService_UploadSebConfig_FullMethodName          = "/base.ExamSecurityService/UploadSebConfig"
+	ExamSecurityService_GetSebConfig_FullMethodName             = "/base.ExamSecurityService/GetSebConfig"
+	ExamSecurityService_DeleteSebConfig_FullMethodName          = "/base.ExamSecurityService/DeleteSebConfig"
+	ExamSecurityService_ListDeviceLeases_FullMethodName         = "/base.ExamSecurityService/ListDeviceLeases"
+	ExamSecurityService_ApproveDeviceTransfer_FullMethodName    = "/base.ExamSecurityService/ApproveDeviceTransfer"
+	ExamSecurityService_SetNetworkAllowlist_FullMethodName      = "/base.ExamSecurityService/SetNetworkAllowlist"
+	ExamSecurityService_GetNetworkAllowlist_FullMethodName      = "/base.ExamSecurityService/GetNetworkAllowlist"
+	ExamSecurityService_GrantNetworkOverride_FullMethodName     = "/base.ExamSecurityService/GrantNetworkOverride"
+	ExamSecurityService_ListNetworkAccessDenials_FullMethodName = "/base.ExamSecurityService/ListNetworkAccessDenials"
+	ExamSecurityService_AnalyzeCollusion_FullMethodName         = "/base.ExamSecurityService/AnalyzeCollusion"
+)
+
+// ExamSecurityServiceClient is the client API for ExamSecurityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExamSecurityServiceClient interface {
+	// Safe Exam Browser configuration per LMS assignment
+	UploadSebConfig(ctx context.Context, in *UploadSebConfigRequest, opts ...grpc.CallOption) (*SebConfigResponse, error)
+	GetSebConfig(ctx context.Context, in *GetSebConfigRequest, opts ...grpc.CallOption) (*SebConfigResponse, error)
+	DeleteSebConfig(ctx context.Context, in *DeleteSebConfigRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+	// Single active device per test session (proctor)
+	ListDeviceLeases(ctx context.Context, in *ListDeviceLeasesRequest, opts ...grpc.CallOption) (*ListDeviceLeasesResponse, error)
+	ApproveDeviceTransfer(ctx context.Context, in *ApproveDeviceTransferRequest, opts ...grpc.CallOption) (*DeviceLeaseResponse, error)
+	// Network allow-lists per school / assignment
+	SetNetworkAllowlist(ctx context.Context, in *SetNetworkAllowlistRequest, opts ...grpc.CallOption) (*NetworkAllowlistResponse, error)
+	GetNetworkAllowlist(ctx context.Context, in *GetNetworkAllowlistRequest, opts ...grpc.CallOption) (*NetworkAllowlistResponse, error)
+	GrantNetworkOverride(ctx context.Context, in *GrantNetworkOverrideRequest, opts ...grpc.CallOption) (*NetworkOverrideResponse, error)
+	ListNetworkAccessDenials(ctx context.Context, in *ListNetworkAccessDenialsRequest, opts ...grpc.CallOption) (*ListNetworkAccessDenialsResponse, error)
+	// Answer-pattern collusion analysis of an assignment
+	AnalyzeCollusion(ctx context.Context, in *AnalyzeCollusionRequest, opts ...grpc.CallOption) (*CollusionReportResponse, error)
+}
+
+type examSecurityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExamSecurityServiceClient(cc grpc.ClientConnInterface) ExamSecurityServiceClient {
+	return &examSecurityServiceClient{cc}
+}
+
+func (c *examSecurityServiceClient) UploadSebConfig(ctx context.Context, in *UploadSebConfigRequest, opts ...grpc.CallOption) (*SebConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SebConfigResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_UploadSebConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) GetSebConfig(ctx context.Context, in *GetSebConfigRequest, opts ...grpc.CallOption) (*SebConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SebConfigResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_GetSebConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) DeleteSebConfig(ctx context.Context, in *DeleteSebConfigRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_DeleteSebConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) ListDeviceLeases(ctx context.Context, in *ListDeviceLeasesRequest, opts ...grpc.CallOption) (*ListDeviceLeasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeviceLeasesResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_ListDeviceLeases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) ApproveDeviceTransfer(ctx context.Context, in *ApproveDeviceTransferRequest, opts ...grpc.CallOption) (*DeviceLeaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeviceLeaseResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_ApproveDeviceTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) SetNetworkAllowlist(ctx context.Context, in *SetNetworkAllowlistRequest, opts ...grpc.CallOption) (*NetworkAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkAllowlistResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_SetNetworkAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) GetNetworkAllowlist(ctx context.Context, in *GetNetworkAllowlistRequest, opts ...grpc.CallOption) (*NetworkAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkAllowlistResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_GetNetworkAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) GrantNetworkOverride(ctx context.Context, in *GrantNetworkOverrideRequest, opts ...grpc.CallOption) (*NetworkOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkOverrideResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_GrantNetworkOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) ListNetworkAccessDenials(ctx context.Context, in *ListNetworkAccessDenialsRequest, opts ...grpc.CallOption) (*ListNetworkAccessDenialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetworkAccessDenialsResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_ListNetworkAccessDenials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *examSecurityServiceClient) AnalyzeCollusion(ctx context.Context, in *AnalyzeCollusionRequest, opts ...grpc.CallOption) (*CollusionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollusionReportResponse)
+	err := c.cc.Invoke(ctx, ExamSecurityService_AnalyzeCollusion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExamSecurityServiceServer is the server API for ExamSecurityService service.
+// All implementations must embed UnimplementedExamSecurityServiceServer
+// for forward compatibility.
+type ExamSecurityServiceServer interface {
+	// Safe Exam Browser configuration per LMS assignment
+	UploadSebConfig(context.Context, *UploadSebConfigRequest) (*SebConfigResponse, error)
+	GetSebConfig(context.Context, *GetSebConfigRequest) (*SebConfigResponse, error)
+	DeleteSebConfig(context.Context, *DeleteSebConfigRequest) (*MessageStatusResponse, error)
+	// Single active device per test session (proctor)
+	ListDeviceLeases(context.Context, *ListDeviceLeasesRequest) (*ListDeviceLeasesResponse, error)
+	ApproveDeviceTransfer(context.Context, *ApproveDeviceTransferRequest) (*DeviceLeaseResponse, error)
+	// Network allow-lists per school / assignment
+	SetNetworkAllowlist(context.Context, *SetNetworkAllowlistRequest) (*NetworkAllowlistResponse, error)
+	GetNetworkAllowlist(context.Context, *GetNetworkAllowlistRequest) (*NetworkAllowlistResponse, error)
+	GrantNetworkOverride(context.Context, *GrantNetworkOverrideRequest) (*NetworkOverrideResponse, error)
+	ListNetworkAccessDenials(context.Context, *ListNetworkAccessDenialsRequest) (*ListNetworkAccessDenialsResponse, error)
+	// Answer-pattern collusion analysis of an assignment
+	AnalyzeCollusion(context.Context, *AnalyzeCollusionRequest) (*CollusionReportResponse, error)
+	mustEmbedUnimplementedExamSecurityServiceServer()
+}
+
+// UnimplementedExamSecurityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExamSecurityServiceServer struct{}
+
+func (UnimplementedExamSecurityServiceServer) UploadSebConfig(context.Context, *UploadSebConfigRequest) (*SebConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadSebConfig not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) GetSebConfig(context.Context, *GetSebConfigRequest) (*SebConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSebConfig not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) DeleteSebConfig(context.Context, *DeleteSebConfigRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSebConfig not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) ListDeviceLeases(context.Context, *ListDeviceLeasesRequest) (*ListDeviceLeasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeviceLeases not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) ApproveDeviceTransfer(context.Context, *ApproveDeviceTransferRequest) (*DeviceLeaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveDeviceTransfer not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) SetNetworkAllowlist(context.Context, *SetNetworkAllowlistRequest) (*NetworkAllowlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNetworkAllowlist not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) GetNetworkAllowlist(context.Context, *GetNetworkAllowlistRequest) (*NetworkAllowlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNetworkAllowlist not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) GrantNetworkOverride(context.Context, *GrantNetworkOverrideRequest) (*NetworkOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantNetworkOverride not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) ListNetworkAccessDenials(context.Context, *ListNetworkAccessDenialsRequest) (*ListNetworkAccessDenialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNetworkAccessDenials not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) AnalyzeCollusion(context.Context, *AnalyzeCollusionRequest) (*CollusionReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeCollusion not implemented")
+}
+func (UnimplementedExamSecurityServiceServer) mustEmbedUnimplementedExamSecurityServiceServer() {}
+func (UnimplementedExamSecurityServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeExamSecurityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExamSecurityServiceServer will
+// result in compilation errors.
+type UnsafeExamSecurityServiceServer interface {
+	mustEmbedUnimplementedExamSecurityServiceServer()
+}
+
+func RegisterExamSecurityServiceServer(s grpc.ServiceRegistrar, srv ExamSecurityServiceServer) {
+	// If the following call panics, it indicates UnimplementedExamSecurityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExamSecurityService_ServiceDesc, srv)
+}
+
+func _ExamSecurityService_UploadSebConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSebConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).UploadSebConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_UploadSebConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).UploadSebConfig(ctx, req.(*UploadSebConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_GetSebConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSebConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).GetSebConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_GetSebConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).GetSebConfig(ctx, req.(*GetSebConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_DeleteSebConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSebConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).DeleteSebConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_DeleteSebConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).DeleteSebConfig(ctx, req.(*DeleteSebConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_ListDeviceLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).ListDeviceLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_ListDeviceLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).ListDeviceLeases(ctx, req.(*ListDeviceLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_ApproveDeviceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeviceTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).ApproveDeviceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_ApproveDeviceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).ApproveDeviceTransfer(ctx, req.(*ApproveDeviceTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_SetNetworkAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNetworkAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).SetNetworkAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_SetNetworkAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).SetNetworkAllowlist(ctx, req.(*SetNetworkAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_GetNetworkAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).GetNetworkAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_GetNetworkAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).GetNetworkAllowlist(ctx, req.(*GetNetworkAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_GrantNetworkOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantNetworkOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).GrantNetworkOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_GrantNetworkOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).GrantNetworkOverride(ctx, req.(*GrantNetworkOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_ListNetworkAccessDenials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworkAccessDenialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).ListNetworkAccessDenials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_ListNetworkAccessDenials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).ListNetworkAccessDenials(ctx, req.(*ListNetworkAccessDenialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExamSecurityService_AnalyzeCollusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeCollusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExamSecurityServiceServer).AnalyzeCollusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExamSecurityService_AnalyzeCollusion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExamSecurityServiceServer).AnalyzeCollusion(ctx, req.(*AnalyzeCollusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExamSecurityService_ServiceDesc is the grpc.ServiceDesc for ExamSecurityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExamSecurityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.ExamSecurityService",
+	HandlerType: (*ExamSecurityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadSebConfig",
+			Handler:    _ExamSecurityService_UploadSebConfig_Handler,
+		},
+		{
+			MethodName: "GetSebConfig",
+			Handler:    _ExamSecurityService_GetSebConfig_Handler,
+		},
+		{
+			MethodName: "DeleteSebConfig",
+			Handler:    _ExamSecurityService_DeleteSebConfig_Handler,
+		},
+		{
+			MethodName: "ListDeviceLeases",
+			Handler:    _ExamSecurityService_ListDeviceLeases_Handler,
+		},
+		{
+			MethodName: "ApproveDeviceTransfer",
+			Handler:    _ExamSecurityService_ApproveDeviceTransfer_Handler,
+		},
+		{
+			MethodName: "SetNetworkAllowlist",
+			Handler:    _ExamSecurityService_SetNetworkAllowlist_Handler,
+		},
+		{
+			MethodName: "GetNetworkAllowlist",
+			Handler:    _ExamSecurityService_GetNetworkAllowlist_Handler,
+		},
+		{
+			MethodName: "GrantNetworkOverride",
+			Handler:    _ExamSecurityService_GrantNetworkOverride_Handler,
+		},
+		{
+			MethodName: "ListNetworkAccessDenials",
+			Handler:    _ExamSecurityService_ListNetworkAccessDenials_Handler,
+		},
+		{
+			MethodName: "AnalyzeCollusion",
+			Handler:    _ExamSecurityService_AnalyzeCollusion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbt.proto",
+}
+
+const (
+	GradingService_RunEssaySimilarityCheck_FullMethodName  = "/base.GradingService/RunEssaySimilarityCheck"
+	GradingService_GetEssayGradingView_FullMethodName      = "/base.GradingService/GetEssayGradingView"
+	GradingService_SetEssayRubric_FullMethodName           = "/base.GradingService/SetEssayRubric"
+	GradingService_GetEssayRubric_FullMethodName           = "/base.GradingService/GetEssayRubric"
+	GradingService_SetGradingConfig_FullMethodName         = "/base.GradingService/SetGradingConfig"
+	GradingService_GetGradingConfig_FullMethodName         = "/base.GradingService/GetGradingConfig"
+	GradingService_ListPendingEssays_FullMethodName        = "/base.GradingService/ListPendingEssays"
+	GradingService_AssignGraders_FullMethodName            = "/base.GradingService/AssignGraders"
+	GradingService_SubmitEssayMark_FullMethodName          = "/base.GradingService/SubmitEssayMark"
+	GradingService_ResolveModeration_FullMethodName        = "/base.GradingService/ResolveModeration"
+	GradingService_GetGradingProgress_FullMethodName       = "/base.GradingService/GetGradingProgress"
+	GradingService_SetEssayKeywords_FullMethodName         = "/base.GradingService/SetEssayKeywords"
+	GradingService_GetEssayKeywords_FullMethodName         = "/base.GradingService/GetEssayKeywords"
+	GradingService_GenerateScoreSuggestions_FullMethodName = "/base.GradingService/GenerateScoreSuggestions"
+	GradingService_GetSuggestionAgreement_FullMethodName   = "/base.GradingService/GetSuggestionAgreement"
+)
+
+// GradingServiceClient is the client API for GradingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GradingServiceClient interface {
+	// Essay similarity / plagiarism check
+	RunEssaySimilarityCheck(ctx context.Context, in *RunEssaySimilarityCheckRequest, opts ...grpc.CallOption) (*EssaySimilarityRunResponse, error)
+	GetEssayGradingView(ctx context.Context, in *GetEssayGradingViewRequest, opts ...grpc.CallOption) (*EssayGradingViewResponse, error)
+	// Essay rubrics per soal
+	SetEssayRubric(ctx context.Context, in *SetEssayRubricRequest, opts ...grpc.CallOption) (*EssayRubricResponse, error)
+	GetEssayRubric(ctx context.Context, in *GetEssayRubricRequest, opts ...grpc.CallOption) (*EssayRubricResponse, error)
+	// Grading queue: blind mode, grader assignment, double marking and moderation
+	SetGradingConfig(ctx context.Context, in *SetGradingConfigRequest, opts ...grpc.CallOption) (*GradingConfigResponse, error)
+	GetGradingConfig(ctx context.Context, in *GetGradingConfigRequest, opts ...grpc.CallOption) (*GradingConfigResponse, error)
+	ListPendingEssays(ctx context.Context, in *ListPendingEssaysRequest, opts ...grpc.CallOption) (*ListPendingEssaysResponse, error)
+	AssignGraders(ctx context.Context, in *AssignGradersRequest, opts ...grpc.CallOption) (*AssignGradersResponse, error)
+	SubmitEssayMark(ctx context.Context, in *SubmitEssayMarkRequest, opts ...grpc.CallOption) (*EssayMarkResponse, error)
+	ResolveModeration(ctx context.Context, in *ResolveModerationRequest, opts ...grpc.CallOption) (*EssayMarkResponse, error)
+	GetGradingProgress(ctx context.Context, in *GetGradingProgressRequest, opts ...grpc.CallOption) (*GradingProgressResponse, error)
+	// Keyword and answer-key score suggestions
+	SetEssayKeywords(ctx context.Context, in *SetEssayKeywordsRequest, opts ...grpc.CallOption) (*EssayKeywordsResponse, error)
+	GetEssayKeywords(ctx context.Context, in *GetEssayKeywordsRequest, opts ...grpc.CallOption) (*EssayKeywordsResponse, error)
+	GenerateScoreSuggestions(ctx context.Context, in *GenerateScoreSuggestionsRequest, opts ...grpc.CallOption) (*GenerateScoreSuggestionsResponse, error)
+	GetSuggestionAgreement(ctx context.Context, in *GetSuggestionAgreementRequest, opts ...grpc.CallOption) (*SuggestionAgreementResponse, error)
+}
+
+type gradingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradingServiceClient(cc grpc.ClientConnInterface) GradingServiceClient {
+	return &gradingServiceClient{cc}
+}
+
+func (c *gradingServiceClient) RunEssaySimilarityCheck(ctx context.Context, in *RunEssaySimilarityCheckRequest, opts ...grpc.CallOption) (*EssaySimilarityRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssaySimilarityRunResponse)
+	err := c.cc.Invoke(ctx, GradingService_RunEssaySimilarityCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetEssayGradingView(ctx context.Context, in *GetEssayGradingViewRequest, opts ...grpc.CallOption) (*EssayGradingViewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayGradingViewResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetEssayGradingView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) SetEssayRubric(ctx context.Context, in *SetEssayRubricRequest, opts ...grpc.CallOption) (*EssayRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayRubricResponse)
+	err := c.cc.Invoke(ctx, GradingService_SetEssayRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetEssayRubric(ctx context.Context, in *GetEssayRubricRequest, opts ...grpc.CallOption) (*EssayRubricResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayRubricResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetEssayRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) SetGradingConfig(ctx context.Context, in *SetGradingConfigRequest, opts ...grpc.CallOption) (*GradingConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingConfigResponse)
+	err := c.cc.Invoke(ctx, GradingService_SetGradingConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetGradingConfig(ctx context.Context, in *GetGradingConfigRequest, opts ...grpc.CallOption) (*GradingConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingConfigResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetGradingConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) ListPendingEssays(ctx context.Context, in *ListPendingEssaysRequest, opts ...grpc.CallOption) (*ListPendingEssaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingEssaysResponse)
+	err := c.cc.Invoke(ctx, GradingService_ListPendingEssays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) AssignGraders(ctx context.Context, in *AssignGradersRequest, opts ...grpc.CallOption) (*AssignGradersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignGradersResponse)
+	err := c.cc.Invoke(ctx, GradingService_AssignGraders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) SubmitEssayMark(ctx context.Context, in *SubmitEssayMarkRequest, opts ...grpc.CallOption) (*EssayMarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayMarkResponse)
+	err := c.cc.Invoke(ctx, GradingService_SubmitEssayMark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) ResolveModeration(ctx context.Context, in *ResolveModerationRequest, opts ...grpc.CallOption) (*EssayMarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayMarkResponse)
+	err := c.cc.Invoke(ctx, GradingService_ResolveModeration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetGradingProgress(ctx context.Context, in *GetGradingProgressRequest, opts ...grpc.CallOption) (*GradingProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingProgressResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetGradingProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) SetEssayKeywords(ctx context.Context, in *SetEssayKeywordsRequest, opts ...grpc.CallOption) (*EssayKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayKeywordsResponse)
+	err := c.cc.Invoke(ctx, GradingService_SetEssayKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetEssayKeywords(ctx context.Context, in *GetEssayKeywordsRequest, opts ...grpc.CallOption) (*EssayKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EssayKeywordsResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetEssayKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GenerateScoreSuggestions(ctx context.Context, in *GenerateScoreSuggestionsRequest, opts ...grpc.CallOption) (*GenerateScoreSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateScoreSuggestionsResponse)
+	err := c.cc.Invoke(ctx, GradingService_GenerateScoreSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetSuggestionAgreement(ctx context.Context, in *GetSuggestionAgreementRequest, opts ...grpc.CallOption) (*SuggestionAgreementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestionAgreementResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetSuggestionAgreement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradingServiceServer is the server API for GradingService service.
+// All implementations must embed UnimplementedGradingServiceServer
+// for forward compatibility.
+type GradingServiceServer interface {
+	// Essay similarity / plagiarism check
+	RunEssaySimilarityCheck(context.Context, *RunEssaySimilarityCheckRequest) (*EssaySimilarityRunResponse, error)
+	GetEssayGradingView(context.Context, *GetEssayGradingViewRequest) (*EssayGradingViewResponse, error)
+	// Essay rubrics per soal
+	SetEssayRubric(context.Context, *SetEssayRubricRequest) (*EssayRubricResponse, error)
+	GetEssayRubric(context.Context, *GetEssayRubricRequest) (*EssayRubricResponse, error)
+	// Grading queue: blind mode, grader assignment, double marking and moderation
+	SetGradingConfig(context.Context, *SetGradingConfigRequest) (*GradingConfigResponse, error)
+	GetGradingConfig(context.Context, *GetGradingConfigRequest) (*GradingConfigResponse, error)
+	ListPendingEssays(context.Context, *ListPendingEssaysRequest) (*ListPendingEssaysResponse, error)
+	AssignGraders(context.Context, *AssignGradersRequest) (*AssignGradersResponse, error)
+	SubmitEssayMark(context.Context, *SubmitEssayMarkRequest) (*EssayMarkResponse, error)
+	ResolveModeration(context.Context, *ResolveModerationRequest) (*EssayMarkResponse, error)
+	GetGradingProgress(context.Context, *GetGradingProgressRequest) (*GradingProgressResponse, error)
+	// Keyword and answer-key score suggestions
+	SetEssayKeywords(context.Context, *SetEssayKeywordsRequest) (*EssayKeywordsResponse, error)
+	GetEssayKeywords(context.Context, *GetEssayKeywordsRequest) (*EssayKeywordsResponse, error)
+	GenerateScoreSuggestions(context.Context, *GenerateScoreSuggestionsRequest) (*GenerateScoreSuggestionsResponse, error)
+	GetSuggestionAgreement(context.Context, *GetSuggestionAgreementRequest) (*SuggestionAgreementResponse, error)
+	mustEmbedUnimplementedGradingServiceServer()
+}
+
+// UnimplementedGradingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradingServiceServer struct{}
+
+func (UnimplementedGradingServiceServer) RunEssaySimilarityCheck(context.Context, *RunEssaySimilarityCheckRequest) (*EssaySimilarityRunResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunEssaySimilarityCheck not implemented")
+}
+func (UnimplementedGradingServiceServer) GetEssayGradingView(context.Context, *GetEssayGradingViewRequest) (*EssayGradingViewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEssayGradingView not implemented")
+}
+func (UnimplementedGradingServiceServer) SetEssayRubric(context.Context, *SetEssayRubricRequest) (*EssayRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEssayRubric not implemented")
+}
+func (UnimplementedGradingServiceServer) GetEssayRubric(context.Context, *GetEssayRubricRequest) (*EssayRubricResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEssayRubric not implemented")
+}
+func (UnimplementedGradingServiceServer) SetGradingConfig(context.Context, *SetGradingConfigRequest) (*GradingConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGradingConfig not implemented")
+}
+func (UnimplementedGradingServiceServer) GetGradingConfig(context.Context, *GetGradingConfigRequest) (*GradingConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGradingConfig not implemented")
+}
+func (UnimplementedGradingServiceServer) ListPendingEssays(context.Context, *ListPendingEssaysRequest) (*ListPendingEssaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingEssays not implemented")
+}
+func (UnimplementedGradingServiceServer) AssignGraders(context.Context, *AssignGradersRequest) (*AssignGradersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignGraders not implemented")
+}
+func (UnimplementedGradingServiceServer) SubmitEssayMark(context.Context, *SubmitEssayMarkRequest) (*EssayMarkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitEssayMark not implemented")
+}
+func (UnimplementedGradingServiceServer) ResolveModeration(context.Context, *ResolveModerationRequest) (*EssayMarkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveModeration not implemented")
+}
+func (UnimplementedGradingServiceServer) GetGradingProgress(context.Context, *GetGradingProgressRequest) (*GradingProgressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGradingProgress not implemented")
+}
+func (UnimplementedGradingServiceServer) SetEssayKeywords(context.Context, *SetEssayKeywordsRequest) (*EssayKeywordsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEssayKeywords not implemented")
+}
+func (UnimplementedGradingServiceServer) GetEssayKeywords(context.Context, *GetEssayKeywordsRequest) (*EssayKeywordsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEssayKeywords not implemented")
+}
+func (UnimplementedGradingServiceServer) GenerateScoreSuggestions(context.Context, *GenerateScoreSuggestionsRequest) (*GenerateScoreSuggestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateScoreSuggestions not implemented")
+}
+func (UnimplementedGradingServiceServer) GetSuggestionAgreement(context.Context, *GetSuggestionAgreementRequest) (*SuggestionAgreementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSuggestionAgreement not implemented")
+}
+func (UnimplementedGradingServiceServer) mustEmbedUnimplementedGradingServiceServer() {}
+func (UnimplementedGradingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeGradingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradingServiceServer will
+// result in compilation errors.
+type UnsafeGradingServiceServer interface {
+	mustEmbedUnimplementedGradingServiceServer()
+}
+
+func RegisterGradingServiceServer(s grpc.ServiceRegistrar, srv GradingServiceServer) {
+	// If the following call panics, it indicates UnimplementedGradingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradingService_ServiceDesc, srv)
+}
+
+func _GradingService_RunEssaySimilarityCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunEssaySimilarityCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).RunEssaySimilarityCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_RunEssaySimilarityCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).RunEssaySimilarityCheck(ctx, req.(*RunEssaySimilarityCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetEssayGradingView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEssayGradingViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetEssayGradingView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetEssayGradingView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetEssayGradingView(ctx, req.(*GetEssayGradingViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_SetEssayRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEssayRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).SetEssayRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_SetEssayRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).SetEssayRubric(ctx, req.(*SetEssayRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetEssayRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEssayRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetEssayRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetEssayRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetEssayRubric(ctx, req.(*GetEssayRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_SetGradingConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradingConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).SetGradingConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_SetGradingConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).SetGradingConfig(ctx, req.(*SetGradingConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetGradingConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetGradingConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetGradingConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetGradingConfig(ctx, req.(*GetGradingConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_ListPendingEssays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingEssaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).ListPendingEssays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_ListPendingEssays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).ListPendingEssays(ctx, req.(*ListPendingEssaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_AssignGraders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignGradersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).AssignGraders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_AssignGraders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).AssignGraders(ctx, req.(*AssignGradersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_SubmitEssayMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitEssayMarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).SubmitEssayMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_SubmitEssayMark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).SubmitEssayMark(ctx, req.(*SubmitEssayMarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_ResolveModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).ResolveModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_ResolveModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).ResolveModeration(ctx, req.(*ResolveModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetGradingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetGradingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetGradingProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetGradingProgress(ctx, req.(*GetGradingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_SetEssayKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEssayKeywordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).SetEssayKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_SetEssayKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).SetEssayKeywords(ctx, req.(*SetEssayKeywordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetEssayKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEssayKeywordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetEssayKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetEssayKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetEssayKeywords(ctx, req.(*GetEssayKeywordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GenerateScoreSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateScoreSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GenerateScoreSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GenerateScoreSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GenerateScoreSuggestions(ctx, req.(*GenerateScoreSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetSuggestionAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSuggestionAgreementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetSuggestionAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetSuggestionAgreement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetSuggestionAgreement(ctx, req.(*GetSuggestionAgreementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradingService_ServiceDesc is the grpc.ServiceDesc for GradingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.GradingService",
+	HandlerType: (*GradingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunEssaySimilarityCheck",
+			Handler:    _GradingService_RunEssaySimilarityCheck_Handler,
+		},
+		{
+			MethodName: "GetEssayGradingView",
+			Handler:    _GradingService_GetEssayGradingView_Handler,
+		},
+		{
+			MethodName: "SetEssayRubric",
+			Handler:    _GradingService_SetEssayRubric_Handler,
+		},
+		{
+			MethodName: "GetEssayRubric",
+			Handler:    _GradingService_GetEssayRubric_Handler,
+		},
+		{
+			MethodName: "SetGradingConfig",
+			Handler:    _GradingService_SetGradingConfig_Handler,
+		},
+		{
+			MethodName: "GetGradingConfig",
+			Handler:    _GradingService_GetGradingConfig_Handler,
+		},
+		{
+			MethodName: "ListPendingEssays",
+			Handler:    _GradingService_ListPendingEssays_Handler,
+		},
+		{
+			MethodName: "AssignGraders",
+			Handler:    _GradingService_AssignGraders_Handler,
+		},
+		{
+			MethodName: "SubmitEssayMark",
+			Handler:    _GradingService_SubmitEssayMark_Handler,
+		},
+		{
+			MethodName: "ResolveModeration",
+			Handler:    _GradingService_ResolveModeration_Handler,
+		},
+		{
+			MethodName: "GetGradingProgress",
+			Handler:    _GradingService_GetGradingProgress_Handler,
+		},
+		{
+			MethodName: "SetEssayKeywords",
+			Handler:    _GradingService_SetEssayKeywords_Handler,
+		},
+		{
+			MethodName: "GetEssayKeywords",
+			Handler:    _GradingService_GetEssayKeywords_Handler,
+		},
+		{
+			MethodName: "GenerateScoreSuggestions",
+			Handler:    _GradingService_GenerateScoreSuggestions_Handler,
+		},
+		{
+			MethodName: "GetSuggestionAgreement",
+			Handler:    _GradingService_GetSuggestionAgreement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbt.proto",
+}
+
+const (
+	ApiKeyService_CreateApiKey_FullMethodName = "/base.ApiKeyService/CreateApiKey"
+	ApiKeyService_ListApiKeys_FullMethodName  = "/base.ApiKeyService/ListApiKeys"
+	ApiKeyService_RevokeApiKey_FullMethodName = "/base.ApiKeyService/RevokeApiKey"
+)
+
+// ApiKeyServiceClient is the client API for ApiKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Keys let the LMS and other integrations call the service without a user token
+type ApiKeyServiceClient interface {
+	// The plaintext key is only returned here, it is stored hashed
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error)
+}
+
+type apiKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApiKeyServiceClient(cc grpc.ClientConnInterface) ApiKeyServiceClient {
+	return &apiKeyServiceClient{cc}
+}
+
+func (c *apiKeyServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiKeyServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*MessageStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageStatusResponse)
+	err := c.cc.Invoke(ctx, ApiKeyService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiKeyServiceServer is the server API for ApiKeyService service.
+// All implementations must embed UnimplementedApiKeyServiceServer
+// for forward compatibility.
+//
+// Keys let the LMS and other integrations call the service without a user token
+type ApiKeyServiceServer interface {
+	// The plaintext key is only returned here, it is stored hashed
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*MessageStatusResponse, error)
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+// UnimplementedApiKeyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApiKeyServiceServer struct{}
+
+func (UnimplementedApiKeyServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedApiKeyServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*MessageStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedApiKeyServiceServer) mustEmbedUnimplementedApiKeyServiceServer() {}
+func (UnimplementedApiKeyServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeApiKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApiKeyServiceServer will
+// result in compilation errors.
+type UnsafeApiKeyServiceServer interface {
+	mustEmbedUnimplementedApiKeyServiceServer()
+}
+
+func RegisterApiKeyServiceServer(s grpc.ServiceRegistrar, srv ApiKeyServiceServer) {
+	// If the following call panics, it indicates UnimplementedApiKeyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApiKeyService_ServiceDesc, srv)
+}
+
+func _ApiKeyService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiKeyService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiKeyServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiKeyService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiKeyServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApiKeyService_ServiceDesc is the grpc.ServiceDesc for ApiKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApiKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.ApiKeyService",
+	HandlerType: (*ApiKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _ApiKeyService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _ApiKeyService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _ApiKeyService_RevokeApiKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbt.proto",
+}
+
+const (
+	LabLoginService_IssueLabCredentials_FullMethodName  = "/base.LabLoginService/IssueLabCredentials"
+	LabLoginService_RevokeLabCredentials_FullMethodName = "/base.LabLoginService/RevokeLabCredentials"
+	LabLoginService_LabLogin_FullMethodName             = "/base.LabLoginService/LabLogin"
+)
+
+// LabLoginServiceClient is the client API for LabLoginService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// QR cards and PINs for students who cannot sign in with email and password
+type LabLoginServiceClient interface {
+	// Replaces the unused credentials of the sessions; PINs and QR codes are only returned here
+	IssueLabCredentials(ctx context.Context, in *IssueLabCredentialsRequest, opts ...grpc.CallOption) (*IssueLabCredentialsResponse, error)
+	RevokeLabCredentials(ctx context.Context, in *RevokeLabCredentialsRequest, opts ...grpc.CallOption) (*RevokeLabCredentialsResponse, error)
+	// Public; the token can only call TestSessionService for the credential's session
+	LabLogin(ctx context.Context, in *LabLoginRequest, opts ...grpc.CallOption) (*LabLoginResponse, error)
+}
+
+type labLoginServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLabLoginServiceClient(cc grpc.ClientConnInterface) LabLoginServiceClient {
+	return &labLoginServiceClient{cc}
+}
+
+func (c *labLoginServiceClient) IssueLabCredentials(ctx context.Context, in *IssueLabCredentialsRequest, opts ...grpc.CallOption) (*IssueLabCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueLabCredentialsResponse)
+	err := c.cc.Invoke(ctx, LabLoginService_IssueLabCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labLoginServiceClient) RevokeLabCredentials(ctx context.Context, in *RevokeLabCredentialsRequest, opts ...grpc.CallOption) (*RevokeLabCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeLabCredentialsResponse)
+	err := c.cc.Invoke(ctx, LabLoginService_RevokeLabCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *labLoginServiceClient) LabLogin(ctx context.Context, in *LabLoginRequest, opts ...grpc.CallOption) (*LabLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LabLoginResponse)
+	err := c.cc.Invoke(ctx, LabLoginService_LabLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LabLoginServiceServer is the server API for LabLoginService service.
+// All implementations must embed UnimplementedLabLoginServiceServer
+// for forward compatibility.
+//
+// QR cards and PINs for students who cannot sign in with email and password
+type LabLoginServiceServer interface {
+	// Replaces the unused credentials of the sessions; PINs and QR codes are only returned here
+	IssueLabCredentials(context.Context, *IssueLabCredentialsRequest) (*IssueLabCredentialsResponse, error)
+	RevokeLabCredentials(context.Context, *RevokeLabCredentialsRequest) (*RevokeLabCredentialsResponse, error)
+	// Public; the token can only call TestSessionService for the credential's session
+	LabLogin(context.Context, *LabLoginRequest) (*LabLoginResponse, error)
+	mustEmbedUnimplementedLabLoginServiceServer()
+}
+
+// UnimplementedLabLoginServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLabLoginServiceServer struct{}
+
+func (UnimplementedLabLoginServiceServer) IssueLabCredentials(context.Context, *IssueLabCredentialsRequest) (*IssueLabCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueLabCredentials not implemented")
+}
+func (UnimplementedLabLoginServiceServer) RevokeLabCredentials(context.Context, *RevokeLabCredentialsRequest) (*RevokeLabCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeLabCredentials not implemented")
+}
+func (UnimplementedLabLoginServiceServer) LabLogin(context.Context, *LabLoginRequest) (*LabLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LabLogin not implemented")
+}
+func (UnimplementedLabLoginServiceServer) mustEmbedUnimplementedLabLoginServiceServer() {}
+func (UnimplementedLabLoginServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeLabLoginServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LabLoginServiceServer will
+// result in compilation errors.
+type UnsafeLabLoginServiceServer interface {
+	mustEmbedUnimplementedLabLoginServiceServer()
+}
+
+func RegisterLabLoginServiceServer(s grpc.ServiceRegistrar, srv LabLoginServiceServer) {
+	// If the following call panics, it indicates UnimplementedLabLoginServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LabLoginService_ServiceDesc, srv)
+}
+
+func _LabLoginService_IssueLabCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueLabCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabLoginServiceServer).IssueLabCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabLoginService_IssueLabCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabLoginServiceServer).IssueLabCredentials(ctx, req.(*IssueLabCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabLoginService_RevokeLabCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLabCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabLoginServiceServer).RevokeLabCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabLoginService_RevokeLabCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabLoginServiceServer).RevokeLabCredentials(ctx, req.(*RevokeLabCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LabLoginService_LabLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabLoginServiceServer).LabLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LabLoginService_LabLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabLoginServiceServer).LabLogin(ctx, req.(*LabLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LabLoginService_ServiceDesc is the grpc.ServiceDesc for LabLoginService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LabLoginService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.LabLoginService",
+	HandlerType: (*LabLoginServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueLabCredentials",
+			Handler:    _LabLoginService_IssueLabCredentials_Handler,
+		},
+		{
+			MethodName: "RevokeLabCredentials",
+			Handler:    _LabLoginService_RevokeLabCredentials_Handler,
+		},
+		{
+			MethodName: "LabLogin",
+			Handler:    _LabLoginService_LabLogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbt.proto",
+}
+
+const (
+	AuditLogService_ListAuditLogs_FullMethodName = "/base.AuditLogService/ListAuditLogs"
+)
+
+// AuditLogServiceClient is the client API for AuditLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Append-only record of the changes made through the API
+type AuditLogServiceClient interface {
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+}
+
+type auditLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditLogServiceClient(cc grpc.ClientConnInterface) AuditLogServiceClient {
+	return &auditLogServiceClient{cc}
+}
+
+func (c *auditLogServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, AuditLogService_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditLogServiceServer is the server API for AuditLogService service.
+// All implementations must embed UnimplementedAuditLogServiceServer
+// for forward compatibility.
+//
+// Append-only record of the changes made through the API
+type AuditLogServiceServer interface {
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	mustEmbedUnimplementedAuditLogServiceServer()
+}
+
+// UnimplementedAuditLogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditLogServiceServer struct{}
+
+func (UnimplementedAuditLogServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedAuditLogServiceServer) mustEmbedUnimplementedAuditLogServiceServer() {}
+func (UnimplementedAuditLogServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAuditLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditLogServiceServer will
+// result in compilation errors.
+type UnsafeAuditLogServiceServer interface {
+	mustEmbedUnimplementedAuditLogServiceServer()
+}
+
+func RegisterAuditLogServiceServer(s grpc.ServiceRegistrar, srv AuditLogServiceServer) {
+	// If the following call panics, it indicates UnimplementedAuditLogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditLogService_ServiceDesc, srv)
+}
+
+func _AuditLogService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLogService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditLogService_ServiceDesc is the grpc.ServiceDesc for AuditLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "base.AuditLogService",
+	HandlerType: (*AuditLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _AuditLogService_ListAuditLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbt.proto",
+}
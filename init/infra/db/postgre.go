@@ -1,21 +1,26 @@
 package db
 
 import (
-	"cbt-test-mini-project/init/config"
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"time"
 
-	_ "github.com/lib/pq"
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/util/tenant"
+
+	"github.com/lib/pq"
 )
 
 func OpenSQL(cfgMain config.Main) (db *sql.DB, err error) {
 	cfg := cfgMain.Database
-	db, err = sql.Open("postgres", cfg.DSN)
+	connector, err := pq.NewConnector(cfg.DSN)
 	if err != nil {
 		return nil, err
 	}
+	// Every statement carries the school of its request, see util/tenant
+	db = sql.OpenDB(tenant.NewConnector(connector))
 
 	// Configure connection pool
 	configureConnectionPool(db, cfg)
@@ -29,6 +34,13 @@ func OpenSQL(cfgMain config.Main) (db *sql.DB, err error) {
 		return nil, err
 	}
 
+	if rlsErr := tenant.CheckEnforced(context.Background(), db); rlsErr != nil {
+		if cfg.TenantRequireRLS {
+			return nil, fmt.Errorf("tenant isolation is not enforced: %w", rlsErr)
+		}
+		slog.Warn("Tenant isolation is not enforced by the database, schools can read each other's data; DB_TENANT_REQUIRE_RLS=false is for development only", "error", rlsErr)
+	}
+
 	return db, nil
 }
 
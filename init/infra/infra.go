@@ -1,23 +1,32 @@
 package infra
 
 import (
-	"cbt-test-mini-project/init/config"
-	"cbt-test-mini-project/init/infra/db"
 	"database/sql"
 	"log"
 	"os"
 
-	"go.elastic.co/apm"
-
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/init/infra/db"
+	infraRedis "cbt-test-mini-project/init/infra/redis"
 	"cbt-test-mini-project/internal/repository"
+	"cbt-test-mini-project/util/ratelimit"
+
+	"go.elastic.co/apm"
 )
 
 type Repository struct {
 	SQLDB         *sql.DB
 	UserLimitRepo repository.UserLimitRepository
+	// RateLimitPolicyRepo holds the policies the rate limiter picks limits from
+	RateLimitPolicyRepo repository.RateLimitPolicyRepository
+	// RateLimits shares rate limit counters between replicas; nil without Redis
+	RateLimits ratelimit.Store
 }
 
 func (r *Repository) Close() error {
+	if err := infraRedis.CloseRedis(); err != nil {
+		log.Printf("Failed to close Redis: %v", err)
+	}
 	if r != nil && r.SQLDB != nil {
 		if err := r.SQLDB.Close(); err != nil {
 			return err
@@ -39,6 +48,18 @@ func LoadRepository(cfg config.Main) *Repository {
 
 	// Initialize user limit repository
 	repo.UserLimitRepo = repository.NewUserLimitRepository(sqlDB, &cfg)
+	repo.RateLimitPolicyRepo = repository.NewRateLimitPolicyRepository(sqlDB)
+
+	// Redis is optional. The client reconnects on its own, so a Redis that is down at startup
+	// only means rate limits are counted in the database until it is back.
+	if cfg.Redis.Addr != "" {
+		if err := infraRedis.InitRedis(cfg.Redis.Addr, cfg.Redis.Password, cfg.Redis.DB); err != nil {
+			log.Printf("Redis unavailable, rate limits fall back to the database: %v", err)
+		} else {
+			log.Println("✓ Redis connected successfully")
+		}
+		repo.RateLimits = ratelimit.NewRedisStore(infraRedis.RedisClient)
+	}
 
 	return repo
 }
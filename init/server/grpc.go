@@ -9,6 +9,19 @@ import (
 	"regexp"
 	"runtime/debug"
 
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/init/infra"
+	"cbt-test-mini-project/internal/dependency"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/event"
+	apiKeyRepo "cbt-test-mini-project/internal/repository/api_key"
+	auditLogRepo "cbt-test-mini-project/internal/repository/audit_log"
+	authRepo "cbt-test-mini-project/internal/repository/auth"
+	authSessionRepo "cbt-test-mini-project/internal/repository/auth_session"
+	examSecurityRepo "cbt-test-mini-project/internal/repository/exam_security"
+	materiRepo "cbt-test-mini-project/internal/repository/materi"
+	"cbt-test-mini-project/util/interceptor"
+
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"go.elastic.co/apm/module/apmgrpc/v2"
 	"google.golang.org/grpc"
@@ -18,15 +31,11 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
-
-	"cbt-test-mini-project/init/config"
-	"cbt-test-mini-project/init/infra"
-	"cbt-test-mini-project/internal/dependency"
-	"cbt-test-mini-project/internal/event"
-	authRepo "cbt-test-mini-project/internal/repository/auth"
-	"cbt-test-mini-project/util/interceptor"
 )
 
+// maxRecvMsgSize leaves room for question audio and video uploads, which are sent as bytes
+const maxRecvMsgSize = entity.MaxSoalMediaSize + 1<<20
+
 func RunGRPCServer(ctx context.Context, cfg config.Main, repo infra.Repository, publisher *event.Publisher) (*grpc.Server, error) {
 	grpcPort := fmt.Sprintf(":%d", cfg.GrpcServer.Port)
 	grpcConn, err := net.Listen("tcp", grpcPort)
@@ -36,19 +45,42 @@ func RunGRPCServer(ctx context.Context, cfg config.Main, repo infra.Repository,
 
 	// Initialize JWT middleware
 	authRepository := authRepo.NewAuthRepository(repo.SQLDB)
-	jwtMiddleware := interceptor.NewJWTMiddleware(&cfg, authRepository)
+	jwtMiddleware, err := interceptor.NewJWTMiddleware(&cfg, authRepository, authSessionRepo.NewAuthSessionRepository(repo.SQLDB))
+	if err != nil {
+		return nil, err
+	}
+
+	// Service API keys for LMS and integrations, checked before the JWT
+	apiKeyMiddleware := interceptor.NewAPIKeyMiddleware(apiKeyRepo.NewAPIKeyRepository(repo.SQLDB))
 
 	// Initialize repositories for middleware
 	userLimitRepo := repo.UserLimitRepo
 
-	// Initialize rate limit middleware
-	rateLimitMiddleware := interceptor.NewRateLimitMiddleware(userLimitRepo)
+	// Initialize rate limit middleware; counters are shared through Redis when configured
+	rateLimitMiddleware := interceptor.NewRateLimitMiddleware(userLimitRepo, repo.RateLimitPolicyRepo, repo.RateLimits)
+
+	// Initialize exam lockdown middlewares (Safe Exam Browser, network allow-lists)
+	examSecurityRepository := examSecurityRepo.NewExamSecurityRepository(repo.SQLDB)
+	sebMiddleware := interceptor.NewSEBMiddleware(examSecurityRepository)
+	networkAccessMiddleware := interceptor.NewNetworkAccessMiddleware(&cfg, examSecurityRepository)
+
+	// Role and ownership checks for every method, see interceptor.MethodPolicies
+	materiRepository := materiRepo.NewMateriRepository(repo.SQLDB)
+	authorizationMiddleware := interceptor.NewAuthorizationMiddleware(interceptor.MethodPolicies, examSecurityRepository, examSecurityRepository, materiRepository)
+
+	// Append-only audit entries for every authenticated call that changes data
+	auditMiddleware := interceptor.NewAuditMiddleware(auditLogRepo.NewAuditLogRepository(repo.SQLDB))
 
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(metadataInterceptor),
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+		grpc.UnaryInterceptor(metadataInterceptor(sebMiddleware)),
 		grpc.ChainUnaryInterceptor(
 			apmgrpc.NewUnaryServerInterceptor(),
+			apiKeyMiddleware.UnaryServerInterceptor(),
 			jwtMiddleware.UnaryServerInterceptor(),
+			networkAccessMiddleware.UnaryServerInterceptor(), // resolves the client IP for the audit entry
+			auditMiddleware.UnaryServerInterceptor,           // before authorization so denied calls are audited too
+			authorizationMiddleware.UnaryServerInterceptor(),
 			rateLimitMiddleware.UnaryServerInterceptor,
 			interceptor.GRPCValidationInterceptor(), // Add validation
 			recovery.UnaryServerInterceptor(recovery.WithRecoveryHandlerContext(grpcRecoveryHandler)),
@@ -83,8 +115,15 @@ func grpcRecoveryHandler(ctx context.Context, panic interface{}) error {
 	return status.Errorf(codes.Internal, "server error happened")
 }
 
-func metadataInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	md, _ := metadata.FromIncomingContext(ctx)
-	newCtx := metadata.NewIncomingContext(ctx, md)
-	return handler(newCtx, req)
-}
\ No newline at end of file
+// metadataInterceptor normalizes incoming metadata and rejects TestSessionService calls
+// that do not come from the Safe Exam Browser configured for the assignment.
+func metadataInterceptor(sebMiddleware *interceptor.SEBMiddleware) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		newCtx := metadata.NewIncomingContext(ctx, md)
+		if err := sebMiddleware.Verify(newCtx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(newCtx, req)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/smtp"
 	"os"
@@ -14,23 +15,28 @@ import (
 	"strings"
 	"time"
 
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
-
 	// Update this import path
 	"cbt-test-mini-project/init/config"
 	"cbt-test-mini-project/init/infra"
 	infraRedis "cbt-test-mini-project/init/infra/redis"
 	"cbt-test-mini-project/internal/dependency"
+	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/event"
+	apiKeyRepo "cbt-test-mini-project/internal/repository/api_key"
 	classRepo "cbt-test-mini-project/internal/repository/class"
 	classStudentRepo "cbt-test-mini-project/internal/repository/class_student"
 	testSessionRepo "cbt-test-mini-project/internal/repository/test_session"
 	"cbt-test-mini-project/util/idcodec"
 	"cbt-test-mini-project/util/idobfuscation"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/seb"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // ShareEmailRequest represents the request payload for sharing results via email
@@ -143,10 +149,13 @@ func RunGatewayRestServer(ctx context.Context, cfg config.Main, repo infra.Repos
 	gwMux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, customMarshaler),
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
-		runtime.WithOutgoingHeaderMatcher(customHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 		runtime.WithErrorHandler(customErrorHandler),
 		runtime.WithMiddlewares(idobfuscation.PathParamDecodingMiddleware()),
 		runtime.WithForwardResponseRewriter(idobfuscation.ResponseRewriter()),
+		runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+			return interceptor.GatewayMetadata()
+		}),
 	)
 
 	opts := []grpc.DialOption{
@@ -159,22 +168,31 @@ func RunGatewayRestServer(ctx context.Context, cfg config.Main, repo infra.Repos
 	// Create a custom mux to handle both API and static files
 	mux := http.NewServeMux()
 	syncOpsHandler := NewSyncOpsHandler(repo.SQLDB)
+	apiKeys := interceptor.NewAPIKeyMiddleware(apiKeyRepo.NewAPIKeyRepository(repo.SQLDB))
 
-	// Custom endpoints
+	// Custom endpoints; the admin and sync ones are for machine clients with an X-API-Key
 	mux.HandleFunc("/v1/sessions/share-email", handleShareEmail)
-	mux.HandleFunc("/v1/admin/subjects", handleCreateSubject(repo.SQLDB))
-	mux.HandleFunc("/v1/admin/levels", handleCreateLevel(repo.SQLDB))
-	mux.HandleFunc("/v1/sync/health", syncOpsHandler.HandleSyncHealth)
-	mux.HandleFunc("/v1/sync/classes", syncOpsHandler.HandleSyncClasses)
-	mux.HandleFunc("/v1/sync/classes/", syncOpsHandler.HandleSyncClassStudents)
-	mux.HandleFunc("/v1/sync/resync/sessions", syncOpsHandler.HandleSyncResyncSessions)
+	mux.HandleFunc("/v1/admin/subjects", apiKeys.RequireScope(entity.APIKeyScopeCatalogWrite, handleCreateSubject(repo.SQLDB)))
+	mux.HandleFunc("/v1/admin/levels", apiKeys.RequireScope(entity.APIKeyScopeCatalogWrite, handleCreateLevel(repo.SQLDB)))
+	mux.HandleFunc("/v1/sync/health", apiKeys.RequireScope(entity.APIKeyScopeSyncRead, syncOpsHandler.HandleSyncHealth))
+	mux.HandleFunc("/v1/sync/classes", apiKeys.RequireScope(entity.APIKeyScopeSyncRead, syncOpsHandler.HandleSyncClasses))
+	mux.HandleFunc("/v1/sync/classes/", apiKeys.RequireScope(entity.APIKeyScopeSyncRead, syncOpsHandler.HandleSyncClassStudents))
+	mux.HandleFunc("/v1/sync/resync/sessions", apiKeys.RequireScope(entity.APIKeyScopeSyncWrite, syncOpsHandler.HandleSyncResyncSessions))
+
+	// Question audio and video, streamed after RecordMediaPlay
+	mediaStream, err := newMediaStreamHandler(gwMux, fmt.Sprintf(":%d", cfg.GrpcServer.Port), opts)
+	if err != nil {
+		return nil, err
+	}
+	trustedProxies := interceptor.ParseTrustedProxies(cfg.Network.TrustedProxies)
+	mux.Handle("GET "+mediaStreamPattern, sebRequestURLMiddleware(trustedProxies, mediaStream))
 
 	// Serve static files (uploads)
 	fs := http.FileServer(http.Dir("uploads"))
 	mux.Handle("/uploads/", http.StripPrefix("/uploads/", fs))
 
 	// Serve API through gRPC-Gateway
-	mux.Handle("/", gwMux)
+	mux.Handle("/", sebRequestURLMiddleware(trustedProxies, gwMux))
 
 	// Create HTTP server with timeouts
 	srv := &http.Server{
@@ -496,7 +514,12 @@ func (h *SyncOpsHandler) HandleSyncResyncSessions(w http.ResponseWriter, r *http
 		return
 	}
 
-	inserted, err := h.testSessionRepo.BackfillMissingSessions(req.LMSClassID, req.LMSAssignmentID)
+	// Limited to the API key's school, or every school for an unscoped key
+	var lmsSchoolID *int64
+	if key, ok := interceptor.APIKeyFromContext(r.Context()); ok {
+		lmsSchoolID = key.LMSSchoolID
+	}
+	inserted, err := h.testSessionRepo.BackfillMissingSessions(r.Context(), lmsSchoolID, req.LMSClassID, req.LMSAssignmentID)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		_ = json.NewEncoder(w).Encode(map[string]string{"error": "failed to run session resync"})
@@ -555,11 +578,73 @@ func customHeaderMatcher(key string) (string, bool) {
 		return lowered, true
 	case "x-request-id":
 		return lowered, true
+	case "x-safeexambrowser-configkeyhash", "x-safeexambrowser-requesthash", "x-seb-request-url":
+		return lowered, true
+	case "x-device-id", "x-device-lease":
+		return lowered, true
+	case "x-school-id":
+		return lowered, true
+	case "x-api-key":
+		return lowered, true
+	case "user-agent":
+		return "grpcgateway-user-agent", true
 	default:
 		return lowered, false
 	}
 }
 
+// rateLimitHeaders are gRPC headers set by the rate limiter and the HTTP headers they become
+var rateLimitHeaders = map[string]string{
+	"ratelimit-limit":     "RateLimit-Limit",
+	"ratelimit-remaining": "RateLimit-Remaining",
+	"ratelimit-reset":     "RateLimit-Reset",
+	"retry-after":         "Retry-After",
+}
+
+// outgoingHeaderMatcher passes rate limit headers through unprefixed
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if header, ok := rateLimitHeaders[strings.ToLower(key)]; ok {
+		return header, true
+	}
+	return customHeaderMatcher(key)
+}
+
+// sebRequestURLMiddleware records the absolute URL the client requested. Safe Exam Browser
+// hashes its keys together with that URL, so the gRPC layer needs it to verify the headers.
+// Any client supplied value is overwritten.
+func sebRequestURLMiddleware(trustedProxies interceptor.TrustedProxies, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set(seb.HeaderRequestURL, absoluteRequestURL(r, trustedProxies))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// absoluteRequestURL rebuilds the URL from the connection. X-Forwarded-Proto and
+// X-Forwarded-Host are only believed from a trusted proxy, otherwise a client could pick
+// the URL its SEB keys are checked against.
+func absoluteRequestURL(r *http.Request, trustedProxies interceptor.TrustedProxies) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	host := r.Host
+
+	remoteIP := r.RemoteAddr
+	if h, _, err := net.SplitHostPort(remoteIP); err == nil {
+		remoteIP = h
+	}
+	if trustedProxies.Contains(remoteIP) {
+		if proto := strings.TrimSpace(strings.Split(r.Header.Get("X-Forwarded-Proto"), ",")[0]); proto != "" {
+			scheme = proto
+		}
+		if forwardedHost := strings.TrimSpace(strings.Split(r.Header.Get("X-Forwarded-Host"), ",")[0]); forwardedHost != "" {
+			host = forwardedHost
+		}
+	}
+
+	return scheme + "://" + host + r.URL.RequestURI()
+}
+
 // Custom error handler for gRPC-Gateway
 func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
 	// Log the actual error with full details
@@ -577,6 +662,23 @@ func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler ru
 		errMessage = st.Message()
 	}
 
+	// Rejected calls never reach the forwarder, so their rate limit headers and request ID
+	// are copied here
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		for key, header := range rateLimitHeaders {
+			if values := md.HeaderMD.Get(key); len(values) > 0 {
+				w.Header().Set(header, values[0])
+			}
+		}
+		if values := md.HeaderMD.Get(interceptor.RequestIDHeader); len(values) > 0 {
+			w.Header().Set("X-Request-Id", values[0])
+		}
+		// A device refused a session gets the secret it waits for a transfer with
+		if values := md.HeaderMD.Get(interceptor.DeviceLeaseHeader); len(values) > 0 {
+			w.Header().Set("X-Device-Lease", values[0])
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(httpStatus)
 
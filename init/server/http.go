@@ -44,7 +44,8 @@ func corsMiddleware(cfg *config.Main) func(http.Handler) http.Handler {
 			}
 
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, X-Requested-With, X-Request-Id")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, X-Requested-With, X-Request-Id, X-SafeExamBrowser-ConfigKeyHash, X-SafeExamBrowser-RequestHash, X-Device-Id, X-Device-Lease, X-School-Id")
+			w.Header().Set("Access-Control-Expose-Headers", "X-Device-Lease, X-Request-Id")
 			w.Header().Set("Access-Control-Max-Age", "86400")
 
 			// Handle preflight OPTIONS request globally
@@ -61,4 +62,4 @@ func corsMiddleware(cfg *config.Main) func(http.Handler) http.Handler {
 // StartHTTPServer starts the HTTP server with the given handler and address.
 func StartHTTPServer(addr string, handler http.Handler, cfg *config.Main) error {
 	return http.ListenAndServe(addr, corsMiddleware(cfg)(handler))
-}
\ No newline at end of file
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	base "cbt-test-mini-project/gen/proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mediaStreamPattern is the stream_url of a QuestionMedia
+const mediaStreamPattern = "/v1/test-sessions/{session_token}/questions/{nomor_urut}/media/{id_media}"
+
+// mediaStreamWriteTimeout replaces the server write timeout while a clip is sent, since a
+// slow client may take longer than an API response to receive it
+const mediaStreamWriteTimeout = 30 * time.Minute
+
+// mediaStreamRequestHeaders are passed to the origin so seeking and resumed buffering work
+var mediaStreamRequestHeaders = []string{"Range", "If-Range"}
+
+// mediaStreamResponseHeaders are passed back to the client from the origin
+var mediaStreamResponseHeaders = []string{"Content-Type", "Content-Length", "Content-Range", "Accept-Ranges", "ETag", "Last-Modified"}
+
+// mediaStreamHandler streams question clips to students. Each request is authorized by
+// TestSessionService.GetMediaStreamSource with the caller's headers forwarded the way the
+// gateway forwards them, so session ownership, device lease and play limits are checked by
+// the gRPC layer. The origin URL never reaches the client. A clip with a play limit is sent
+// whole in one response for the play nonce in the play query parameter: range requests would
+// each need a stream of their own and would let a student replay parts of one play.
+type mediaStreamHandler struct {
+	gwMux  *runtime.ServeMux
+	client base.TestSessionServiceClient
+	origin *http.Client
+}
+
+func newMediaStreamHandler(gwMux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (*mediaStreamHandler, error) {
+	conn, err := grpc.NewClient(endpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect media stream handler: %w", err)
+	}
+	return &mediaStreamHandler{
+		gwMux:  gwMux,
+		client: base.NewTestSessionServiceClient(conn),
+		origin: &http.Client{},
+	}, nil
+}
+
+func (h *mediaStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	nomorUrut, errNomor := strconv.Atoi(r.PathValue("nomor_urut"))
+	idMedia, errMedia := strconv.Atoi(r.PathValue("id_media"))
+	if errNomor != nil || errMedia != nil {
+		h.writeError(w, r, status.Error(codes.InvalidArgument, "invalid nomor_urut or id_media in path"))
+		return
+	}
+
+	playNonce := r.URL.Query().Get("play")
+	if r.Method == http.MethodHead && playNonce != "" {
+		// A HEAD request would use up the play's only stream
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, err := runtime.AnnotateContext(r.Context(), h.gwMux, r, base.TestSessionService_GetMediaStreamSource_FullMethodName, runtime.WithHTTPPathPattern(mediaStreamPattern))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	source, err := h.client.GetMediaStreamSource(ctx, &base.GetMediaStreamSourceRequest{
+		SessionToken: r.PathValue("session_token"),
+		NomorUrut:    int32(nomorUrut),
+		IdMedia:      int32(idMedia),
+		PlayNonce:    playNonce,
+	})
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+
+	upstream, err := http.NewRequestWithContext(r.Context(), r.Method, source.SourceUrl, nil)
+	if err != nil {
+		h.writeError(w, r, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	if source.Seekable {
+		for _, header := range mediaStreamRequestHeaders {
+			if value := r.Header.Get(header); value != "" {
+				upstream.Header.Set(header, value)
+			}
+		}
+	}
+
+	resp, err := h.origin.Do(upstream)
+	if err != nil {
+		slog.Error("failed to fetch question media", "id_media", idMedia, "error", err)
+		http.Error(w, "failed to fetch media", http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for _, header := range mediaStreamResponseHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			w.Header().Set(header, value)
+		}
+	}
+	if !source.Seekable {
+		w.Header().Del("Content-Range")
+		w.Header().Set("Accept-Ranges", "none")
+	}
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", source.MimeType)
+	}
+	// Clips with a play limit must not be replayed from a cache
+	w.Header().Set("Cache-Control", "private, no-store")
+
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(mediaStreamWriteTimeout)); err != nil {
+		slog.Warn("failed to extend media stream write deadline", "error", err)
+	}
+	w.WriteHeader(resp.StatusCode)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		slog.Debug("question media stream ended early", "id_media", idMedia, "error", err)
+	}
+}
+
+func (h *mediaStreamHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	customErrorHandler(r.Context(), h.gwMux, &runtime.JSONPb{}, w, r, err)
+}
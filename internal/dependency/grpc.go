@@ -1,17 +1,19 @@
 package dependency
 
 import (
+	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/init/config"
 	"cbt-test-mini-project/init/infra"
-
-	"google.golang.org/grpc"
-
-	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/event"
+	apiKeyHandler "cbt-test-mini-project/internal/handler/api_key"
+	auditLogHandler "cbt-test-mini-project/internal/handler/audit_log"
 	authHandler "cbt-test-mini-project/internal/handler/auth"
 	baseGrpcServer "cbt-test-mini-project/internal/handler/base"
 	classSyncHandler "cbt-test-mini-project/internal/handler/class_sync"
+	examSecurityHandler "cbt-test-mini-project/internal/handler/exam_security"
+	gradingHandler "cbt-test-mini-project/internal/handler/grading"
 	historyHandler "cbt-test-mini-project/internal/handler/history"
+	labLoginHandler "cbt-test-mini-project/internal/handler/lab_login"
 	mataPelajaranHandler "cbt-test-mini-project/internal/handler/mata_pelajaran"
 	materiHandler "cbt-test-mini-project/internal/handler/materi"
 	soalHandler "cbt-test-mini-project/internal/handler/soal"
@@ -19,10 +21,16 @@ import (
 	testSessionHandler "cbt-test-mini-project/internal/handler/test_session"
 	tingkatHandler "cbt-test-mini-project/internal/handler/tingkat"
 	userLimitHandler "cbt-test-mini-project/internal/handler/user_limit"
+	apiKeyRepo "cbt-test-mini-project/internal/repository/api_key"
+	auditLogRepo "cbt-test-mini-project/internal/repository/audit_log"
 	authRepo "cbt-test-mini-project/internal/repository/auth"
+	authSessionRepo "cbt-test-mini-project/internal/repository/auth_session"
 	classRepo "cbt-test-mini-project/internal/repository/class"
 	classStudentRepo "cbt-test-mini-project/internal/repository/class_student"
+	examSecurityRepo "cbt-test-mini-project/internal/repository/exam_security"
+	gradingRepo "cbt-test-mini-project/internal/repository/grading"
 	historyRepo "cbt-test-mini-project/internal/repository/history"
+	labLoginRepo "cbt-test-mini-project/internal/repository/lab_login"
 	mataPelajaranRepo "cbt-test-mini-project/internal/repository/mata_pelajaran"
 	materiRepo "cbt-test-mini-project/internal/repository/materi"
 	soalDragDropRepo "cbt-test-mini-project/internal/repository/soal_drag_drop"
@@ -30,23 +38,36 @@ import (
 	soalRepo "cbt-test-mini-project/internal/repository/test_soal"
 	tingkatRepo "cbt-test-mini-project/internal/repository/tingkat"
 	userLimitUsecase "cbt-test-mini-project/internal/usecase"
+	apiKeyUsecase "cbt-test-mini-project/internal/usecase/api_key"
+	auditLogUsecase "cbt-test-mini-project/internal/usecase/audit_log"
 	authUsecase "cbt-test-mini-project/internal/usecase/auth"
 	classUsecase "cbt-test-mini-project/internal/usecase/class"
 	classStudentUsecase "cbt-test-mini-project/internal/usecase/class_student"
+	examSecurityUsecase "cbt-test-mini-project/internal/usecase/exam_security"
+	gradingUsecase "cbt-test-mini-project/internal/usecase/grading"
 	historyUsecase "cbt-test-mini-project/internal/usecase/history"
+	labLoginUsecase "cbt-test-mini-project/internal/usecase/lab_login"
 	mataPelajaranUsecase "cbt-test-mini-project/internal/usecase/mata_pelajaran"
 	materiUsecase "cbt-test-mini-project/internal/usecase/materi"
 	soalUsecase "cbt-test-mini-project/internal/usecase/soal"
 	soalDragDropUsecase "cbt-test-mini-project/internal/usecase/soal_drag_drop"
 	testSessionUsecase "cbt-test-mini-project/internal/usecase/test_session"
 	tingkatUsecase "cbt-test-mini-project/internal/usecase/tingkat"
+
+	"google.golang.org/grpc"
 )
 
 func InitGrpcDependency(server *grpc.Server, repo infra.Repository, config *config.Main, publisher *event.Publisher) {
 	// Initialize repositories
 	authRepo := authRepo.NewAuthRepository(repo.SQLDB)
+	authSessionRepo := authSessionRepo.NewAuthSessionRepository(repo.SQLDB)
 	classRepo := classRepo.NewClassRepository(repo.SQLDB)
 	classStudentRepo := classStudentRepo.NewClassStudentRepository(repo.SQLDB)
+	examSecurityRepo := examSecurityRepo.NewExamSecurityRepository(repo.SQLDB)
+	gradingRepo := gradingRepo.NewGradingRepository(repo.SQLDB)
+	apiKeyRepo := apiKeyRepo.NewAPIKeyRepository(repo.SQLDB)
+	auditLogRepo := auditLogRepo.NewAuditLogRepository(repo.SQLDB)
+	labLoginRepo := labLoginRepo.NewLabLoginRepository(repo.SQLDB)
 	mataPelajaranRepo := mataPelajaranRepo.NewMataPelajaranRepository(repo.SQLDB)
 	materiRepo := materiRepo.NewMateriRepository(repo.SQLDB)
 	soalRepo := soalRepo.NewSoalRepository(repo.SQLDB)
@@ -56,9 +77,14 @@ func InitGrpcDependency(server *grpc.Server, repo infra.Repository, config *conf
 	tingkatRepo := tingkatRepo.NewTingkatRepository(repo.SQLDB)
 
 	// Initialize usecases
-	authUsecase := authUsecase.NewAuthUsecase(authRepo, config)
+	authUsecase := authUsecase.NewAuthUsecase(authRepo, authSessionRepo, config)
 	classUsecase := classUsecase.NewClassUsecase(classRepo)
 	classStudentUsecase := classStudentUsecase.NewClassStudentUsecase(classStudentRepo)
+	examSecurityUsecase := examSecurityUsecase.NewExamSecurityUsecase(examSecurityRepo)
+	gradingUsecase := gradingUsecase.NewGradingUsecase(gradingRepo, testSessionRepo)
+	apiKeyUsecase := apiKeyUsecase.NewAPIKeyUsecase(apiKeyRepo)
+	auditLogUsecase := auditLogUsecase.NewAuditLogUsecase(auditLogRepo)
+	labLoginUsecase := labLoginUsecase.NewLabLoginUsecase(labLoginRepo, authRepo, authSessionRepo, config, repo.RateLimits)
 	mataPelajaranUsecase := mataPelajaranUsecase.NewMataPelajaranUsecase(mataPelajaranRepo)
 	materiUsecase := materiUsecase.NewMateriUsecase(materiRepo)
 	soalUsecase := soalUsecase.NewSoalUsecase(soalRepo, config)
@@ -66,17 +92,22 @@ func InitGrpcDependency(server *grpc.Server, repo infra.Repository, config *conf
 	testSessionUsecase := testSessionUsecase.NewTestSessionUsecase(testSessionRepo, authRepo, publisher)
 	historyUsecase := historyUsecase.NewHistoryUsecase(historyRepo)
 	tingkatUsecase := tingkatUsecase.NewTingkatUsecase(tingkatRepo)
-	userLimitUsecase := userLimitUsecase.NewUserLimitUsecase(repo.UserLimitRepo)
+	userLimitUsecase := userLimitUsecase.NewUserLimitUsecase(repo.UserLimitRepo, repo.RateLimitPolicyRepo, repo.RateLimits)
 
 	// Initialize handlers
 	baseServer := baseGrpcServer.NewBaseHandler()
 	authServer := authHandler.NewAuthHandler(authUsecase)
 	classSyncServer := classSyncHandler.NewClassSyncHandler(classUsecase, classStudentUsecase)
+	examSecurityServer := examSecurityHandler.NewExamSecurityHandler(examSecurityUsecase)
+	gradingServer := gradingHandler.NewGradingHandler(gradingUsecase)
+	apiKeyServer := apiKeyHandler.NewAPIKeyHandler(apiKeyUsecase)
+	auditLogServer := auditLogHandler.NewAuditLogHandler(auditLogUsecase)
+	labLoginServer := labLoginHandler.NewLabLoginHandler(labLoginUsecase)
 	mataPelajaranServer := mataPelajaranHandler.NewMataPelajaranHandler(mataPelajaranUsecase)
 	materiServer := materiHandler.NewMateriHandler(materiUsecase, soalUsecase, mataPelajaranUsecase)
 	soalServer := soalHandler.NewSoalHandler(soalUsecase)
 	soalDragDropServer := soalDragDropHandler.NewGrpcHandler(soalDragDropUsecase)
-	testSessionServer := testSessionHandler.NewTestSessionHandler(testSessionUsecase, materiUsecase, tingkatUsecase, userLimitUsecase)
+	testSessionServer := testSessionHandler.NewTestSessionHandler(testSessionUsecase, materiUsecase, tingkatUsecase, userLimitUsecase, examSecurityUsecase, gradingUsecase)
 	historyServer := historyHandler.NewHistoryHandler(historyUsecase)
 	tingkatServer := tingkatHandler.NewTingkatHandler(tingkatUsecase)
 	userLimitServer := userLimitHandler.NewUserLimitHandler(userLimitUsecase)
@@ -85,6 +116,11 @@ func InitGrpcDependency(server *grpc.Server, repo infra.Repository, config *conf
 	base.RegisterBaseServer(server, baseServer)
 	base.RegisterAuthServiceServer(server, authServer)
 	base.RegisterClassSyncServiceServer(server, classSyncServer)
+	base.RegisterExamSecurityServiceServer(server, examSecurityServer)
+	base.RegisterGradingServiceServer(server, gradingServer)
+	base.RegisterApiKeyServiceServer(server, apiKeyServer)
+	base.RegisterAuditLogServiceServer(server, auditLogServer)
+	base.RegisterLabLoginServiceServer(server, labLoginServer)
 	base.RegisterMataPelajaranServiceServer(server, mataPelajaranServer)
 	base.RegisterMateriServiceServer(server, materiServer)
 	base.RegisterSoalServiceServer(server, soalServer)
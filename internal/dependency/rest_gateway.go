@@ -6,7 +6,6 @@ import (
 
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/init/config"
-
 	"cbt-test-mini-project/internal/event"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -18,6 +17,11 @@ func InitRestGatewayDependency(mux *runtime.ServeMux, opts []grpc.DialOption, ct
 	base.RegisterBaseHandlerFromEndpoint(ctx, mux, port, opts)
 	base.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, port, opts)
 	base.RegisterClassSyncServiceHandlerFromEndpoint(ctx, mux, port, opts)
+	base.RegisterExamSecurityServiceHandlerFromEndpoint(ctx, mux, port, opts)
+	base.RegisterGradingServiceHandlerFromEndpoint(ctx, mux, port, opts)
+	base.RegisterApiKeyServiceHandlerFromEndpoint(ctx, mux, port, opts)
+	base.RegisterAuditLogServiceHandlerFromEndpoint(ctx, mux, port, opts)
+	base.RegisterLabLoginServiceHandlerFromEndpoint(ctx, mux, port, opts)
 	base.RegisterMataPelajaranServiceHandlerFromEndpoint(ctx, mux, port, opts)
 	base.RegisterMateriServiceHandlerFromEndpoint(ctx, mux, port, opts)
 	base.RegisterTingkatServiceHandlerFromEndpoint(ctx, mux, port, opts)
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// API key scopes. A key can only call what its scopes name; rpc:<service> (e.g.
+// rpc:base.ClassSyncService) or rpc:* opens gRPC methods to it as a superadmin.
+const (
+	APIKeyScopeSyncRead     = "sync:read"     // GET /v1/sync/health, /v1/sync/classes...
+	APIKeyScopeSyncWrite    = "sync:write"    // POST /v1/sync/resync/sessions
+	APIKeyScopeCatalogWrite = "catalog:write" // POST /v1/admin/subjects, /v1/admin/levels
+	APIKeyScopeRPCPrefix    = "rpc:"
+	APIKeyScopeRPCAll       = "rpc:*"
+)
+
+// APIKey represents the api_keys table. Only the SHA-256 of the key is stored; the
+// prefix identifies a key in listings and logs.
+type APIKey struct {
+	ID          int        `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name        string     `json:"name" gorm:"not null"`
+	KeyPrefix   string     `json:"key_prefix" gorm:"not null"`
+	KeyHash     string     `json:"-" gorm:"not null;uniqueIndex"`
+	Scopes      []string   `json:"scopes" gorm:"type:jsonb"`
+	LMSSchoolID *int64     `json:"lms_school_id"` // nil = every school
+	CreatedBy   int        `json:"created_by" gorm:"not null"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	RevokedBy   *int       `json:"revoked_by"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (APIKey) TableName() string { return "api_keys" }
+
+// IsActive reports whether the key is neither revoked nor expired at now
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// HasScope reports whether the key was granted scope
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsMethod reports whether the key may call a gRPC method given as /package.Service/Method
+func (k *APIKey) AllowsMethod(fullMethod string) bool {
+	service := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	return k.HasScope(APIKeyScopeRPCAll) || k.HasScope(APIKeyScopeRPCPrefix+service)
+}
+
+// IsValidAPIKeyScope reports whether scope is one a key can be granted
+func IsValidAPIKeyScope(scope string) bool {
+	switch scope {
+	case APIKeyScopeSyncRead, APIKeyScopeSyncWrite, APIKeyScopeCatalogWrite, APIKeyScopeRPCAll:
+		return true
+	}
+	service := strings.TrimPrefix(scope, APIKeyScopeRPCPrefix)
+	return service != scope && strings.HasPrefix(service, "base.") && len(service) > len("base.") && !strings.ContainsAny(service, "/* ")
+}
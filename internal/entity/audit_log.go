@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLog represents the append-only audit_logs table: one change made through the API.
+// Before and After hold only the fields that changed; Request is the redacted request of
+// entries written for a call without a more specific record.
+type AuditLog struct {
+	ID           int64           `json:"id" gorm:"primaryKey;autoIncrement"`
+	OccurredAt   time.Time       `json:"occurred_at" gorm:"not null"`
+	ActorID      *int            `json:"actor_id"`
+	ActorRole    string          `json:"actor_role" gorm:"size:20"`
+	APIKeyID     *int            `json:"api_key_id"`
+	SchoolID     *int64          `json:"school_id"`
+	Method       string          `json:"method" gorm:"not null;size:200"`
+	ResourceType string          `json:"resource_type" gorm:"not null;size:50"`
+	ResourceID   string          `json:"resource_id" gorm:"size:100"`
+	Before       json.RawMessage `json:"before" gorm:"type:jsonb"`
+	After        json.RawMessage `json:"after" gorm:"type:jsonb"`
+	Request      json.RawMessage `json:"request" gorm:"type:jsonb"`
+	RequestID    string          `json:"request_id" gorm:"size:100"`
+	ClientIP     string          `json:"client_ip" gorm:"size:64"`
+	// Status is the gRPC code of the call, "OK" when it succeeded
+	Status string `json:"status" gorm:"not null;size:32"`
+}
+
+func (AuditLog) TableName() string { return "audit_logs" }
+
+// Resource types of entries recorded by usecases; other entries are named after their service
+const (
+	AuditResourceEssayAnswer = "essay_answer"
+	AuditResourceSoal        = "soal"
+	AuditResourceMateri      = "materi"
+	AuditResourceUserLimit   = "user_limit"
+)
+
+// AuditLogFilter narrows ListAuditLogs; zero values match everything
+type AuditLogFilter struct {
+	ActorID      int
+	ResourceType string
+	ResourceID   string
+	Method       string
+	From         time.Time
+	To           time.Time
+	Page         int
+	PageSize     int
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// AuthSession represents the auth_sessions table: one local login, ended by Logout,
+// a password change or reuse of a rotated refresh token
+type AuthSession struct {
+	ID           int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID       int32      `json:"user_id" gorm:"not null"`
+	SchoolID     *int64     `json:"school_id"` // The school the session was opened for
+	UserAgent    string     `json:"user_agent"`
+	ClientIP     string     `json:"client_ip"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	LastUsedAt   *time.Time `json:"last_used_at"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	RevokeReason string     `json:"revoke_reason"`
+}
+
+func (AuthSession) TableName() string { return "auth_sessions" }
+
+// maxSessionUserAgent is the length of auth_sessions.user_agent
+const maxSessionUserAgent = 255
+
+// NewAuthSession describes a login of userID from device, cutting the user agent to fit
+func NewAuthSession(userID int32, device DeviceInfo) *AuthSession {
+	userAgent := device.UserAgent
+	if len(userAgent) > maxSessionUserAgent {
+		userAgent = userAgent[:maxSessionUserAgent]
+	}
+	return &AuthSession{UserID: userID, UserAgent: userAgent, ClientIP: device.IPAddress}
+}
+
+// AuthRefreshToken represents the auth_refresh_tokens table. Each refresh consumes
+// the token (rotated_at) and issues the next one of the session.
+type AuthRefreshToken struct {
+	ID        int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	SessionID int64      `json:"session_id" gorm:"not null"`
+	TokenHash string     `json:"-" gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time  `json:"expires_at" gorm:"not null"`
+	RotatedAt *time.Time `json:"rotated_at"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (AuthRefreshToken) TableName() string { return "auth_refresh_tokens" }
+
+// Reasons a session was revoked
+const (
+	AuthSessionRevokedLogout         = "logout"
+	AuthSessionRevokedPasswordChange = "password_change"
+	AuthSessionRevokedTokenReuse     = "refresh_token_reuse"
+	AuthSessionRevokedUserInactive   = "user_inactive"
+	AuthSessionRevokedLabCredential  = "lab_credential_revoked"
+)
+
+// AuthTokens is what Login and RefreshToken hand back to the client
+type AuthTokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	SessionID        int64
+}
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	// ErrRefreshTokenReused means an already rotated token was presented, so it may have
+	// been stolen; the whole session is revoked
+	ErrRefreshTokenReused = errors.New("refresh token was already used, please log in again")
+)
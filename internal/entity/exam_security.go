@@ -0,0 +1,192 @@
+package entity
+
+import "time"
+
+// SebConfig represents the assignment_seb_config table.
+// When present for an assignment, every TestSessionService call on its sessions
+// must come from Safe Exam Browser running this configuration.
+type SebConfig struct {
+	ID              int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	LMSAssignmentID int64     `json:"lms_assignment_id" gorm:"uniqueIndex;not null"`
+	FileName        string    `json:"file_name" gorm:"size:255"`
+	ConfigRaw       []byte    `json:"-" gorm:"not null"`
+	ConfigKey       string    `json:"config_key" gorm:"size:64;not null"`
+	BrowserExamKeys []string  `json:"browser_exam_keys" gorm:"type:jsonb;serializer:json"`
+	IsActive        bool      `json:"is_active" gorm:"default:true"`
+	CreatedBy       *int      `json:"created_by"`
+	CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (SebConfig) TableName() string { return "assignment_seb_config" }
+
+// DeviceLeaseStatus defines the lifecycle of a session device lease
+type DeviceLeaseStatus string
+
+const (
+	DeviceLeaseActive      DeviceLeaseStatus = "active"
+	DeviceLeaseTransferred DeviceLeaseStatus = "transferred"
+	DeviceLeaseRejected    DeviceLeaseStatus = "rejected"
+	// DeviceLeaseApproved closes a rejected attempt whose device was given the lease
+	DeviceLeaseApproved DeviceLeaseStatus = "approved"
+)
+
+// DeviceInfo identifies the device a request comes from. DeviceID is chosen by the client
+// and only labels the device; LeaseSecret is the secret the server issued to it.
+type DeviceInfo struct {
+	DeviceID    string
+	UserAgent   string
+	IPAddress   string
+	LeaseSecret string
+}
+
+// DeviceLease represents the test_session_device_lease table
+type DeviceLease struct {
+	ID            int64             `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDTestSession int               `json:"id_test_session" gorm:"not null;index"`
+	DeviceID      string            `json:"device_id" gorm:"size:128;not null"`
+	UserAgent     string            `json:"user_agent"`
+	IPAddress     string            `json:"ip_address" gorm:"size:64"`
+	Status        DeviceLeaseStatus `json:"status" gorm:"size:20;default:'active'"`
+	AttemptCount  int               `json:"attempt_count" gorm:"default:1"`
+	IssuedAt      time.Time         `json:"issued_at" gorm:"autoCreateTime"`
+	LastSeenAt    time.Time         `json:"last_seen_at"`
+	ReleasedAt    *time.Time        `json:"released_at"`
+	ApprovedBy    *int              `json:"approved_by"`
+	Note          string            `json:"note"`
+	// SecretHash is the SHA-256 of the lease secret held by the device
+	SecretHash string `json:"-" gorm:"size:64"`
+}
+
+func (DeviceLease) TableName() string { return "test_session_device_lease" }
+
+// NetworkAllowlistEntry represents the network_allowlist table.
+// Exactly one of LMSSchoolID / LMSAssignmentID is set.
+type NetworkAllowlistEntry struct {
+	ID              int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	LMSSchoolID     *int64    `json:"lms_school_id" gorm:"index"`
+	LMSAssignmentID *int64    `json:"lms_assignment_id" gorm:"index"`
+	CIDR            string    `json:"cidr" gorm:"type:cidr;not null"`
+	Label           string    `json:"label" gorm:"size:255"`
+	CreatedBy       *int      `json:"created_by"`
+	CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (NetworkAllowlistEntry) TableName() string { return "network_allowlist" }
+
+// NetworkOverride represents the network_allowlist_override table
+type NetworkOverride struct {
+	ID            int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDTestSession int        `json:"id_test_session" gorm:"not null;index"`
+	Reason        string     `json:"reason" gorm:"not null"`
+	GrantedBy     int        `json:"granted_by" gorm:"not null"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (NetworkOverride) TableName() string { return "network_allowlist_override" }
+
+// NetworkAccessDenial represents the network_access_denied_log table
+type NetworkAccessDenial struct {
+	ID              int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDTestSession   *int      `json:"id_test_session"`
+	UserID          *int      `json:"user_id"`
+	LMSSchoolID     *int64    `json:"lms_school_id"`
+	LMSAssignmentID *int64    `json:"lms_assignment_id"`
+	Method          string    `json:"method" gorm:"size:255;not null"`
+	ClientIP        string    `json:"client_ip" gorm:"size:64;not null"`
+	CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (NetworkAccessDenial) TableName() string { return "network_access_denied_log" }
+
+// NetworkPolicy is the allow-list that applies to a single test session
+type NetworkPolicy struct {
+	SessionID       int
+	UserID          *int
+	LMSSchoolID     *int64
+	LMSAssignmentID *int64
+	AssignmentCIDRs []string
+	SchoolCIDRs     []string
+	OverrideActive  bool
+}
+
+// EffectiveCIDRs returns the networks the session may be used from; an assignment
+// list takes precedence over the school list. Empty means unrestricted.
+func (p NetworkPolicy) EffectiveCIDRs() []string {
+	if len(p.AssignmentCIDRs) > 0 {
+		return p.AssignmentCIDRs
+	}
+	return p.SchoolCIDRs
+}
+
+// AnswerItemKey identifies a question independently of its position in a session
+type AnswerItemKey struct {
+	QuestionType QuestionType
+	QuestionID   int
+}
+
+// RecordedAnswer is one saved answer of a session, as used by the collusion analysis
+type RecordedAnswer struct {
+	Item       AnswerItemKey
+	Response   string
+	IsCorrect  bool
+	AnsweredAt time.Time
+	// OptionCount is the number of options of a multiple-choice question, 0 for other types
+	OptionCount int
+}
+
+// SessionAnswerPattern is the answer vector of one finished session of an assignment
+type SessionAnswerPattern struct {
+	SessionID    int
+	SessionToken string
+	UserID       *int
+	NamaPeserta  string
+	Answers      []RecordedAnswer
+}
+
+// CollusionEvidence is one question on which a pair of sessions looks suspicious
+type CollusionEvidence struct {
+	Item           AnswerItemKey
+	ResponseA      string
+	ResponseB      string
+	IsCorrect      bool
+	AnsweredAtA    time.Time
+	AnsweredAtB    time.Time
+	TimeGapSeconds float64
+	IdenticalWrong bool
+	Synchronized   bool
+}
+
+// CollusionPair holds the similarity indices of two sessions.
+// SharedWrong is the Harpp-Hogan EEIC (exact errors in common) and Differences
+// its D (questions answered differently); SharedWrongPValue is the upper tail
+// probability of observing SharedWrong identical wrong answers by chance given
+// how the class distributed its wrong answers.
+type CollusionPair struct {
+	SessionA             SessionAnswerPattern
+	SessionB             SessionAnswerPattern
+	CommonItems          int
+	IdenticalAnswers     int
+	SharedWrong          int
+	Differences          int
+	HarppHoganRatio      float64
+	ExpectedSharedWrong  float64
+	SharedWrongPValue    float64
+	SynchronizedAnswers  int
+	SynchronizedRatio    float64
+	MedianTimeGapSeconds float64
+	Score                float64
+	Flagged              bool
+	Evidence             []CollusionEvidence
+}
+
+// CollusionReport is the ranked result of a collusion analysis of an assignment
+type CollusionReport struct {
+	LMSAssignmentID int64
+	SessionCount    int
+	PairsAnalyzed   int
+	Pairs           []CollusionPair
+	GeneratedAt     time.Time
+}
@@ -0,0 +1,4 @@
+package entity
+
+// Exported for the tests of the hotspot geometry
+var PolygonContains = polygonContains
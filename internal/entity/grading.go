@@ -0,0 +1,376 @@
+package entity
+
+import "time"
+
+// EssaySimilaritySource identifies what an essay answer was compared with
+type EssaySimilaritySource string
+
+const (
+	EssaySimilarityPeer      EssaySimilaritySource = "peer"
+	EssaySimilarityAnswerKey EssaySimilaritySource = "answer_key"
+)
+
+// EssayAnswerForGrading is an essay answer with the context a grader needs
+type EssayAnswerForGrading struct {
+	AnswerID        int        `json:"answer_id"`
+	SessionID       int        `json:"id_test_session"`
+	SessionToken    string     `json:"session_token"`
+	UserID          *int       `json:"user_id"`
+	NamaPeserta     string     `json:"nama_peserta"`
+	LMSAssignmentID *int64     `json:"lms_assignment_id"`
+	LMSClassID      *int64     `json:"lms_class_id"`
+	SoalID          int        `json:"id_soal"`
+	Pertanyaan      string     `json:"pertanyaan"`
+	JawabanEssayKey *string    `json:"jawaban_essay_key"`
+	JawabanEssay    string     `json:"jawaban_essay"`
+	NilaiEssay      *float64   `json:"nilai_essay"`
+	FeedbackTeacher *string    `json:"feedback_teacher"`
+	DijawabPada     *time.Time `json:"dijawab_pada"`
+}
+
+// MatchedPassage is a run of words shared by an answer and the text it was compared with
+type MatchedPassage struct {
+	Text         string `json:"text"`
+	Start        int    `json:"start"`
+	End          int    `json:"end"`
+	MatchedText  string `json:"matched_text"`
+	MatchedStart int    `json:"matched_start"`
+	MatchedEnd   int    `json:"matched_end"`
+	Words        int    `json:"words"`
+}
+
+// EssaySimilarity represents the essay_similarity table
+type EssaySimilarity struct {
+	ID                  int64                 `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDJawaban           int                   `json:"id_jawaban" gorm:"not null;index"`
+	IDSoal              int                   `json:"id_soal" gorm:"not null"`
+	LMSAssignmentID     int64                 `json:"lms_assignment_id" gorm:"not null"`
+	Source              EssaySimilaritySource `json:"source" gorm:"size:20;not null"`
+	MatchedIDJawaban    *int                  `json:"matched_id_jawaban"`
+	Similarity          float64               `json:"similarity"`
+	Containment         float64               `json:"containment"`
+	MatchedPassages     []MatchedPassage      `json:"matched_passages" gorm:"type:jsonb;serializer:json"`
+	ComputedAt          time.Time             `json:"computed_at" gorm:"autoCreateTime"`
+	MatchedSessionToken string                `json:"matched_session_token" gorm:"-"`
+	MatchedNamaPeserta  string                `json:"matched_nama_peserta" gorm:"-"`
+}
+
+func (EssaySimilarity) TableName() string { return "essay_similarity" }
+
+// EssaySimilarityRun summarizes one run of the similarity engine
+type EssaySimilarityRun struct {
+	LMSAssignmentID   int64
+	SoalID            int
+	AnswersCompared   int
+	PairsCompared     int
+	SimilaritiesSaved int
+	FlaggedAnswers    int
+	ComputedAt        time.Time
+}
+
+// EssayGradingView is what a grader sees before calling GradeEssayAnswer
+type EssayGradingView struct {
+	Answer       EssayAnswerForGrading
+	Similarities []EssaySimilarity
+	Rubric       *EssayRubric
+	RubricScores []JawabanRubricScore
+	Suggestion   *EssayScoreSuggestion
+}
+
+// DefaultEssayPassingScore is the nilai_essay an answer needs to count as correct
+// when its soal has no rubric
+const DefaultEssayPassingScore = 60.0
+
+// RubricLevel represents the soal_rubric_level table
+type RubricLevel struct {
+	ID          int64   `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDCriterion int64   `json:"id_criterion" gorm:"not null"`
+	Label       string  `json:"label" gorm:"size:100;not null"`
+	Deskripsi   *string `json:"deskripsi"`
+	Point       float64 `json:"point" gorm:"not null"`
+	Urutan      int     `json:"urutan"`
+}
+
+func (RubricLevel) TableName() string { return "soal_rubric_level" }
+
+// RubricCriterion represents the soal_rubric_criterion table
+type RubricCriterion struct {
+	ID        int64         `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDSoal    int           `json:"id_soal" gorm:"not null"`
+	Nama      string        `json:"nama" gorm:"size:255;not null"`
+	Deskripsi *string       `json:"deskripsi"`
+	Urutan    int           `json:"urutan"`
+	Levels    []RubricLevel `json:"levels" gorm:"foreignKey:IDCriterion"`
+}
+
+func (RubricCriterion) TableName() string { return "soal_rubric_criterion" }
+
+// MaxPoint returns the highest level point of the criterion
+func (c RubricCriterion) MaxPoint() float64 {
+	max := 0.0
+	for _, level := range c.Levels {
+		if level.Point > max {
+			max = level.Point
+		}
+	}
+	return max
+}
+
+// EssayRubric represents the soal_rubric table with its criteria
+type EssayRubric struct {
+	IDSoal       int               `json:"id_soal" gorm:"primaryKey"`
+	PassingScore float64           `json:"passing_score" gorm:"default:60"`
+	Criteria     []RubricCriterion `json:"criteria" gorm:"foreignKey:IDSoal"`
+	UpdatedBy    *int              `json:"updated_by"`
+	UpdatedAt    time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (EssayRubric) TableName() string { return "soal_rubric" }
+
+// MaxPoint returns the total points of the rubric
+func (r EssayRubric) MaxPoint() float64 {
+	total := 0.0
+	for _, criterion := range r.Criteria {
+		total += criterion.MaxPoint()
+	}
+	return total
+}
+
+// RubricSelection is the level a grader picked for one criterion
+type RubricSelection struct {
+	CriterionID int64
+	LevelID     int64
+	Feedback    string
+}
+
+// JawabanRubricScore represents the jawaban_rubric_score table.
+// Criterion and level names are copied so the breakdown survives rubric edits.
+type JawabanRubricScore struct {
+	ID           int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDJawaban    int       `json:"id_jawaban" gorm:"not null"`
+	IDCriterion  int64     `json:"id_criterion" gorm:"not null"`
+	IDLevel      int64     `json:"id_level" gorm:"not null"`
+	NamaKriteria string    `json:"nama_kriteria" gorm:"size:255"`
+	LabelLevel   string    `json:"label_level" gorm:"size:100"`
+	Point        float64   `json:"point"`
+	MaxPoint     float64   `json:"max_point"`
+	Feedback     *string   `json:"feedback"`
+	Urutan       int       `json:"urutan"`
+	GradedBy     *int      `json:"graded_by"`
+	GradedAt     time.Time `json:"graded_at" gorm:"autoCreateTime"`
+}
+
+func (JawabanRubricScore) TableName() string { return "jawaban_rubric_score" }
+
+// DefaultDiscrepancyThreshold is the largest difference between two marks that is
+// settled by averaging instead of moderation
+const DefaultDiscrepancyThreshold = 15.0
+
+// GradingConfig represents the grading_config table
+type GradingConfig struct {
+	LMSAssignmentID      int64     `json:"lms_assignment_id" gorm:"primaryKey"`
+	BlindMode            bool      `json:"blind_mode"`
+	DoubleMarking        bool      `json:"double_marking"`
+	DiscrepancyThreshold float64   `json:"discrepancy_threshold" gorm:"default:15"`
+	UpdatedBy            *int      `json:"updated_by"`
+	UpdatedAt            time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (GradingConfig) TableName() string { return "grading_config" }
+
+// EssayGradeWrite is the final grade of an essay answer. RubricScores replace the stored
+// breakdown, so a grade without them clears it. A pending moderation is resolved by GradedBy
+// with ModerationNote.
+type EssayGradeWrite struct {
+	AnswerID       int
+	NilaiEssay     float64
+	Feedback       string
+	RubricScores   []JawabanRubricScore
+	GradedBy       *int
+	ModerationNote string
+	// AcceptedSuggestion records that the grade is the suggested score, taken with
+	// accept_suggestion
+	AcceptedSuggestion bool
+}
+
+// EssayGradeChange is the grade of an essay answer before and after a write
+type EssayGradeChange struct {
+	SessionToken string
+	Before       *EssayGrade
+	After        *EssayGrade
+}
+
+// GradingTaskStatus defines valid essay grading task statuses
+type GradingTaskStatus string
+
+const (
+	GradingTaskAssigned  GradingTaskStatus = "assigned"
+	GradingTaskSubmitted GradingTaskStatus = "submitted"
+)
+
+// GradingTask represents the essay_grading_task table
+type GradingTask struct {
+	ID              int64                `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDJawaban       int                  `json:"id_jawaban" gorm:"not null"`
+	LMSAssignmentID int64                `json:"lms_assignment_id" gorm:"not null"`
+	GraderID        int                  `json:"grader_id" gorm:"not null"`
+	MarkerSlot      int                  `json:"marker_slot" gorm:"default:1"`
+	Status          GradingTaskStatus    `json:"status" gorm:"size:20;default:'assigned'"`
+	Score           *float64             `json:"score"`
+	Feedback        *string              `json:"feedback"`
+	RubricScores    []JawabanRubricScore `json:"rubric_scores" gorm:"type:jsonb;serializer:json"`
+	AssignedBy      *int                 `json:"assigned_by"`
+	AssignedAt      time.Time            `json:"assigned_at" gorm:"autoCreateTime"`
+	SubmittedAt     *time.Time           `json:"submitted_at"`
+}
+
+func (GradingTask) TableName() string { return "essay_grading_task" }
+
+// EssayModerationStatus defines valid essay moderation statuses
+type EssayModerationStatus string
+
+const (
+	EssayModerationPending  EssayModerationStatus = "pending"
+	EssayModerationResolved EssayModerationStatus = "resolved"
+)
+
+// EssayModeration represents the essay_moderation table
+type EssayModeration struct {
+	IDJawaban       int                   `json:"id_jawaban" gorm:"primaryKey"`
+	LMSAssignmentID int64                 `json:"lms_assignment_id" gorm:"not null"`
+	Status          EssayModerationStatus `json:"status" gorm:"size:20;default:'pending'"`
+	Discrepancy     float64               `json:"discrepancy"`
+	FinalScore      *float64              `json:"final_score"`
+	ModeratorID     *int                  `json:"moderator_id"`
+	Note            *string               `json:"note"`
+	CreatedAt       time.Time             `json:"created_at" gorm:"autoCreateTime"`
+	ResolvedAt      *time.Time            `json:"resolved_at"`
+}
+
+func (EssayModeration) TableName() string { return "essay_moderation" }
+
+// PendingEssayFilter narrows the grading queue; zero values are ignored
+type PendingEssayFilter struct {
+	LMSAssignmentID int64
+	LMSClassID      int64
+	SoalID          int
+	GraderID        int
+	// InModeration lists only essays whose two marks disagree
+	InModeration bool
+	AnswerIDs    []int
+	// Scope limits a teacher to essays of classes they teach and essays they were assigned to mark
+	Scope *TeacherScope
+}
+
+// PendingEssay is an ungraded essay in the grading queue
+type PendingEssay struct {
+	Answer     EssayAnswerForGrading
+	Tasks      []GradingTask
+	Moderation *EssayModeration
+	Suggestion *EssayScoreSuggestion
+}
+
+// Task returns the grading task of a marker slot, or nil
+func (p PendingEssay) Task(slot int) *GradingTask {
+	for i := range p.Tasks {
+		if p.Tasks[i].MarkerSlot == slot {
+			return &p.Tasks[i]
+		}
+	}
+	return nil
+}
+
+// EssayMarkStatus describes where an essay is after a mark was submitted
+type EssayMarkStatus string
+
+const (
+	EssayMarkFinalized          EssayMarkStatus = "finalized"
+	EssayMarkAwaitingSecondMark EssayMarkStatus = "awaiting_second_mark"
+	EssayMarkModeration         EssayMarkStatus = "moderation"
+)
+
+// EssayMarkResult is the outcome of submitting or moderating an essay mark
+type EssayMarkResult struct {
+	AnswerID      int
+	Status        EssayMarkStatus
+	NilaiEssay    *float64
+	Discrepancy   *float64
+	RubricScores  []JawabanRubricScore
+	SessionToken  string
+	SessionStatus TestStatus
+}
+
+// GradingProgress counts the essays and sessions of an assignment by grading state
+type GradingProgress struct {
+	LMSAssignmentID           int64
+	TotalEssays               int
+	GradedEssays              int
+	PendingEssays             int
+	UnassignedEssays          int
+	AwaitingSecondMark        int
+	InModeration              int
+	SessionsGradingInProgress int
+	SessionsGraded            int
+}
+
+// EssayKeyword represents the soal_essay_keyword table
+type EssayKeyword struct {
+	ID       int64    `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDSoal   int      `json:"id_soal" gorm:"not null"`
+	Keyword  string   `json:"keyword" gorm:"size:255;not null"`
+	Synonyms []string `json:"synonyms" gorm:"type:jsonb;serializer:json"`
+	Weight   float64  `json:"weight" gorm:"default:1"`
+	Urutan   int      `json:"urutan"`
+}
+
+func (EssayKeyword) TableName() string { return "soal_essay_keyword" }
+
+// KeywordMatch is an occurrence of a keyword, or one of its synonyms, in an answer
+type KeywordMatch struct {
+	Keyword     string `json:"keyword"`
+	MatchedTerm string `json:"matched_term"`
+	Start       int    `json:"start"`
+	End         int    `json:"end"`
+}
+
+// EssayScoreSuggestion represents the essay_score_suggestion table
+type EssayScoreSuggestion struct {
+	IDJawaban      int     `json:"id_jawaban" gorm:"primaryKey"`
+	IDSoal         int     `json:"id_soal" gorm:"not null"`
+	SuggestedScore float64 `json:"suggested_score"`
+	// KeywordCoverage is the weighted share of keywords found; nil when the soal has none
+	KeywordCoverage *float64 `json:"keyword_coverage"`
+	// ReferenceOverlap is the share of the answer key's word pairs found in the answer;
+	// nil when the soal has no answer key
+	ReferenceOverlap  *float64         `json:"reference_overlap"`
+	KeywordMatches    []KeywordMatch   `json:"keyword_matches" gorm:"type:jsonb;serializer:json"`
+	MissingKeywords   []string         `json:"missing_keywords" gorm:"type:jsonb;serializer:json"`
+	ReferencePassages []MatchedPassage `json:"reference_passages" gorm:"type:jsonb;serializer:json"`
+	ComputedAt        time.Time        `json:"computed_at" gorm:"autoCreateTime"`
+}
+
+func (EssayScoreSuggestion) TableName() string { return "essay_score_suggestion" }
+
+// EssaySuggestionRun summarizes one run of the scoring assistant
+type EssaySuggestionRun struct {
+	LMSAssignmentID  int64
+	SoalID           int
+	AnswersScored    int
+	SkippedNoKeyData int
+	ComputedAt       time.Time
+}
+
+// SuggestionAgreementTolerance is how far a final score may be from the suggestion
+// and still count as agreeing with it
+const SuggestionAgreementTolerance = 5.0
+
+// SuggestionAgreement compares suggested scores with the final nilai_essay of graded answers
+type SuggestionAgreement struct {
+	LMSAssignmentID        int64
+	SoalID                 int
+	GradedWithSuggestion   int
+	Agreed                 int
+	AcceptedExactly        int
+	AgreementRate          float64
+	MeanAbsoluteDifference float64
+}
@@ -16,10 +16,24 @@ type JawabanSiswa struct {
 	JawabanDipilih *JawabanOption `json:"jawaban_dipilih" gorm:"type:char(1)"`
 
 	// Question type for routing
-	QuestionType QuestionType `json:"question_type" gorm:"type:enum('multiple_choice','drag_drop','essay','multiple_choices_complex');default:'multiple_choice'"`
+	QuestionType QuestionType `json:"question_type" gorm:"type:enum('multiple_choice','drag_drop','essay','multiple_choices_complex','short_answer','numeric','hotspot','grid');default:'multiple_choice'"`
 
 	JawabanDipilihComplex *string `json:"jawaban_dipilih_complex,omitempty" gorm:"column:jawaban_dipilih_complex;type:json"`
 
+	// Short-answer responses, one per blank - stored as JSON
+	JawabanShortAnswer *string `json:"jawaban_short_answer,omitempty" gorm:"column:jawaban_short_answer;type:json"`
+
+	// Numeric answer as the student typed it, unit included
+	JawabanNumeric *string `json:"jawaban_numeric,omitempty" gorm:"column:jawaban_numeric;type:text"`
+
+	// Hotspot points the student placed on the image - stored as JSON
+	JawabanHotspot *string `json:"jawaban_hotspot,omitempty" gorm:"column:jawaban_hotspot;type:json"`
+
+	// Grid answer, the picked column per row - stored as JSON
+	JawabanGrid *string `json:"jawaban_grid,omitempty" gorm:"column:jawaban_grid;type:json"`
+	// Percentage of the point earned by question types with partial credit
+	NilaiParsial *float64 `json:"nilai_parsial,omitempty" gorm:"column:nilai_parsial;type:decimal(5,2)"`
+
 	// Drag-drop answer (for DRAG_DROP questions) - stored as JSON
 	JawabanDragDrop *string  `json:"jawaban_drag_drop,omitempty" gorm:"type:json"`
 	JawabanEssay    *string  `json:"jawaban_essay,omitempty" gorm:"column:jawaban_essay;type:text"`
@@ -58,10 +72,12 @@ type JawabanDetail struct {
 	NomorUrut      int            `json:"nomor_urut"`
 	QuestionType   QuestionType   `json:"question_type"`
 	Pertanyaan     string         `json:"pertanyaan"`
+	FormatKonten   FormatKonten   `json:"format_konten"`
 	OpsiA          string         `json:"opsi_a,omitempty"`
 	OpsiB          string         `json:"opsi_b,omitempty"`
 	OpsiC          string         `json:"opsi_c,omitempty"`
 	OpsiD          string         `json:"opsi_d,omitempty"`
+	Opsi           []SoalOpsi     `json:"opsi,omitempty"`
 	JawabanDipilih *JawabanOption `json:"jawaban_dipilih,omitempty"`
 	JawabanBenar   JawabanOption  `json:"jawaban_benar,omitempty"`
 	IsCorrect      bool           `json:"is_correct"`
@@ -69,16 +85,29 @@ type JawabanDetail struct {
 	Pembahasan     *string        `json:"pembahasan,omitempty"`
 	Gambar         []SoalGambar   `json:"gambar"`
 	// Drag Drop Fields
-	DragType          *DragDropType `json:"drag_type,omitempty"`
-	DragItems         []DragItem    `json:"items,omitempty"`
-	DragSlots         []DragSlot    `json:"slots,omitempty"`
-	UserDragAnswer    map[int]int   `json:"user_drag_answer,omitempty"`
-	CorrectDragAnswer map[int]int   `json:"correct_drag_answer,omitempty"`
-	JawabanEssay      *string       `json:"jawaban_essay,omitempty"`
-	NilaiEssay        *float64      `json:"nilai_essay,omitempty"`
-	FeedbackTeacher   *string       `json:"feedback_teacher,omitempty"`
-	JawabanDipilihComplex []JawabanOption `json:"jawaban_dipilih_complex,omitempty"`
-	JawabanBenarComplex []JawabanOption `json:"jawaban_benar_complex,omitempty"`
+	DragType                *DragDropType        `json:"drag_type,omitempty"`
+	DragItems               []DragItem           `json:"items,omitempty"`
+	DragSlots               []DragSlot           `json:"slots,omitempty"`
+	UserDragAnswer          map[int]int          `json:"user_drag_answer,omitempty"`
+	CorrectDragAnswer       map[int]int          `json:"correct_drag_answer,omitempty"`
+	JawabanEssay            *string              `json:"jawaban_essay,omitempty"`
+	NilaiEssay              *float64             `json:"nilai_essay,omitempty"`
+	FeedbackTeacher         *string              `json:"feedback_teacher,omitempty"`
+	JawabanDipilihComplex   []JawabanOption      `json:"jawaban_dipilih_complex,omitempty"`
+	JawabanBenarComplex     []JawabanOption      `json:"jawaban_benar_complex,omitempty"`
+	RubricScores            []JawabanRubricScore `json:"rubric_scores,omitempty"`
+	JawabanShortAnswer      []string             `json:"jawaban_short_answer,omitempty"`
+	ShortAnswerBlanks       []ShortAnswerBlank   `json:"short_answer_blanks,omitempty"`
+	ShortAnswerBlankCorrect []bool               `json:"short_answer_blank_correct,omitempty"`
+	JawabanNumeric          *string              `json:"jawaban_numeric,omitempty"`
+	NumericAnswerKey        *NumericAnswerKey    `json:"numeric_answer_key,omitempty"`
+	JawabanHotspot          []HotspotPoint       `json:"jawaban_hotspot,omitempty"`
+	HotspotAnswerKey        *HotspotAnswerKey    `json:"hotspot_answer_key,omitempty"`
+	HotspotPointCorrect     []bool               `json:"hotspot_point_correct,omitempty"`
+	JawabanGrid             []int                `json:"jawaban_grid,omitempty"`
+	GridAnswerKey           *GridAnswerKey       `json:"grid_answer_key,omitempty"`
+	GridRowCorrect          []bool               `json:"grid_row_correct,omitempty"`
+	NilaiParsial            *float64             `json:"nilai_parsial,omitempty"`
 }
 
 func (j *JawabanSiswa) GetJawabanDipilihComplex() []JawabanOption {
@@ -113,3 +142,10 @@ func (j *JawabanSiswa) SetJawabanDipilihComplex(options []JawabanOption) error {
 	j.JawabanDipilihComplex = &encoded
 	return nil
 }
+
+// EssayGrade is the teacher's grade of an essay answer
+type EssayGrade struct {
+	NilaiEssay      *float64 `json:"nilai_essay"`
+	FeedbackTeacher *string  `json:"feedback_teacher"`
+	IsCorrect       bool     `json:"is_correct"`
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// LabCredential represents the lab_login_credentials table: a one-time login printed on a
+// student's QR card, also usable by typing its PIN, for one scheduled test session. Only
+// keyed hashes of the PIN and of the QR nonce are stored.
+type LabCredential struct {
+	ID              int        `json:"id" gorm:"primaryKey;autoIncrement"`
+	TestSessionID   int        `json:"test_session_id" gorm:"not null"`
+	UserID          int        `json:"user_id" gorm:"not null"`
+	LMSAssignmentID int64      `json:"lms_assignment_id" gorm:"not null"`
+	SchoolID        *int64     `json:"school_id"`
+	PinHash         string     `json:"-" gorm:"not null"`
+	QRNonceHash     string     `json:"-" gorm:"not null"`
+	ExpiresAt       time.Time  `json:"expires_at" gorm:"not null"`
+	UsedAt          *time.Time `json:"used_at"`
+	RevokedAt       *time.Time `json:"revoked_at"`
+	AuthSessionID   *int64     `json:"auth_session_id"`
+	CreatedBy       int        `json:"created_by" gorm:"not null"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (LabCredential) TableName() string { return "lab_login_credentials" }
+
+// IsUsable reports whether the credential can still log in at now
+func (c *LabCredential) IsUsable(now time.Time) bool {
+	return c.UsedAt == nil && c.RevokedAt == nil && now.Before(c.ExpiresAt)
+}
+
+// LabSession is a test session lab credentials can be issued for or log in to
+type LabSession struct {
+	TestSessionID   int
+	SessionToken    string
+	UserID          int
+	NamaPeserta     string
+	LMSAssignmentID int64
+	Status          TestStatus
+	SchoolID        *int64
+}
+
+// IssuedLabCredential is a new credential with the PIN and QR code to print; they cannot
+// be read back later
+type IssuedLabCredential struct {
+	LabCredential
+	NamaPeserta string
+	PIN         string
+	QRCode      string
+}
+
+// LabLoginResult is what LabLogin hands back to the lab computer besides the user
+type LabLoginResult struct {
+	AccessToken   string
+	ExpiresAt     time.Time
+	SessionToken  string
+	AuthSessionID int64
+}
+
+var (
+	ErrLabLoginDisabled     = errors.New("lab login is not enabled")
+	ErrInvalidLabCredential = errors.New("invalid, used or expired lab login credential")
+	ErrLabSessionClosed     = errors.New("the test session of this credential is no longer open")
+	ErrTooManyPinAttempts   = errors.New("too many wrong PINs, please wait before trying again")
+)
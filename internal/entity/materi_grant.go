@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// MateriPermission is what a teacher may do with a materi and its questions
+type MateriPermission string
+
+const (
+	MateriPermissionNone MateriPermission = ""
+	// MateriPermissionView is a shared materi: it is listed and its questions can be read
+	MateriPermissionView MateriPermission = "view"
+	// MateriPermissionEdit is a co-owner: the materi and its questions can be changed
+	MateriPermissionEdit MateriPermission = "edit"
+	// MateriPermissionOwner can additionally delete the materi and manage its grants
+	MateriPermissionOwner MateriPermission = "owner"
+)
+
+var materiPermissionRank = map[MateriPermission]int{
+	MateriPermissionView:  1,
+	MateriPermissionEdit:  2,
+	MateriPermissionOwner: 3,
+}
+
+// Allows reports whether p includes need
+func (p MateriPermission) Allows(need MateriPermission) bool {
+	return materiPermissionRank[p] > 0 && materiPermissionRank[p] >= materiPermissionRank[need]
+}
+
+// IsGrantable reports whether p can be given to another teacher; ownership cannot
+func (p MateriPermission) IsGrantable() bool {
+	return p == MateriPermissionView || p == MateriPermissionEdit
+}
+
+// MateriGrant shares a materi with a teacher other than its owner
+type MateriGrant struct {
+	ID         int64            `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDMateri   int              `json:"id_materi" gorm:"not null;uniqueIndex:uq_materi_grant"`
+	UserID     int              `json:"user_id" gorm:"not null;uniqueIndex:uq_materi_grant"`
+	UserName   string           `json:"user_name" gorm:"-"`
+	Permission MateriPermission `json:"permission" gorm:"type:varchar(10);not null"`
+	GrantedBy  int              `json:"granted_by" gorm:"not null"`
+	CreatedAt  time.Time        `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (MateriGrant) TableName() string { return "materi_grants" }
+
+// MateriResource is the kind of record an id refers to when resolving the materi it belongs to
+type MateriResource string
+
+const (
+	MateriResourceMateri       MateriResource = "materi"
+	MateriResourceSoal         MateriResource = "soal"
+	MateriResourceSoalGambar   MateriResource = "soal_gambar"
+	MateriResourceSoalMedia    MateriResource = "soal_media"
+	MateriResourceSoalDragDrop MateriResource = "soal_drag_drop"
+)
+
+// TeacherScope restricts repository queries to what one teacher may access: materi
+// they own or that are shared with them, and sessions of the classes they teach.
+// A nil *TeacherScope is unrestricted.
+type TeacherScope struct {
+	UserID int
+}
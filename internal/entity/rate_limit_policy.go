@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// RateLimitGroupDefault is the method group of methods without a rate_limit_method_groups row
+const RateLimitGroupDefault = "default"
+
+// RateLimitPolicy represents the rate_limit_policies table: the limit of one method group,
+// optionally narrowed to a role, a school plan or a single school
+type RateLimitPolicy struct {
+	ID          int     `json:"id" gorm:"primaryKey;autoIncrement"`
+	MethodGroup string  `json:"method_group" gorm:"not null;size:24"`
+	Role        *string `json:"role" gorm:"size:20"`
+	SchoolPlan  *string `json:"school_plan" gorm:"size:50"`
+	SchoolID    *int64  `json:"school_id"`
+	LimitType   string  `json:"limit_type" gorm:"not null;size:50"`
+	// LimitValue nil keeps the user's own user_limits value
+	LimitValue *int `json:"limit_value"`
+	// Burst is headroom on top of the limit, e.g. for everyone reconnecting at exam start
+	Burst      int       `json:"burst" gorm:"not null;default:0"`
+	ExamExempt bool      `json:"exam_exempt" gorm:"not null;default:false"`
+	Enabled    bool      `json:"enabled" gorm:"not null;default:true"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (RateLimitPolicy) TableName() string { return "rate_limit_policies" }
+
+// Bucket is the user_limits limit_type the policy counts in. The default group counts in
+// the plain limit type, so existing per-user limits keep applying to it.
+func (p RateLimitPolicy) Bucket() string {
+	if p.MethodGroup == "" || p.MethodGroup == RateLimitGroupDefault {
+		return p.LimitType
+	}
+	return p.LimitType + "." + p.MethodGroup
+}
+
+// Specificity orders matching policies: a school beats a plan, which beats a role
+func (p RateLimitPolicy) Specificity() int {
+	score := 0
+	if p.SchoolID != nil {
+		score += 4
+	}
+	if p.SchoolPlan != nil {
+		score += 2
+	}
+	if p.Role != nil {
+		score++
+	}
+	return score
+}
+
+// RateLimitMethodGroup represents the rate_limit_method_groups table. Method is a full gRPC
+// method name or "/base.<Service>/*" for every method of a service.
+type RateLimitMethodGroup struct {
+	Method      string    `json:"method" gorm:"primaryKey;size:200"`
+	MethodGroup string    `json:"method_group" gorm:"not null;size:24"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (RateLimitMethodGroup) TableName() string { return "rate_limit_method_groups" }
+
+// SchoolPlan represents the school_plans table
+type SchoolPlan struct {
+	SchoolID  int64     `json:"school_id" gorm:"primaryKey"`
+	Plan      string    `json:"plan" gorm:"not null;size:50"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+func (SchoolPlan) TableName() string { return "school_plans" }
+
+// RateLimitPolicySet is everything the rate limiter needs to pick a policy
+type RateLimitPolicySet struct {
+	Policies     []RateLimitPolicy
+	MethodGroups []RateLimitMethodGroup
+	SchoolPlans  []SchoolPlan
+}
+
+// BaseLimitType strips the method group from a bucket name
+func BaseLimitType(bucket string) string {
+	if i := strings.IndexByte(bucket, '.'); i >= 0 {
+		return bucket[:i]
+	}
+	return bucket
+}
+
+// IsLimitType reports whether limitType is one of the predefined limit types
+func IsLimitType(limitType string) bool {
+	switch limitType {
+	case LimitTypeTestSessionsPerDay, LimitTypeTestSessionsPerWeek, LimitTypeAPIRequestsPerHour,
+		LimitTypeAPIRequestsPerDay, LimitTypeQuestionsPerDay:
+		return true
+	}
+	return false
+}
+
+var ErrRateLimitPolicyNotFound = errors.New("rate limit policy not found")
+
+// ErrRateLimitPolicyCrossTenant is returned when a school admin changes rate limits, which
+// apply to every school
+var ErrRateLimitPolicyCrossTenant = errors.New("rate limit policies can only be changed by a superadmin of every school")
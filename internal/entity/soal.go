@@ -10,47 +10,64 @@ const (
 	JawabanB JawabanOption = "B"
 	JawabanC JawabanOption = "C"
 	JawabanD JawabanOption = "D"
+	JawabanE JawabanOption = "E"
+)
+
+// FormatKonten is the format pertanyaan, options and pembahasan are written in
+type FormatKonten string
+
+const (
+	FormatKontenPlain         FormatKonten = "plain"
+	FormatKontenMarkdownLatex FormatKonten = "markdown_latex"
+	FormatKontenMathML        FormatKonten = "mathml"
 )
 
 type Soal struct {
-	ID              int           `json:"id" gorm:"primaryKey;autoIncrement"`
-	IDMateri        int           `json:"id_materi" gorm:"not null"`
-	LMSAssetID      *int64        `json:"lms_asset_id,omitempty" gorm:"column:lms_asset_id"`
-	Materi          Materi        `json:"materi" gorm:"foreignKey:IDMateri"`
-	IDTingkat       int           `json:"id_tingkat" gorm:"not null"`
-	Tingkat         Tingkat       `json:"tingkat" gorm:"foreignKey:IDTingkat"`
-	Pertanyaan      string        `json:"pertanyaan" gorm:"type:text;not null"`
-	Point           float64       `json:"point" gorm:"column:point;type:decimal(10,2);not null;default:1"`
-	Urutan          int           `json:"urutan" gorm:"column:urutan;not null;default:0"`
-	QuestionType    QuestionType  `json:"question_type" gorm:"column:question_type;type:enum('multiple_choice','drag_drop','essay','multiple_choices_complex');default:'multiple_choice'"`
-	OpsiA           string        `json:"opsi_a" gorm:"not null"`
-	OpsiB           string        `json:"opsi_b" gorm:"not null"`
-	OpsiC           string        `json:"opsi_c" gorm:"not null"`
-	OpsiD           string        `json:"opsi_d" gorm:"not null"`
-	JawabanBenar    JawabanOption `json:"-" gorm:"type:char(1);not null"`
-	JawabanBenarComplex *string   `json:"jawaban_benar_complex,omitempty" gorm:"column:jawaban_benar_complex;type:json"`
-	JawabanEssayKey *string       `json:"jawaban_essay_key,omitempty" gorm:"column:jawaban_essay_key;type:text"`
-	Pembahasan      *string       `json:"pembahasan,omitempty" gorm:"type:text"`
-	IsActive        bool          `json:"is_active" gorm:"default:true"`
-	Gambar          []SoalGambar  `json:"gambar" gorm:"foreignKey:IDSoal;references:ID;constraint:OnDelete:CASCADE"`
+	ID                  int           `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDMateri            int           `json:"id_materi" gorm:"not null"`
+	LMSAssetID          *int64        `json:"lms_asset_id,omitempty" gorm:"column:lms_asset_id"`
+	Materi              Materi        `json:"materi" gorm:"foreignKey:IDMateri"`
+	IDTingkat           int           `json:"id_tingkat" gorm:"not null"`
+	Tingkat             Tingkat       `json:"tingkat" gorm:"foreignKey:IDTingkat"`
+	Pertanyaan          string        `json:"pertanyaan" gorm:"type:text;not null"`
+	Point               float64       `json:"point" gorm:"column:point;type:decimal(10,2);not null;default:1"`
+	Urutan              int           `json:"urutan" gorm:"column:urutan;not null;default:0"`
+	QuestionType        QuestionType  `json:"question_type" gorm:"column:question_type;type:enum('multiple_choice','drag_drop','essay','multiple_choices_complex','short_answer','numeric','hotspot','grid');default:'multiple_choice'"`
+	OpsiA               string        `json:"opsi_a" gorm:"not null"`
+	OpsiB               string        `json:"opsi_b" gorm:"not null"`
+	OpsiC               string        `json:"opsi_c" gorm:"not null"`
+	OpsiD               string        `json:"opsi_d" gorm:"not null"`
+	JawabanBenar        JawabanOption `json:"-" gorm:"type:char(1);not null"`
+	JawabanBenarComplex *string       `json:"jawaban_benar_complex,omitempty" gorm:"column:jawaban_benar_complex;type:json"`
+	JawabanEssayKey     *string       `json:"jawaban_essay_key,omitempty" gorm:"column:jawaban_essay_key;type:text"`
+	JawabanShortAnswer  *string       `json:"jawaban_short_answer,omitempty" gorm:"column:jawaban_short_answer;type:json"`
+	JawabanNumeric      *string       `json:"jawaban_numeric,omitempty" gorm:"column:jawaban_numeric;type:json"`
+	JawabanHotspot      *string       `json:"jawaban_hotspot,omitempty" gorm:"column:jawaban_hotspot;type:json"`
+	JawabanGrid         *string       `json:"jawaban_grid,omitempty" gorm:"column:jawaban_grid;type:json"`
+	Pembahasan          *string       `json:"pembahasan,omitempty" gorm:"type:text"`
+	FormatKonten        FormatKonten  `json:"format_konten" gorm:"column:format_konten;type:varchar(20);not null;default:'plain'"`
+	IsActive            bool          `json:"is_active" gorm:"default:true"`
+	Gambar              []SoalGambar  `json:"gambar" gorm:"foreignKey:IDSoal;references:ID;constraint:OnDelete:CASCADE"`
+	Opsi                []SoalOpsi    `json:"opsi,omitempty" gorm:"foreignKey:IDSoal;references:ID;constraint:OnDelete:CASCADE"`
+	Media               []SoalMedia   `json:"media,omitempty" gorm:"foreignKey:IDSoal;references:ID;constraint:OnDelete:CASCADE"`
 }
 
 func (Soal) TableName() string { return "soal" }
 
 // SoalForStudent represents a question for students (without correct answer)
 type SoalForStudent struct {
-	ID             int            `json:"id"`
-	NomorUrut      int            `json:"nomor_urut"`
-	Pertanyaan     string         `json:"pertanyaan"`
-	OpsiA          string         `json:"opsi_a"`
-	OpsiB          string         `json:"opsi_b"`
-	OpsiC          string         `json:"opsi_c"`
-	OpsiD          string         `json:"opsi_d"`
-	JawabanDipilih *JawabanOption `json:"jawaban_dipilih"`
+	ID                    int             `json:"id"`
+	NomorUrut             int             `json:"nomor_urut"`
+	Pertanyaan            string          `json:"pertanyaan"`
+	OpsiA                 string          `json:"opsi_a"`
+	OpsiB                 string          `json:"opsi_b"`
+	OpsiC                 string          `json:"opsi_c"`
+	OpsiD                 string          `json:"opsi_d"`
+	JawabanDipilih        *JawabanOption  `json:"jawaban_dipilih"`
 	JawabanDipilihComplex []JawabanOption `json:"jawaban_dipilih_complex,omitempty"`
-	IsAnswered     bool           `json:"is_answered"`
-	Materi         Materi         `json:"materi"`
-	Gambar         []SoalGambar   `json:"gambar"`
+	IsAnswered            bool            `json:"is_answered"`
+	Materi                Materi          `json:"materi"`
+	Gambar                []SoalGambar    `json:"gambar"`
 }
 
 // QuestionForStudent represents a unified question for students (multiple choice or drag-drop)
@@ -59,18 +76,20 @@ type QuestionForStudent struct {
 	QuestionType QuestionType `json:"question_type"`
 	Materi       Materi       `json:"materi"`
 	IsAnswered   bool         `json:"is_answered"`
+	FormatKonten FormatKonten `json:"format_konten"`
 
 	// Multiple choice fields
-	MCID             *int           `json:"mc_id,omitempty"`
-	MCPertanyaan     *string        `json:"mc_pertanyaan,omitempty"`
-	MCOpsiA          *string        `json:"mc_opsi_a,omitempty"`
-	MCOpsiB          *string        `json:"mc_opsi_b,omitempty"`
-	MCOpsiC          *string        `json:"mc_opsi_c,omitempty"`
-	MCOpsiD          *string        `json:"mc_opsi_d,omitempty"`
-	MCJawabanDipilih *JawabanOption `json:"mc_jawaban_dipilih,omitempty"`
+	MCID                    *int            `json:"mc_id,omitempty"`
+	MCPertanyaan            *string         `json:"mc_pertanyaan,omitempty"`
+	MCOpsiA                 *string         `json:"mc_opsi_a,omitempty"`
+	MCOpsiB                 *string         `json:"mc_opsi_b,omitempty"`
+	MCOpsiC                 *string         `json:"mc_opsi_c,omitempty"`
+	MCOpsiD                 *string         `json:"mc_opsi_d,omitempty"`
+	MCJawabanDipilih        *JawabanOption  `json:"mc_jawaban_dipilih,omitempty"`
 	MCJawabanDipilihComplex []JawabanOption `json:"mc_jawaban_dipilih_complex,omitempty"`
-	MCJawabanBenarComplex []JawabanOption `json:"mc_jawaban_benar_complex,omitempty"`
-	MCGambar         []SoalGambar   `json:"mc_gambar,omitempty"`
+	MCJawabanBenarComplex   []JawabanOption `json:"mc_jawaban_benar_complex,omitempty"`
+	MCGambar                []SoalGambar    `json:"mc_gambar,omitempty"`
+	MCOpsi                  []SoalOpsi      `json:"mc_opsi,omitempty"`
 
 	// Drag-drop fields
 	DDID         *int          `json:"dd_id,omitempty"`
@@ -87,15 +106,58 @@ type QuestionForStudent struct {
 	EssayScore      *float64 `json:"essay_score,omitempty"`
 
 	// Multiple choices complex fields
-	MCCID                 *int            `json:"mcc_id,omitempty"`
-	MCCPertanyaan         *string         `json:"mcc_pertanyaan,omitempty"`
-	MCCOpsiA              *string         `json:"mcc_opsi_a,omitempty"`
-	MCCOpsiB              *string         `json:"mcc_opsi_b,omitempty"`
-	MCCOpsiC              *string         `json:"mcc_opsi_c,omitempty"`
-	MCCOpsiD              *string         `json:"mcc_opsi_d,omitempty"`
-	MCCJawabanDipilih     []JawabanOption `json:"mcc_jawaban_dipilih,omitempty"`
-	MCCJawabanBenar       []JawabanOption `json:"mcc_jawaban_benar,omitempty"`
-	MCCGambar             []SoalGambar    `json:"mcc_gambar,omitempty"`
+	MCCID             *int            `json:"mcc_id,omitempty"`
+	MCCPertanyaan     *string         `json:"mcc_pertanyaan,omitempty"`
+	MCCOpsiA          *string         `json:"mcc_opsi_a,omitempty"`
+	MCCOpsiB          *string         `json:"mcc_opsi_b,omitempty"`
+	MCCOpsiC          *string         `json:"mcc_opsi_c,omitempty"`
+	MCCOpsiD          *string         `json:"mcc_opsi_d,omitempty"`
+	MCCJawabanDipilih []JawabanOption `json:"mcc_jawaban_dipilih,omitempty"`
+	MCCJawabanBenar   []JawabanOption `json:"mcc_jawaban_benar,omitempty"`
+	MCCGambar         []SoalGambar    `json:"mcc_gambar,omitempty"`
+	MCCOpsi           []SoalOpsi      `json:"mcc_opsi,omitempty"`
+
+	// Short answer / cloze fields
+	SAID         *int         `json:"sa_id,omitempty"`
+	SAPertanyaan *string      `json:"sa_pertanyaan,omitempty"`
+	SABlankCount int          `json:"sa_blank_count,omitempty"`
+	SAJawaban    []string     `json:"sa_jawaban,omitempty"`
+	SAGambar     []SoalGambar `json:"sa_gambar,omitempty"`
+
+	// Numeric fields
+	NUMID         *int         `json:"num_id,omitempty"`
+	NUMPertanyaan *string      `json:"num_pertanyaan,omitempty"`
+	NUMJawaban    *string      `json:"num_jawaban,omitempty"`
+	NUMGambar     []SoalGambar `json:"num_gambar,omitempty"`
+
+	// Hotspot fields
+	HSID          *int           `json:"hs_id,omitempty"`
+	HSPertanyaan  *string        `json:"hs_pertanyaan,omitempty"`
+	HSGambar      []SoalGambar   `json:"hs_gambar,omitempty"`
+	HSImageUrutan int            `json:"hs_image_urutan,omitempty"`
+	HSMaxPoints   int            `json:"hs_max_points,omitempty"`
+	HSJawaban     []HotspotPoint `json:"hs_jawaban,omitempty"`
+
+	// Grid fields
+	GRIDID         *int         `json:"grid_id,omitempty"`
+	GRIDPertanyaan *string      `json:"grid_pertanyaan,omitempty"`
+	GRIDRows       []string     `json:"grid_rows,omitempty"`
+	GRIDColumns    []string     `json:"grid_columns,omitempty"`
+	GRIDJawaban    []int        `json:"grid_jawaban,omitempty"`
+	GRIDGambar     []SoalGambar `json:"grid_gambar,omitempty"`
+
+	// Audio and video clips of the question, for every type except drag-drop
+	Media []SessionMedia `json:"media,omitempty"`
+}
+
+// Pertanyaan returns the question text of whichever question type q is
+func (q *QuestionForStudent) Pertanyaan() string {
+	for _, pertanyaan := range []*string{q.MCPertanyaan, q.MCCPertanyaan, q.DDPertanyaan, q.EssayPertanyaan, q.SAPertanyaan, q.NUMPertanyaan, q.HSPertanyaan, q.GRIDPertanyaan} {
+		if pertanyaan != nil {
+			return *pertanyaan
+		}
+	}
+	return ""
 }
 
 func (s *Soal) GetJawabanBenarComplex() []JawabanOption {
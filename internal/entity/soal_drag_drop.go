@@ -14,10 +14,14 @@ const (
 type QuestionType string
 
 const (
-	QuestionTypeMultipleChoice QuestionType = "multiple_choice"
-	QuestionTypeDragDrop       QuestionType = "drag_drop"
-	QuestionTypeEssay          QuestionType = "essay"
+	QuestionTypeMultipleChoice         QuestionType = "multiple_choice"
+	QuestionTypeDragDrop               QuestionType = "drag_drop"
+	QuestionTypeEssay                  QuestionType = "essay"
 	QuestionTypeMultipleChoicesComplex QuestionType = "multiple_choices_complex"
+	QuestionTypeShortAnswer            QuestionType = "short_answer"
+	QuestionTypeNumeric                QuestionType = "numeric"
+	QuestionTypeHotspot                QuestionType = "hotspot"
+	QuestionTypeGrid                   QuestionType = "grid"
 )
 
 // SoalDragDrop represents a drag-and-drop question
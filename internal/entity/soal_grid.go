@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxGridRows is the most statements a grid question may have
+	MaxGridRows = 30
+	// MaxGridColumns is the most columns a grid question may have
+	MaxGridColumns = 10
+	// GridUnanswered marks a row the student has not answered
+	GridUnanswered = -1
+)
+
+// GridRow is one statement of a grid question with the index of its correct column
+type GridRow struct {
+	Teks       string `json:"teks"`
+	KolomBenar int    `json:"kolom_benar"`
+}
+
+// GridAnswerKey is the answer key of a grid (matrix) question: a table of statements each
+// marked in one of the columns, e.g. "Benar" / "Salah" or "Setuju" ... "Tidak setuju".
+//
+// Each row scores on its own, so a student with 3 of 4 rows right gets 75% of the point.
+// With AllOrNothing only a fully correct grid scores.
+type GridAnswerKey struct {
+	Columns      []string  `json:"columns"`
+	Rows         []GridRow `json:"rows"`
+	AllOrNothing bool      `json:"all_or_nothing,omitempty"`
+}
+
+// Validate checks the key before it is saved
+func (k GridAnswerKey) Validate() error {
+	if len(k.Columns) < 2 || len(k.Columns) > MaxGridColumns {
+		return fmt.Errorf("a grid must have between 2 and %d columns", MaxGridColumns)
+	}
+	for i, column := range k.Columns {
+		if strings.TrimSpace(column) == "" {
+			return fmt.Errorf("grid column %d must be filled", i+1)
+		}
+	}
+	if len(k.Rows) == 0 || len(k.Rows) > MaxGridRows {
+		return fmt.Errorf("a grid must have between 1 and %d rows", MaxGridRows)
+	}
+	for i, row := range k.Rows {
+		if strings.TrimSpace(row.Teks) == "" {
+			return fmt.Errorf("grid row %d must be filled", i+1)
+		}
+		if row.KolomBenar < 0 || row.KolomBenar >= len(k.Columns) {
+			return fmt.Errorf("grid row %d has no valid correct column", i+1)
+		}
+	}
+	return nil
+}
+
+// RowTexts returns the statements in order, without their answers
+func (k GridAnswerKey) RowTexts() []string {
+	texts := make([]string, 0, len(k.Rows))
+	for _, row := range k.Rows {
+		texts = append(texts, row.Teks)
+	}
+	return texts
+}
+
+// Check marks each row of the response. It returns whether every row is right, the fraction
+// of the point earned and, per row, whether it is right. A missing row is wrong.
+func (k GridAnswerKey) Check(jawaban []int) (bool, float64, []bool) {
+	rowCorrect := make([]bool, len(k.Rows))
+	benar := 0
+	for i, row := range k.Rows {
+		if i < len(jawaban) && jawaban[i] == row.KolomBenar {
+			rowCorrect[i] = true
+			benar++
+		}
+	}
+	if len(k.Rows) == 0 {
+		return false, 0, rowCorrect
+	}
+	allCorrect := benar == len(k.Rows)
+	if k.AllOrNothing {
+		if allCorrect {
+			return true, 1, rowCorrect
+		}
+		return false, 0, rowCorrect
+	}
+	return allCorrect, float64(benar) / float64(len(k.Rows)), rowCorrect
+}
+
+// ValidateJawaban checks that a response picks an existing column, or none, for each row
+func (k GridAnswerKey) ValidateJawaban(jawaban []int) error {
+	if len(jawaban) != len(k.Rows) {
+		return fmt.Errorf("jawaban grid must have %d rows", len(k.Rows))
+	}
+	for i, kolom := range jawaban {
+		if kolom != GridUnanswered && (kolom < 0 || kolom >= len(k.Columns)) {
+			return fmt.Errorf("jawaban grid row %d has an invalid column", i+1)
+		}
+	}
+	return nil
+}
+
+// GetGridAnswerKey parses the answer key of a grid question
+func (s *Soal) GetGridAnswerKey() *GridAnswerKey {
+	if s.JawabanGrid == nil {
+		return nil
+	}
+	var key GridAnswerKey
+	if err := json.Unmarshal([]byte(*s.JawabanGrid), &key); err != nil {
+		return nil
+	}
+	return &key
+}
+
+// SetGridAnswerKey serializes the answer key of a grid question
+func (s *Soal) SetGridAnswerKey(key *GridAnswerKey) error {
+	if key == nil {
+		s.JawabanGrid = nil
+		return nil
+	}
+	bytes, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	s.JawabanGrid = &encoded
+	return nil
+}
+
+// GetJawabanGrid parses the column the student picked for each row
+func (j *JawabanSiswa) GetJawabanGrid() []int {
+	if j.JawabanGrid == nil {
+		return nil
+	}
+	var jawaban []int
+	if err := json.Unmarshal([]byte(*j.JawabanGrid), &jawaban); err != nil {
+		return nil
+	}
+	return jawaban
+}
+
+// SetJawabanGrid serializes the column the student picked for each row
+func (j *JawabanSiswa) SetJawabanGrid(jawaban []int) error {
+	if len(jawaban) == 0 {
+		j.JawabanGrid = nil
+		return nil
+	}
+	bytes, err := json.Marshal(jawaban)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	j.JawabanGrid = &encoded
+	return nil
+}
+
+// IsGridAnswered reports whether the student picked a column in at least one row
+func IsGridAnswered(jawaban []int) bool {
+	for _, kolom := range jawaban {
+		if kolom != GridUnanswered {
+			return true
+		}
+	}
+	return false
+}
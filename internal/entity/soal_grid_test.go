@@ -0,0 +1,140 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func benarSalahKey(kolomBenar ...int) entity.GridAnswerKey {
+	key := entity.GridAnswerKey{Columns: []string{"Benar", "Salah"}}
+	for i, kolom := range kolomBenar {
+		key.Rows = append(key.Rows, entity.GridRow{Teks: "Pernyataan " + string(rune('A'+i)), KolomBenar: kolom})
+	}
+	return key
+}
+
+func TestGridAnswerKey_Validate(t *testing.T) {
+	tooManyColumns := benarSalahKey(0)
+	tooManyColumns.Columns = strings.Split(strings.Repeat("x,", entity.MaxGridColumns), ",")
+	tooManyRows := benarSalahKey(make([]int, entity.MaxGridRows+1)...)
+	blankColumn := benarSalahKey(0)
+	blankColumn.Columns[1] = " "
+	blankRow := benarSalahKey(0, 1)
+	blankRow.Rows[1].Teks = ""
+
+	tests := []struct {
+		name    string
+		key     entity.GridAnswerKey
+		wantErr string
+	}{
+		{name: "valid", key: benarSalahKey(0, 1, 1)},
+		{name: "most rows", key: benarSalahKey(make([]int, entity.MaxGridRows)...)},
+		{name: "one column", key: entity.GridAnswerKey{Columns: []string{"Benar"}, Rows: []entity.GridRow{{Teks: "a"}}}, wantErr: "columns"},
+		{name: "too many columns", key: tooManyColumns, wantErr: "columns"},
+		{name: "blank column", key: blankColumn, wantErr: "grid column 2"},
+		{name: "no rows", key: benarSalahKey(), wantErr: "rows"},
+		{name: "too many rows", key: tooManyRows, wantErr: "rows"},
+		{name: "blank row", key: blankRow, wantErr: "grid row 2 must"},
+		{name: "negative column", key: benarSalahKey(0, -1), wantErr: "grid row 2 has"},
+		{name: "column past the end", key: benarSalahKey(2), wantErr: "grid row 1 has"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.key.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestGridAnswerKey_Check(t *testing.T) {
+	key := benarSalahKey(0, 1, 1, 0)
+	allOrNothing := key
+	allOrNothing.AllOrNothing = true
+
+	tests := []struct {
+		name        string
+		key         entity.GridAnswerKey
+		jawaban     []int
+		wantCorrect bool
+		wantScore   float64
+		wantRows    []bool
+	}{
+		{name: "all right", key: key, jawaban: []int{0, 1, 1, 0}, wantCorrect: true, wantScore: 1, wantRows: []bool{true, true, true, true}},
+		{name: "three of four", key: key, jawaban: []int{0, 1, 0, 0}, wantScore: 0.75, wantRows: []bool{true, true, false, true}},
+		{name: "unanswered row", key: key, jawaban: []int{0, entity.GridUnanswered, 1, 0}, wantScore: 0.75, wantRows: []bool{true, false, true, true}},
+		{name: "missing rows", key: key, jawaban: []int{0}, wantScore: 0.25, wantRows: []bool{true, false, false, false}},
+		{name: "all wrong", key: key, jawaban: []int{1, 0, 0, 1}, wantScore: 0, wantRows: []bool{false, false, false, false}},
+		{name: "all or nothing right", key: allOrNothing, jawaban: []int{0, 1, 1, 0}, wantCorrect: true, wantScore: 1, wantRows: []bool{true, true, true, true}},
+		{name: "all or nothing partial", key: allOrNothing, jawaban: []int{0, 1, 0, 0}, wantScore: 0, wantRows: []bool{true, true, false, true}},
+		{name: "no rows", key: benarSalahKey(), jawaban: []int{0}, wantScore: 0, wantRows: []bool{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			correct, score, rows := tt.key.Check(tt.jawaban)
+			assert.Equal(t, tt.wantCorrect, correct)
+			assert.InDelta(t, tt.wantScore, score, 1e-9)
+			assert.Equal(t, tt.wantRows, rows)
+		})
+	}
+}
+
+func TestGridAnswerKey_ValidateJawaban(t *testing.T) {
+	key := benarSalahKey(0, 1)
+
+	tests := []struct {
+		name    string
+		jawaban []int
+		wantErr bool
+	}{
+		{name: "every row answered", jawaban: []int{1, 0}},
+		{name: "unanswered row", jawaban: []int{entity.GridUnanswered, 0}},
+		{name: "too few rows", jawaban: []int{0}, wantErr: true},
+		{name: "too many rows", jawaban: []int{0, 1, 0}, wantErr: true},
+		{name: "column past the end", jawaban: []int{0, 2}, wantErr: true},
+		{name: "negative column", jawaban: []int{-2, 0}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := key.ValidateJawaban(tt.jawaban)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsGridAnswered(t *testing.T) {
+	assert.False(t, entity.IsGridAnswered(nil))
+	assert.False(t, entity.IsGridAnswered([]int{entity.GridUnanswered, entity.GridUnanswered}))
+	assert.True(t, entity.IsGridAnswered([]int{entity.GridUnanswered, 0}))
+}
+
+func TestSoal_GridAnswerKeyRoundTrip(t *testing.T) {
+	soal := &entity.Soal{}
+	key := benarSalahKey(1, 0)
+	key.AllOrNothing = true
+	require.NoError(t, soal.SetGridAnswerKey(&key))
+	assert.Equal(t, &key, soal.GetGridAnswerKey())
+	assert.Equal(t, []string{"Pernyataan A", "Pernyataan B"}, soal.GetGridAnswerKey().RowTexts())
+
+	require.NoError(t, soal.SetGridAnswerKey(nil))
+	assert.Nil(t, soal.GetGridAnswerKey())
+
+	jawaban := &entity.JawabanSiswa{}
+	require.NoError(t, jawaban.SetJawabanGrid([]int{1, entity.GridUnanswered}))
+	assert.Equal(t, []int{1, entity.GridUnanswered}, jawaban.GetJawabanGrid())
+	require.NoError(t, jawaban.SetJawabanGrid(nil))
+	assert.Nil(t, jawaban.JawabanGrid)
+}
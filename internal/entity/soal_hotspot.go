@@ -0,0 +1,250 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// HotspotShape defines the shape of a region on a hotspot image
+type HotspotShape string
+
+const (
+	HotspotShapeRectangle HotspotShape = "rectangle"
+	HotspotShapePolygon   HotspotShape = "polygon"
+)
+
+// HotspotPoint is a position on the question image. Coordinates are fractions of the image
+// width and height, from 0,0 at the top left to 1,1 at the bottom right, so they do not
+// depend on the size the image is shown at.
+type HotspotPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// InBounds reports whether the point lies on the image
+func (p HotspotPoint) InBounds() bool {
+	return p.X >= 0 && p.X <= 1 && p.Y >= 0 && p.Y <= 1
+}
+
+// HotspotRegion is one correct area of a hotspot image. A rectangle uses X, Y (top left),
+// Width and Height; a polygon uses Points in drawing order.
+type HotspotRegion struct {
+	Label  string         `json:"label,omitempty"`
+	Shape  HotspotShape   `json:"shape"`
+	X      float64        `json:"x,omitempty"`
+	Y      float64        `json:"y,omitempty"`
+	Width  float64        `json:"width,omitempty"`
+	Height float64        `json:"height,omitempty"`
+	Points []HotspotPoint `json:"points,omitempty"`
+}
+
+// Validate checks that the region is a well-formed shape on the image
+func (r HotspotRegion) Validate() error {
+	switch r.Shape {
+	case HotspotShapeRectangle:
+		if r.Width <= 0 || r.Height <= 0 {
+			return errors.New("rectangle width and height must be greater than 0")
+		}
+		if !(HotspotPoint{X: r.X, Y: r.Y}).InBounds() || !(HotspotPoint{X: r.X + r.Width, Y: r.Y + r.Height}).InBounds() {
+			return errors.New("rectangle must lie within the image")
+		}
+	case HotspotShapePolygon:
+		if len(r.Points) < 3 {
+			return errors.New("polygon needs at least 3 points")
+		}
+		for _, p := range r.Points {
+			if !p.InBounds() {
+				return errors.New("polygon points must lie within the image")
+			}
+		}
+	default:
+		return fmt.Errorf("unknown region shape %q", r.Shape)
+	}
+	return nil
+}
+
+// Contains reports whether the point is inside the region. Points on the border count as
+// inside, so a click on the outline of a small region is not lost to rounding.
+func (r HotspotRegion) Contains(p HotspotPoint) bool {
+	switch r.Shape {
+	case HotspotShapeRectangle:
+		return p.X >= r.X && p.X <= r.X+r.Width && p.Y >= r.Y && p.Y <= r.Y+r.Height
+	case HotspotShapePolygon:
+		return polygonContains(r.Points, p)
+	}
+	return false
+}
+
+// polygonContains is the even-odd ray casting test, with points on an edge counted as inside
+func polygonContains(polygon []HotspotPoint, p HotspotPoint) bool {
+	if len(polygon) < 3 {
+		return false
+	}
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if onSegment(a, b, p) {
+			return true
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// hotspotEpsilon absorbs float rounding in the edge test; it is far below one pixel
+const hotspotEpsilon = 1e-9
+
+func onSegment(a, b, p HotspotPoint) bool {
+	cross := (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+	if cross > hotspotEpsilon || cross < -hotspotEpsilon {
+		return false
+	}
+	return p.X >= min(a.X, b.X)-hotspotEpsilon && p.X <= max(a.X, b.X)+hotspotEpsilon &&
+		p.Y >= min(a.Y, b.Y)-hotspotEpsilon && p.Y <= max(a.Y, b.Y)+hotspotEpsilon
+}
+
+// HotspotAnswerKey is the answer key of a hotspot question: the correct regions over one of
+// the question's images.
+//
+// By default the student places one point and it must fall in any region. With RequireAll
+// the student must mark every region and may not place points outside them.
+type HotspotAnswerKey struct {
+	// ImageUrutan is the urutan of the soal image the regions are drawn on
+	ImageUrutan int             `json:"image_urutan"`
+	Regions     []HotspotRegion `json:"regions"`
+	RequireAll  bool            `json:"require_all,omitempty"`
+	// MaxPoints caps how many points a student may place; 0 uses the default above
+	MaxPoints int `json:"max_points,omitempty"`
+}
+
+// PointLimit is the number of points a student may place
+func (k HotspotAnswerKey) PointLimit() int {
+	if k.MaxPoints > 0 {
+		return k.MaxPoints
+	}
+	if k.RequireAll {
+		return len(k.Regions)
+	}
+	return 1
+}
+
+// Validate checks the key before it is saved
+func (k HotspotAnswerKey) Validate() error {
+	if k.ImageUrutan < 1 {
+		return errors.New("hotspot image urutan must be at least 1")
+	}
+	if len(k.Regions) == 0 {
+		return errors.New("hotspot answer needs at least one region")
+	}
+	for i, region := range k.Regions {
+		if err := region.Validate(); err != nil {
+			return fmt.Errorf("hotspot region %d: %w", i+1, err)
+		}
+	}
+	if k.RequireAll && k.MaxPoints > 0 && k.MaxPoints < len(k.Regions) {
+		return errors.New("hotspot max points is lower than the number of regions to mark")
+	}
+	return nil
+}
+
+// Check marks the points against the key. It returns whether the answer is correct and, for
+// each point, whether it fell in a region.
+func (k HotspotAnswerKey) Check(points []HotspotPoint) (bool, []bool) {
+	hits := make([]bool, len(points))
+	regionHit := make([]bool, len(k.Regions))
+	for i, p := range points {
+		for r, region := range k.Regions {
+			if region.Contains(p) {
+				hits[i] = true
+				regionHit[r] = true
+			}
+		}
+	}
+	if len(points) == 0 || len(points) > k.PointLimit() {
+		return false, hits
+	}
+	for _, hit := range hits {
+		if !hit {
+			return false, hits
+		}
+	}
+	if k.RequireAll {
+		for _, hit := range regionHit {
+			if !hit {
+				return false, hits
+			}
+		}
+	}
+	return true, hits
+}
+
+// GetHotspotAnswerKey parses the answer key of a hotspot question
+func (s *Soal) GetHotspotAnswerKey() *HotspotAnswerKey {
+	if s.JawabanHotspot == nil {
+		return nil
+	}
+	var key HotspotAnswerKey
+	if err := json.Unmarshal([]byte(*s.JawabanHotspot), &key); err != nil {
+		return nil
+	}
+	return &key
+}
+
+// SetHotspotAnswerKey serializes the answer key of a hotspot question
+func (s *Soal) SetHotspotAnswerKey(key *HotspotAnswerKey) error {
+	if key == nil {
+		s.JawabanHotspot = nil
+		return nil
+	}
+	bytes, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	s.JawabanHotspot = &encoded
+	return nil
+}
+
+// HotspotImage returns the image the regions of a hotspot question are drawn on
+func (s *Soal) HotspotImage() *SoalGambar {
+	key := s.GetHotspotAnswerKey()
+	if key == nil {
+		return nil
+	}
+	for i := range s.Gambar {
+		if s.Gambar[i].Urutan == key.ImageUrutan {
+			return &s.Gambar[i]
+		}
+	}
+	return nil
+}
+
+// GetJawabanHotspot parses the points the student placed
+func (j *JawabanSiswa) GetJawabanHotspot() []HotspotPoint {
+	if j.JawabanHotspot == nil {
+		return nil
+	}
+	var points []HotspotPoint
+	if err := json.Unmarshal([]byte(*j.JawabanHotspot), &points); err != nil {
+		return nil
+	}
+	return points
+}
+
+// SetJawabanHotspot serializes the points the student placed
+func (j *JawabanSiswa) SetJawabanHotspot(points []HotspotPoint) error {
+	if len(points) == 0 {
+		j.JawabanHotspot = nil
+		return nil
+	}
+	bytes, err := json.Marshal(points)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	j.JawabanHotspot = &encoded
+	return nil
+}
@@ -0,0 +1,103 @@
+package entity_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pt(x, y float64) entity.HotspotPoint {
+	return entity.HotspotPoint{X: x, Y: y}
+}
+
+func TestPolygonContains(t *testing.T) {
+	square := []entity.HotspotPoint{pt(0.2, 0.2), pt(0.6, 0.2), pt(0.6, 0.6), pt(0.2, 0.6)}
+	// An L shape whose notch covers 0.4..0.6 on both axes
+	lShape := []entity.HotspotPoint{pt(0.2, 0.2), pt(0.4, 0.2), pt(0.4, 0.4), pt(0.6, 0.4), pt(0.6, 0.6), pt(0.2, 0.6)}
+	triangle := []entity.HotspotPoint{pt(0.1, 0.9), pt(0.5, 0.1), pt(0.9, 0.9)}
+
+	tests := []struct {
+		name    string
+		polygon []entity.HotspotPoint
+		point   entity.HotspotPoint
+		want    bool
+	}{
+		{name: "inside square", polygon: square, point: pt(0.4, 0.4), want: true},
+		{name: "outside square", polygon: square, point: pt(0.7, 0.4)},
+		{name: "on an edge", polygon: square, point: pt(0.6, 0.3), want: true},
+		{name: "on a vertex", polygon: square, point: pt(0.2, 0.2), want: true},
+		{name: "level with a vertex outside", polygon: square, point: pt(0.1, 0.2)},
+		{name: "inside the L", polygon: lShape, point: pt(0.3, 0.5), want: true},
+		{name: "in the notch of the L", polygon: lShape, point: pt(0.5, 0.3)},
+		{name: "on the inner corner of the L", polygon: lShape, point: pt(0.4, 0.4), want: true},
+		{name: "inside a triangle", polygon: triangle, point: pt(0.5, 0.5), want: true},
+		{name: "beside a slanted edge", polygon: triangle, point: pt(0.2, 0.3)},
+		{name: "on a slanted edge", polygon: triangle, point: pt(0.3, 0.5), want: true},
+		{name: "fewer than 3 points", polygon: square[:2], point: pt(0.4, 0.2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, entity.PolygonContains(tt.polygon, tt.point))
+		})
+	}
+}
+
+func TestHotspotRegion_Contains(t *testing.T) {
+	rect := entity.HotspotRegion{Shape: entity.HotspotShapeRectangle, X: 0.1, Y: 0.2, Width: 0.3, Height: 0.2}
+	polygon := entity.HotspotRegion{Shape: entity.HotspotShapePolygon, Points: []entity.HotspotPoint{pt(0.5, 0.5), pt(0.9, 0.5), pt(0.7, 0.9)}}
+
+	tests := []struct {
+		name   string
+		region entity.HotspotRegion
+		point  entity.HotspotPoint
+		want   bool
+	}{
+		{name: "inside rectangle", region: rect, point: pt(0.2, 0.3), want: true},
+		{name: "on rectangle border", region: rect, point: pt(0.4, 0.4), want: true},
+		{name: "right of rectangle", region: rect, point: pt(0.41, 0.3)},
+		{name: "below rectangle", region: rect, point: pt(0.2, 0.41)},
+		{name: "inside polygon", region: polygon, point: pt(0.7, 0.6), want: true},
+		{name: "outside polygon", region: polygon, point: pt(0.5, 0.9)},
+		{name: "unknown shape", region: entity.HotspotRegion{Shape: "circle", Width: 1, Height: 1}, point: pt(0.5, 0.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.region.Contains(tt.point))
+		})
+	}
+}
+
+func TestHotspotAnswerKey_Check(t *testing.T) {
+	left := entity.HotspotRegion{Shape: entity.HotspotShapeRectangle, X: 0, Y: 0, Width: 0.3, Height: 0.3}
+	right := entity.HotspotRegion{Shape: entity.HotspotShapeRectangle, X: 0.7, Y: 0, Width: 0.3, Height: 0.3}
+	anyRegion := entity.HotspotAnswerKey{ImageUrutan: 1, Regions: []entity.HotspotRegion{left, right}}
+	allRegions := entity.HotspotAnswerKey{ImageUrutan: 1, Regions: []entity.HotspotRegion{left, right}, RequireAll: true}
+	withSpare := entity.HotspotAnswerKey{ImageUrutan: 1, Regions: []entity.HotspotRegion{left, right}, MaxPoints: 3}
+
+	tests := []struct {
+		name     string
+		key      entity.HotspotAnswerKey
+		points   []entity.HotspotPoint
+		want     bool
+		wantHits []bool
+	}{
+		{name: "one point in any region", key: anyRegion, points: []entity.HotspotPoint{pt(0.8, 0.1)}, want: true, wantHits: []bool{true}},
+		{name: "one point outside", key: anyRegion, points: []entity.HotspotPoint{pt(0.5, 0.5)}, wantHits: []bool{false}},
+		{name: "no points", key: anyRegion, points: nil, wantHits: []bool{}},
+		{name: "more points than the limit", key: anyRegion, points: []entity.HotspotPoint{pt(0.1, 0.1), pt(0.8, 0.1)}, wantHits: []bool{true, true}},
+		{name: "every region marked", key: allRegions, points: []entity.HotspotPoint{pt(0.1, 0.1), pt(0.8, 0.1)}, want: true, wantHits: []bool{true, true}},
+		{name: "one region missed", key: allRegions, points: []entity.HotspotPoint{pt(0.1, 0.1), pt(0.2, 0.2)}, wantHits: []bool{true, true}},
+		{name: "stray point with require all", key: allRegions, points: []entity.HotspotPoint{pt(0.1, 0.1), pt(0.5, 0.5)}, wantHits: []bool{true, false}},
+		{name: "max points allows extra hits", key: withSpare, points: []entity.HotspotPoint{pt(0.1, 0.1), pt(0.2, 0.1), pt(0.8, 0.1)}, want: true, wantHits: []bool{true, true, true}},
+		{name: "max points still rejects misses", key: withSpare, points: []entity.HotspotPoint{pt(0.1, 0.1), pt(0.5, 0.5)}, wantHits: []bool{true, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			correct, hits := tt.key.Check(tt.points)
+			assert.Equal(t, tt.want, correct)
+			assert.Equal(t, tt.wantHits, hits)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MediaJenis defines the kind of a question media clip
+type MediaJenis string
+
+const (
+	MediaJenisAudio MediaJenis = "audio"
+	MediaJenisVideo MediaJenis = "video"
+)
+
+const (
+	// MaxSoalMediaSize is the largest clip that may be uploaded, in bytes
+	MaxSoalMediaSize = 20 << 20
+	// MaxSoalMediaDurasi is the longest clip that may be uploaded, in seconds
+	MaxSoalMediaDurasi = 30 * 60
+	// MaxSoalMediaPutar is the highest play limit a clip may have; 0 means unlimited
+	MaxSoalMediaPutar = 10
+	// MediaStreamGrace is how long after a clip's duration a recorded play may still stream,
+	// to cover buffering and pauses
+	MediaStreamGrace = 5 * time.Minute
+)
+
+// IsValid reports whether the kind is one a clip can have
+func (j MediaJenis) IsValid() bool {
+	return j == MediaJenisAudio || j == MediaJenisVideo
+}
+
+// AllowsMime reports whether a clip of this kind may be stored with the MIME type
+func (j MediaJenis) AllowsMime(mimeType string) bool {
+	switch j {
+	case MediaJenisAudio:
+		return mimeType == "audio/mpeg" || mimeType == "audio/wave" || mimeType == "audio/ogg" || mimeType == "audio/mp4" || mimeType == "audio/webm"
+	case MediaJenisVideo:
+		return mimeType == "video/mp4" || mimeType == "video/webm"
+	}
+	return false
+}
+
+// SoalMedia is an audio or video clip of a question, e.g. the recording of a listening
+// comprehension question. FilePath is the origin URL and is never sent to students; they
+// stream the clip through the gateway so its play limit can be enforced.
+type SoalMedia struct {
+	ID          int        `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDSoal      int        `json:"id_soal" gorm:"not null;index"`
+	Jenis       MediaJenis `json:"jenis" gorm:"type:varchar(10);not null"`
+	NamaFile    string     `json:"nama_file" gorm:"type:varchar(255);not null"`
+	FilePath    string     `json:"file_path" gorm:"type:varchar(500);not null"`
+	FileSize    int        `json:"file_size" gorm:"not null"`
+	MimeType    string     `json:"mime_type" gorm:"type:varchar(50);not null"`
+	DurasiDetik float64    `json:"durasi_detik" gorm:"type:decimal(8,2);not null;default:0"`
+	// MaxPutar is how many times a student may play the clip in one session; 0 is unlimited
+	MaxPutar   int       `json:"max_putar" gorm:"not null;default:0"`
+	Urutan     int       `json:"urutan" gorm:"default:1;not null"`
+	Keterangan *string   `json:"keterangan" gorm:"type:varchar(255)"`
+	CloudId    *string   `json:"cloud_id" gorm:"type:varchar(255)"`
+	PublicId   *string   `json:"public_id" gorm:"type:varchar(500)"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func (SoalMedia) TableName() string { return "soal_media" }
+
+// ValidateSoalMediaSettings checks the teacher-set duration and play limit of a clip
+func ValidateSoalMediaSettings(durasiDetik float64, maxPutar int) error {
+	if durasiDetik < 0 || durasiDetik > MaxSoalMediaDurasi {
+		return fmt.Errorf("media duration must be between 0 and %d seconds", MaxSoalMediaDurasi)
+	}
+	if maxPutar < 0 || maxPutar > MaxSoalMediaPutar {
+		return fmt.Errorf("media max putar must be between 0 (unlimited) and %d", MaxSoalMediaPutar)
+	}
+	return nil
+}
+
+// SesiMediaPutar counts how many times a clip was played for one question of a session
+type SesiMediaPutar struct {
+	ID                int       `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDTestSessionSoal int       `json:"id_test_session_soal" gorm:"not null;uniqueIndex:idx_sesi_media_putar"`
+	IDMedia           int       `json:"id_media" gorm:"not null;uniqueIndex:idx_sesi_media_putar"`
+	JumlahPutar       int       `json:"jumlah_putar" gorm:"not null;default:0"`
+	TerakhirDiputar   time.Time `json:"terakhir_diputar"`
+}
+
+func (SesiMediaPutar) TableName() string { return "sesi_media_putar" }
+
+// ErrMediaPlayLimitReached is returned when a clip has been played as often as it may be
+var ErrMediaPlayLimitReached = errors.New("media play limit reached")
+
+// ErrMediaPlayNotRecorded is returned when a limited clip is streamed without an unused
+// stream nonce of a recent play
+var ErrMediaPlayNotRecorded = errors.New("media play has not been recorded")
+
+// SessionMedia is a clip of a session question as a student sees it, with its plays so far
+type SessionMedia struct {
+	IDMedia         int        `json:"id_media"`
+	NomorUrut       int        `json:"nomor_urut"`
+	Jenis           MediaJenis `json:"jenis"`
+	MimeType        string     `json:"mime_type"`
+	DurasiDetik     float64    `json:"durasi_detik"`
+	MaxPutar        int        `json:"max_putar"`
+	JumlahPutar     int        `json:"jumlah_putar"`
+	Urutan          int        `json:"urutan"`
+	Keterangan      *string    `json:"keterangan,omitempty"`
+	FilePath        string     `json:"-"`
+	TerakhirDiputar *time.Time `json:"-"`
+	// StreamNonce is set by RecordMediaPlay and streams the recorded play once
+	StreamNonce string `json:"-"`
+}
+
+// SisaPutar is how many plays are left, or -1 when the clip may be played without limit
+func (m SessionMedia) SisaPutar() int {
+	if m.MaxPutar == 0 {
+		return -1
+	}
+	return max(m.MaxPutar-m.JumlahPutar, 0)
+}
+
+// CanStream reports whether the clip may be streamed now. A limited clip streams once per
+// recorded play, claimed with the play's stream nonce, and only within its duration plus
+// MediaStreamGrace after the play was recorded.
+func (m SessionMedia) CanStream(now time.Time) bool {
+	if m.MaxPutar == 0 {
+		return true
+	}
+	if m.JumlahPutar == 0 || m.TerakhirDiputar == nil {
+		return false
+	}
+	window := time.Duration(m.DurasiDetik*float64(time.Second)) + MediaStreamGrace
+	return !now.After(m.TerakhirDiputar.Add(window))
+}
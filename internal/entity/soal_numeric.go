@@ -0,0 +1,266 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NumericAnswerKey is the answer key of a numeric question. A response is correct when it is
+// within the larger of the absolute and relative tolerance of Value; with neither set it must
+// equal Value.
+type NumericAnswerKey struct {
+	Value float64 `json:"value"`
+	// AbsTolerance is in the question's unit, e.g. 0.05
+	AbsTolerance float64 `json:"abs_tolerance,omitempty"`
+	// RelTolerance is a fraction of Value, e.g. 0.01 for 1%
+	RelTolerance float64 `json:"rel_tolerance,omitempty"`
+	// Unit, when set, is checked against a unit written after the number.
+	// AcceptedUnits lists other spellings of the same unit, e.g. "m/s" and "ms^-1".
+	Unit          string   `json:"unit,omitempty"`
+	AcceptedUnits []string `json:"accepted_units,omitempty"`
+	UnitRequired  bool     `json:"unit_required,omitempty"`
+	// SignificantFigures, when set, is the number of significant figures the response must have
+	SignificantFigures int `json:"significant_figures,omitempty"`
+	// DecimalSeparator is "," or "." to fix how responses are read; empty accepts both
+	// and rejects the ambiguous "1.000" and "1,000"
+	DecimalSeparator string `json:"decimal_separator,omitempty"`
+}
+
+// ErrAmbiguousNumber is returned for a response like "1.000" that reads as both one and
+// one thousand when the question accepts either decimal separator
+var ErrAmbiguousNumber = errors.New("jawaban numeric is ambiguous: write the decimals without a thousands separator, e.g. 1000 or 1,0")
+
+// NumericResponse is a parsed numeric answer
+type NumericResponse struct {
+	Value              float64
+	Unit               string
+	SignificantFigures int
+}
+
+// NumericCheck is the outcome of checking a response against the key
+type NumericCheck struct {
+	ValueCorrect   bool
+	UnitCorrect    bool
+	FiguresCorrect bool
+}
+
+// IsCorrect reports whether every rule of the key was met
+func (c NumericCheck) IsCorrect() bool {
+	return c.ValueCorrect && c.UnitCorrect && c.FiguresCorrect
+}
+
+// ParseNumericResponse reads a number optionally followed by a unit, e.g. "9,8 m/s2",
+// "-1.5e3", "1.234,5" or "6,02x10^23". decimalSeparator is "," or "." to fix the decimal
+// separator, the other one grouping thousands; empty accepts both, see normalizeDecimal.
+func ParseNumericResponse(text, decimalSeparator string) (*NumericResponse, error) {
+	text = strings.TrimSpace(text)
+	end := 0
+	for end < len(text) && strings.ContainsRune("+-0123456789.,", rune(text[end])) {
+		end++
+	}
+	mantissa := text[:end]
+	rest := strings.TrimSpace(text[end:])
+	if mantissa == "" {
+		return nil, errors.New("jawaban numeric must start with a number")
+	}
+
+	normalized, err := normalizeDecimal(mantissa, decimalSeparator)
+	if err != nil {
+		return nil, err
+	}
+	exponent, rest, err := parseExponent(rest)
+	if err != nil {
+		return nil, err
+	}
+	// Parsing the exponent together with the digits avoids the rounding of a separate multiply
+	value, err := strconv.ParseFloat(normalized+"e"+strconv.Itoa(exponent), 64)
+	if err != nil {
+		return nil, errors.New("jawaban numeric is not a valid number")
+	}
+
+	return &NumericResponse{
+		Value:              value,
+		Unit:               rest,
+		SignificantFigures: countSignificantFigures(normalized),
+	}, nil
+}
+
+// normalizeDecimal rewrites a number written with "," or "." separators into Go syntax.
+// Without a fixed decimal separator, when both appear the last one is the decimal
+// separator; a separator repeated alone groups thousands as in "1.000.000"; a single one is
+// the decimal separator unless it could also group thousands, as in "1.000", which is
+// rejected with ErrAmbiguousNumber.
+func normalizeDecimal(mantissa, decimal string) (string, error) {
+	if decimal == "" {
+		lastComma := strings.LastIndex(mantissa, ",")
+		lastDot := strings.LastIndex(mantissa, ".")
+		commas, dots := strings.Count(mantissa, ","), strings.Count(mantissa, ".")
+		switch {
+		case lastComma >= 0 && lastDot >= 0:
+			decimal = ","
+			if lastDot > lastComma {
+				decimal = "."
+			}
+		case commas > 1:
+			decimal = "."
+		case dots > 1:
+			decimal = ","
+		case commas == 1 || dots == 1:
+			decimal = ","
+			if dots == 1 {
+				decimal = "."
+			}
+			if groupsThousands(mantissa, decimal) {
+				return "", ErrAmbiguousNumber
+			}
+		default:
+			return mantissa, nil
+		}
+	}
+
+	thousands := ","
+	if decimal == "," {
+		thousands = "."
+	}
+	whole, fraction, hasDecimal := strings.Cut(mantissa, decimal)
+	if strings.Contains(fraction, decimal) {
+		return "", errors.New("jawaban numeric has more than one decimal separator")
+	}
+	if strings.Contains(fraction, thousands) {
+		return "", errors.New("jawaban numeric has a thousands separator after the decimal separator")
+	}
+	if strings.Contains(whole, thousands) {
+		groups := strings.Split(strings.TrimLeft(whole, "+-"), thousands)
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return "", errors.New("jawaban numeric has misplaced thousands separators")
+		}
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return "", errors.New("jawaban numeric has misplaced thousands separators")
+			}
+		}
+		whole = strings.ReplaceAll(whole, thousands, "")
+	}
+	if !hasDecimal {
+		return whole, nil
+	}
+	return whole + "." + fraction, nil
+}
+
+// groupsThousands reports whether the single separator in mantissa could be a thousands
+// separator: one to three digits, not a lone zero, before it and exactly three after it
+func groupsThousands(mantissa, separator string) bool {
+	whole, fraction, _ := strings.Cut(strings.TrimLeft(mantissa, "+-"), separator)
+	return len(fraction) == 3 && len(whole) >= 1 && len(whole) <= 3 && strings.TrimLeft(whole, "0") == whole
+}
+
+// parseExponent reads a power of ten written as "e3", "E-3", "x10^3" or "×10^3" and returns
+// it with the remaining text
+func parseExponent(rest string) (int, string, error) {
+	var digits string
+	switch {
+	case strings.HasPrefix(rest, "e") || strings.HasPrefix(rest, "E"):
+		digits = rest[1:]
+	case strings.HasPrefix(rest, "x10^") || strings.HasPrefix(rest, "X10^"):
+		digits = rest[len("x10^"):]
+	case strings.HasPrefix(rest, "×10^"):
+		digits = rest[len("×10^"):]
+	default:
+		return 0, rest, nil
+	}
+
+	end := 0
+	for end < len(digits) && (unicode.IsDigit(rune(digits[end])) || (end == 0 && (digits[0] == '-' || digits[0] == '+'))) {
+		end++
+	}
+	if end == 0 || (end == 1 && (digits[0] == '-' || digits[0] == '+')) {
+		// An "e" with no exponent is the start of a unit
+		if rest[0] == 'e' || rest[0] == 'E' {
+			return 0, rest, nil
+		}
+		return 0, "", errors.New("jawaban numeric has an invalid exponent")
+	}
+	exponent, err := strconv.Atoi(digits[:end])
+	if err != nil {
+		return 0, "", errors.New("jawaban numeric has an invalid exponent")
+	}
+	return exponent, strings.TrimSpace(digits[end:]), nil
+}
+
+// countSignificantFigures counts the significant figures of a plain decimal: leading zeros
+// never count, trailing zeros count only after a decimal point
+func countSignificantFigures(decimal string) int {
+	decimal = strings.TrimLeft(decimal, "+-")
+	hasPoint := strings.Contains(decimal, ".")
+	digits := strings.TrimLeft(strings.Replace(decimal, ".", "", 1), "0")
+	if !hasPoint {
+		digits = strings.TrimRight(digits, "0")
+	}
+	return len(digits)
+}
+
+// Tolerance is the largest accepted distance from Value
+func (k NumericAnswerKey) Tolerance() float64 {
+	tolerance := math.Max(k.AbsTolerance, k.RelTolerance*math.Abs(k.Value))
+	if tolerance == 0 {
+		// Absorb the rounding of decimal input into binary floats
+		tolerance = 1e-9 * math.Max(1, math.Abs(k.Value))
+	}
+	return tolerance
+}
+
+// Check compares a parsed response with the key
+func (k NumericAnswerKey) Check(response NumericResponse) NumericCheck {
+	check := NumericCheck{
+		ValueCorrect:   math.Abs(response.Value-k.Value) <= k.Tolerance(),
+		UnitCorrect:    true,
+		FiguresCorrect: k.SignificantFigures == 0 || response.SignificantFigures == k.SignificantFigures,
+	}
+
+	if k.Unit != "" {
+		unit := strings.Join(strings.Fields(response.Unit), "")
+		if unit == "" {
+			check.UnitCorrect = !k.UnitRequired
+		} else {
+			check.UnitCorrect = false
+			for _, accepted := range append([]string{k.Unit}, k.AcceptedUnits...) {
+				if strings.Join(strings.Fields(accepted), "") == unit {
+					check.UnitCorrect = true
+					break
+				}
+			}
+		}
+	}
+	return check
+}
+
+// GetNumericAnswerKey parses the answer key of a numeric question
+func (s *Soal) GetNumericAnswerKey() *NumericAnswerKey {
+	if s.JawabanNumeric == nil {
+		return nil
+	}
+	var key NumericAnswerKey
+	if err := json.Unmarshal([]byte(*s.JawabanNumeric), &key); err != nil {
+		return nil
+	}
+	return &key
+}
+
+// SetNumericAnswerKey serializes the answer key of a numeric question
+func (s *Soal) SetNumericAnswerKey(key *NumericAnswerKey) error {
+	if key == nil {
+		s.JawabanNumeric = nil
+		return nil
+	}
+	bytes, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	s.JawabanNumeric = &encoded
+	return nil
+}
@@ -0,0 +1,96 @@
+package entity_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNumericResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		separator string
+		want      float64
+		wantUnit  string
+		wantSF    int
+		wantErr   error
+	}{
+		{name: "integer", text: "42", want: 42, wantSF: 2},
+		{name: "comma decimal with unit", text: "9,8 m/s2", want: 9.8, wantUnit: "m/s2", wantSF: 2},
+		{name: "dot decimal", text: "-1.5", want: -1.5, wantSF: 2},
+		{name: "exponent", text: "-1.5e3", want: -1500, wantSF: 2},
+		{name: "times ten exponent", text: "6,02x10^23", want: 6.02e23, wantSF: 3},
+		{name: "e starts a unit", text: "5 eV", want: 5, wantUnit: "eV", wantSF: 1},
+		{name: "dot thousands comma decimal", text: "1.234,5", want: 1234.5, wantSF: 5},
+		{name: "comma thousands dot decimal", text: "1,234.5", want: 1234.5, wantSF: 5},
+		{name: "repeated dots group thousands", text: "1.000.000", want: 1000000, wantSF: 1},
+		{name: "repeated commas group thousands", text: "1,000,000", want: 1000000, wantSF: 1},
+		{name: "single dot with three digits is ambiguous", text: "1.000", wantErr: entity.ErrAmbiguousNumber},
+		{name: "single comma with three digits is ambiguous", text: "12,500", wantErr: entity.ErrAmbiguousNumber},
+		{name: "leading zero is a decimal", text: "0.125", want: 0.125, wantSF: 3},
+		{name: "four digits after a dot is a decimal", text: "1.0005", want: 1.0005, wantSF: 5},
+		{name: "two digits after a comma is a decimal", text: "1,50", want: 1.5, wantSF: 3},
+		{name: "four digits before a dot is a decimal", text: "1000.000", want: 1000, wantSF: 7},
+		{name: "fixed dot reads a decimal", text: "1.000", separator: ".", want: 1, wantSF: 4},
+		{name: "fixed comma reads thousands", text: "1.000", separator: ",", want: 1000, wantSF: 1},
+		{name: "fixed comma keeps both forms", text: "1.000.000,25", separator: ",", want: 1000000.25, wantSF: 9},
+		{name: "fixed dot rejects repeated dots", text: "1.000.000", separator: ".", wantErr: errAny},
+		{name: "misplaced thousands separator", text: "1.00.000", wantErr: errAny},
+		{name: "thousands after the decimal", text: "1,5.000", separator: ",", wantErr: errAny},
+		{name: "not a number", text: "abc", wantErr: errAny},
+		{name: "bad exponent", text: "2x10^", wantErr: errAny},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := entity.ParseNumericResponse(tt.text, tt.separator)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if tt.wantErr != errAny {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got.Value, 1e-9*max(1, tt.want))
+			assert.Equal(t, tt.wantUnit, got.Unit)
+			assert.Equal(t, tt.wantSF, got.SignificantFigures)
+		})
+	}
+}
+
+func TestNumericAnswerKey_Check(t *testing.T) {
+	key := entity.NumericAnswerKey{Value: 9.8, AbsTolerance: 0.05, Unit: "m/s2", AcceptedUnits: []string{"m s^-2"}}
+	tests := []struct {
+		name     string
+		response entity.NumericResponse
+		want     entity.NumericCheck
+	}{
+		{name: "exact", response: entity.NumericResponse{Value: 9.8, Unit: "m/s2"}, want: entity.NumericCheck{ValueCorrect: true, UnitCorrect: true, FiguresCorrect: true}},
+		{name: "within tolerance", response: entity.NumericResponse{Value: 9.84}, want: entity.NumericCheck{ValueCorrect: true, UnitCorrect: true, FiguresCorrect: true}},
+		{name: "outside tolerance", response: entity.NumericResponse{Value: 9.9}, want: entity.NumericCheck{UnitCorrect: true, FiguresCorrect: true}},
+		{name: "accepted unit ignores spaces", response: entity.NumericResponse{Value: 9.8, Unit: "ms^-2"}, want: entity.NumericCheck{ValueCorrect: true, UnitCorrect: true, FiguresCorrect: true}},
+		{name: "wrong unit", response: entity.NumericResponse{Value: 9.8, Unit: "km"}, want: entity.NumericCheck{ValueCorrect: true, FiguresCorrect: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, key.Check(tt.response))
+		})
+	}
+
+	strict := entity.NumericAnswerKey{Value: 1000, Unit: "g", UnitRequired: true, SignificantFigures: 4}
+	check := strict.Check(entity.NumericResponse{Value: 1000, SignificantFigures: 1})
+	assert.False(t, check.UnitCorrect)
+	assert.False(t, check.FiguresCorrect)
+	assert.False(t, check.IsCorrect())
+}
+
+// errAny marks a case that only has to fail
+var errAny = &anyError{}
+
+type anyError struct{}
+
+func (*anyError) Error() string { return "any error" }
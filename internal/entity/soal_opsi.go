@@ -0,0 +1,90 @@
+package entity
+
+import "strings"
+
+const (
+	// MinSoalOpsi is the fewest options a choice question may have, e.g. true/false
+	MinSoalOpsi = 2
+	// MaxSoalOpsi is the most options a choice question may have, labelled A to J
+	MaxSoalOpsi = 10
+)
+
+// SoalOpsi is one answer option of a multiple-choice or complex multiple-choice question.
+// Options are labelled A, B, C, ... in the order they are shown.
+type SoalOpsi struct {
+	ID     int           `json:"id" gorm:"primaryKey;autoIncrement"`
+	IDSoal int           `json:"id_soal" gorm:"not null;uniqueIndex:idx_soal_opsi_label"`
+	Label  JawabanOption `json:"label" gorm:"type:char(1);not null;uniqueIndex:idx_soal_opsi_label"`
+	Teks   string        `json:"teks" gorm:"type:text;not null"`
+	Urutan int           `json:"urutan" gorm:"not null;default:0"`
+}
+
+func (SoalOpsi) TableName() string { return "soal_opsi" }
+
+// OpsiLabel returns the label of the i-th option (0-based): A, B, C, ...
+func OpsiLabel(i int) JawabanOption {
+	return JawabanOption(rune('A' + i))
+}
+
+// ParseJawabanOption normalizes a label as typed by a client, e.g. " b" becomes "B"
+func ParseJawabanOption(label string) JawabanOption {
+	return JawabanOption(strings.ToUpper(strings.TrimSpace(label)))
+}
+
+// IsValid reports whether the option is a label any question could have
+func (o JawabanOption) IsValid() bool {
+	return len(o) == 1 && o >= OpsiLabel(0) && o <= OpsiLabel(MaxSoalOpsi-1)
+}
+
+// BuildSoalOpsi labels the option texts in order
+func BuildSoalOpsi(texts []string) []SoalOpsi {
+	opsi := make([]SoalOpsi, 0, len(texts))
+	for i, teks := range texts {
+		opsi = append(opsi, SoalOpsi{Label: OpsiLabel(i), Teks: teks, Urutan: i + 1})
+	}
+	return opsi
+}
+
+// IsChoiceQuestion reports whether answers of the question type pick from labelled options
+func IsChoiceQuestion(questionType QuestionType) bool {
+	return questionType == QuestionTypeMultipleChoice || questionType == QuestionTypeMultipleChoicesComplex
+}
+
+// Options returns the options of a choice question. Questions saved before options moved
+// to soal_opsi fall back to the opsi_a..opsi_d columns.
+func (s *Soal) Options() []SoalOpsi {
+	if len(s.Opsi) > 0 {
+		return s.Opsi
+	}
+	if s.QuestionType != "" && !IsChoiceQuestion(s.QuestionType) {
+		return nil
+	}
+	opsi := BuildSoalOpsi([]string{s.OpsiA, s.OpsiB, s.OpsiC, s.OpsiD})
+	for i := range opsi {
+		opsi[i].IDSoal = s.ID
+	}
+	return opsi
+}
+
+// HasOption reports whether the question has an option with the label
+func (s *Soal) HasOption(label JawabanOption) bool {
+	for _, opsi := range s.Options() {
+		if opsi.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+// SetOpsi replaces the options of the question. The first four are mirrored into the legacy
+// opsi_a..opsi_d columns, padded with "-", so older readers keep working.
+func (s *Soal) SetOpsi(opsi []SoalOpsi) {
+	s.Opsi = opsi
+	legacy := []*string{&s.OpsiA, &s.OpsiB, &s.OpsiC, &s.OpsiD}
+	for i, column := range legacy {
+		*column = "-"
+		if i < len(opsi) {
+			*column = opsi[i].Teks
+		}
+	}
+}
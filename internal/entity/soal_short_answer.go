@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ShortAnswerBlank is one blank of a short-answer or cloze question. A plain short-answer
+// question has a single blank; a cloze question marks its blanks in the pertanyaan as
+// {{1}}, {{2}}, ... in the order of the blanks.
+//
+// The zero value of every rule is the lenient one: case, repeated whitespace and
+// diacritics are ignored unless the blank asks to keep them.
+type ShortAnswerBlank struct {
+	AcceptedAnswers []string `json:"accepted_answers"`
+	CaseSensitive   bool     `json:"case_sensitive,omitempty"`
+	ExactWhitespace bool     `json:"exact_whitespace,omitempty"`
+	KeepDiacritics  bool     `json:"keep_diacritics,omitempty"`
+	// Regex also accepts any response it matches in full, e.g. `3[.,]14`
+	Regex string `json:"regex,omitempty"`
+}
+
+// ClozeMarker returns the placeholder of the n-th blank (1-based) in a cloze pertanyaan
+func ClozeMarker(n int) string {
+	return fmt.Sprintf("{{%d}}", n)
+}
+
+// Normalize applies the blank's case, whitespace and diacritic rules to a text
+func (b ShortAnswerBlank) Normalize(text string) string {
+	if !b.ExactWhitespace {
+		text = strings.Join(strings.Fields(text), " ")
+	}
+	if !b.KeepDiacritics {
+		text = stripDiacritics(text)
+	}
+	if !b.CaseSensitive {
+		text = strings.ToLower(text)
+	}
+	return text
+}
+
+// CompileRegex compiles the blank's regex anchored to the whole response. Case-insensitive
+// blanks compile it case-insensitively so authors need not write lower-case patterns.
+func (b ShortAnswerBlank) CompileRegex() (*regexp.Regexp, error) {
+	flags := ""
+	if !b.CaseSensitive {
+		flags = "(?i)"
+	}
+	return regexp.Compile(flags + "^(?:" + b.Regex + ")$")
+}
+
+// Matches reports whether a response is accepted for the blank
+func (b ShortAnswerBlank) Matches(response string) bool {
+	if strings.TrimSpace(response) == "" {
+		return false
+	}
+
+	normalized := b.Normalize(response)
+	for _, accepted := range b.AcceptedAnswers {
+		if b.Normalize(accepted) == normalized {
+			return true
+		}
+	}
+
+	if b.Regex != "" {
+		pattern, err := b.CompileRegex()
+		if err != nil {
+			return false
+		}
+		// Case is left to the regex flags so character classes keep working
+		caseKept := b
+		caseKept.CaseSensitive = true
+		return pattern.MatchString(caseKept.Normalize(response))
+	}
+	return false
+}
+
+var diacriticRemover = runes.Remove(runes.In(unicode.Mn))
+
+func stripDiacritics(text string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, diacriticRemover, norm.NFC), text)
+	if err != nil {
+		return text
+	}
+	return stripped
+}
+
+// GetShortAnswerBlanks parses the blanks of a short-answer question
+func (s *Soal) GetShortAnswerBlanks() []ShortAnswerBlank {
+	if s.JawabanShortAnswer == nil {
+		return nil
+	}
+	var blanks []ShortAnswerBlank
+	if err := json.Unmarshal([]byte(*s.JawabanShortAnswer), &blanks); err != nil {
+		return nil
+	}
+	return blanks
+}
+
+// SetShortAnswerBlanks serializes the blanks of a short-answer question
+func (s *Soal) SetShortAnswerBlanks(blanks []ShortAnswerBlank) error {
+	if len(blanks) == 0 {
+		s.JawabanShortAnswer = nil
+		return nil
+	}
+	bytes, err := json.Marshal(blanks)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	s.JawabanShortAnswer = &encoded
+	return nil
+}
+
+// CheckShortAnswer marks each response against its blank. A missing response is wrong.
+func (s *Soal) CheckShortAnswer(responses []string) []bool {
+	blanks := s.GetShortAnswerBlanks()
+	results := make([]bool, len(blanks))
+	for i, blank := range blanks {
+		if i < len(responses) {
+			results[i] = blank.Matches(responses[i])
+		}
+	}
+	return results
+}
+
+// GetJawabanShortAnswer parses the student's responses, one per blank
+func (j *JawabanSiswa) GetJawabanShortAnswer() []string {
+	if j.JawabanShortAnswer == nil {
+		return nil
+	}
+	var responses []string
+	if err := json.Unmarshal([]byte(*j.JawabanShortAnswer), &responses); err != nil {
+		return nil
+	}
+	return responses
+}
+
+// SetJawabanShortAnswer serializes the student's responses
+func (j *JawabanSiswa) SetJawabanShortAnswer(responses []string) error {
+	if len(responses) == 0 {
+		j.JawabanShortAnswer = nil
+		return nil
+	}
+	bytes, err := json.Marshal(responses)
+	if err != nil {
+		return err
+	}
+	encoded := string(bytes)
+	j.JawabanShortAnswer = &encoded
+	return nil
+}
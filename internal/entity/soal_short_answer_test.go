@@ -0,0 +1,116 @@
+package entity_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortAnswerBlank_Normalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		blank entity.ShortAnswerBlank
+		text  string
+		want  string
+	}{
+		{name: "lenient rules", text: "  Ibu   KOTA\tJakarta ", want: "ibu kota jakarta"},
+		{name: "diacritics stripped", text: "Café Señor", want: "cafe senor"},
+		{name: "case kept", blank: entity.ShortAnswerBlank{CaseSensitive: true}, text: "Jakarta", want: "Jakarta"},
+		{name: "whitespace kept", blank: entity.ShortAnswerBlank{ExactWhitespace: true}, text: " a  b ", want: " a  b "},
+		{name: "diacritics kept", blank: entity.ShortAnswerBlank{KeepDiacritics: true}, text: "Café", want: "café"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.blank.Normalize(tt.text))
+		})
+	}
+}
+
+func TestShortAnswerBlank_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		blank    entity.ShortAnswerBlank
+		response string
+		want     bool
+	}{
+		{name: "exact answer", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"Jakarta"}}, response: "Jakarta", want: true},
+		{name: "case and spacing ignored", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"Ibu Kota"}}, response: " ibu   kota ", want: true},
+		{name: "accents ignored", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"café"}}, response: "CAFE", want: true},
+		{name: "second accepted answer", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"H2O", "air"}}, response: "Air", want: true},
+		{name: "wrong answer", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"Jakarta"}}, response: "Bandung"},
+		{name: "blank response", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{""}}, response: "   "},
+		{name: "case sensitive", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"NaCl"}, CaseSensitive: true}, response: "nacl"},
+		{name: "diacritics kept", blank: entity.ShortAnswerBlank{AcceptedAnswers: []string{"café"}, KeepDiacritics: true}, response: "cafe"},
+		{name: "regex match", blank: entity.ShortAnswerBlank{Regex: `3[.,]14`}, response: "3,14", want: true},
+		{name: "regex anchored", blank: entity.ShortAnswerBlank{Regex: `3[.,]14`}, response: "3,145"},
+		{name: "regex case-insensitive", blank: entity.ShortAnswerBlank{Regex: `fotosintes[ai]s`}, response: "FOTOSINTESIS", want: true},
+		{name: "regex case sensitive", blank: entity.ShortAnswerBlank{Regex: `[A-Z]+`, CaseSensitive: true}, response: "abc"},
+		{name: "regex after accents stripped", blank: entity.ShortAnswerBlank{Regex: `cafe`}, response: "café", want: true},
+		{name: "invalid regex", blank: entity.ShortAnswerBlank{Regex: `(`}, response: "("},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.blank.Matches(tt.response))
+		})
+	}
+}
+
+func TestShortAnswerBlank_CompileRegex(t *testing.T) {
+	pattern, err := entity.ShortAnswerBlank{Regex: `a|b`}.CompileRegex()
+	require.NoError(t, err)
+	// The alternation is grouped so the anchors apply to both branches
+	assert.True(t, pattern.MatchString("B"))
+	assert.False(t, pattern.MatchString("ab"))
+
+	_, err = entity.ShortAnswerBlank{Regex: `[`}.CompileRegex()
+	assert.Error(t, err)
+}
+
+func TestSoal_CheckShortAnswer(t *testing.T) {
+	soal := &entity.Soal{}
+	require.NoError(t, soal.SetShortAnswerBlanks([]entity.ShortAnswerBlank{
+		{AcceptedAnswers: []string{"Soekarno"}},
+		{AcceptedAnswers: []string{"1945"}},
+		{AcceptedAnswers: []string{"Jakarta"}},
+	}))
+
+	tests := []struct {
+		name      string
+		responses []string
+		want      []bool
+	}{
+		{name: "all correct", responses: []string{"soekarno", "1945", "JAKARTA"}, want: []bool{true, true, true}},
+		{name: "one wrong", responses: []string{"Soekarno", "1946", "Jakarta"}, want: []bool{true, false, true}},
+		{name: "missing responses", responses: []string{"Soekarno"}, want: []bool{true, false, false}},
+		{name: "extra responses ignored", responses: []string{"Soekarno", "1945", "Jakarta", "x"}, want: []bool{true, true, true}},
+		{name: "no responses", want: []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, soal.CheckShortAnswer(tt.responses))
+		})
+	}
+}
+
+func TestSoal_ShortAnswerBlanksRoundTrip(t *testing.T) {
+	soal := &entity.Soal{}
+	blanks := []entity.ShortAnswerBlank{{AcceptedAnswers: []string{"a"}, CaseSensitive: true, Regex: `a+`}}
+	require.NoError(t, soal.SetShortAnswerBlanks(blanks))
+	assert.Equal(t, blanks, soal.GetShortAnswerBlanks())
+
+	require.NoError(t, soal.SetShortAnswerBlanks(nil))
+	assert.Nil(t, soal.JawabanShortAnswer)
+	assert.Nil(t, soal.GetShortAnswerBlanks())
+
+	broken := "{"
+	soal.JawabanShortAnswer = &broken
+	assert.Nil(t, soal.GetShortAnswerBlanks())
+}
+
+func TestClozeMarker(t *testing.T) {
+	assert.Equal(t, "{{1}}", entity.ClozeMarker(1))
+	assert.Equal(t, "{{12}}", entity.ClozeMarker(12))
+}
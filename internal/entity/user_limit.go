@@ -11,6 +11,7 @@ type UserLimit struct {
 	LimitValue  int       `json:"limit_value" gorm:"not null"`
 	CurrentUsed int       `json:"current_used" gorm:"not null;default:0"`
 	ResetAt     time.Time `json:"reset_at" gorm:"not null"`
+	IsOverride  bool      `json:"is_override" gorm:"not null;default:false"` // set by SetUserLimit; wins over rate limit policies
 	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 }
@@ -22,11 +23,11 @@ func (UserLimit) TableName() string {
 
 // Predefined limit types
 const (
-	LimitTypeTestSessionsPerDay    = "test_sessions_per_day"
-	LimitTypeTestSessionsPerWeek   = "test_sessions_per_week"
-	LimitTypeAPIRequestsPerHour    = "api_requests_per_hour"
-	LimitTypeAPIRequestsPerDay     = "api_requests_per_day"
-	LimitTypeQuestionsPerDay       = "questions_per_day"
+	LimitTypeTestSessionsPerDay  = "test_sessions_per_day"
+	LimitTypeTestSessionsPerWeek = "test_sessions_per_week"
+	LimitTypeAPIRequestsPerHour  = "api_requests_per_hour"
+	LimitTypeAPIRequestsPerDay   = "api_requests_per_day"
+	LimitTypeQuestionsPerDay     = "questions_per_day"
 )
 
 // UserLimitUsage tracks individual usage for detailed analytics
@@ -36,11 +37,11 @@ type UserLimitUsage struct {
 	User       User      `json:"user" gorm:"foreignKey:UserID"`
 	LimitType  string    `json:"limit_type" gorm:"not null;size:50"`
 	Action     string    `json:"action" gorm:"not null;size:100"` // e.g., "create_test_session", "api_call"
-	ResourceID *int      `json:"resource_id"` // ID of the resource (test_session_id, question_id, etc.)
+	ResourceID *int      `json:"resource_id"`                     // ID of the resource (test_session_id, question_id, etc.)
 	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
 }
 
 // TableName specifies the table name for GORM
 func (UserLimitUsage) TableName() string {
 	return "user_limit_usage"
-}
\ No newline at end of file
+}
@@ -4,16 +4,18 @@ package contracts
 type EventType string
 
 const (
-	ExamResultCompleted EventType = "exam_result_completed"
+	ExamResultCompleted   EventType = "exam_result_completed"
 	ExamAssignmentCreated EventType = "exam_assignment_created"
 	ExamAssignmentUpdated EventType = "exam_assignment_updated"
 	ExamAssignmentDeleted EventType = "exam_assignment_deleted"
-	ModuleUpsert EventType = "module_upsert"
-	ModuleDeleted EventType = "module_deleted"
-	ClassUpsert EventType = "class_upsert"
-	ClassDeleted EventType = "class_deleted"
-	ClassStudentJoined EventType = "class_student_joined"
-	ClassStudentLeft EventType = "class_student_left"
+	ModuleUpsert          EventType = "module_upsert"
+	ModuleDeleted         EventType = "module_deleted"
+	ClassUpsert           EventType = "class_upsert"
+	ClassDeleted          EventType = "class_deleted"
+	ClassStudentJoined    EventType = "class_student_joined"
+	ClassStudentLeft      EventType = "class_student_left"
+	ClassTeacherJoined    EventType = "class_teacher_joined"
+	ClassTeacherLeft      EventType = "class_teacher_left"
 )
 
 // ExamResultPayload is emitted by CBT and consumed by LMS.
@@ -30,13 +32,13 @@ type ExamResultPayload struct {
 
 // ExamAssignmentPayload is emitted by LMS and consumed by CBT.
 type ExamAssignmentPayload struct {
-	AssignmentID int64   `json:"assignment_id"`
-	ClassID      int64   `json:"class_id"`
-	Title        string  `json:"title"`
-	MaxScore     float64 `json:"max_score"`
-	ModuleID     int64   `json:"module_id"`
-	ModuleRefType string `json:"module_ref_type,omitempty"`
-	ScheduledTime string `json:"scheduled_time"`
+	AssignmentID  int64   `json:"assignment_id"`
+	ClassID       int64   `json:"class_id"`
+	Title         string  `json:"title"`
+	MaxScore      float64 `json:"max_score"`
+	ModuleID      int64   `json:"module_id"`
+	ModuleRefType string  `json:"module_ref_type,omitempty"`
+	ScheduledTime string  `json:"scheduled_time"`
 }
 
 // ModuleUpsertPayload is emitted by LMS and consumed by CBT.
@@ -62,6 +64,12 @@ type ClassStudentPayload struct {
 	UserID  int64 `json:"user_id"`
 }
 
+// ClassTeacherPayload is emitted by LMS and consumed by CBT.
+type ClassTeacherPayload struct {
+	ClassID int64 `json:"class_id"`
+	UserID  int64 `json:"user_id"`
+}
+
 // DeletePayload is emitted by LMS for delete sync operations.
 type DeletePayload struct {
 	ID int64 `json:"id"`
@@ -0,0 +1,98 @@
+package api_key
+
+import (
+	"context"
+	"strings"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	apiKeyUsecase "cbt-test-mini-project/internal/usecase/api_key"
+	"cbt-test-mini-project/util/interceptor"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type apiKeyHandler struct {
+	base.UnimplementedApiKeyServiceServer
+	usecase apiKeyUsecase.APIKeyUsecase
+}
+
+func NewAPIKeyHandler(usecase apiKeyUsecase.APIKeyUsecase) base.ApiKeyServiceServer {
+	return &apiKeyHandler{usecase: usecase}
+}
+
+// CreateApiKey issues a key; the plaintext is only part of this response
+func (h *apiKeyHandler) CreateApiKey(ctx context.Context, req *base.CreateApiKeyRequest) (*base.CreateApiKeyResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	key, plaintext, err := h.usecase.CreateAPIKey(ctx, req.Name, req.Scopes, req.LmsSchoolId, int(req.ExpiresInDays), int(user.Id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.CreateApiKeyResponse{ApiKey: convertAPIKeyToProto(key), Key: plaintext}, nil
+}
+
+// ListApiKeys lists issued keys
+func (h *apiKeyHandler) ListApiKeys(ctx context.Context, req *base.ListApiKeysRequest) (*base.ListApiKeysResponse, error) {
+	keys, err := h.usecase.ListAPIKeys(ctx, req.IncludeRevoked)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list api keys")
+	}
+
+	res := make([]*base.ApiKey, 0, len(keys))
+	for i := range keys {
+		res = append(res, convertAPIKeyToProto(&keys[i]))
+	}
+	return &base.ListApiKeysResponse{ApiKeys: res}, nil
+}
+
+// RevokeApiKey stops a key from authenticating
+func (h *apiKeyHandler) RevokeApiKey(ctx context.Context, req *base.RevokeApiKeyRequest) (*base.MessageStatusResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := h.usecase.RevokeAPIKey(ctx, int(req.Id), int(user.Id)); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.MessageStatusResponse{Status: "success", Message: "API key revoked successfully"}, nil
+}
+
+func convertAPIKeyToProto(key *entity.APIKey) *base.ApiKey {
+	if key == nil {
+		return nil
+	}
+
+	result := &base.ApiKey{
+		Id:        int32(key.ID),
+		Name:      key.Name,
+		KeyPrefix: key.KeyPrefix,
+		Scopes:    key.Scopes,
+		CreatedBy: int32(key.CreatedBy),
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.LMSSchoolID != nil {
+		result.LmsSchoolId = *key.LMSSchoolID
+	}
+	if key.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+	if key.RevokedAt != nil {
+		result.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+	if key.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package audit_log
+
+import (
+	"context"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	auditLogUsecase "cbt-test-mini-project/internal/usecase/audit_log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type auditLogHandler struct {
+	base.UnimplementedAuditLogServiceServer
+	usecase auditLogUsecase.AuditLogUsecase
+}
+
+func NewAuditLogHandler(usecase auditLogUsecase.AuditLogUsecase) base.AuditLogServiceServer {
+	return &auditLogHandler{usecase: usecase}
+}
+
+// ListAuditLogs lists audit entries by actor, resource, method and time, newest first
+func (h *auditLogHandler) ListAuditLogs(ctx context.Context, req *base.ListAuditLogsRequest) (*base.ListAuditLogsResponse, error) {
+	filter := entity.AuditLogFilter{
+		ActorID:      int(req.ActorId),
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceId,
+		Method:       req.Method,
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.To.After(filter.From) {
+		return nil, status.Error(codes.InvalidArgument, "to must be after from")
+	}
+	if req.Pagination != nil {
+		filter.Page = int(req.Pagination.Page)
+		filter.PageSize = int(req.Pagination.PageSize)
+	}
+
+	entries, pagination, err := h.usecase.ListAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*base.AuditLog, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, convertAuditLog(entry))
+	}
+	return &base.ListAuditLogsResponse{
+		AuditLogs: res,
+		Pagination: &base.PaginationResponse{
+			TotalCount:  int32(pagination.TotalCount),
+			TotalPages:  int32(pagination.TotalPages),
+			CurrentPage: int32(pagination.CurrentPage),
+			PageSize:    int32(pagination.PageSize),
+		},
+	}, nil
+}
+
+func convertAuditLog(entry entity.AuditLog) *base.AuditLog {
+	res := &base.AuditLog{
+		Id:           entry.ID,
+		OccurredAt:   timestamppb.New(entry.OccurredAt),
+		ActorRole:    entry.ActorRole,
+		Method:       entry.Method,
+		ResourceType: entry.ResourceType,
+		ResourceId:   entry.ResourceID,
+		Before:       string(entry.Before),
+		After:        string(entry.After),
+		Request:      string(entry.Request),
+		RequestId:    entry.RequestID,
+		ClientIp:     entry.ClientIP,
+		Status:       entry.Status,
+	}
+	if entry.ActorID != nil {
+		res.ActorId = int32(*entry.ActorID)
+	}
+	if entry.APIKeyID != nil {
+		res.ApiKeyId = int32(*entry.APIKeyID)
+	}
+	if entry.SchoolID != nil {
+		res.SchoolId = *entry.SchoolID
+	}
+	return res
+}
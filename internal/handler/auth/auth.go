@@ -2,11 +2,17 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/usecase/auth"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // authHandler implements the AuthServiceServer.
@@ -36,3 +42,72 @@ func (h *authHandler) GetProfile(ctx context.Context, req *emptypb.Empty) (*base
 		Message: "Profile retrieved successfully",
 	}, nil
 }
+
+// Login handles standalone email and password login.
+func (h *authHandler) Login(ctx context.Context, req *base.LoginRequest) (*base.LoginResponse, error) {
+	user, tokens, err := h.usecase.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, authError(err)
+	}
+
+	return &base.LoginResponse{
+		Token:            tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		User:             user,
+		ExpiresAt:        timestamppb.New(tokens.AccessExpiresAt),
+		RefreshExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+		Success:          true,
+		Message:          "Login successful",
+	}, nil
+}
+
+// RefreshToken handles refresh token rotation.
+func (h *authHandler) RefreshToken(ctx context.Context, req *base.RefreshTokenRequest) (*base.RefreshTokenResponse, error) {
+	tokens, err := h.usecase.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, authError(err)
+	}
+
+	return &base.RefreshTokenResponse{
+		Token:            tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        timestamppb.New(tokens.AccessExpiresAt),
+		RefreshExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+		Success:          true,
+		Message:          "Token refreshed successfully",
+	}, nil
+}
+
+// Logout ends the caller's session.
+func (h *authHandler) Logout(ctx context.Context, req *emptypb.Empty) (*base.MessageStatusResponse, error) {
+	if err := h.usecase.Logout(ctx); err != nil {
+		return nil, authError(err)
+	}
+	return &base.MessageStatusResponse{Status: "success", Message: "Logged out successfully"}, nil
+}
+
+// ChangePassword handles a password change by the logged-in user.
+func (h *authHandler) ChangePassword(ctx context.Context, req *base.ChangePasswordRequest) (*base.MessageStatusResponse, error) {
+	if err := h.usecase.ChangePassword(ctx, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, authError(err)
+	}
+	return &base.MessageStatusResponse{Status: "success", Message: "Password changed successfully"}, nil
+}
+
+// authError maps usecase errors to gRPC status codes
+func authError(err error) error {
+	message := err.Error()
+	switch {
+	case errors.Is(err, auth.ErrLocalAuthDisabled), strings.Contains(message, "local session"):
+		return status.Error(codes.FailedPrecondition, message)
+	case errors.Is(err, entity.ErrInvalidRefreshToken), errors.Is(err, entity.ErrRefreshTokenReused),
+		strings.Contains(message, "invalid credentials"):
+		return status.Error(codes.Unauthenticated, message)
+	case strings.Contains(message, "required"), strings.Contains(message, "password"):
+		return status.Error(codes.InvalidArgument, message)
+	case strings.Contains(message, "not found"):
+		return status.Error(codes.NotFound, message)
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
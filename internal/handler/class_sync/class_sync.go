@@ -1,14 +1,12 @@
 package class_sync
 
 import (
+	"context"
+
 	base "cbt-test-mini-project/gen/proto"
 	classUsecase "cbt-test-mini-project/internal/usecase/class"
 	classStudentUsecase "cbt-test-mini-project/internal/usecase/class_student"
-	"cbt-test-mini-project/util/interceptor"
-	"context"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -26,10 +24,6 @@ func NewClassSyncHandler(classUsecase classUsecase.ClassUsecase, classStudentUse
 }
 
 func (h *classSyncHandler) ListClasses(ctx context.Context, req *base.ListClassesRequest) (*base.ListClassesResponse, error) {
-	if err := h.ensureAdmin(ctx); err != nil {
-		return nil, err
-	}
-
 	classes, err := h.classUsecase.ListClasses(req.LmsSchoolId)
 	if err != nil {
 		return nil, err
@@ -52,10 +46,6 @@ func (h *classSyncHandler) ListClasses(ctx context.Context, req *base.ListClasse
 }
 
 func (h *classSyncHandler) ListClassStudents(ctx context.Context, req *base.ListClassStudentsRequest) (*base.ListClassStudentsResponse, error) {
-	if err := h.ensureAdmin(ctx); err != nil {
-		return nil, err
-	}
-
 	students, err := h.classStudentUsecase.ListClassStudents(req.LmsClassId)
 	if err != nil {
 		return nil, err
@@ -73,16 +63,3 @@ func (h *classSyncHandler) ListClassStudents(ctx context.Context, req *base.List
 
 	return &base.ListClassStudentsResponse{Students: result}, nil
 }
-
-func (h *classSyncHandler) ensureAdmin(ctx context.Context) error {
-	user, err := interceptor.GetUserFromContext(ctx)
-	if err != nil {
-		return status.Error(codes.Unauthenticated, "user not authenticated")
-	}
-
-	if user.Role != base.UserRole_ADMIN {
-		return status.Error(codes.PermissionDenied, "admin role required")
-	}
-
-	return nil
-}
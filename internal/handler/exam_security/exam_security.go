@@ -0,0 +1,353 @@
+package exam_security
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	examSecurityUsecase "cbt-test-mini-project/internal/usecase/exam_security"
+	"cbt-test-mini-project/util/interceptor"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type examSecurityHandler struct {
+	base.UnimplementedExamSecurityServiceServer
+	usecase examSecurityUsecase.ExamSecurityUsecase
+}
+
+func NewExamSecurityHandler(usecase examSecurityUsecase.ExamSecurityUsecase) base.ExamSecurityServiceServer {
+	return &examSecurityHandler{usecase: usecase}
+}
+
+// UploadSebConfig stores the Safe Exam Browser config of an assignment and returns the computed keys
+func (h *examSecurityHandler) UploadSebConfig(ctx context.Context, req *base.UploadSebConfigRequest) (*base.SebConfigResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := h.usecase.UploadSebConfig(ctx, req.LmsAssignmentId, req.FileName, req.SebFile, req.BrowserExamKeys, int(user.Id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.SebConfigResponse{SebConfig: convertSebConfigToProto(cfg)}, nil
+}
+
+// GetSebConfig returns the Safe Exam Browser config of an assignment
+func (h *examSecurityHandler) GetSebConfig(ctx context.Context, req *base.GetSebConfigRequest) (*base.SebConfigResponse, error) {
+	cfg, err := h.usecase.GetSebConfig(ctx, req.LmsAssignmentId)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.SebConfigResponse{SebConfig: convertSebConfigToProto(cfg)}, nil
+}
+
+// DeleteSebConfig stops requiring Safe Exam Browser for an assignment
+func (h *examSecurityHandler) DeleteSebConfig(ctx context.Context, req *base.DeleteSebConfigRequest) (*base.MessageStatusResponse, error) {
+	if err := h.usecase.DeleteSebConfig(ctx, req.LmsAssignmentId); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.MessageStatusResponse{Status: "success", Message: "SEB config deleted successfully"}, nil
+}
+
+// ListDeviceLeases returns the device lease history of a session
+func (h *examSecurityHandler) ListDeviceLeases(ctx context.Context, req *base.ListDeviceLeasesRequest) (*base.ListDeviceLeasesResponse, error) {
+	leases, err := h.usecase.ListDeviceLeases(ctx, req.SessionToken, interceptor.TeacherScopeFromContext(ctx))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result := make([]*base.DeviceLease, 0, len(leases))
+	for i := range leases {
+		result = append(result, convertDeviceLeaseToProto(&leases[i]))
+	}
+
+	return &base.ListDeviceLeasesResponse{Leases: result}, nil
+}
+
+// ApproveDeviceTransfer lets a proctor move an ongoing session to another device
+func (h *examSecurityHandler) ApproveDeviceTransfer(ctx context.Context, req *base.ApproveDeviceTransferRequest) (*base.DeviceLeaseResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := h.usecase.ApproveDeviceTransfer(ctx, req.SessionToken, req.DeviceId, req.Note, interceptor.TeacherScopeFromContext(ctx), int(user.Id))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &base.DeviceLeaseResponse{Lease: convertDeviceLeaseToProto(lease)}, nil
+}
+
+// SetNetworkAllowlist replaces the CIDR allow-list of a school (admin) or an assignment
+func (h *examSecurityHandler) SetNetworkAllowlist(ctx context.Context, req *base.SetNetworkAllowlistRequest) (*base.NetworkAllowlistResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := h.usecase.SetNetworkAllowlist(ctx, req.LmsSchoolId, req.LmsAssignmentId, req.Cidrs, req.Label, int(user.Id))
+	if errors.Is(err, examSecurityUsecase.ErrOtherSchool) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.NetworkAllowlistResponse{Entries: convertNetworkAllowlistToProto(entries)}, nil
+}
+
+// GetNetworkAllowlist returns the CIDR allow-list of a school or an assignment
+func (h *examSecurityHandler) GetNetworkAllowlist(ctx context.Context, req *base.GetNetworkAllowlistRequest) (*base.NetworkAllowlistResponse, error) {
+	entries, err := h.usecase.GetNetworkAllowlist(ctx, req.LmsSchoolId, req.LmsAssignmentId)
+	if errors.Is(err, examSecurityUsecase.ErrOtherSchool) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.NetworkAllowlistResponse{Entries: convertNetworkAllowlistToProto(entries)}, nil
+}
+
+// GrantNetworkOverride lets an admin allow a session from outside the allow-listed networks
+func (h *examSecurityHandler) GrantNetworkOverride(ctx context.Context, req *base.GrantNetworkOverrideRequest) (*base.NetworkOverrideResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	override, err := h.usecase.GrantNetworkOverride(ctx, req.SessionToken, req.Reason, int(req.ExpiresInMinutes), int(user.Id))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &base.NetworkOverrideResponse{
+		Id:           override.ID,
+		SessionToken: req.SessionToken,
+		Reason:       override.Reason,
+		GrantedBy:    int32(override.GrantedBy),
+		CreatedAt:    timestamppb.New(override.CreatedAt),
+	}
+	if override.ExpiresAt != nil {
+		response.ExpiresAt = timestamppb.New(*override.ExpiresAt)
+	}
+	return response, nil
+}
+
+// ListNetworkAccessDenials returns the denied-access audit log
+func (h *examSecurityHandler) ListNetworkAccessDenials(ctx context.Context, req *base.ListNetworkAccessDenialsRequest) (*base.ListNetworkAccessDenialsResponse, error) {
+	page := 1
+	pageSize := 20
+	if req.Pagination != nil {
+		if req.Pagination.Page > 0 {
+			page = int(req.Pagination.Page)
+		}
+		if req.Pagination.PageSize > 0 {
+			pageSize = int(req.Pagination.PageSize)
+		}
+	}
+
+	denials, pagination, err := h.usecase.ListNetworkDenials(ctx, req.LmsSchoolId, req.LmsAssignmentId, interceptor.TeacherScopeFromContext(ctx), page, pageSize)
+	if errors.Is(err, examSecurityUsecase.ErrOtherSchool) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*base.NetworkAccessDenial, 0, len(denials))
+	for _, denial := range denials {
+		item := &base.NetworkAccessDenial{
+			Id:        denial.ID,
+			Method:    denial.Method,
+			ClientIp:  denial.ClientIP,
+			CreatedAt: timestamppb.New(denial.CreatedAt),
+		}
+		if denial.IDTestSession != nil {
+			item.IdTestSession = int32(*denial.IDTestSession)
+		}
+		if denial.UserID != nil {
+			item.UserId = int32(*denial.UserID)
+		}
+		if denial.LMSSchoolID != nil {
+			item.LmsSchoolId = *denial.LMSSchoolID
+		}
+		if denial.LMSAssignmentID != nil {
+			item.LmsAssignmentId = *denial.LMSAssignmentID
+		}
+		result = append(result, item)
+	}
+
+	return &base.ListNetworkAccessDenialsResponse{
+		Denials: result,
+		Pagination: &base.PaginationResponse{
+			TotalCount:  int32(pagination.TotalCount),
+			TotalPages:  int32(pagination.TotalPages),
+			CurrentPage: int32(pagination.CurrentPage),
+			PageSize:    int32(pagination.PageSize),
+		},
+	}, nil
+}
+
+// AnalyzeCollusion ranks pairs of sessions of an assignment by answer-pattern similarity
+func (h *examSecurityHandler) AnalyzeCollusion(ctx context.Context, req *base.AnalyzeCollusionRequest) (*base.CollusionReportResponse, error) {
+	report, err := h.usecase.AnalyzeCollusion(ctx, req.LmsAssignmentId, int(req.SyncWindowSeconds), int(req.MinSharedWrong), int(req.Limit), interceptor.TeacherScopeFromContext(ctx))
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pairs := make([]*base.CollusionPair, 0, len(report.Pairs))
+	for _, pair := range report.Pairs {
+		evidence := make([]*base.CollusionEvidence, 0, len(pair.Evidence))
+		for _, item := range pair.Evidence {
+			evidence = append(evidence, &base.CollusionEvidence{
+				QuestionType:   string(item.Item.QuestionType),
+				IdSoal:         int32(item.Item.QuestionID),
+				ResponseA:      item.ResponseA,
+				ResponseB:      item.ResponseB,
+				IsCorrect:      item.IsCorrect,
+				AnsweredAtA:    timestamppb.New(item.AnsweredAtA),
+				AnsweredAtB:    timestamppb.New(item.AnsweredAtB),
+				TimeGapSeconds: item.TimeGapSeconds,
+				IdenticalWrong: item.IdenticalWrong,
+				Synchronized:   item.Synchronized,
+			})
+		}
+
+		pairs = append(pairs, &base.CollusionPair{
+			SessionA:             convertCollusionSessionToProto(pair.SessionA),
+			SessionB:             convertCollusionSessionToProto(pair.SessionB),
+			CommonItems:          int32(pair.CommonItems),
+			IdenticalAnswers:     int32(pair.IdenticalAnswers),
+			SharedWrong:          int32(pair.SharedWrong),
+			Differences:          int32(pair.Differences),
+			HarppHoganRatio:      pair.HarppHoganRatio,
+			ExpectedSharedWrong:  pair.ExpectedSharedWrong,
+			SharedWrongPValue:    pair.SharedWrongPValue,
+			SynchronizedAnswers:  int32(pair.SynchronizedAnswers),
+			SynchronizedRatio:    pair.SynchronizedRatio,
+			MedianTimeGapSeconds: pair.MedianTimeGapSeconds,
+			Score:                pair.Score,
+			Flagged:              pair.Flagged,
+			Evidence:             evidence,
+		})
+	}
+
+	return &base.CollusionReportResponse{
+		LmsAssignmentId: report.LMSAssignmentID,
+		SessionCount:    int32(report.SessionCount),
+		PairsAnalyzed:   int32(report.PairsAnalyzed),
+		Pairs:           pairs,
+		GeneratedAt:     timestamppb.New(report.GeneratedAt),
+	}, nil
+}
+
+// currentUser returns the caller; staff roles are enforced by interceptor.MethodPolicies
+func (h *examSecurityHandler) currentUser(ctx context.Context) (*base.User, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	return user, nil
+}
+
+func convertSebConfigToProto(cfg *entity.SebConfig) *base.SebConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &base.SebConfig{
+		LmsAssignmentId: cfg.LMSAssignmentID,
+		FileName:        cfg.FileName,
+		ConfigKey:       cfg.ConfigKey,
+		BrowserExamKeys: cfg.BrowserExamKeys,
+		IsActive:        cfg.IsActive,
+		CreatedAt:       timestamppb.New(cfg.CreatedAt),
+		UpdatedAt:       timestamppb.New(cfg.UpdatedAt),
+	}
+}
+
+func convertDeviceLeaseToProto(lease *entity.DeviceLease) *base.DeviceLease {
+	if lease == nil {
+		return nil
+	}
+
+	result := &base.DeviceLease{
+		Id:           lease.ID,
+		DeviceId:     lease.DeviceID,
+		UserAgent:    lease.UserAgent,
+		IpAddress:    lease.IPAddress,
+		Status:       string(lease.Status),
+		AttemptCount: int32(lease.AttemptCount),
+		IssuedAt:     timestamppb.New(lease.IssuedAt),
+		LastSeenAt:   timestamppb.New(lease.LastSeenAt),
+		Note:         lease.Note,
+	}
+	if lease.ReleasedAt != nil {
+		result.ReleasedAt = timestamppb.New(*lease.ReleasedAt)
+	}
+	if lease.ApprovedBy != nil {
+		result.ApprovedBy = int32(*lease.ApprovedBy)
+	}
+	return result
+}
+
+func convertCollusionSessionToProto(session entity.SessionAnswerPattern) *base.CollusionSession {
+	result := &base.CollusionSession{
+		IdTestSession: int32(session.SessionID),
+		SessionToken:  session.SessionToken,
+		NamaPeserta:   session.NamaPeserta,
+	}
+	if session.UserID != nil {
+		result.UserId = int32(*session.UserID)
+	}
+	return result
+}
+
+func convertNetworkAllowlistToProto(entries []entity.NetworkAllowlistEntry) []*base.NetworkAllowlistEntry {
+	result := make([]*base.NetworkAllowlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		item := &base.NetworkAllowlistEntry{
+			Id:        entry.ID,
+			Cidr:      entry.CIDR,
+			Label:     entry.Label,
+			CreatedAt: timestamppb.New(entry.CreatedAt),
+		}
+		if entry.LMSSchoolID != nil {
+			item.LmsSchoolId = *entry.LMSSchoolID
+		}
+		if entry.LMSAssignmentID != nil {
+			item.LmsAssignmentId = *entry.LMSAssignmentID
+		}
+		result = append(result, item)
+	}
+	return result
+}
@@ -0,0 +1,617 @@
+package grading
+
+import (
+	"context"
+	"strings"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/handler/protoconv"
+	gradingUsecase "cbt-test-mini-project/internal/usecase/grading"
+	"cbt-test-mini-project/util/interceptor"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type gradingHandler struct {
+	base.UnimplementedGradingServiceServer
+	usecase gradingUsecase.GradingUsecase
+}
+
+func NewGradingHandler(usecase gradingUsecase.GradingUsecase) base.GradingServiceServer {
+	return &gradingHandler{usecase: usecase}
+}
+
+// RunEssaySimilarityCheck compares the essay answers of an assignment with each other and with the answer key
+func (h *gradingHandler) RunEssaySimilarityCheck(ctx context.Context, req *base.RunEssaySimilarityCheckRequest) (*base.EssaySimilarityRunResponse, error) {
+	run, err := h.usecase.RunEssaySimilarityCheck(ctx, req.LmsAssignmentId, int(req.IdSoal))
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &base.EssaySimilarityRunResponse{
+		LmsAssignmentId:   run.LMSAssignmentID,
+		IdSoal:            int32(run.SoalID),
+		AnswersCompared:   int32(run.AnswersCompared),
+		PairsCompared:     int32(run.PairsCompared),
+		SimilaritiesSaved: int32(run.SimilaritiesSaved),
+		FlaggedAnswers:    int32(run.FlaggedAnswers),
+		ComputedAt:        timestamppb.New(run.ComputedAt),
+	}, nil
+}
+
+// GetEssayGradingView returns an essay answer with its similarity results, for use before GradeEssayAnswer
+func (h *gradingHandler) GetEssayGradingView(ctx context.Context, req *base.GetEssayGradingViewRequest) (*base.EssayGradingViewResponse, error) {
+
+	view, err := h.usecase.GetEssayGradingView(ctx, int(req.AnswerId), isAdmin(ctx))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	similarities := make([]*base.EssaySimilarity, 0, len(view.Similarities))
+	for _, similarity := range view.Similarities {
+		item := &base.EssaySimilarity{
+			Source:              string(similarity.Source),
+			MatchedSessionToken: similarity.MatchedSessionToken,
+			MatchedNamaPeserta:  similarity.MatchedNamaPeserta,
+			Similarity:          similarity.Similarity,
+			Containment:         similarity.Containment,
+			ComputedAt:          timestamppb.New(similarity.ComputedAt),
+		}
+		if similarity.MatchedIDJawaban != nil {
+			item.MatchedAnswerId = int32(*similarity.MatchedIDJawaban)
+		}
+		for _, passage := range similarity.MatchedPassages {
+			item.MatchedPassages = append(item.MatchedPassages, &base.MatchedPassage{
+				Text:         passage.Text,
+				Start:        int32(passage.Start),
+				End:          int32(passage.End),
+				MatchedText:  passage.MatchedText,
+				MatchedStart: int32(passage.MatchedStart),
+				MatchedEnd:   int32(passage.MatchedEnd),
+				Words:        int32(passage.Words),
+			})
+		}
+		similarities = append(similarities, item)
+	}
+
+	return &base.EssayGradingViewResponse{
+		Answer:       convertEssayAnswerToProto(&view.Answer),
+		Similarities: similarities,
+		Rubric:       convertRubricToProto(view.Rubric),
+		RubricScores: protoconv.RubricScores(view.RubricScores),
+		Suggestion:   convertSuggestionToProto(view.Suggestion),
+	}, nil
+}
+
+// SetEssayRubric replaces the rubric of an essay soal
+func (h *gradingHandler) SetEssayRubric(ctx context.Context, req *base.SetEssayRubricRequest) (*base.EssayRubricResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := make([]entity.RubricCriterion, 0, len(req.Criteria))
+	for _, c := range req.Criteria {
+		criterion := entity.RubricCriterion{Nama: c.Nama, Urutan: int(c.Urutan)}
+		if c.Deskripsi != "" {
+			deskripsi := c.Deskripsi
+			criterion.Deskripsi = &deskripsi
+		}
+		for _, l := range c.Levels {
+			level := entity.RubricLevel{Label: l.Label, Point: l.Point, Urutan: int(l.Urutan)}
+			if l.Deskripsi != "" {
+				deskripsi := l.Deskripsi
+				level.Deskripsi = &deskripsi
+			}
+			criterion.Levels = append(criterion.Levels, level)
+		}
+		criteria = append(criteria, criterion)
+	}
+
+	rubric, err := h.usecase.SetEssayRubric(ctx, int(req.IdSoal), req.PassingScore, criteria, int(user.Id))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.EssayRubricResponse{Rubric: convertRubricToProto(rubric)}, nil
+}
+
+// GetEssayRubric returns the rubric of an essay soal
+func (h *gradingHandler) GetEssayRubric(ctx context.Context, req *base.GetEssayRubricRequest) (*base.EssayRubricResponse, error) {
+	rubric, err := h.usecase.GetEssayRubric(ctx, int(req.IdSoal))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.EssayRubricResponse{Rubric: convertRubricToProto(rubric)}, nil
+}
+
+// SetGradingConfig stores the blind-mode and double-marking settings of an assignment
+func (h *gradingHandler) SetGradingConfig(ctx context.Context, req *base.SetGradingConfigRequest) (*base.GradingConfigResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := h.usecase.SetGradingConfig(ctx, req.LmsAssignmentId, req.BlindMode, req.DoubleMarking, req.DiscrepancyThreshold, int(user.Id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.GradingConfigResponse{Config: convertGradingConfigToProto(config)}, nil
+}
+
+// GetGradingConfig returns the grading settings of an assignment
+func (h *gradingHandler) GetGradingConfig(ctx context.Context, req *base.GetGradingConfigRequest) (*base.GradingConfigResponse, error) {
+	config, err := h.usecase.GetGradingConfig(ctx, req.LmsAssignmentId)
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &base.GradingConfigResponse{Config: convertGradingConfigToProto(config)}, nil
+}
+
+// ListPendingEssays lists ungraded essays by assignment, class, soal or grader
+func (h *gradingHandler) ListPendingEssays(ctx context.Context, req *base.ListPendingEssaysRequest) (*base.ListPendingEssaysResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 20
+	if req.Pagination != nil {
+		if req.Pagination.Page > 0 {
+			page = int(req.Pagination.Page)
+		}
+		if req.Pagination.PageSize > 0 {
+			pageSize = int(req.Pagination.PageSize)
+		}
+	}
+
+	filter := entity.PendingEssayFilter{
+		LMSAssignmentID: req.LmsAssignmentId,
+		LMSClassID:      req.LmsClassId,
+		SoalID:          int(req.IdSoal),
+		GraderID:        int(req.GraderId),
+		InModeration:    req.InModeration,
+		Scope:           interceptor.TeacherScopeFromContext(ctx),
+	}
+	essays, pagination, err := h.usecase.ListPendingEssays(ctx, filter, page, pageSize, int(user.Id), isAdmin(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items := make([]*base.PendingEssay, 0, len(essays))
+	for i := range essays {
+		essay := &essays[i]
+		item := &base.PendingEssay{
+			Answer:     convertEssayAnswerToProto(&essay.Answer),
+			Tasks:      convertGradingTasksToProto(essay.Tasks),
+			Moderation: convertModerationToProto(essay.Moderation),
+			Suggestion: convertSuggestionToProto(essay.Suggestion),
+		}
+		items = append(items, item)
+	}
+
+	return &base.ListPendingEssaysResponse{
+		Essays: items,
+		Pagination: &base.PaginationResponse{
+			TotalCount:  int32(pagination.TotalCount),
+			TotalPages:  int32(pagination.TotalPages),
+			CurrentPage: int32(pagination.CurrentPage),
+			PageSize:    int32(pagination.PageSize),
+		},
+	}, nil
+}
+
+// AssignGraders distributes the pending essays of an assignment over graders
+func (h *gradingHandler) AssignGraders(ctx context.Context, req *base.AssignGradersRequest) (*base.AssignGradersResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	answerIDs := make([]int, 0, len(req.AnswerIds))
+	for _, id := range req.AnswerIds {
+		answerIDs = append(answerIDs, int(id))
+	}
+	graderIDs := make([]int, 0, len(req.GraderIds))
+	for _, id := range req.GraderIds {
+		graderIDs = append(graderIDs, int(id))
+	}
+
+	tasks, err := h.usecase.AssignGraders(ctx, req.LmsAssignmentId, int(req.IdSoal), answerIDs, graderIDs, int(req.MarkerSlot), int(user.Id))
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, status.Error(codes.Aborted, "essays were assigned concurrently, please retry")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.AssignGradersResponse{
+		Tasks:         convertGradingTasksToProto(tasks),
+		AssignedCount: int32(len(tasks)),
+	}, nil
+}
+
+// SubmitEssayMark records the caller's mark for an essay in the grading queue
+func (h *gradingHandler) SubmitEssayMark(ctx context.Context, req *base.SubmitEssayMarkRequest) (*base.EssayMarkResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.usecase.SubmitEssayMark(ctx, int(req.AnswerId), int(user.Id), isAdmin(ctx), req.Score,
+		protoconv.RubricSelections(req.RubricSelections), req.AcceptSuggestion, req.Feedback)
+	if err != nil {
+		return nil, markError(err)
+	}
+
+	return convertMarkResultToProto(result), nil
+}
+
+// ResolveModeration sets the final score of an essay whose two marks disagreed
+func (h *gradingHandler) ResolveModeration(ctx context.Context, req *base.ResolveModerationRequest) (*base.EssayMarkResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.usecase.ResolveModeration(ctx, int(req.AnswerId), int(user.Id), req.Score,
+		protoconv.RubricSelections(req.RubricSelections), req.Feedback, req.Note)
+	if err != nil {
+		return nil, markError(err)
+	}
+
+	return convertMarkResultToProto(result), nil
+}
+
+// GetGradingProgress counts the essays and sessions of an assignment by grading state
+func (h *gradingHandler) GetGradingProgress(ctx context.Context, req *base.GetGradingProgressRequest) (*base.GradingProgressResponse, error) {
+	progress, err := h.usecase.GetGradingProgress(ctx, req.LmsAssignmentId)
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &base.GradingProgressResponse{
+		LmsAssignmentId:           progress.LMSAssignmentID,
+		TotalEssays:               int32(progress.TotalEssays),
+		GradedEssays:              int32(progress.GradedEssays),
+		PendingEssays:             int32(progress.PendingEssays),
+		UnassignedEssays:          int32(progress.UnassignedEssays),
+		AwaitingSecondMark:        int32(progress.AwaitingSecondMark),
+		InModeration:              int32(progress.InModeration),
+		SessionsGradingInProgress: int32(progress.SessionsGradingInProgress),
+		SessionsGraded:            int32(progress.SessionsGraded),
+	}, nil
+}
+
+// SetEssayKeywords replaces the keywords the scoring assistant looks for in answers to an essay soal
+func (h *gradingHandler) SetEssayKeywords(ctx context.Context, req *base.SetEssayKeywordsRequest) (*base.EssayKeywordsResponse, error) {
+	keywords := make([]entity.EssayKeyword, 0, len(req.Keywords))
+	for _, k := range req.Keywords {
+		keywords = append(keywords, entity.EssayKeyword{
+			Keyword:  k.Keyword,
+			Synonyms: k.Synonyms,
+			Weight:   k.Weight,
+			Urutan:   int(k.Urutan),
+		})
+	}
+
+	keywords, err := h.usecase.SetEssayKeywords(ctx, int(req.IdSoal), keywords)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &base.EssayKeywordsResponse{IdSoal: req.IdSoal, Keywords: convertKeywordsToProto(keywords)}, nil
+}
+
+// GetEssayKeywords returns the keywords of an essay soal
+func (h *gradingHandler) GetEssayKeywords(ctx context.Context, req *base.GetEssayKeywordsRequest) (*base.EssayKeywordsResponse, error) {
+	keywords, err := h.usecase.GetEssayKeywords(ctx, int(req.IdSoal))
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &base.EssayKeywordsResponse{IdSoal: req.IdSoal, Keywords: convertKeywordsToProto(keywords)}, nil
+}
+
+// GenerateScoreSuggestions scores the pending essays of an assignment against keywords and the answer key
+func (h *gradingHandler) GenerateScoreSuggestions(ctx context.Context, req *base.GenerateScoreSuggestionsRequest) (*base.GenerateScoreSuggestionsResponse, error) {
+	run, err := h.usecase.GenerateScoreSuggestions(ctx, req.LmsAssignmentId, int(req.IdSoal))
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &base.GenerateScoreSuggestionsResponse{
+		LmsAssignmentId:  run.LMSAssignmentID,
+		IdSoal:           int32(run.SoalID),
+		AnswersScored:    int32(run.AnswersScored),
+		SkippedNoKeyData: int32(run.SkippedNoKeyData),
+		ComputedAt:       timestamppb.New(run.ComputedAt),
+	}, nil
+}
+
+// GetSuggestionAgreement reports how often final scores agree with the suggested scores
+func (h *gradingHandler) GetSuggestionAgreement(ctx context.Context, req *base.GetSuggestionAgreementRequest) (*base.SuggestionAgreementResponse, error) {
+	agreement, err := h.usecase.GetSuggestionAgreement(ctx, req.LmsAssignmentId, int(req.IdSoal))
+	if err != nil {
+		if strings.Contains(err.Error(), "required") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &base.SuggestionAgreementResponse{
+		LmsAssignmentId:        agreement.LMSAssignmentID,
+		IdSoal:                 int32(agreement.SoalID),
+		GradedWithSuggestion:   int32(agreement.GradedWithSuggestion),
+		Agreed:                 int32(agreement.Agreed),
+		AcceptedExactly:        int32(agreement.AcceptedExactly),
+		AgreementRate:          agreement.AgreementRate,
+		MeanAbsoluteDifference: agreement.MeanAbsoluteDifference,
+		Tolerance:              entity.SuggestionAgreementTolerance,
+	}, nil
+}
+
+// currentUser returns the caller; staff roles are enforced by interceptor.MethodPolicies
+func (h *gradingHandler) currentUser(ctx context.Context) (*base.User, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	return user, nil
+}
+
+func isAdmin(ctx context.Context) bool {
+	return interceptor.HasRole(ctx, interceptor.RoleSuperadmin)
+}
+
+func markError(err error) error {
+	switch {
+	case strings.Contains(err.Error(), "permission denied"):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case strings.Contains(err.Error(), "not found"):
+		return status.Error(codes.NotFound, err.Error())
+	case strings.Contains(err.Error(), "already"):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+func convertEssayAnswerToProto(answer *entity.EssayAnswerForGrading) *base.EssayAnswerForGrading {
+	result := &base.EssayAnswerForGrading{
+		AnswerId:      int32(answer.AnswerID),
+		IdTestSession: int32(answer.SessionID),
+		SessionToken:  answer.SessionToken,
+		NamaPeserta:   answer.NamaPeserta,
+		IdSoal:        int32(answer.SoalID),
+		Pertanyaan:    answer.Pertanyaan,
+		JawabanEssay:  answer.JawabanEssay,
+	}
+	if answer.UserID != nil {
+		result.UserId = int32(*answer.UserID)
+	}
+	if answer.LMSAssignmentID != nil {
+		result.LmsAssignmentId = *answer.LMSAssignmentID
+	}
+	if answer.LMSClassID != nil {
+		result.LmsClassId = *answer.LMSClassID
+	}
+	if answer.JawabanEssayKey != nil {
+		result.JawabanEssayKey = *answer.JawabanEssayKey
+	}
+	if answer.NilaiEssay != nil {
+		result.NilaiEssay = *answer.NilaiEssay
+		result.IsGraded = true
+	}
+	if answer.FeedbackTeacher != nil {
+		result.FeedbackTeacher = *answer.FeedbackTeacher
+	}
+	if answer.DijawabPada != nil {
+		result.DijawabPada = timestamppb.New(*answer.DijawabPada)
+	}
+	return result
+}
+
+func convertRubricToProto(rubric *entity.EssayRubric) *base.EssayRubric {
+	if rubric == nil {
+		return nil
+	}
+
+	result := &base.EssayRubric{
+		IdSoal:       int32(rubric.IDSoal),
+		PassingScore: rubric.PassingScore,
+		MaxPoint:     rubric.MaxPoint(),
+		UpdatedAt:    timestamppb.New(rubric.UpdatedAt),
+	}
+	for _, criterion := range rubric.Criteria {
+		item := &base.RubricCriterion{
+			Id:       criterion.ID,
+			Nama:     criterion.Nama,
+			Urutan:   int32(criterion.Urutan),
+			MaxPoint: criterion.MaxPoint(),
+		}
+		if criterion.Deskripsi != nil {
+			item.Deskripsi = *criterion.Deskripsi
+		}
+		for _, level := range criterion.Levels {
+			protoLevel := &base.RubricLevel{
+				Id:     level.ID,
+				Label:  level.Label,
+				Point:  level.Point,
+				Urutan: int32(level.Urutan),
+			}
+			if level.Deskripsi != nil {
+				protoLevel.Deskripsi = *level.Deskripsi
+			}
+			item.Levels = append(item.Levels, protoLevel)
+		}
+		result.Criteria = append(result.Criteria, item)
+	}
+	return result
+}
+
+func convertGradingConfigToProto(config *entity.GradingConfig) *base.GradingConfig {
+	result := &base.GradingConfig{
+		LmsAssignmentId:      config.LMSAssignmentID,
+		BlindMode:            config.BlindMode,
+		DoubleMarking:        config.DoubleMarking,
+		DiscrepancyThreshold: config.DiscrepancyThreshold,
+	}
+	if !config.UpdatedAt.IsZero() {
+		result.UpdatedAt = timestamppb.New(config.UpdatedAt)
+	}
+	return result
+}
+
+func convertGradingTasksToProto(tasks []entity.GradingTask) []*base.GradingTask {
+	result := make([]*base.GradingTask, 0, len(tasks))
+	for _, task := range tasks {
+		item := &base.GradingTask{
+			Id:         task.ID,
+			AnswerId:   int32(task.IDJawaban),
+			GraderId:   int32(task.GraderID),
+			MarkerSlot: int32(task.MarkerSlot),
+			Status:     string(task.Status),
+			AssignedAt: timestamppb.New(task.AssignedAt),
+		}
+		if task.Score != nil {
+			item.Score = *task.Score
+			item.HasScore = true
+		}
+		if task.Feedback != nil {
+			item.Feedback = *task.Feedback
+		}
+		if task.SubmittedAt != nil {
+			item.SubmittedAt = timestamppb.New(*task.SubmittedAt)
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func convertModerationToProto(moderation *entity.EssayModeration) *base.EssayModeration {
+	if moderation == nil {
+		return nil
+	}
+
+	result := &base.EssayModeration{
+		Status:      string(moderation.Status),
+		Discrepancy: moderation.Discrepancy,
+		CreatedAt:   timestamppb.New(moderation.CreatedAt),
+	}
+	if moderation.FinalScore != nil {
+		result.FinalScore = *moderation.FinalScore
+	}
+	if moderation.ModeratorID != nil {
+		result.ModeratorId = int32(*moderation.ModeratorID)
+	}
+	if moderation.Note != nil {
+		result.Note = *moderation.Note
+	}
+	return result
+}
+
+func convertMarkResultToProto(result *entity.EssayMarkResult) *base.EssayMarkResponse {
+	response := &base.EssayMarkResponse{
+		AnswerId:      int32(result.AnswerID),
+		Status:        string(result.Status),
+		RubricScores:  protoconv.RubricScores(result.RubricScores),
+		SessionToken:  result.SessionToken,
+		SessionStatus: string(result.SessionStatus),
+	}
+	if result.NilaiEssay != nil {
+		response.NilaiEssay = *result.NilaiEssay
+	}
+	if result.Discrepancy != nil {
+		response.Discrepancy = *result.Discrepancy
+	}
+	return response
+}
+
+func convertKeywordsToProto(keywords []entity.EssayKeyword) []*base.EssayKeyword {
+	result := make([]*base.EssayKeyword, 0, len(keywords))
+	for _, keyword := range keywords {
+		result = append(result, &base.EssayKeyword{
+			Id:       keyword.ID,
+			Keyword:  keyword.Keyword,
+			Synonyms: keyword.Synonyms,
+			Weight:   keyword.Weight,
+			Urutan:   int32(keyword.Urutan),
+		})
+	}
+	return result
+}
+
+func convertSuggestionToProto(suggestion *entity.EssayScoreSuggestion) *base.ScoreSuggestion {
+	if suggestion == nil {
+		return nil
+	}
+
+	result := &base.ScoreSuggestion{
+		SuggestedScore:  suggestion.SuggestedScore,
+		MissingKeywords: suggestion.MissingKeywords,
+		ComputedAt:      timestamppb.New(suggestion.ComputedAt),
+	}
+	if suggestion.KeywordCoverage != nil {
+		result.KeywordCoverage = *suggestion.KeywordCoverage
+		result.HasKeywordCoverage = true
+	}
+	if suggestion.ReferenceOverlap != nil {
+		result.ReferenceOverlap = *suggestion.ReferenceOverlap
+		result.HasReferenceOverlap = true
+	}
+	for _, match := range suggestion.KeywordMatches {
+		result.KeywordMatches = append(result.KeywordMatches, &base.KeywordMatch{
+			Keyword:     match.Keyword,
+			MatchedTerm: match.MatchedTerm,
+			Start:       int32(match.Start),
+			End:         int32(match.End),
+		})
+	}
+	for _, passage := range suggestion.ReferencePassages {
+		result.ReferencePassages = append(result.ReferencePassages, &base.MatchedPassage{
+			Text:         passage.Text,
+			Start:        int32(passage.Start),
+			End:          int32(passage.End),
+			MatchedText:  passage.MatchedText,
+			MatchedStart: int32(passage.MatchedStart),
+			MatchedEnd:   int32(passage.MatchedEnd),
+			Words:        int32(passage.Words),
+		})
+	}
+	return result
+}
@@ -1,12 +1,14 @@
 package history
 
 import (
+	"context"
+	"strings"
+
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/handler/protoconv"
 	"cbt-test-mini-project/internal/usecase/history"
 	"cbt-test-mini-project/util/interceptor"
-	"context"
-	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -59,7 +61,7 @@ func (h *historyHandler) GetStudentHistory(ctx context.Context, req *base.Studen
 		}
 	}
 
-	response, err := h.usecase.GetStudentHistory(int(userID), tingkatan, idMataPelajaran, page, pageSize)
+	response, err := h.usecase.GetStudentHistory(ctx, int(userID), tingkatan, idMataPelajaran, page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +112,7 @@ func (h *historyHandler) GetHistoryDetail(ctx context.Context, req *base.GetHist
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	response, err := h.usecase.GetHistoryDetail(req.SessionToken)
+	response, err := h.usecase.GetHistoryDetail(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -123,20 +125,30 @@ func (h *historyHandler) GetHistoryDetail(ctx context.Context, req *base.GetHist
 	var jawabanDetails []*base.JawabanDetail
 	for _, d := range response.DetailJawaban {
 		var jawabanDipilih base.JawabanOption
+		var jawabanDipilihLabel string
 		if d.JawabanDipilih != nil {
 			jawabanDipilih = base.JawabanOption(base.JawabanOption_value[string(*d.JawabanDipilih)])
+			jawabanDipilihLabel = string(*d.JawabanDipilih)
 		}
 
 		jawabanDetails = append(jawabanDetails, &base.JawabanDetail{
-			NomorUrut:      int32(d.NomorUrut),
-			Pertanyaan:     d.Pertanyaan,
-			OpsiA:          d.OpsiA,
-			OpsiB:          d.OpsiB,
-			OpsiC:          d.OpsiC,
-			OpsiD:          d.OpsiD,
-			JawabanDipilih: jawabanDipilih,
-			JawabanBenar:   base.JawabanOption(base.JawabanOption_value[string(d.JawabanBenar)]),
-			IsCorrect:      d.IsCorrect,
+			NomorUrut:                   int32(d.NomorUrut),
+			Pertanyaan:                  d.Pertanyaan,
+			OpsiA:                       d.OpsiA,
+			OpsiB:                       d.OpsiB,
+			OpsiC:                       d.OpsiC,
+			OpsiD:                       d.OpsiD,
+			JawabanDipilih:              jawabanDipilih,
+			JawabanBenar:                base.JawabanOption(base.JawabanOption_value[string(d.JawabanBenar)]),
+			IsCorrect:                   d.IsCorrect,
+			RubricScores:                protoconv.RubricScores(d.RubricScores),
+			Opsi:                        protoconv.SoalOpsi(d.Opsi, d.FormatKonten),
+			ContentFormat:               protoconv.FormatKonten(d.FormatKonten),
+			PertanyaanHtml:              protoconv.RenderKonten(d.FormatKonten, d.Pertanyaan),
+			JawabanDipilihLabel:         jawabanDipilihLabel,
+			JawabanBenarLabel:           string(d.JawabanBenar),
+			JawabanDipilihComplexLabels: protoconv.JawabanLabels(d.JawabanDipilihComplex),
+			JawabanBenarComplexLabels:   protoconv.JawabanLabels(d.JawabanBenarComplex),
 		})
 	}
 
@@ -203,7 +215,7 @@ func (h *historyHandler) convertUserToProto(user *entity.User) *base.User {
 		return nil
 	}
 
-	role := base.UserRole(base.UserRole_value[strings.ToUpper(user.Role)])
+	role := interceptor.ProtoRole(user.Role)
 
 	return &base.User{
 		Id:        int32(user.ID),
@@ -248,19 +260,13 @@ func (h *historyHandler) convertHistoriesToProto(histories []entity.HistorySumma
 	return protoHistories
 }
 
-// ListStudentHistories lists all student histories (admin only)
+// ListStudentHistories lists student histories; teachers only see the classes they teach
 func (h *historyHandler) ListStudentHistories(ctx context.Context, req *base.ListStudentHistoriesRequest) (*base.ListStudentHistoriesResponse, error) {
 	// Get user from context
-	user, err := interceptor.GetUserFromContext(ctx)
-	if err != nil {
+	if _, err := interceptor.GetUserFromContext(ctx); err != nil {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Check if user is admin
-	if user.Role != base.UserRole_ADMIN {
-		return nil, status.Error(codes.PermissionDenied, "admin access required")
-	}
-
 	page := 1
 	pageSize := 20
 	if req.Pagination != nil {
@@ -287,7 +293,7 @@ func (h *historyHandler) ListStudentHistories(ctx context.Context, req *base.Lis
 		idMataPelajaran = &[]int{int(req.IdMataPelajaran)}[0]
 	}
 
-	histories, total, err := h.usecase.ListStudentHistories(userID, tingkatan, idMataPelajaran, page, pageSize)
+	histories, total, err := h.usecase.ListStudentHistories(ctx, userID, tingkatan, idMataPelajaran, interceptor.TeacherScopeFromContext(ctx), page, pageSize)
 	if err != nil {
 		return &base.ListStudentHistoriesResponse{
 			HistoryPerStudent: []*base.StudentHistoryWithUser{},
@@ -0,0 +1,101 @@
+package lab_login
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	labLoginUsecase "cbt-test-mini-project/internal/usecase/lab_login"
+	"cbt-test-mini-project/util/interceptor"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type labLoginHandler struct {
+	base.UnimplementedLabLoginServiceServer
+	usecase labLoginUsecase.LabLoginUsecase
+}
+
+func NewLabLoginHandler(usecase labLoginUsecase.LabLoginUsecase) base.LabLoginServiceServer {
+	return &labLoginHandler{usecase: usecase}
+}
+
+// IssueLabCredentials issues credentials to print; the PINs and QR codes are only part of this response
+func (h *labLoginHandler) IssueLabCredentials(ctx context.Context, req *base.IssueLabCredentialsRequest) (*base.IssueLabCredentialsResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	credentials, err := h.usecase.IssueCredentials(ctx, req.LmsAssignmentId, toInts(req.UserIds), int(req.ValidMinutes), interceptor.TeacherScopeFromContext(ctx), int(user.Id))
+	if err != nil {
+		return nil, labLoginError(err)
+	}
+
+	res := make([]*base.LabCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		res = append(res, &base.LabCredential{
+			Id:              int32(credential.ID),
+			UserId:          int32(credential.UserID),
+			NamaPeserta:     credential.NamaPeserta,
+			LmsAssignmentId: credential.LMSAssignmentID,
+			Pin:             credential.PIN,
+			QrCode:          credential.QRCode,
+			ExpiresAt:       timestamppb.New(credential.ExpiresAt),
+		})
+	}
+	return &base.IssueLabCredentialsResponse{Credentials: res}, nil
+}
+
+// RevokeLabCredentials revokes credentials, e.g. for lost cards, and ends logins made with them
+func (h *labLoginHandler) RevokeLabCredentials(ctx context.Context, req *base.RevokeLabCredentialsRequest) (*base.RevokeLabCredentialsResponse, error) {
+	revoked, err := h.usecase.RevokeCredentials(ctx, req.LmsAssignmentId, toInts(req.UserIds), interceptor.TeacherScopeFromContext(ctx))
+	if err != nil {
+		return nil, labLoginError(err)
+	}
+	return &base.RevokeLabCredentialsResponse{Revoked: int32(revoked)}, nil
+}
+
+// LabLogin exchanges a QR code or PIN for a token limited to one test session
+func (h *labLoginHandler) LabLogin(ctx context.Context, req *base.LabLoginRequest) (*base.LabLoginResponse, error) {
+	user, result, err := h.usecase.LabLogin(ctx, req.QrCode, req.LmsAssignmentId, req.Pin)
+	if err != nil {
+		return nil, labLoginError(err)
+	}
+	return &base.LabLoginResponse{
+		Token:        result.AccessToken,
+		ExpiresAt:    timestamppb.New(result.ExpiresAt),
+		SessionToken: result.SessionToken,
+		User:         user,
+	}, nil
+}
+
+func labLoginError(err error) error {
+	message := err.Error()
+	switch {
+	case errors.Is(err, entity.ErrLabLoginDisabled), errors.Is(err, entity.ErrLabSessionClosed):
+		return status.Error(codes.FailedPrecondition, message)
+	case errors.Is(err, entity.ErrInvalidLabCredential):
+		return status.Error(codes.Unauthenticated, message)
+	case errors.Is(err, entity.ErrTooManyPinAttempts):
+		return status.Error(codes.ResourceExhausted, message)
+	case strings.Contains(message, "required"), strings.Contains(message, "must be"):
+		return status.Error(codes.InvalidArgument, message)
+	case strings.Contains(message, "no scheduled"):
+		return status.Error(codes.NotFound, message)
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
+
+func toInts(ids []int32) []int {
+	res := make([]int, len(ids))
+	for i, id := range ids {
+		res[i] = int(id)
+	}
+	return res
+}
@@ -1,16 +1,18 @@
 package materi
 
 import (
+	"context"
+
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/usecase/mata_pelajaran"
 	"cbt-test-mini-project/internal/usecase/materi"
 	"cbt-test-mini-project/internal/usecase/soal"
 	"cbt-test-mini-project/util/interceptor"
-	"context"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // materiHandler implements base.MateriServiceServer
@@ -137,7 +139,7 @@ func (h *materiHandler) CreateMateri(ctx context.Context, req *base.CreateMateri
 		lmsTeacherMaterialID = &v
 	}
 
-	m, err := h.usecase.CreateMateri(int(req.IdMataPelajaran), req.Nama, int(req.IdTingkat), req.IsActive, int(req.DefaultDurasiMenit), int(req.DefaultJumlahSoal), ownerUserID, schoolID, labels, req.RandomizeQuestions, lmsModuleID, lmsBookID, lmsTeacherMaterialID)
+	m, err := h.usecase.CreateMateri(ctx, int(req.IdMataPelajaran), req.Nama, int(req.IdTingkat), req.IsActive, int(req.DefaultDurasiMenit), int(req.DefaultJumlahSoal), ownerUserID, schoolID, labels, req.RandomizeQuestions, lmsModuleID, lmsBookID, lmsTeacherMaterialID)
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +154,6 @@ func (h *materiHandler) CreateMateriSuperadmin(ctx context.Context, req *base.Cr
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
-	if interceptor.GetRoleNameFromContext(ctx) != "superadmin" {
-		return nil, status.Error(codes.PermissionDenied, "hanya superadmin yang boleh akses endpoint ini")
-	}
 	if req.LmsBookId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "lms_book_id wajib diisi")
 	}
@@ -169,6 +168,7 @@ func (h *materiHandler) CreateMateriSuperadmin(ctx context.Context, req *base.Cr
 
 	lmsBookID := req.LmsBookId
 	m, err := h.usecase.CreateMateri(
+		ctx,
 		int(req.IdMataPelajaran),
 		req.Nama,
 		int(req.IdTingkat),
@@ -195,9 +195,6 @@ func (h *materiHandler) CreateMateriTeacher(ctx context.Context, req *base.Creat
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
-	if interceptor.GetRoleNameFromContext(ctx) != "teacher" {
-		return nil, status.Error(codes.PermissionDenied, "hanya guru yang boleh akses endpoint ini")
-	}
 	if req.LmsTeacherMaterialId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "lms_teacher_material_id wajib diisi")
 	}
@@ -212,6 +209,7 @@ func (h *materiHandler) CreateMateriTeacher(ctx context.Context, req *base.Creat
 
 	lmsTeacherMaterialID := req.LmsTeacherMaterialId
 	m, err := h.usecase.CreateMateri(
+		ctx,
 		int(req.IdMataPelajaran),
 		req.Nama,
 		int(req.IdTingkat),
@@ -235,13 +233,13 @@ func (h *materiHandler) CreateMateriTeacher(ctx context.Context, req *base.Creat
 
 // GetMateri gets materi by ID
 func (h *materiHandler) GetMateri(ctx context.Context, req *base.GetMateriRequest) (*base.MateriResponse, error) {
-	m, err := h.usecase.GetMateri(int(req.Id))
+	m, err := h.usecase.GetMateri(ctx, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
 
 	// Get question count for this materi
-	counts, _ := h.soalUsecase.GetQuestionCountsByTopic()
+	counts, _ := h.soalUsecase.GetQuestionCountsByTopic(ctx)
 	questionCount := 0
 	if counts != nil {
 		questionCount = counts[m.ID]
@@ -255,7 +253,7 @@ func (h *materiHandler) GetMateri(ctx context.Context, req *base.GetMateriReques
 // UpdateMateri updates materi
 func (h *materiHandler) UpdateMateri(ctx context.Context, req *base.UpdateMateriRequest) (*base.MateriResponse, error) {
 	roleName := interceptor.GetRoleNameFromContext(ctx)
-	existing, err := h.usecase.GetMateri(int(req.Id))
+	existing, err := h.usecase.GetMateri(ctx, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -296,13 +294,13 @@ func (h *materiHandler) UpdateMateri(ctx context.Context, req *base.UpdateMateri
 		lmsTeacherMaterialID = &v
 	}
 
-	m, err := h.usecase.UpdateMateri(int(req.Id), int(req.IdMataPelajaran), req.Nama, int(req.IdTingkat), req.IsActive, int(req.DefaultDurasiMenit), int(req.DefaultJumlahSoal), req.RandomizeQuestions, lmsModuleID, lmsBookID, lmsTeacherMaterialID)
+	m, err := h.usecase.UpdateMateri(ctx, int(req.Id), int(req.IdMataPelajaran), req.Nama, int(req.IdTingkat), req.IsActive, int(req.DefaultDurasiMenit), int(req.DefaultJumlahSoal), req.RandomizeQuestions, lmsModuleID, lmsBookID, lmsTeacherMaterialID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get question count for this materi
-	counts, _ := h.soalUsecase.GetQuestionCountsByTopic()
+	counts, _ := h.soalUsecase.GetQuestionCountsByTopic(ctx)
 	questionCount := 0
 	if counts != nil {
 		questionCount = counts[m.ID]
@@ -315,7 +313,7 @@ func (h *materiHandler) UpdateMateri(ctx context.Context, req *base.UpdateMateri
 
 // DeleteMateri deletes materi
 func (h *materiHandler) DeleteMateri(ctx context.Context, req *base.DeleteMateriRequest) (*base.MessageStatusResponse, error) {
-	err := h.usecase.DeleteMateri(int(req.Id))
+	err := h.usecase.DeleteMateri(ctx, int(req.Id))
 	if err != nil {
 		return &base.MessageStatusResponse{
 			Message: "Failed to delete materi",
@@ -345,13 +343,13 @@ func (h *materiHandler) ListMateri(ctx context.Context, req *base.ListMateriRequ
 	if pageSize > 1000 {
 		pageSize = 1000
 	}
-	materis, pagination, err := h.usecase.ListMateri(int(req.IdMataPelajaran), int(req.IdTingkat), page, pageSize)
+	materis, pagination, err := h.usecase.ListMateri(ctx, int(req.IdMataPelajaran), int(req.IdTingkat), interceptor.TeacherScopeFromContext(ctx), page, pageSize)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get all question counts in one query
-	counts, _ := h.soalUsecase.GetQuestionCountsByTopic()
+	counts, _ := h.soalUsecase.GetQuestionCountsByTopic(ctx)
 	if counts == nil {
 		counts = make(map[int]int)
 	}
@@ -372,3 +370,61 @@ func (h *materiHandler) ListMateri(ctx context.Context, req *base.ListMateriRequ
 		},
 	}, nil
 }
+
+// ShareMateri gives another teacher view or edit access to a materi
+func (h *materiHandler) ShareMateri(ctx context.Context, req *base.ShareMateriRequest) (*base.ShareMateriResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	permission := entity.MateriPermission(req.Permission)
+	if !permission.IsGrantable() {
+		return nil, status.Error(codes.InvalidArgument, "permission must be view or edit")
+	}
+
+	grant, err := h.usecase.ShareMateri(ctx, int(req.IdMateri), int(req.UserId), permission, int(user.Id))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &base.ShareMateriResponse{Share: convertToProtoMateriShare(grant)}, nil
+}
+
+// RevokeMateriShare removes a teacher's access to a materi
+func (h *materiHandler) RevokeMateriShare(ctx context.Context, req *base.RevokeMateriShareRequest) (*base.MessageStatusResponse, error) {
+	if err := h.usecase.RevokeMateriShare(ctx, int(req.IdMateri), int(req.UserId)); err != nil {
+		return &base.MessageStatusResponse{
+			Message: "Failed to revoke materi share",
+			Status:  "error",
+		}, err
+	}
+
+	return &base.MessageStatusResponse{
+		Message: "Materi share revoked successfully",
+		Status:  "success",
+	}, nil
+}
+
+// ListMateriShares lists the teachers a materi is shared with
+func (h *materiHandler) ListMateriShares(ctx context.Context, req *base.ListMateriSharesRequest) (*base.ListMateriSharesResponse, error) {
+	grants, err := h.usecase.ListMateriShares(ctx, int(req.IdMateri))
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]*base.MateriShare, 0, len(grants))
+	for i := range grants {
+		shares = append(shares, convertToProtoMateriShare(&grants[i]))
+	}
+	return &base.ListMateriSharesResponse{Shares: shares}, nil
+}
+
+func convertToProtoMateriShare(grant *entity.MateriGrant) *base.MateriShare {
+	return &base.MateriShare{
+		IdMateri:   int32(grant.IDMateri),
+		UserId:     int32(grant.UserID),
+		UserName:   grant.UserName,
+		Permission: string(grant.Permission),
+		GrantedBy:  int32(grant.GrantedBy),
+		CreatedAt:  timestamppb.New(grant.CreatedAt),
+	}
+}
@@ -0,0 +1,105 @@
+package protoconv
+
+import (
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+)
+
+// EntityShortAnswerBlanks converts the blanks of a short answer or cloze soal
+func EntityShortAnswerBlanks(blanks []*base.ShortAnswerBlank) []entity.ShortAnswerBlank {
+	result := make([]entity.ShortAnswerBlank, 0, len(blanks))
+	for _, blank := range blanks {
+		result = append(result, entity.ShortAnswerBlank{
+			AcceptedAnswers: blank.AcceptedAnswers,
+			CaseSensitive:   blank.CaseSensitive,
+			ExactWhitespace: blank.ExactWhitespace,
+			KeepDiacritics:  blank.KeepDiacritics,
+			Regex:           blank.Regex,
+		})
+	}
+	return result
+}
+
+// ShortAnswerBlanks converts the blanks of a short answer or cloze soal
+func ShortAnswerBlanks(blanks []entity.ShortAnswerBlank) []*base.ShortAnswerBlank {
+	result := make([]*base.ShortAnswerBlank, 0, len(blanks))
+	for _, blank := range blanks {
+		result = append(result, &base.ShortAnswerBlank{
+			AcceptedAnswers: blank.AcceptedAnswers,
+			CaseSensitive:   blank.CaseSensitive,
+			ExactWhitespace: blank.ExactWhitespace,
+			KeepDiacritics:  blank.KeepDiacritics,
+			Regex:           blank.Regex,
+		})
+	}
+	return result
+}
+
+// EntityNumericAnswerKey converts a numeric answer key
+func EntityNumericAnswerKey(key *base.NumericAnswerKey) *entity.NumericAnswerKey {
+	if key == nil {
+		return nil
+	}
+	return &entity.NumericAnswerKey{
+		Value:              key.Value,
+		AbsTolerance:       key.AbsTolerance,
+		RelTolerance:       key.RelTolerance,
+		Unit:               key.Unit,
+		AcceptedUnits:      key.AcceptedUnits,
+		UnitRequired:       key.UnitRequired,
+		SignificantFigures: int(key.SignificantFigures),
+		DecimalSeparator:   key.DecimalSeparator,
+	}
+}
+
+// NumericAnswerKey converts a numeric answer key
+func NumericAnswerKey(key *entity.NumericAnswerKey) *base.NumericAnswerKey {
+	if key == nil {
+		return nil
+	}
+	return &base.NumericAnswerKey{
+		Value:              key.Value,
+		AbsTolerance:       key.AbsTolerance,
+		RelTolerance:       key.RelTolerance,
+		Unit:               key.Unit,
+		AcceptedUnits:      key.AcceptedUnits,
+		UnitRequired:       key.UnitRequired,
+		SignificantFigures: int32(key.SignificantFigures),
+		DecimalSeparator:   key.DecimalSeparator,
+	}
+}
+
+// EntityGridAnswerKey converts a grid answer key, skipping nil rows
+func EntityGridAnswerKey(key *base.GridAnswerKey) *entity.GridAnswerKey {
+	if key == nil {
+		return nil
+	}
+	rows := make([]entity.GridRow, 0, len(key.Rows))
+	for _, r := range key.Rows {
+		if r == nil {
+			continue
+		}
+		rows = append(rows, entity.GridRow{Teks: r.Teks, KolomBenar: int(r.KolomBenar)})
+	}
+	return &entity.GridAnswerKey{
+		Columns:      key.Columns,
+		Rows:         rows,
+		AllOrNothing: key.AllOrNothing,
+	}
+}
+
+// GridAnswerKey converts a grid answer key
+func GridAnswerKey(key *entity.GridAnswerKey) *base.GridAnswerKey {
+	if key == nil {
+		return nil
+	}
+	rows := make([]*base.GridRow, 0, len(key.Rows))
+	for _, r := range key.Rows {
+		rows = append(rows, &base.GridRow{Teks: r.Teks, KolomBenar: int32(r.KolomBenar)})
+	}
+	return &base.GridAnswerKey{
+		Columns:      key.Columns,
+		Rows:         rows,
+		AllOrNothing: key.AllOrNothing,
+	}
+}
@@ -0,0 +1,95 @@
+package protoconv
+
+import (
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+)
+
+// EntityHotspotPoints converts the points of a hotspot response, skipping nil points
+func EntityHotspotPoints(points []*base.HotspotPoint) []entity.HotspotPoint {
+	result := make([]entity.HotspotPoint, 0, len(points))
+	for _, p := range points {
+		if p == nil {
+			continue
+		}
+		result = append(result, entity.HotspotPoint{X: p.X, Y: p.Y})
+	}
+	return result
+}
+
+// HotspotPoints converts the points of a hotspot response
+func HotspotPoints(points []entity.HotspotPoint) []*base.HotspotPoint {
+	result := make([]*base.HotspotPoint, 0, len(points))
+	for _, p := range points {
+		result = append(result, &base.HotspotPoint{X: p.X, Y: p.Y})
+	}
+	return result
+}
+
+// EntityHotspotAnswerKey converts a hotspot answer key; regions of an unknown shape keep an
+// empty shape for validation to reject
+func EntityHotspotAnswerKey(key *base.HotspotAnswerKey) *entity.HotspotAnswerKey {
+	if key == nil {
+		return nil
+	}
+	regions := make([]entity.HotspotRegion, 0, len(key.Regions))
+	for _, r := range key.Regions {
+		if r == nil {
+			continue
+		}
+		shape := entity.HotspotShape("")
+		switch r.Shape {
+		case base.HotspotShape_HOTSPOT_RECTANGLE:
+			shape = entity.HotspotShapeRectangle
+		case base.HotspotShape_HOTSPOT_POLYGON:
+			shape = entity.HotspotShapePolygon
+		}
+		regions = append(regions, entity.HotspotRegion{
+			Label:  r.Label,
+			Shape:  shape,
+			X:      r.X,
+			Y:      r.Y,
+			Width:  r.Width,
+			Height: r.Height,
+			Points: EntityHotspotPoints(r.Points),
+		})
+	}
+	return &entity.HotspotAnswerKey{
+		ImageUrutan: int(key.ImageUrutan),
+		Regions:     regions,
+		RequireAll:  key.RequireAll,
+		MaxPoints:   int(key.MaxPoints),
+	}
+}
+
+// HotspotAnswerKey converts a hotspot answer key
+func HotspotAnswerKey(key *entity.HotspotAnswerKey) *base.HotspotAnswerKey {
+	if key == nil {
+		return nil
+	}
+	regions := make([]*base.HotspotRegion, 0, len(key.Regions))
+	for _, r := range key.Regions {
+		shape := base.HotspotShape_HOTSPOT_SHAPE_INVALID
+		switch r.Shape {
+		case entity.HotspotShapeRectangle:
+			shape = base.HotspotShape_HOTSPOT_RECTANGLE
+		case entity.HotspotShapePolygon:
+			shape = base.HotspotShape_HOTSPOT_POLYGON
+		}
+		regions = append(regions, &base.HotspotRegion{
+			Label:  r.Label,
+			Shape:  shape,
+			X:      r.X,
+			Y:      r.Y,
+			Width:  r.Width,
+			Height: r.Height,
+			Points: HotspotPoints(r.Points),
+		})
+	}
+	return &base.HotspotAnswerKey{
+		ImageUrutan: int32(key.ImageUrutan),
+		Regions:     regions,
+		RequireAll:  key.RequireAll,
+		MaxPoints:   int32(key.MaxPoints),
+	}
+}
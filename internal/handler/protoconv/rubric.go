@@ -0,0 +1,41 @@
+// Package protoconv converts the entities shared by several handlers to and from their proto
+// messages, so every service returns them the same way.
+package protoconv
+
+import (
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+)
+
+// RubricScores converts the rubric breakdown of a graded essay
+func RubricScores(scores []entity.JawabanRubricScore) []*base.RubricScore {
+	result := make([]*base.RubricScore, 0, len(scores))
+	for _, score := range scores {
+		item := &base.RubricScore{
+			CriterionId:  score.IDCriterion,
+			NamaKriteria: score.NamaKriteria,
+			LevelId:      score.IDLevel,
+			LabelLevel:   score.LabelLevel,
+			Point:        score.Point,
+			MaxPoint:     score.MaxPoint,
+		}
+		if score.Feedback != nil {
+			item.Feedback = *score.Feedback
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// RubricSelections converts the levels a grader picked, one per criterion
+func RubricSelections(selections []*base.RubricSelection) []entity.RubricSelection {
+	result := make([]entity.RubricSelection, 0, len(selections))
+	for _, selection := range selections {
+		result = append(result, entity.RubricSelection{
+			CriterionID: selection.CriterionId,
+			LevelID:     selection.LevelId,
+			Feedback:    selection.Feedback,
+		})
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+package protoconv
+
+import (
+	"strings"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/richtext"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EntityFormatKonten converts the format a question text is written in
+func EntityFormatKonten(format base.ContentFormat) entity.FormatKonten {
+	switch format {
+	case base.ContentFormat_CONTENT_FORMAT_MARKDOWN_LATEX:
+		return entity.FormatKontenMarkdownLatex
+	case base.ContentFormat_CONTENT_FORMAT_MATHML:
+		return entity.FormatKontenMathML
+	default:
+		return entity.FormatKontenPlain
+	}
+}
+
+// FormatKonten converts the format a question text is written in
+func FormatKonten(format entity.FormatKonten) base.ContentFormat {
+	switch format {
+	case entity.FormatKontenMarkdownLatex:
+		return base.ContentFormat_CONTENT_FORMAT_MARKDOWN_LATEX
+	case entity.FormatKontenMathML:
+		return base.ContentFormat_CONTENT_FORMAT_MATHML
+	default:
+		return base.ContentFormat_CONTENT_FORMAT_PLAIN
+	}
+}
+
+// RenderKonten returns the sanitized HTML of a question text written in formatKonten
+func RenderKonten(formatKonten entity.FormatKonten, text string) string {
+	return richtext.RenderOrEscape(richtext.Format(formatKonten), text)
+}
+
+// SoalOpsi converts the options of a soal, rendering each text in the soal's format
+func SoalOpsi(opsi []entity.SoalOpsi, formatKonten entity.FormatKonten) []*base.SoalOpsi {
+	result := make([]*base.SoalOpsi, 0, len(opsi))
+	for _, o := range opsi {
+		result = append(result, &base.SoalOpsi{Label: string(o.Label), Teks: o.Teks, TeksHtml: RenderKonten(formatKonten, o.Teks)})
+	}
+	return result
+}
+
+// SoalGambar converts the images attached to a soal
+func SoalGambar(gambar []entity.SoalGambar) []*base.SoalGambar {
+	if len(gambar) == 0 {
+		return nil
+	}
+
+	var protoGambar []*base.SoalGambar
+	for _, g := range gambar {
+		keterangan := ""
+		if g.Keterangan != nil {
+			keterangan = *g.Keterangan
+		}
+
+		cloudId := ""
+		if g.CloudId != nil {
+			cloudId = *g.CloudId
+		}
+
+		publicId := ""
+		if g.PublicId != nil {
+			publicId = *g.PublicId
+		}
+
+		protoGambar = append(protoGambar, &base.SoalGambar{
+			Id:         int32(g.ID),
+			NamaFile:   g.NamaFile,
+			FilePath:   g.FilePath,
+			FileSize:   int32(g.FileSize),
+			MimeType:   g.MimeType,
+			Urutan:     int32(g.Urutan),
+			Keterangan: keterangan,
+			CloudId:    cloudId,
+			PublicId:   publicId,
+			CreatedAt:  timestamppb.New(g.CreatedAt),
+		})
+	}
+	return protoGambar
+}
+
+// EntityJawabanOption converts an enum option, returning an empty option for JAWABAN_INVALID
+func EntityJawabanOption(option base.JawabanOption) entity.JawabanOption {
+	switch option {
+	case base.JawabanOption_A:
+		return entity.JawabanA
+	case base.JawabanOption_B:
+		return entity.JawabanB
+	case base.JawabanOption_C:
+		return entity.JawabanC
+	case base.JawabanOption_D:
+		return entity.JawabanD
+	case base.JawabanOption_E:
+		return entity.JawabanE
+	default:
+		return ""
+	}
+}
+
+// EntityJawabanLabel prefers the label sent by the client, which can go past E, over the enum
+func EntityJawabanLabel(option base.JawabanOption, label string) entity.JawabanOption {
+	if strings.TrimSpace(label) != "" {
+		return entity.ParseJawabanOption(label)
+	}
+	return EntityJawabanOption(option)
+}
+
+// EntityJawabanLabels prefers the labels sent by the client and falls back to the enum options
+func EntityJawabanLabels(options []base.JawabanOption, labels []string) []entity.JawabanOption {
+	if len(labels) == 0 {
+		return EntityJawabanOptions(options)
+	}
+	result := make([]entity.JawabanOption, 0, len(labels))
+	for _, label := range labels {
+		if strings.TrimSpace(label) == "" {
+			continue
+		}
+		result = append(result, entity.ParseJawabanOption(label))
+	}
+	return result
+}
+
+// EntityJawabanOptions converts enum options, skipping JAWABAN_INVALID
+func EntityJawabanOptions(options []base.JawabanOption) []entity.JawabanOption {
+	result := make([]entity.JawabanOption, 0, len(options))
+	for _, option := range options {
+		mapped := EntityJawabanOption(option)
+		if mapped == "" {
+			continue
+		}
+		result = append(result, mapped)
+	}
+	return result
+}
+
+// JawabanOptions converts options to the enum, dropping the labels past E it cannot carry
+func JawabanOptions(options []entity.JawabanOption) []base.JawabanOption {
+	result := make([]base.JawabanOption, 0, len(options))
+	for _, option := range options {
+		switch option {
+		case entity.JawabanA:
+			result = append(result, base.JawabanOption_A)
+		case entity.JawabanB:
+			result = append(result, base.JawabanOption_B)
+		case entity.JawabanC:
+			result = append(result, base.JawabanOption_C)
+		case entity.JawabanD:
+			result = append(result, base.JawabanOption_D)
+		case entity.JawabanE:
+			result = append(result, base.JawabanOption_E)
+		}
+	}
+	return result
+}
+
+// JawabanLabels converts options to their labels
+func JawabanLabels(options []entity.JawabanOption) []string {
+	result := make([]string, 0, len(options))
+	for _, option := range options {
+		result = append(result, string(option))
+	}
+	return result
+}
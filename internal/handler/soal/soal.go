@@ -1,11 +1,17 @@
 package soal
 
 import (
+	"context"
+	"strings"
+
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/handler/protoconv"
 	"cbt-test-mini-project/internal/usecase/soal"
-	"context"
+	"cbt-test-mini-project/util/interceptor"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -23,17 +29,21 @@ func NewSoalHandler(usecase soal.SoalUsecase) base.SoalServiceServer {
 
 // CreateSoal creates a new soal with multiple images
 func (h *soalHandler) CreateSoal(ctx context.Context, req *base.CreateSoalRequest) (*base.SoalResponse, error) {
-	jawabanBenar := toEntityJawabanOption(req.JawabanBenar)
+	jawabanBenar := protoconv.EntityJawabanLabel(req.JawabanBenar, req.JawabanBenarLabel)
 	questionType := toEntityQuestionType(req.QuestionType)
-	jawabanBenarComplex := toEntityJawabanOptions(req.JawabanBenarComplex)
-	
+	jawabanBenarComplex := protoconv.EntityJawabanLabels(req.JawabanBenarComplex, req.JawabanBenarComplexLabels)
+	shortAnswerBlanks := protoconv.EntityShortAnswerBlanks(req.ShortAnswerBlanks)
+	numericKey := protoconv.EntityNumericAnswerKey(req.NumericAnswer)
+	hotspotKey := protoconv.EntityHotspotAnswerKey(req.HotspotAnswer)
+	gridKey := protoconv.EntityGridAnswerKey(req.GridAnswer)
+
 	// Handle multiple image_bytes from repeated field
 	var imageFilesBytes [][]byte
 	if len(req.ImageBytes) > 0 {
 		imageFilesBytes = req.ImageBytes
 	}
-	
-	s, err := h.usecase.CreateSoal(int(req.IdMateri), int(req.IdTingkat), req.Pertanyaan, req.OpsiA, req.OpsiB, req.OpsiC, req.OpsiD, req.Pembahasan, req.Point, int(req.Urutan), questionType, jawabanBenar, jawabanBenarComplex, imageFilesBytes)
+
+	s, err := h.usecase.CreateSoal(ctx, int(req.IdMateri), int(req.IdTingkat), req.Pertanyaan, req.OpsiA, req.OpsiB, req.OpsiC, req.OpsiD, req.Pembahasan, req.Point, int(req.Urutan), questionType, protoconv.EntityFormatKonten(req.ContentFormat), jawabanBenar, jawabanBenarComplex, shortAnswerBlanks, numericKey, hotspotKey, gridKey, req.Opsi, imageFilesBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +63,7 @@ func (h *soalHandler) CreateSoal(ctx context.Context, req *base.CreateSoalReques
 		if g.PublicId != nil {
 			publicIdStr = *g.PublicId
 		}
-		
+
 		protoGambar = append(protoGambar, &base.SoalGambar{
 			Id:         int32(g.ID),
 			NamaFile:   g.NamaFile,
@@ -77,60 +87,82 @@ func (h *soalHandler) CreateSoal(ctx context.Context, req *base.CreateSoalReques
 				Tingkat:       &base.Tingkat{Id: int32(s.Materi.Tingkat.ID), Nama: s.Materi.Tingkat.Nama},
 				Nama:          s.Materi.Nama,
 			},
-			Pertanyaan:   s.Pertanyaan,
-			Point:        s.Point,
-			Urutan:       int32(s.Urutan),
-			OpsiA:        s.OpsiA,
-			OpsiB:        s.OpsiB,
-			OpsiC:        s.OpsiC,
-			OpsiD:        s.OpsiD,
-			JawabanBenar: base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
-			QuestionType: toProtoQuestionType(s.QuestionType),
-			JawabanBenarComplex: toProtoJawabanOptions(s.GetJawabanBenarComplex()),
+			Pertanyaan:                s.Pertanyaan,
+			Point:                     s.Point,
+			Urutan:                    int32(s.Urutan),
+			OpsiA:                     s.OpsiA,
+			OpsiB:                     s.OpsiB,
+			OpsiC:                     s.OpsiC,
+			OpsiD:                     s.OpsiD,
+			JawabanBenar:              base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
+			QuestionType:              toProtoQuestionType(s.QuestionType),
+			JawabanBenarComplex:       protoconv.JawabanOptions(s.GetJawabanBenarComplex()),
+			ShortAnswerBlanks:         protoconv.ShortAnswerBlanks(s.GetShortAnswerBlanks()),
+			NumericAnswer:             protoconv.NumericAnswerKey(s.GetNumericAnswerKey()),
+			HotspotAnswer:             protoconv.HotspotAnswerKey(s.GetHotspotAnswerKey()),
+			GridAnswer:                protoconv.GridAnswerKey(s.GetGridAnswerKey()),
+			Media:                     convertSoalMediaToProto(s.Media),
+			Opsi:                      protoconv.SoalOpsi(s.Options(), s.FormatKonten),
+			JawabanBenarLabel:         string(s.JawabanBenar),
+			JawabanBenarComplexLabels: protoconv.JawabanLabels(s.GetJawabanBenarComplex()),
 			Pembahasan: func() string {
 				if s.Pembahasan != nil {
 					return *s.Pembahasan
 				}
 				return ""
 			}(),
-			Gambar:       protoGambar,
+			ContentFormat:  protoconv.FormatKonten(s.FormatKonten),
+			PertanyaanHtml: protoconv.RenderKonten(s.FormatKonten, s.Pertanyaan),
+			PembahasanHtml: protoconv.RenderKonten(s.FormatKonten, derefString(s.Pembahasan)),
+			Gambar:         protoGambar,
 		},
 	}, nil
 }
 
 // GetSoal gets soal by ID
 func (h *soalHandler) GetSoal(ctx context.Context, req *base.GetSoalRequest) (*base.SoalResponse, error) {
-	s, err := h.usecase.GetSoal(int(req.Id))
+	s, err := h.usecase.GetSoal(ctx, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
 
 	return &base.SoalResponse{
 		Soal: &base.SoalFull{
-			Id:            int32(s.ID),
-			Materi:        &base.Materi{
-				Id: int32(s.Materi.ID),
+			Id: int32(s.ID),
+			Materi: &base.Materi{
+				Id:            int32(s.Materi.ID),
 				MataPelajaran: &base.MataPelajaran{Id: int32(s.Materi.MataPelajaran.ID), Nama: s.Materi.MataPelajaran.Nama},
-				Tingkat: &base.Tingkat{Id: int32(s.Materi.Tingkat.ID), Nama: s.Materi.Tingkat.Nama},
-				Nama: s.Materi.Nama,
+				Tingkat:       &base.Tingkat{Id: int32(s.Materi.Tingkat.ID), Nama: s.Materi.Tingkat.Nama},
+				Nama:          s.Materi.Nama,
 			},
-			Pertanyaan:    s.Pertanyaan,
-			Point:         s.Point,
-			Urutan:        int32(s.Urutan),
-			OpsiA:         s.OpsiA,
-			OpsiB:         s.OpsiB,
-			OpsiC:         s.OpsiC,
-			OpsiD:         s.OpsiD,
-			JawabanBenar:  base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
-			QuestionType: toProtoQuestionType(s.QuestionType),
-			JawabanBenarComplex: toProtoJawabanOptions(s.GetJawabanBenarComplex()),
+			Pertanyaan:                s.Pertanyaan,
+			Point:                     s.Point,
+			Urutan:                    int32(s.Urutan),
+			OpsiA:                     s.OpsiA,
+			OpsiB:                     s.OpsiB,
+			OpsiC:                     s.OpsiC,
+			OpsiD:                     s.OpsiD,
+			JawabanBenar:              base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
+			QuestionType:              toProtoQuestionType(s.QuestionType),
+			JawabanBenarComplex:       protoconv.JawabanOptions(s.GetJawabanBenarComplex()),
+			ShortAnswerBlanks:         protoconv.ShortAnswerBlanks(s.GetShortAnswerBlanks()),
+			NumericAnswer:             protoconv.NumericAnswerKey(s.GetNumericAnswerKey()),
+			HotspotAnswer:             protoconv.HotspotAnswerKey(s.GetHotspotAnswerKey()),
+			GridAnswer:                protoconv.GridAnswerKey(s.GetGridAnswerKey()),
+			Media:                     convertSoalMediaToProto(s.Media),
+			Opsi:                      protoconv.SoalOpsi(s.Options(), s.FormatKonten),
+			JawabanBenarLabel:         string(s.JawabanBenar),
+			JawabanBenarComplexLabels: protoconv.JawabanLabels(s.GetJawabanBenarComplex()),
 			Pembahasan: func() string {
 				if s.Pembahasan != nil {
 					return *s.Pembahasan
 				}
 				return ""
 			}(),
-			Gambar:        convertSoalGambarToProto(s.Gambar),
+			ContentFormat:  protoconv.FormatKonten(s.FormatKonten),
+			PertanyaanHtml: protoconv.RenderKonten(s.FormatKonten, s.Pertanyaan),
+			PembahasanHtml: protoconv.RenderKonten(s.FormatKonten, derefString(s.Pembahasan)),
+			Gambar:         protoconv.SoalGambar(s.Gambar),
 		},
 	}, nil
 }
@@ -141,8 +173,8 @@ func (h *soalHandler) UploadImageToSoal(ctx context.Context, req *base.UploadIma
 	if req.Keterangan != "" {
 		keterangan = &req.Keterangan
 	}
-	
-	gambar, err := h.usecase.UploadImageToSoal(int(req.IdSoal), req.ImageBytes, req.NamaFile, int(req.Urutan), keterangan)
+
+	gambar, err := h.usecase.UploadImageToSoal(ctx, int(req.IdSoal), req.ImageBytes, req.NamaFile, int(req.Urutan), keterangan)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +212,7 @@ func (h *soalHandler) UploadImageToSoal(ctx context.Context, req *base.UploadIma
 
 // DeleteImageFromSoal deletes an image from a soal
 func (h *soalHandler) DeleteImageFromSoal(ctx context.Context, req *base.DeleteImageFromSoalRequest) (*base.MessageStatusResponse, error) {
-	err := h.usecase.DeleteImageFromSoal(int(req.IdGambar))
+	err := h.usecase.DeleteImageFromSoal(ctx, int(req.IdGambar))
 	if err != nil {
 		return nil, err
 	}
@@ -197,8 +229,8 @@ func (h *soalHandler) UpdateImageInSoal(ctx context.Context, req *base.UpdateIma
 	if req.Keterangan != "" {
 		keterangan = &req.Keterangan
 	}
-	
-	err := h.usecase.UpdateImageInSoal(int(req.IdGambar), int(req.Urutan), keterangan)
+
+	err := h.usecase.UpdateImageInSoal(ctx, int(req.IdGambar), int(req.Urutan), keterangan)
 	if err != nil {
 		return nil, err
 	}
@@ -209,19 +241,79 @@ func (h *soalHandler) UpdateImageInSoal(ctx context.Context, req *base.UpdateIma
 	}, nil
 }
 
+// UploadMediaToSoal uploads an audio or video clip to a soal
+func (h *soalHandler) UploadMediaToSoal(ctx context.Context, req *base.UploadMediaToSoalRequest) (*base.UploadMediaResponse, error) {
+	var keterangan *string
+	if req.Keterangan != "" {
+		keterangan = &req.Keterangan
+	}
+
+	media, err := h.usecase.UploadMediaToSoal(ctx, int(req.IdSoal), req.MediaBytes, req.NamaFile, toEntityMediaJenis(req.Jenis), req.DurasiDetik, int(req.MaxPutar), int(req.Urutan), keterangan)
+	if err != nil {
+		return nil, toMediaError(err)
+	}
+
+	return &base.UploadMediaResponse{
+		Media: convertSoalMediaToProto([]entity.SoalMedia{*media})[0],
+	}, nil
+}
+
+// DeleteMediaFromSoal deletes an audio or video clip from a soal
+func (h *soalHandler) DeleteMediaFromSoal(ctx context.Context, req *base.DeleteMediaFromSoalRequest) (*base.MessageStatusResponse, error) {
+	err := h.usecase.DeleteMediaFromSoal(ctx, int(req.IdMedia))
+	if err != nil {
+		return nil, err
+	}
+
+	return &base.MessageStatusResponse{
+		Message: "Media deleted successfully",
+		Status:  "success",
+	}, nil
+}
+
+// UpdateMediaInSoal updates the order, play limit, duration and caption of a soal clip
+func (h *soalHandler) UpdateMediaInSoal(ctx context.Context, req *base.UpdateMediaInSoalRequest) (*base.MessageStatusResponse, error) {
+	var keterangan *string
+	if req.Keterangan != "" {
+		keterangan = &req.Keterangan
+	}
+
+	err := h.usecase.UpdateMediaInSoal(ctx, int(req.IdMedia), int(req.Urutan), int(req.MaxPutar), req.DurasiDetik, keterangan)
+	if err != nil {
+		return nil, toMediaError(err)
+	}
+
+	return &base.MessageStatusResponse{
+		Message: "Media updated successfully",
+		Status:  "success",
+	}, nil
+}
+
+// toMediaError reports invalid clips and settings as bad input
+func toMediaError(err error) error {
+	if strings.Contains(err.Error(), "media") {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
 // UpdateSoal updates soal with multiple images
 func (h *soalHandler) UpdateSoal(ctx context.Context, req *base.UpdateSoalRequest) (*base.SoalResponse, error) {
-	jawabanBenar := toEntityJawabanOption(req.JawabanBenar)
+	jawabanBenar := protoconv.EntityJawabanLabel(req.JawabanBenar, req.JawabanBenarLabel)
 	questionType := toEntityQuestionType(req.QuestionType)
-	jawabanBenarComplex := toEntityJawabanOptions(req.JawabanBenarComplex)
-	
+	jawabanBenarComplex := protoconv.EntityJawabanLabels(req.JawabanBenarComplex, req.JawabanBenarComplexLabels)
+	shortAnswerBlanks := protoconv.EntityShortAnswerBlanks(req.ShortAnswerBlanks)
+	numericKey := protoconv.EntityNumericAnswerKey(req.NumericAnswer)
+	hotspotKey := protoconv.EntityHotspotAnswerKey(req.HotspotAnswer)
+	gridKey := protoconv.EntityGridAnswerKey(req.GridAnswer)
+
 	// Handle multiple image_bytes from repeated field
 	var imageFilesBytes [][]byte
 	if len(req.ImageBytes) > 0 {
 		imageFilesBytes = req.ImageBytes
 	}
-	
-	s, err := h.usecase.UpdateSoal(int(req.Id), int(req.IdMateri), int(req.IdTingkat), req.Pertanyaan, req.OpsiA, req.OpsiB, req.OpsiC, req.OpsiD, req.Pembahasan, req.Point, int(req.Urutan), questionType, jawabanBenar, jawabanBenarComplex, imageFilesBytes)
+
+	s, err := h.usecase.UpdateSoal(ctx, int(req.Id), int(req.IdMateri), int(req.IdTingkat), req.Pertanyaan, req.OpsiA, req.OpsiB, req.OpsiC, req.OpsiD, req.Pembahasan, req.Point, int(req.Urutan), questionType, protoconv.EntityFormatKonten(req.ContentFormat), jawabanBenar, jawabanBenarComplex, shortAnswerBlanks, numericKey, hotspotKey, gridKey, req.Opsi, imageFilesBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -245,30 +337,41 @@ func (h *soalHandler) UpdateSoal(ctx context.Context, req *base.UpdateSoalReques
 				Tingkat:       &base.Tingkat{Id: int32(s.Materi.Tingkat.ID), Nama: s.Materi.Tingkat.Nama},
 				Nama:          s.Materi.Nama,
 			},
-			Pertanyaan:   s.Pertanyaan,
-			Point:        s.Point,
-			Urutan:       int32(s.Urutan),
-			OpsiA:        s.OpsiA,
-			OpsiB:        s.OpsiB,
-			OpsiC:        s.OpsiC,
-			OpsiD:        s.OpsiD,
-			JawabanBenar: base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
-			QuestionType: toProtoQuestionType(s.QuestionType),
-			JawabanBenarComplex: toProtoJawabanOptions(s.GetJawabanBenarComplex()),
+			Pertanyaan:                s.Pertanyaan,
+			Point:                     s.Point,
+			Urutan:                    int32(s.Urutan),
+			OpsiA:                     s.OpsiA,
+			OpsiB:                     s.OpsiB,
+			OpsiC:                     s.OpsiC,
+			OpsiD:                     s.OpsiD,
+			JawabanBenar:              base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
+			QuestionType:              toProtoQuestionType(s.QuestionType),
+			JawabanBenarComplex:       protoconv.JawabanOptions(s.GetJawabanBenarComplex()),
+			ShortAnswerBlanks:         protoconv.ShortAnswerBlanks(s.GetShortAnswerBlanks()),
+			NumericAnswer:             protoconv.NumericAnswerKey(s.GetNumericAnswerKey()),
+			HotspotAnswer:             protoconv.HotspotAnswerKey(s.GetHotspotAnswerKey()),
+			GridAnswer:                protoconv.GridAnswerKey(s.GetGridAnswerKey()),
+			Media:                     convertSoalMediaToProto(s.Media),
+			Opsi:                      protoconv.SoalOpsi(s.Options(), s.FormatKonten),
+			JawabanBenarLabel:         string(s.JawabanBenar),
+			JawabanBenarComplexLabels: protoconv.JawabanLabels(s.GetJawabanBenarComplex()),
 			Pembahasan: func() string {
 				if s.Pembahasan != nil {
 					return *s.Pembahasan
 				}
 				return ""
 			}(),
-			Gambar:       protoGambar,
+			ContentFormat:  protoconv.FormatKonten(s.FormatKonten),
+			PertanyaanHtml: protoconv.RenderKonten(s.FormatKonten, s.Pertanyaan),
+			PembahasanHtml: protoconv.RenderKonten(s.FormatKonten, derefString(s.Pembahasan)),
+			Gambar:         protoGambar,
 		},
 	}, nil
 }
 
 // DeleteSoal deletes soal
 func (h *soalHandler) DeleteSoal(ctx context.Context, req *base.DeleteSoalRequest) (*base.MessageStatusResponse, error) {
-	err := h.usecase.DeleteSoal(int(req.Id))
+	err := h.usecase.DeleteSoal(ctx, int(req.Id))
 	if err != nil {
 		return &base.MessageStatusResponse{
 			Message: "Failed to delete soal",
@@ -294,7 +397,7 @@ func (h *soalHandler) ListSoal(ctx context.Context, req *base.ListSoalRequest) (
 			pageSize = int(req.Pagination.PageSize)
 		}
 	}
-	soals, pagination, err := h.usecase.ListSoal(int(req.IdMateri), int(req.IdTingkat), int(req.IdMataPelajaran), page, pageSize)
+	soals, pagination, err := h.usecase.ListSoal(ctx, int(req.IdMateri), int(req.IdTingkat), int(req.IdMataPelajaran), interceptor.TeacherScopeFromContext(ctx), page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -302,30 +405,41 @@ func (h *soalHandler) ListSoal(ctx context.Context, req *base.ListSoalRequest) (
 	var soalList []*base.SoalFull
 	for _, s := range soals {
 		soalList = append(soalList, &base.SoalFull{
-			Id:            int32(s.ID),
-			Materi:        &base.Materi{
-				Id: int32(s.Materi.ID),
+			Id: int32(s.ID),
+			Materi: &base.Materi{
+				Id:            int32(s.Materi.ID),
 				MataPelajaran: &base.MataPelajaran{Id: int32(s.Materi.MataPelajaran.ID), Nama: s.Materi.MataPelajaran.Nama},
-				Tingkat: &base.Tingkat{Id: int32(s.Materi.Tingkat.ID), Nama: s.Materi.Tingkat.Nama},
-				Nama: s.Materi.Nama,
+				Tingkat:       &base.Tingkat{Id: int32(s.Materi.Tingkat.ID), Nama: s.Materi.Tingkat.Nama},
+				Nama:          s.Materi.Nama,
 			},
-			Pertanyaan:    s.Pertanyaan,
-			Point:         s.Point,
-			Urutan:        int32(s.Urutan),
-			OpsiA:         s.OpsiA,
-			OpsiB:         s.OpsiB,
-			OpsiC:         s.OpsiC,
-			OpsiD:         s.OpsiD,
-			JawabanBenar:  base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
-			QuestionType: toProtoQuestionType(s.QuestionType),
-			JawabanBenarComplex: toProtoJawabanOptions(s.GetJawabanBenarComplex()),
+			Pertanyaan:                s.Pertanyaan,
+			Point:                     s.Point,
+			Urutan:                    int32(s.Urutan),
+			OpsiA:                     s.OpsiA,
+			OpsiB:                     s.OpsiB,
+			OpsiC:                     s.OpsiC,
+			OpsiD:                     s.OpsiD,
+			JawabanBenar:              base.JawabanOption(base.JawabanOption_value[string(s.JawabanBenar)]),
+			QuestionType:              toProtoQuestionType(s.QuestionType),
+			JawabanBenarComplex:       protoconv.JawabanOptions(s.GetJawabanBenarComplex()),
+			ShortAnswerBlanks:         protoconv.ShortAnswerBlanks(s.GetShortAnswerBlanks()),
+			NumericAnswer:             protoconv.NumericAnswerKey(s.GetNumericAnswerKey()),
+			HotspotAnswer:             protoconv.HotspotAnswerKey(s.GetHotspotAnswerKey()),
+			GridAnswer:                protoconv.GridAnswerKey(s.GetGridAnswerKey()),
+			Media:                     convertSoalMediaToProto(s.Media),
+			Opsi:                      protoconv.SoalOpsi(s.Options(), s.FormatKonten),
+			JawabanBenarLabel:         string(s.JawabanBenar),
+			JawabanBenarComplexLabels: protoconv.JawabanLabels(s.GetJawabanBenarComplex()),
 			Pembahasan: func() string {
 				if s.Pembahasan != nil {
 					return *s.Pembahasan
 				}
 				return ""
 			}(),
-			Gambar:         convertSoalGambarToProto(s.Gambar),
+			ContentFormat:  protoconv.FormatKonten(s.FormatKonten),
+			PertanyaanHtml: protoconv.RenderKonten(s.FormatKonten, s.Pertanyaan),
+			PembahasanHtml: protoconv.RenderKonten(s.FormatKonten, derefString(s.Pembahasan)),
+			Gambar:         protoconv.SoalGambar(s.Gambar),
 		})
 	}
 
@@ -340,48 +454,71 @@ func (h *soalHandler) ListSoal(ctx context.Context, req *base.ListSoalRequest) (
 	}, nil
 }
 
-// convertSoalGambarToProto converts entity.SoalGambar slice to proto SoalGambar slice
-func convertSoalGambarToProto(gambar []entity.SoalGambar) []*base.SoalGambar {
-	if len(gambar) == 0 {
+// convertSoalMediaToProto converts entity.SoalMedia slice to proto SoalMedia slice
+func convertSoalMediaToProto(media []entity.SoalMedia) []*base.SoalMedia {
+	if len(media) == 0 {
 		return nil
 	}
-	
-	var protoGambar []*base.SoalGambar
-	for _, g := range gambar {
+
+	protoMedia := make([]*base.SoalMedia, 0, len(media))
+	for _, m := range media {
 		keteranganStr := ""
-		if g.Keterangan != nil {
-			keteranganStr = *g.Keterangan
+		if m.Keterangan != nil {
+			keteranganStr = *m.Keterangan
 		}
-		
+
 		cloudIdStr := ""
-		if g.CloudId != nil {
-			cloudIdStr = *g.CloudId
+		if m.CloudId != nil {
+			cloudIdStr = *m.CloudId
 		}
-		
+
 		publicIdStr := ""
-		if g.PublicId != nil {
-			publicIdStr = *g.PublicId
+		if m.PublicId != nil {
+			publicIdStr = *m.PublicId
 		}
-		
-		protoGambar = append(protoGambar, &base.SoalGambar{
-			Id:         int32(g.ID),
-			NamaFile:   g.NamaFile,
-			FilePath:   g.FilePath,
-			FileSize:   int32(g.FileSize),
-			MimeType:   g.MimeType,
-			Urutan:     int32(g.Urutan),
-			Keterangan: keteranganStr,
-			CloudId:    cloudIdStr,
-			PublicId:   publicIdStr,
-			CreatedAt:  timestamppb.New(g.CreatedAt),
+
+		protoMedia = append(protoMedia, &base.SoalMedia{
+			Id:          int32(m.ID),
+			Jenis:       toProtoMediaJenis(m.Jenis),
+			NamaFile:    m.NamaFile,
+			FilePath:    m.FilePath,
+			FileSize:    int32(m.FileSize),
+			MimeType:    m.MimeType,
+			DurasiDetik: m.DurasiDetik,
+			MaxPutar:    int32(m.MaxPutar),
+			Urutan:      int32(m.Urutan),
+			Keterangan:  keteranganStr,
+			CloudId:     cloudIdStr,
+			PublicId:    publicIdStr,
+			CreatedAt:   timestamppb.New(m.CreatedAt),
 		})
 	}
-	return protoGambar
+	return protoMedia
+}
+
+func toEntityMediaJenis(jenis base.MediaJenis) entity.MediaJenis {
+	switch jenis {
+	case base.MediaJenis_MEDIA_AUDIO:
+		return entity.MediaJenisAudio
+	case base.MediaJenis_MEDIA_VIDEO:
+		return entity.MediaJenisVideo
+	}
+	return ""
+}
+
+func toProtoMediaJenis(jenis entity.MediaJenis) base.MediaJenis {
+	switch jenis {
+	case entity.MediaJenisAudio:
+		return base.MediaJenis_MEDIA_AUDIO
+	case entity.MediaJenisVideo:
+		return base.MediaJenis_MEDIA_VIDEO
+	}
+	return base.MediaJenis_MEDIA_JENIS_INVALID
 }
 
 // GetQuestionCountsByTopic gets the count of questions per topic
 func (h *soalHandler) GetQuestionCountsByTopic(ctx context.Context, req *emptypb.Empty) (*base.QuestionCountsResponse, error) {
-	counts, err := h.usecase.GetQuestionCountsByTopic()
+	counts, err := h.usecase.GetQuestionCountsByTopic(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -399,33 +536,6 @@ func (h *soalHandler) GetQuestionCountsByTopic(ctx context.Context, req *emptypb
 	}, nil
 }
 
-func toEntityJawabanOption(option base.JawabanOption) entity.JawabanOption {
-	switch option {
-	case base.JawabanOption_A:
-		return entity.JawabanA
-	case base.JawabanOption_B:
-		return entity.JawabanB
-	case base.JawabanOption_C:
-		return entity.JawabanC
-	case base.JawabanOption_D:
-		return entity.JawabanD
-	default:
-		return ""
-	}
-}
-
-func toEntityJawabanOptions(options []base.JawabanOption) []entity.JawabanOption {
-	result := make([]entity.JawabanOption, 0, len(options))
-	for _, option := range options {
-		mapped := toEntityJawabanOption(option)
-		if mapped == "" {
-			continue
-		}
-		result = append(result, mapped)
-	}
-	return result
-}
-
 func toEntityQuestionType(questionType base.QuestionType) entity.QuestionType {
 	switch questionType {
 	case base.QuestionType_MULTIPLE_CHOICE:
@@ -436,6 +546,14 @@ func toEntityQuestionType(questionType base.QuestionType) entity.QuestionType {
 		return entity.QuestionTypeEssay
 	case base.QuestionType_MULTIPLE_CHOICES_COMPLEX:
 		return entity.QuestionTypeMultipleChoicesComplex
+	case base.QuestionType_SHORT_ANSWER:
+		return entity.QuestionTypeShortAnswer
+	case base.QuestionType_NUMERIC:
+		return entity.QuestionTypeNumeric
+	case base.QuestionType_HOTSPOT:
+		return entity.QuestionTypeHotspot
+	case base.QuestionType_GRID:
+		return entity.QuestionTypeGrid
 	default:
 		return entity.QuestionType("")
 	}
@@ -451,26 +569,24 @@ func toProtoQuestionType(questionType entity.QuestionType) base.QuestionType {
 		return base.QuestionType_ESSAY
 	case entity.QuestionTypeMultipleChoicesComplex:
 		return base.QuestionType_MULTIPLE_CHOICES_COMPLEX
+	case entity.QuestionTypeShortAnswer:
+		return base.QuestionType_SHORT_ANSWER
+	case entity.QuestionTypeNumeric:
+		return base.QuestionType_NUMERIC
+	case entity.QuestionTypeHotspot:
+		return base.QuestionType_HOTSPOT
+	case entity.QuestionTypeGrid:
+		return base.QuestionType_GRID
 	default:
 		return base.QuestionType_QUESTION_TYPE_INVALID
 	}
 }
 
-func toProtoJawabanOptions(options []entity.JawabanOption) []base.JawabanOption {
-	result := make([]base.JawabanOption, 0, len(options))
-	for _, option := range options {
-		switch option {
-		case entity.JawabanA:
-			result = append(result, base.JawabanOption_A)
-		case entity.JawabanB:
-			result = append(result, base.JawabanOption_B)
-		case entity.JawabanC:
-			result = append(result, base.JawabanOption_C)
-		case entity.JawabanD:
-			result = append(result, base.JawabanOption_D)
-		}
+func derefString(s *string) string {
+	if s == nil {
+		return ""
 	}
-	return result
+	return *s
 }
 
 func (h *soalHandler) ReorderSoal(ctx context.Context, req *base.ReorderSoalRequest) (*base.MessageStatusResponse, error) {
@@ -479,9 +595,9 @@ func (h *soalHandler) ReorderSoal(ctx context.Context, req *base.ReorderSoalRequ
 		urutanByID[int(item.Id)] = int(item.Urutan)
 	}
 
-	if err := h.usecase.ReorderSoal(int(req.IdMateri), urutanByID); err != nil {
+	if err := h.usecase.ReorderSoal(ctx, int(req.IdMateri), urutanByID); err != nil {
 		return nil, err
 	}
 
 	return &base.MessageStatusResponse{Message: "Soal reordered successfully", Status: "success"}, nil
-}
\ No newline at end of file
+}
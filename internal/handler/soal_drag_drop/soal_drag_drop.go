@@ -6,6 +6,7 @@ import (
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
 	usecase "cbt-test-mini-project/internal/usecase/soal_drag_drop"
+	"cbt-test-mini-project/util/interceptor"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -68,17 +69,17 @@ func (h *grpcHandler) CreateSoalDragDrop(ctx context.Context, req *base.CreateSo
 		})
 	}
 
-	soal, err := h.usecase.Create(ucReq)
+	soal, err := h.usecase.Create(ctx, ucReq)
 	if err != nil {
 		return nil, err
 	}
 
-	return h.toProtoResponse(soal)
+	return h.toProtoResponse(ctx, soal)
 }
 
 // GetSoalDragDrop gets a drag-drop question by ID
 func (h *grpcHandler) GetSoalDragDrop(ctx context.Context, req *base.GetSoalDragDropRequest) (*base.SoalDragDropResponse, error) {
-	soal, err := h.usecase.GetByID(int(req.Id))
+	soal, err := h.usecase.GetByID(ctx, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +87,7 @@ func (h *grpcHandler) GetSoalDragDrop(ctx context.Context, req *base.GetSoalDrag
 		return nil, err
 	}
 
-	return h.toProtoResponse(soal)
+	return h.toProtoResponse(ctx, soal)
 }
 
 // UpdateSoalDragDrop updates a drag-drop question
@@ -136,17 +137,17 @@ func (h *grpcHandler) UpdateSoalDragDrop(ctx context.Context, req *base.UpdateSo
 		})
 	}
 
-	soal, err := h.usecase.Update(int(req.Id), ucReq)
+	soal, err := h.usecase.Update(ctx, int(req.Id), ucReq)
 	if err != nil {
 		return nil, err
 	}
 
-	return h.toProtoResponse(soal)
+	return h.toProtoResponse(ctx, soal)
 }
 
 // DeleteSoalDragDrop deletes a drag-drop question
 func (h *grpcHandler) DeleteSoalDragDrop(ctx context.Context, req *base.DeleteSoalDragDropRequest) (*base.MessageStatusResponse, error) {
-	err := h.usecase.Delete(int(req.Id))
+	err := h.usecase.Delete(ctx, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -170,14 +171,14 @@ func (h *grpcHandler) ListSoalDragDrop(ctx context.Context, req *base.ListSoalDr
 		}
 	}
 
-	soals, total, err := h.usecase.List(int(req.IdMateri), int(req.IdTingkat), page, pageSize)
+	soals, total, err := h.usecase.List(ctx, int(req.IdMateri), int(req.IdTingkat), interceptor.TeacherScopeFromContext(ctx), page, pageSize)
 	if err != nil {
 		return nil, err
 	}
 
 	var protoSoals []*base.SoalDragDropFull
 	for _, s := range soals {
-		protoSoal, err := h.entityToProto(&s)
+		protoSoal, err := h.entityToProto(ctx, &s)
 		if err == nil {
 			protoSoals = append(protoSoals, protoSoal)
 		}
@@ -202,7 +203,7 @@ func (h *grpcHandler) ReorderSoalDragDrop(ctx context.Context, req *base.Reorder
 		urutanByID[int(item.Id)] = int(item.Urutan)
 	}
 
-	if err := h.usecase.ReorderSoalDragDrop(int(req.IdMateri), urutanByID); err != nil {
+	if err := h.usecase.ReorderSoalDragDrop(ctx, int(req.IdMateri), urutanByID); err != nil {
 		return nil, err
 	}
 
@@ -211,17 +212,17 @@ func (h *grpcHandler) ReorderSoalDragDrop(ctx context.Context, req *base.Reorder
 
 // Helper functions
 
-func (h *grpcHandler) toProtoResponse(soal *entity.SoalDragDrop) (*base.SoalDragDropResponse, error) {
-	protoSoal, err := h.entityToProto(soal)
+func (h *grpcHandler) toProtoResponse(ctx context.Context, soal *entity.SoalDragDrop) (*base.SoalDragDropResponse, error) {
+	protoSoal, err := h.entityToProto(ctx, soal)
 	if err != nil {
 		return nil, err
 	}
 	return &base.SoalDragDropResponse{Soal: protoSoal}, nil
 }
 
-func (h *grpcHandler) entityToProto(soal *entity.SoalDragDrop) (*base.SoalDragDropFull, error) {
+func (h *grpcHandler) entityToProto(ctx context.Context, soal *entity.SoalDragDrop) (*base.SoalDragDropFull, error) {
 	// Get correct answers
-	_, correctAnswers, _ := h.usecase.GetByIDWithCorrectAnswers(soal.ID)
+	_, correctAnswers, _ := h.usecase.GetByIDWithCorrectAnswers(ctx, soal.ID)
 
 	// Convert items
 	var protoItems []*base.DragItem
@@ -1,20 +1,27 @@
 package test_session
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/handler/protoconv"
 	userLimitUsecase "cbt-test-mini-project/internal/usecase"
+	examSecurityUsecase "cbt-test-mini-project/internal/usecase/exam_security"
+	gradingUsecase "cbt-test-mini-project/internal/usecase/grading"
 	"cbt-test-mini-project/internal/usecase/materi"
 	"cbt-test-mini-project/internal/usecase/test_session"
 	tingkatUsecase "cbt-test-mini-project/internal/usecase/tingkat"
 	"cbt-test-mini-project/util/interceptor"
-	"context"
-	"errors"
-	"fmt"
-	"strings"
-	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -22,20 +29,41 @@ import (
 // testSessionHandler implements base.TestSessionServiceServer
 type testSessionHandler struct {
 	base.UnimplementedTestSessionServiceServer
-	usecase          test_session.TestSessionUsecase
-	materiUsecase    materi.MateriUsecase
-	tingkatUsecase   tingkatUsecase.TingkatUsecase
-	userLimitUsecase userLimitUsecase.UserLimitUsecase
+	usecase             test_session.TestSessionUsecase
+	materiUsecase       materi.MateriUsecase
+	tingkatUsecase      tingkatUsecase.TingkatUsecase
+	userLimitUsecase    userLimitUsecase.UserLimitUsecase
+	examSecurityUsecase examSecurityUsecase.ExamSecurityUsecase
+	gradingUsecase      gradingUsecase.GradingUsecase
 }
 
 // NewTestSessionHandler creates a new TestSessionHandler
-func NewTestSessionHandler(usecase test_session.TestSessionUsecase, materiUsecase materi.MateriUsecase, tingkatUsecase tingkatUsecase.TingkatUsecase, userLimitUsecase userLimitUsecase.UserLimitUsecase) base.TestSessionServiceServer {
+func NewTestSessionHandler(usecase test_session.TestSessionUsecase, materiUsecase materi.MateriUsecase, tingkatUsecase tingkatUsecase.TingkatUsecase, userLimitUsecase userLimitUsecase.UserLimitUsecase, examSecurityUsecase examSecurityUsecase.ExamSecurityUsecase, gradingUsecase gradingUsecase.GradingUsecase) base.TestSessionServiceServer {
 	return &testSessionHandler{
-		usecase:          usecase,
-		materiUsecase:    materiUsecase,
-		tingkatUsecase:   tingkatUsecase,
-		userLimitUsecase: userLimitUsecase,
+		usecase:             usecase,
+		materiUsecase:       materiUsecase,
+		tingkatUsecase:      tingkatUsecase,
+		userLimitUsecase:    userLimitUsecase,
+		examSecurityUsecase: examSecurityUsecase,
+		gradingUsecase:      gradingUsecase,
+	}
+}
+
+// ensureDeviceLease binds the session to the calling device; other devices are rejected
+// until a proctor approves a transfer through ExamSecurityService.ApproveDeviceTransfer.
+// A lease secret issued to the device is returned in the X-Device-Lease header.
+func (h *testSessionHandler) ensureDeviceLease(ctx context.Context, sessionID int) error {
+	secret, err := h.examSecurityUsecase.EnsureDeviceLease(ctx, sessionID, interceptor.GetDeviceInfoFromContext(ctx))
+	if secret != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(interceptor.DeviceLeaseHeader, secret))
+	}
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, examSecurityUsecase.ErrDeviceMismatch) {
+		return status.Error(codes.FailedPrecondition, err.Error())
 	}
+	return status.Error(codes.Internal, err.Error())
 }
 
 // CreateTestSession creates a new test session
@@ -85,6 +113,14 @@ func (h *testSessionHandler) CreateTestSession(ctx context.Context, req *base.Cr
 			includeTypes = append(includeTypes, entity.QuestionTypeEssay)
 		case base.QuestionType_MULTIPLE_CHOICES_COMPLEX:
 			includeTypes = append(includeTypes, entity.QuestionTypeMultipleChoicesComplex)
+		case base.QuestionType_SHORT_ANSWER:
+			includeTypes = append(includeTypes, entity.QuestionTypeShortAnswer)
+		case base.QuestionType_NUMERIC:
+			includeTypes = append(includeTypes, entity.QuestionTypeNumeric)
+		case base.QuestionType_HOTSPOT:
+			includeTypes = append(includeTypes, entity.QuestionTypeHotspot)
+		case base.QuestionType_GRID:
+			includeTypes = append(includeTypes, entity.QuestionTypeGrid)
 		}
 	}
 
@@ -93,7 +129,7 @@ func (h *testSessionHandler) CreateTestSession(ctx context.Context, req *base.Cr
 		randomize = false
 	}
 
-	session, err := h.usecase.CreateTestSession(int(userID), int(req.IdTingkat), int(req.IdMataPelajaran), durasiMenit, jumlahSoal, includeTypes, randomize)
+	session, err := h.usecase.CreateTestSession(ctx, int(userID), int(req.IdTingkat), int(req.IdMataPelajaran), durasiMenit, jumlahSoal, includeTypes, randomize)
 	if err != nil {
 		fmt.Printf("=== HANDLER: CreateTestSession FAILED: %v ===\n", err)
 		return nil, status.Error(codes.Internal, err.Error())
@@ -112,6 +148,11 @@ func (h *testSessionHandler) CreateTestSession(ctx context.Context, req *base.Cr
 	}
 	fmt.Printf("=== HANDLER: IncrementUsage success for user %d ===\n", userID)
 
+	// Bind the new session to the device that started it
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
 	return &base.TestSessionResponse{
 		TestSession: h.convertToProtoTestSession(session),
 	}, nil
@@ -125,7 +166,7 @@ func (h *testSessionHandler) GetTestSession(ctx context.Context, req *base.GetTe
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +195,7 @@ func (h *testSessionHandler) GetTestQuestions(ctx context.Context, req *base.Get
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +208,7 @@ func (h *testSessionHandler) GetTestQuestions(ctx context.Context, req *base.Get
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
-	soals, err := h.usecase.GetAllTestQuestions(req.SessionToken)
+	soals, err := h.usecase.GetAllTestQuestions(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +218,7 @@ func (h *testSessionHandler) GetTestQuestions(ctx context.Context, req *base.Get
 		req.SessionToken, session.ID, session.Status, session.WaktuMulai, session.BatasWaktu(), len(soals), time.Now())
 
 	// Get answers status
-	answers, _ := h.usecase.GetSessionAnswers(req.SessionToken)
+	answers, _ := h.usecase.GetSessionAnswers(ctx, req.SessionToken)
 	isAnsweredStatus := make([]bool, len(soals))
 	for i := range soals {
 		for _, ans := range answers {
@@ -234,8 +275,10 @@ func (h *testSessionHandler) GetTestQuestions(ctx context.Context, req *base.Get
 				if val, ok := base.JawabanOption_value[string(*q.MCJawabanDipilih)]; ok {
 					protoQuestion.McJawabanDipilih = base.JawabanOption(val)
 				}
+				protoQuestion.McJawabanDipilihLabel = string(*q.MCJawabanDipilih)
 			}
-			protoQuestion.McGambar = convertSoalGambarToProto(q.MCGambar)
+			protoQuestion.McGambar = protoconv.SoalGambar(q.MCGambar)
+			protoQuestion.McOpsi = protoconv.SoalOpsi(q.MCOpsi, q.FormatKonten)
 		}
 
 		if q.QuestionType == entity.QuestionTypeMultipleChoicesComplex && q.MCCID != nil {
@@ -255,8 +298,10 @@ func (h *testSessionHandler) GetTestQuestions(ctx context.Context, req *base.Get
 			if q.MCCOpsiD != nil {
 				protoQuestion.MccOpsiD = *q.MCCOpsiD
 			}
-			protoQuestion.MccJawabanDipilih = toProtoJawabanOptions(q.MCCJawabanDipilih)
-			protoQuestion.MccGambar = convertSoalGambarToProto(q.MCCGambar)
+			protoQuestion.MccJawabanDipilih = protoconv.JawabanOptions(q.MCCJawabanDipilih)
+			protoQuestion.MccJawabanDipilihLabels = protoconv.JawabanLabels(q.MCCJawabanDipilih)
+			protoQuestion.MccGambar = protoconv.SoalGambar(q.MCCGambar)
+			protoQuestion.MccOpsi = protoconv.SoalOpsi(q.MCCOpsi, q.FormatKonten)
 		}
 
 		// Handle drag-drop fields
@@ -288,6 +333,52 @@ func (h *testSessionHandler) GetTestQuestions(ctx context.Context, req *base.Get
 			}
 		}
 
+		if q.QuestionType == entity.QuestionTypeShortAnswer && q.SAID != nil {
+			protoQuestion.SaId = int32(*q.SAID)
+			if q.SAPertanyaan != nil {
+				protoQuestion.SaPertanyaan = *q.SAPertanyaan
+			}
+			protoQuestion.SaBlankCount = int32(q.SABlankCount)
+			protoQuestion.SaJawaban = q.SAJawaban
+			protoQuestion.SaGambar = protoconv.SoalGambar(q.SAGambar)
+		}
+
+		if q.QuestionType == entity.QuestionTypeNumeric && q.NUMID != nil {
+			protoQuestion.NumId = int32(*q.NUMID)
+			if q.NUMPertanyaan != nil {
+				protoQuestion.NumPertanyaan = *q.NUMPertanyaan
+			}
+			if q.NUMJawaban != nil {
+				protoQuestion.NumJawaban = *q.NUMJawaban
+			}
+			protoQuestion.NumGambar = protoconv.SoalGambar(q.NUMGambar)
+		}
+
+		if q.QuestionType == entity.QuestionTypeHotspot && q.HSID != nil {
+			protoQuestion.HsId = int32(*q.HSID)
+			if q.HSPertanyaan != nil {
+				protoQuestion.HsPertanyaan = *q.HSPertanyaan
+			}
+			protoQuestion.HsGambar = protoconv.SoalGambar(q.HSGambar)
+			protoQuestion.HsImageUrutan = int32(q.HSImageUrutan)
+			protoQuestion.HsMaxPoints = int32(q.HSMaxPoints)
+			protoQuestion.HsJawaban = protoconv.HotspotPoints(q.HSJawaban)
+		}
+
+		if q.QuestionType == entity.QuestionTypeGrid && q.GRIDID != nil {
+			protoQuestion.GridId = int32(*q.GRIDID)
+			if q.GRIDPertanyaan != nil {
+				protoQuestion.GridPertanyaan = *q.GRIDPertanyaan
+			}
+			protoQuestion.GridRows = q.GRIDRows
+			protoQuestion.GridColumns = q.GRIDColumns
+			protoQuestion.GridJawaban = toProtoGridJawaban(q.GRIDJawaban)
+			protoQuestion.GridGambar = protoconv.SoalGambar(q.GRIDGambar)
+		}
+		protoQuestion.Media = toProtoQuestionMedia(req.SessionToken, q.Media)
+		protoQuestion.ContentFormat = protoconv.FormatKonten(q.FormatKonten)
+		protoQuestion.PertanyaanHtml = protoconv.RenderKonten(q.FormatKonten, q.Pertanyaan())
+
 		protoQuestions = append(protoQuestions, protoQuestion)
 	}
 
@@ -329,7 +420,7 @@ func (h *testSessionHandler) SubmitAnswer(ctx context.Context, req *base.SubmitA
 	}
 
 	// Get session to check ownership
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +430,12 @@ func (h *testSessionHandler) SubmitAnswer(ctx context.Context, req *base.SubmitA
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
-	jawaban := entity.JawabanOption(req.JawabanDipilih.String()[0])
-	err = h.usecase.SubmitAnswer(req.SessionToken, int(req.NomorUrut), jawaban)
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	jawaban := protoconv.EntityJawabanLabel(req.JawabanDipilih, req.JawabanLabel)
+	err = h.usecase.SubmitAnswer(ctx, req.SessionToken, int(req.NomorUrut), jawaban)
 	if err != nil {
 		return nil, err
 	}
@@ -348,7 +443,8 @@ func (h *testSessionHandler) SubmitAnswer(ctx context.Context, req *base.SubmitA
 	return &base.SubmitAnswerResponse{
 		SessionToken:   req.SessionToken,
 		NomorUrut:      req.NomorUrut,
-		JawabanDipilih: req.JawabanDipilih,
+		JawabanDipilih: base.JawabanOption(base.JawabanOption_value[string(jawaban)]),
+		JawabanLabel:   string(jawaban),
 		IsCorrect:      true, // TODO: get from usecase
 		DijawabPada:    timestamppb.Now(),
 	}, nil
@@ -360,7 +456,7 @@ func (h *testSessionHandler) SubmitComplexAnswer(ctx context.Context, req *base.
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -369,8 +465,12 @@ func (h *testSessionHandler) SubmitComplexAnswer(ctx context.Context, req *base.
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
-	jawaban := toEntityJawabanOptions(req.JawabanDipilih)
-	err = h.usecase.SubmitComplexAnswer(req.SessionToken, int(req.NomorUrut), jawaban)
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	jawaban := protoconv.EntityJawabanLabels(req.JawabanDipilih, req.JawabanLabels)
+	err = h.usecase.SubmitComplexAnswer(ctx, req.SessionToken, int(req.NomorUrut), jawaban)
 	if err != nil {
 		return nil, err
 	}
@@ -378,12 +478,235 @@ func (h *testSessionHandler) SubmitComplexAnswer(ctx context.Context, req *base.
 	return &base.SubmitComplexAnswerResponse{
 		SessionToken:   req.SessionToken,
 		NomorUrut:      req.NomorUrut,
-		JawabanDipilih: req.JawabanDipilih,
+		JawabanDipilih: protoconv.JawabanOptions(jawaban),
+		JawabanLabels:  protoconv.JawabanLabels(jawaban),
 		IsCorrect:      true,
 		DijawabPada:    timestamppb.Now(),
 	}, nil
 }
 
+func (h *testSessionHandler) SubmitShortAnswer(ctx context.Context, req *base.SubmitShortAnswerRequest) (*base.SubmitShortAnswerResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID == nil || *session.UserID != int(user.Id) {
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+	}
+
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.SubmitShortAnswer(ctx, req.SessionToken, int(req.NomorUrut), req.Jawaban)
+	if err != nil {
+		return nil, err
+	}
+
+	return &base.SubmitShortAnswerResponse{
+		SessionToken: req.SessionToken,
+		NomorUrut:    req.NomorUrut,
+		Jawaban:      req.Jawaban,
+		DijawabPada:  timestamppb.Now(),
+	}, nil
+}
+
+func (h *testSessionHandler) SubmitNumericAnswer(ctx context.Context, req *base.SubmitNumericAnswerRequest) (*base.SubmitNumericAnswerResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID == nil || *session.UserID != int(user.Id) {
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+	}
+
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.SubmitNumericAnswer(ctx, req.SessionToken, int(req.NomorUrut), req.Jawaban)
+	if err != nil {
+		if strings.Contains(err.Error(), "jawaban numeric") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &base.SubmitNumericAnswerResponse{
+		SessionToken: req.SessionToken,
+		NomorUrut:    req.NomorUrut,
+		Jawaban:      req.Jawaban,
+		DijawabPada:  timestamppb.Now(),
+	}, nil
+}
+
+// SubmitHotspotAnswer submits the points placed on a hotspot image
+func (h *testSessionHandler) SubmitHotspotAnswer(ctx context.Context, req *base.SubmitHotspotAnswerRequest) (*base.SubmitHotspotAnswerResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID == nil || *session.UserID != int(user.Id) {
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+	}
+
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	points := protoconv.EntityHotspotPoints(req.Points)
+	err = h.usecase.SubmitHotspotAnswer(ctx, req.SessionToken, int(req.NomorUrut), points)
+	if err != nil {
+		if strings.Contains(err.Error(), "jawaban hotspot") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &base.SubmitHotspotAnswerResponse{
+		SessionToken: req.SessionToken,
+		NomorUrut:    req.NomorUrut,
+		Points:       protoconv.HotspotPoints(points),
+		DijawabPada:  timestamppb.Now(),
+	}, nil
+}
+
+// SubmitGridAnswer submits the column picked for each row of a grid question
+func (h *testSessionHandler) SubmitGridAnswer(ctx context.Context, req *base.SubmitGridAnswerRequest) (*base.SubmitGridAnswerResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID == nil || *session.UserID != int(user.Id) {
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+	}
+
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.SubmitGridAnswer(ctx, req.SessionToken, int(req.NomorUrut), toEntityGridJawaban(req.Jawaban))
+	if err != nil {
+		if strings.Contains(err.Error(), "jawaban grid") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &base.SubmitGridAnswerResponse{
+		SessionToken: req.SessionToken,
+		NomorUrut:    req.NomorUrut,
+		Jawaban:      req.Jawaban,
+		DijawabPada:  timestamppb.Now(),
+	}, nil
+}
+
+// RecordMediaPlay counts a play of a question clip; the client streams the clip afterwards
+// from the returned stream_url, which carries the play's stream nonce for limited clips
+func (h *testSessionHandler) RecordMediaPlay(ctx context.Context, req *base.RecordMediaPlayRequest) (*base.RecordMediaPlayResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID == nil || *session.UserID != int(user.Id) {
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+	}
+
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	media, err := h.usecase.RecordMediaPlay(ctx, req.SessionToken, int(req.NomorUrut), int(req.IdMedia))
+	if err != nil {
+		return nil, toMediaPlayError(err)
+	}
+
+	protoMedia := toProtoQuestionMedia(req.SessionToken, []entity.SessionMedia{*media})[0]
+	if media.MaxPutar > 0 {
+		protoMedia.StreamUrl += "?play=" + url.QueryEscape(media.StreamNonce)
+	}
+	return &base.RecordMediaPlayResponse{
+		SessionToken: req.SessionToken,
+		NomorUrut:    req.NomorUrut,
+		Media:        protoMedia,
+	}, nil
+}
+
+// GetMediaStreamSource resolves the origin of a clip for the gateway's stream endpoint
+func (h *testSessionHandler) GetMediaStreamSource(ctx context.Context, req *base.GetMediaStreamSourceRequest) (*base.GetMediaStreamSourceResponse, error) {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID == nil || *session.UserID != int(user.Id) {
+		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+	}
+
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	media, err := h.usecase.GetMediaStreamSource(ctx, req.SessionToken, int(req.NomorUrut), int(req.IdMedia), req.PlayNonce)
+	if err != nil {
+		return nil, toMediaPlayError(err)
+	}
+
+	return &base.GetMediaStreamSourceResponse{
+		SourceUrl: media.FilePath,
+		MimeType:  media.MimeType,
+		Seekable:  media.MaxPutar == 0,
+	}, nil
+}
+
+// toMediaPlayError maps clip lookup and play limit errors to gRPC codes
+func toMediaPlayError(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrMediaPlayLimitReached):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case strings.Contains(err.Error(), "media not found"):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrMediaPlayNotRecorded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
 // SubmitDragDropAnswer submits a drag-drop answer
 func (h *testSessionHandler) SubmitDragDropAnswer(ctx context.Context, req *base.SubmitDragDropAnswerRequest) (*base.SubmitDragDropAnswerResponse, error) {
 	// Get user from JWT context
@@ -393,7 +716,7 @@ func (h *testSessionHandler) SubmitDragDropAnswer(ctx context.Context, req *base
 	}
 
 	// Get session to check ownership
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -403,13 +726,17 @@ func (h *testSessionHandler) SubmitDragDropAnswer(ctx context.Context, req *base
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
 	// Convert map[int32]int32 to map[int]int
 	answer := make(map[int]int)
 	for k, v := range req.Answer {
 		answer[int(k)] = int(v)
 	}
 
-	err = h.usecase.SubmitDragDropAnswer(req.SessionToken, int(req.NomorUrut), answer)
+	err = h.usecase.SubmitDragDropAnswer(ctx, req.SessionToken, int(req.NomorUrut), answer)
 	if err != nil {
 		return nil, err
 	}
@@ -429,7 +756,7 @@ func (h *testSessionHandler) SubmitEssayAnswer(ctx context.Context, req *base.Su
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -438,7 +765,11 @@ func (h *testSessionHandler) SubmitEssayAnswer(ctx context.Context, req *base.Su
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
-	err = h.usecase.SubmitEssayAnswer(req.SessionToken, int(req.NomorUrut), req.JawabanEssay)
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.SubmitEssayAnswer(ctx, req.SessionToken, int(req.NomorUrut), req.JawabanEssay)
 	if err != nil {
 		return nil, err
 	}
@@ -460,7 +791,7 @@ func (h *testSessionHandler) ClearAnswer(ctx context.Context, req *base.ClearAns
 	}
 
 	// Get session to check ownership
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -470,7 +801,11 @@ func (h *testSessionHandler) ClearAnswer(ctx context.Context, req *base.ClearAns
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
-	err = h.usecase.ClearAnswer(req.SessionToken, int(req.NomorUrut))
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.ClearAnswer(ctx, req.SessionToken, int(req.NomorUrut))
 	if err != nil {
 		return nil, err
 	}
@@ -491,7 +826,7 @@ func (h *testSessionHandler) CompleteSession(ctx context.Context, req *base.Comp
 	}
 
 	// Get session to check ownership
-	session, err := h.usecase.GetTestSession(req.SessionToken)
+	session, err := h.usecase.GetTestSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -501,7 +836,11 @@ func (h *testSessionHandler) CompleteSession(ctx context.Context, req *base.Comp
 		return nil, status.Error(codes.PermissionDenied, "you do not have permission to access this session")
 	}
 
-	session, err = h.usecase.CompleteSession(req.SessionToken)
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	session, err = h.usecase.CompleteSession(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -519,7 +858,7 @@ func (h *testSessionHandler) GetTestResult(ctx context.Context, req *base.GetTes
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	session, details, err := h.usecase.GetTestResult(req.SessionToken)
+	session, details, err := h.usecase.GetTestResult(ctx, req.SessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -538,8 +877,10 @@ func (h *testSessionHandler) GetTestResult(ctx context.Context, req *base.GetTes
 	var jawabanDetails []*base.JawabanDetail
 	for _, d := range details {
 		var jawabanDipilih base.JawabanOption
+		var jawabanDipilihLabel string
 		if d.JawabanDipilih != nil {
 			jawabanDipilih = base.JawabanOption(base.JawabanOption_value[string(*d.JawabanDipilih)])
+			jawabanDipilihLabel = string(*d.JawabanDipilih)
 		}
 
 		var pembahasan string
@@ -548,19 +889,25 @@ func (h *testSessionHandler) GetTestResult(ctx context.Context, req *base.GetTes
 		}
 
 		jawabanDetail := &base.JawabanDetail{
-			NomorUrut:      int32(d.NomorUrut),
-			Pertanyaan:     d.Pertanyaan,
-			OpsiA:          d.OpsiA,
-			OpsiB:          d.OpsiB,
-			OpsiC:          d.OpsiC,
-			OpsiD:          d.OpsiD,
-			JawabanDipilih: jawabanDipilih,
-			JawabanBenar:   base.JawabanOption(base.JawabanOption_value[string(d.JawabanBenar)]),
-			IsCorrect:      d.IsCorrect,
-			IsAnswered:     d.IsAnswered,
-			Pembahasan:     pembahasan,
-			Gambar:         convertSoalGambarToProto(d.Gambar),
-			QuestionType:   base.QuestionType(base.QuestionType_value[strings.ToUpper(string(d.QuestionType))]),
+			NomorUrut:           int32(d.NomorUrut),
+			Pertanyaan:          d.Pertanyaan,
+			OpsiA:               d.OpsiA,
+			OpsiB:               d.OpsiB,
+			OpsiC:               d.OpsiC,
+			OpsiD:               d.OpsiD,
+			JawabanDipilih:      jawabanDipilih,
+			JawabanBenar:        base.JawabanOption(base.JawabanOption_value[string(d.JawabanBenar)]),
+			IsCorrect:           d.IsCorrect,
+			IsAnswered:          d.IsAnswered,
+			Pembahasan:          pembahasan,
+			Gambar:              protoconv.SoalGambar(d.Gambar),
+			QuestionType:        base.QuestionType(base.QuestionType_value[strings.ToUpper(string(d.QuestionType))]),
+			Opsi:                protoconv.SoalOpsi(d.Opsi, d.FormatKonten),
+			ContentFormat:       protoconv.FormatKonten(d.FormatKonten),
+			PertanyaanHtml:      protoconv.RenderKonten(d.FormatKonten, d.Pertanyaan),
+			PembahasanHtml:      protoconv.RenderKonten(d.FormatKonten, pembahasan),
+			JawabanDipilihLabel: jawabanDipilihLabel,
+			JawabanBenarLabel:   string(d.JawabanBenar),
 		}
 
 		if d.QuestionType == entity.QuestionTypeEssay {
@@ -573,11 +920,42 @@ func (h *testSessionHandler) GetTestResult(ctx context.Context, req *base.GetTes
 			if d.FeedbackTeacher != nil {
 				jawabanDetail.FeedbackTeacher = *d.FeedbackTeacher
 			}
+			jawabanDetail.RubricScores = protoconv.RubricScores(d.RubricScores)
 		}
 
 		if d.QuestionType == entity.QuestionTypeMultipleChoicesComplex {
-			jawabanDetail.JawabanDipilihComplex = toProtoJawabanOptions(d.JawabanDipilihComplex)
-			jawabanDetail.JawabanBenarComplex = toProtoJawabanOptions(d.JawabanBenarComplex)
+			jawabanDetail.JawabanDipilihComplex = protoconv.JawabanOptions(d.JawabanDipilihComplex)
+			jawabanDetail.JawabanBenarComplex = protoconv.JawabanOptions(d.JawabanBenarComplex)
+			jawabanDetail.JawabanDipilihComplexLabels = protoconv.JawabanLabels(d.JawabanDipilihComplex)
+			jawabanDetail.JawabanBenarComplexLabels = protoconv.JawabanLabels(d.JawabanBenarComplex)
+		}
+
+		if d.QuestionType == entity.QuestionTypeShortAnswer {
+			jawabanDetail.JawabanShortAnswer = d.JawabanShortAnswer
+			jawabanDetail.ShortAnswerBlanks = protoconv.ShortAnswerBlanks(d.ShortAnswerBlanks)
+			jawabanDetail.ShortAnswerBlankCorrect = d.ShortAnswerBlankCorrect
+		}
+
+		if d.QuestionType == entity.QuestionTypeNumeric {
+			if d.JawabanNumeric != nil {
+				jawabanDetail.JawabanNumeric = *d.JawabanNumeric
+			}
+			jawabanDetail.NumericAnswer = protoconv.NumericAnswerKey(d.NumericAnswerKey)
+		}
+
+		if d.QuestionType == entity.QuestionTypeHotspot {
+			jawabanDetail.JawabanHotspot = protoconv.HotspotPoints(d.JawabanHotspot)
+			jawabanDetail.HotspotAnswer = protoconv.HotspotAnswerKey(d.HotspotAnswerKey)
+			jawabanDetail.HotspotPointCorrect = d.HotspotPointCorrect
+		}
+
+		if d.QuestionType == entity.QuestionTypeGrid {
+			jawabanDetail.JawabanGrid = toProtoGridJawaban(d.JawabanGrid)
+			jawabanDetail.GridAnswer = protoconv.GridAnswerKey(d.GridAnswerKey)
+			jawabanDetail.GridRowCorrect = d.GridRowCorrect
+			if d.NilaiParsial != nil {
+				jawabanDetail.NilaiParsial = *d.NilaiParsial
+			}
 		}
 
 		if d.QuestionType == entity.QuestionTypeDragDrop {
@@ -619,36 +997,53 @@ func (h *testSessionHandler) GetTestResult(ctx context.Context, req *base.GetTes
 	}, nil
 }
 
-func toEntityJawabanOptions(options []base.JawabanOption) []entity.JawabanOption {
-	result := make([]entity.JawabanOption, 0, len(options))
-	for _, option := range options {
-		switch option {
-		case base.JawabanOption_A:
-			result = append(result, entity.JawabanA)
-		case base.JawabanOption_B:
-			result = append(result, entity.JawabanB)
-		case base.JawabanOption_C:
-			result = append(result, entity.JawabanC)
-		case base.JawabanOption_D:
-			result = append(result, entity.JawabanD)
-		}
+func toEntityGridJawaban(jawaban []int32) []int {
+	result := make([]int, 0, len(jawaban))
+	for _, kolom := range jawaban {
+		result = append(result, int(kolom))
 	}
 	return result
 }
 
-func toProtoJawabanOptions(options []entity.JawabanOption) []base.JawabanOption {
-	result := make([]base.JawabanOption, 0, len(options))
-	for _, option := range options {
-		switch option {
-		case entity.JawabanA:
-			result = append(result, base.JawabanOption_A)
-		case entity.JawabanB:
-			result = append(result, base.JawabanOption_B)
-		case entity.JawabanC:
-			result = append(result, base.JawabanOption_C)
-		case entity.JawabanD:
-			result = append(result, base.JawabanOption_D)
+// mediaStreamURL is the gateway path a student streams a clip from, see init/server
+func mediaStreamURL(sessionToken string, nomorUrut, idMedia int) string {
+	return fmt.Sprintf("/v1/test-sessions/%s/questions/%d/media/%d", sessionToken, nomorUrut, idMedia)
+}
+
+func toProtoQuestionMedia(sessionToken string, media []entity.SessionMedia) []*base.QuestionMedia {
+	if len(media) == 0 {
+		return nil
+	}
+	result := make([]*base.QuestionMedia, 0, len(media))
+	for _, m := range media {
+		keterangan := ""
+		if m.Keterangan != nil {
+			keterangan = *m.Keterangan
 		}
+		jenis := base.MediaJenis_MEDIA_AUDIO
+		if m.Jenis == entity.MediaJenisVideo {
+			jenis = base.MediaJenis_MEDIA_VIDEO
+		}
+		result = append(result, &base.QuestionMedia{
+			IdMedia:     int32(m.IDMedia),
+			Jenis:       jenis,
+			MimeType:    m.MimeType,
+			DurasiDetik: m.DurasiDetik,
+			MaxPutar:    int32(m.MaxPutar),
+			JumlahPutar: int32(m.JumlahPutar),
+			SisaPutar:   int32(m.SisaPutar()),
+			Urutan:      int32(m.Urutan),
+			Keterangan:  keterangan,
+			StreamUrl:   mediaStreamURL(sessionToken, m.NomorUrut, m.IDMedia),
+		})
+	}
+	return result
+}
+
+func toProtoGridJawaban(jawaban []int) []int32 {
+	result := make([]int32, 0, len(jawaban))
+	for _, kolom := range jawaban {
+		result = append(result, int32(kolom))
 	}
 	return result
 }
@@ -659,17 +1054,20 @@ func (h *testSessionHandler) GradeEssayAnswer(ctx context.Context, req *base.Gra
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	if user.Role != base.UserRole_ADMIN {
-		return nil, status.Error(codes.PermissionDenied, "only admin can grade essay answers")
-	}
-
-	if err := h.usecase.GradeEssayAnswer(int(req.AnswerId), req.Score, req.Feedback); err != nil {
+	selections := protoconv.RubricSelections(req.RubricSelections)
+	nilai, scores, err := h.gradingUsecase.GradeEssay(ctx, int(req.AnswerId), req.Score, selections, req.Feedback, int(user.Id))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return &base.GradeEssayAnswerResponse{
-		Success: true,
-		Message: "Essay answer graded successfully",
+		Success:      true,
+		Message:      "Essay answer graded successfully",
+		NilaiEssay:   nilai,
+		RubricScores: protoconv.RubricScores(scores),
 	}, nil
 }
 
@@ -680,10 +1078,6 @@ func (h *testSessionHandler) ListMyScheduledSessions(ctx context.Context, req *b
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	if user.Role != base.UserRole_SISWA {
-		return nil, status.Error(codes.PermissionDenied, "only student can access this endpoint")
-	}
-
 	page := 1
 	pageSize := 20
 	if req.Pagination != nil {
@@ -701,7 +1095,7 @@ func (h *testSessionHandler) ListMyScheduledSessions(ctx context.Context, req *b
 		lmsClassID = &v
 	}
 
-	sessions, pagination, err := h.usecase.ListScheduledSessions(int(user.Id), lmsClassID, page, pageSize)
+	sessions, pagination, err := h.usecase.ListScheduledSessions(ctx, int(user.Id), lmsClassID, page, pageSize)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -729,11 +1123,7 @@ func (h *testSessionHandler) StartScheduledSession(ctx context.Context, req *bas
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
 	}
 
-	if user.Role != base.UserRole_SISWA {
-		return nil, status.Error(codes.PermissionDenied, "only student can access this endpoint")
-	}
-
-	session, err := h.usecase.StartScheduledSession(int(user.Id), req.SessionToken)
+	session, err := h.usecase.StartScheduledSession(ctx, int(user.Id), req.SessionToken)
 	if err != nil {
 		if strings.Contains(strings.ToLower(err.Error()), "scheduled to start") {
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
@@ -744,22 +1134,15 @@ func (h *testSessionHandler) StartScheduledSession(ctx context.Context, req *bas
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := h.ensureDeviceLease(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
 	return &base.TestSessionResponse{TestSession: h.convertToProtoTestSession(session)}, nil
 }
 
 // ListTestSessions lists sessions
 func (h *testSessionHandler) ListTestSessions(ctx context.Context, req *base.ListTestSessionsRequest) (*base.ListTestSessionsResponse, error) {
-	// Get user from JWT context for admin access
-	user, err := interceptor.GetUserFromContext(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
-	}
-
-	// Check if user is admin
-	if user.Role != base.UserRole_ADMIN {
-		return nil, status.Error(codes.PermissionDenied, "only admin can access this endpoint")
-	}
-
 	var tingkatan, idMataPelajaran *int
 	var status *entity.TestStatus
 
@@ -787,7 +1170,7 @@ func (h *testSessionHandler) ListTestSessions(ctx context.Context, req *base.Lis
 		}
 	}
 
-	sessions, pagination, err := h.usecase.ListTestSessions(tingkatan, idMataPelajaran, status, page, pageSize)
+	sessions, pagination, err := h.usecase.ListTestSessions(ctx, tingkatan, idMataPelajaran, status, interceptor.TeacherScopeFromContext(ctx), page, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -865,7 +1248,7 @@ func (h *testSessionHandler) convertUserToProto(user *entity.User) *base.User {
 		return nil
 	}
 
-	role := base.UserRole(base.UserRole_value[strings.ToUpper(user.Role)])
+	role := interceptor.ProtoRole(user.Role)
 
 	return &base.User{
 		Id:        int32(user.ID),
@@ -877,44 +1260,6 @@ func (h *testSessionHandler) convertUserToProto(user *entity.User) *base.User {
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
 	}
 }
-func convertSoalGambarToProto(gambar []entity.SoalGambar) []*base.SoalGambar {
-	if len(gambar) == 0 {
-		return nil
-	}
-
-	var protoGambar []*base.SoalGambar
-	for _, g := range gambar {
-		keterangan := ""
-		if g.Keterangan != nil {
-			keterangan = *g.Keterangan
-		}
-
-		cloudId := ""
-		if g.CloudId != nil {
-			cloudId = *g.CloudId
-		}
-
-		publicId := ""
-		if g.PublicId != nil {
-			publicId = *g.PublicId
-		}
-
-		protoGambar = append(protoGambar, &base.SoalGambar{
-			Id:         int32(g.ID),
-			NamaFile:   g.NamaFile,
-			FilePath:   g.FilePath,
-			FileSize:   int32(g.FileSize),
-			MimeType:   g.MimeType,
-			Urutan:     int32(g.Urutan),
-			Keterangan: keterangan,
-			CloudId:    cloudId,
-			PublicId:   publicId,
-			CreatedAt:  timestamppb.New(g.CreatedAt),
-		})
-	}
-	return protoGambar
-}
-
 func convertDragItemsToProto(items []entity.DragItem) []*base.DragItem {
 	if len(items) == 0 {
 		return nil
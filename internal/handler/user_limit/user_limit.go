@@ -1,11 +1,11 @@
 package user_limit
 
 import (
+	"context"
+
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
 	userLimitUsecase "cbt-test-mini-project/internal/usecase"
-	"cbt-test-mini-project/util/interceptor"
-	"context"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,21 +21,7 @@ func NewUserLimitHandler(usecase userLimitUsecase.UserLimitUsecase) base.UserLim
 	return &userLimitHandler{usecase: usecase}
 }
 
-func (h *userLimitHandler) requireAdmin(ctx context.Context) error {
-	user, err := interceptor.GetUserFromContext(ctx)
-	if err != nil {
-		return status.Error(codes.Unauthenticated, "user not authenticated")
-	}
-	if user.Role != base.UserRole_ADMIN {
-		return status.Error(codes.PermissionDenied, "only admin can access this endpoint")
-	}
-	return nil
-}
-
 func (h *userLimitHandler) GetUserLimits(ctx context.Context, req *base.GetUserLimitsRequest) (*base.GetUserLimitsResponse, error) {
-	if err := h.requireAdmin(ctx); err != nil {
-		return nil, err
-	}
 	if req.UserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id must be greater than 0")
 	}
@@ -58,9 +44,6 @@ func (h *userLimitHandler) GetUserLimits(ctx context.Context, req *base.GetUserL
 }
 
 func (h *userLimitHandler) SetUserLimit(ctx context.Context, req *base.SetUserLimitRequest) (*base.UserLimitResponse, error) {
-	if err := h.requireAdmin(ctx); err != nil {
-		return nil, err
-	}
 	if req.UserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id must be greater than 0")
 	}
@@ -71,7 +54,13 @@ func (h *userLimitHandler) SetUserLimit(ctx context.Context, req *base.SetUserLi
 		return nil, status.Error(codes.InvalidArgument, "limit_value cannot be negative")
 	}
 
-	limit, err := h.usecase.SetUserLimit(ctx, int(req.UserId), req.LimitType, int(req.LimitValue))
+	var limit *entity.UserLimit
+	var err error
+	if req.UsePolicy {
+		limit, err = h.usecase.ClearUserLimitOverride(ctx, int(req.UserId), req.LimitType)
+	} else {
+		limit, err = h.usecase.SetUserLimit(ctx, int(req.UserId), req.LimitType, int(req.LimitValue))
+	}
 	if err != nil {
 		return &base.UserLimitResponse{Success: false, Message: err.Error()}, nil
 	}
@@ -84,9 +73,6 @@ func (h *userLimitHandler) SetUserLimit(ctx context.Context, req *base.SetUserLi
 }
 
 func (h *userLimitHandler) ResetUserLimit(ctx context.Context, req *base.ResetUserLimitRequest) (*base.MessageStatusResponse, error) {
-	if err := h.requireAdmin(ctx); err != nil {
-		return nil, err
-	}
 	if req.UserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id must be greater than 0")
 	}
@@ -102,9 +88,6 @@ func (h *userLimitHandler) ResetUserLimit(ctx context.Context, req *base.ResetUs
 }
 
 func (h *userLimitHandler) GetUserLimitUsageHistory(ctx context.Context, req *base.GetUserLimitUsageHistoryRequest) (*base.GetUserLimitUsageHistoryResponse, error) {
-	if err := h.requireAdmin(ctx); err != nil {
-		return nil, err
-	}
 	if req.UserId <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "user_id must be greater than 0")
 	}
@@ -157,5 +140,154 @@ func convertUserLimit(limit *entity.UserLimit) *base.UserLimit {
 		ResetAt:     timestamppb.New(limit.ResetAt),
 		CreatedAt:   timestamppb.New(limit.CreatedAt),
 		UpdatedAt:   timestamppb.New(limit.UpdatedAt),
+		IsOverride:  limit.IsOverride,
+	}
+}
+
+func (h *userLimitHandler) ListRateLimitPolicies(ctx context.Context, req *base.ListRateLimitPoliciesRequest) (*base.ListRateLimitPoliciesResponse, error) {
+	set, err := h.usecase.ListRateLimitPolicies(ctx)
+	if err != nil {
+		return &base.ListRateLimitPoliciesResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	res := &base.ListRateLimitPoliciesResponse{
+		Policies:     make([]*base.RateLimitPolicy, 0, len(set.Policies)),
+		MethodGroups: make([]*base.RateLimitMethodGroup, 0, len(set.MethodGroups)),
+		SchoolPlans:  make([]*base.SchoolPlan, 0, len(set.SchoolPlans)),
+		Success:      true,
+		Message:      "Rate limit policies retrieved successfully",
+	}
+	for i := range set.Policies {
+		res.Policies = append(res.Policies, convertRateLimitPolicy(&set.Policies[i]))
+	}
+	for _, group := range set.MethodGroups {
+		res.MethodGroups = append(res.MethodGroups, &base.RateLimitMethodGroup{Method: group.Method, MethodGroup: group.MethodGroup})
+	}
+	for _, plan := range set.SchoolPlans {
+		res.SchoolPlans = append(res.SchoolPlans, &base.SchoolPlan{SchoolId: plan.SchoolID, Plan: plan.Plan})
+	}
+	return res, nil
+}
+
+func (h *userLimitHandler) SaveRateLimitPolicy(ctx context.Context, req *base.SaveRateLimitPolicyRequest) (*base.RateLimitPolicyResponse, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	policy := toRateLimitPolicy(req.Policy)
+	if err := h.usecase.SaveRateLimitPolicy(ctx, policy); err != nil {
+		if err == entity.ErrRateLimitPolicyNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err == entity.ErrRateLimitPolicyCrossTenant {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return &base.RateLimitPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &base.RateLimitPolicyResponse{
+		Policy:  convertRateLimitPolicy(policy),
+		Success: true,
+		Message: "Rate limit policy saved successfully",
+	}, nil
+}
+
+func (h *userLimitHandler) DeleteRateLimitPolicy(ctx context.Context, req *base.DeleteRateLimitPolicyRequest) (*base.MessageStatusResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be greater than 0")
+	}
+
+	if err := h.usecase.DeleteRateLimitPolicy(ctx, int(req.Id)); err != nil {
+		if err == entity.ErrRateLimitPolicyNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err == entity.ErrRateLimitPolicyCrossTenant {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return &base.MessageStatusResponse{Status: "error", Message: err.Error()}, nil
+	}
+
+	return &base.MessageStatusResponse{Status: "success", Message: "Rate limit policy deleted successfully"}, nil
+}
+
+func (h *userLimitHandler) SetRateLimitMethodGroup(ctx context.Context, req *base.SetRateLimitMethodGroupRequest) (*base.MessageStatusResponse, error) {
+	if req.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "method is required")
+	}
+
+	if err := h.usecase.SetRateLimitMethodGroup(ctx, req.Method, req.MethodGroup); err != nil {
+		if err == entity.ErrRateLimitPolicyCrossTenant {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return &base.MessageStatusResponse{Status: "error", Message: err.Error()}, nil
+	}
+
+	return &base.MessageStatusResponse{Status: "success", Message: "Method group updated successfully"}, nil
+}
+
+func (h *userLimitHandler) SetSchoolPlan(ctx context.Context, req *base.SetSchoolPlanRequest) (*base.MessageStatusResponse, error) {
+	if req.SchoolId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "school_id must be greater than 0")
+	}
+
+	if err := h.usecase.SetSchoolPlan(ctx, req.SchoolId, req.Plan); err != nil {
+		if err == entity.ErrRateLimitPolicyCrossTenant {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return &base.MessageStatusResponse{Status: "error", Message: err.Error()}, nil
+	}
+
+	return &base.MessageStatusResponse{Status: "success", Message: "School plan updated successfully"}, nil
+}
+
+func convertRateLimitPolicy(policy *entity.RateLimitPolicy) *base.RateLimitPolicy {
+	res := &base.RateLimitPolicy{
+		Id:          int32(policy.ID),
+		MethodGroup: policy.MethodGroup,
+		LimitType:   policy.LimitType,
+		Burst:       int32(policy.Burst),
+		ExamExempt:  policy.ExamExempt,
+		Disabled:    !policy.Enabled,
+		CreatedAt:   timestamppb.New(policy.CreatedAt),
+		UpdatedAt:   timestamppb.New(policy.UpdatedAt),
+	}
+	if policy.Role != nil {
+		res.Role = *policy.Role
+	}
+	if policy.SchoolPlan != nil {
+		res.SchoolPlan = *policy.SchoolPlan
+	}
+	if policy.SchoolID != nil {
+		res.SchoolId = *policy.SchoolID
+	}
+	if policy.LimitValue != nil {
+		res.LimitValue = int32(*policy.LimitValue)
+		res.HasLimitValue = true
+	}
+	return res
+}
+
+func toRateLimitPolicy(req *base.RateLimitPolicy) *entity.RateLimitPolicy {
+	policy := &entity.RateLimitPolicy{
+		ID:          int(req.Id),
+		MethodGroup: req.MethodGroup,
+		LimitType:   req.LimitType,
+		Burst:       int(req.Burst),
+		ExamExempt:  req.ExamExempt,
+		Enabled:     !req.Disabled,
+	}
+	if req.Role != "" {
+		policy.Role = &req.Role
+	}
+	if req.SchoolPlan != "" {
+		policy.SchoolPlan = &req.SchoolPlan
+	}
+	if req.SchoolId != 0 {
+		policy.SchoolID = &req.SchoolId
+	}
+	if req.HasLimitValue {
+		value := int(req.LimitValue)
+		policy.LimitValue = &value
 	}
+	return policy
 }
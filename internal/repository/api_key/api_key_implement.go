@@ -0,0 +1,137 @@
+package api_key
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// apiKeyRepositoryImpl implements APIKeyRepository
+type apiKeyRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository instance
+func NewAPIKeyRepository(db *sql.DB) APIKeyRepository {
+	return &apiKeyRepositoryImpl{db: db}
+}
+
+const apiKeyColumns = `id, name, key_prefix, key_hash, scopes, lms_school_id, created_by, expires_at, revoked_at, revoked_by, last_used_at, created_at`
+
+// Store a new key
+func (r *apiKeyRepositoryImpl) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	scopes := key.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO api_keys (name, key_prefix, key_hash, scopes, lms_school_id, created_by, expires_at)
+		VALUES ($1, $2, $3, $4::jsonb, $5, $6, $7)
+		RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query, key.Name, key.KeyPrefix, key.KeyHash, string(scopesJSON), key.LMSSchoolID, key.CreatedBy, key.ExpiresAt).
+		Scan(&key.ID, &key.CreatedAt)
+}
+
+// Get a key by the hash of its secret
+func (r *apiKeyRepositoryImpl) GetAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1`, hash)
+	key, err := scanAPIKey(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return key, err
+}
+
+// List keys, newest first
+func (r *apiKeyRepositoryImpl) ListAPIKeys(ctx context.Context, lmsSchoolID *int64, includeRevoked bool) ([]entity.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys
+		WHERE ($1 OR revoked_at IS NULL)
+			AND ($2::BIGINT IS NULL OR lms_school_id = $2)
+		ORDER BY created_at DESC, id DESC`, includeRevoked, lmsSchoolID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []entity.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+	return keys, rows.Err()
+}
+
+// Revoke a key
+func (r *apiKeyRepositoryImpl) RevokeAPIKey(ctx context.Context, id int, lmsSchoolID *int64, revokedBy int) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE api_keys SET revoked_at = NOW(), revoked_by = $3
+		WHERE id = $1 AND revoked_at IS NULL
+			AND ($2::BIGINT IS NULL OR lms_school_id = $2)`, id, lmsSchoolID, revokedBy)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// Record that a key was just used
+func (r *apiKeyRepositoryImpl) TouchAPIKey(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE api_keys SET last_used_at = NOW() WHERE id = $1`, id)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAPIKey(row rowScanner) (*entity.APIKey, error) {
+	var key entity.APIKey
+	var scopesJSON []byte
+	var lmsSchoolID sql.NullInt64
+	var revokedBy sql.NullInt64
+	var expiresAt, revokedAt, lastUsedAt sql.NullTime
+
+	err := row.Scan(&key.ID, &key.Name, &key.KeyPrefix, &key.KeyHash, &scopesJSON, &lmsSchoolID, &key.CreatedBy,
+		&expiresAt, &revokedAt, &revokedBy, &lastUsedAt, &key.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(scopesJSON) > 0 {
+		if err := json.Unmarshal(scopesJSON, &key.Scopes); err != nil {
+			return nil, err
+		}
+	}
+	if lmsSchoolID.Valid {
+		key.LMSSchoolID = &lmsSchoolID.Int64
+	}
+	if revokedBy.Valid {
+		v := int(revokedBy.Int64)
+		key.RevokedBy = &v
+	}
+	if expiresAt.Valid {
+		key.ExpiresAt = &expiresAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	return &key, nil
+}
@@ -0,0 +1,26 @@
+package api_key
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// APIKeyRepository defines the interface for service-to-service API keys
+type APIKeyRepository interface {
+	// Store a new key
+	CreateAPIKey(ctx context.Context, key *entity.APIKey) error
+
+	// Get a key by the hash of its secret, revoked and expired keys included (nil when not found)
+	GetAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error)
+
+	// List keys, newest first; only the keys of one school when lmsSchoolID is set
+	ListAPIKeys(ctx context.Context, lmsSchoolID *int64, includeRevoked bool) ([]entity.APIKey, error)
+
+	// Revoke a key, of one school when lmsSchoolID is set; returns false when it does not exist
+	// or is already revoked
+	RevokeAPIKey(ctx context.Context, id int, lmsSchoolID *int64, revokedBy int) (bool, error)
+
+	// Record that a key was just used
+	TouchAPIKey(ctx context.Context, id int) error
+}
@@ -0,0 +1,145 @@
+package audit_log
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// auditLogRepositoryImpl implements AuditLogRepository
+type auditLogRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewAuditLogRepository creates a new AuditLogRepository instance
+func NewAuditLogRepository(db *sql.DB) AuditLogRepository {
+	return &auditLogRepositoryImpl{db: db}
+}
+
+const auditLogColumns = `id, occurred_at, actor_id, actor_role, api_key_id, school_id, method, resource_type, resource_id, before_data, after_data, request_data, request_id, client_ip, status`
+
+// Append entries in one transaction
+func (r *auditLogRepositoryImpl) Append(ctx context.Context, entries []*entity.AuditLog) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, entry := range entries {
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO audit_logs (occurred_at, actor_id, actor_role, api_key_id, school_id, method, resource_type, resource_id,
+				before_data, after_data, request_data, request_id, client_ip, status)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			RETURNING id`,
+			entry.OccurredAt, entry.ActorID, entry.ActorRole, entry.APIKeyID, entry.SchoolID, entry.Method,
+			entry.ResourceType, entry.ResourceID, jsonArg(entry.Before), jsonArg(entry.After), jsonArg(entry.Request),
+			entry.RequestID, entry.ClientIP, entry.Status).
+			Scan(&entry.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// List entries matching the filter, newest first
+func (r *auditLogRepositoryImpl) List(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, int, error) {
+	var conditions []string
+	var args []interface{}
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.ActorID > 0 {
+		add("actor_id = $%d", filter.ActorID)
+	}
+	if filter.ResourceType != "" {
+		add("resource_type = $%d", filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		add("resource_id = $%d", filter.ResourceID)
+	}
+	if filter.Method != "" {
+		add("method = $%d", filter.Method)
+	}
+	if !filter.From.IsZero() {
+		add("occurred_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("occurred_at < $%d", filter.To)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM audit_logs`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, filter.PageSize, (filter.Page-1)*filter.PageSize)
+	query := fmt.Sprintf(`SELECT %s FROM audit_logs%s ORDER BY occurred_at DESC, id DESC LIMIT $%d OFFSET $%d`,
+		auditLogColumns, where, len(args)-1, len(args))
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := []entity.AuditLog{}
+	for rows.Next() {
+		entry, err := scanAuditLog(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, total, rows.Err()
+}
+
+// jsonArg stores empty JSON as NULL
+func jsonArg(raw json.RawMessage) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	return string(raw)
+}
+
+func scanAuditLog(rows *sql.Rows) (*entity.AuditLog, error) {
+	var entry entity.AuditLog
+	var actorID, apiKeyID, schoolID sql.NullInt64
+	var actorRole, resourceID, requestID, clientIP sql.NullString
+	var before, after, request []byte
+
+	err := rows.Scan(&entry.ID, &entry.OccurredAt, &actorID, &actorRole, &apiKeyID, &schoolID, &entry.Method,
+		&entry.ResourceType, &resourceID, &before, &after, &request, &requestID, &clientIP, &entry.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if actorID.Valid {
+		id := int(actorID.Int64)
+		entry.ActorID = &id
+	}
+	if apiKeyID.Valid {
+		id := int(apiKeyID.Int64)
+		entry.APIKeyID = &id
+	}
+	if schoolID.Valid {
+		entry.SchoolID = &schoolID.Int64
+	}
+	entry.ActorRole = actorRole.String
+	entry.ResourceID = resourceID.String
+	entry.RequestID = requestID.String
+	entry.ClientIP = clientIP.String
+	entry.Before = before
+	entry.After = after
+	entry.Request = request
+	return &entry, nil
+}
@@ -0,0 +1,16 @@
+package audit_log
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// AuditLogRepository defines the interface for the append-only audit log
+type AuditLogRepository interface {
+	// Append entries in one transaction; fills ID
+	Append(ctx context.Context, entries []*entity.AuditLog) error
+
+	// List entries matching the filter, newest first, with the total count
+	List(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, int, error)
+}
@@ -1,14 +1,15 @@
 package auth
 
 import (
-	base "cbt-test-mini-project/gen/proto"
-	"cbt-test-mini-project/internal/entity"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
 
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -67,11 +68,11 @@ func NewAuthRepository(db *sql.DB) AuthRepository {
 	return &authRepositoryImpl{db: db}
 }
 
-// Login authenticates a user
+// Login authenticates an active user by email and password
 func (r *authRepositoryImpl) Login(ctx context.Context, email, password string) (*base.User, error) {
 	var userEntity entity.User
 
-	query := `SELECT id, email, password_hash, full_name, role, is_active, created_at, updated_at, lms_user_id FROM users WHERE email = $1 AND is_active = $2 AND role = 'superadmin'`
+	query := `SELECT id, email, password_hash, full_name, role, is_active, created_at, updated_at, lms_user_id FROM users WHERE lower(email) = lower($1) AND is_active = $2`
 	err := r.db.QueryRowContext(ctx, query, email, true).Scan(&userEntity.ID, &userEntity.Email, &userEntity.PasswordHash, &userEntity.Nama, &userEntity.Role, &userEntity.IsActive, &userEntity.CreatedAt, &userEntity.UpdatedAt, &userEntity.LmsUserID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -358,3 +359,35 @@ func (r *authRepositoryImpl) GetLMSUserIDByLocalID(ctx context.Context, id int32
 	}
 	return lmsUserID, nil
 }
+
+// ChangePassword replaces the password of an active user after checking the current one
+func (r *authRepositoryImpl) ChangePassword(ctx context.Context, id int32, currentPassword, newPassword string) error {
+	var passwordHash string
+	query := `SELECT password_hash FROM users WHERE id = $1 AND is_active = true`
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&passwordHash); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New("user not found")
+		}
+		return err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(currentPassword)); err != nil {
+		return errors.New("current password is incorrect")
+	}
+
+	newHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `UPDATE users SET password_hash = $2, updated_at = NOW() WHERE id = $1`, id, string(newHash))
+	return err
+}
+
+// GetUserSchoolID returns the school of a local user, 0 when none is set
+func (r *authRepositoryImpl) GetUserSchoolID(ctx context.Context, id int32) (int64, error) {
+	var schoolID sql.NullInt64
+	err := r.db.QueryRowContext(ctx, `SELECT school_id FROM users WHERE id = $1`, id).Scan(&schoolID)
+	if err != nil {
+		return 0, err
+	}
+	return schoolID.Int64, nil
+}
@@ -1,9 +1,10 @@
 package auth
 
 import (
-	base "cbt-test-mini-project/gen/proto"
 	"context"
 	"database/sql"
+
+	base "cbt-test-mini-project/gen/proto"
 )
 
 // AuthRepository defines the interface for auth repository
@@ -20,9 +21,13 @@ type AuthRepository interface {
 	// LMS sync methods
 	FindOrCreateByLMSID(ctx context.Context, lmsID int64, email, name string, role int32) (*base.User, error)
 	GetLMSUserIDByLocalID(ctx context.Context, id int32) (int64, error)
+
+	// Standalone (AUTH_MODE=local) methods
+	ChangePassword(ctx context.Context, id int32, currentPassword, newPassword string) error
+	GetUserSchoolID(ctx context.Context, id int32) (int64, error)
 }
 
 // InitAuthRepository initializes the auth repository
 func InitAuthRepository(db *sql.DB) AuthRepository {
 	return NewAuthRepository(db)
-}
\ No newline at end of file
+}
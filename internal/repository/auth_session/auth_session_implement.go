@@ -0,0 +1,142 @@
+package auth_session
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// authSessionRepositoryImpl implements AuthSessionRepository
+type authSessionRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewAuthSessionRepository creates a new AuthSessionRepository instance
+func NewAuthSessionRepository(db *sql.DB) AuthSessionRepository {
+	return &authSessionRepositoryImpl{db: db}
+}
+
+// Start a session with its first refresh token, if any
+func (r *authSessionRepositoryImpl) CreateSession(ctx context.Context, session *entity.AuthSession, refreshHash string, refreshExpiresAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO auth_sessions (user_id, school_id, user_agent, client_ip, last_used_at)
+		VALUES ($1, NULLIF($2, 0), NULLIF($3, ''), NULLIF($4, ''), NOW())
+		RETURNING id, created_at`, session.UserID, session.SchoolID, session.UserAgent, session.ClientIP).
+		Scan(&session.ID, &session.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if refreshHash != "" {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO auth_refresh_tokens (session_id, token_hash, expires_at)
+			VALUES ($1, $2, $3)`, session.ID, refreshHash, refreshExpiresAt)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Consume a refresh token and store its successor
+func (r *authSessionRepositoryImpl) RotateRefreshToken(ctx context.Context, oldHash, newHash string, newExpiresAt time.Time) (*entity.AuthSession, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var tokenID int64
+	var expiresAt time.Time
+	var rotatedAt sql.NullTime
+	var session entity.AuthSession
+	var userAgent, clientIP sql.NullString
+	var lastUsedAt, revokedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, `
+		SELECT t.id, t.expires_at, t.rotated_at,
+			s.id, s.user_id, s.user_agent, s.client_ip, s.created_at, s.last_used_at, s.revoked_at
+		FROM auth_refresh_tokens t
+		JOIN auth_sessions s ON s.id = t.session_id
+		WHERE t.token_hash = $1
+		FOR UPDATE OF t, s`, oldHash).
+		Scan(&tokenID, &expiresAt, &rotatedAt,
+			&session.ID, &session.UserID, &userAgent, &clientIP, &session.CreatedAt, &lastUsedAt, &revokedAt)
+	if err == sql.ErrNoRows {
+		return nil, entity.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if revokedAt.Valid || !time.Now().Before(expiresAt) {
+		return nil, entity.ErrInvalidRefreshToken
+	}
+
+	if rotatedAt.Valid {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE auth_sessions SET revoked_at = NOW(), revoke_reason = $2
+			WHERE id = $1 AND revoked_at IS NULL`, session.ID, entity.AuthSessionRevokedTokenReuse)
+		if err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return nil, entity.ErrRefreshTokenReused
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE auth_refresh_tokens SET rotated_at = NOW() WHERE id = $1`, tokenID); err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO auth_refresh_tokens (session_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)`, session.ID, newHash, newExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE auth_sessions SET last_used_at = NOW() WHERE id = $1`, session.ID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	session.UserAgent = userAgent.String
+	session.ClientIP = clientIP.String
+	now := time.Now()
+	session.LastUsedAt = &now
+	return &session, nil
+}
+
+// Whether a session of the user is still open
+func (r *authSessionRepositoryImpl) IsSessionActive(ctx context.Context, sessionID int64, userID int32) (bool, error) {
+	var active bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM auth_sessions WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+		)`, sessionID, userID).Scan(&active)
+	return active, err
+}
+
+// End one session of the user
+func (r *authSessionRepositoryImpl) RevokeSession(ctx context.Context, sessionID int64, userID int32, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE auth_sessions SET revoked_at = NOW(), revoke_reason = $3
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL`, sessionID, userID, reason)
+	return err
+}
+
+// End every session of the user except exceptSessionID
+func (r *authSessionRepositoryImpl) RevokeUserSessions(ctx context.Context, userID int32, exceptSessionID int64, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE auth_sessions SET revoked_at = NOW(), revoke_reason = $3
+		WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL`, userID, exceptSessionID, reason)
+	return err
+}
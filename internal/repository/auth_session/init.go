@@ -0,0 +1,29 @@
+package auth_session
+
+import (
+	"context"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// AuthSessionRepository defines the interface for local login sessions and their refresh tokens
+type AuthSessionRepository interface {
+	// Start a session with its first refresh token; fills session.ID and CreatedAt. Sessions
+	// without a refresh token (refreshHash empty) end when their access token expires.
+	CreateSession(ctx context.Context, session *entity.AuthSession, refreshHash string, refreshExpiresAt time.Time) error
+
+	// Consume a refresh token and store its successor. Returns entity.ErrInvalidRefreshToken for
+	// unknown, expired or revoked tokens and entity.ErrRefreshTokenReused (after revoking the
+	// session) for a token that was already rotated.
+	RotateRefreshToken(ctx context.Context, oldHash, newHash string, newExpiresAt time.Time) (*entity.AuthSession, error)
+
+	// Whether a session of the user is still open
+	IsSessionActive(ctx context.Context, sessionID int64, userID int32) (bool, error)
+
+	// End one session of the user
+	RevokeSession(ctx context.Context, sessionID int64, userID int32, reason string) error
+
+	// End every session of the user except exceptSessionID (0 for all)
+	RevokeUserSessions(ctx context.Context, userID int32, exceptSessionID int64, reason string) error
+}
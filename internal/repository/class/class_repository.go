@@ -1,6 +1,7 @@
 package class
 
 import (
+	"context"
 	"database/sql"
 
 	"cbt-test-mini-project/internal/entity"
@@ -12,6 +13,8 @@ type ClassRepository interface {
 	GetByLMSID(lmsClassID int64) (*entity.Class, error)
 	DeleteByLMSID(lmsClassID int64) error
 	List() ([]entity.Class, error)
+	AddTeacher(ctx context.Context, lmsClassID, lmsUserID int64) error
+	RemoveTeacher(ctx context.Context, lmsClassID, lmsUserID int64) error
 }
 
 type classRepository struct {
@@ -97,3 +100,21 @@ func (r *classRepository) List() ([]entity.Class, error) {
 	}
 	return classes, rows.Err()
 }
+
+// AddTeacher records that a user teaches a class (idempotent). class_teachers is protected
+// by row-level security, so ctx must carry the class's school or be cross-tenant.
+func (r *classRepository) AddTeacher(ctx context.Context, lmsClassID, lmsUserID int64) error {
+	query := `
+		INSERT INTO class_teachers (lms_class_id, lms_user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (lms_class_id, lms_user_id) DO NOTHING`
+	_, err := r.db.ExecContext(ctx, query, lmsClassID, lmsUserID)
+	return err
+}
+
+// RemoveTeacher removes a teaching assignment
+func (r *classRepository) RemoveTeacher(ctx context.Context, lmsClassID, lmsUserID int64) error {
+	query := `DELETE FROM class_teachers WHERE lms_class_id = $1 AND lms_user_id = $2`
+	_, err := r.db.ExecContext(ctx, query, lmsClassID, lmsUserID)
+	return err
+}
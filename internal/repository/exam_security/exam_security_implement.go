@@ -0,0 +1,633 @@
+package exam_security
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/teacherscope"
+
+	"github.com/lib/pq"
+)
+
+// examSecurityRepositoryImpl implements ExamSecurityRepository
+type examSecurityRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewExamSecurityRepository creates a new ExamSecurityRepository instance
+func NewExamSecurityRepository(db *sql.DB) ExamSecurityRepository {
+	return &examSecurityRepositoryImpl{db: db}
+}
+
+// Create or replace the SEB config of an assignment
+func (r *examSecurityRepositoryImpl) UpsertSebConfig(ctx context.Context, cfg *entity.SebConfig) error {
+	keys := cfg.BrowserExamKeys
+	if keys == nil {
+		keys = []string{}
+	}
+	keysJSON, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO assignment_seb_config (lms_assignment_id, file_name, config_raw, config_key, browser_exam_keys, is_active, created_by)
+		VALUES ($1, $2, $3, $4, $5::jsonb, TRUE, $6)
+		ON CONFLICT (lms_assignment_id) DO UPDATE
+		SET file_name = EXCLUDED.file_name,
+		    config_raw = EXCLUDED.config_raw,
+		    config_key = EXCLUDED.config_key,
+		    browser_exam_keys = EXCLUDED.browser_exam_keys,
+		    is_active = TRUE,
+		    created_by = EXCLUDED.created_by,
+		    updated_at = NOW()
+		RETURNING id, is_active, created_at, updated_at`
+	return r.db.QueryRowContext(ctx, query, cfg.LMSAssignmentID, cfg.FileName, cfg.ConfigRaw, cfg.ConfigKey, string(keysJSON), cfg.CreatedBy).
+		Scan(&cfg.ID, &cfg.IsActive, &cfg.CreatedAt, &cfg.UpdatedAt)
+}
+
+// Get SEB config by LMS assignment ID
+func (r *examSecurityRepositoryImpl) GetSebConfigByAssignment(ctx context.Context, lmsAssignmentID int64) (*entity.SebConfig, error) {
+	query := `
+		SELECT id, lms_assignment_id, COALESCE(file_name, ''), config_raw, config_key, browser_exam_keys, is_active, created_by, created_at, updated_at
+		FROM assignment_seb_config
+		WHERE lms_assignment_id = $1`
+	return r.scanSebConfig(ctx, r.db.QueryRowContext(ctx, query, lmsAssignmentID))
+}
+
+// Get the active SEB config that applies to a session token
+func (r *examSecurityRepositoryImpl) GetActiveSebConfigBySessionToken(ctx context.Context, token string) (*entity.SebConfig, error) {
+	query := `
+		SELECT c.id, c.lms_assignment_id, COALESCE(c.file_name, ''), c.config_raw, c.config_key, c.browser_exam_keys, c.is_active, c.created_by, c.created_at, c.updated_at
+		FROM test_session ts
+		JOIN assignment_seb_config c ON c.lms_assignment_id = ts.lms_assignment_id
+		WHERE ts.session_token = $1
+		  AND ts.deleted_at IS NULL
+		  AND c.is_active = TRUE`
+	return r.scanSebConfig(ctx, r.db.QueryRowContext(ctx, query, token))
+}
+
+// Delete SEB config of an assignment
+func (r *examSecurityRepositoryImpl) DeleteSebConfig(ctx context.Context, lmsAssignmentID int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM assignment_seb_config WHERE lms_assignment_id = $1`, lmsAssignmentID)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *examSecurityRepositoryImpl) scanSebConfig(ctx context.Context, row *sql.Row) (*entity.SebConfig, error) {
+	var cfg entity.SebConfig
+	var keysJSON []byte
+	var createdBy sql.NullInt64
+
+	err := row.Scan(&cfg.ID, &cfg.LMSAssignmentID, &cfg.FileName, &cfg.ConfigRaw, &cfg.ConfigKey, &keysJSON, &cfg.IsActive, &createdBy, &cfg.CreatedAt, &cfg.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keysJSON) > 0 {
+		if err := json.Unmarshal(keysJSON, &cfg.BrowserExamKeys); err != nil {
+			return nil, err
+		}
+	}
+	if createdBy.Valid {
+		v := int(createdBy.Int64)
+		cfg.CreatedBy = &v
+	}
+	return &cfg, nil
+}
+
+const deviceLeaseColumns = `id, id_test_session, device_id, COALESCE(user_agent, ''), COALESCE(ip_address, ''), status, attempt_count, issued_at, last_seen_at, released_at, approved_by, COALESCE(note, ''), COALESCE(secret_hash, '')`
+
+// Resolve session ID from token
+func (r *examSecurityRepositoryImpl) GetSessionIDByToken(ctx context.Context, token string) (int, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx, `SELECT id FROM test_session WHERE session_token = $1 AND deleted_at IS NULL`, token).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return id, err
+}
+
+// Resolve the user a session belongs to
+func (r *examSecurityRepositoryImpl) GetSessionOwnerByToken(ctx context.Context, token string) (int, error) {
+	var userID int
+	err := r.db.QueryRowContext(ctx, `SELECT COALESCE(user_id, 0) FROM test_session WHERE session_token = $1 AND deleted_at IS NULL`, token).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return userID, err
+}
+
+// Report whether the user teaches a class with sessions of the assignment
+func (r *examSecurityRepositoryImpl) TeachesAssignment(ctx context.Context, lmsAssignmentID int64, userID int) (bool, error) {
+	var teaches bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (
+		SELECT 1 FROM test_session ts
+		WHERE ts.lms_assignment_id = $1 AND ts.deleted_at IS NULL AND `+teacherscope.TaughtClassCondition("ts.lms_class_id", 2)+`)`,
+		lmsAssignmentID, userID).Scan(&teaches)
+	return teaches, err
+}
+
+// Report whether every answer exists and belongs to a session of a class the user teaches;
+// essays the user was assigned to mark count as taught
+func (r *examSecurityRepositoryImpl) TeachesAnswers(ctx context.Context, answerIDs []int, userID int) (bool, error) {
+	unique := make(map[int]bool, len(answerIDs))
+	for _, id := range answerIDs {
+		unique[id] = true
+	}
+	var taught int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT js.id)
+		FROM jawaban_siswa js
+		JOIN test_session_soal tss ON tss.id = js.id_test_session_soal
+		JOIN test_session ts ON ts.id = tss.id_test_session
+		WHERE js.id = ANY($1) AND (`+teacherscope.TaughtClassCondition("ts.lms_class_id", 2)+`
+			OR EXISTS (SELECT 1 FROM essay_grading_task egt WHERE egt.id_jawaban = js.id AND egt.grader_id = $2))`,
+		pq.Array(answerIDs), userID).Scan(&taught)
+	return taught == len(unique), err
+}
+
+// Report whether the session belongs to a class the user teaches
+func (r *examSecurityRepositoryImpl) TeachesSession(ctx context.Context, token string, userID int) (bool, error) {
+	var teaches bool
+	err := r.db.QueryRowContext(ctx, `SELECT EXISTS (
+		SELECT 1 FROM test_session ts
+		WHERE ts.session_token = $1 AND ts.deleted_at IS NULL AND `+teacherscope.TaughtClassCondition("ts.lms_class_id", 2)+`)`,
+		token, userID).Scan(&teaches)
+	return teaches, err
+}
+
+// Get the active device lease of a session
+func (r *examSecurityRepositoryImpl) GetActiveDeviceLease(ctx context.Context, sessionID int) (*entity.DeviceLease, error) {
+	query := `SELECT ` + deviceLeaseColumns + `
+		FROM test_session_device_lease
+		WHERE id_test_session = $1 AND status = 'active'`
+	lease, err := scanDeviceLease(r.db.QueryRowContext(ctx, query, sessionID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return lease, err
+}
+
+// Issue an active lease
+func (r *examSecurityRepositoryImpl) CreateDeviceLease(ctx context.Context, lease *entity.DeviceLease) (bool, error) {
+	query := `
+		INSERT INTO test_session_device_lease (id_test_session, device_id, user_agent, ip_address, status, secret_hash)
+		VALUES ($1, $2, $3, $4, 'active', $5)
+		ON CONFLICT (id_test_session) WHERE status = 'active' DO NOTHING
+		RETURNING id, status, attempt_count, issued_at, last_seen_at`
+	err := r.db.QueryRowContext(ctx, query, lease.IDTestSession, lease.DeviceID, lease.UserAgent, lease.IPAddress, lease.SecretHash).
+		Scan(&lease.ID, &lease.Status, &lease.AttemptCount, &lease.IssuedAt, &lease.LastSeenAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Refresh last_seen_at of a lease
+func (r *examSecurityRepositoryImpl) TouchDeviceLease(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE test_session_device_lease SET last_seen_at = NOW() WHERE id = $1`, id)
+	return err
+}
+
+// Set the secret of an active lease issued without one
+func (r *examSecurityRepositoryImpl) SetDeviceLeaseSecret(ctx context.Context, id int64, secretHash string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE test_session_device_lease
+		SET secret_hash = $2, last_seen_at = NOW()
+		WHERE id = $1 AND status = 'active' AND secret_hash IS NULL`, id, secretHash)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected == 1, err
+}
+
+// Record an attempt from a device that does not hold the lease
+func (r *examSecurityRepositoryImpl) RecordRejectedDevice(ctx context.Context, sessionID int, device entity.DeviceInfo, presentedHash, newHash string) (string, error) {
+	query := `
+		INSERT INTO test_session_device_lease (id_test_session, device_id, user_agent, ip_address, status, secret_hash)
+		VALUES ($1, $2, $3, $4, 'rejected', $6)
+		ON CONFLICT (id_test_session, device_id) WHERE status = 'rejected' DO UPDATE
+		SET attempt_count = test_session_device_lease.attempt_count + 1,
+		    user_agent = EXCLUDED.user_agent,
+		    ip_address = EXCLUDED.ip_address,
+		    last_seen_at = NOW(),
+		    secret_hash = CASE
+		        WHEN test_session_device_lease.secret_hash = $5 THEN test_session_device_lease.secret_hash
+		        ELSE EXCLUDED.secret_hash
+		    END
+		RETURNING secret_hash`
+	var stored string
+	err := r.db.QueryRowContext(ctx, query, sessionID, device.DeviceID, device.UserAgent, device.IPAddress, presentedHash, newHash).Scan(&stored)
+	return stored, err
+}
+
+// Close the rejected attempt as approved and the active lease as transferred, and issue a new
+// lease to the attempt's device
+func (r *examSecurityRepositoryImpl) TransferDeviceLease(ctx context.Context, sessionID int, rejectedID int64, approvedBy int, note string) (*entity.DeviceLease, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var device entity.DeviceInfo
+	var secretHash sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		UPDATE test_session_device_lease
+		SET status = 'approved', released_at = NOW(), approved_by = $3, note = NULLIF($4, '')
+		WHERE id = $2 AND id_test_session = $1 AND status = 'rejected'
+		RETURNING device_id, COALESCE(user_agent, ''), COALESCE(ip_address, ''), secret_hash`,
+		sessionID, rejectedID, approvedBy, note).Scan(&device.DeviceID, &device.UserAgent, &device.IPAddress, &secretHash)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("no pending device transfer for this session")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE test_session_device_lease
+		SET status = 'transferred', released_at = NOW(), approved_by = $2, note = NULLIF($3, '')
+		WHERE id_test_session = $1 AND status = 'active'`, sessionID, approvedBy, note)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO test_session_device_lease (id_test_session, device_id, user_agent, ip_address, status, approved_by, note, secret_hash)
+		VALUES ($1, $2, $3, $4, 'active', $5, NULLIF($6, ''), $7)
+		RETURNING ` + deviceLeaseColumns
+	lease, err := scanDeviceLease(tx.QueryRowContext(ctx, query, sessionID, device.DeviceID, device.UserAgent, device.IPAddress, approvedBy, note, secretHash))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// Get the most recent rejected attempt of a session
+func (r *examSecurityRepositoryImpl) GetLatestRejectedDevice(ctx context.Context, sessionID int) (*entity.DeviceLease, error) {
+	query := `SELECT ` + deviceLeaseColumns + `
+		FROM test_session_device_lease
+		WHERE id_test_session = $1 AND status = 'rejected'
+		ORDER BY last_seen_at DESC
+		LIMIT 1`
+	lease, err := scanDeviceLease(r.db.QueryRowContext(ctx, query, sessionID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return lease, err
+}
+
+// List lease history of a session
+func (r *examSecurityRepositoryImpl) ListDeviceLeases(ctx context.Context, sessionID int) ([]entity.DeviceLease, error) {
+	query := `SELECT ` + deviceLeaseColumns + `
+		FROM test_session_device_lease
+		WHERE id_test_session = $1
+		ORDER BY issued_at, id`
+	rows, err := r.db.QueryContext(ctx, query, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var leases []entity.DeviceLease
+	for rows.Next() {
+		lease, err := scanDeviceLease(rows)
+		if err != nil {
+			return nil, err
+		}
+		leases = append(leases, *lease)
+	}
+	return leases, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDeviceLease(row rowScanner) (*entity.DeviceLease, error) {
+	var lease entity.DeviceLease
+	var releasedAt sql.NullTime
+	var approvedBy sql.NullInt64
+
+	err := row.Scan(&lease.ID, &lease.IDTestSession, &lease.DeviceID, &lease.UserAgent, &lease.IPAddress, &lease.Status, &lease.AttemptCount, &lease.IssuedAt, &lease.LastSeenAt, &releasedAt, &approvedBy, &lease.Note, &lease.SecretHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if releasedAt.Valid {
+		lease.ReleasedAt = &releasedAt.Time
+	}
+	if approvedBy.Valid {
+		v := int(approvedBy.Int64)
+		lease.ApprovedBy = &v
+	}
+	return &lease, nil
+}
+
+// Get the network allow-lists and overrides that apply to a session token
+func (r *examSecurityRepositoryImpl) GetNetworkPolicyBySessionToken(ctx context.Context, token string) (*entity.NetworkPolicy, error) {
+	var policy entity.NetworkPolicy
+	var userID sql.NullInt64
+	var lmsSchoolID, lmsAssignmentID sql.NullInt64
+
+	query := `
+		SELECT ts.id, ts.user_id, COALESCE(c.school_id, mp.lms_school_id), ts.lms_assignment_id,
+		       EXISTS (
+		           SELECT 1 FROM network_allowlist_override o
+		           WHERE o.id_test_session = ts.id
+		             AND o.revoked_at IS NULL
+		             AND (o.expires_at IS NULL OR o.expires_at > NOW())
+		       )
+		FROM test_session ts
+		LEFT JOIN classes c ON c.id = ts.lms_class_id
+		LEFT JOIN mata_pelajaran mp ON mp.id = ts.id_mata_pelajaran
+		WHERE ts.session_token = $1 AND ts.deleted_at IS NULL`
+	err := r.db.QueryRowContext(ctx, query, token).Scan(&policy.SessionID, &userID, &lmsSchoolID, &lmsAssignmentID, &policy.OverrideActive)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if userID.Valid {
+		v := int(userID.Int64)
+		policy.UserID = &v
+	}
+	if lmsSchoolID.Valid {
+		policy.LMSSchoolID = &lmsSchoolID.Int64
+	}
+	if lmsAssignmentID.Valid {
+		policy.LMSAssignmentID = &lmsAssignmentID.Int64
+	}
+	if policy.LMSSchoolID == nil && policy.LMSAssignmentID == nil {
+		return &policy, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT cidr::text, lms_assignment_id IS NOT NULL
+		FROM network_allowlist
+		WHERE lms_assignment_id = $1 OR lms_school_id = $2`, lmsAssignmentID, lmsSchoolID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cidr string
+		var isAssignment bool
+		if err := rows.Scan(&cidr, &isAssignment); err != nil {
+			return nil, err
+		}
+		if isAssignment {
+			policy.AssignmentCIDRs = append(policy.AssignmentCIDRs, cidr)
+		} else {
+			policy.SchoolCIDRs = append(policy.SchoolCIDRs, cidr)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Replace the allow-list of a school or an assignment
+func (r *examSecurityRepositoryImpl) ReplaceNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64, entries []entity.NetworkAllowlistEntry) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if lmsAssignmentID != nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM network_allowlist WHERE lms_assignment_id = $1`, *lmsAssignmentID)
+	} else {
+		_, err = tx.ExecContext(ctx, `DELETE FROM network_allowlist WHERE lms_school_id = $1 AND lms_assignment_id IS NULL`, lmsSchoolID)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO network_allowlist (lms_school_id, lms_assignment_id, cidr, label, created_by)
+			VALUES ($1, $2, $3::cidr, NULLIF($4, ''), $5)
+			RETURNING id, created_at`,
+			entry.LMSSchoolID, entry.LMSAssignmentID, entry.CIDR, entry.Label, entry.CreatedBy,
+		).Scan(&entry.ID, &entry.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// List the allow-list of a school or an assignment
+func (r *examSecurityRepositoryImpl) ListNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64) ([]entity.NetworkAllowlistEntry, error) {
+	query := `
+		SELECT id, lms_school_id, lms_assignment_id, cidr::text, COALESCE(label, ''), created_by, created_at
+		FROM network_allowlist
+		WHERE lms_school_id = $1 AND lms_assignment_id IS NULL
+		ORDER BY id`
+	args := []interface{}{lmsSchoolID}
+	if lmsAssignmentID != nil {
+		query = `
+		SELECT id, lms_school_id, lms_assignment_id, cidr::text, COALESCE(label, ''), created_by, created_at
+		FROM network_allowlist
+		WHERE lms_assignment_id = $1
+		ORDER BY id`
+		args = []interface{}{*lmsAssignmentID}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []entity.NetworkAllowlistEntry
+	for rows.Next() {
+		var entry entity.NetworkAllowlistEntry
+		var schoolID, assignmentID, createdBy sql.NullInt64
+		if err := rows.Scan(&entry.ID, &schoolID, &assignmentID, &entry.CIDR, &entry.Label, &createdBy, &entry.CreatedAt); err != nil {
+			return nil, err
+		}
+		if schoolID.Valid {
+			entry.LMSSchoolID = &schoolID.Int64
+		}
+		if assignmentID.Valid {
+			entry.LMSAssignmentID = &assignmentID.Int64
+		}
+		if createdBy.Valid {
+			v := int(createdBy.Int64)
+			entry.CreatedBy = &v
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// Grant a network override for a session
+func (r *examSecurityRepositoryImpl) CreateNetworkOverride(ctx context.Context, override *entity.NetworkOverride) error {
+	query := `
+		INSERT INTO network_allowlist_override (id_test_session, reason, granted_by, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query, override.IDTestSession, override.Reason, override.GrantedBy, override.ExpiresAt).
+		Scan(&override.ID, &override.CreatedAt)
+}
+
+// Record a rejected call in the denied-access audit log
+func (r *examSecurityRepositoryImpl) RecordNetworkDenial(ctx context.Context, denial *entity.NetworkAccessDenial) error {
+	query := `
+		INSERT INTO network_access_denied_log (id_test_session, user_id, lms_school_id, lms_assignment_id, method, client_ip)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query, denial.IDTestSession, denial.UserID, denial.LMSSchoolID, denial.LMSAssignmentID, denial.Method, denial.ClientIP).
+		Scan(&denial.ID, &denial.CreatedAt)
+}
+
+// List denied-access audit log entries
+func (r *examSecurityRepositoryImpl) ListNetworkDenials(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64, scope *entity.TeacherScope, limit, offset int) ([]entity.NetworkAccessDenial, int, error) {
+	where := `WHERE ($1::bigint IS NULL OR lms_school_id = $1) AND ($2::bigint IS NULL OR lms_assignment_id = $2)`
+	args := []interface{}{lmsSchoolID, lmsAssignmentID}
+	if scope != nil {
+		args = append(args, scope.UserID)
+		where += ` AND id_test_session IN (
+			SELECT ts.id FROM test_session ts WHERE ` + teacherscope.TaughtClassCondition("ts.lms_class_id", len(args)) + `)`
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM network_access_denied_log `+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT id, id_test_session, user_id, lms_school_id, lms_assignment_id, method, client_ip, created_at
+		FROM network_access_denied_log ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $` + strconv.Itoa(len(args)+1) + ` OFFSET $` + strconv.Itoa(len(args)+2)
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var denials []entity.NetworkAccessDenial
+	for rows.Next() {
+		var denial entity.NetworkAccessDenial
+		var sessionID, userID, schoolID, assignmentID sql.NullInt64
+		if err := rows.Scan(&denial.ID, &sessionID, &userID, &schoolID, &assignmentID, &denial.Method, &denial.ClientIP, &denial.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		if sessionID.Valid {
+			v := int(sessionID.Int64)
+			denial.IDTestSession = &v
+		}
+		if userID.Valid {
+			v := int(userID.Int64)
+			denial.UserID = &v
+		}
+		if schoolID.Valid {
+			denial.LMSSchoolID = &schoolID.Int64
+		}
+		if assignmentID.Valid {
+			denial.LMSAssignmentID = &assignmentID.Int64
+		}
+		denials = append(denials, denial)
+	}
+	return denials, total, rows.Err()
+}
+
+// List the recorded answers of finished sessions of an assignment, grouped per session
+func (r *examSecurityRepositoryImpl) ListAssignmentAnswerPatterns(ctx context.Context, lmsAssignmentID int64, scope *entity.TeacherScope) ([]entity.SessionAnswerPattern, error) {
+	args := []interface{}{lmsAssignmentID}
+	scopeCondition := ""
+	if scope != nil {
+		args = append(args, scope.UserID)
+		scopeCondition = " AND " + teacherscope.TaughtClassCondition("ts.lms_class_id", len(args))
+	}
+
+	query := `
+		SELECT ts.id, ts.session_token, ts.user_id, COALESCE(ts.nama_peserta, ''),
+		       tss.question_type, COALESCE(tss.id_soal, tss.id_soal_drag_drop),
+		       COALESCE(NULLIF(js.jawaban_dipilih, ''), js.jawaban_dipilih_complex::text, js.jawaban_drag_drop::text, ''),
+		       js.is_correct, js.dijawab_pada,
+		       CASE WHEN tss.question_type = 'multiple_choice'
+		            THEN (SELECT COUNT(*) FROM soal_opsi so WHERE so.id_soal = tss.id_soal)
+		            ELSE 0 END
+		FROM test_session ts
+		JOIN test_session_soal tss ON tss.id_test_session = ts.id
+		JOIN jawaban_siswa js ON js.id_test_session_soal = tss.id
+		WHERE ts.lms_assignment_id = $1
+		  AND ts.status IN ('completed', 'timeout', 'grading_in_progress', 'graded')
+		  AND tss.question_type <> 'essay'` + scopeCondition + `
+		ORDER BY ts.id, tss.nomor_urut`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var patterns []entity.SessionAnswerPattern
+	for rows.Next() {
+		var sessionID int
+		var sessionToken, namaPeserta, questionType, response string
+		var userID, questionID sql.NullInt64
+		var isCorrect sql.NullBool
+		var answeredAt time.Time
+		var optionCount int
+		if err := rows.Scan(&sessionID, &sessionToken, &userID, &namaPeserta, &questionType, &questionID, &response, &isCorrect, &answeredAt, &optionCount); err != nil {
+			return nil, err
+		}
+		if !questionID.Valid || response == "" {
+			continue
+		}
+
+		if len(patterns) == 0 || patterns[len(patterns)-1].SessionID != sessionID {
+			pattern := entity.SessionAnswerPattern{SessionID: sessionID, SessionToken: sessionToken, NamaPeserta: namaPeserta}
+			if userID.Valid {
+				v := int(userID.Int64)
+				pattern.UserID = &v
+			}
+			patterns = append(patterns, pattern)
+		}
+
+		current := &patterns[len(patterns)-1]
+		current.Answers = append(current.Answers, entity.RecordedAnswer{
+			Item:        entity.AnswerItemKey{QuestionType: entity.QuestionType(questionType), QuestionID: int(questionID.Int64)},
+			Response:    response,
+			IsCorrect:   isCorrect.Valid && isCorrect.Bool,
+			AnsweredAt:  answeredAt,
+			OptionCount: optionCount,
+		})
+	}
+	return patterns, rows.Err()
+}
@@ -0,0 +1,87 @@
+package exam_security
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// ExamSecurityRepository defines the interface for exam lockdown settings (SEB, devices, networks)
+type ExamSecurityRepository interface {
+	// Create or replace the SEB config of an assignment
+	UpsertSebConfig(ctx context.Context, cfg *entity.SebConfig) error
+
+	// Get SEB config by LMS assignment ID (nil when not configured)
+	GetSebConfigByAssignment(ctx context.Context, lmsAssignmentID int64) (*entity.SebConfig, error)
+
+	// Get the active SEB config that applies to a session token (nil when not configured)
+	GetActiveSebConfigBySessionToken(ctx context.Context, token string) (*entity.SebConfig, error)
+
+	// Delete SEB config of an assignment
+	DeleteSebConfig(ctx context.Context, lmsAssignmentID int64) (bool, error)
+
+	// Resolve session ID from token (0 when not found)
+	GetSessionIDByToken(ctx context.Context, token string) (int, error)
+
+	// Resolve the user a session belongs to (0 when not found or unassigned)
+	GetSessionOwnerByToken(ctx context.Context, token string) (int, error)
+
+	// Report whether the user teaches a class with sessions of the assignment
+	TeachesAssignment(ctx context.Context, lmsAssignmentID int64, userID int) (bool, error)
+
+	// Report whether every answer exists and belongs to a session of a class the user teaches;
+	// essays the user was assigned to mark count as taught
+	TeachesAnswers(ctx context.Context, answerIDs []int, userID int) (bool, error)
+
+	// Report whether the session belongs to a class the user teaches
+	TeachesSession(ctx context.Context, token string, userID int) (bool, error)
+
+	// Get the active device lease of a session (nil when none issued yet)
+	GetActiveDeviceLease(ctx context.Context, sessionID int) (*entity.DeviceLease, error)
+
+	// Issue an active lease; returns false when another lease won the race
+	CreateDeviceLease(ctx context.Context, lease *entity.DeviceLease) (bool, error)
+
+	// Refresh last_seen_at of a lease
+	TouchDeviceLease(ctx context.Context, id int64) error
+
+	// Set the secret of an active lease issued without one; false when it already has one
+	SetDeviceLeaseSecret(ctx context.Context, id int64, secretHash string) (bool, error)
+
+	// Record (or count) an attempt from a device that does not hold the lease. The row keeps
+	// its secret while the device presents it (presentedHash) and takes newHash otherwise;
+	// the stored hash is returned.
+	RecordRejectedDevice(ctx context.Context, sessionID int, device entity.DeviceInfo, presentedHash, newHash string) (string, error)
+
+	// Close the rejected attempt as approved and the active lease as transferred, and issue
+	// a new lease to the attempt's device with its secret
+	TransferDeviceLease(ctx context.Context, sessionID int, rejectedID int64, approvedBy int, note string) (*entity.DeviceLease, error)
+
+	// Get the most recent rejected attempt of a session (nil when none)
+	GetLatestRejectedDevice(ctx context.Context, sessionID int) (*entity.DeviceLease, error)
+
+	// List lease history of a session, oldest first
+	ListDeviceLeases(ctx context.Context, sessionID int) ([]entity.DeviceLease, error)
+
+	// Get the network allow-lists and overrides that apply to a session token (nil when not found)
+	GetNetworkPolicyBySessionToken(ctx context.Context, token string) (*entity.NetworkPolicy, error)
+
+	// Replace the allow-list of a school or an assignment (exactly one must be set)
+	ReplaceNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64, entries []entity.NetworkAllowlistEntry) error
+
+	// List the allow-list of a school or an assignment
+	ListNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64) ([]entity.NetworkAllowlistEntry, error)
+
+	// Grant a network override for a session
+	CreateNetworkOverride(ctx context.Context, override *entity.NetworkOverride) error
+
+	// Record a rejected call in the denied-access audit log
+	RecordNetworkDenial(ctx context.Context, denial *entity.NetworkAccessDenial) error
+
+	// List denied-access audit log entries, newest first
+	ListNetworkDenials(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64, scope *entity.TeacherScope, limit, offset int) ([]entity.NetworkAccessDenial, int, error)
+
+	// List the recorded non-essay answers of every finished session of an assignment; a
+	// non-nil scope limits them to sessions of classes the teacher teaches
+	ListAssignmentAnswerPatterns(ctx context.Context, lmsAssignmentID int64, scope *entity.TeacherScope) ([]entity.SessionAnswerPattern, error)
+}
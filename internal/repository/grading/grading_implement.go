@@ -0,0 +1,1180 @@
+package grading
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/teacherscope"
+)
+
+// gradingRepositoryImpl implements GradingRepository
+type gradingRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewGradingRepository creates a new GradingRepository instance
+func NewGradingRepository(db *sql.DB) GradingRepository {
+	return &gradingRepositoryImpl{db: db}
+}
+
+const essayAnswerQuery = `
+	SELECT js.id, ts.id, ts.session_token, ts.user_id, COALESCE(ts.nama_peserta, ''), ts.lms_assignment_id, ts.lms_class_id,
+	       s.id, s.pertanyaan, s.jawaban_essay_key, COALESCE(js.jawaban_essay, ''), js.nilai_essay, js.feedback_teacher, js.dijawab_pada
+	FROM jawaban_siswa js
+	JOIN test_session_soal tss ON js.id_test_session_soal = tss.id
+	JOIN test_session ts ON tss.id_test_session = ts.id
+	JOIN soal s ON tss.id_soal = s.id`
+
+// Get an essay answer by ID
+func (r *gradingRepositoryImpl) GetEssayAnswer(ctx context.Context, answerID int) (*entity.EssayAnswerForGrading, error) {
+	rows, err := r.db.QueryContext(ctx, essayAnswerQuery+`
+		WHERE js.id = $1 AND tss.question_type = 'essay'`, answerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	answers, err := scanEssayAnswers(rows)
+	if err != nil || len(answers) == 0 {
+		return nil, err
+	}
+	return &answers[0], nil
+}
+
+// List essay answers of an assignment
+func (r *gradingRepositoryImpl) ListAssignmentEssays(ctx context.Context, lmsAssignmentID int64, soalID int) ([]entity.EssayAnswerForGrading, error) {
+	rows, err := r.db.QueryContext(ctx, essayAnswerQuery+`
+		WHERE ts.lms_assignment_id = $1
+		  AND tss.question_type = 'essay'
+		  AND ($2::int = 0 OR s.id = $2)
+		  AND COALESCE(TRIM(js.jawaban_essay), '') <> ''
+		ORDER BY s.id, js.id`, lmsAssignmentID, soalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanEssayAnswers(rows)
+}
+
+func scanEssayAnswers(rows *sql.Rows) ([]entity.EssayAnswerForGrading, error) {
+	var answers []entity.EssayAnswerForGrading
+	for rows.Next() {
+		var answer entity.EssayAnswerForGrading
+		var userID, assignmentID, classID sql.NullInt64
+		var answerKey, feedback sql.NullString
+		var nilai sql.NullFloat64
+		var dijawabPada sql.NullTime
+		err := rows.Scan(&answer.AnswerID, &answer.SessionID, &answer.SessionToken, &userID, &answer.NamaPeserta, &assignmentID, &classID,
+			&answer.SoalID, &answer.Pertanyaan, &answerKey, &answer.JawabanEssay, &nilai, &feedback, &dijawabPada)
+		if err != nil {
+			return nil, err
+		}
+		if userID.Valid {
+			v := int(userID.Int64)
+			answer.UserID = &v
+		}
+		if assignmentID.Valid {
+			answer.LMSAssignmentID = &assignmentID.Int64
+		}
+		if classID.Valid {
+			answer.LMSClassID = &classID.Int64
+		}
+		if answerKey.Valid {
+			answer.JawabanEssayKey = &answerKey.String
+		}
+		if nilai.Valid {
+			answer.NilaiEssay = &nilai.Float64
+		}
+		if feedback.Valid {
+			answer.FeedbackTeacher = &feedback.String
+		}
+		if dijawabPada.Valid {
+			answer.DijawabPada = &dijawabPada.Time
+		}
+		answers = append(answers, answer)
+	}
+	return answers, rows.Err()
+}
+
+// Replace similarity results of an assignment
+func (r *gradingRepositoryImpl) ReplaceEssaySimilarities(ctx context.Context, lmsAssignmentID int64, soalID int, results []entity.EssaySimilarity) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM essay_similarity WHERE lms_assignment_id = $1 AND ($2::int = 0 OR id_soal = $2)`, lmsAssignmentID, soalID)
+	if err != nil {
+		return err
+	}
+
+	for i := range results {
+		result := &results[i]
+		passages, err := json.Marshal(result.MatchedPassages)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO essay_similarity (id_jawaban, id_soal, lms_assignment_id, source, matched_id_jawaban, similarity, containment, matched_passages)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id, computed_at`,
+			result.IDJawaban, result.IDSoal, result.LMSAssignmentID, string(result.Source), result.MatchedIDJawaban,
+			result.Similarity, result.Containment, passages,
+		).Scan(&result.ID, &result.ComputedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// List similarity results of an answer
+func (r *gradingRepositoryImpl) ListEssaySimilarities(ctx context.Context, answerID int) ([]entity.EssaySimilarity, error) {
+	query := `
+		SELECT es.id, es.id_jawaban, es.id_soal, es.lms_assignment_id, es.source, es.matched_id_jawaban,
+		       es.similarity, es.containment, es.matched_passages, es.computed_at,
+		       COALESCE(ts.session_token, ''), COALESCE(ts.nama_peserta, '')
+		FROM essay_similarity es
+		LEFT JOIN jawaban_siswa js ON js.id = es.matched_id_jawaban
+		LEFT JOIN test_session_soal tss ON tss.id = js.id_test_session_soal
+		LEFT JOIN test_session ts ON ts.id = tss.id_test_session
+		WHERE es.id_jawaban = $1
+		ORDER BY es.source = 'answer_key' DESC, es.similarity DESC, es.containment DESC`
+	rows, err := r.db.QueryContext(ctx, query, answerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []entity.EssaySimilarity
+	for rows.Next() {
+		var result entity.EssaySimilarity
+		var source string
+		var matchedID sql.NullInt64
+		var passages []byte
+		err := rows.Scan(&result.ID, &result.IDJawaban, &result.IDSoal, &result.LMSAssignmentID, &source, &matchedID,
+			&result.Similarity, &result.Containment, &passages, &result.ComputedAt,
+			&result.MatchedSessionToken, &result.MatchedNamaPeserta)
+		if err != nil {
+			return nil, err
+		}
+		result.Source = entity.EssaySimilaritySource(source)
+		if matchedID.Valid {
+			v := int(matchedID.Int64)
+			result.MatchedIDJawaban = &v
+		}
+		if len(passages) > 0 {
+			if err := json.Unmarshal(passages, &result.MatchedPassages); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+// Get the question type of a soal
+func (r *gradingRepositoryImpl) GetSoalQuestionType(ctx context.Context, soalID int) (entity.QuestionType, error) {
+	var questionType string
+	err := r.db.QueryRowContext(ctx, `SELECT question_type FROM soal WHERE id = $1`, soalID).Scan(&questionType)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return entity.QuestionType(questionType), nil
+}
+
+// Get the rubric of an essay soal
+func (r *gradingRepositoryImpl) GetEssayRubric(ctx context.Context, soalID int) (*entity.EssayRubric, error) {
+	var rubric entity.EssayRubric
+	var updatedBy sql.NullInt64
+	err := r.db.QueryRowContext(ctx, `SELECT id_soal, passing_score, updated_by, updated_at FROM soal_rubric WHERE id_soal = $1`, soalID).
+		Scan(&rubric.IDSoal, &rubric.PassingScore, &updatedBy, &rubric.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if updatedBy.Valid {
+		v := int(updatedBy.Int64)
+		rubric.UpdatedBy = &v
+	}
+
+	query := `
+		SELECT c.id, c.id_soal, c.nama, c.deskripsi, c.urutan,
+		       l.id, l.label, l.deskripsi, l.point, l.urutan
+		FROM soal_rubric_criterion c
+		LEFT JOIN soal_rubric_level l ON l.id_criterion = c.id
+		WHERE c.id_soal = $1
+		ORDER BY c.urutan, c.id, l.urutan, l.id`
+	rows, err := r.db.QueryContext(ctx, query, soalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var criterion entity.RubricCriterion
+		var criterionDeskripsi, levelLabel, levelDeskripsi sql.NullString
+		var levelID, levelUrutan sql.NullInt64
+		var levelPoint sql.NullFloat64
+		err := rows.Scan(&criterion.ID, &criterion.IDSoal, &criterion.Nama, &criterionDeskripsi, &criterion.Urutan,
+			&levelID, &levelLabel, &levelDeskripsi, &levelPoint, &levelUrutan)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rubric.Criteria) == 0 || rubric.Criteria[len(rubric.Criteria)-1].ID != criterion.ID {
+			if criterionDeskripsi.Valid {
+				criterion.Deskripsi = &criterionDeskripsi.String
+			}
+			rubric.Criteria = append(rubric.Criteria, criterion)
+		}
+		if !levelID.Valid {
+			continue
+		}
+
+		level := entity.RubricLevel{
+			ID:          levelID.Int64,
+			IDCriterion: criterion.ID,
+			Label:       levelLabel.String,
+			Point:       levelPoint.Float64,
+			Urutan:      int(levelUrutan.Int64),
+		}
+		if levelDeskripsi.Valid {
+			level.Deskripsi = &levelDeskripsi.String
+		}
+		current := &rubric.Criteria[len(rubric.Criteria)-1]
+		current.Levels = append(current.Levels, level)
+	}
+	return &rubric, rows.Err()
+}
+
+// Replace the rubric of an essay soal
+func (r *gradingRepositoryImpl) ReplaceEssayRubric(ctx context.Context, rubric *entity.EssayRubric) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Criteria and levels cascade from soal_rubric
+	if _, err := tx.ExecContext(ctx, `DELETE FROM soal_rubric WHERE id_soal = $1`, rubric.IDSoal); err != nil {
+		return err
+	}
+	if len(rubric.Criteria) == 0 {
+		return tx.Commit()
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO soal_rubric (id_soal, passing_score, updated_by)
+		VALUES ($1, $2, $3)
+		RETURNING updated_at`,
+		rubric.IDSoal, rubric.PassingScore, rubric.UpdatedBy,
+	).Scan(&rubric.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	for i := range rubric.Criteria {
+		criterion := &rubric.Criteria[i]
+		criterion.IDSoal = rubric.IDSoal
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO soal_rubric_criterion (id_soal, nama, deskripsi, urutan)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id`,
+			rubric.IDSoal, criterion.Nama, criterion.Deskripsi, criterion.Urutan,
+		).Scan(&criterion.ID)
+		if err != nil {
+			return err
+		}
+
+		for j := range criterion.Levels {
+			level := &criterion.Levels[j]
+			level.IDCriterion = criterion.ID
+			err := tx.QueryRowContext(ctx, `
+				INSERT INTO soal_rubric_level (id_criterion, label, deskripsi, point, urutan)
+				VALUES ($1, $2, $3, $4, $5)
+				RETURNING id`,
+				criterion.ID, level.Label, level.Deskripsi, level.Point, level.Urutan,
+			).Scan(&level.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Store the final grade of an essay answer. The answer row stays locked until the session
+// has been recalculated, so concurrent grades of the same answer are applied one at a time.
+func (r *gradingRepositoryImpl) SaveEssayGrade(ctx context.Context, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error) {
+	if grade.NilaiEssay < 0 || grade.NilaiEssay > 100 {
+		return nil, errors.New("score must be between 0 and 100")
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	change, err := saveEssayGrade(ctx, tx, grade)
+	if err != nil {
+		return nil, err
+	}
+	return change, tx.Commit()
+}
+
+// querier runs statements on a *sql.DB or inside a *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func saveEssayGrade(ctx context.Context, q querier, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error) {
+	change := &entity.EssayGradeChange{}
+	before, token, err := getEssayGrade(ctx, q, grade.AnswerID, true)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil {
+		return nil, errors.New("essay answer not found")
+	}
+	change.Before = before
+	change.SessionToken = token
+
+	// An essay counts as correct once it reaches the passing score of its rubric
+	_, err = q.ExecContext(ctx, `
+		UPDATE jawaban_siswa js
+		SET nilai_essay = $1,
+		    feedback_teacher = $2,
+		    is_correct = $1 >= COALESCE((
+		        SELECT sr.passing_score
+		        FROM test_session_soal tss
+		        JOIN soal_rubric sr ON sr.id_soal = tss.id_soal
+		        WHERE tss.id = js.id_test_session_soal
+		    ), $3)
+		WHERE js.id = $4`,
+		grade.NilaiEssay, strings.TrimSpace(grade.Feedback), entity.DefaultEssayPassingScore, grade.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := replaceRubricScores(ctx, q, grade.AnswerID, grade.RubricScores); err != nil {
+		return nil, err
+	}
+
+	// Every final grade says whether it took the suggestion, so a later override clears it
+	_, err = q.ExecContext(ctx, `UPDATE essay_score_suggestion SET accepted = $1 WHERE id_jawaban = $2`,
+		grade.AcceptedSuggestion, grade.AnswerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A final grade ends double marking, however it was given: a pending moderation is
+	// closed with it and tasks nobody submitted leave the graders' queues
+	var note *string
+	if text := strings.TrimSpace(grade.ModerationNote); text != "" {
+		note = &text
+	}
+	_, err = q.ExecContext(ctx, `
+		UPDATE essay_moderation
+		SET status = $1, final_score = $2, moderator_id = $3, note = COALESCE($4, note), resolved_at = NOW()
+		WHERE id_jawaban = $5 AND status = $6`,
+		string(entity.EssayModerationResolved), grade.NilaiEssay, grade.GradedBy, note, grade.AnswerID, string(entity.EssayModerationPending))
+	if err != nil {
+		return nil, err
+	}
+	_, err = q.ExecContext(ctx, `DELETE FROM essay_grading_task WHERE id_jawaban = $1 AND status = $2`,
+		grade.AnswerID, string(entity.GradingTaskAssigned))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recalculateSession(ctx, q, token); err != nil {
+		return nil, err
+	}
+
+	if change.After, _, err = getEssayGrade(ctx, q, grade.AnswerID, false); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// getEssayGrade returns the grade of an essay answer and the token of its session; lock
+// keeps the answer row locked until the transaction ends
+func getEssayGrade(ctx context.Context, q querier, answerID int, lock bool) (*entity.EssayGrade, string, error) {
+	query := `
+		SELECT js.nilai_essay, js.feedback_teacher, js.is_correct, ts.session_token
+		FROM jawaban_siswa js
+		JOIN test_session_soal tss ON js.id_test_session_soal = tss.id
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		WHERE js.id = $1 AND js.question_type = $2`
+	if lock {
+		query += ` FOR UPDATE OF js`
+	}
+
+	var grade entity.EssayGrade
+	var nilai sql.NullFloat64
+	var feedback sql.NullString
+	var token string
+	err := q.QueryRowContext(ctx, query, answerID, string(entity.QuestionTypeEssay)).
+		Scan(&nilai, &feedback, &grade.IsCorrect, &token)
+	if err == sql.ErrNoRows {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if nilai.Valid {
+		grade.NilaiEssay = &nilai.Float64
+	}
+	if feedback.Valid {
+		grade.FeedbackTeacher = &feedback.String
+	}
+	return &grade, token, nil
+}
+
+func replaceRubricScores(ctx context.Context, q querier, answerID int, scores []entity.JawabanRubricScore) error {
+	if _, err := q.ExecContext(ctx, `DELETE FROM jawaban_rubric_score WHERE id_jawaban = $1`, answerID); err != nil {
+		return err
+	}
+
+	for i := range scores {
+		score := &scores[i]
+		score.IDJawaban = answerID
+		err := q.QueryRowContext(ctx, `
+			INSERT INTO jawaban_rubric_score (id_jawaban, id_criterion, id_level, nama_kriteria, label_level, point, max_point, feedback, urutan, graded_by)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING id, graded_at`,
+			answerID, score.IDCriterion, score.IDLevel, score.NamaKriteria, score.LabelLevel,
+			score.Point, score.MaxPoint, score.Feedback, score.Urutan, score.GradedBy,
+		).Scan(&score.ID, &score.GradedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recalculateSession recomputes the score of a session and moves it to graded once no
+// answered essay is left without a score
+func recalculateSession(ctx context.Context, q querier, token string) error {
+	_, err := q.ExecContext(ctx, `
+		WITH score_calc AS (
+			SELECT ts.id AS session_id,
+				COUNT(tss.id)::int AS total_questions,
+				SUM(COALESCE(tss.point, 1.0)) AS total_weight,
+				SUM(
+					CASE
+						WHEN tss.question_type = 'essay' THEN (COALESCE(js.nilai_essay, 0) / 100.0) * COALESCE(tss.point, 1.0)
+						WHEN js.nilai_parsial IS NOT NULL THEN (js.nilai_parsial / 100.0) * COALESCE(tss.point, 1.0)
+						WHEN js.is_correct THEN COALESCE(tss.point, 1.0)
+						ELSE 0.0
+					END
+				) AS total_points,
+				SUM(
+					CASE
+						WHEN tss.question_type = 'essay' AND COALESCE(js.nilai_essay, 0) >= COALESCE(sr.passing_score, $2) THEN 1
+						WHEN tss.question_type <> 'essay' AND js.is_correct THEN 1
+						ELSE 0
+					END
+				)::int AS total_correct,
+				SUM(
+					CASE
+						WHEN tss.question_type = 'essay' AND js.nilai_essay IS NULL AND COALESCE(TRIM(js.jawaban_essay), '') <> '' THEN 1
+						ELSE 0
+					END
+				)::int AS pending_essay
+			FROM test_session ts
+			JOIN test_session_soal tss ON tss.id_test_session = ts.id
+			LEFT JOIN jawaban_siswa js ON js.id_test_session_soal = tss.id
+			LEFT JOIN soal_rubric sr ON sr.id_soal = tss.id_soal
+			WHERE ts.session_token = $1
+			GROUP BY ts.id
+		)
+		UPDATE test_session ts
+		SET nilai_akhir = CASE WHEN sc.total_weight > 0 THEN (sc.total_points / sc.total_weight) * 100 ELSE 0 END,
+		    jumlah_benar = sc.total_correct,
+		    total_soal = sc.total_questions,
+		    status = CASE WHEN sc.pending_essay > 0 THEN 'grading_in_progress' ELSE 'graded' END
+		FROM score_calc sc
+		WHERE ts.id = sc.session_id`, token, entity.DefaultEssayPassingScore)
+	return err
+}
+
+// List the per-criterion scores of an answer
+func (r *gradingRepositoryImpl) ListRubricScores(ctx context.Context, answerID int) ([]entity.JawabanRubricScore, error) {
+	query := `
+		SELECT id, id_jawaban, id_criterion, id_level, nama_kriteria, label_level, point, max_point, feedback, urutan, graded_by, graded_at
+		FROM jawaban_rubric_score
+		WHERE id_jawaban = $1
+		ORDER BY urutan, id`
+	rows, err := r.db.QueryContext(ctx, query, answerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []entity.JawabanRubricScore
+	for rows.Next() {
+		score, err := scanRubricScore(rows)
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, *score)
+	}
+	return scores, rows.Err()
+}
+
+func scanRubricScore(rows *sql.Rows) (*entity.JawabanRubricScore, error) {
+	var score entity.JawabanRubricScore
+	var feedback sql.NullString
+	var gradedBy sql.NullInt64
+	err := rows.Scan(&score.ID, &score.IDJawaban, &score.IDCriterion, &score.IDLevel, &score.NamaKriteria, &score.LabelLevel,
+		&score.Point, &score.MaxPoint, &feedback, &score.Urutan, &gradedBy, &score.GradedAt)
+	if err != nil {
+		return nil, err
+	}
+	if feedback.Valid {
+		score.Feedback = &feedback.String
+	}
+	if gradedBy.Valid {
+		v := int(gradedBy.Int64)
+		score.GradedBy = &v
+	}
+	return &score, nil
+}
+
+// pendingEssayCondition selects answered essays of finished sessions that have no score yet
+const pendingEssayCondition = `tss.question_type = 'essay'
+		  AND js.nilai_essay IS NULL
+		  AND COALESCE(TRIM(js.jawaban_essay), '') <> ''
+		  AND ts.status IN ('completed', 'grading_in_progress')`
+
+// Get the grading settings of an assignment
+func (r *gradingRepositoryImpl) GetGradingConfig(ctx context.Context, lmsAssignmentID int64) (*entity.GradingConfig, error) {
+	var config entity.GradingConfig
+	var updatedBy sql.NullInt64
+	query := `
+		SELECT lms_assignment_id, blind_mode, double_marking, discrepancy_threshold, updated_by, updated_at
+		FROM grading_config
+		WHERE lms_assignment_id = $1`
+	err := r.db.QueryRowContext(ctx, query, lmsAssignmentID).Scan(&config.LMSAssignmentID, &config.BlindMode, &config.DoubleMarking,
+		&config.DiscrepancyThreshold, &updatedBy, &config.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if updatedBy.Valid {
+		v := int(updatedBy.Int64)
+		config.UpdatedBy = &v
+	}
+	return &config, nil
+}
+
+// Create or update the grading settings of an assignment
+func (r *gradingRepositoryImpl) UpsertGradingConfig(ctx context.Context, config *entity.GradingConfig) error {
+	query := `
+		INSERT INTO grading_config (lms_assignment_id, blind_mode, double_marking, discrepancy_threshold, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (lms_assignment_id) DO UPDATE
+		SET blind_mode = EXCLUDED.blind_mode,
+		    double_marking = EXCLUDED.double_marking,
+		    discrepancy_threshold = EXCLUDED.discrepancy_threshold,
+		    updated_by = EXCLUDED.updated_by,
+		    updated_at = NOW()
+		RETURNING updated_at`
+	return r.db.QueryRowContext(ctx, query, config.LMSAssignmentID, config.BlindMode, config.DoubleMarking, config.DiscrepancyThreshold, config.UpdatedBy).
+		Scan(&config.UpdatedAt)
+}
+
+// List ungraded essays with their grading tasks and moderation
+func (r *gradingRepositoryImpl) ListPendingEssays(ctx context.Context, filter entity.PendingEssayFilter, limit, offset int) ([]entity.PendingEssay, int, error) {
+	conditions := []string{pendingEssayCondition}
+	var args []interface{}
+
+	if filter.LMSAssignmentID > 0 {
+		args = append(args, filter.LMSAssignmentID)
+		conditions = append(conditions, fmt.Sprintf("ts.lms_assignment_id = $%d", len(args)))
+	}
+	if filter.LMSClassID > 0 {
+		args = append(args, filter.LMSClassID)
+		conditions = append(conditions, fmt.Sprintf("ts.lms_class_id = $%d", len(args)))
+	}
+	if filter.SoalID > 0 {
+		args = append(args, filter.SoalID)
+		conditions = append(conditions, fmt.Sprintf("s.id = $%d", len(args)))
+	}
+	if filter.GraderID > 0 {
+		args = append(args, filter.GraderID, string(entity.GradingTaskAssigned))
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM essay_grading_task egt WHERE egt.id_jawaban = js.id AND egt.grader_id = $%d AND egt.status = $%d)",
+			len(args)-1, len(args)))
+	}
+	if filter.InModeration {
+		args = append(args, string(entity.EssayModerationPending))
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM essay_moderation em WHERE em.id_jawaban = js.id AND em.status = $%d)", len(args)))
+	}
+	if len(filter.AnswerIDs) > 0 {
+		placeholders := make([]string, len(filter.AnswerIDs))
+		for i, id := range filter.AnswerIDs {
+			args = append(args, id)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, "js.id IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if filter.Scope != nil {
+		args = append(args, filter.Scope.UserID)
+		conditions = append(conditions, fmt.Sprintf(
+			"(%s OR EXISTS (SELECT 1 FROM essay_grading_task egt WHERE egt.id_jawaban = js.id AND egt.grader_id = $%d))",
+			teacherscope.TaughtClassCondition("ts.lms_class_id", len(args)), len(args)))
+	}
+	whereClause := strings.Join(conditions, " AND ")
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM jawaban_siswa js
+		JOIN test_session_soal tss ON js.id_test_session_soal = tss.id
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		JOIN soal s ON tss.id_soal = s.id
+		WHERE ` + whereClause
+	var total int
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// A non-positive limit returns every pending essay
+	query := essayAnswerQuery + `
+		WHERE ` + whereClause + `
+		ORDER BY js.dijawab_pada NULLS LAST, js.id`
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+		args = append(args, limit, offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	answers, err := scanEssayAnswers(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(answers) == 0 {
+		return nil, total, nil
+	}
+
+	answerIDs := make([]interface{}, len(answers))
+	placeholders := make([]string, len(answers))
+	for i, answer := range answers {
+		answerIDs[i] = answer.AnswerID
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	inClause := strings.Join(placeholders, ", ")
+
+	tasks, err := queryGradingTasks(ctx, r.db, `WHERE id_jawaban IN (`+inClause+`)`, answerIDs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	moderations, err := queryEssayModerations(ctx, r.db, `WHERE id_jawaban IN (`+inClause+`)`, answerIDs...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	essays := make([]entity.PendingEssay, len(answers))
+	index := make(map[int]int, len(answers))
+	for i, answer := range answers {
+		essays[i].Answer = answer
+		index[answer.AnswerID] = i
+	}
+	for _, task := range tasks {
+		essay := &essays[index[task.IDJawaban]]
+		essay.Tasks = append(essay.Tasks, task)
+	}
+	for i := range moderations {
+		essays[index[moderations[i].IDJawaban]].Moderation = &moderations[i]
+	}
+
+	suggestions, err := r.queryScoreSuggestions(ctx, `WHERE id_jawaban IN (`+inClause+`)`, answerIDs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range suggestions {
+		essays[index[suggestions[i].IDJawaban]].Suggestion = &suggestions[i]
+	}
+	return essays, total, nil
+}
+
+// Run fn in one transaction holding the answer and its grading tasks locked
+func (r *gradingRepositoryImpl) GradeEssayInTx(ctx context.Context, answerID int, fn func(tx EssayGradingTx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The answer row is locked first by every grade write, so marks of one essay queue up
+	// behind each other instead of each acting on what the other has not committed yet
+	var id int
+	err = tx.QueryRowContext(ctx, `SELECT id FROM jawaban_siswa WHERE id = $1 AND question_type = $2 FOR UPDATE`,
+		answerID, string(entity.QuestionTypeEssay)).Scan(&id)
+	if err == sql.ErrNoRows {
+		return errors.New("essay answer not found")
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM essay_grading_task WHERE id_jawaban = $1 FOR UPDATE`, answerID); err != nil {
+		return err
+	}
+
+	if err := fn(&gradingTx{q: tx, answerID: answerID}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// gradingTx implements EssayGradingTx for the answer locked by GradeEssayInTx
+type gradingTx struct {
+	q        querier
+	answerID int
+}
+
+func (t *gradingTx) GetEssayGrade(ctx context.Context) (*entity.EssayGrade, error) {
+	grade, _, err := getEssayGrade(ctx, t.q, t.answerID, false)
+	return grade, err
+}
+
+func (t *gradingTx) ListGradingTasks(ctx context.Context) ([]entity.GradingTask, error) {
+	return queryGradingTasks(ctx, t.q, `WHERE id_jawaban = $1`, t.answerID)
+}
+
+func (t *gradingTx) SubmitGradingTask(ctx context.Context, task *entity.GradingTask) error {
+	if task.IDJawaban != t.answerID {
+		return errors.New("grading task not found")
+	}
+	return submitGradingTask(ctx, t.q, task)
+}
+
+func (t *gradingTx) GetEssayModeration(ctx context.Context) (*entity.EssayModeration, error) {
+	moderations, err := queryEssayModerations(ctx, t.q, `WHERE id_jawaban = $1`, t.answerID)
+	if err != nil || len(moderations) == 0 {
+		return nil, err
+	}
+	return &moderations[0], nil
+}
+
+func (t *gradingTx) CreateEssayModeration(ctx context.Context, moderation *entity.EssayModeration) error {
+	moderation.IDJawaban = t.answerID
+	moderation.Status = entity.EssayModerationPending
+	query := `
+		INSERT INTO essay_moderation (id_jawaban, lms_assignment_id, status, discrepancy)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id_jawaban) DO UPDATE
+		SET status = EXCLUDED.status,
+		    discrepancy = EXCLUDED.discrepancy,
+		    final_score = NULL,
+		    moderator_id = NULL,
+		    note = NULL,
+		    created_at = NOW(),
+		    resolved_at = NULL
+		RETURNING created_at`
+	return t.q.QueryRowContext(ctx, query, moderation.IDJawaban, moderation.LMSAssignmentID, string(moderation.Status), moderation.Discrepancy).
+		Scan(&moderation.CreatedAt)
+}
+
+func (t *gradingTx) SaveEssayGrade(ctx context.Context, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error) {
+	if grade.NilaiEssay < 0 || grade.NilaiEssay > 100 {
+		return nil, errors.New("score must be between 0 and 100")
+	}
+	grade.AnswerID = t.answerID
+	return saveEssayGrade(ctx, t.q, grade)
+}
+
+func queryGradingTasks(ctx context.Context, q querier, where string, args ...interface{}) ([]entity.GradingTask, error) {
+	query := `
+		SELECT id, id_jawaban, lms_assignment_id, grader_id, marker_slot, status, score, feedback, rubric_scores,
+		       assigned_by, assigned_at, submitted_at
+		FROM essay_grading_task ` + where + `
+		ORDER BY id_jawaban, marker_slot`
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entity.GradingTask
+	for rows.Next() {
+		var task entity.GradingTask
+		var taskStatus string
+		var score sql.NullFloat64
+		var feedback sql.NullString
+		var rubricScores []byte
+		var assignedBy sql.NullInt64
+		var submittedAt sql.NullTime
+		err := rows.Scan(&task.ID, &task.IDJawaban, &task.LMSAssignmentID, &task.GraderID, &task.MarkerSlot, &taskStatus,
+			&score, &feedback, &rubricScores, &assignedBy, &task.AssignedAt, &submittedAt)
+		if err != nil {
+			return nil, err
+		}
+		task.Status = entity.GradingTaskStatus(taskStatus)
+		if score.Valid {
+			task.Score = &score.Float64
+		}
+		if feedback.Valid {
+			task.Feedback = &feedback.String
+		}
+		if len(rubricScores) > 0 {
+			if err := json.Unmarshal(rubricScores, &task.RubricScores); err != nil {
+				return nil, err
+			}
+		}
+		if assignedBy.Valid {
+			v := int(assignedBy.Int64)
+			task.AssignedBy = &v
+		}
+		if submittedAt.Valid {
+			task.SubmittedAt = &submittedAt.Time
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+// Create grading tasks
+func (r *gradingRepositoryImpl) CreateGradingTasks(ctx context.Context, tasks []entity.GradingTask) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i := range tasks {
+		task := &tasks[i]
+		task.Status = entity.GradingTaskAssigned
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO essay_grading_task (id_jawaban, lms_assignment_id, grader_id, marker_slot, status, assigned_by)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id, assigned_at`,
+			task.IDJawaban, task.LMSAssignmentID, task.GraderID, task.MarkerSlot, string(task.Status), task.AssignedBy,
+		).Scan(&task.ID, &task.AssignedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func submitGradingTask(ctx context.Context, q querier, task *entity.GradingTask) error {
+	var rubricScores []byte
+	if len(task.RubricScores) > 0 {
+		var err error
+		if rubricScores, err = json.Marshal(task.RubricScores); err != nil {
+			return err
+		}
+	}
+
+	task.Status = entity.GradingTaskSubmitted
+	query := `
+		UPDATE essay_grading_task
+		SET status = $1, score = $2, feedback = $3, rubric_scores = $4, submitted_at = NOW()
+		WHERE id = $5
+		RETURNING submitted_at`
+	var submittedAt time.Time
+	err := q.QueryRowContext(ctx, query, string(task.Status), task.Score, task.Feedback, rubricScores, task.ID).Scan(&submittedAt)
+	if err == sql.ErrNoRows {
+		return errors.New("grading task not found")
+	}
+	if err != nil {
+		return err
+	}
+	task.SubmittedAt = &submittedAt
+	return nil
+}
+
+func queryEssayModerations(ctx context.Context, q querier, where string, args ...interface{}) ([]entity.EssayModeration, error) {
+	query := `
+		SELECT id_jawaban, lms_assignment_id, status, discrepancy, final_score, moderator_id, note, created_at, resolved_at
+		FROM essay_moderation ` + where
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var moderations []entity.EssayModeration
+	for rows.Next() {
+		var moderation entity.EssayModeration
+		var moderationStatus string
+		var finalScore sql.NullFloat64
+		var moderatorID sql.NullInt64
+		var note sql.NullString
+		var resolvedAt sql.NullTime
+		err := rows.Scan(&moderation.IDJawaban, &moderation.LMSAssignmentID, &moderationStatus, &moderation.Discrepancy,
+			&finalScore, &moderatorID, &note, &moderation.CreatedAt, &resolvedAt)
+		if err != nil {
+			return nil, err
+		}
+		moderation.Status = entity.EssayModerationStatus(moderationStatus)
+		if finalScore.Valid {
+			moderation.FinalScore = &finalScore.Float64
+		}
+		if moderatorID.Valid {
+			v := int(moderatorID.Int64)
+			moderation.ModeratorID = &v
+		}
+		if note.Valid {
+			moderation.Note = &note.String
+		}
+		if resolvedAt.Valid {
+			moderation.ResolvedAt = &resolvedAt.Time
+		}
+		moderations = append(moderations, moderation)
+	}
+	return moderations, rows.Err()
+}
+
+// Count the essays and sessions of an assignment by grading state
+func (r *gradingRepositoryImpl) GetGradingProgress(ctx context.Context, lmsAssignmentID int64) (*entity.GradingProgress, error) {
+	progress := &entity.GradingProgress{LMSAssignmentID: lmsAssignmentID}
+
+	essayQuery := `
+		SELECT COUNT(*)::int,
+		       COUNT(*) FILTER (WHERE js.nilai_essay IS NOT NULL)::int,
+		       COUNT(*) FILTER (WHERE js.nilai_essay IS NULL)::int,
+		       COUNT(*) FILTER (WHERE js.nilai_essay IS NULL
+		           AND NOT EXISTS (SELECT 1 FROM essay_grading_task egt WHERE egt.id_jawaban = js.id))::int,
+		       COUNT(*) FILTER (WHERE js.nilai_essay IS NULL
+		           AND EXISTS (SELECT 1 FROM essay_grading_task egt WHERE egt.id_jawaban = js.id AND egt.status = 'submitted')
+		           AND NOT EXISTS (SELECT 1 FROM essay_moderation em WHERE em.id_jawaban = js.id AND em.status = 'pending'))::int,
+		       COUNT(*) FILTER (WHERE js.nilai_essay IS NULL
+		           AND EXISTS (SELECT 1 FROM essay_moderation em WHERE em.id_jawaban = js.id AND em.status = 'pending'))::int
+		FROM jawaban_siswa js
+		JOIN test_session_soal tss ON js.id_test_session_soal = tss.id
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		WHERE ts.lms_assignment_id = $1
+		  AND tss.question_type = 'essay'
+		  AND COALESCE(TRIM(js.jawaban_essay), '') <> ''
+		  AND ts.status IN ('completed', 'grading_in_progress', 'graded')`
+	err := r.db.QueryRowContext(ctx, essayQuery, lmsAssignmentID).Scan(&progress.TotalEssays, &progress.GradedEssays, &progress.PendingEssays,
+		&progress.UnassignedEssays, &progress.AwaitingSecondMark, &progress.InModeration)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionQuery := `
+		SELECT COUNT(*) FILTER (WHERE status = 'grading_in_progress')::int,
+		       COUNT(*) FILTER (WHERE status = 'graded')::int
+		FROM test_session
+		WHERE lms_assignment_id = $1`
+	if err := r.db.QueryRowContext(ctx, sessionQuery, lmsAssignmentID).Scan(&progress.SessionsGradingInProgress, &progress.SessionsGraded); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// List the keywords of an essay soal
+func (r *gradingRepositoryImpl) ListEssayKeywords(ctx context.Context, soalID int) ([]entity.EssayKeyword, error) {
+	query := `
+		SELECT id, id_soal, keyword, synonyms, weight, urutan
+		FROM soal_essay_keyword
+		WHERE id_soal = $1
+		ORDER BY urutan, id`
+	rows, err := r.db.QueryContext(ctx, query, soalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keywords []entity.EssayKeyword
+	for rows.Next() {
+		var keyword entity.EssayKeyword
+		var synonyms []byte
+		if err := rows.Scan(&keyword.ID, &keyword.IDSoal, &keyword.Keyword, &synonyms, &keyword.Weight, &keyword.Urutan); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(synonyms, &keyword.Synonyms); err != nil {
+			return nil, err
+		}
+		keywords = append(keywords, keyword)
+	}
+	return keywords, rows.Err()
+}
+
+// Replace the keywords of an essay soal
+func (r *gradingRepositoryImpl) ReplaceEssayKeywords(ctx context.Context, soalID int, keywords []entity.EssayKeyword) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM soal_essay_keyword WHERE id_soal = $1`, soalID); err != nil {
+		return err
+	}
+
+	for i := range keywords {
+		keyword := &keywords[i]
+		keyword.IDSoal = soalID
+		synonyms, err := json.Marshal(keyword.Synonyms)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO soal_essay_keyword (id_soal, keyword, synonyms, weight, urutan)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id`,
+			soalID, keyword.Keyword, synonyms, keyword.Weight, keyword.Urutan,
+		).Scan(&keyword.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Create or replace score suggestions
+func (r *gradingRepositoryImpl) UpsertScoreSuggestions(ctx context.Context, suggestions []entity.EssayScoreSuggestion) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO essay_score_suggestion (id_jawaban, id_soal, suggested_score, keyword_coverage, reference_overlap,
+		                                    keyword_matches, missing_keywords, reference_passages, computed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT (id_jawaban) DO UPDATE
+		SET id_soal = EXCLUDED.id_soal,
+		    suggested_score = EXCLUDED.suggested_score,
+		    keyword_coverage = EXCLUDED.keyword_coverage,
+		    reference_overlap = EXCLUDED.reference_overlap,
+		    keyword_matches = EXCLUDED.keyword_matches,
+		    missing_keywords = EXCLUDED.missing_keywords,
+		    reference_passages = EXCLUDED.reference_passages,
+		    accepted = essay_score_suggestion.accepted AND essay_score_suggestion.suggested_score = EXCLUDED.suggested_score,
+		    computed_at = NOW()
+		RETURNING computed_at`
+	for i := range suggestions {
+		suggestion := &suggestions[i]
+		matches, err := json.Marshal(suggestion.KeywordMatches)
+		if err != nil {
+			return err
+		}
+		missing, err := json.Marshal(suggestion.MissingKeywords)
+		if err != nil {
+			return err
+		}
+		passages, err := json.Marshal(suggestion.ReferencePassages)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, query, suggestion.IDJawaban, suggestion.IDSoal, suggestion.SuggestedScore, suggestion.KeywordCoverage,
+			suggestion.ReferenceOverlap, matches, missing, passages).Scan(&suggestion.ComputedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Get the score suggestion of an answer
+func (r *gradingRepositoryImpl) GetScoreSuggestion(ctx context.Context, answerID int) (*entity.EssayScoreSuggestion, error) {
+	suggestions, err := r.queryScoreSuggestions(ctx, `WHERE id_jawaban = $1`, answerID)
+	if err != nil || len(suggestions) == 0 {
+		return nil, err
+	}
+	return &suggestions[0], nil
+}
+
+func (r *gradingRepositoryImpl) queryScoreSuggestions(ctx context.Context, where string, args ...interface{}) ([]entity.EssayScoreSuggestion, error) {
+	query := `
+		SELECT id_jawaban, id_soal, suggested_score, keyword_coverage, reference_overlap,
+		       keyword_matches, missing_keywords, reference_passages, computed_at
+		FROM essay_score_suggestion ` + where
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suggestions []entity.EssayScoreSuggestion
+	for rows.Next() {
+		var suggestion entity.EssayScoreSuggestion
+		var coverage, overlap sql.NullFloat64
+		var matches, missing, passages []byte
+		err := rows.Scan(&suggestion.IDJawaban, &suggestion.IDSoal, &suggestion.SuggestedScore, &coverage, &overlap,
+			&matches, &missing, &passages, &suggestion.ComputedAt)
+		if err != nil {
+			return nil, err
+		}
+		if coverage.Valid {
+			suggestion.KeywordCoverage = &coverage.Float64
+		}
+		if overlap.Valid {
+			suggestion.ReferenceOverlap = &overlap.Float64
+		}
+		if err := json.Unmarshal(matches, &suggestion.KeywordMatches); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(missing, &suggestion.MissingKeywords); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(passages, &suggestion.ReferencePassages); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	return suggestions, rows.Err()
+}
+
+// Compare suggestions with final scores
+func (r *gradingRepositoryImpl) GetSuggestionAgreement(ctx context.Context, lmsAssignmentID int64, soalID int, tolerance float64) (*entity.SuggestionAgreement, error) {
+	agreement := &entity.SuggestionAgreement{LMSAssignmentID: lmsAssignmentID, SoalID: soalID}
+	query := `
+		SELECT COUNT(*)::int,
+		       COUNT(*) FILTER (WHERE ABS(js.nilai_essay - ess.suggested_score) <= $3)::int,
+		       COUNT(*) FILTER (WHERE ess.accepted)::int,
+		       COALESCE(AVG(ABS(js.nilai_essay - ess.suggested_score)), 0)
+		FROM essay_score_suggestion ess
+		JOIN jawaban_siswa js ON js.id = ess.id_jawaban
+		JOIN test_session_soal tss ON js.id_test_session_soal = tss.id
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		WHERE js.nilai_essay IS NOT NULL
+		  AND ($1::bigint = 0 OR ts.lms_assignment_id = $1)
+		  AND ($2::int = 0 OR ess.id_soal = $2)`
+	err := r.db.QueryRowContext(ctx, query, lmsAssignmentID, soalID, tolerance).Scan(&agreement.GradedWithSuggestion, &agreement.Agreed,
+		&agreement.AcceptedExactly, &agreement.MeanAbsoluteDifference)
+	if err != nil {
+		return nil, err
+	}
+	if agreement.GradedWithSuggestion > 0 {
+		agreement.AgreementRate = float64(agreement.Agreed) / float64(agreement.GradedWithSuggestion)
+	}
+	return agreement, nil
+}
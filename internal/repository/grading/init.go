@@ -0,0 +1,93 @@
+package grading
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// GradingRepository defines the interface for essay grading data
+type GradingRepository interface {
+	// Get an essay answer with its question and session (nil when not found)
+	GetEssayAnswer(ctx context.Context, answerID int) (*entity.EssayAnswerForGrading, error)
+
+	// List non-empty essay answers of an assignment, optionally for one soal
+	ListAssignmentEssays(ctx context.Context, lmsAssignmentID int64, soalID int) ([]entity.EssayAnswerForGrading, error)
+
+	// Replace the similarity results of an assignment (or of one soal when soalID > 0)
+	ReplaceEssaySimilarities(ctx context.Context, lmsAssignmentID int64, soalID int, results []entity.EssaySimilarity) error
+
+	// List similarity results of an answer, most similar first
+	ListEssaySimilarities(ctx context.Context, answerID int) ([]entity.EssaySimilarity, error)
+
+	// Get the question type of a soal (empty when not found)
+	GetSoalQuestionType(ctx context.Context, soalID int) (entity.QuestionType, error)
+
+	// Get the rubric of an essay soal with criteria and levels (nil when none)
+	GetEssayRubric(ctx context.Context, soalID int) (*entity.EssayRubric, error)
+
+	// Replace the rubric of an essay soal; no criteria removes the rubric
+	ReplaceEssayRubric(ctx context.Context, rubric *entity.EssayRubric) error
+
+	// Store the final grade of an essay answer and its rubric scores, close its double marking,
+	// and recalculate the session, in one transaction
+	SaveEssayGrade(ctx context.Context, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error)
+
+	// List the per-criterion scores of an answer
+	ListRubricScores(ctx context.Context, answerID int) ([]entity.JawabanRubricScore, error)
+
+	// Get the grading settings of an assignment (nil when none)
+	GetGradingConfig(ctx context.Context, lmsAssignmentID int64) (*entity.GradingConfig, error)
+
+	// Create or update the grading settings of an assignment
+	UpsertGradingConfig(ctx context.Context, config *entity.GradingConfig) error
+
+	// List ungraded essays of finished sessions with their grading tasks and moderation
+	ListPendingEssays(ctx context.Context, filter entity.PendingEssayFilter, limit, offset int) ([]entity.PendingEssay, int, error)
+
+	// Create grading tasks in one transaction
+	CreateGradingTasks(ctx context.Context, tasks []entity.GradingTask) error
+
+	// Run fn in one transaction that holds the answer and its grading tasks locked; nothing
+	// fn wrote is kept when it returns an error
+	GradeEssayInTx(ctx context.Context, answerID int, fn func(tx EssayGradingTx) error) error
+
+	// Count the essays and sessions of an assignment by grading state
+	GetGradingProgress(ctx context.Context, lmsAssignmentID int64) (*entity.GradingProgress, error)
+
+	// List the keywords of an essay soal
+	ListEssayKeywords(ctx context.Context, soalID int) ([]entity.EssayKeyword, error)
+
+	// Replace the keywords of an essay soal
+	ReplaceEssayKeywords(ctx context.Context, soalID int, keywords []entity.EssayKeyword) error
+
+	// Create or replace the score suggestions of answers
+	UpsertScoreSuggestions(ctx context.Context, suggestions []entity.EssayScoreSuggestion) error
+
+	// Get the score suggestion of an answer (nil when none)
+	GetScoreSuggestion(ctx context.Context, answerID int) (*entity.EssayScoreSuggestion, error)
+
+	// Compare suggestions with the final scores of graded answers of an assignment and/or soal
+	GetSuggestionAgreement(ctx context.Context, lmsAssignmentID int64, soalID int, tolerance float64) (*entity.SuggestionAgreement, error)
+}
+
+// EssayGradingTx reads and writes the grading state of the answer locked by GradeEssayInTx
+type EssayGradingTx interface {
+	// Get the grade of the answer
+	GetEssayGrade(ctx context.Context) (*entity.EssayGrade, error)
+
+	// List the grading tasks of the answer ordered by marker slot
+	ListGradingTasks(ctx context.Context) ([]entity.GradingTask, error)
+
+	// Store the mark of a grading task and mark it submitted
+	SubmitGradingTask(ctx context.Context, task *entity.GradingTask) error
+
+	// Get the moderation of the answer (nil when none)
+	GetEssayModeration(ctx context.Context) (*entity.EssayModeration, error)
+
+	// Open (or reopen) the moderation of the answer
+	CreateEssayModeration(ctx context.Context, moderation *entity.EssayModeration) error
+
+	// Store the final grade like GradingRepository.SaveEssayGrade
+	SaveEssayGrade(ctx context.Context, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error)
+}
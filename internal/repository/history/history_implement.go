@@ -1,11 +1,15 @@
 package history
 
 import (
-	"cbt-test-mini-project/internal/entity"
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository"
+	"cbt-test-mini-project/util/teacherscope"
 )
 
 // historyRepositoryImpl implements HistoryRepository
@@ -19,7 +23,7 @@ func NewHistoryRepository(db *sql.DB) HistoryRepository {
 }
 
 // Get student history
-func (r *historyRepositoryImpl) GetStudentHistory(userID int, tingkatan, idMataPelajaran *int, limit, offset int) ([]entity.HistorySummary, int, error) {
+func (r *historyRepositoryImpl) GetStudentHistory(ctx context.Context, userID int, tingkatan, idMataPelajaran *int, limit, offset int) ([]entity.HistorySummary, int, error) {
 	var conditions []string
 	var args []interface{}
 
@@ -45,7 +49,7 @@ func (r *historyRepositoryImpl) GetStudentHistory(userID int, tingkatan, idMataP
 	// Count total
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM test_session ts WHERE %s", whereClause)
 	var total int
-	err := r.db.QueryRow(countQuery, args...).Scan(&total)
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -62,7 +66,7 @@ func (r *historyRepositoryImpl) GetStudentHistory(userID int, tingkatan, idMataP
 		LIMIT $%d OFFSET $%d`, whereClause, len(args)+1, len(args)+2)
 	args = append(args, limit, offset)
 
-	rows, err := r.db.Query(selectQuery, args...)
+	rows, err := r.db.QueryContext(ctx, selectQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -117,21 +121,26 @@ func (r *historyRepositoryImpl) GetStudentHistory(userID int, tingkatan, idMataP
 }
 
 // Get history detail by session token
-func (r *historyRepositoryImpl) GetHistoryDetail(sessionToken string) (*entity.TestSession, []entity.JawabanDetail, []entity.MateriBreakdown, error) {
+func (r *historyRepositoryImpl) GetHistoryDetail(ctx context.Context, sessionToken string) (*entity.TestSession, []entity.JawabanDetail, []entity.MateriBreakdown, error) {
 	// Get session
-	session, err := r.getSessionByToken(sessionToken)
+	session, err := r.getSessionByToken(ctx, sessionToken)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	// Get answers
-	answers, err := r.getAnswersBySessionToken(sessionToken)
+	answers, err := r.getAnswersBySessionToken(ctx, sessionToken)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
+	// Attach rubric breakdown of graded essays
+	if err := r.attachRubricScores(ctx, sessionToken, answers); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// Get materi breakdown
-	breakdowns, err := r.getMateriBreakdown(sessionToken)
+	breakdowns, err := r.getMateriBreakdown(ctx, sessionToken)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -139,7 +148,47 @@ func (r *historyRepositoryImpl) GetHistoryDetail(sessionToken string) (*entity.T
 	return session, answers, breakdowns, nil
 }
 
-func (r *historyRepositoryImpl) getSessionByToken(token string) (*entity.TestSession, error) {
+func (r *historyRepositoryImpl) attachRubricScores(ctx context.Context, token string, details []entity.JawabanDetail) error {
+	query := `
+		SELECT tss.nomor_urut, jrs.id, jrs.id_jawaban, jrs.id_criterion, jrs.id_level, jrs.nama_kriteria, jrs.label_level,
+		       jrs.point, jrs.max_point, jrs.feedback, jrs.urutan, jrs.graded_at
+		FROM jawaban_rubric_score jrs
+		JOIN jawaban_siswa js ON js.id = jrs.id_jawaban
+		JOIN test_session_soal tss ON tss.id = js.id_test_session_soal
+		JOIN test_session ts ON ts.id = tss.id_test_session
+		WHERE ts.session_token = $1
+		ORDER BY tss.nomor_urut, jrs.urutan, jrs.id`
+	rows, err := r.db.QueryContext(ctx, query, token)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	indexByNomorUrut := make(map[int]int, len(details))
+	for i, detail := range details {
+		indexByNomorUrut[detail.NomorUrut] = i
+	}
+
+	for rows.Next() {
+		var nomorUrut int
+		var score entity.JawabanRubricScore
+		var feedback sql.NullString
+		err := rows.Scan(&nomorUrut, &score.ID, &score.IDJawaban, &score.IDCriterion, &score.IDLevel, &score.NamaKriteria, &score.LabelLevel,
+			&score.Point, &score.MaxPoint, &feedback, &score.Urutan, &score.GradedAt)
+		if err != nil {
+			return err
+		}
+		if feedback.Valid {
+			score.Feedback = &feedback.String
+		}
+		if i, ok := indexByNomorUrut[nomorUrut]; ok {
+			details[i].RubricScores = append(details[i].RubricScores, score)
+		}
+	}
+	return rows.Err()
+}
+
+func (r *historyRepositoryImpl) getSessionByToken(ctx context.Context, token string) (*entity.TestSession, error) {
 	query := `
 		SELECT ts.id, ts.session_token, ts.user_id, ts.nama_peserta, ts.waktu_mulai, ts.waktu_selesai, ts.nilai_akhir, ts.jumlah_benar, ts.total_soal, ts.status, ts.id_mata_pelajaran, ts.id_tingkat,
 		       mp.nama as mata_pelajaran_nama, mp.is_active as mata_pelajaran_is_active,
@@ -159,7 +208,7 @@ func (r *historyRepositoryImpl) getSessionByToken(token string) (*entity.TestSes
 	var tNama string
 	var tIsActive bool
 
-	err := r.db.QueryRow(query, token).Scan(&session.ID, &session.SessionToken, &userID, &session.NamaPeserta, &session.WaktuMulai, &waktuSelesai, &nilaiAkhir, &jumlahBenar, &totalSoal, &session.Status, &session.IDMataPelajaran, &session.IDTingkat,
+	err := r.db.QueryRowContext(ctx, query, token).Scan(&session.ID, &session.SessionToken, &userID, &session.NamaPeserta, &session.WaktuMulai, &waktuSelesai, &nilaiAkhir, &jumlahBenar, &totalSoal, &session.Status, &session.IDMataPelajaran, &session.IDTingkat,
 		&mpNama, &mpIsActive, &tNama, &tIsActive)
 	if err != nil {
 		return nil, err
@@ -190,10 +239,11 @@ func (r *historyRepositoryImpl) getSessionByToken(token string) (*entity.TestSes
 	return &session, nil
 }
 
-func (r *historyRepositoryImpl) getAnswersBySessionToken(token string) ([]entity.JawabanDetail, error) {
+func (r *historyRepositoryImpl) getAnswersBySessionToken(ctx context.Context, token string) ([]entity.JawabanDetail, error) {
 	query := `
 		SELECT tss.nomor_urut, s.pertanyaan, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, js.jawaban_dipilih, s.jawaban_benar, js.is_correct, s.pembahasan,
-		       CASE WHEN js.id IS NOT NULL THEN true ELSE false END as is_answered
+		       CASE WHEN js.id IS NOT NULL THEN true ELSE false END as is_answered,
+		       s.id, s.question_type, js.jawaban_dipilih_complex, s.jawaban_benar_complex, s.format_konten
 		FROM test_session_soal tss
 		JOIN test_session ts ON tss.id_test_session = ts.id
 		JOIN soal s ON tss.id_soal = s.id
@@ -201,22 +251,37 @@ func (r *historyRepositoryImpl) getAnswersBySessionToken(token string) ([]entity
 		WHERE ts.session_token = $1
 		ORDER BY tss.nomor_urut`
 
-	rows, err := r.db.Query(query, token)
+	rows, err := r.db.QueryContext(ctx, query, token)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	var details []entity.JawabanDetail
+	var soals []entity.Soal
 	for rows.Next() {
 		var detail entity.JawabanDetail
+		var soal entity.Soal
 		var jawabanDipilih sql.NullString
 		var isCorrect sql.NullBool
 		var pembahasan sql.NullString
-		err := rows.Scan(&detail.NomorUrut, &detail.Pertanyaan, &detail.OpsiA, &detail.OpsiB, &detail.OpsiC, &detail.OpsiD, &jawabanDipilih, &detail.JawabanBenar, &isCorrect, &pembahasan, &detail.IsAnswered)
+		var jawabanDipilihComplex, jawabanBenarComplex sql.NullString
+		err := rows.Scan(&detail.NomorUrut, &detail.Pertanyaan, &detail.OpsiA, &detail.OpsiB, &detail.OpsiC, &detail.OpsiD, &jawabanDipilih, &detail.JawabanBenar, &isCorrect, &pembahasan, &detail.IsAnswered,
+			&soal.ID, &detail.QuestionType, &jawabanDipilihComplex, &jawabanBenarComplex, &detail.FormatKonten)
 		if err != nil {
 			return nil, err
 		}
+		soal.QuestionType = detail.QuestionType
+		soal.OpsiA, soal.OpsiB, soal.OpsiC, soal.OpsiD = detail.OpsiA, detail.OpsiB, detail.OpsiC, detail.OpsiD
+		if jawabanBenarComplex.Valid {
+			soal.JawabanBenarComplex = &jawabanBenarComplex.String
+			detail.JawabanBenarComplex = soal.GetJawabanBenarComplex()
+		}
+		if jawabanDipilihComplex.Valid {
+			answer := entity.JawabanSiswa{JawabanDipilihComplex: &jawabanDipilihComplex.String}
+			detail.JawabanDipilihComplex = answer.GetJawabanDipilihComplex()
+		}
+		soals = append(soals, soal)
 		if jawabanDipilih.Valid {
 			s := entity.JawabanOption(jawabanDipilih.String)
 			detail.JawabanDipilih = &s
@@ -241,7 +306,7 @@ func (r *historyRepositoryImpl) getAnswersBySessionToken(token string) ([]entity
 				JOIN test_session ts ON tss.id_test_session = ts.id
 				WHERE ts.session_token = $1 AND tss.nomor_urut = $2
 			)`
-		gRows, err := r.db.Query(gambarQuery, token, details[i].NomorUrut)
+		gRows, err := r.db.QueryContext(ctx, gambarQuery, token, details[i].NomorUrut)
 		if err != nil {
 			return nil, err
 		}
@@ -259,10 +324,23 @@ func (r *historyRepositoryImpl) getAnswersBySessionToken(token string) ([]entity
 		details[i].Gambar = gambar
 	}
 
+	soalIDs := make([]int, len(soals))
+	for i := range soals {
+		soalIDs[i] = soals[i].ID
+	}
+	opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, soalIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range details {
+		soals[i].Opsi = opsiBySoal[soals[i].ID]
+		details[i].Opsi = soals[i].Options()
+	}
+
 	return details, rows.Err()
 }
 
-func (r *historyRepositoryImpl) getMateriBreakdown(token string) ([]entity.MateriBreakdown, error) {
+func (r *historyRepositoryImpl) getMateriBreakdown(ctx context.Context, token string) ([]entity.MateriBreakdown, error) {
 	query := `
 		SELECT
 			m.nama as nama_materi,
@@ -277,7 +355,7 @@ func (r *historyRepositoryImpl) getMateriBreakdown(token string) ([]entity.Mater
 		WHERE ts.session_token = $1
 		GROUP BY m.id, m.nama`
 
-	rows, err := r.db.Query(query, token)
+	rows, err := r.db.QueryContext(ctx, query, token)
 	if err != nil {
 		return nil, err
 	}
@@ -297,14 +375,14 @@ func (r *historyRepositoryImpl) getMateriBreakdown(token string) ([]entity.Mater
 }
 
 // Get user from session token
-func (r *historyRepositoryImpl) GetUserFromSessionToken(sessionToken string) (*entity.User, error) {
+func (r *historyRepositoryImpl) GetUserFromSessionToken(ctx context.Context, sessionToken string) (*entity.User, error) {
 	query := `
 		SELECT u.id, u.email, u.password_hash, u.full_name, u.role, u.is_active, u.created_at, u.updated_at
 		FROM users u
 		JOIN test_session ts ON ts.user_id = u.id
 		WHERE ts.session_token = $1`
 	var user entity.User
-	err := r.db.QueryRow(query, sessionToken).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Nama, &user.Role, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
+	err := r.db.QueryRowContext(ctx, query, sessionToken).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Nama, &user.Role, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("user not found")
@@ -314,8 +392,9 @@ func (r *historyRepositoryImpl) GetUserFromSessionToken(sessionToken string) (*e
 	return &user, nil
 }
 
-// ListStudentHistories lists all student histories with user info
-func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPelajaran *int, limit, offset int) ([]entity.StudentHistoryWithUser, int, error) {
+// ListStudentHistories lists all student histories with user info; a non-nil scope limits
+// them to sessions of classes the teacher teaches
+func (r *historyRepositoryImpl) ListStudentHistories(ctx context.Context, userID, tingkatan, idMataPelajaran *int, scope *entity.TeacherScope, limit, offset int) ([]entity.StudentHistoryWithUser, int, error) {
 	// Build conditions for user_ids
 	var conditions []string
 	var args []interface{}
@@ -335,13 +414,17 @@ func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPe
 		conditions = append(conditions, "id_mata_pelajaran = $"+fmt.Sprintf("%d", len(args)+1))
 		args = append(args, *idMataPelajaran)
 	}
+	if scope != nil {
+		conditions = append(conditions, teacherscope.TaughtClassCondition("lms_class_id", len(args)+1))
+		args = append(args, scope.UserID)
+	}
 
 	whereClause := strings.Join(conditions, " AND ")
 
 	// Count total users
 	countQuery := fmt.Sprintf("SELECT COUNT(DISTINCT user_id) FROM test_session WHERE %s", whereClause)
 	var total int
-	err := r.db.QueryRow(countQuery, args...).Scan(&total)
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -349,7 +432,7 @@ func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPe
 	// Get user IDs with pagination
 	userIDQuery := fmt.Sprintf("SELECT DISTINCT user_id FROM test_session WHERE %s ORDER BY user_id LIMIT $%d OFFSET $%d", whereClause, len(args)+1, len(args)+2)
 	args = append(args, limit, offset)
-	userIDRows, err := r.db.Query(userIDQuery, args...)
+	userIDRows, err := r.db.QueryContext(ctx, userIDQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -371,7 +454,7 @@ func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPe
 		// Get user info
 		userQuery := "SELECT id, email, password_hash, nama, role, is_active, created_at, updated_at FROM users WHERE id = $1"
 		var user entity.User
-		err := r.db.QueryRow(userQuery, uid).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Nama, &user.Role, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
+		err := r.db.QueryRowContext(ctx, userQuery, uid).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Nama, &user.Role, &user.IsActive, &user.CreatedAt, &user.UpdatedAt)
 		if err != nil {
 			continue // Skip if user not found
 		}
@@ -388,6 +471,10 @@ func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPe
 			sessionConditions = append(sessionConditions, fmt.Sprintf("id_mata_pelajaran = $%d", len(sessionArgs)+1))
 			sessionArgs = append(sessionArgs, *idMataPelajaran)
 		}
+		if scope != nil {
+			sessionConditions = append(sessionConditions, teacherscope.TaughtClassCondition("ts.lms_class_id", len(sessionArgs)+1))
+			sessionArgs = append(sessionArgs, scope.UserID)
+		}
 
 		sessionWhere := strings.Join(sessionConditions, " AND ")
 		sessionQuery := fmt.Sprintf(`
@@ -399,7 +486,7 @@ func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPe
 			JOIN tingkat t ON ts.id_tingkat = t.id
 			WHERE %s`, sessionWhere)
 
-		sessionRows, err := r.db.Query(sessionQuery, sessionArgs...)
+		sessionRows, err := r.db.QueryContext(ctx, sessionQuery, sessionArgs...)
 		if err != nil {
 			continue
 		}
@@ -494,12 +581,12 @@ func (r *historyRepositoryImpl) ListStudentHistories(userID, tingkatan, idMataPe
 		}
 
 		results = append(results, entity.StudentHistoryWithUser{
-			User:              user,
-			History:           histories,
-			RataRataNilai:     rataRata,
+			User:               user,
+			History:            histories,
+			RataRataNilai:      rataRata,
 			TotalTestCompleted: len(histories),
 		})
 	}
 
 	return results, total, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,39 @@
+package lab_login
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// LabLoginRepository defines the interface for QR-card and PIN credentials of exam labs
+type LabLoginRepository interface {
+	// Scheduled or ongoing sessions of an assignment, only those of userIDs when given and of
+	// the classes a teacher teaches when scope is set
+	ListOpenSessions(ctx context.Context, lmsAssignmentID int64, userIDs []int, scope *entity.TeacherScope) ([]entity.LabSession, error)
+
+	// Get a session with its status, whatever the status (nil when not found)
+	GetSession(ctx context.Context, testSessionID int) (*entity.LabSession, error)
+
+	// PIN hashes taken by unused, unrevoked credentials of the assignment
+	ListActivePinHashes(ctx context.Context, lmsAssignmentID int64) (map[string]bool, error)
+
+	// Store credentials, first revoking unused ones of the same sessions; fills ID and CreatedAt
+	CreateCredentials(ctx context.Context, credentials []*entity.LabCredential) error
+
+	// Get a credential by id (nil when not found)
+	GetCredential(ctx context.Context, id int) (*entity.LabCredential, error)
+
+	// Get the unused, unrevoked credential of an assignment with this PIN (nil when not found)
+	GetCredentialByPin(ctx context.Context, lmsAssignmentID int64, pinHash string) (*entity.LabCredential, error)
+
+	// Mark a credential used; false when it was used, revoked or expired in the meantime
+	ConsumeCredential(ctx context.Context, id int) (bool, error)
+
+	// Record the login session a credential opened
+	SetAuthSession(ctx context.Context, id int, authSessionID int64) error
+
+	// Revoke the assignment's credentials (only those of userIDs when given) and end the lab
+	// logins already made with them; returns how many credentials were revoked
+	RevokeCredentials(ctx context.Context, lmsAssignmentID int64, userIDs []int, scope *entity.TeacherScope) (int, error)
+}
@@ -0,0 +1,280 @@
+package lab_login
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/teacherscope"
+)
+
+// labLoginRepositoryImpl implements LabLoginRepository
+type labLoginRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewLabLoginRepository creates a new LabLoginRepository instance
+func NewLabLoginRepository(db *sql.DB) LabLoginRepository {
+	return &labLoginRepositoryImpl{db: db}
+}
+
+const labCredentialColumns = `id, test_session_id, user_id, lms_assignment_id, school_id, pin_hash, qr_nonce_hash, expires_at, used_at, revoked_at, auth_session_id, created_by, created_at`
+
+// labSessionQuery selects sessions with the school their credentials belong to
+const labSessionQuery = `
+	SELECT ts.id, ts.session_token, ts.user_id, ts.nama_peserta, ts.lms_assignment_id, ts.status,
+		COALESCE((SELECT c.school_id FROM classes c WHERE c.id = ts.lms_class_id), mp.lms_school_id)
+	FROM test_session ts
+	JOIN mata_pelajaran mp ON ts.id_mata_pelajaran = mp.id
+	WHERE ts.deleted_at IS NULL`
+
+// List open sessions of an assignment
+func (r *labLoginRepositoryImpl) ListOpenSessions(ctx context.Context, lmsAssignmentID int64, userIDs []int, scope *entity.TeacherScope) ([]entity.LabSession, error) {
+	args := []interface{}{lmsAssignmentID}
+	query := labSessionQuery + ` AND ts.lms_assignment_id = $1
+		AND ts.status IN ('scheduled'::test_session_status_enum, 'ongoing'::test_session_status_enum)`
+	if len(userIDs) > 0 {
+		var in string
+		in, args = inClause(args, userIDs)
+		query += " AND ts.user_id IN (" + in + ")"
+	}
+	if scope != nil {
+		args = append(args, scope.UserID)
+		query += " AND " + teacherscope.TaughtClassCondition("ts.lms_class_id", len(args))
+	}
+	query += " ORDER BY ts.nama_peserta, ts.id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []entity.LabSession{}
+	for rows.Next() {
+		session, err := scanLabSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	return sessions, rows.Err()
+}
+
+// Get a session by id
+func (r *labLoginRepositoryImpl) GetSession(ctx context.Context, testSessionID int) (*entity.LabSession, error) {
+	session, err := scanLabSession(r.db.QueryRowContext(ctx, labSessionQuery+` AND ts.id = $1`, testSessionID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return session, err
+}
+
+// PIN hashes taken by usable credentials of the assignment
+func (r *labLoginRepositoryImpl) ListActivePinHashes(ctx context.Context, lmsAssignmentID int64) (map[string]bool, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT pin_hash FROM lab_login_credentials
+		WHERE lms_assignment_id = $1 AND used_at IS NULL AND revoked_at IS NULL`, lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hashes := map[string]bool{}
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes[hash] = true
+	}
+	return hashes, rows.Err()
+}
+
+// Store credentials, replacing unused ones of the same sessions
+func (r *labLoginRepositoryImpl) CreateCredentials(ctx context.Context, credentials []*entity.LabCredential) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, credential := range credentials {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE lab_login_credentials SET revoked_at = NOW()
+			WHERE test_session_id = $1 AND used_at IS NULL AND revoked_at IS NULL`, credential.TestSessionID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO lab_login_credentials (test_session_id, user_id, lms_assignment_id, school_id, pin_hash, qr_nonce_hash, expires_at, created_by)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id, created_at`,
+			credential.TestSessionID, credential.UserID, credential.LMSAssignmentID, credential.SchoolID,
+			credential.PinHash, credential.QRNonceHash, credential.ExpiresAt, credential.CreatedBy).
+			Scan(&credential.ID, &credential.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Get a credential by id
+func (r *labLoginRepositoryImpl) GetCredential(ctx context.Context, id int) (*entity.LabCredential, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+labCredentialColumns+` FROM lab_login_credentials WHERE id = $1`, id)
+	credential, err := scanLabCredential(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return credential, err
+}
+
+// Get the usable credential of an assignment with this PIN
+func (r *labLoginRepositoryImpl) GetCredentialByPin(ctx context.Context, lmsAssignmentID int64, pinHash string) (*entity.LabCredential, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+labCredentialColumns+`
+		FROM lab_login_credentials
+		WHERE lms_assignment_id = $1 AND pin_hash = $2 AND used_at IS NULL AND revoked_at IS NULL`, lmsAssignmentID, pinHash)
+	credential, err := scanLabCredential(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return credential, err
+}
+
+// Mark a credential used
+func (r *labLoginRepositoryImpl) ConsumeCredential(ctx context.Context, id int) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE lab_login_credentials SET used_at = NOW()
+		WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()`, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// Record the login session a credential opened
+func (r *labLoginRepositoryImpl) SetAuthSession(ctx context.Context, id int, authSessionID int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE lab_login_credentials SET auth_session_id = $2 WHERE id = $1`, id, authSessionID)
+	return err
+}
+
+// Revoke credentials and end the logins made with them
+func (r *labLoginRepositoryImpl) RevokeCredentials(ctx context.Context, lmsAssignmentID int64, userIDs []int, scope *entity.TeacherScope) (int, error) {
+	args := []interface{}{lmsAssignmentID}
+	query := `
+		UPDATE lab_login_credentials SET revoked_at = NOW()
+		WHERE lms_assignment_id = $1 AND revoked_at IS NULL`
+	if len(userIDs) > 0 {
+		var in string
+		in, args = inClause(args, userIDs)
+		query += " AND user_id IN (" + in + ")"
+	}
+	if scope != nil {
+		args = append(args, scope.UserID)
+		query += " AND test_session_id IN (SELECT ts.id FROM test_session ts WHERE " + teacherscope.TaughtClassCondition("ts.lms_class_id", len(args)) + ")"
+	}
+	query += " RETURNING auth_session_id"
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	var authSessionIDs []int64
+	for rows.Next() {
+		var authSessionID sql.NullInt64
+		if err := rows.Scan(&authSessionID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		revoked++
+		if authSessionID.Valid {
+			authSessionIDs = append(authSessionIDs, authSessionID.Int64)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, authSessionID := range authSessionIDs {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE auth_sessions SET revoked_at = NOW(), revoke_reason = $2
+			WHERE id = $1 AND revoked_at IS NULL`, authSessionID, entity.AuthSessionRevokedLabCredential)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return revoked, tx.Commit()
+}
+
+// inClause appends ids to args and returns their placeholders
+func inClause(args []interface{}, ids []int) (string, []interface{}) {
+	placeholders := make([]string, len(ids))
+	for i, id := range ids {
+		args = append(args, id)
+		placeholders[i] = fmt.Sprintf("$%d", len(args))
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanLabSession(row rowScanner) (*entity.LabSession, error) {
+	var session entity.LabSession
+	var lmsAssignmentID, schoolID sql.NullInt64
+	err := row.Scan(&session.TestSessionID, &session.SessionToken, &session.UserID, &session.NamaPeserta,
+		&lmsAssignmentID, &session.Status, &schoolID)
+	if err != nil {
+		return nil, err
+	}
+	session.LMSAssignmentID = lmsAssignmentID.Int64
+	if schoolID.Valid {
+		session.SchoolID = &schoolID.Int64
+	}
+	return &session, nil
+}
+
+func scanLabCredential(row rowScanner) (*entity.LabCredential, error) {
+	var credential entity.LabCredential
+	var schoolID, authSessionID sql.NullInt64
+	var usedAt, revokedAt sql.NullTime
+
+	err := row.Scan(&credential.ID, &credential.TestSessionID, &credential.UserID, &credential.LMSAssignmentID, &schoolID,
+		&credential.PinHash, &credential.QRNonceHash, &credential.ExpiresAt, &usedAt, &revokedAt, &authSessionID,
+		&credential.CreatedBy, &credential.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if schoolID.Valid {
+		credential.SchoolID = &schoolID.Int64
+	}
+	if usedAt.Valid {
+		credential.UsedAt = &usedAt.Time
+	}
+	if revokedAt.Valid {
+		credential.RevokedAt = &revokedAt.Time
+	}
+	if authSessionID.Valid {
+		credential.AuthSessionID = &authSessionID.Int64
+	}
+	return &credential, nil
+}
@@ -1,33 +1,43 @@
 package materi
 
 import (
+	"context"
+
 	"cbt-test-mini-project/internal/entity"
 )
 
 // MateriRepository defines the interface for Materi (material) repository operations
 type MateriRepository interface {
 	// Create a new materi
-	Create(materi *entity.Materi) error
+	Create(ctx context.Context, materi *entity.Materi) error
 
 	// Get by ID
-	GetByID(id int) (*entity.Materi, error)
+	GetByID(ctx context.Context, id int) (*entity.Materi, error)
 
 	// Update existing
-	Update(materi *entity.Materi) error
+	Update(ctx context.Context, materi *entity.Materi) error
 
 	// Delete by ID
-	Delete(id int) error
+	Delete(ctx context.Context, id int) error
 
-	// List with filters
-	List(idMataPelajaran, idTingkat *int, limit, offset int) ([]entity.Materi, int, error)
+	// List with filters, restricted to the teacher's materi when scope is non-nil
+	List(ctx context.Context, idMataPelajaran, idTingkat *int, scope *entity.TeacherScope, limit, offset int) ([]entity.Materi, int, error)
 
 	// Get by mata pelajaran ID
-	GetByMataPelajaranID(idMataPelajaran int) ([]entity.Materi, error)
+	GetByMataPelajaranID(ctx context.Context, idMataPelajaran int) ([]entity.Materi, error)
 
 	// LMS sync methods
-	UpsertByLMSID(lmsID int64, subjectID int64, levelID int64, classID int64, name string) error
-	DeleteByLMSID(lmsID int64) error
-	GetByLMSID(lmsID int64) (*entity.Materi, error)
-	GetByLMSBookID(lmsBookID int64) (*entity.Materi, error)
-	GetByLMSTeacherMaterialID(lmsTeacherMaterialID int64) (*entity.Materi, error)
-}
\ No newline at end of file
+	UpsertByLMSID(ctx context.Context, lmsID int64, subjectID int64, levelID int64, classID int64, name string) error
+	DeleteByLMSID(ctx context.Context, lmsID int64) error
+	GetByLMSID(ctx context.Context, lmsID int64) (*entity.Materi, error)
+	GetByLMSBookID(ctx context.Context, lmsBookID int64) (*entity.Materi, error)
+	GetByLMSTeacherMaterialID(ctx context.Context, lmsTeacherMaterialID int64) (*entity.Materi, error)
+
+	// Sharing
+	// GetPermission resolves the materi a resource belongs to and what userID may do with it.
+	// The returned materi ID is 0 when the resource does not exist.
+	GetPermission(ctx context.Context, resource entity.MateriResource, id int, userID int) (int, entity.MateriPermission, error)
+	UpsertGrant(ctx context.Context, grant *entity.MateriGrant) error
+	DeleteGrant(ctx context.Context, idMateri, userID int) error
+	ListGrants(ctx context.Context, idMateri int) ([]entity.MateriGrant, error)
+}
@@ -1,10 +1,12 @@
 package materi
 
 import (
-	"cbt-test-mini-project/internal/entity"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
+
+	"cbt-test-mini-project/internal/entity"
 )
 
 // materiRepositoryImpl implements MateriRepository
@@ -18,17 +20,17 @@ func NewMateriRepository(db *sql.DB) MateriRepository {
 }
 
 // Create a new materi
-func (r *materiRepositoryImpl) Create(materi *entity.Materi) error {
+func (r *materiRepositoryImpl) Create(ctx context.Context, materi *entity.Materi) error {
 	query := `INSERT INTO materi (id_mata_pelajaran, id_tingkat, nama, is_active, default_durasi_menit, default_jumlah_soal, lms_module_id, lms_book_id, lms_teacher_material_id, lms_class_id, owner_user_id, school_id, labels, randomize_questions) 
 			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING id`
 
 	labelsJSON, _ := json.Marshal(materi.Labels)
-	err := r.db.QueryRow(query, materi.IDMataPelajaran, materi.IDTingkat, materi.Nama, materi.IsActive, materi.DefaultDurasiMenit, materi.DefaultJumlahSoal, materi.LmsModuleID, materi.LmsBookID, materi.LmsTeacherMaterialID, materi.LmsClassID, materi.OwnerUserID, materi.SchoolID, string(labelsJSON), materi.RandomizeQuestions).Scan(&materi.ID)
+	err := r.db.QueryRowContext(ctx, query, materi.IDMataPelajaran, materi.IDTingkat, materi.Nama, materi.IsActive, materi.DefaultDurasiMenit, materi.DefaultJumlahSoal, materi.LmsModuleID, materi.LmsBookID, materi.LmsTeacherMaterialID, materi.LmsClassID, materi.OwnerUserID, materi.SchoolID, string(labelsJSON), materi.RandomizeQuestions).Scan(&materi.ID)
 	return err
 }
 
 // Get by ID
-func (r *materiRepositoryImpl) GetByID(id int) (*entity.Materi, error) {
+func (r *materiRepositoryImpl) GetByID(ctx context.Context, id int) (*entity.Materi, error) {
 	var materi entity.Materi
 	query := `
 		SELECT m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_book_id, m.lms_teacher_material_id, m.lms_class_id, m.owner_user_id, m.school_id, m.labels, m.randomize_questions,
@@ -40,7 +42,7 @@ func (r *materiRepositoryImpl) GetByID(id int) (*entity.Materi, error) {
 		WHERE m.id = $1 AND m.is_active = true
 		`
 	var labelsSQL []byte
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&materi.ID, &materi.IDMataPelajaran, &materi.IDTingkat, &materi.Nama, &materi.IsActive, &materi.DefaultDurasiMenit, &materi.DefaultJumlahSoal, &materi.LmsModuleID, &materi.LmsBookID, &materi.LmsTeacherMaterialID, &materi.LmsClassID, &materi.OwnerUserID, &materi.SchoolID, &labelsSQL, &materi.RandomizeQuestions,
 		&materi.MataPelajaran.ID, &materi.MataPelajaran.Nama, &materi.MataPelajaran.LmsSubjectID, &materi.MataPelajaran.LmsSchoolID, &materi.MataPelajaran.LmsClassID,
 		&materi.Tingkat.ID, &materi.Tingkat.Nama, &materi.Tingkat.LmsLevelID,
@@ -58,21 +60,21 @@ func (r *materiRepositoryImpl) GetByID(id int) (*entity.Materi, error) {
 }
 
 // Update existing
-func (r *materiRepositoryImpl) Update(materi *entity.Materi) error {
+func (r *materiRepositoryImpl) Update(ctx context.Context, materi *entity.Materi) error {
 	query := `UPDATE materi SET id_mata_pelajaran = $1, id_tingkat = $2, nama = $3, is_active = $4, default_durasi_menit = $5, default_jumlah_soal = $6, lms_module_id = $7, lms_book_id = $8, lms_teacher_material_id = $9, lms_class_id = $10, randomize_questions = $11 WHERE id = $12`
-	_, err := r.db.Exec(query, materi.IDMataPelajaran, materi.IDTingkat, materi.Nama, materi.IsActive, materi.DefaultDurasiMenit, materi.DefaultJumlahSoal, materi.LmsModuleID, materi.LmsBookID, materi.LmsTeacherMaterialID, materi.LmsClassID, materi.RandomizeQuestions, materi.ID)
+	_, err := r.db.ExecContext(ctx, query, materi.IDMataPelajaran, materi.IDTingkat, materi.Nama, materi.IsActive, materi.DefaultDurasiMenit, materi.DefaultJumlahSoal, materi.LmsModuleID, materi.LmsBookID, materi.LmsTeacherMaterialID, materi.LmsClassID, materi.RandomizeQuestions, materi.ID)
 	return err
 }
 
 // Delete by ID (soft delete)
-func (r *materiRepositoryImpl) Delete(id int) error {
+func (r *materiRepositoryImpl) Delete(ctx context.Context, id int) error {
 	query := `UPDATE materi SET is_active = false WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
 
-// List with filters
-func (r *materiRepositoryImpl) List(idMataPelajaran, idTingkat *int, limit, offset int) ([]entity.Materi, int, error) {
+// List with filters; a non-nil scope limits the result to materi the teacher owns or was granted
+func (r *materiRepositoryImpl) List(ctx context.Context, idMataPelajaran, idTingkat *int, scope *entity.TeacherScope, limit, offset int) ([]entity.Materi, int, error) {
 	var materis []entity.Materi
 	var total int
 
@@ -91,6 +93,11 @@ func (r *materiRepositoryImpl) List(idMataPelajaran, idTingkat *int, limit, offs
 		whereClause += fmt.Sprintf(" AND m.id_tingkat = $%d", argCount)
 		args = append(args, *idTingkat)
 	}
+	if scope != nil {
+		argCount++
+		whereClause += fmt.Sprintf(" AND (m.owner_user_id = $%d OR EXISTS (SELECT 1 FROM materi_grants g WHERE g.id_materi = m.id AND g.user_id = $%d))", argCount, argCount)
+		args = append(args, scope.UserID)
+	}
 
 	// Count query
 	countQuery := fmt.Sprintf(`
@@ -99,7 +106,7 @@ func (r *materiRepositoryImpl) List(idMataPelajaran, idTingkat *int, limit, offs
 		JOIN tingkat t ON m.id_tingkat = t.id
 		WHERE %s
 	`, whereClause)
-	err := r.db.QueryRow(countQuery, args...).Scan(&total)
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -118,7 +125,7 @@ func (r *materiRepositoryImpl) List(idMataPelajaran, idTingkat *int, limit, offs
 	`, whereClause, argCount+1, argCount+2)
 	args = append(args, limit, offset)
 
-	rows, err := r.db.Query(listQuery, args...)
+	rows, err := r.db.QueryContext(ctx, listQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -148,7 +155,7 @@ func (r *materiRepositoryImpl) List(idMataPelajaran, idTingkat *int, limit, offs
 }
 
 // Get by mata pelajaran ID
-func (r *materiRepositoryImpl) GetByMataPelajaranID(idMataPelajaran int) ([]entity.Materi, error) {
+func (r *materiRepositoryImpl) GetByMataPelajaranID(ctx context.Context, idMataPelajaran int) ([]entity.Materi, error) {
 	var materis []entity.Materi
 	query := `
 		SELECT m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_book_id, m.lms_teacher_material_id, m.lms_class_id, m.owner_user_id, m.school_id, m.labels, m.randomize_questions,
@@ -160,7 +167,7 @@ func (r *materiRepositoryImpl) GetByMataPelajaranID(idMataPelajaran int) ([]enti
 		WHERE m.id_mata_pelajaran = $1 AND m.is_active = true
 		ORDER BY m.id
 	`
-	rows, err := r.db.Query(query, idMataPelajaran)
+	rows, err := r.db.QueryContext(ctx, query, idMataPelajaran)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +197,7 @@ func (r *materiRepositoryImpl) GetByMataPelajaranID(idMataPelajaran int) ([]enti
 }
 
 // UpsertByLMSID inserts or updates based on LMS ID
-func (r *materiRepositoryImpl) UpsertByLMSID(lmsID int64, subjectID int64, levelID int64, classID int64, name string) error {
+func (r *materiRepositoryImpl) UpsertByLMSID(ctx context.Context, lmsID int64, subjectID int64, levelID int64, classID int64, name string) error {
 	query := `
 		INSERT INTO materi (lms_module_id, nama, id_mata_pelajaran, id_tingkat, lms_class_id, is_active)
 		VALUES ($1, $2, $3, $4, $5, true)
@@ -202,19 +209,19 @@ func (r *materiRepositoryImpl) UpsertByLMSID(lmsID int64, subjectID int64, level
 			lms_class_id = EXCLUDED.lms_class_id,
 			is_active = true
 	`
-	_, err := r.db.Exec(query, lmsID, name, subjectID, levelID, classID)
+	_, err := r.db.ExecContext(ctx, query, lmsID, name, subjectID, levelID, classID)
 	return err
 }
 
 // DeleteByLMSID soft deletes by LMS ID
-func (r *materiRepositoryImpl) DeleteByLMSID(lmsID int64) error {
+func (r *materiRepositoryImpl) DeleteByLMSID(ctx context.Context, lmsID int64) error {
 	query := `UPDATE materi SET is_active = false WHERE lms_module_id = $1`
-	_, err := r.db.Exec(query, lmsID)
+	_, err := r.db.ExecContext(ctx, query, lmsID)
 	return err
 }
 
 // GetByLMSID retrieves materi by LMS ID
-func (r *materiRepositoryImpl) GetByLMSID(lmsID int64) (*entity.Materi, error) {
+func (r *materiRepositoryImpl) GetByLMSID(ctx context.Context, lmsID int64) (*entity.Materi, error) {
 	var materi entity.Materi
 	query := `
 		SELECT m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_book_id, m.lms_teacher_material_id, m.lms_class_id, m.owner_user_id, m.school_id, m.labels, m.randomize_questions
@@ -222,7 +229,7 @@ func (r *materiRepositoryImpl) GetByLMSID(lmsID int64) (*entity.Materi, error) {
 		WHERE m.lms_module_id = $1 AND m.is_active = true
 	`
 	var labelsSQL []byte
-	err := r.db.QueryRow(query, lmsID).Scan(
+	err := r.db.QueryRowContext(ctx, query, lmsID).Scan(
 		&materi.ID, &materi.IDMataPelajaran, &materi.IDTingkat, &materi.Nama, &materi.IsActive, &materi.DefaultDurasiMenit, &materi.DefaultJumlahSoal, &materi.LmsModuleID, &materi.LmsBookID, &materi.LmsTeacherMaterialID, &materi.LmsClassID, &materi.OwnerUserID, &materi.SchoolID, &labelsSQL, &materi.RandomizeQuestions,
 	)
 	if err != nil {
@@ -237,7 +244,7 @@ func (r *materiRepositoryImpl) GetByLMSID(lmsID int64) (*entity.Materi, error) {
 	return &materi, nil
 }
 
-func (r *materiRepositoryImpl) GetByLMSBookID(lmsBookID int64) (*entity.Materi, error) {
+func (r *materiRepositoryImpl) GetByLMSBookID(ctx context.Context, lmsBookID int64) (*entity.Materi, error) {
 	var materi entity.Materi
 	query := `
 		SELECT m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_book_id, m.lms_teacher_material_id, m.lms_class_id, m.owner_user_id, m.school_id, m.labels, m.randomize_questions
@@ -245,7 +252,7 @@ func (r *materiRepositoryImpl) GetByLMSBookID(lmsBookID int64) (*entity.Materi,
 		WHERE m.lms_book_id = $1 AND m.is_active = true
 	`
 	var labelsSQL []byte
-	err := r.db.QueryRow(query, lmsBookID).Scan(
+	err := r.db.QueryRowContext(ctx, query, lmsBookID).Scan(
 		&materi.ID, &materi.IDMataPelajaran, &materi.IDTingkat, &materi.Nama, &materi.IsActive, &materi.DefaultDurasiMenit, &materi.DefaultJumlahSoal, &materi.LmsModuleID, &materi.LmsBookID, &materi.LmsTeacherMaterialID, &materi.LmsClassID, &materi.OwnerUserID, &materi.SchoolID, &labelsSQL, &materi.RandomizeQuestions,
 	)
 	if err != nil {
@@ -260,7 +267,7 @@ func (r *materiRepositoryImpl) GetByLMSBookID(lmsBookID int64) (*entity.Materi,
 	return &materi, nil
 }
 
-func (r *materiRepositoryImpl) GetByLMSTeacherMaterialID(lmsTeacherMaterialID int64) (*entity.Materi, error) {
+func (r *materiRepositoryImpl) GetByLMSTeacherMaterialID(ctx context.Context, lmsTeacherMaterialID int64) (*entity.Materi, error) {
 	var materi entity.Materi
 	query := `
 		SELECT m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_book_id, m.lms_teacher_material_id, m.lms_class_id, m.owner_user_id, m.school_id, m.labels, m.randomize_questions
@@ -268,7 +275,7 @@ func (r *materiRepositoryImpl) GetByLMSTeacherMaterialID(lmsTeacherMaterialID in
 		WHERE m.lms_teacher_material_id = $1 AND m.is_active = true
 	`
 	var labelsSQL []byte
-	err := r.db.QueryRow(query, lmsTeacherMaterialID).Scan(
+	err := r.db.QueryRowContext(ctx, query, lmsTeacherMaterialID).Scan(
 		&materi.ID, &materi.IDMataPelajaran, &materi.IDTingkat, &materi.Nama, &materi.IsActive, &materi.DefaultDurasiMenit, &materi.DefaultJumlahSoal, &materi.LmsModuleID, &materi.LmsBookID, &materi.LmsTeacherMaterialID, &materi.LmsClassID, &materi.OwnerUserID, &materi.SchoolID, &labelsSQL, &materi.RandomizeQuestions,
 	)
 	if err != nil {
@@ -282,3 +289,87 @@ func (r *materiRepositoryImpl) GetByLMSTeacherMaterialID(lmsTeacherMaterialID in
 	}
 	return &materi, nil
 }
+
+// materiOfResource selects the materi id of a resource, keyed by $1
+var materiOfResource = map[entity.MateriResource]string{
+	entity.MateriResourceMateri:       `SELECT id FROM materi WHERE id = $1`,
+	entity.MateriResourceSoal:         `SELECT id_materi FROM soal WHERE id = $1`,
+	entity.MateriResourceSoalGambar:   `SELECT s.id_materi FROM soal_gambar sg JOIN soal s ON s.id = sg.id_soal WHERE sg.id = $1`,
+	entity.MateriResourceSoalMedia:    `SELECT s.id_materi FROM soal_media sm JOIN soal s ON s.id = sm.id_soal WHERE sm.id = $1`,
+	entity.MateriResourceSoalDragDrop: `SELECT id_materi FROM soal_drag_drop WHERE id = $1`,
+}
+
+// GetPermission resolves the materi a resource belongs to and what userID may do with it
+func (r *materiRepositoryImpl) GetPermission(ctx context.Context, resource entity.MateriResource, id int, userID int) (int, entity.MateriPermission, error) {
+	lookup, ok := materiOfResource[resource]
+	if !ok {
+		return 0, entity.MateriPermissionNone, fmt.Errorf("unknown materi resource %q", resource)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT m.id,
+		       CASE
+		           WHEN m.owner_user_id = $2 THEN 'owner'
+		           ELSE COALESCE((SELECT g.permission FROM materi_grants g WHERE g.id_materi = m.id AND g.user_id = $2), '')
+		       END
+		FROM materi m
+		WHERE m.id = (%s)
+	`, lookup)
+
+	var idMateri int
+	var permission string
+	err := r.db.QueryRowContext(ctx, query, id, userID).Scan(&idMateri, &permission)
+	if err == sql.ErrNoRows {
+		return 0, entity.MateriPermissionNone, nil
+	}
+	if err != nil {
+		return 0, entity.MateriPermissionNone, err
+	}
+	return idMateri, entity.MateriPermission(permission), nil
+}
+
+// UpsertGrant shares a materi with a teacher, replacing the permission of an existing grant
+func (r *materiRepositoryImpl) UpsertGrant(ctx context.Context, grant *entity.MateriGrant) error {
+	query := `
+		INSERT INTO materi_grants (id_materi, user_id, permission, granted_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id_materi, user_id)
+		DO UPDATE SET permission = EXCLUDED.permission, granted_by = EXCLUDED.granted_by
+		RETURNING id, created_at
+	`
+	return r.db.QueryRowContext(ctx, query, grant.IDMateri, grant.UserID, string(grant.Permission), grant.GrantedBy).Scan(&grant.ID, &grant.CreatedAt)
+}
+
+// DeleteGrant revokes a teacher's access to a materi
+func (r *materiRepositoryImpl) DeleteGrant(ctx context.Context, idMateri, userID int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM materi_grants WHERE id_materi = $1 AND user_id = $2`, idMateri, userID)
+	return err
+}
+
+// ListGrants lists the teachers a materi is shared with
+func (r *materiRepositoryImpl) ListGrants(ctx context.Context, idMateri int) ([]entity.MateriGrant, error) {
+	query := `
+		SELECT g.id, g.id_materi, g.user_id, COALESCE(u.full_name, ''), g.permission, g.granted_by, g.created_at
+		FROM materi_grants g
+		LEFT JOIN users u ON u.id = g.user_id
+		WHERE g.id_materi = $1
+		ORDER BY g.created_at, g.id
+	`
+	rows, err := r.db.QueryContext(ctx, query, idMateri)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []entity.MateriGrant
+	for rows.Next() {
+		var grant entity.MateriGrant
+		var permission string
+		if err := rows.Scan(&grant.ID, &grant.IDMateri, &grant.UserID, &grant.UserName, &permission, &grant.GrantedBy, &grant.CreatedAt); err != nil {
+			return nil, err
+		}
+		grant.Permission = entity.MateriPermission(permission)
+		grants = append(grants, grant)
+	}
+	return grants, rows.Err()
+}
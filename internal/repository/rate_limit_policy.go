@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// RateLimitPolicyRepository defines the interface for rate limit policy operations
+type RateLimitPolicyRepository interface {
+	// LoadPolicySet returns all policies, method groups and school plans
+	LoadPolicySet(ctx context.Context) (*entity.RateLimitPolicySet, error)
+	// SavePolicy inserts a policy without ID and updates one with ID
+	SavePolicy(ctx context.Context, policy *entity.RateLimitPolicy) error
+	DeletePolicy(ctx context.Context, id int) error
+	// SetMethodGroup puts a method in a group; an empty group moves it back to the default
+	SetMethodGroup(ctx context.Context, method, group string) error
+	// SetSchoolPlan sets the plan of a school; an empty plan removes it
+	SetSchoolPlan(ctx context.Context, schoolID int64, plan string) error
+}
+
+// rateLimitPolicyRepository implements RateLimitPolicyRepository
+type rateLimitPolicyRepository struct {
+	db *sql.DB
+}
+
+// NewRateLimitPolicyRepository creates a new rate limit policy repository
+func NewRateLimitPolicyRepository(db *sql.DB) RateLimitPolicyRepository {
+	return &rateLimitPolicyRepository{db: db}
+}
+
+const rateLimitPolicyColumns = `id, method_group, role, school_plan, school_id, limit_type, limit_value, burst, exam_exempt, enabled, created_at, updated_at`
+
+// LoadPolicySet returns all policies, method groups and school plans
+func (r *rateLimitPolicyRepository) LoadPolicySet(ctx context.Context) (*entity.RateLimitPolicySet, error) {
+	set := &entity.RateLimitPolicySet{
+		Policies:     []entity.RateLimitPolicy{},
+		MethodGroups: []entity.RateLimitMethodGroup{},
+		SchoolPlans:  []entity.SchoolPlan{},
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT `+rateLimitPolicyColumns+` FROM rate_limit_policies ORDER BY method_group, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		policy, err := scanRateLimitPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		set.Policies = append(set.Policies, *policy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	groupRows, err := r.db.QueryContext(ctx, `SELECT method, method_group, updated_at FROM rate_limit_method_groups ORDER BY method`)
+	if err != nil {
+		return nil, err
+	}
+	defer groupRows.Close()
+	for groupRows.Next() {
+		var group entity.RateLimitMethodGroup
+		if err := groupRows.Scan(&group.Method, &group.MethodGroup, &group.UpdatedAt); err != nil {
+			return nil, err
+		}
+		set.MethodGroups = append(set.MethodGroups, group)
+	}
+	if err := groupRows.Err(); err != nil {
+		return nil, err
+	}
+
+	planRows, err := r.db.QueryContext(ctx, `SELECT school_id, plan, updated_at FROM school_plans ORDER BY school_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer planRows.Close()
+	for planRows.Next() {
+		var plan entity.SchoolPlan
+		if err := planRows.Scan(&plan.SchoolID, &plan.Plan, &plan.UpdatedAt); err != nil {
+			return nil, err
+		}
+		set.SchoolPlans = append(set.SchoolPlans, plan)
+	}
+	return set, planRows.Err()
+}
+
+// SavePolicy inserts a policy without ID and updates one with ID
+func (r *rateLimitPolicyRepository) SavePolicy(ctx context.Context, policy *entity.RateLimitPolicy) error {
+	now := time.Now()
+	if policy.ID == 0 {
+		return r.db.QueryRowContext(ctx, `
+			INSERT INTO rate_limit_policies (method_group, role, school_plan, school_id, limit_type, limit_value, burst, exam_exempt, enabled, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+			RETURNING id, created_at, updated_at`,
+			policy.MethodGroup, policy.Role, policy.SchoolPlan, policy.SchoolID, policy.LimitType, policy.LimitValue,
+			policy.Burst, policy.ExamExempt, policy.Enabled, now).
+			Scan(&policy.ID, &policy.CreatedAt, &policy.UpdatedAt)
+	}
+
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE rate_limit_policies
+		SET method_group = $2, role = $3, school_plan = $4, school_id = $5, limit_type = $6, limit_value = $7,
+			burst = $8, exam_exempt = $9, enabled = $10, updated_at = $11
+		WHERE id = $1
+		RETURNING created_at, updated_at`,
+		policy.ID, policy.MethodGroup, policy.Role, policy.SchoolPlan, policy.SchoolID, policy.LimitType, policy.LimitValue,
+		policy.Burst, policy.ExamExempt, policy.Enabled, now).
+		Scan(&policy.CreatedAt, &policy.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return entity.ErrRateLimitPolicyNotFound
+	}
+	return err
+}
+
+// DeletePolicy deletes a policy
+func (r *rateLimitPolicyRepository) DeletePolicy(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM rate_limit_policies WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return entity.ErrRateLimitPolicyNotFound
+	}
+	return nil
+}
+
+// SetMethodGroup puts a method in a group; an empty group moves it back to the default
+func (r *rateLimitPolicyRepository) SetMethodGroup(ctx context.Context, method, group string) error {
+	if group == "" || group == entity.RateLimitGroupDefault {
+		_, err := r.db.ExecContext(ctx, `DELETE FROM rate_limit_method_groups WHERE method = $1`, method)
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO rate_limit_method_groups (method, method_group, updated_at) VALUES ($1, $2, NOW())
+		ON CONFLICT (method) DO UPDATE SET method_group = EXCLUDED.method_group, updated_at = EXCLUDED.updated_at`,
+		method, group)
+	return err
+}
+
+// SetSchoolPlan sets the plan of a school; an empty plan removes it
+func (r *rateLimitPolicyRepository) SetSchoolPlan(ctx context.Context, schoolID int64, plan string) error {
+	if plan == "" {
+		_, err := r.db.ExecContext(ctx, `DELETE FROM school_plans WHERE school_id = $1`, schoolID)
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO school_plans (school_id, plan, updated_at) VALUES ($1, $2, NOW())
+		ON CONFLICT (school_id) DO UPDATE SET plan = EXCLUDED.plan, updated_at = EXCLUDED.updated_at`,
+		schoolID, plan)
+	return err
+}
+
+func scanRateLimitPolicy(rows *sql.Rows) (*entity.RateLimitPolicy, error) {
+	var policy entity.RateLimitPolicy
+	var role, schoolPlan sql.NullString
+	var schoolID, limitValue sql.NullInt64
+	err := rows.Scan(&policy.ID, &policy.MethodGroup, &role, &schoolPlan, &schoolID, &policy.LimitType, &limitValue,
+		&policy.Burst, &policy.ExamExempt, &policy.Enabled, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if role.Valid {
+		policy.Role = &role.String
+	}
+	if schoolPlan.Valid {
+		policy.SchoolPlan = &schoolPlan.String
+	}
+	if schoolID.Valid {
+		policy.SchoolID = &schoolID.Int64
+	}
+	if limitValue.Valid {
+		value := int(limitValue.Int64)
+		policy.LimitValue = &value
+	}
+	return &policy, nil
+}
@@ -21,6 +21,12 @@ type UserLimitRepository interface {
 	GetOrCreateLimit(ctx context.Context, userID int, limitType string) (*entity.UserLimit, error)
 	UpdateLimit(ctx context.Context, limit *entity.UserLimit) error
 	IncrementUsageAtomic(ctx context.Context, userID int, limitType string, resourceID *int) error
+	// ConsumeLimit adds cost to the usage under policy, starting a new period when reset_at
+	// has passed. Returns the updated limit, or nil when cost does not fit or the limit does
+	// not exist.
+	ConsumeLimit(ctx context.Context, userID int, limitType string, cost int, policy entity.RateLimitPolicy) (*entity.UserLimit, error)
+	// HasOngoingTestSession reports whether the user is taking an exam right now
+	HasOngoingTestSession(ctx context.Context, userID int) (bool, error)
 	GetLimitsByUser(ctx context.Context, userID int) ([]*entity.UserLimit, error)
 	RecordUsage(ctx context.Context, usage *entity.UserLimitUsage) error
 	GetUsageHistory(ctx context.Context, userID int, limitType string, since time.Time) ([]*entity.UserLimitUsage, error)
@@ -38,9 +44,9 @@ func NewUserLimitRepository(db *sql.DB, config *config.Main) UserLimitRepository
 func (r *userLimitRepository) GetOrCreateLimit(ctx context.Context, userID int, limitType string) (*entity.UserLimit, error) {
 	var limit entity.UserLimit
 
-	query := `SELECT id, user_id, limit_type, limit_value, current_used, reset_at, created_at, updated_at FROM user_limits WHERE user_id = $1 AND limit_type = $2`
+	query := `SELECT id, user_id, limit_type, limit_value, current_used, reset_at, is_override, created_at, updated_at FROM user_limits WHERE user_id = $1 AND limit_type = $2`
 
-	err := r.db.QueryRowContext(ctx, query, userID, limitType).Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.CreatedAt, &limit.UpdatedAt)
+	err := r.db.QueryRowContext(ctx, query, userID, limitType).Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.IsOverride, &limit.CreatedAt, &limit.UpdatedAt)
 
 	if err == nil {
 		return &limit, nil
@@ -55,9 +61,9 @@ func (r *userLimitRepository) GetOrCreateLimit(ctx context.Context, userID int,
 	resetAt := r.getNextResetTime(limitType)
 	now := time.Now()
 
-	insertQuery := `INSERT INTO user_limits (user_id, limit_type, limit_value, current_used, reset_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, user_id, limit_type, limit_value, current_used, reset_at, created_at, updated_at`
+	insertQuery := `INSERT INTO user_limits (user_id, limit_type, limit_value, current_used, reset_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, user_id, limit_type, limit_value, current_used, reset_at, is_override, created_at, updated_at`
 
-	err = r.db.QueryRowContext(ctx, insertQuery, userID, limitType, defaultLimit, 0, resetAt, now, now).Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.CreatedAt, &limit.UpdatedAt)
+	err = r.db.QueryRowContext(ctx, insertQuery, userID, limitType, defaultLimit, 0, resetAt, now, now).Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.IsOverride, &limit.CreatedAt, &limit.UpdatedAt)
 
 	if err != nil {
 		return nil, err
@@ -69,8 +75,8 @@ func (r *userLimitRepository) GetOrCreateLimit(ctx context.Context, userID int,
 // UpdateLimit updates an existing user limit
 func (r *userLimitRepository) UpdateLimit(ctx context.Context, limit *entity.UserLimit) error {
 	limit.UpdatedAt = time.Now()
-	query := `UPDATE user_limits SET limit_value = $1, current_used = $2, reset_at = $3, updated_at = $4 WHERE id = $5`
-	_, err := r.db.ExecContext(ctx, query, limit.LimitValue, limit.CurrentUsed, limit.ResetAt, limit.UpdatedAt, limit.ID)
+	query := `UPDATE user_limits SET limit_value = $1, current_used = $2, reset_at = $3, is_override = $4, updated_at = $5 WHERE id = $6`
+	_, err := r.db.ExecContext(ctx, query, limit.LimitValue, limit.CurrentUsed, limit.ResetAt, limit.IsOverride, limit.UpdatedAt, limit.ID)
 	return err
 }
 
@@ -125,9 +131,50 @@ func (r *userLimitRepository) IncrementUsageAtomic(ctx context.Context, userID i
 	return nil
 }
 
+// ConsumeLimit atomically adds cost to the usage if it fits, so replicas counting in the
+// database never overshoot the limit together. Rows that are not overrides take the
+// policy's limit value, so they show the limit that applies.
+func (r *userLimitRepository) ConsumeLimit(ctx context.Context, userID int, limitType string, cost int, policy entity.RateLimitPolicy) (*entity.UserLimit, error) {
+	var limit entity.UserLimit
+	var policyValue sql.NullInt64
+	if policy.LimitValue != nil {
+		policyValue = sql.NullInt64{Int64: int64(*policy.LimitValue), Valid: true}
+	}
+	query := `
+		UPDATE user_limits
+		SET current_used = CASE WHEN reset_at <= $1 THEN 0 ELSE current_used END + $4,
+			reset_at = CASE WHEN reset_at <= $1 THEN $5 ELSE reset_at END,
+			limit_value = CASE WHEN is_override THEN limit_value ELSE COALESCE($6::int, limit_value) END,
+			updated_at = $1
+		WHERE user_id = $2 AND limit_type = $3
+			AND CASE WHEN reset_at <= $1 THEN 0 ELSE current_used END + $4 <=
+				CASE WHEN is_override THEN limit_value ELSE COALESCE($6::int, limit_value) + $7 END
+		RETURNING id, user_id, limit_type, limit_value, current_used, reset_at, is_override, created_at, updated_at`
+	err := r.db.QueryRowContext(ctx, query, time.Now(), userID, limitType, cost, r.getNextResetTime(limitType), policyValue, policy.Burst).
+		Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.IsOverride, &limit.CreatedAt, &limit.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &limit, nil
+}
+
+// HasOngoingTestSession reports whether the user has a test session in progress
+func (r *userLimitRepository) HasOngoingTestSession(ctx context.Context, userID int) (bool, error) {
+	var ongoing bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM test_session
+			WHERE user_id = $1 AND status = 'ongoing'::test_session_status_enum AND deleted_at IS NULL
+		)`, userID).Scan(&ongoing)
+	return ongoing, err
+}
+
 // GetLimitsByUser gets all limits for a user
 func (r *userLimitRepository) GetLimitsByUser(ctx context.Context, userID int) ([]*entity.UserLimit, error) {
-	query := `SELECT id, user_id, limit_type, limit_value, current_used, reset_at, created_at, updated_at FROM user_limits WHERE user_id = $1`
+	query := `SELECT id, user_id, limit_type, limit_value, current_used, reset_at, is_override, created_at, updated_at FROM user_limits WHERE user_id = $1`
 	rows, err := r.db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
@@ -137,7 +184,7 @@ func (r *userLimitRepository) GetLimitsByUser(ctx context.Context, userID int) (
 	var limits []*entity.UserLimit
 	for rows.Next() {
 		var limit entity.UserLimit
-		err := rows.Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.CreatedAt, &limit.UpdatedAt)
+		err := rows.Scan(&limit.ID, &limit.UserID, &limit.LimitType, &limit.LimitValue, &limit.CurrentUsed, &limit.ResetAt, &limit.IsOverride, &limit.CreatedAt, &limit.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -177,7 +224,7 @@ func (r *userLimitRepository) GetUsageHistory(ctx context.Context, userID int, l
 
 // getDefaultLimit returns the default limit value for a limit type
 func (r *userLimitRepository) getDefaultLimit(limitType string) int {
-	switch limitType {
+	switch entity.BaseLimitType(limitType) {
 	case entity.LimitTypeAPIRequestsPerHour:
 		return r.config.RateLimit.APIRequestsPerHour
 	case entity.LimitTypeAPIRequestsPerDay:
@@ -196,7 +243,7 @@ func (r *userLimitRepository) getDefaultLimit(limitType string) int {
 // getNextResetTime calculates the next reset time for a limit type
 func (r *userLimitRepository) getNextResetTime(limitType string) time.Time {
 	now := time.Now()
-	switch limitType {
+	switch entity.BaseLimitType(limitType) {
 	case entity.LimitTypeAPIRequestsPerHour:
 		return now.Add(time.Hour)
 	case entity.LimitTypeAPIRequestsPerDay, entity.LimitTypeTestSessionsPerDay, entity.LimitTypeQuestionsPerDay:
@@ -211,4 +258,4 @@ func (r *userLimitRepository) getNextResetTime(limitType string) time.Time {
 	default:
 		return now.Add(time.Hour)
 	}
-}
\ No newline at end of file
+}
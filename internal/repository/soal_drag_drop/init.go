@@ -1,22 +1,24 @@
 package soal_drag_drop
 
 import (
-	"cbt-test-mini-project/internal/entity"
+	"context"
 	"database/sql"
+
+	"cbt-test-mini-project/internal/entity"
 )
 
 // Repository interface for soal_drag_drop operations
 type Repository interface {
-	Create(soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error
-	GetByID(id int) (*entity.SoalDragDrop, error)
-	GetByIDWithCorrectAnswers(id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error)
-	Update(soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error
-	Delete(id int) error
-	List(idMateri, idTingkat int, page, pageSize int) ([]entity.SoalDragDrop, int64, error)
-	GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDragDrop, error)
-	GetCorrectAnswersBySoalID(soalID int) ([]entity.DragCorrectAnswer, error)
-	CountByMateri(idMateri int) (int64, error)
-	ReorderByMateri(idMateri int, urutanByID map[int]int) error
+	Create(ctx context.Context, soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error
+	GetByID(ctx context.Context, id int) (*entity.SoalDragDrop, error)
+	GetByIDWithCorrectAnswers(ctx context.Context, id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error)
+	Update(ctx context.Context, soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, idMateri, idTingkat int, scope *entity.TeacherScope, page, pageSize int) ([]entity.SoalDragDrop, int64, error)
+	GetActiveByMateri(ctx context.Context, idMateri int, limit int) ([]entity.SoalDragDrop, error)
+	GetCorrectAnswersBySoalID(ctx context.Context, soalID int) ([]entity.DragCorrectAnswer, error)
+	CountByMateri(ctx context.Context, idMateri int) (int64, error)
+	ReorderByMateri(ctx context.Context, idMateri int, urutanByID map[int]int) error
 }
 
 type repository struct {
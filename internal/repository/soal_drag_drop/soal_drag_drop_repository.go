@@ -1,17 +1,19 @@
 package soal_drag_drop
 
 import (
-	"cbt-test-mini-project/internal/entity"
+	"context"
 	"database/sql"
 	"errors"
 	"math/rand"
 	"strconv"
 	"time"
+
+	"cbt-test-mini-project/internal/entity"
 )
 
 // Create creates a new drag-drop question with items, slots, and correct answers
-func (r *repository) Create(soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error {
-	tx, err := r.db.Begin()
+func (r *repository) Create(ctx context.Context, soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -22,7 +24,7 @@ func (r *repository) Create(soal *entity.SoalDragDrop, items []entity.DragItem,
 		INSERT INTO soal_drag_drop (id_materi, id_tingkat, pertanyaan, point, urutan, drag_type, pembahasan, is_active, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, COALESCE(NULLIF($5, 0), (SELECT COALESCE(MAX(s2.urutan),0)+1 FROM soal_drag_drop s2 WHERE s2.id_materi = $1)), $6, $7, $8, NOW(), NOW())
 		RETURNING id`
-	err = tx.QueryRow(soalQuery, soal.IDMateri, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.DragType, soal.Pembahasan, soal.IsActive).Scan(&soal.ID)
+	err = tx.QueryRowContext(ctx, soalQuery, soal.IDMateri, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.DragType, soal.Pembahasan, soal.IsActive).Scan(&soal.ID)
 	if err != nil {
 		return err
 	}
@@ -34,7 +36,7 @@ func (r *repository) Create(soal *entity.SoalDragDrop, items []entity.DragItem,
 			INSERT INTO drag_item (id_soal_drag_drop, label, image_url, urutan, created_at)
 			VALUES ($1, $2, $3, $4, NOW())
 			RETURNING id`
-		err = tx.QueryRow(itemQuery, items[i].IDSoalDragDrop, items[i].Label, items[i].ImageURL, items[i].Urutan).Scan(&items[i].ID)
+		err = tx.QueryRowContext(ctx, itemQuery, items[i].IDSoalDragDrop, items[i].Label, items[i].ImageURL, items[i].Urutan).Scan(&items[i].ID)
 		if err != nil {
 			return err
 		}
@@ -47,15 +49,15 @@ func (r *repository) Create(soal *entity.SoalDragDrop, items []entity.DragItem,
 			INSERT INTO drag_slot (id_soal_drag_drop, label, image_url, urutan, created_at)
 			VALUES ($1, $2, $3, $4, NOW())
 			RETURNING id`
-		err = tx.QueryRow(slotQuery, slots[i].IDSoalDragDrop, slots[i].Label, slots[i].ImageURL, slots[i].Urutan).Scan(&slots[i].ID)
+		err = tx.QueryRowContext(ctx, slotQuery, slots[i].IDSoalDragDrop, slots[i].Label, slots[i].ImageURL, slots[i].Urutan).Scan(&slots[i].ID)
 		if err != nil {
 			return err
 		}
 	}
 
 	// Create correct answers (need to map temp IDs to real IDs)
-	itemMap := make(map[int]int)  // urutan -> id
-	slotMap := make(map[int]int)  // urutan -> id
+	itemMap := make(map[int]int) // urutan -> id
+	slotMap := make(map[int]int) // urutan -> id
 	for _, item := range items {
 		itemMap[item.Urutan] = item.ID
 	}
@@ -74,7 +76,7 @@ func (r *repository) Create(soal *entity.SoalDragDrop, items []entity.DragItem,
 		correctAnswerQuery := `
 			INSERT INTO drag_correct_answer (id_drag_item, id_drag_slot)
 			VALUES ($1, $2)`
-		_, err = tx.Exec(correctAnswerQuery, itemID, slotID)
+		_, err = tx.ExecContext(ctx, correctAnswerQuery, itemID, slotID)
 		if err != nil {
 			return err
 		}
@@ -84,7 +86,7 @@ func (r *repository) Create(soal *entity.SoalDragDrop, items []entity.DragItem,
 }
 
 // GetByID retrieves a drag-drop question by ID with items and slots
-func (r *repository) GetByID(id int) (*entity.SoalDragDrop, error) {
+func (r *repository) GetByID(ctx context.Context, id int) (*entity.SoalDragDrop, error) {
 	// Get main soal with relations
 	soalQuery := `
 		SELECT sdd.id, sdd.id_materi, sdd.id_tingkat, sdd.pertanyaan, sdd.point, sdd.urutan, sdd.drag_type, sdd.pembahasan, sdd.is_active, sdd.created_at, sdd.updated_at,
@@ -101,7 +103,7 @@ func (r *repository) GetByID(id int) (*entity.SoalDragDrop, error) {
 
 	var soal entity.SoalDragDrop
 	var pembahasan *string
-	err := r.db.QueryRow(soalQuery, id).Scan(
+	err := r.db.QueryRowContext(ctx, soalQuery, id).Scan(
 		&soal.ID, &soal.IDMateri, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.DragType, &pembahasan, &soal.IsActive, &soal.CreatedAt, &soal.UpdatedAt,
 		&soal.Materi.ID, &soal.Materi.IDMataPelajaran, &soal.Materi.IDTingkat, &soal.Materi.Nama, &soal.Materi.IsActive, &soal.Materi.DefaultDurasiMenit, &soal.Materi.DefaultJumlahSoal, &soal.Materi.LmsModuleID, &soal.Materi.LmsClassID,
 		&soal.Materi.MataPelajaran.ID, &soal.Materi.MataPelajaran.Nama, &soal.Materi.MataPelajaran.IsActive, &soal.Materi.MataPelajaran.LmsSubjectID, &soal.Materi.MataPelajaran.LmsSchoolID, &soal.Materi.MataPelajaran.LmsClassID,
@@ -122,7 +124,7 @@ func (r *repository) GetByID(id int) (*entity.SoalDragDrop, error) {
 		FROM drag_item
 		WHERE id_soal_drag_drop = $1
 		ORDER BY urutan ASC`
-	itemsRows, err := r.db.Query(itemsQuery, id)
+	itemsRows, err := r.db.QueryContext(ctx, itemsQuery, id)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +145,7 @@ func (r *repository) GetByID(id int) (*entity.SoalDragDrop, error) {
 		FROM drag_slot
 		WHERE id_soal_drag_drop = $1
 		ORDER BY urutan ASC`
-	slotsRows, err := r.db.Query(slotsQuery, id)
+	slotsRows, err := r.db.QueryContext(ctx, slotsQuery, id)
 	if err != nil {
 		return nil, err
 	}
@@ -162,13 +164,13 @@ func (r *repository) GetByID(id int) (*entity.SoalDragDrop, error) {
 }
 
 // GetByIDWithCorrectAnswers retrieves a drag-drop question with correct answers (admin only)
-func (r *repository) GetByIDWithCorrectAnswers(id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error) {
-	soal, err := r.GetByID(id)
+func (r *repository) GetByIDWithCorrectAnswers(ctx context.Context, id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error) {
+	soal, err := r.GetByID(ctx, id)
 	if err != nil || soal == nil {
 		return soal, nil, err
 	}
 
-	correctAnswers, err := r.GetCorrectAnswersBySoalID(id)
+	correctAnswers, err := r.GetCorrectAnswersBySoalID(ctx, id)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,7 +179,7 @@ func (r *repository) GetByIDWithCorrectAnswers(id int) (*entity.SoalDragDrop, []
 }
 
 // GetCorrectAnswersBySoalID gets correct answers for a question
-func (r *repository) GetCorrectAnswersBySoalID(soalID int) ([]entity.DragCorrectAnswer, error) {
+func (r *repository) GetCorrectAnswersBySoalID(ctx context.Context, soalID int) ([]entity.DragCorrectAnswer, error) {
 	var correctAnswers []entity.DragCorrectAnswer
 
 	query := `
@@ -185,7 +187,7 @@ func (r *repository) GetCorrectAnswersBySoalID(soalID int) ([]entity.DragCorrect
 		FROM drag_correct_answer dca
 		JOIN drag_item di ON dca.id_drag_item = di.id
 		WHERE di.id_soal_drag_drop = $1`
-	rows, err := r.db.Query(query, soalID)
+	rows, err := r.db.QueryContext(ctx, query, soalID)
 	if err != nil {
 		return nil, err
 	}
@@ -204,8 +206,8 @@ func (r *repository) GetCorrectAnswersBySoalID(soalID int) ([]entity.DragCorrect
 }
 
 // Update updates a drag-drop question with items, slots, and correct answers
-func (r *repository) Update(soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error {
-	tx, err := r.db.Begin()
+func (r *repository) Update(ctx context.Context, soal *entity.SoalDragDrop, items []entity.DragItem, slots []entity.DragSlot, correctAnswers []entity.DragCorrectAnswer) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -216,21 +218,21 @@ func (r *repository) Update(soal *entity.SoalDragDrop, items []entity.DragItem,
 		UPDATE soal_drag_drop
 		SET id_materi = $1, id_tingkat = $2, pertanyaan = $3, point = $4, urutan = COALESCE(NULLIF($5, 0), urutan), drag_type = $6, pembahasan = $7, is_active = $8, updated_at = NOW()
 		WHERE id = $9`
-	_, err = tx.Exec(updateQuery, soal.IDMateri, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.DragType, soal.Pembahasan, soal.IsActive, soal.ID)
+	_, err = tx.ExecContext(ctx, updateQuery, soal.IDMateri, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.DragType, soal.Pembahasan, soal.IsActive, soal.ID)
 	if err != nil {
 		return err
 	}
 
 	// Delete old items (cascade will delete correct_answers)
 	deleteItemsQuery := `DELETE FROM drag_item WHERE id_soal_drag_drop = $1`
-	_, err = tx.Exec(deleteItemsQuery, soal.ID)
+	_, err = tx.ExecContext(ctx, deleteItemsQuery, soal.ID)
 	if err != nil {
 		return err
 	}
 
 	// Delete old slots
 	deleteSlotsQuery := `DELETE FROM drag_slot WHERE id_soal_drag_drop = $1`
-	_, err = tx.Exec(deleteSlotsQuery, soal.ID)
+	_, err = tx.ExecContext(ctx, deleteSlotsQuery, soal.ID)
 	if err != nil {
 		return err
 	}
@@ -242,7 +244,7 @@ func (r *repository) Update(soal *entity.SoalDragDrop, items []entity.DragItem,
 			INSERT INTO drag_item (id_soal_drag_drop, label, image_url, urutan, created_at)
 			VALUES ($1, $2, $3, $4, NOW())
 			RETURNING id`
-		err = tx.QueryRow(itemQuery, items[i].IDSoalDragDrop, items[i].Label, items[i].ImageURL, items[i].Urutan).Scan(&items[i].ID)
+		err = tx.QueryRowContext(ctx, itemQuery, items[i].IDSoalDragDrop, items[i].Label, items[i].ImageURL, items[i].Urutan).Scan(&items[i].ID)
 		if err != nil {
 			return err
 		}
@@ -255,7 +257,7 @@ func (r *repository) Update(soal *entity.SoalDragDrop, items []entity.DragItem,
 			INSERT INTO drag_slot (id_soal_drag_drop, label, image_url, urutan, created_at)
 			VALUES ($1, $2, $3, $4, NOW())
 			RETURNING id`
-		err = tx.QueryRow(slotQuery, slots[i].IDSoalDragDrop, slots[i].Label, slots[i].ImageURL, slots[i].Urutan).Scan(&slots[i].ID)
+		err = tx.QueryRowContext(ctx, slotQuery, slots[i].IDSoalDragDrop, slots[i].Label, slots[i].ImageURL, slots[i].Urutan).Scan(&slots[i].ID)
 		if err != nil {
 			return err
 		}
@@ -284,7 +286,7 @@ func (r *repository) Update(soal *entity.SoalDragDrop, items []entity.DragItem,
 		correctAnswerQuery := `
 			INSERT INTO drag_correct_answer (id_drag_item, id_drag_slot)
 			VALUES ($1, $2)`
-		_, err = tx.Exec(correctAnswerQuery, itemID, slotID)
+		_, err = tx.ExecContext(ctx, correctAnswerQuery, itemID, slotID)
 		if err != nil {
 			return err
 		}
@@ -294,14 +296,15 @@ func (r *repository) Update(soal *entity.SoalDragDrop, items []entity.DragItem,
 }
 
 // Delete soft-deletes a drag-drop question by setting is_active to false
-func (r *repository) Delete(id int) error {
+func (r *repository) Delete(ctx context.Context, id int) error {
 	query := `UPDATE soal_drag_drop SET is_active = false WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
 
-// List retrieves drag-drop questions with pagination and optional filters
-func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity.SoalDragDrop, int64, error) {
+// List retrieves drag-drop questions with pagination and optional filters; a non-nil scope
+// limits the result to materi the teacher owns or was granted
+func (r *repository) List(ctx context.Context, idMateri, idTingkat int, scope *entity.TeacherScope, page, pageSize int) ([]entity.SoalDragDrop, int64, error) {
 	var soals []entity.SoalDragDrop
 
 	// Build WHERE clause
@@ -319,6 +322,12 @@ func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity
 		whereClause += " AND sdd.id_tingkat = $" + strconv.Itoa(argCount)
 		args = append(args, idTingkat)
 	}
+	if scope != nil {
+		argCount++
+		param := "$" + strconv.Itoa(argCount)
+		whereClause += " AND (m.owner_user_id = " + param + " OR EXISTS (SELECT 1 FROM materi_grants g WHERE g.id_materi = m.id AND g.user_id = " + param + "))"
+		args = append(args, scope.UserID)
+	}
 
 	// Count total
 	countQuery := `
@@ -328,7 +337,7 @@ func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity
 		` + whereClause
 
 	var total int64
-	err := r.db.QueryRow(countQuery, args...).Scan(&total)
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -351,7 +360,7 @@ func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity
 		LIMIT $` + strconv.Itoa(argCount+1) + ` OFFSET $` + strconv.Itoa(argCount+2)
 
 	args = append(args, pageSize, offset)
-	rows, err := r.db.Query(listQuery, args...)
+	rows, err := r.db.QueryContext(ctx, listQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -378,7 +387,7 @@ func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity
 			FROM drag_item
 			WHERE id_soal_drag_drop = $1
 			ORDER BY urutan ASC`
-		itemsRows, err := r.db.Query(itemsQuery, soal.ID)
+		itemsRows, err := r.db.QueryContext(ctx, itemsQuery, soal.ID)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -400,7 +409,7 @@ func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity
 			FROM drag_slot
 			WHERE id_soal_drag_drop = $1
 			ORDER BY urutan ASC`
-		slotsRows, err := r.db.Query(slotsQuery, soal.ID)
+		slotsRows, err := r.db.QueryContext(ctx, slotsQuery, soal.ID)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -423,7 +432,7 @@ func (r *repository) List(idMateri, idTingkat int, page, pageSize int) ([]entity
 }
 
 // GetActiveByMateri retrieves active drag-drop questions for a materi (for test sessions)
-func (r *repository) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDragDrop, error) {
+func (r *repository) GetActiveByMateri(ctx context.Context, idMateri int, limit int) ([]entity.SoalDragDrop, error) {
 	var soals []entity.SoalDragDrop
 
 	query := `
@@ -436,7 +445,7 @@ func (r *repository) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDr
 	if limit > 0 {
 		var total int
 		countQuery := `SELECT COUNT(*) FROM soal_drag_drop WHERE is_active = true AND id_materi = $1`
-		if err := r.db.QueryRow(countQuery, idMateri).Scan(&total); err != nil {
+		if err := r.db.QueryRowContext(ctx, countQuery, idMateri).Scan(&total); err != nil {
 			return nil, err
 		}
 
@@ -447,7 +456,7 @@ func (r *repository) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDr
 		}
 
 		query += ` ORDER BY sdd.urutan ASC, sdd.id ASC LIMIT $2 OFFSET $3`
-		rows, err := r.db.Query(query, idMateri, limit, offset)
+		rows, err := r.db.QueryContext(ctx, query, idMateri, limit, offset)
 		if err != nil {
 			return nil, err
 		}
@@ -466,12 +475,12 @@ func (r *repository) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDr
 			soal.Pembahasan = pembahasan
 
 			// Get items and slots for this soal
-			r.loadItemsAndSlotsForSoal(&soal)
+			r.loadItemsAndSlotsForSoal(ctx, &soal)
 			soals = append(soals, soal)
 		}
 	} else {
 		query += ` ORDER BY sdd.urutan ASC, sdd.id ASC`
-		rows, err := r.db.Query(query, idMateri)
+		rows, err := r.db.QueryContext(ctx, query, idMateri)
 		if err != nil {
 			return nil, err
 		}
@@ -490,7 +499,7 @@ func (r *repository) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDr
 			soal.Pembahasan = pembahasan
 
 			// Get items and slots for this soal
-			r.loadItemsAndSlotsForSoal(&soal)
+			r.loadItemsAndSlotsForSoal(ctx, &soal)
 			soals = append(soals, soal)
 		}
 	}
@@ -499,14 +508,14 @@ func (r *repository) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDr
 }
 
 // loadItemsAndSlotsForSoal loads items and slots for a soal (helper method)
-func (r *repository) loadItemsAndSlotsForSoal(soal *entity.SoalDragDrop) error {
+func (r *repository) loadItemsAndSlotsForSoal(ctx context.Context, soal *entity.SoalDragDrop) error {
 	// Get items
 	itemsQuery := `
 		SELECT id, id_soal_drag_drop, label, image_url, urutan, created_at
 		FROM drag_item
 		WHERE id_soal_drag_drop = $1
 		ORDER BY urutan ASC`
-	itemsRows, err := r.db.Query(itemsQuery, soal.ID)
+	itemsRows, err := r.db.QueryContext(ctx, itemsQuery, soal.ID)
 	if err != nil {
 		return err
 	}
@@ -527,7 +536,7 @@ func (r *repository) loadItemsAndSlotsForSoal(soal *entity.SoalDragDrop) error {
 		FROM drag_slot
 		WHERE id_soal_drag_drop = $1
 		ORDER BY urutan ASC`
-	slotsRows, err := r.db.Query(slotsQuery, soal.ID)
+	slotsRows, err := r.db.QueryContext(ctx, slotsQuery, soal.ID)
 	if err != nil {
 		return err
 	}
@@ -546,22 +555,22 @@ func (r *repository) loadItemsAndSlotsForSoal(soal *entity.SoalDragDrop) error {
 }
 
 // CountByMateri counts active drag-drop questions for a materi
-func (r *repository) CountByMateri(idMateri int) (int64, error) {
+func (r *repository) CountByMateri(ctx context.Context, idMateri int) (int64, error) {
 	var count int64
 	query := `SELECT COUNT(*) FROM soal_drag_drop WHERE is_active = true AND id_materi = $1`
-	err := r.db.QueryRow(query, idMateri).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, idMateri).Scan(&count)
 	return count, err
 }
 
-func (r *repository) ReorderByMateri(idMateri int, urutanByID map[int]int) error {
-	tx, err := r.db.Begin()
+func (r *repository) ReorderByMateri(ctx context.Context, idMateri int, urutanByID map[int]int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
 	for id, urutan := range urutanByID {
-		if _, err := tx.Exec(`UPDATE soal_drag_drop SET urutan = $1 WHERE id = $2 AND id_materi = $3`, urutan, id, idMateri); err != nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE soal_drag_drop SET urutan = $1 WHERE id = $2 AND id_materi = $3`, urutan, id, idMateri); err != nil {
 			return err
 		}
 	}
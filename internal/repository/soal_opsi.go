@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"github.com/lib/pq"
+)
+
+// Querier runs queries on a *sql.DB or inside a *sql.Tx
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// LoadSoalOpsi loads the options of the given soal in one query, in display order and keyed
+// by soal ID
+func LoadSoalOpsi(ctx context.Context, q Querier, soalIDs []int) (map[int][]entity.SoalOpsi, error) {
+	opsiBySoal := make(map[int][]entity.SoalOpsi)
+	if len(soalIDs) == 0 {
+		return opsiBySoal, nil
+	}
+	rows, err := q.QueryContext(ctx, `
+		SELECT id, id_soal, label, teks, urutan
+		FROM soal_opsi
+		WHERE id_soal = ANY($1)
+		ORDER BY id_soal, urutan ASC, label ASC`, pq.Array(soalIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o entity.SoalOpsi
+		if err := rows.Scan(&o.ID, &o.IDSoal, &o.Label, &o.Teks, &o.Urutan); err != nil {
+			return nil, err
+		}
+		opsiBySoal[o.IDSoal] = append(opsiBySoal[o.IDSoal], o)
+	}
+	return opsiBySoal, rows.Err()
+}
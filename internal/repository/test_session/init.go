@@ -1,99 +1,118 @@
 package test_session
 
 import (
-	"cbt-test-mini-project/internal/entity"
+	"context"
 	"time"
+
+	"cbt-test-mini-project/internal/entity"
 )
 
 // TestSessionRepository defines the interface for TestSession repository operations
 type TestSessionRepository interface {
 	// Create a new test session
-	Create(session *entity.TestSession) error
+	Create(ctx context.Context, session *entity.TestSession) error
 
 	// LMS sync: create session for LMS assignment/student if missing (idempotent)
-	CreateSessionForLMSUserIfMissing(lmsAssignmentID, lmsClassID, lmsUserID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time, status entity.TestStatus) (bool, error)
+	CreateSessionForLMSUserIfMissing(ctx context.Context, lmsAssignmentID, lmsClassID, lmsUserID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time, status entity.TestStatus) (bool, error)
 
 	// LMS sync: backfill missing sessions for a newly joined student based on active class assignments
-	BackfillSessionsForJoinedStudent(lmsClassID, lmsUserID int64) (int, error)
+	BackfillSessionsForJoinedStudent(ctx context.Context, lmsClassID, lmsUserID int64) (int, error)
 
-	// LMS sync: backfill missing sessions across all enrolled students from active assignment sessions
-	BackfillMissingSessions(lmsClassID *int64, lmsAssignmentID *int64) (int, error)
+	// LMS sync: backfill missing sessions across all enrolled students from active assignment sessions,
+	// limited to one school when lmsSchoolID is set
+	BackfillMissingSessions(ctx context.Context, lmsSchoolID, lmsClassID, lmsAssignmentID *int64) (int, error)
 
 	// LMS sync: update scheduled sessions for assignment lifecycle update
-	UpdateScheduledSessionsByAssignment(lmsAssignmentID int64, lmsClassID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time) (int64, error)
+	UpdateScheduledSessionsByAssignment(ctx context.Context, lmsAssignmentID int64, lmsClassID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time) (int64, error)
 
 	// LMS sync: delete all sessions for an LMS assignment
-	DeleteSessionsByAssignment(lmsAssignmentID int64) (int64, error)
+	DeleteSessionsByAssignment(ctx context.Context, lmsAssignmentID int64) (int64, error)
 
 	// Get session by token
-	GetByToken(token string) (*entity.TestSession, error)
+	GetByToken(ctx context.Context, token string) (*entity.TestSession, error)
 
 	// Update existing session
-	Update(session *entity.TestSession) error
+	Update(ctx context.Context, session *entity.TestSession) error
 
 	// Delete session by ID
-	Delete(id int) error
+	Delete(ctx context.Context, id int) error
 
 	// Complete session
-	CompleteSession(token string, waktuSelesai time.Time, nilaiAkhir *float64, jumlahBenar, totalSoal *int) error
+	CompleteSession(ctx context.Context, token string, waktuSelesai time.Time, nilaiAkhir *float64, jumlahBenar, totalSoal *int) error
 
 	// Update session status
-	UpdateSessionStatus(token string, status entity.TestStatus) error
+	UpdateSessionStatus(ctx context.Context, token string, status entity.TestStatus) error
 
 	// Assign random questions to session
-	AssignRandomQuestions(sessionID, idMataPelajaran, tingkatan, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) error
+	AssignRandomQuestions(ctx context.Context, sessionID, idMataPelajaran, tingkatan, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) error
 
-	// List sessions with filters
-	List(tingkatan, idMataPelajaran *int, status *entity.TestStatus, limit, offset int) ([]entity.TestSession, int, error)
+	// List sessions with filters, restricted to classes the teacher teaches when scope is non-nil
+	List(ctx context.Context, tingkatan, idMataPelajaran *int, status *entity.TestStatus, scope *entity.TeacherScope, limit, offset int) ([]entity.TestSession, int, error)
 
 	// List scheduled sessions for a specific user
-	ListScheduledByUser(userID int, lmsClassID *int64, limit, offset int) ([]entity.TestSession, int, error)
+	ListScheduledByUser(ctx context.Context, userID int, lmsClassID *int64, limit, offset int) ([]entity.TestSession, int, error)
 
 	// Start scheduled session when allowed
-	StartScheduledSession(token string, startedAt time.Time) (bool, error)
+	StartScheduledSession(ctx context.Context, token string, startedAt time.Time) (bool, error)
 
 	// Get questions for session
-	GetSessionQuestions(token string) ([]entity.TestSessionSoal, error)
+	GetSessionQuestions(ctx context.Context, token string) ([]entity.TestSessionSoal, error)
 
 	// Get all questions for session with soal data
-	GetAllQuestionsForSession(token string) ([]entity.TestSessionSoal, error)
+	GetAllQuestionsForSession(ctx context.Context, token string) ([]entity.TestSessionSoal, error)
 
 	// Get single question by order
-	GetQuestionByOrder(token string, nomorUrut int) (*entity.Soal, error)
+	GetQuestionByOrder(ctx context.Context, token string, nomorUrut int) (*entity.Soal, error)
 
 	// Submit answer (multiple choice)
-	SubmitAnswer(token string, nomorUrut int, jawaban entity.JawabanOption) error
+	SubmitAnswer(ctx context.Context, token string, nomorUrut int, jawaban entity.JawabanOption) error
 
 	// Submit answer (multiple choices complex)
-	SubmitComplexAnswer(token string, nomorUrut int, jawaban []entity.JawabanOption) error
+	SubmitComplexAnswer(ctx context.Context, token string, nomorUrut int, jawaban []entity.JawabanOption) error
+	SubmitShortAnswer(ctx context.Context, token string, nomorUrut int, jawaban []string, isCorrect bool) error
+	SubmitNumericAnswer(ctx context.Context, token string, nomorUrut int, jawaban string, isCorrect bool) error
+	SubmitHotspotAnswer(ctx context.Context, token string, nomorUrut int, points []entity.HotspotPoint, isCorrect bool) error
+	SubmitGridAnswer(ctx context.Context, token string, nomorUrut int, jawaban []int, isCorrect bool, nilaiParsial float64) error
+
+	// Audio and video clips of the session questions with how often each was played
+	GetSessionMedia(ctx context.Context, token string) ([]entity.SessionMedia, error)
+	GetSessionMediaByID(ctx context.Context, token string, nomorUrut, idMedia int) (*entity.SessionMedia, error)
+
+	// Count a play of a clip and store the hash of its stream nonce, failing with
+	// entity.ErrMediaPlayLimitReached once the clip is used up
+	RecordMediaPlay(ctx context.Context, token string, nomorUrut, idMedia int, streamNonceHash string) (*entity.SessionMedia, error)
+
+	// Mark the stream nonce of the latest play of a clip as used; false when it does not
+	// match or was already used
+	ClaimMediaStream(ctx context.Context, token string, nomorUrut, idMedia int, streamNonceHash string) (bool, error)
 
 	// Clear answer
-	ClearAnswer(token string, nomorUrut int) error
+	ClearAnswer(ctx context.Context, token string, nomorUrut int) error
 
 	// Get answers for session
-	GetSessionAnswers(token string) ([]entity.JawabanSiswa, error)
+	GetSessionAnswers(ctx context.Context, token string) ([]entity.JawabanSiswa, error)
 
 	// Create unanswered record with NULL jawaban_dipilih
-	CreateUnansweredRecord(sessionSoalID, testSessionID int) error
+	CreateUnansweredRecord(ctx context.Context, sessionSoalID, testSessionID int) error
 
 	// NEW: Get TestSessionSoal by token and nomor_urut
-	GetTestSessionSoalByOrder(token string, nomorUrut int) (*entity.TestSessionSoal, error)
+	GetTestSessionSoalByOrder(ctx context.Context, token string, nomorUrut int) (*entity.TestSessionSoal, error)
 
 	// NEW: Submit drag-drop answer
-	SubmitDragDropAnswer(token string, nomorUrut int, answer map[int]int, isCorrect bool) error
+	SubmitDragDropAnswer(ctx context.Context, token string, nomorUrut int, answer map[int]int, isCorrect bool) error
 
 	// Submit essay answer
-	SubmitEssayAnswer(token string, nomorUrut int, jawabanEssay string) error
+	SubmitEssayAnswer(ctx context.Context, token string, nomorUrut int, jawabanEssay string) error
 
-	// Grade essay answer and recalculate affected session
-	GradeEssayAnswer(answerID int, score float64, feedback string) (string, error)
+	// Check if session has answered essays that still need a score
+	HasPendingEssays(ctx context.Context, token string) (bool, error)
 
-	// Check if session has essay questions
-	HasEssayQuestions(token string) (bool, error)
+	// Get rubric scores of a session's essay answers keyed by nomor_urut
+	GetRubricScoresBySession(ctx context.Context, token string) (map[int][]entity.JawabanRubricScore, error)
 
 	// NEW: Get correct answers for a drag-drop question
-	GetDragDropCorrectAnswers(soalDragDropID int) ([]entity.DragCorrectAnswer, error)
+	GetDragDropCorrectAnswers(ctx context.Context, soalDragDropID int) ([]entity.DragCorrectAnswer, error)
 
 	// NEW: Get drag-drop question by ID
-	GetSoalDragDropByID(id int) (*entity.SoalDragDrop, error)
+	GetSoalDragDropByID(ctx context.Context, id int) (*entity.SoalDragDrop, error)
 }
@@ -1,8 +1,7 @@
 package test_session
 
 import (
-	"cbt-test-mini-project/internal/entity"
-	"cbt-test-mini-project/internal/event/contracts"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -11,6 +10,11 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/event/contracts"
+	"cbt-test-mini-project/internal/repository"
+	"cbt-test-mini-project/util/teacherscope"
 )
 
 // testSessionRepositoryImpl implements TestSessionRepository
@@ -24,16 +28,16 @@ func NewTestSessionRepository(db *sql.DB) TestSessionRepository {
 }
 
 // Create a new test session
-func (r *testSessionRepositoryImpl) Create(session *entity.TestSession) error {
+func (r *testSessionRepositoryImpl) Create(ctx context.Context, session *entity.TestSession) error {
 	query := `
 		INSERT INTO test_session (session_token, user_id, nama_peserta, id_tingkat, id_mata_pelajaran, waktu_mulai, waktu_selesai, durasi_menit, nilai_akhir, jumlah_benar, total_soal, status, lms_assignment_id, lms_class_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING id`
-	return r.db.QueryRow(query, session.SessionToken, session.UserID, session.NamaPeserta, session.IDTingkat, session.IDMataPelajaran, session.WaktuMulai, session.WaktuSelesai, session.DurasiMenit, session.NilaiAkhir, session.JumlahBenar, session.TotalSoal, string(session.Status), session.LMSAssignmentID, session.LMSClassID).Scan(&session.ID)
+	return r.db.QueryRowContext(ctx, query, session.SessionToken, session.UserID, session.NamaPeserta, session.IDTingkat, session.IDMataPelajaran, session.WaktuMulai, session.WaktuSelesai, session.DurasiMenit, session.NilaiAkhir, session.JumlahBenar, session.TotalSoal, string(session.Status), session.LMSAssignmentID, session.LMSClassID).Scan(&session.ID)
 }
 
 // Get session by token
-func (r *testSessionRepositoryImpl) GetByToken(token string) (*entity.TestSession, error) {
+func (r *testSessionRepositoryImpl) GetByToken(ctx context.Context, token string) (*entity.TestSession, error) {
 	var session entity.TestSession
 	// Initialize User pointer to avoid nil pointer dereference during scan
 	session.User = &entity.User{}
@@ -48,7 +52,7 @@ func (r *testSessionRepositoryImpl) GetByToken(token string) (*entity.TestSessio
 		JOIN tingkat t ON ts.id_tingkat = t.id
 		JOIN users u ON ts.user_id = u.id
 		WHERE ts.session_token = $1 AND ts.deleted_at IS NULL`
-	err := r.db.QueryRow(query, token).Scan(
+	err := r.db.QueryRowContext(ctx, query, token).Scan(
 		&session.ID, &session.SessionToken, &session.UserID, &session.NamaPeserta, &session.IDTingkat, &session.IDMataPelajaran, &session.WaktuMulai, &session.WaktuSelesai, &session.DurasiMenit, &session.NilaiAkhir, &session.JumlahBenar, &session.TotalSoal, &session.Status, &session.LMSAssignmentID,
 		&session.MataPelajaran.ID, &session.MataPelajaran.Nama, &session.MataPelajaran.IsActive, &session.MataPelajaran.LmsSubjectID, &session.MataPelajaran.LmsSchoolID, &session.MataPelajaran.LmsClassID,
 		&session.Tingkat.ID, &session.Tingkat.Nama, &session.Tingkat.IsActive, &session.Tingkat.LmsLevelID,
@@ -61,21 +65,21 @@ func (r *testSessionRepositoryImpl) GetByToken(token string) (*entity.TestSessio
 }
 
 // Update existing session
-func (r *testSessionRepositoryImpl) Update(session *entity.TestSession) error {
+func (r *testSessionRepositoryImpl) Update(ctx context.Context, session *entity.TestSession) error {
 	query := `
 		UPDATE test_session
 		SET session_token = $1, user_id = $2, nama_peserta = $3, id_tingkat = $4, id_mata_pelajaran = $5, waktu_mulai = $6, waktu_selesai = $7, durasi_menit = $8, nilai_akhir = $9, jumlah_benar = $10, total_soal = $11, status = $12, lms_assignment_id = $13, lms_class_id = $14
 		WHERE id = $15`
-	_, err := r.db.Exec(query, session.SessionToken, session.UserID, session.NamaPeserta, session.IDTingkat, session.IDMataPelajaran, session.WaktuMulai, session.WaktuSelesai, session.DurasiMenit, session.NilaiAkhir, session.JumlahBenar, session.TotalSoal, string(session.Status), session.LMSAssignmentID, session.LMSClassID, session.ID)
+	_, err := r.db.ExecContext(ctx, query, session.SessionToken, session.UserID, session.NamaPeserta, session.IDTingkat, session.IDMataPelajaran, session.WaktuMulai, session.WaktuSelesai, session.DurasiMenit, session.NilaiAkhir, session.JumlahBenar, session.TotalSoal, string(session.Status), session.LMSAssignmentID, session.LMSClassID, session.ID)
 	return err
 }
 
-func (r *testSessionRepositoryImpl) CreateSessionForLMSUserIfMissing(lmsAssignmentID, lmsClassID, lmsUserID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time, status entity.TestStatus) (bool, error) {
+func (r *testSessionRepositoryImpl) CreateSessionForLMSUserIfMissing(ctx context.Context, lmsAssignmentID, lmsClassID, lmsUserID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time, status entity.TestStatus) (bool, error) {
 	var userID int
 	var namaPeserta string
 	var isActive bool
 
-	if err := r.db.QueryRow(`SELECT id, full_name, is_active FROM users WHERE lms_user_id = $1`, lmsUserID).Scan(&userID, &namaPeserta, &isActive); err != nil {
+	if err := r.db.QueryRowContext(ctx, `SELECT id, full_name, is_active FROM users WHERE lms_user_id = $1`, lmsUserID).Scan(&userID, &namaPeserta, &isActive); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, fmt.Errorf("active membership resolution failed: local user not found for lms_user_id=%d", lmsUserID)
 		}
@@ -86,7 +90,7 @@ func (r *testSessionRepositoryImpl) CreateSessionForLMSUserIfMissing(lmsAssignme
 	}
 
 	var existingID int
-	err := r.db.QueryRow(`SELECT id FROM test_session WHERE lms_assignment_id = $1 AND user_id = $2 LIMIT 1`, lmsAssignmentID, userID).Scan(&existingID)
+	err := r.db.QueryRowContext(ctx, `SELECT id FROM test_session WHERE lms_assignment_id = $1 AND user_id = $2 LIMIT 1`, lmsAssignmentID, userID).Scan(&existingID)
 	if err == nil {
 		return false, nil
 	}
@@ -103,7 +107,7 @@ func (r *testSessionRepositoryImpl) CreateSessionForLMSUserIfMissing(lmsAssignme
 		)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 	`
-	_, err = r.db.Exec(query, sessionToken, namaPeserta, idTingkat, idMataPelajaran, userID, scheduledTime, durasiMenit, totalSoal, string(status), lmsAssignmentID, lmsClassID)
+	_, err = r.db.ExecContext(ctx, query, sessionToken, namaPeserta, idTingkat, idMataPelajaran, userID, scheduledTime, durasiMenit, totalSoal, string(status), lmsAssignmentID, lmsClassID)
 	if err != nil {
 		return false, err
 	}
@@ -111,7 +115,7 @@ func (r *testSessionRepositoryImpl) CreateSessionForLMSUserIfMissing(lmsAssignme
 	return true, nil
 }
 
-func (r *testSessionRepositoryImpl) BackfillSessionsForJoinedStudent(lmsClassID, lmsUserID int64) (int, error) {
+func (r *testSessionRepositoryImpl) BackfillSessionsForJoinedStudent(ctx context.Context, lmsClassID, lmsUserID int64) (int, error) {
 	query := `
 		WITH active_assignments AS (
 			SELECT DISTINCT lms_assignment_id, lms_class_id, id_tingkat, id_mata_pelajaran, waktu_mulai, durasi_menit, total_soal,
@@ -152,7 +156,7 @@ func (r *testSessionRepositoryImpl) BackfillSessionsForJoinedStudent(lmsClassID,
 				AND existing.user_id = u.id
 		)
 	`
-	result, err := r.db.Exec(query, lmsClassID, lmsUserID)
+	result, err := r.db.ExecContext(ctx, query, lmsClassID, lmsUserID)
 	if err != nil {
 		return 0, err
 	}
@@ -165,13 +169,17 @@ func (r *testSessionRepositoryImpl) BackfillSessionsForJoinedStudent(lmsClassID,
 	return int(rows), nil
 }
 
-func (r *testSessionRepositoryImpl) BackfillMissingSessions(lmsClassID *int64, lmsAssignmentID *int64) (int, error) {
+func (r *testSessionRepositoryImpl) BackfillMissingSessions(ctx context.Context, lmsSchoolID, lmsClassID, lmsAssignmentID *int64) (int, error) {
 	where := []string{
 		"ts.lms_assignment_id IS NOT NULL",
 		"ts.status IN ('scheduled', 'ongoing')",
 	}
 	args := make([]interface{}, 0)
 
+	if lmsSchoolID != nil {
+		args = append(args, *lmsSchoolID)
+		where = append(where, fmt.Sprintf("ts.school_id = $%d", len(args)))
+	}
 	if lmsClassID != nil {
 		args = append(args, *lmsClassID)
 		where = append(where, fmt.Sprintf("ts.lms_class_id = $%d", len(args)))
@@ -221,7 +229,7 @@ func (r *testSessionRepositoryImpl) BackfillMissingSessions(lmsClassID *int64, l
 		)
 	`, strings.Join(where, " AND "))
 
-	result, err := r.db.Exec(query, args...)
+	result, err := r.db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, err
 	}
@@ -234,7 +242,7 @@ func (r *testSessionRepositoryImpl) BackfillMissingSessions(lmsClassID *int64, l
 	return int(rows), nil
 }
 
-func (r *testSessionRepositoryImpl) UpdateScheduledSessionsByAssignment(lmsAssignmentID int64, lmsClassID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time) (int64, error) {
+func (r *testSessionRepositoryImpl) UpdateScheduledSessionsByAssignment(ctx context.Context, lmsAssignmentID int64, lmsClassID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time) (int64, error) {
 	query := `
 		UPDATE test_session
 		SET id_mata_pelajaran = $1,
@@ -247,7 +255,7 @@ func (r *testSessionRepositoryImpl) UpdateScheduledSessionsByAssignment(lmsAssig
 		WHERE lms_assignment_id = $7
 			AND status = 'scheduled'
 	`
-	result, err := r.db.Exec(query, idMataPelajaran, idTingkat, durasiMenit, totalSoal, scheduledTime, lmsClassID, lmsAssignmentID)
+	result, err := r.db.ExecContext(ctx, query, idMataPelajaran, idTingkat, durasiMenit, totalSoal, scheduledTime, lmsClassID, lmsAssignmentID)
 	if err != nil {
 		return 0, err
 	}
@@ -255,8 +263,8 @@ func (r *testSessionRepositoryImpl) UpdateScheduledSessionsByAssignment(lmsAssig
 	return result.RowsAffected()
 }
 
-func (r *testSessionRepositoryImpl) DeleteSessionsByAssignment(lmsAssignmentID int64) (int64, error) {
-	result, err := r.db.Exec(`UPDATE test_session SET deleted_at = NOW() WHERE lms_assignment_id = $1 AND deleted_at IS NULL`, lmsAssignmentID)
+func (r *testSessionRepositoryImpl) DeleteSessionsByAssignment(ctx context.Context, lmsAssignmentID int64) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `UPDATE test_session SET deleted_at = NOW() WHERE lms_assignment_id = $1 AND deleted_at IS NULL`, lmsAssignmentID)
 	if err != nil {
 		return 0, err
 	}
@@ -265,15 +273,15 @@ func (r *testSessionRepositoryImpl) DeleteSessionsByAssignment(lmsAssignmentID i
 }
 
 // Delete session by ID
-func (r *testSessionRepositoryImpl) Delete(id int) error {
+func (r *testSessionRepositoryImpl) Delete(ctx context.Context, id int) error {
 	query := `UPDATE test_session SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
 
 // Complete session
-func (r *testSessionRepositoryImpl) CompleteSession(token string, waktuSelesai time.Time, nilaiAkhir *float64, jumlahBenar, totalSoal *int) error {
-	tx, err := r.db.Begin()
+func (r *testSessionRepositoryImpl) CompleteSession(ctx context.Context, token string, waktuSelesai time.Time, nilaiAkhir *float64, jumlahBenar, totalSoal *int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -297,7 +305,8 @@ func (r *testSessionRepositoryImpl) CompleteSession(token string, waktuSelesai t
 	var lmsClassID sql.NullInt64
 	var userID sql.NullInt64
 
-	err = tx.QueryRow(
+	err = tx.QueryRowContext(
+		ctx,
 		query,
 		waktuSelesai,
 		nilaiAkhir,
@@ -317,7 +326,7 @@ func (r *testSessionRepositoryImpl) CompleteSession(token string, waktuSelesai t
 	if lmsAssignmentID.Valid && lmsClassID.Valid && userID.Valid && nilaiAkhir != nil && jumlahBenar != nil && totalSoal != nil {
 		var lmsUserID sql.NullInt64
 		userQuery := `SELECT lms_user_id FROM users WHERE id = $1 AND is_active = true`
-		if err := tx.QueryRow(userQuery, userID.Int64).Scan(&lmsUserID); err != nil {
+		if err := tx.QueryRowContext(ctx, userQuery, userID.Int64).Scan(&lmsUserID); err != nil {
 			if !errors.Is(err, sql.ErrNoRows) {
 				return err
 			}
@@ -327,10 +336,10 @@ func (r *testSessionRepositoryImpl) CompleteSession(token string, waktuSelesai t
 				AssignmentID: lmsAssignmentID.Int64,
 				UserID:       lmsUserID.Int64,
 				ClassID:      lmsClassID.Int64,
-				Score:           *nilaiAkhir,
-				CorrectCount:    *jumlahBenar,
-				TotalCount:      *totalSoal,
-				CompletedAt:     waktuSelesai.UTC().Format(time.RFC3339),
+				Score:        *nilaiAkhir,
+				CorrectCount: *jumlahBenar,
+				TotalCount:   *totalSoal,
+				CompletedAt:  waktuSelesai.UTC().Format(time.RFC3339),
 			}
 
 			payloadJSON, marshalErr := json.Marshal(payload)
@@ -341,7 +350,7 @@ func (r *testSessionRepositoryImpl) CompleteSession(token string, waktuSelesai t
 			outboxQuery := `
 				INSERT INTO cbt_outbox (event_type, aggregate_type, aggregate_id, payload, status, retry_count, created_at, updated_at)
 				VALUES ($1, $2, $3, $4::jsonb, 'pending', 0, NOW(), NOW())`
-			if _, err := tx.Exec(outboxQuery, string(contracts.ExamResultCompleted), "test_session", sessionID, string(payloadJSON)); err != nil {
+			if _, err := tx.ExecContext(ctx, outboxQuery, string(contracts.ExamResultCompleted), "test_session", sessionID, string(payloadJSON)); err != nil {
 				return err
 			}
 		}
@@ -356,14 +365,14 @@ func (r *testSessionRepositoryImpl) CompleteSession(token string, waktuSelesai t
 }
 
 // UpdateSessionStatus updates only the status of a session
-func (r *testSessionRepositoryImpl) UpdateSessionStatus(token string, status entity.TestStatus) error {
+func (r *testSessionRepositoryImpl) UpdateSessionStatus(ctx context.Context, token string, status entity.TestStatus) error {
 	query := `UPDATE test_session SET status = $1, updated_at = $2 WHERE session_token = $3`
-	_, err := r.db.Exec(query, string(status), time.Now(), token)
+	_, err := r.db.ExecContext(ctx, query, string(status), time.Now(), token)
 	return err
 }
 
 // List sessions with filters
-func (r *testSessionRepositoryImpl) List(tingkatan, idMataPelajaran *int, status *entity.TestStatus, limit, offset int) ([]entity.TestSession, int, error) {
+func (r *testSessionRepositoryImpl) List(ctx context.Context, tingkatan, idMataPelajaran *int, status *entity.TestStatus, scope *entity.TeacherScope, limit, offset int) ([]entity.TestSession, int, error) {
 	var sessions []entity.TestSession
 	var total int
 
@@ -384,12 +393,16 @@ func (r *testSessionRepositoryImpl) List(tingkatan, idMataPelajaran *int, status
 		countConditions = append(countConditions, "ts.status = $"+fmt.Sprintf("%d", len(countArgs)+1))
 		countArgs = append(countArgs, string(*status))
 	}
+	if scope != nil {
+		countConditions = append(countConditions, teacherscope.TaughtClassCondition("ts.lms_class_id", len(countArgs)+1))
+		countArgs = append(countArgs, scope.UserID)
+	}
 
 	if len(countConditions) > 0 {
 		countQuery += " AND " + strings.Join(countConditions, " AND ")
 	}
 
-	err := r.db.QueryRow(countQuery, countArgs...).Scan(&total)
+	err := r.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -420,6 +433,10 @@ func (r *testSessionRepositoryImpl) List(tingkatan, idMataPelajaran *int, status
 		dataConditions = append(dataConditions, "ts.status = $"+fmt.Sprintf("%d", len(dataArgs)+1))
 		dataArgs = append(dataArgs, string(*status))
 	}
+	if scope != nil {
+		dataConditions = append(dataConditions, teacherscope.TaughtClassCondition("ts.lms_class_id", len(dataArgs)+1))
+		dataArgs = append(dataArgs, scope.UserID)
+	}
 
 	if len(dataConditions) > 0 {
 		dataQuery += " AND " + strings.Join(dataConditions, " AND ")
@@ -428,7 +445,7 @@ func (r *testSessionRepositoryImpl) List(tingkatan, idMataPelajaran *int, status
 	dataQuery += " ORDER BY ts.created_at DESC LIMIT $" + fmt.Sprintf("%d", len(dataArgs)+1) + " OFFSET $" + fmt.Sprintf("%d", len(dataArgs)+2)
 	dataArgs = append(dataArgs, limit, offset)
 
-	rows, err := r.db.Query(dataQuery, dataArgs...)
+	rows, err := r.db.QueryContext(ctx, dataQuery, dataArgs...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -453,7 +470,7 @@ func (r *testSessionRepositoryImpl) List(tingkatan, idMataPelajaran *int, status
 	return sessions, total, nil
 }
 
-func (r *testSessionRepositoryImpl) ListScheduledByUser(userID int, lmsClassID *int64, limit, offset int) ([]entity.TestSession, int, error) {
+func (r *testSessionRepositoryImpl) ListScheduledByUser(ctx context.Context, userID int, lmsClassID *int64, limit, offset int) ([]entity.TestSession, int, error) {
 	var sessions []entity.TestSession
 	var total int
 
@@ -464,7 +481,7 @@ func (r *testSessionRepositoryImpl) ListScheduledByUser(userID int, lmsClassID *
 		countArgs = append(countArgs, *lmsClassID)
 	}
 
-	if err := r.db.QueryRow(countQuery, countArgs...).Scan(&total); err != nil {
+	if err := r.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
 		return nil, 0, err
 	}
 
@@ -487,7 +504,7 @@ func (r *testSessionRepositoryImpl) ListScheduledByUser(userID int, lmsClassID *
 	dataQuery += " ORDER BY ts.waktu_mulai ASC LIMIT $" + fmt.Sprintf("%d", len(dataArgs)+1) + " OFFSET $" + fmt.Sprintf("%d", len(dataArgs)+2)
 	dataArgs = append(dataArgs, limit, offset)
 
-	rows, err := r.db.Query(dataQuery, dataArgs...)
+	rows, err := r.db.QueryContext(ctx, dataQuery, dataArgs...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -511,7 +528,7 @@ func (r *testSessionRepositoryImpl) ListScheduledByUser(userID int, lmsClassID *
 	return sessions, total, nil
 }
 
-func (r *testSessionRepositoryImpl) StartScheduledSession(token string, startedAt time.Time) (bool, error) {
+func (r *testSessionRepositoryImpl) StartScheduledSession(ctx context.Context, token string, startedAt time.Time) (bool, error) {
 	query := `
 		UPDATE test_session
 		SET status = 'ongoing'::test_session_status_enum,
@@ -520,7 +537,7 @@ func (r *testSessionRepositoryImpl) StartScheduledSession(token string, startedA
 		WHERE session_token = $3
 			AND status = 'scheduled'::test_session_status_enum
 	`
-	result, err := r.db.Exec(query, startedAt, time.Now(), token)
+	result, err := r.db.ExecContext(ctx, query, startedAt, time.Now(), token)
 	if err != nil {
 		return false, err
 	}
@@ -534,7 +551,7 @@ func (r *testSessionRepositoryImpl) StartScheduledSession(token string, startedA
 }
 
 // Get questions for session
-func (r *testSessionRepositoryImpl) GetSessionQuestions(token string) ([]entity.TestSessionSoal, error) {
+func (r *testSessionRepositoryImpl) GetSessionQuestions(ctx context.Context, token string) ([]entity.TestSessionSoal, error) {
 	query := `
 		SELECT tss.id, tss.id_test_session, tss.question_type, tss.id_soal, tss.id_soal_drag_drop, tss.point, tss.nomor_urut,
 		       s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.id_materi,
@@ -545,7 +562,7 @@ func (r *testSessionRepositoryImpl) GetSessionQuestions(token string) ([]entity.
 		LEFT JOIN materi m ON s.id_materi = m.id
 		WHERE ts.session_token = $1
 		ORDER BY tss.nomor_urut`
-	rows, err := r.db.Query(query, token)
+	rows, err := r.db.QueryContext(ctx, query, token)
 	if err != nil {
 		return nil, err
 	}
@@ -611,14 +628,20 @@ func (r *testSessionRepositoryImpl) GetSessionQuestions(token string) ([]entity.
 
 		sessionSoals = append(sessionSoals, tss)
 	}
+	if err := r.attachSessionOpsi(ctx, sessionSoals); err != nil {
+		return nil, err
+	}
+	if err := r.attachSessionGambar(ctx, token, sessionSoals); err != nil {
+		return nil, err
+	}
 	return sessionSoals, nil
 }
 
 // Get all questions for session with soal data
-func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(token string) ([]entity.TestSessionSoal, error) {
+func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(ctx context.Context, token string) ([]entity.TestSessionSoal, error) {
 	query := `
 		SELECT tss.id, tss.id_test_session, tss.question_type, tss.id_soal, tss.id_soal_drag_drop, tss.point, tss.nomor_urut,
-		       s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.id_materi,
+		       s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.jawaban_short_answer, s.jawaban_numeric, s.jawaban_hotspot, s.jawaban_grid, s.format_konten, s.id_materi,
 		       m.id, m.nama, m.id_mata_pelajaran, m.id_tingkat, mp.id, mp.nama, mp.is_active, t.id, t.nama, t.is_active,
 		       sdd.id, sdd.pertanyaan, sdd.point, sdd.id_materi
 		FROM test_session_soal tss
@@ -630,7 +653,7 @@ func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(token string) ([]e
 		LEFT JOIN soal_drag_drop sdd ON tss.id_soal_drag_drop = sdd.id
 		WHERE ts.session_token = $1
 		ORDER BY tss.nomor_urut`
-	rows, err := r.db.Query(query, token)
+	rows, err := r.db.QueryContext(ctx, query, token)
 	if err != nil {
 		return nil, err
 	}
@@ -647,7 +670,7 @@ func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(token string) ([]e
 
 		// Use nullable types for LEFT JOIN columns
 		var soalID, soalIDMateri sql.NullInt64
-		var soalPertanyaan, soalQuestionType, soalOpsiA, soalOpsiB, soalOpsiC, soalOpsiD, soalJawabanBenar, soalJawabanBenarComplex, soalJawabanEssayKey sql.NullString
+		var soalPertanyaan, soalQuestionType, soalOpsiA, soalOpsiB, soalOpsiC, soalOpsiD, soalJawabanBenar, soalJawabanBenarComplex, soalJawabanEssayKey, soalJawabanShortAnswer, soalJawabanNumeric, soalJawabanHotspot, soalJawabanGrid, soalFormatKonten sql.NullString
 		var soalPoint sql.NullFloat64
 		var materiID, materiIDMataPelajaran, materiIDTingkat sql.NullInt64
 		var materiNama sql.NullString
@@ -663,7 +686,7 @@ func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(token string) ([]e
 
 		err := rows.Scan(
 			&tss.ID, &tss.IDTestSession, &tss.QuestionType, &tss.IDSoal, &tss.IDSoalDragDrop, &tss.Point, &tss.NomorUrut,
-			&soalID, &soalPertanyaan, &soalPoint, &soalQuestionType, &soalOpsiA, &soalOpsiB, &soalOpsiC, &soalOpsiD, &soalJawabanBenar, &soalJawabanBenarComplex, &soalJawabanEssayKey, &soalIDMateri,
+			&soalID, &soalPertanyaan, &soalPoint, &soalQuestionType, &soalOpsiA, &soalOpsiB, &soalOpsiC, &soalOpsiD, &soalJawabanBenar, &soalJawabanBenarComplex, &soalJawabanEssayKey, &soalJawabanShortAnswer, &soalJawabanNumeric, &soalJawabanHotspot, &soalJawabanGrid, &soalFormatKonten, &soalIDMateri,
 			&materiID, &materiNama, &materiIDMataPelajaran, &materiIDTingkat, &mataPelajaranID, &mataPelajaranNama, &mataPelajaranIsActive, &tingkatID, &tingkatNama, &tingkatIsActive,
 			&sddID, &sddPertanyaan, &sddPoint, &sddIDMateri,
 		)
@@ -690,6 +713,19 @@ func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(token string) ([]e
 			if soalJawabanEssayKey.Valid {
 				soal.JawabanEssayKey = &soalJawabanEssayKey.String
 			}
+			if soalJawabanShortAnswer.Valid {
+				soal.JawabanShortAnswer = &soalJawabanShortAnswer.String
+			}
+			if soalJawabanNumeric.Valid {
+				soal.JawabanNumeric = &soalJawabanNumeric.String
+			}
+			if soalJawabanHotspot.Valid {
+				soal.JawabanHotspot = &soalJawabanHotspot.String
+			}
+			if soalJawabanGrid.Valid {
+				soal.JawabanGrid = &soalJawabanGrid.String
+			}
+			soal.FormatKonten = entity.FormatKonten(soalFormatKonten.String)
 			if soalIDMateri.Valid {
 				soal.IDMateri = int(soalIDMateri.Int64)
 			}
@@ -734,13 +770,19 @@ func (r *testSessionRepositoryImpl) GetAllQuestionsForSession(token string) ([]e
 
 		sessionSoals = append(sessionSoals, tss)
 	}
+	if err := r.attachSessionOpsi(ctx, sessionSoals); err != nil {
+		return nil, err
+	}
+	if err := r.attachSessionGambar(ctx, token, sessionSoals); err != nil {
+		return nil, err
+	}
 	return sessionSoals, nil
 }
 
 // Get single question by order
-func (r *testSessionRepositoryImpl) GetQuestionByOrder(token string, nomorUrut int) (*entity.Soal, error) {
+func (r *testSessionRepositoryImpl) GetQuestionByOrder(ctx context.Context, token string, nomorUrut int) (*entity.Soal, error) {
 	query := `
-		SELECT s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.id_materi,
+		SELECT s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.format_konten, s.id_materi,
 		       m.id, m.nama, m.id_mata_pelajaran, m.id_tingkat,
 		       mp.id, mp.nama, mp.is_active, mp.lms_subject_id, mp.lms_school_id, mp.lms_class_id,
 		       t.id, t.nama, t.is_active, t.lms_level_id
@@ -758,8 +800,8 @@ func (r *testSessionRepositoryImpl) GetQuestionByOrder(token string, nomorUrut i
 	var soalQuestionType sql.NullString
 	var jawabanBenarComplex sql.NullString
 	var jawabanEssayKey sql.NullString
-	err := r.db.QueryRow(query, token, nomorUrut).Scan(
-		&soal.ID, &soal.Pertanyaan, &soal.Point, &soalQuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &jawabanEssayKey, &soal.IDMateri,
+	err := r.db.QueryRowContext(ctx, query, token, nomorUrut).Scan(
+		&soal.ID, &soal.Pertanyaan, &soal.Point, &soalQuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &jawabanEssayKey, &soal.FormatKonten, &soal.IDMateri,
 		&materi.ID, &materi.Nama, &materi.IDMataPelajaran, &materi.IDTingkat,
 		&mataPelajaran.ID, &mataPelajaran.Nama, &mataPelajaran.IsActive, &mataPelajaran.LmsSubjectID, &mataPelajaran.LmsSchoolID, &mataPelajaran.LmsClassID,
 		&tingkat.ID, &tingkat.Nama, &tingkat.IsActive, &tingkat.LmsLevelID,
@@ -779,11 +821,18 @@ func (r *testSessionRepositoryImpl) GetQuestionByOrder(token string, nomorUrut i
 	if jawabanEssayKey.Valid {
 		soal.JawabanEssayKey = &jawabanEssayKey.String
 	}
+	if entity.IsChoiceQuestion(soal.QuestionType) {
+		opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, []int{soal.ID})
+		if err != nil {
+			return nil, err
+		}
+		soal.Opsi = opsiBySoal[soal.ID]
+	}
 	return &soal, nil
 }
 
 // Submit answer
-func (r *testSessionRepositoryImpl) SubmitAnswer(token string, nomorUrut int, jawaban entity.JawabanOption) error {
+func (r *testSessionRepositoryImpl) SubmitAnswer(ctx context.Context, token string, nomorUrut int, jawaban entity.JawabanOption) error {
 	// Find the TestSessionSoal
 	var tss entity.TestSessionSoal
 	var soal entity.Soal
@@ -794,7 +843,7 @@ func (r *testSessionRepositoryImpl) SubmitAnswer(token string, nomorUrut int, ja
 		JOIN test_session ts ON tss.id_test_session = ts.id
 		JOIN soal s ON tss.id_soal = s.id
 		WHERE ts.session_token = $1 AND tss.nomor_urut = $2`
-	err := r.db.QueryRow(query, token, nomorUrut).Scan(
+	err := r.db.QueryRowContext(ctx, query, token, nomorUrut).Scan(
 		&tss.ID, &tss.IDTestSession, &tss.QuestionType, &tss.IDSoal, &tss.IDSoalDragDrop, &tss.Point, &tss.NomorUrut,
 		&soal.ID, &soal.Pertanyaan, &soal.Point, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &soal.IDMateri,
 	)
@@ -807,6 +856,15 @@ func (r *testSessionRepositoryImpl) SubmitAnswer(token string, nomorUrut int, ja
 	if tss.QuestionType != entity.QuestionTypeMultipleChoice {
 		return errors.New("this is not a multiple-choice question")
 	}
+	soal.QuestionType = tss.QuestionType
+	opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, []int{soal.ID})
+	if err != nil {
+		return err
+	}
+	soal.Opsi = opsiBySoal[soal.ID]
+	if !soal.HasOption(jawaban) {
+		return errors.New("invalid jawaban option")
+	}
 	isCorrect := (jawaban == tss.Soal.JawabanBenar)
 	newAnswer := entity.JawabanSiswa{
 		IDTestSessionSoal: tss.ID,
@@ -821,12 +879,12 @@ func (r *testSessionRepositoryImpl) SubmitAnswer(token string, nomorUrut int, ja
 		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (id_test_session_soal)
 		DO UPDATE SET jawaban_dipilih = EXCLUDED.jawaban_dipilih, is_correct = EXCLUDED.is_correct, dijawab_pada = EXCLUDED.dijawab_pada`
-	_, err = r.db.Exec(upsertQuery, newAnswer.IDTestSessionSoal, newAnswer.JawabanDipilih, newAnswer.IsCorrect, string(newAnswer.QuestionType), time.Now())
+	_, err = r.db.ExecContext(ctx, upsertQuery, newAnswer.IDTestSessionSoal, newAnswer.JawabanDipilih, newAnswer.IsCorrect, string(newAnswer.QuestionType), time.Now())
 	return err
 }
 
 // Clear answer
-func (r *testSessionRepositoryImpl) ClearAnswer(token string, nomorUrut int) error {
+func (r *testSessionRepositoryImpl) ClearAnswer(ctx context.Context, token string, nomorUrut int) error {
 	// Find the TestSessionSoal
 	var tssID int
 	query := `
@@ -834,22 +892,22 @@ func (r *testSessionRepositoryImpl) ClearAnswer(token string, nomorUrut int) err
 		FROM test_session_soal tss
 		JOIN test_session ts ON tss.id_test_session = ts.id
 		WHERE ts.session_token = $1 AND tss.nomor_urut = $2`
-	err := r.db.QueryRow(query, token, nomorUrut).Scan(&tssID)
+	err := r.db.QueryRowContext(ctx, query, token, nomorUrut).Scan(&tssID)
 	if err != nil {
 		return err
 	}
 
 	// Delete the answer if exists
 	deleteQuery := `DELETE FROM jawaban_siswa WHERE id_test_session_soal = $1`
-	_, err = r.db.Exec(deleteQuery, tssID)
+	_, err = r.db.ExecContext(ctx, deleteQuery, tssID)
 	return err
 }
 
 // Get answers for session
-func (r *testSessionRepositoryImpl) GetSessionAnswers(token string) ([]entity.JawabanSiswa, error) {
+func (r *testSessionRepositoryImpl) GetSessionAnswers(ctx context.Context, token string) ([]entity.JawabanSiswa, error) {
 	query := `
 		SELECT js.id, js.id_test_session_soal, js.jawaban_dipilih, js.is_correct, js.question_type, js.dijawab_pada, js.jawaban_drag_drop, js.jawaban_essay, js.nilai_essay, js.feedback_teacher,
-		       js.jawaban_dipilih_complex,
+		       js.jawaban_dipilih_complex, js.jawaban_short_answer, js.jawaban_numeric, js.jawaban_hotspot, js.jawaban_grid, js.nilai_parsial,
 		       tss.id, tss.id_test_session, tss.question_type, tss.id_soal, tss.id_soal_drag_drop, tss.point, tss.nomor_urut,
 		       s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.id_materi
 		FROM jawaban_siswa js
@@ -857,7 +915,7 @@ func (r *testSessionRepositoryImpl) GetSessionAnswers(token string) ([]entity.Ja
 		JOIN test_session ts ON tss.id_test_session = ts.id
 		LEFT JOIN soal s ON tss.id_soal = s.id
 		WHERE ts.session_token = $1`
-	rows, err := r.db.Query(query, token)
+	rows, err := r.db.QueryContext(ctx, query, token)
 	if err != nil {
 		return nil, err
 	}
@@ -873,10 +931,10 @@ func (r *testSessionRepositoryImpl) GetSessionAnswers(token string) ([]entity.Ja
 		var soalID, soalIDMateri sql.NullInt64
 		var soalPertanyaan, soalQuestionType, soalOpsiA, soalOpsiB, soalOpsiC, soalOpsiD, soalJawabanBenar, soalJawabanBenarComplex, soalJawabanEssayKey sql.NullString
 		var soalPoint sql.NullFloat64
-		var nilaiEssay sql.NullFloat64
+		var nilaiEssay, nilaiParsial sql.NullFloat64
 
-			err := rows.Scan(
-			&js.ID, &js.IDTestSessionSoal, &js.JawabanDipilih, &js.IsCorrect, &js.QuestionType, &js.DijawabPada, &js.JawabanDragDrop, &js.JawabanEssay, &nilaiEssay, &js.FeedbackTeacher, &js.JawabanDipilihComplex,
+		err := rows.Scan(
+			&js.ID, &js.IDTestSessionSoal, &js.JawabanDipilih, &js.IsCorrect, &js.QuestionType, &js.DijawabPada, &js.JawabanDragDrop, &js.JawabanEssay, &nilaiEssay, &js.FeedbackTeacher, &js.JawabanDipilihComplex, &js.JawabanShortAnswer, &js.JawabanNumeric, &js.JawabanHotspot, &js.JawabanGrid, &nilaiParsial,
 			&tss.ID, &tss.IDTestSession, &tss.QuestionType, &tss.IDSoal, &tss.IDSoalDragDrop, &tss.Point, &tss.NomorUrut,
 			&soalID, &soalPertanyaan, &soalPoint, &soalQuestionType, &soalOpsiA, &soalOpsiB, &soalOpsiC, &soalOpsiD, &soalJawabanBenar, &soalJawabanBenarComplex, &soalJawabanEssayKey, &soalIDMateri,
 		)
@@ -912,15 +970,35 @@ func (r *testSessionRepositoryImpl) GetSessionAnswers(token string) ([]entity.Ja
 			v := nilaiEssay.Float64
 			js.NilaiEssay = &v
 		}
+		if nilaiParsial.Valid {
+			v := nilaiParsial.Float64
+			js.NilaiParsial = &v
+		}
 
 		js.TestSessionSoal = tss
 		answers = append(answers, js)
 	}
+
+	var soalIDs []int
+	for i := range answers {
+		if soal := answers[i].TestSessionSoal.Soal; soal != nil {
+			soalIDs = append(soalIDs, soal.ID)
+		}
+	}
+	opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, soalIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range answers {
+		if soal := answers[i].TestSessionSoal.Soal; soal != nil {
+			soal.Opsi = opsiBySoal[soal.ID]
+		}
+	}
 	return answers, nil
 }
 
 // Assign random questions to session
-func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelajaran, tingkatan, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) error {
+func (r *testSessionRepositoryImpl) AssignRandomQuestions(ctx context.Context, sessionID, idMataPelajaran, tingkatan, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) error {
 	includeSet := map[entity.QuestionType]bool{}
 	for _, questionType := range includeTypes {
 		includeSet[questionType] = true
@@ -930,6 +1008,10 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 		includeSet[entity.QuestionTypeMultipleChoicesComplex] = true
 		includeSet[entity.QuestionTypeDragDrop] = true
 		includeSet[entity.QuestionTypeEssay] = true
+		includeSet[entity.QuestionTypeShortAnswer] = true
+		includeSet[entity.QuestionTypeNumeric] = true
+		includeSet[entity.QuestionTypeHotspot] = true
+		includeSet[entity.QuestionTypeGrid] = true
 	}
 
 	// Get random soal IDs for the criteria - get questions for the mata_pelajaran and tingkat
@@ -939,7 +1021,7 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 		JOIN materi m ON s.id_materi = m.id
 		WHERE m.id_mata_pelajaran = $1 AND m.id_tingkat = $2
 		ORDER BY COALESCE(s.urutan, 0) ASC, s.id ASC`
-	soalRows, err := r.db.Query(soalQuery, idMataPelajaran, tingkatan)
+	soalRows, err := r.db.QueryContext(ctx, soalQuery, idMataPelajaran, tingkatan)
 	if err != nil {
 		return err
 	}
@@ -992,6 +1074,46 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 				Point        float64
 				Urutan       int
 			}{ID: id, QuestionType: entity.QuestionTypeMultipleChoicesComplex, Point: resolvedPoint, Urutan: resolvedUrutan})
+		} else if strings.EqualFold(questionType.String, string(entity.QuestionTypeShortAnswer)) {
+			if !includeSet[entity.QuestionTypeShortAnswer] {
+				continue
+			}
+			allQuestionIDs = append(allQuestionIDs, struct {
+				ID           int
+				QuestionType entity.QuestionType
+				Point        float64
+				Urutan       int
+			}{ID: id, QuestionType: entity.QuestionTypeShortAnswer, Point: resolvedPoint, Urutan: resolvedUrutan})
+		} else if strings.EqualFold(questionType.String, string(entity.QuestionTypeNumeric)) {
+			if !includeSet[entity.QuestionTypeNumeric] {
+				continue
+			}
+			allQuestionIDs = append(allQuestionIDs, struct {
+				ID           int
+				QuestionType entity.QuestionType
+				Point        float64
+				Urutan       int
+			}{ID: id, QuestionType: entity.QuestionTypeNumeric, Point: resolvedPoint, Urutan: resolvedUrutan})
+		} else if strings.EqualFold(questionType.String, string(entity.QuestionTypeHotspot)) {
+			if !includeSet[entity.QuestionTypeHotspot] {
+				continue
+			}
+			allQuestionIDs = append(allQuestionIDs, struct {
+				ID           int
+				QuestionType entity.QuestionType
+				Point        float64
+				Urutan       int
+			}{ID: id, QuestionType: entity.QuestionTypeHotspot, Point: resolvedPoint, Urutan: resolvedUrutan})
+		} else if strings.EqualFold(questionType.String, string(entity.QuestionTypeGrid)) {
+			if !includeSet[entity.QuestionTypeGrid] {
+				continue
+			}
+			allQuestionIDs = append(allQuestionIDs, struct {
+				ID           int
+				QuestionType entity.QuestionType
+				Point        float64
+				Urutan       int
+			}{ID: id, QuestionType: entity.QuestionTypeGrid, Point: resolvedPoint, Urutan: resolvedUrutan})
 		} else {
 			if !includeSet[entity.QuestionTypeMultipleChoice] {
 				continue
@@ -1007,7 +1129,7 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 		JOIN materi m ON sdd.id_materi = m.id
 		WHERE m.id_mata_pelajaran = $1 AND m.id_tingkat = $2 AND sdd.is_active = $3
 		ORDER BY COALESCE(sdd.urutan, 0) ASC, sdd.id ASC`
-	dragDropRows, err := r.db.Query(dragDropQuery, idMataPelajaran, tingkatan, true)
+	dragDropRows, err := r.db.QueryContext(ctx, dragDropQuery, idMataPelajaran, tingkatan, true)
 	if err != nil {
 		return err
 	}
@@ -1083,12 +1205,12 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 	// Create TestSessionSoal entries
 	for i, question := range selectedQuestions {
 		switch question.QuestionType {
-		case entity.QuestionTypeMultipleChoice, entity.QuestionTypeEssay, entity.QuestionTypeMultipleChoicesComplex:
+		case entity.QuestionTypeMultipleChoice, entity.QuestionTypeEssay, entity.QuestionTypeMultipleChoicesComplex, entity.QuestionTypeShortAnswer, entity.QuestionTypeNumeric, entity.QuestionTypeHotspot, entity.QuestionTypeGrid:
 			soalIDPtr := question.ID // Create a copy for pointer
 			insertQuery := `
 				INSERT INTO test_session_soal (id_test_session, question_type, id_soal, point, nomor_urut)
 				VALUES ($1, $2, $3, $4, $5)`
-			_, err := r.db.Exec(insertQuery, sessionID, string(question.QuestionType), soalIDPtr, question.Point, i+1)
+			_, err := r.db.ExecContext(ctx, insertQuery, sessionID, string(question.QuestionType), soalIDPtr, question.Point, i+1)
 			if err != nil {
 				return err
 			}
@@ -1097,7 +1219,7 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 			insertQuery := `
 				INSERT INTO test_session_soal (id_test_session, question_type, id_soal_drag_drop, point, nomor_urut)
 				VALUES ($1, $2, $3, $4, $5)`
-			_, err := r.db.Exec(insertQuery, sessionID, string(entity.QuestionTypeDragDrop), soalDragDropIDPtr, question.Point, i+1)
+			_, err := r.db.ExecContext(ctx, insertQuery, sessionID, string(entity.QuestionTypeDragDrop), soalDragDropIDPtr, question.Point, i+1)
 			if err != nil {
 				return err
 			}
@@ -1108,21 +1230,21 @@ func (r *testSessionRepositoryImpl) AssignRandomQuestions(sessionID, idMataPelaj
 }
 
 // CreateUnansweredRecord creates a record for unanswered question with NULL jawaban_dipilih
-func (r *testSessionRepositoryImpl) CreateUnansweredRecord(sessionSoalID, testSessionID int) error {
+func (r *testSessionRepositoryImpl) CreateUnansweredRecord(ctx context.Context, sessionSoalID, testSessionID int) error {
 	insertQuery := `
 		INSERT INTO jawaban_siswa (id_test_session_soal, jawaban_dipilih, is_correct, question_type)
 		SELECT tss.id, NULL, false, tss.question_type
 		FROM test_session_soal tss
 		WHERE tss.id = $1`
-	_, err := r.db.Exec(insertQuery, sessionSoalID)
+	_, err := r.db.ExecContext(ctx, insertQuery, sessionSoalID)
 	return err
 }
 
 // GetTestSessionSoalByOrder gets TestSessionSoal by token and nomor_urut
-func (r *testSessionRepositoryImpl) GetTestSessionSoalByOrder(token string, nomorUrut int) (*entity.TestSessionSoal, error) {
+func (r *testSessionRepositoryImpl) GetTestSessionSoalByOrder(ctx context.Context, token string, nomorUrut int) (*entity.TestSessionSoal, error) {
 	query := `
 		SELECT tss.id, tss.id_test_session, tss.question_type, tss.id_soal, tss.id_soal_drag_drop, tss.point, tss.nomor_urut,
-		       s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.id_materi,
+		       s.id, s.pertanyaan, s.point, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.jawaban_short_answer, s.jawaban_numeric, s.jawaban_hotspot, s.jawaban_grid, s.format_konten, s.id_materi,
 		       sdd.id, sdd.pertanyaan, sdd.point, sdd.id_materi
 		FROM test_session_soal tss
 		JOIN test_session ts ON tss.id_test_session = ts.id
@@ -1135,15 +1257,15 @@ func (r *testSessionRepositoryImpl) GetTestSessionSoalByOrder(token string, nomo
 
 	// Use nullable types for LEFT JOIN columns
 	var soalID, soalIDMateri sql.NullInt64
-	var soalPertanyaan, soalQuestionType, soalOpsiA, soalOpsiB, soalOpsiC, soalOpsiD, soalJawabanBenar, soalJawabanBenarComplex, soalJawabanEssayKey sql.NullString
+	var soalPertanyaan, soalQuestionType, soalOpsiA, soalOpsiB, soalOpsiC, soalOpsiD, soalJawabanBenar, soalJawabanBenarComplex, soalJawabanEssayKey, soalJawabanShortAnswer, soalJawabanNumeric, soalJawabanHotspot, soalJawabanGrid, soalFormatKonten sql.NullString
 	var soalPoint sql.NullFloat64
 	var sddID, sddIDMateri sql.NullInt64
 	var sddPoint sql.NullFloat64
 	var sddPertanyaan sql.NullString
 
-	err := r.db.QueryRow(query, token, nomorUrut).Scan(
+	err := r.db.QueryRowContext(ctx, query, token, nomorUrut).Scan(
 		&tss.ID, &tss.IDTestSession, &tss.QuestionType, &tss.IDSoal, &tss.IDSoalDragDrop, &tss.Point, &tss.NomorUrut,
-		&soalID, &soalPertanyaan, &soalPoint, &soalQuestionType, &soalOpsiA, &soalOpsiB, &soalOpsiC, &soalOpsiD, &soalJawabanBenar, &soalJawabanBenarComplex, &soalJawabanEssayKey, &soalIDMateri,
+		&soalID, &soalPertanyaan, &soalPoint, &soalQuestionType, &soalOpsiA, &soalOpsiB, &soalOpsiC, &soalOpsiD, &soalJawabanBenar, &soalJawabanBenarComplex, &soalJawabanEssayKey, &soalJawabanShortAnswer, &soalJawabanNumeric, &soalJawabanHotspot, &soalJawabanGrid, &soalFormatKonten, &soalIDMateri,
 		&sddID, &sddPertanyaan, &sddPoint, &sddIDMateri,
 	)
 	if err != nil {
@@ -1169,9 +1291,33 @@ func (r *testSessionRepositoryImpl) GetTestSessionSoalByOrder(token string, nomo
 		if soalJawabanEssayKey.Valid {
 			soal.JawabanEssayKey = &soalJawabanEssayKey.String
 		}
+		if soalJawabanShortAnswer.Valid {
+			soal.JawabanShortAnswer = &soalJawabanShortAnswer.String
+		}
+		if soalJawabanNumeric.Valid {
+			soal.JawabanNumeric = &soalJawabanNumeric.String
+		}
+		if soalJawabanHotspot.Valid {
+			soal.JawabanHotspot = &soalJawabanHotspot.String
+		}
+		if soalJawabanGrid.Valid {
+			soal.JawabanGrid = &soalJawabanGrid.String
+		}
+		soal.FormatKonten = entity.FormatKonten(soalFormatKonten.String)
 		if soalIDMateri.Valid {
 			soal.IDMateri = int(soalIDMateri.Int64)
 		}
+		if entity.IsChoiceQuestion(soal.QuestionType) {
+			opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, []int{soal.ID})
+			if err != nil {
+				return nil, err
+			}
+			soal.Opsi = opsiBySoal[soal.ID]
+		}
+		soal.Gambar, err = r.getSoalGambar(ctx, soal.ID)
+		if err != nil {
+			return nil, err
+		}
 		tss.Soal = &soal
 	}
 
@@ -1192,9 +1338,9 @@ func (r *testSessionRepositoryImpl) GetTestSessionSoalByOrder(token string, nomo
 }
 
 // SubmitDragDropAnswer submits a drag-drop answer
-func (r *testSessionRepositoryImpl) SubmitDragDropAnswer(token string, nomorUrut int, answer map[int]int, isCorrect bool) error {
+func (r *testSessionRepositoryImpl) SubmitDragDropAnswer(ctx context.Context, token string, nomorUrut int, answer map[int]int, isCorrect bool) error {
 	// Find the TestSessionSoal
-	tss, err := r.GetTestSessionSoalByOrder(token, nomorUrut)
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
 	if err != nil {
 		return err
 	}
@@ -1214,12 +1360,12 @@ func (r *testSessionRepositoryImpl) SubmitDragDropAnswer(token string, nomorUrut
 		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (id_test_session_soal)
 		DO UPDATE SET jawaban_drag_drop = EXCLUDED.jawaban_drag_drop, question_type = EXCLUDED.question_type, is_correct = EXCLUDED.is_correct, dijawab_pada = EXCLUDED.dijawab_pada`
-	_, err = r.db.Exec(upsertQuery, newAnswer.IDTestSessionSoal, string(newAnswer.QuestionType), newAnswer.IsCorrect, newAnswer.JawabanDragDrop, time.Now())
+	_, err = r.db.ExecContext(ctx, upsertQuery, newAnswer.IDTestSessionSoal, string(newAnswer.QuestionType), newAnswer.IsCorrect, newAnswer.JawabanDragDrop, time.Now())
 	return err
 }
 
-func (r *testSessionRepositoryImpl) SubmitComplexAnswer(token string, nomorUrut int, jawaban []entity.JawabanOption) error {
-	tss, err := r.GetTestSessionSoalByOrder(token, nomorUrut)
+func (r *testSessionRepositoryImpl) SubmitComplexAnswer(ctx context.Context, token string, nomorUrut int, jawaban []entity.JawabanOption) error {
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
 	if err != nil {
 		return err
 	}
@@ -1231,6 +1377,11 @@ func (r *testSessionRepositoryImpl) SubmitComplexAnswer(token string, nomorUrut
 	if len(correct) == 0 {
 		return errors.New("complex correct answers are not configured")
 	}
+	for _, option := range jawaban {
+		if !tss.Soal.HasOption(option) {
+			return errors.New("invalid jawaban complex option")
+		}
+	}
 
 	newAnswer := entity.JawabanSiswa{
 		IDTestSessionSoal: tss.ID,
@@ -1246,35 +1397,316 @@ func (r *testSessionRepositoryImpl) SubmitComplexAnswer(token string, nomorUrut
 		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (id_test_session_soal)
 		DO UPDATE SET question_type = EXCLUDED.question_type, is_correct = EXCLUDED.is_correct, jawaban_dipilih_complex = EXCLUDED.jawaban_dipilih_complex, dijawab_pada = EXCLUDED.dijawab_pada`
-	_, err = r.db.Exec(upsertQuery, newAnswer.IDTestSessionSoal, string(newAnswer.QuestionType), newAnswer.IsCorrect, newAnswer.JawabanDipilihComplex, time.Now())
+	_, err = r.db.ExecContext(ctx, upsertQuery, newAnswer.IDTestSessionSoal, string(newAnswer.QuestionType), newAnswer.IsCorrect, newAnswer.JawabanDipilihComplex, time.Now())
 	return err
 }
 
-func compareOptionSet(correct []entity.JawabanOption, actual []entity.JawabanOption) bool {
-	if len(correct) != len(actual) {
-		return false
+// SubmitShortAnswer stores the responses of a short-answer question with the correctness worked out by the usecase
+func (r *testSessionRepositoryImpl) SubmitShortAnswer(ctx context.Context, token string, nomorUrut int, jawaban []string, isCorrect bool) error {
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
+	if err != nil {
+		return err
 	}
-	counts := map[entity.JawabanOption]int{}
-	for _, option := range correct {
-		counts[option]++
+	if tss.QuestionType != entity.QuestionTypeShortAnswer {
+		return errors.New("this is not a short-answer question")
 	}
-	for _, option := range actual {
-		remaining, exists := counts[option]
-		if !exists || remaining == 0 {
-			return false
+
+	newAnswer := entity.JawabanSiswa{
+		IDTestSessionSoal: tss.ID,
+		QuestionType:      entity.QuestionTypeShortAnswer,
+		IsCorrect:         isCorrect,
+	}
+	if err := newAnswer.SetJawabanShortAnswer(jawaban); err != nil {
+		return err
+	}
+
+	upsertQuery := `
+		INSERT INTO jawaban_siswa (id_test_session_soal, question_type, is_correct, jawaban_short_answer, dijawab_pada)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id_test_session_soal)
+		DO UPDATE SET question_type = EXCLUDED.question_type, is_correct = EXCLUDED.is_correct, jawaban_short_answer = EXCLUDED.jawaban_short_answer, dijawab_pada = EXCLUDED.dijawab_pada`
+	_, err = r.db.ExecContext(ctx, upsertQuery, newAnswer.IDTestSessionSoal, string(newAnswer.QuestionType), newAnswer.IsCorrect, newAnswer.JawabanShortAnswer, time.Now())
+	return err
+}
+
+// SubmitNumericAnswer stores a numeric response as typed with the correctness worked out by the usecase
+func (r *testSessionRepositoryImpl) SubmitNumericAnswer(ctx context.Context, token string, nomorUrut int, jawaban string, isCorrect bool) error {
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeNumeric {
+		return errors.New("this is not a numeric question")
+	}
+
+	upsertQuery := `
+		INSERT INTO jawaban_siswa (id_test_session_soal, question_type, is_correct, jawaban_numeric, dijawab_pada)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id_test_session_soal)
+		DO UPDATE SET question_type = EXCLUDED.question_type, is_correct = EXCLUDED.is_correct, jawaban_numeric = EXCLUDED.jawaban_numeric, dijawab_pada = EXCLUDED.dijawab_pada`
+	_, err = r.db.ExecContext(ctx, upsertQuery, tss.ID, string(entity.QuestionTypeNumeric), isCorrect, strings.TrimSpace(jawaban), time.Now())
+	return err
+}
+
+// compareOptionSet reports whether both answers pick the same set of option labels,
+// ignoring order, letter case and repeated labels
+// SubmitHotspotAnswer stores the points placed on a hotspot image with the correctness worked out by the usecase
+func (r *testSessionRepositoryImpl) SubmitHotspotAnswer(ctx context.Context, token string, nomorUrut int, points []entity.HotspotPoint, isCorrect bool) error {
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeHotspot {
+		return errors.New("this is not a hotspot question")
+	}
+
+	var answer entity.JawabanSiswa
+	if err := answer.SetJawabanHotspot(points); err != nil {
+		return err
+	}
+
+	upsertQuery := `
+		INSERT INTO jawaban_siswa (id_test_session_soal, question_type, is_correct, jawaban_hotspot, dijawab_pada)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id_test_session_soal)
+		DO UPDATE SET question_type = EXCLUDED.question_type, is_correct = EXCLUDED.is_correct, jawaban_hotspot = EXCLUDED.jawaban_hotspot, dijawab_pada = EXCLUDED.dijawab_pada`
+	_, err = r.db.ExecContext(ctx, upsertQuery, tss.ID, string(entity.QuestionTypeHotspot), isCorrect, answer.JawabanHotspot, time.Now())
+	return err
+}
+
+// SubmitGridAnswer stores the column picked per row of a grid question with the score worked
+// out by the usecase. nilaiParsial is the percentage of the point earned.
+func (r *testSessionRepositoryImpl) SubmitGridAnswer(ctx context.Context, token string, nomorUrut int, jawaban []int, isCorrect bool, nilaiParsial float64) error {
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeGrid {
+		return errors.New("this is not a grid question")
+	}
+
+	var answer entity.JawabanSiswa
+	if err := answer.SetJawabanGrid(jawaban); err != nil {
+		return err
+	}
+
+	upsertQuery := `
+		INSERT INTO jawaban_siswa (id_test_session_soal, question_type, is_correct, jawaban_grid, nilai_parsial, dijawab_pada)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id_test_session_soal)
+		DO UPDATE SET question_type = EXCLUDED.question_type, is_correct = EXCLUDED.is_correct, jawaban_grid = EXCLUDED.jawaban_grid, nilai_parsial = EXCLUDED.nilai_parsial, dijawab_pada = EXCLUDED.dijawab_pada`
+	_, err = r.db.ExecContext(ctx, upsertQuery, tss.ID, string(entity.QuestionTypeGrid), isCorrect, answer.JawabanGrid, nilaiParsial, time.Now())
+	return err
+}
+
+// sessionMediaQuery selects the clips of a session's questions with their play counts
+const sessionMediaQuery = `
+	SELECT sm.id, tss.nomor_urut, sm.jenis, sm.mime_type, sm.durasi_detik, sm.max_putar, COALESCE(smp.jumlah_putar, 0), sm.urutan, sm.keterangan, sm.file_path, smp.terakhir_diputar
+	FROM test_session_soal tss
+	JOIN test_session ts ON tss.id_test_session = ts.id
+	JOIN soal_media sm ON sm.id_soal = tss.id_soal
+	LEFT JOIN sesi_media_putar smp ON smp.id_test_session_soal = tss.id AND smp.id_media = sm.id
+	WHERE ts.session_token = $1`
+
+func scanSessionMedia(scanner interface{ Scan(dest ...any) error }) (*entity.SessionMedia, error) {
+	var m entity.SessionMedia
+	var terakhirDiputar sql.NullTime
+	if err := scanner.Scan(&m.IDMedia, &m.NomorUrut, &m.Jenis, &m.MimeType, &m.DurasiDetik, &m.MaxPutar, &m.JumlahPutar, &m.Urutan, &m.Keterangan, &m.FilePath, &terakhirDiputar); err != nil {
+		return nil, err
+	}
+	if terakhirDiputar.Valid {
+		m.TerakhirDiputar = &terakhirDiputar.Time
+	}
+	return &m, nil
+}
+
+// GetSessionMedia loads the clips of every question of a session in question and display order
+func (r *testSessionRepositoryImpl) GetSessionMedia(ctx context.Context, token string) ([]entity.SessionMedia, error) {
+	rows, err := r.db.QueryContext(ctx, sessionMediaQuery+` ORDER BY tss.nomor_urut, sm.urutan ASC, sm.id ASC`, token)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var media []entity.SessionMedia
+	for rows.Next() {
+		m, err := scanSessionMedia(rows)
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, *m)
+	}
+	return media, rows.Err()
+}
+
+// GetSessionMediaByID loads one clip of a session question
+func (r *testSessionRepositoryImpl) GetSessionMediaByID(ctx context.Context, token string, nomorUrut, idMedia int) (*entity.SessionMedia, error) {
+	m, err := scanSessionMedia(r.db.QueryRowContext(ctx, sessionMediaQuery+` AND tss.nomor_urut = $2 AND sm.id = $3`, token, nomorUrut, idMedia))
+	if err == sql.ErrNoRows {
+		return nil, errors.New("media not found for this question")
+	}
+	return m, err
+}
+
+// RecordMediaPlay counts a play of a clip. The check against max_putar and the increment are
+// one statement, so concurrent requests cannot play a clip more often than allowed. The new
+// stream nonce replaces the one of the previous play.
+func (r *testSessionRepositoryImpl) RecordMediaPlay(ctx context.Context, token string, nomorUrut, idMedia int, streamNonceHash string) (*entity.SessionMedia, error) {
+	media, err := r.GetSessionMediaByID(ctx, token, nomorUrut, idMedia)
+	if err != nil {
+		return nil, err
+	}
+	if media.MaxPutar > 0 && media.JumlahPutar >= media.MaxPutar {
+		return nil, entity.ErrMediaPlayLimitReached
+	}
+
+	query := `
+		INSERT INTO sesi_media_putar (id_test_session_soal, id_media, jumlah_putar, terakhir_diputar, stream_nonce_hash)
+		SELECT tss.id, $3::int, 1, NOW(), $5
+		FROM test_session_soal tss
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		WHERE ts.session_token = $1 AND tss.nomor_urut = $2
+		ON CONFLICT (id_test_session_soal, id_media)
+		DO UPDATE SET jumlah_putar = sesi_media_putar.jumlah_putar + 1,
+		              terakhir_diputar = EXCLUDED.terakhir_diputar,
+		              stream_nonce_hash = EXCLUDED.stream_nonce_hash,
+		              stream_dipakai_pada = NULL
+		WHERE $4::int = 0 OR sesi_media_putar.jumlah_putar < $4::int
+		RETURNING jumlah_putar, terakhir_diputar`
+	var terakhirDiputar time.Time
+	err = r.db.QueryRowContext(ctx, query, token, nomorUrut, idMedia, media.MaxPutar, streamNonceHash).Scan(&media.JumlahPutar, &terakhirDiputar)
+	if err == sql.ErrNoRows {
+		return nil, entity.ErrMediaPlayLimitReached
+	}
+	if err != nil {
+		return nil, err
+	}
+	media.TerakhirDiputar = &terakhirDiputar
+	return media, nil
+}
+
+// ClaimMediaStream marks the stream nonce of a play as used. Checking and marking it is one
+// statement, so of two requests with the same nonce only one is served.
+func (r *testSessionRepositoryImpl) ClaimMediaStream(ctx context.Context, token string, nomorUrut, idMedia int, streamNonceHash string) (bool, error) {
+	if streamNonceHash == "" {
+		return false, nil
+	}
+	query := `
+		UPDATE sesi_media_putar smp
+		SET stream_dipakai_pada = NOW()
+		FROM test_session_soal tss
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		WHERE smp.id_test_session_soal = tss.id
+		  AND ts.session_token = $1 AND tss.nomor_urut = $2 AND smp.id_media = $3
+		  AND smp.stream_nonce_hash = $4 AND smp.stream_dipakai_pada IS NULL`
+	result, err := r.db.ExecContext(ctx, query, token, nomorUrut, idMedia, streamNonceHash)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected == 1, err
+}
+
+func compareOptionSet(correct []entity.JawabanOption, actual []entity.JawabanOption) bool {
+	toSet := func(options []entity.JawabanOption) map[entity.JawabanOption]bool {
+		set := make(map[entity.JawabanOption]bool, len(options))
+		for _, option := range options {
+			set[entity.ParseJawabanOption(string(option))] = true
 		}
-		counts[option]--
+		return set
+	}
+	correctSet, actualSet := toSet(correct), toSet(actual)
+	if len(correctSet) != len(actualSet) {
+		return false
 	}
-	for _, remaining := range counts {
-		if remaining != 0 {
+	for option := range actualSet {
+		if !correctSet[option] {
 			return false
 		}
 	}
 	return true
 }
 
-func (r *testSessionRepositoryImpl) SubmitEssayAnswer(token string, nomorUrut int, jawabanEssay string) error {
-	tss, err := r.GetTestSessionSoalByOrder(token, nomorUrut)
+// attachSessionOpsi fills the options of the soal of each session question
+func (r *testSessionRepositoryImpl) attachSessionOpsi(ctx context.Context, sessionSoals []entity.TestSessionSoal) error {
+	var soalIDs []int
+	for i := range sessionSoals {
+		if soal := sessionSoals[i].Soal; soal != nil {
+			soalIDs = append(soalIDs, soal.ID)
+		}
+	}
+	opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, soalIDs)
+	if err != nil {
+		return err
+	}
+	for i := range sessionSoals {
+		if soal := sessionSoals[i].Soal; soal != nil {
+			soal.Opsi = opsiBySoal[soal.ID]
+		}
+	}
+	return nil
+}
+
+// getSoalGambar loads the images of a soal in display order
+func (r *testSessionRepositoryImpl) getSoalGambar(ctx context.Context, idSoal int) ([]entity.SoalGambar, error) {
+	query := `
+		SELECT id, id_soal, nama_file, file_path, file_size, mime_type, urutan, keterangan, cloud_id, public_id, created_at
+		FROM soal_gambar
+		WHERE id_soal = $1
+		ORDER BY urutan ASC, id ASC`
+	rows, err := r.db.QueryContext(ctx, query, idSoal)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var gambar []entity.SoalGambar
+	for rows.Next() {
+		var g entity.SoalGambar
+		if err := rows.Scan(&g.ID, &g.IDSoal, &g.NamaFile, &g.FilePath, &g.FileSize, &g.MimeType, &g.Urutan, &g.Keterangan, &g.CloudId, &g.PublicId, &g.CreatedAt); err != nil {
+			return nil, err
+		}
+		gambar = append(gambar, g)
+	}
+	return gambar, rows.Err()
+}
+
+// attachSessionGambar fills the images of the soal of each session question
+func (r *testSessionRepositoryImpl) attachSessionGambar(ctx context.Context, token string, sessionSoals []entity.TestSessionSoal) error {
+	query := `
+		SELECT sg.id, sg.id_soal, sg.nama_file, sg.file_path, sg.file_size, sg.mime_type, sg.urutan, sg.keterangan, sg.cloud_id, sg.public_id, sg.created_at
+		FROM soal_gambar sg
+		JOIN test_session_soal tss ON tss.id_soal = sg.id_soal
+		JOIN test_session ts ON tss.id_test_session = ts.id
+		WHERE ts.session_token = $1
+		ORDER BY sg.id_soal, sg.urutan ASC, sg.id ASC`
+	rows, err := r.db.QueryContext(ctx, query, token)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	gambarBySoal := make(map[int][]entity.SoalGambar)
+	for rows.Next() {
+		var g entity.SoalGambar
+		if err := rows.Scan(&g.ID, &g.IDSoal, &g.NamaFile, &g.FilePath, &g.FileSize, &g.MimeType, &g.Urutan, &g.Keterangan, &g.CloudId, &g.PublicId, &g.CreatedAt); err != nil {
+			return err
+		}
+		gambarBySoal[g.IDSoal] = append(gambarBySoal[g.IDSoal], g)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for i := range sessionSoals {
+		if soal := sessionSoals[i].Soal; soal != nil {
+			soal.Gambar = gambarBySoal[soal.ID]
+		}
+	}
+	return nil
+}
+
+func (r *testSessionRepositoryImpl) SubmitEssayAnswer(ctx context.Context, token string, nomorUrut int, jawabanEssay string) error {
+	tss, err := r.GetTestSessionSoalByOrder(ctx, token, nomorUrut)
 	if err != nil {
 		return err
 	}
@@ -1287,108 +1719,75 @@ func (r *testSessionRepositoryImpl) SubmitEssayAnswer(token string, nomorUrut in
 		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (id_test_session_soal)
 		DO UPDATE SET question_type = EXCLUDED.question_type, jawaban_essay = EXCLUDED.jawaban_essay, dijawab_pada = EXCLUDED.dijawab_pada`
-	_, err = r.db.Exec(upsertQuery, tss.ID, string(entity.QuestionTypeEssay), false, strings.TrimSpace(jawabanEssay), time.Now())
+	_, err = r.db.ExecContext(ctx, upsertQuery, tss.ID, string(entity.QuestionTypeEssay), false, strings.TrimSpace(jawabanEssay), time.Now())
 	return err
 }
 
-func (r *testSessionRepositoryImpl) HasEssayQuestions(token string) (bool, error) {
+func (r *testSessionRepositoryImpl) HasPendingEssays(ctx context.Context, token string) (bool, error) {
 	query := `
 		SELECT COUNT(*)
 		FROM test_session_soal tss
 		JOIN test_session ts ON tss.id_test_session = ts.id
-		WHERE ts.session_token = $1 AND tss.question_type = $2`
+		JOIN jawaban_siswa js ON js.id_test_session_soal = tss.id
+		WHERE ts.session_token = $1 AND tss.question_type = $2
+		  AND js.nilai_essay IS NULL
+		  AND COALESCE(TRIM(js.jawaban_essay), '') <> ''`
 	var count int
-	err := r.db.QueryRow(query, token, string(entity.QuestionTypeEssay)).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, token, string(entity.QuestionTypeEssay)).Scan(&count)
 	if err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
 
-func (r *testSessionRepositoryImpl) GradeEssayAnswer(answerID int, score float64, feedback string) (string, error) {
-	if score < 0 || score > 100 {
-		return "", errors.New("score must be between 0 and 100")
-	}
-
-	isCorrect := score >= 60
+// GetRubricScoresBySession gets the rubric breakdown of every graded essay in a session
+func (r *testSessionRepositoryImpl) GetRubricScoresBySession(ctx context.Context, token string) (map[int][]entity.JawabanRubricScore, error) {
 	query := `
-		UPDATE jawaban_siswa
-		SET nilai_essay = $1,
-		    feedback_teacher = $2,
-		    is_correct = $3
-		WHERE id = $4 AND question_type = $5`
-	res, err := r.db.Exec(query, score, strings.TrimSpace(feedback), isCorrect, answerID, string(entity.QuestionTypeEssay))
-	if err != nil {
-		return "", err
-	}
-	affected, err := res.RowsAffected()
+		SELECT tss.nomor_urut, jrs.id, jrs.id_jawaban, jrs.id_criterion, jrs.id_level, jrs.nama_kriteria, jrs.label_level,
+		       jrs.point, jrs.max_point, jrs.feedback, jrs.urutan, jrs.graded_by, jrs.graded_at
+		FROM jawaban_rubric_score jrs
+		JOIN jawaban_siswa js ON js.id = jrs.id_jawaban
+		JOIN test_session_soal tss ON tss.id = js.id_test_session_soal
+		JOIN test_session ts ON ts.id = tss.id_test_session
+		WHERE ts.session_token = $1
+		ORDER BY tss.nomor_urut, jrs.urutan, jrs.id`
+	rows, err := r.db.QueryContext(ctx, query, token)
 	if err != nil {
-		return "", err
-	}
-	if affected == 0 {
-		return "", errors.New("essay answer not found")
+		return nil, err
 	}
+	defer rows.Close()
 
-	var token string
-	tokenQuery := `
-		SELECT ts.session_token
-		FROM jawaban_siswa js
-		JOIN test_session_soal tss ON js.id_test_session_soal = tss.id
-		JOIN test_session ts ON tss.id_test_session = ts.id
-		WHERE js.id = $1`
-	if err := r.db.QueryRow(tokenQuery, answerID).Scan(&token); err != nil {
-		return "", err
-	}
-
-	recalcQuery := `
-		WITH score_calc AS (
-			SELECT ts.id AS session_id,
-				COUNT(tss.id)::int AS total_questions,
-				SUM(COALESCE(tss.point, 1.0)) AS total_weight,
-				SUM(
-					CASE
-						WHEN tss.question_type = 'essay' THEN (COALESCE(js.nilai_essay, 0) / 100.0) * COALESCE(tss.point, 1.0)
-						WHEN js.is_correct THEN COALESCE(tss.point, 1.0)
-						ELSE 0.0
-					END
-				) AS total_points,
-				SUM(
-					CASE
-						WHEN tss.question_type = 'essay' AND COALESCE(js.nilai_essay, 0) >= 60 THEN 1
-						WHEN tss.question_type <> 'essay' AND js.is_correct THEN 1
-						ELSE 0
-					END
-				)::int AS total_correct,
-				SUM(CASE WHEN tss.question_type = 'essay' AND js.nilai_essay IS NULL THEN 1 ELSE 0 END)::int AS pending_essay
-			FROM test_session ts
-			JOIN test_session_soal tss ON tss.id_test_session = ts.id
-			LEFT JOIN jawaban_siswa js ON js.id_test_session_soal = tss.id
-			WHERE ts.session_token = $1
-			GROUP BY ts.id
-		)
-		UPDATE test_session ts
-		SET nilai_akhir = CASE WHEN sc.total_weight > 0 THEN (sc.total_points / sc.total_weight) * 100 ELSE 0 END,
-		    jumlah_benar = sc.total_correct,
-		    total_soal = sc.total_questions,
-		    status = CASE WHEN sc.pending_essay > 0 THEN 'grading_in_progress' ELSE 'graded' END
-		FROM score_calc sc
-		WHERE ts.id = sc.session_id`
-	_, err = r.db.Exec(recalcQuery, token)
-	if err != nil {
-		return "", err
+	scores := make(map[int][]entity.JawabanRubricScore)
+	for rows.Next() {
+		var nomorUrut int
+		var score entity.JawabanRubricScore
+		var feedback sql.NullString
+		var gradedBy sql.NullInt64
+		err := rows.Scan(&nomorUrut, &score.ID, &score.IDJawaban, &score.IDCriterion, &score.IDLevel, &score.NamaKriteria, &score.LabelLevel,
+			&score.Point, &score.MaxPoint, &feedback, &score.Urutan, &gradedBy, &score.GradedAt)
+		if err != nil {
+			return nil, err
+		}
+		if feedback.Valid {
+			score.Feedback = &feedback.String
+		}
+		if gradedBy.Valid {
+			v := int(gradedBy.Int64)
+			score.GradedBy = &v
+		}
+		scores[nomorUrut] = append(scores[nomorUrut], score)
 	}
-
-	return token, nil
+	return scores, rows.Err()
 }
 
 // GetDragDropCorrectAnswers gets correct answers for a drag-drop question
-func (r *testSessionRepositoryImpl) GetDragDropCorrectAnswers(soalDragDropID int) ([]entity.DragCorrectAnswer, error) {
+func (r *testSessionRepositoryImpl) GetDragDropCorrectAnswers(ctx context.Context, soalDragDropID int) ([]entity.DragCorrectAnswer, error) {
 	query := `
 		SELECT dca.id, dca.id_drag_item, dca.id_drag_slot
 		FROM drag_correct_answer dca
 		JOIN drag_item di ON dca.id_drag_item = di.id
 		WHERE di.id_soal_drag_drop = $1`
-	rows, err := r.db.Query(query, soalDragDropID)
+	rows, err := r.db.QueryContext(ctx, query, soalDragDropID)
 	if err != nil {
 		return nil, err
 	}
@@ -1407,7 +1806,7 @@ func (r *testSessionRepositoryImpl) GetDragDropCorrectAnswers(soalDragDropID int
 }
 
 // GetSoalDragDropByID gets a drag-drop question by ID
-func (r *testSessionRepositoryImpl) GetSoalDragDropByID(id int) (*entity.SoalDragDrop, error) {
+func (r *testSessionRepositoryImpl) GetSoalDragDropByID(ctx context.Context, id int) (*entity.SoalDragDrop, error) {
 	query := `
 		SELECT sdd.id, sdd.pertanyaan, sdd.id_materi, sdd.is_active, sdd.created_at, sdd.updated_at,
 		       m.id, m.nama, m.id_mata_pelajaran, m.id_tingkat
@@ -1416,7 +1815,7 @@ func (r *testSessionRepositoryImpl) GetSoalDragDropByID(id int) (*entity.SoalDra
 		WHERE sdd.id = $1`
 	var soal entity.SoalDragDrop
 	var materi entity.Materi
-	err := r.db.QueryRow(query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&soal.ID, &soal.Pertanyaan, &soal.IDMateri, &soal.IsActive, &soal.CreatedAt, &soal.UpdatedAt,
 		&materi.ID, &materi.Nama, &materi.IDMataPelajaran, &materi.IDTingkat,
 	)
@@ -1,36 +1,44 @@
 package test_soal
 
 import (
+	"context"
+
 	"cbt-test-mini-project/internal/entity"
 )
 
 // SoalRepository defines the interface for Soal (question) repository operations
 type SoalRepository interface {
 	// Create a new soal
-	Create(soal *entity.Soal) error
+	Create(ctx context.Context, soal *entity.Soal) error
 
 	// Get soal by ID
-	GetByID(id int) (*entity.Soal, error)
+	GetByID(ctx context.Context, id int) (*entity.Soal, error)
 
 	// Update existing soal
-	Update(soal *entity.Soal) error
+	Update(ctx context.Context, soal *entity.Soal) error
 
 	// Delete soal by ID
-	Delete(id int) error
+	Delete(ctx context.Context, id int) error
 
-	// List soal with filters
-	List(idMateri, tingkatan, idMataPelajaran *int, limit, offset int) ([]entity.Soal, int, error)
-	ReorderByMateri(idMateri int, urutanByID map[int]int) error
+	// List soal with filters, restricted to the teacher's materi when scope is non-nil
+	List(ctx context.Context, idMateri, tingkatan, idMataPelajaran *int, scope *entity.TeacherScope, limit, offset int) ([]entity.Soal, int, error)
+	ReorderByMateri(ctx context.Context, idMateri int, urutanByID map[int]int) error
 
 	// Get soal by materi ID
-	GetByMateriID(idMateri int) ([]entity.Soal, error)
+	GetByMateriID(ctx context.Context, idMateri int) ([]entity.Soal, error)
 
 	// Image operations
-	CreateGambar(gambar *entity.SoalGambar) error
-	GetGambarByID(id int) (*entity.SoalGambar, error)
-	UpdateGambar(id int, urutan int, keterangan *string) error
-	DeleteGambar(id int) error
+	CreateGambar(ctx context.Context, gambar *entity.SoalGambar) error
+	GetGambarByID(ctx context.Context, id int) (*entity.SoalGambar, error)
+	UpdateGambar(ctx context.Context, id int, urutan int, keterangan *string) error
+	DeleteGambar(ctx context.Context, id int) error
+
+	// Audio and video operations
+	CreateMedia(ctx context.Context, media *entity.SoalMedia) error
+	GetMediaByID(ctx context.Context, id int) (*entity.SoalMedia, error)
+	UpdateMedia(ctx context.Context, id int, urutan, maxPutar int, durasiDetik float64, keterangan *string) error
+	DeleteMedia(ctx context.Context, id int) error
 
 	// Get question counts by topic
-	GetQuestionCountsByTopic() (map[int]int, error)
-}
\ No newline at end of file
+	GetQuestionCountsByTopic(ctx context.Context) (map[int]int, error)
+}
@@ -1,9 +1,12 @@
 package test_soal
 
 import (
-	"cbt-test-mini-project/internal/entity"
+	"context"
 	"database/sql"
 	"strconv"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository"
 )
 
 // soalRepositoryImpl implements SoalRepository
@@ -17,10 +20,10 @@ func NewSoalRepository(db *sql.DB) SoalRepository {
 }
 
 // Create a new soal
-func (r *soalRepositoryImpl) Create(soal *entity.Soal) error {
+func (r *soalRepositoryImpl) Create(ctx context.Context, soal *entity.Soal) error {
 	query := `
-		INSERT INTO soal (id_materi, lms_asset_id, lms_class_id, id_tingkat, pertanyaan, point, urutan, question_type, opsi_a, opsi_b, opsi_c, opsi_d, jawaban_benar, jawaban_benar_complex, jawaban_essay_key, pembahasan, is_active)
-		VALUES ($1, $2, (SELECT lms_class_id FROM materi WHERE id = $1), $3, $4, $5, COALESCE(NULLIF($6, 0), (SELECT COALESCE(MAX(s2.urutan),0)+1 FROM soal s2 WHERE s2.id_materi = $1)), $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		INSERT INTO soal (id_materi, lms_asset_id, lms_class_id, id_tingkat, pertanyaan, point, urutan, question_type, opsi_a, opsi_b, opsi_c, opsi_d, jawaban_benar, jawaban_benar_complex, jawaban_essay_key, jawaban_short_answer, jawaban_numeric, jawaban_hotspot, jawaban_grid, pembahasan, format_konten, is_active)
+		VALUES ($1, $2, (SELECT lms_class_id FROM materi WHERE id = $1), $3, $4, $5, COALESCE(NULLIF($6, 0), (SELECT COALESCE(MAX(s2.urutan),0)+1 FROM soal s2 WHERE s2.id_materi = $1)), $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		RETURNING id`
 	var pembahasan *string
 	if soal.Pembahasan != nil {
@@ -30,14 +33,59 @@ func (r *soalRepositoryImpl) Create(soal *entity.Soal) error {
 	if soal.LMSAssetID != nil && *soal.LMSAssetID > 0 {
 		lmsAssetID = *soal.LMSAssetID
 	}
-	return r.db.QueryRow(query, soal.IDMateri, lmsAssetID, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.QuestionType, soal.OpsiA, soal.OpsiB, soal.OpsiC, soal.OpsiD, string(soal.JawabanBenar), soal.JawabanBenarComplex, soal.JawabanEssayKey, pembahasan, soal.IsActive).Scan(&soal.ID)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, query, soal.IDMateri, lmsAssetID, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.QuestionType, soal.OpsiA, soal.OpsiB, soal.OpsiC, soal.OpsiD, string(soal.JawabanBenar), soal.JawabanBenarComplex, soal.JawabanEssayKey, soal.JawabanShortAnswer, soal.JawabanNumeric, soal.JawabanHotspot, soal.JawabanGrid, pembahasan, string(soal.FormatKonten), soal.IsActive).Scan(&soal.ID)
+	if err != nil {
+		return err
+	}
+	if err := replaceOpsi(ctx, tx, soal.ID, soal.Opsi); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// replaceOpsi stores the options of a soal, dropping the ones it no longer has
+func replaceOpsi(ctx context.Context, tx *sql.Tx, idSoal int, opsi []entity.SoalOpsi) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM soal_opsi WHERE id_soal = $1`, idSoal); err != nil {
+		return err
+	}
+	for i := range opsi {
+		opsi[i].IDSoal = idSoal
+		err := tx.QueryRowContext(ctx, `INSERT INTO soal_opsi (id_soal, label, teks, urutan) VALUES ($1, $2, $3, $4) RETURNING id`,
+			idSoal, string(opsi[i].Label), opsi[i].Teks, opsi[i].Urutan).Scan(&opsi[i].ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachOpsi fills the options of each soal
+func (r *soalRepositoryImpl) attachOpsi(ctx context.Context, soals []entity.Soal) error {
+	soalIDs := make([]int, len(soals))
+	for i := range soals {
+		soalIDs[i] = soals[i].ID
+	}
+	opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, soalIDs)
+	if err != nil {
+		return err
+	}
+	for i := range soals {
+		soals[i].Opsi = opsiBySoal[soals[i].ID]
+	}
+	return nil
 }
 
 // Get soal by ID with all relations
-func (r *soalRepositoryImpl) GetByID(id int) (*entity.Soal, error) {
+func (r *soalRepositoryImpl) GetByID(ctx context.Context, id int) (*entity.Soal, error) {
 	// Get soal with materi, mata_pelajaran, and tingkat
 	soalQuery := `
-		SELECT s.id, s.id_materi, s.lms_asset_id, s.id_tingkat, s.pertanyaan, s.point, s.urutan, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.pembahasan, s.is_active,
+		SELECT s.id, s.id_materi, s.lms_asset_id, s.id_tingkat, s.pertanyaan, s.point, s.urutan, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.jawaban_short_answer, s.jawaban_numeric, s.jawaban_hotspot, s.jawaban_grid, s.pembahasan, s.format_konten, s.is_active,
 		       m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_class_id,
 		       mp.id, mp.nama, mp.is_active, mp.lms_subject_id, mp.lms_school_id, mp.lms_class_id,
 		       t.id, t.nama, t.is_active, t.lms_level_id
@@ -50,9 +98,9 @@ func (r *soalRepositoryImpl) GetByID(id int) (*entity.Soal, error) {
 	var soal entity.Soal
 	var pembahasan *string
 	var lmsAssetID sql.NullInt64
-	var jawabanBenarComplex sql.NullString
-	err := r.db.QueryRow(soalQuery, id).Scan(
-		&soal.ID, &soal.IDMateri, &lmsAssetID, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.QuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &soal.JawabanEssayKey, &pembahasan, &soal.IsActive,
+	var jawabanBenarComplex, jawabanShortAnswer, jawabanNumeric, jawabanHotspot, jawabanGrid sql.NullString
+	err := r.db.QueryRowContext(ctx, soalQuery, id).Scan(
+		&soal.ID, &soal.IDMateri, &lmsAssetID, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.QuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &soal.JawabanEssayKey, &jawabanShortAnswer, &jawabanNumeric, &jawabanHotspot, &jawabanGrid, &pembahasan, &soal.FormatKonten, &soal.IsActive,
 		&soal.Materi.ID, &soal.Materi.IDMataPelajaran, &soal.Materi.IDTingkat, &soal.Materi.Nama, &soal.Materi.IsActive, &soal.Materi.DefaultDurasiMenit, &soal.Materi.DefaultJumlahSoal, &soal.Materi.LmsModuleID, &soal.Materi.LmsClassID,
 		&soal.Materi.MataPelajaran.ID, &soal.Materi.MataPelajaran.Nama, &soal.Materi.MataPelajaran.IsActive, &soal.Materi.MataPelajaran.LmsSubjectID, &soal.Materi.MataPelajaran.LmsSchoolID, &soal.Materi.MataPelajaran.LmsClassID,
 		&soal.Materi.Tingkat.ID, &soal.Materi.Tingkat.Nama, &soal.Materi.Tingkat.IsActive, &soal.Materi.Tingkat.LmsLevelID,
@@ -68,6 +116,24 @@ func (r *soalRepositoryImpl) GetByID(id int) (*entity.Soal, error) {
 	if jawabanBenarComplex.Valid {
 		soal.JawabanBenarComplex = &jawabanBenarComplex.String
 	}
+	if jawabanShortAnswer.Valid {
+		soal.JawabanShortAnswer = &jawabanShortAnswer.String
+	}
+	if jawabanNumeric.Valid {
+		soal.JawabanNumeric = &jawabanNumeric.String
+	}
+	if jawabanHotspot.Valid {
+		soal.JawabanHotspot = &jawabanHotspot.String
+	}
+	if jawabanGrid.Valid {
+		soal.JawabanGrid = &jawabanGrid.String
+	}
+
+	opsiBySoal, err := repository.LoadSoalOpsi(ctx, r.db, []int{id})
+	if err != nil {
+		return nil, err
+	}
+	soal.Opsi = opsiBySoal[id]
 
 	// Get gambar
 	gambarQuery := `
@@ -75,7 +141,7 @@ func (r *soalRepositoryImpl) GetByID(id int) (*entity.Soal, error) {
 		FROM soal_gambar
 		WHERE id_soal = $1
 		ORDER BY urutan ASC`
-	rows, err := r.db.Query(gambarQuery, id)
+	rows, err := r.db.QueryContext(ctx, gambarQuery, id)
 	if err != nil {
 		return nil, err
 	}
@@ -94,32 +160,49 @@ func (r *soalRepositoryImpl) GetByID(id int) (*entity.Soal, error) {
 		soal.Gambar = append(soal.Gambar, gambar)
 	}
 
+	soal.Media, err = r.getMedia(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	return &soal, nil
 }
 
 // Update existing soal
-func (r *soalRepositoryImpl) Update(soal *entity.Soal) error {
+func (r *soalRepositoryImpl) Update(ctx context.Context, soal *entity.Soal) error {
 	query := `
 		UPDATE soal
-		SET id_materi = $1, lms_asset_id = $2, lms_class_id = (SELECT lms_class_id FROM materi WHERE id = $1), id_tingkat = $3, pertanyaan = $4, point = $5, urutan = COALESCE(NULLIF($6, 0), urutan), question_type = $7, opsi_a = $8, opsi_b = $9, opsi_c = $10, opsi_d = $11, jawaban_benar = $12, jawaban_benar_complex = $13, jawaban_essay_key = $14, pembahasan = $15, is_active = $16
-		WHERE id = $17`
+		SET id_materi = $1, lms_asset_id = $2, lms_class_id = (SELECT lms_class_id FROM materi WHERE id = $1), id_tingkat = $3, pertanyaan = $4, point = $5, urutan = COALESCE(NULLIF($6, 0), urutan), question_type = $7, opsi_a = $8, opsi_b = $9, opsi_c = $10, opsi_d = $11, jawaban_benar = $12, jawaban_benar_complex = $13, jawaban_essay_key = $14, jawaban_short_answer = $15, jawaban_numeric = $16, jawaban_hotspot = $17, jawaban_grid = $18, pembahasan = $19, format_konten = $20, is_active = $21
+		WHERE id = $22`
 	var lmsAssetID interface{}
 	if soal.LMSAssetID != nil && *soal.LMSAssetID > 0 {
 		lmsAssetID = *soal.LMSAssetID
 	}
-	_, err := r.db.Exec(query, soal.IDMateri, lmsAssetID, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.QuestionType, soal.OpsiA, soal.OpsiB, soal.OpsiC, soal.OpsiD, string(soal.JawabanBenar), soal.JawabanBenarComplex, soal.JawabanEssayKey, soal.Pembahasan, soal.IsActive, soal.ID)
-	return err
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, query, soal.IDMateri, lmsAssetID, soal.IDTingkat, soal.Pertanyaan, soal.Point, soal.Urutan, soal.QuestionType, soal.OpsiA, soal.OpsiB, soal.OpsiC, soal.OpsiD, string(soal.JawabanBenar), soal.JawabanBenarComplex, soal.JawabanEssayKey, soal.JawabanShortAnswer, soal.JawabanNumeric, soal.JawabanHotspot, soal.JawabanGrid, soal.Pembahasan, string(soal.FormatKonten), soal.IsActive, soal.ID)
+	if err != nil {
+		return err
+	}
+	if err := replaceOpsi(ctx, tx, soal.ID, soal.Opsi); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 // Delete soal by ID (soft delete)
-func (r *soalRepositoryImpl) Delete(id int) error {
+func (r *soalRepositoryImpl) Delete(ctx context.Context, id int) error {
 	query := `UPDATE soal SET is_active = false WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
 	return err
 }
 
 // List soal with filters
-func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, limit, offset int) ([]entity.Soal, int, error) {
+func (r *soalRepositoryImpl) List(ctx context.Context, idMateri, tingkatan, idMataPelajaran *int, scope *entity.TeacherScope, limit, offset int) ([]entity.Soal, int, error) {
 	var soals []entity.Soal
 
 	// Build WHERE clause
@@ -142,6 +225,12 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 		whereClause += " AND m.id_mata_pelajaran = $" + strconv.Itoa(argCount)
 		args = append(args, *idMataPelajaran)
 	}
+	if scope != nil {
+		argCount++
+		param := "$" + strconv.Itoa(argCount)
+		whereClause += " AND (m.owner_user_id = " + param + " OR EXISTS (SELECT 1 FROM materi_grants g WHERE g.id_materi = m.id AND g.user_id = " + param + "))"
+		args = append(args, scope.UserID)
+	}
 
 	// Get total count
 	countQuery := `
@@ -153,14 +242,14 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 		` + whereClause
 
 	var total int
-	err := r.db.QueryRow(countQuery, args...).Scan(&total)
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// Get paginated results with all relations
 	listQuery := `
-		SELECT s.id, s.id_materi, s.lms_asset_id, s.id_tingkat, s.pertanyaan, s.point, s.urutan, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.pembahasan, s.is_active,
+		SELECT s.id, s.id_materi, s.lms_asset_id, s.id_tingkat, s.pertanyaan, s.point, s.urutan, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.jawaban_short_answer, s.jawaban_numeric, s.jawaban_hotspot, s.jawaban_grid, s.pembahasan, s.format_konten, s.is_active,
 		       m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_class_id,
 		       mp.id, mp.nama, mp.is_active, mp.lms_subject_id, mp.lms_school_id, mp.lms_class_id,
 		       t.id, t.nama, t.is_active, t.lms_level_id
@@ -173,7 +262,7 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 		LIMIT $` + strconv.Itoa(argCount+1) + ` OFFSET $` + strconv.Itoa(argCount+2)
 
 	args = append(args, limit, offset)
-	rows, err := r.db.Query(listQuery, args...)
+	rows, err := r.db.QueryContext(ctx, listQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -183,9 +272,9 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 		var soal entity.Soal
 		var pembahasan *string
 		var lmsAssetID sql.NullInt64
-		var jawabanBenarComplex sql.NullString
+		var jawabanBenarComplex, jawabanShortAnswer, jawabanNumeric, jawabanHotspot, jawabanGrid sql.NullString
 		err := rows.Scan(
-			&soal.ID, &soal.IDMateri, &lmsAssetID, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.QuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &soal.JawabanEssayKey, &pembahasan, &soal.IsActive,
+			&soal.ID, &soal.IDMateri, &lmsAssetID, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.QuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &soal.JawabanEssayKey, &jawabanShortAnswer, &jawabanNumeric, &jawabanHotspot, &jawabanGrid, &pembahasan, &soal.FormatKonten, &soal.IsActive,
 			&soal.Materi.ID, &soal.Materi.IDMataPelajaran, &soal.Materi.IDTingkat, &soal.Materi.Nama, &soal.Materi.IsActive, &soal.Materi.DefaultDurasiMenit, &soal.Materi.DefaultJumlahSoal, &soal.Materi.LmsModuleID, &soal.Materi.LmsClassID,
 			&soal.Materi.MataPelajaran.ID, &soal.Materi.MataPelajaran.Nama, &soal.Materi.MataPelajaran.IsActive, &soal.Materi.MataPelajaran.LmsSubjectID, &soal.Materi.MataPelajaran.LmsSchoolID, &soal.Materi.MataPelajaran.LmsClassID,
 			&soal.Materi.Tingkat.ID, &soal.Materi.Tingkat.Nama, &soal.Materi.Tingkat.IsActive, &soal.Materi.Tingkat.LmsLevelID,
@@ -201,6 +290,18 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 		if jawabanBenarComplex.Valid {
 			soal.JawabanBenarComplex = &jawabanBenarComplex.String
 		}
+		if jawabanShortAnswer.Valid {
+			soal.JawabanShortAnswer = &jawabanShortAnswer.String
+		}
+		if jawabanNumeric.Valid {
+			soal.JawabanNumeric = &jawabanNumeric.String
+		}
+		if jawabanHotspot.Valid {
+			soal.JawabanHotspot = &jawabanHotspot.String
+		}
+		if jawabanGrid.Valid {
+			soal.JawabanGrid = &jawabanGrid.String
+		}
 
 		// Get gambar for this soal
 		gambarQuery := `
@@ -208,7 +309,7 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 			FROM soal_gambar
 			WHERE id_soal = $1
 			ORDER BY urutan ASC`
-		gambarRows, err := r.db.Query(gambarQuery, soal.ID)
+		gambarRows, err := r.db.QueryContext(ctx, gambarQuery, soal.ID)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -228,18 +329,26 @@ func (r *soalRepositoryImpl) List(idMateri, tingkatan, idMataPelajaran *int, lim
 		}
 		gambarRows.Close()
 
+		soal.Media, err = r.getMedia(ctx, soal.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+
 		soals = append(soals, soal)
 	}
 
+	if err := r.attachOpsi(ctx, soals); err != nil {
+		return nil, 0, err
+	}
 	return soals, total, nil
 }
 
 // Get soal by materi ID
-func (r *soalRepositoryImpl) GetByMateriID(idMateri int) ([]entity.Soal, error) {
+func (r *soalRepositoryImpl) GetByMateriID(ctx context.Context, idMateri int) ([]entity.Soal, error) {
 	var soals []entity.Soal
 
 	query := `
-		SELECT s.id, s.id_materi, s.lms_asset_id, s.id_tingkat, s.pertanyaan, s.point, s.urutan, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.pembahasan, s.is_active,
+		SELECT s.id, s.id_materi, s.lms_asset_id, s.id_tingkat, s.pertanyaan, s.point, s.urutan, s.question_type, s.opsi_a, s.opsi_b, s.opsi_c, s.opsi_d, s.jawaban_benar, s.jawaban_benar_complex, s.jawaban_essay_key, s.jawaban_short_answer, s.jawaban_numeric, s.jawaban_hotspot, s.jawaban_grid, s.pembahasan, s.format_konten, s.is_active,
 		       m.id, m.id_mata_pelajaran, m.id_tingkat, m.nama, m.is_active, m.default_durasi_menit, m.default_jumlah_soal, m.lms_module_id, m.lms_class_id,
 		       mp.id, mp.nama, mp.is_active, mp.lms_subject_id, mp.lms_school_id, mp.lms_class_id,
 		       t.id, t.nama, t.is_active, t.lms_level_id
@@ -250,8 +359,7 @@ func (r *soalRepositoryImpl) GetByMateriID(idMateri int) ([]entity.Soal, error)
 		WHERE s.id_materi = $1 AND s.is_active = true
 		ORDER BY s.urutan ASC, s.id ASC`
 
-
-	rows, err := r.db.Query(query, idMateri)
+	rows, err := r.db.QueryContext(ctx, query, idMateri)
 	if err != nil {
 		return nil, err
 	}
@@ -261,9 +369,9 @@ func (r *soalRepositoryImpl) GetByMateriID(idMateri int) ([]entity.Soal, error)
 		var soal entity.Soal
 		var pembahasan *string
 		var lmsAssetID sql.NullInt64
-		var jawabanBenarComplex sql.NullString
+		var jawabanBenarComplex, jawabanShortAnswer, jawabanNumeric, jawabanHotspot, jawabanGrid sql.NullString
 		err := rows.Scan(
-			&soal.ID, &soal.IDMateri, &lmsAssetID, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.QuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &soal.JawabanEssayKey, &pembahasan, &soal.IsActive,
+			&soal.ID, &soal.IDMateri, &lmsAssetID, &soal.IDTingkat, &soal.Pertanyaan, &soal.Point, &soal.Urutan, &soal.QuestionType, &soal.OpsiA, &soal.OpsiB, &soal.OpsiC, &soal.OpsiD, &soal.JawabanBenar, &jawabanBenarComplex, &soal.JawabanEssayKey, &jawabanShortAnswer, &jawabanNumeric, &jawabanHotspot, &jawabanGrid, &pembahasan, &soal.FormatKonten, &soal.IsActive,
 			&soal.Materi.ID, &soal.Materi.IDMataPelajaran, &soal.Materi.IDTingkat, &soal.Materi.Nama, &soal.Materi.IsActive, &soal.Materi.DefaultDurasiMenit, &soal.Materi.DefaultJumlahSoal, &soal.Materi.LmsModuleID, &soal.Materi.LmsClassID,
 			&soal.Materi.MataPelajaran.ID, &soal.Materi.MataPelajaran.Nama, &soal.Materi.MataPelajaran.IsActive, &soal.Materi.MataPelajaran.LmsSubjectID, &soal.Materi.MataPelajaran.LmsSchoolID, &soal.Materi.MataPelajaran.LmsClassID,
 			&soal.Materi.Tingkat.ID, &soal.Materi.Tingkat.Nama, &soal.Materi.Tingkat.IsActive, &soal.Materi.Tingkat.LmsLevelID,
@@ -279,6 +387,18 @@ func (r *soalRepositoryImpl) GetByMateriID(idMateri int) ([]entity.Soal, error)
 		if jawabanBenarComplex.Valid {
 			soal.JawabanBenarComplex = &jawabanBenarComplex.String
 		}
+		if jawabanShortAnswer.Valid {
+			soal.JawabanShortAnswer = &jawabanShortAnswer.String
+		}
+		if jawabanNumeric.Valid {
+			soal.JawabanNumeric = &jawabanNumeric.String
+		}
+		if jawabanHotspot.Valid {
+			soal.JawabanHotspot = &jawabanHotspot.String
+		}
+		if jawabanGrid.Valid {
+			soal.JawabanGrid = &jawabanGrid.String
+		}
 
 		// Get gambar for this soal
 		gambarQuery := `
@@ -286,7 +406,7 @@ func (r *soalRepositoryImpl) GetByMateriID(idMateri int) ([]entity.Soal, error)
 			FROM soal_gambar
 			WHERE id_soal = $1
 			ORDER BY urutan ASC`
-		gambarRows, err := r.db.Query(gambarQuery, soal.ID)
+		gambarRows, err := r.db.QueryContext(ctx, gambarQuery, soal.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -306,30 +426,38 @@ func (r *soalRepositoryImpl) GetByMateriID(idMateri int) ([]entity.Soal, error)
 		}
 		gambarRows.Close()
 
+		soal.Media, err = r.getMedia(ctx, soal.ID)
+		if err != nil {
+			return nil, err
+		}
+
 		soals = append(soals, soal)
 	}
 
+	if err := r.attachOpsi(ctx, soals); err != nil {
+		return nil, err
+	}
 	return soals, nil
 }
 
 // CreateGambar creates a new soal gambar
-func (r *soalRepositoryImpl) CreateGambar(gambar *entity.SoalGambar) error {
+func (r *soalRepositoryImpl) CreateGambar(ctx context.Context, gambar *entity.SoalGambar) error {
 	query := `
 		INSERT INTO soal_gambar (id_soal, nama_file, file_path, file_size, mime_type, urutan, keterangan, cloud_id, public_id)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id, created_at`
-	return r.db.QueryRow(query, gambar.IDSoal, gambar.NamaFile, gambar.FilePath, gambar.FileSize, gambar.MimeType, gambar.Urutan, gambar.Keterangan, gambar.CloudId, gambar.PublicId).Scan(&gambar.ID, &gambar.CreatedAt)
+	return r.db.QueryRowContext(ctx, query, gambar.IDSoal, gambar.NamaFile, gambar.FilePath, gambar.FileSize, gambar.MimeType, gambar.Urutan, gambar.Keterangan, gambar.CloudId, gambar.PublicId).Scan(&gambar.ID, &gambar.CreatedAt)
 }
 
-func (r *soalRepositoryImpl) ReorderByMateri(idMateri int, urutanByID map[int]int) error {
-	tx, err := r.db.Begin()
+func (r *soalRepositoryImpl) ReorderByMateri(ctx context.Context, idMateri int, urutanByID map[int]int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
 	for id, urutan := range urutanByID {
-		if _, err := tx.Exec(`UPDATE soal SET urutan = $1 WHERE id = $2 AND id_materi = $3`, urutan, id, idMateri); err != nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE soal SET urutan = $1 WHERE id = $2 AND id_materi = $3`, urutan, id, idMateri); err != nil {
 			return err
 		}
 	}
@@ -338,11 +466,11 @@ func (r *soalRepositoryImpl) ReorderByMateri(idMateri int, urutanByID map[int]in
 }
 
 // GetGambarByID gets gambar by ID
-func (r *soalRepositoryImpl) GetGambarByID(id int) (*entity.SoalGambar, error) {
+func (r *soalRepositoryImpl) GetGambarByID(ctx context.Context, id int) (*entity.SoalGambar, error) {
 	var gambar entity.SoalGambar
 	var keterangan, cloudId, publicId *string
 	query := `SELECT id, id_soal, nama_file, file_path, file_size, mime_type, urutan, keterangan, cloud_id, public_id, created_at FROM soal_gambar WHERE id = $1`
-	err := r.db.QueryRow(query, id).Scan(&gambar.ID, &gambar.IDSoal, &gambar.NamaFile, &gambar.FilePath, &gambar.FileSize, &gambar.MimeType, &gambar.Urutan, &keterangan, &cloudId, &publicId, &gambar.CreatedAt)
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&gambar.ID, &gambar.IDSoal, &gambar.NamaFile, &gambar.FilePath, &gambar.FileSize, &gambar.MimeType, &gambar.Urutan, &keterangan, &cloudId, &publicId, &gambar.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -353,21 +481,89 @@ func (r *soalRepositoryImpl) GetGambarByID(id int) (*entity.SoalGambar, error) {
 }
 
 // UpdateGambar updates gambar urutan and keterangan
-func (r *soalRepositoryImpl) UpdateGambar(id int, urutan int, keterangan *string) error {
+func (r *soalRepositoryImpl) UpdateGambar(ctx context.Context, id int, urutan int, keterangan *string) error {
 	query := `UPDATE soal_gambar SET urutan = $1, keterangan = $2 WHERE id = $3`
-	_, err := r.db.Exec(query, urutan, keterangan, id)
+	_, err := r.db.ExecContext(ctx, query, urutan, keterangan, id)
 	return err
 }
 
 // DeleteGambar deletes gambar by ID
-func (r *soalRepositoryImpl) DeleteGambar(id int) error {
+func (r *soalRepositoryImpl) DeleteGambar(ctx context.Context, id int) error {
 	query := `DELETE FROM soal_gambar WHERE id = $1`
-	_, err := r.db.Exec(query, id)
+	_, err := r.db.ExecContext(ctx, query, id)
+	return err
+}
+
+// getMedia loads the audio and video clips of a soal in display order
+func (r *soalRepositoryImpl) getMedia(ctx context.Context, idSoal int) ([]entity.SoalMedia, error) {
+	query := `
+		SELECT id, id_soal, jenis, nama_file, file_path, file_size, mime_type, durasi_detik, max_putar, urutan, keterangan, cloud_id, public_id, created_at
+		FROM soal_media
+		WHERE id_soal = $1
+		ORDER BY urutan ASC, id ASC`
+	rows, err := r.db.QueryContext(ctx, query, idSoal)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var media []entity.SoalMedia
+	for rows.Next() {
+		var m entity.SoalMedia
+		if err := rows.Scan(&m.ID, &m.IDSoal, &m.Jenis, &m.NamaFile, &m.FilePath, &m.FileSize, &m.MimeType, &m.DurasiDetik, &m.MaxPutar, &m.Urutan, &m.Keterangan, &m.CloudId, &m.PublicId, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+	return media, rows.Err()
+}
+
+// CreateMedia creates a new soal audio or video clip
+func (r *soalRepositoryImpl) CreateMedia(ctx context.Context, media *entity.SoalMedia) error {
+	query := `
+		INSERT INTO soal_media (id_soal, jenis, nama_file, file_path, file_size, mime_type, durasi_detik, max_putar, urutan, keterangan, cloud_id, public_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query, media.IDSoal, string(media.Jenis), media.NamaFile, media.FilePath, media.FileSize, media.MimeType, media.DurasiDetik, media.MaxPutar, media.Urutan, media.Keterangan, media.CloudId, media.PublicId).Scan(&media.ID, &media.CreatedAt)
+}
+
+// GetMediaByID gets a soal clip by ID
+func (r *soalRepositoryImpl) GetMediaByID(ctx context.Context, id int) (*entity.SoalMedia, error) {
+	var m entity.SoalMedia
+	query := `SELECT id, id_soal, jenis, nama_file, file_path, file_size, mime_type, durasi_detik, max_putar, urutan, keterangan, cloud_id, public_id, created_at FROM soal_media WHERE id = $1`
+	err := r.db.QueryRowContext(ctx, query, id).Scan(&m.ID, &m.IDSoal, &m.Jenis, &m.NamaFile, &m.FilePath, &m.FileSize, &m.MimeType, &m.DurasiDetik, &m.MaxPutar, &m.Urutan, &m.Keterangan, &m.CloudId, &m.PublicId, &m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// UpdateMedia updates the order, play limit, duration and caption of a soal clip
+func (r *soalRepositoryImpl) UpdateMedia(ctx context.Context, id int, urutan, maxPutar int, durasiDetik float64, keterangan *string) error {
+	query := `UPDATE soal_media SET urutan = $1, max_putar = $2, durasi_detik = $3, keterangan = $4 WHERE id = $5`
+	_, err := r.db.ExecContext(ctx, query, urutan, maxPutar, durasiDetik, keterangan, id)
 	return err
 }
 
+// DeleteMedia deletes a soal clip and its play counts
+func (r *soalRepositoryImpl) DeleteMedia(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM sesi_media_putar WHERE id_media = $1`, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM soal_media WHERE id = $1`, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 // GetQuestionCountsByTopic returns the count of questions per topic (both MC and drag-drop)
-func (r *soalRepositoryImpl) GetQuestionCountsByTopic() (map[int]int, error) {
+func (r *soalRepositoryImpl) GetQuestionCountsByTopic(ctx context.Context) (map[int]int, error) {
 	counts := make(map[int]int)
 
 	// Count multiple-choice questions
@@ -376,7 +572,7 @@ func (r *soalRepositoryImpl) GetQuestionCountsByTopic() (map[int]int, error) {
 		FROM soal
 		WHERE is_active = true
 		GROUP BY id_materi`
-	mcRows, err := r.db.Query(mcQuery)
+	mcRows, err := r.db.QueryContext(ctx, mcQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -397,7 +593,7 @@ func (r *soalRepositoryImpl) GetQuestionCountsByTopic() (map[int]int, error) {
 		FROM soal_drag_drop
 		WHERE is_active = true
 		GROUP BY id_materi`
-	ddRows, err := r.db.Query(ddQuery)
+	ddRows, err := r.db.QueryContext(ctx, ddQuery)
 	if err != nil {
 		return nil, err
 	}
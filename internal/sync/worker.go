@@ -20,6 +20,7 @@ import (
 	"cbt-test-mini-project/internal/repository/materi"
 	testSessionRepo "cbt-test-mini-project/internal/repository/test_session"
 	"cbt-test-mini-project/internal/repository/tingkat"
+	"cbt-test-mini-project/util/tenant"
 
 	goredis "github.com/redis/go-redis/v9"
 )
@@ -152,38 +153,45 @@ func (w *SyncWorker) processMessage(ctx context.Context, streamName string, msg
 
 	slog.Debug("processing LMS event", "type", eventType)
 
+	// LMS events span every school
+	ctx = tenant.System(ctx)
+
 	var processErr error
 	switch eventType {
 	case "level_upsert":
-		processErr = w.handleLevelUpsert(payload)
+		processErr = w.handleLevelUpsert(ctx, payload)
 	case "subject_upsert":
-		processErr = w.handleSubjectUpsert(payload)
+		processErr = w.handleSubjectUpsert(ctx, payload)
 	case string(contracts.ModuleUpsert):
-		processErr = w.handleModuleUpsert(payload)
+		processErr = w.handleModuleUpsert(ctx, payload)
 	case "user_upsert":
-		processErr = w.handleUserUpsert(payload)
+		processErr = w.handleUserUpsert(ctx, payload)
 	case string(contracts.ExamAssignmentCreated):
-		processErr = w.handleExamAssignmentCreated(payload)
+		processErr = w.handleExamAssignmentCreated(ctx, payload)
 	case string(contracts.ExamAssignmentUpdated):
-		processErr = w.handleExamAssignmentUpdated(payload)
+		processErr = w.handleExamAssignmentUpdated(ctx, payload)
 	case string(contracts.ExamAssignmentDeleted):
-		processErr = w.handleExamAssignmentDeleted(payload)
+		processErr = w.handleExamAssignmentDeleted(ctx, payload)
 	case string(contracts.ClassUpsert):
-		processErr = w.handleClassUpsert(payload)
+		processErr = w.handleClassUpsert(ctx, payload)
 	case string(contracts.ClassDeleted):
-		processErr = w.handleClassDeleted(payload)
+		processErr = w.handleClassDeleted(ctx, payload)
 	case "level_deleted":
-		processErr = w.handleLevelDeleted(payload)
+		processErr = w.handleLevelDeleted(ctx, payload)
 	case "subject_deleted":
-		processErr = w.handleSubjectDeleted(payload)
+		processErr = w.handleSubjectDeleted(ctx, payload)
 	case string(contracts.ModuleDeleted):
-		processErr = w.handleModuleDeleted(payload)
+		processErr = w.handleModuleDeleted(ctx, payload)
 	case "user_deleted":
-		processErr = w.handleUserDeleted(payload)
+		processErr = w.handleUserDeleted(ctx, payload)
 	case string(contracts.ClassStudentJoined):
-		processErr = w.handleClassStudentJoined(payload)
+		processErr = w.handleClassStudentJoined(ctx, payload)
 	case string(contracts.ClassStudentLeft):
-		processErr = w.handleClassStudentLeft(payload)
+		processErr = w.handleClassStudentLeft(ctx, payload)
+	case string(contracts.ClassTeacherJoined):
+		processErr = w.handleClassTeacherJoined(ctx, payload)
+	case string(contracts.ClassTeacherLeft):
+		processErr = w.handleClassTeacherLeft(ctx, payload)
 	default:
 		slog.Warn("unknown LMS event type, skipping", "type", eventType)
 	}
@@ -401,7 +409,7 @@ type LevelPayload struct {
 	SchoolID int64  `json:"school_id"`
 }
 
-func (w *SyncWorker) handleLevelUpsert(payload string) error {
+func (w *SyncWorker) handleLevelUpsert(ctx context.Context, payload string) error {
 	slog.Debug("processing level upsert event", "payload", payload)
 	var p LevelPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
@@ -423,7 +431,7 @@ type SubjectPayload struct {
 	SchoolID int64  `json:"school_id"`
 }
 
-func (w *SyncWorker) handleSubjectUpsert(payload string) error {
+func (w *SyncWorker) handleSubjectUpsert(ctx context.Context, payload string) error {
 	var p SubjectPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal subject payload: %w", err)
@@ -436,13 +444,13 @@ func (w *SyncWorker) handleSubjectUpsert(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleModuleUpsert(payload string) error {
+func (w *SyncWorker) handleModuleUpsert(ctx context.Context, payload string) error {
 	var p contracts.ModuleUpsertPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal module payload: %w", err)
 	}
 
-	if err := w.materiRepo.UpsertByLMSID(p.ID, p.SubjectID, p.LevelID, p.ClassID, p.Name); err != nil {
+	if err := w.materiRepo.UpsertByLMSID(ctx, p.ID, p.SubjectID, p.LevelID, p.ClassID, p.Name); err != nil {
 		return fmt.Errorf("failed to sync module id=%d: %w", p.ID, err)
 	}
 	if p.SubjectID > 0 && p.ClassID > 0 {
@@ -461,7 +469,7 @@ type UserPayload struct {
 	Role  string `json:"role"`
 }
 
-func (w *SyncWorker) handleUserUpsert(payload string) error {
+func (w *SyncWorker) handleUserUpsert(ctx context.Context, payload string) error {
 	var p UserPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal user payload: %w", err)
@@ -475,7 +483,7 @@ func (w *SyncWorker) handleUserUpsert(payload string) error {
 		roleInt = 3
 	}
 
-	_, err := w.authRepo.FindOrCreateByLMSID(context.Background(), p.ID, p.Email, p.Name, roleInt)
+	_, err := w.authRepo.FindOrCreateByLMSID(ctx, p.ID, p.Email, p.Name, roleInt)
 	if err != nil {
 		return fmt.Errorf("failed to sync user id=%d: %w", p.ID, err)
 	}
@@ -485,7 +493,7 @@ func (w *SyncWorker) handleUserUpsert(payload string) error {
 		slog.Warn("failed to list class memberships after user upsert", "lms_user_id", p.ID, "error", classErr)
 	} else {
 		for _, classID := range classIDs {
-			created, backfillErr := w.testSessionRepo.BackfillSessionsForJoinedStudent(classID, p.ID)
+			created, backfillErr := w.testSessionRepo.BackfillSessionsForJoinedStudent(ctx, classID, p.ID)
 			if backfillErr != nil {
 				slog.Warn("failed to backfill sessions after user upsert", "lms_user_id", p.ID, "lms_class_id", classID, "error", backfillErr)
 				continue
@@ -500,7 +508,7 @@ func (w *SyncWorker) handleUserUpsert(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleExamAssignmentCreated(payload string) error {
+func (w *SyncWorker) handleExamAssignmentCreated(ctx context.Context, payload string) error {
 	var p contracts.ExamAssignmentPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal exam assignment payload: %w", err)
@@ -511,7 +519,7 @@ func (w *SyncWorker) handleExamAssignmentCreated(payload string) error {
 	}
 
 	// 1. Resolve module reference to materi details
-	materi, err := w.resolveMateriByModuleReference(p.ModuleID, p.ModuleRefType)
+	materi, err := w.resolveMateriByModuleReference(ctx, p.ModuleID, p.ModuleRefType)
 	if err != nil {
 		return fmt.Errorf("failed to resolve materi details for module_id=%d: %w", p.ModuleID, err)
 	}
@@ -530,6 +538,7 @@ func (w *SyncWorker) handleExamAssignmentCreated(payload string) error {
 	var lastErr error
 	for _, studentID := range studentIDs {
 		created, err := w.testSessionRepo.CreateSessionForLMSUserIfMissing(
+			ctx,
 			p.AssignmentID,
 			p.ClassID,
 			studentID,
@@ -559,7 +568,7 @@ func (w *SyncWorker) handleExamAssignmentCreated(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleExamAssignmentUpdated(payload string) error {
+func (w *SyncWorker) handleExamAssignmentUpdated(ctx context.Context, payload string) error {
 	var p contracts.ExamAssignmentPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal exam assignment updated payload: %w", err)
@@ -570,7 +579,7 @@ func (w *SyncWorker) handleExamAssignmentUpdated(payload string) error {
 	}
 
 	if p.ModuleID == 0 {
-		deleted, err := w.testSessionRepo.DeleteSessionsByAssignment(p.AssignmentID)
+		deleted, err := w.testSessionRepo.DeleteSessionsByAssignment(ctx, p.AssignmentID)
 		if err != nil {
 			return fmt.Errorf("failed to delete sessions for assignment without CBT module id=%d: %w", p.AssignmentID, err)
 		}
@@ -578,13 +587,14 @@ func (w *SyncWorker) handleExamAssignmentUpdated(payload string) error {
 		return nil
 	}
 
-	materiData, err := w.resolveMateriByModuleReference(p.ModuleID, p.ModuleRefType)
+	materiData, err := w.resolveMateriByModuleReference(ctx, p.ModuleID, p.ModuleRefType)
 	if err != nil {
 		return fmt.Errorf("failed to resolve materi for assignment update module_id=%d: %w", p.ModuleID, err)
 	}
 
 	scheduledTime := parseScheduledTime(p.ScheduledTime)
 	updatedRows, err := w.testSessionRepo.UpdateScheduledSessionsByAssignment(
+		ctx,
 		p.AssignmentID,
 		p.ClassID,
 		int(materiData.IDMataPelajaran),
@@ -607,6 +617,7 @@ func (w *SyncWorker) handleExamAssignmentUpdated(payload string) error {
 	var lastErr error
 	for _, studentID := range studentIDs {
 		created, createErr := w.testSessionRepo.CreateSessionForLMSUserIfMissing(
+			ctx,
 			p.AssignmentID,
 			p.ClassID,
 			studentID,
@@ -636,7 +647,7 @@ func (w *SyncWorker) handleExamAssignmentUpdated(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleExamAssignmentDeleted(payload string) error {
+func (w *SyncWorker) handleExamAssignmentDeleted(ctx context.Context, payload string) error {
 	var p contracts.ExamAssignmentPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal exam assignment deleted payload: %w", err)
@@ -645,7 +656,7 @@ func (w *SyncWorker) handleExamAssignmentDeleted(payload string) error {
 		return fmt.Errorf("missing assignment_id on exam_assignment_deleted")
 	}
 
-	deletedRows, err := w.testSessionRepo.DeleteSessionsByAssignment(p.AssignmentID)
+	deletedRows, err := w.testSessionRepo.DeleteSessionsByAssignment(ctx, p.AssignmentID)
 	if err != nil {
 		return fmt.Errorf("failed deleting sessions for assignment_id=%d: %w", p.AssignmentID, err)
 	}
@@ -665,7 +676,7 @@ func parseScheduledTime(raw string) time.Time {
 	return parsed
 }
 
-func (w *SyncWorker) resolveMateriByModuleReference(moduleID int64, moduleRefType string) (*entity.Materi, error) {
+func (w *SyncWorker) resolveMateriByModuleReference(ctx context.Context, moduleID int64, moduleRefType string) (*entity.Materi, error) {
 	if moduleID == 0 {
 		return nil, fmt.Errorf("module_id is required")
 	}
@@ -674,20 +685,20 @@ func (w *SyncWorker) resolveMateriByModuleReference(moduleID int64, moduleRefTyp
 
 	switch normalizedRefType {
 	case "lms_module_id", "module", "module_id":
-		return w.materiRepo.GetByLMSID(moduleID)
+		return w.materiRepo.GetByLMSID(ctx, moduleID)
 	case "lms_book_id", "book", "book_id":
-		return w.materiRepo.GetByLMSBookID(moduleID)
+		return w.materiRepo.GetByLMSBookID(ctx, moduleID)
 	case "teacher_material_id", "lms_teacher_material_id":
-		return w.materiRepo.GetByLMSTeacherMaterialID(moduleID)
+		return w.materiRepo.GetByLMSTeacherMaterialID(ctx, moduleID)
 	case "cbt_materi_id":
-		return w.materiRepo.GetByID(int(moduleID))
+		return w.materiRepo.GetByID(ctx, int(moduleID))
 	}
 
-	if materiData, err := w.materiRepo.GetByLMSID(moduleID); err == nil {
+	if materiData, err := w.materiRepo.GetByLMSID(ctx, moduleID); err == nil {
 		return materiData, nil
 	}
 
-	materiData, err := w.materiRepo.GetByID(int(moduleID))
+	materiData, err := w.materiRepo.GetByID(ctx, int(moduleID))
 	if err != nil {
 		return nil, err
 	}
@@ -695,7 +706,7 @@ func (w *SyncWorker) resolveMateriByModuleReference(moduleID int64, moduleRefTyp
 	return materiData, nil
 }
 
-func (w *SyncWorker) handleLevelDeleted(payload string) error {
+func (w *SyncWorker) handleLevelDeleted(ctx context.Context, payload string) error {
 	var p contracts.DeletePayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal level delete payload: %w", err)
@@ -708,7 +719,7 @@ func (w *SyncWorker) handleLevelDeleted(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleSubjectDeleted(payload string) error {
+func (w *SyncWorker) handleSubjectDeleted(ctx context.Context, payload string) error {
 	var p contracts.DeletePayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal subject delete payload: %w", err)
@@ -721,33 +732,33 @@ func (w *SyncWorker) handleSubjectDeleted(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleModuleDeleted(payload string) error {
+func (w *SyncWorker) handleModuleDeleted(ctx context.Context, payload string) error {
 	var p contracts.DeletePayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal module delete payload: %w", err)
 	}
 
-	if err := w.materiRepo.DeleteByLMSID(p.ID); err != nil {
+	if err := w.materiRepo.DeleteByLMSID(ctx, p.ID); err != nil {
 		return fmt.Errorf("failed to delete module id=%d: %w", p.ID, err)
 	}
 	slog.Info("Deleted Module from LMS", "id", p.ID)
 	return nil
 }
 
-func (w *SyncWorker) handleUserDeleted(payload string) error {
+func (w *SyncWorker) handleUserDeleted(ctx context.Context, payload string) error {
 	var p contracts.DeletePayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal user delete payload: %w", err)
 	}
 
-	if err := w.authRepo.DeleteUser(context.Background(), int32(p.ID)); err != nil {
+	if err := w.authRepo.DeleteUser(ctx, int32(p.ID)); err != nil {
 		return fmt.Errorf("failed to delete user id=%d: %w", p.ID, err)
 	}
 	slog.Info("Deleted User from LMS", "id", p.ID)
 	return nil
 }
 
-func (w *SyncWorker) handleClassUpsert(payload string) error {
+func (w *SyncWorker) handleClassUpsert(ctx context.Context, payload string) error {
 	var p contracts.ClassPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal class payload: %w", err)
@@ -760,7 +771,7 @@ func (w *SyncWorker) handleClassUpsert(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleClassDeleted(payload string) error {
+func (w *SyncWorker) handleClassDeleted(ctx context.Context, payload string) error {
 	var p contracts.DeletePayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal class delete payload: %w", err)
@@ -773,7 +784,7 @@ func (w *SyncWorker) handleClassDeleted(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleClassStudentJoined(payload string) error {
+func (w *SyncWorker) handleClassStudentJoined(ctx context.Context, payload string) error {
 	var p contracts.ClassStudentPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal class_student_joined payload: %w", err)
@@ -783,7 +794,7 @@ func (w *SyncWorker) handleClassStudentJoined(payload string) error {
 		return fmt.Errorf("failed to add student to class class_id=%d user_id=%d: %w", p.ClassID, p.UserID, err)
 	}
 
-	created, err := w.testSessionRepo.BackfillSessionsForJoinedStudent(p.ClassID, p.UserID)
+	created, err := w.testSessionRepo.BackfillSessionsForJoinedStudent(ctx, p.ClassID, p.UserID)
 	if err != nil {
 		return fmt.Errorf("failed to backfill sessions for joined student class_id=%d user_id=%d: %w", p.ClassID, p.UserID, err)
 	}
@@ -791,7 +802,7 @@ func (w *SyncWorker) handleClassStudentJoined(payload string) error {
 	return nil
 }
 
-func (w *SyncWorker) handleClassStudentLeft(payload string) error {
+func (w *SyncWorker) handleClassStudentLeft(ctx context.Context, payload string) error {
 	var p contracts.ClassStudentPayload
 	if err := json.Unmarshal([]byte(payload), &p); err != nil {
 		return fmt.Errorf("failed to unmarshal class_student_left payload: %w", err)
@@ -803,3 +814,29 @@ func (w *SyncWorker) handleClassStudentLeft(payload string) error {
 	slog.Info("Synced student leave from class from LMS", "class_id", p.ClassID, "user_id", p.UserID)
 	return nil
 }
+
+func (w *SyncWorker) handleClassTeacherJoined(ctx context.Context, payload string) error {
+	var p contracts.ClassTeacherPayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		return fmt.Errorf("failed to unmarshal class_teacher_joined payload: %w", err)
+	}
+
+	if err := w.classRepo.AddTeacher(ctx, p.ClassID, p.UserID); err != nil {
+		return fmt.Errorf("failed to add teacher to class class_id=%d user_id=%d: %w", p.ClassID, p.UserID, err)
+	}
+	slog.Info("Synced teacher assignment to class from LMS", "class_id", p.ClassID, "user_id", p.UserID)
+	return nil
+}
+
+func (w *SyncWorker) handleClassTeacherLeft(ctx context.Context, payload string) error {
+	var p contracts.ClassTeacherPayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		return fmt.Errorf("failed to unmarshal class_teacher_left payload: %w", err)
+	}
+
+	if err := w.classRepo.RemoveTeacher(ctx, p.ClassID, p.UserID); err != nil {
+		return fmt.Errorf("failed to remove teacher from class class_id=%d user_id=%d: %w", p.ClassID, p.UserID, err)
+	}
+	slog.Info("Synced teacher leave from class from LMS", "class_id", p.ClassID, "user_id", p.UserID)
+	return nil
+}
@@ -0,0 +1,128 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/api_key"
+	"cbt-test-mini-project/util/apikey"
+	"cbt-test-mini-project/util/tenant"
+)
+
+// maxAPIKeyNameLength matches api_keys.name
+const maxAPIKeyNameLength = 100
+
+// apiKeyUsecaseImpl implements APIKeyUsecase
+type apiKeyUsecaseImpl struct {
+	repo api_key.APIKeyRepository
+}
+
+// NewAPIKeyUsecase creates a new APIKeyUsecase instance
+func NewAPIKeyUsecase(repo api_key.APIKeyRepository) APIKeyUsecase {
+	return &apiKeyUsecaseImpl{repo: repo}
+}
+
+// CreateAPIKey issues a key limited to scopes, and to one school when lmsSchoolID is set.
+// Callers limited to a school can only issue keys of that school. expiresInDays 0 means
+// the key does not expire.
+func (u *apiKeyUsecaseImpl) CreateAPIKey(ctx context.Context, name string, scopes []string, lmsSchoolID int64, expiresInDays int, createdBy int) (*entity.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("name is required")
+	}
+	if len(name) > maxAPIKeyNameLength {
+		return nil, "", fmt.Errorf("name must be at most %d characters", maxAPIKeyNameLength)
+	}
+	if lmsSchoolID < 0 {
+		return nil, "", errors.New("lms_school_id cannot be negative")
+	}
+	if expiresInDays < 0 {
+		return nil, "", errors.New("expires_in_days cannot be negative")
+	}
+	if schoolID := callerSchool(ctx); schoolID != nil {
+		if *schoolID == 0 {
+			return nil, "", errors.New("your account is not linked to a school")
+		}
+		if lmsSchoolID != 0 && lmsSchoolID != *schoolID {
+			return nil, "", errors.New("lms_school_id must be your school")
+		}
+		lmsSchoolID = *schoolID
+	}
+
+	cleaned := make([]string, 0, len(scopes))
+	seen := map[string]bool{}
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !entity.IsValidAPIKeyScope(scope) {
+			return nil, "", fmt.Errorf("invalid scope %q", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			cleaned = append(cleaned, scope)
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil, "", errors.New("at least one scope is required")
+	}
+
+	plaintext, prefix, hash, err := apikey.Generate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &entity.APIKey{
+		Name:      name,
+		KeyPrefix: prefix,
+		KeyHash:   hash,
+		Scopes:    cleaned,
+		CreatedBy: createdBy,
+	}
+	if lmsSchoolID > 0 {
+		key.LMSSchoolID = &lmsSchoolID
+	}
+	if expiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, expiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := u.repo.CreateAPIKey(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, plaintext, nil
+}
+
+// ListAPIKeys lists issued keys, of the caller's school when it is limited to one; the
+// prefix identifies each one
+func (u *apiKeyUsecaseImpl) ListAPIKeys(ctx context.Context, includeRevoked bool) ([]entity.APIKey, error) {
+	return u.repo.ListAPIKeys(ctx, callerSchool(ctx), includeRevoked)
+}
+
+// RevokeAPIKey stops a key from authenticating immediately
+func (u *apiKeyUsecaseImpl) RevokeAPIKey(ctx context.Context, id int, revokedBy int) error {
+	if id <= 0 {
+		return errors.New("id is required")
+	}
+	revoked, err := u.repo.RevokeAPIKey(ctx, id, callerSchool(ctx), revokedBy)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return errors.New("api key not found or already revoked")
+	}
+	return nil
+}
+
+// callerSchool is the school a caller is limited to, nil for superadmins working across
+// schools. A caller without any school is limited to a school no key belongs to.
+func callerSchool(ctx context.Context) *int64 {
+	t, _ := tenant.FromContext(ctx)
+	if t.CrossTenant {
+		return nil
+	}
+	schoolID := t.SchoolID
+	return &schoolID
+}
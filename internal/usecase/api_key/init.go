@@ -0,0 +1,15 @@
+package api_key
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// APIKeyUsecase defines the interface for managing service-to-service API keys
+type APIKeyUsecase interface {
+	// CreateAPIKey returns the stored key and its plaintext, which is never shown again
+	CreateAPIKey(ctx context.Context, name string, scopes []string, lmsSchoolID int64, expiresInDays int, createdBy int) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, includeRevoked bool) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int, revokedBy int) error
+}
@@ -0,0 +1,47 @@
+package audit_log
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/audit_log"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// auditLogUsecaseImpl implements AuditLogUsecase
+type auditLogUsecaseImpl struct {
+	repo audit_log.AuditLogRepository
+}
+
+// NewAuditLogUsecase creates a new AuditLogUsecase instance
+func NewAuditLogUsecase(repo audit_log.AuditLogRepository) AuditLogUsecase {
+	return &auditLogUsecaseImpl{repo: repo}
+}
+
+// ListAuditLogs returns one page of entries matching the filter, newest first
+func (u *auditLogUsecaseImpl) ListAuditLogs(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, *entity.PaginationResponse, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultPageSize
+	}
+	if filter.PageSize > maxPageSize {
+		filter.PageSize = maxPageSize
+	}
+
+	entries, total, err := u.repo.List(ctx, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, &entity.PaginationResponse{
+		TotalCount:  total,
+		TotalPages:  (total + filter.PageSize - 1) / filter.PageSize,
+		CurrentPage: filter.Page,
+		PageSize:    filter.PageSize,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package audit_log
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// AuditLogUsecase defines the interface for reading the audit log
+type AuditLogUsecase interface {
+	// ListAuditLogs returns one page of entries matching the filter, newest first
+	ListAuditLogs(ctx context.Context, filter entity.AuditLogFilter) ([]entity.AuditLog, *entity.PaginationResponse, error)
+}
@@ -2,20 +2,40 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/repository/auth"
+	"cbt-test-mini-project/internal/repository/auth_session"
 	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/localauth"
+	"cbt-test-mini-project/util/tenant"
 )
 
+// Password bounds; bcrypt ignores everything after 72 bytes
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72
+)
+
+// ErrLocalAuthDisabled is returned by the standalone login calls when AUTH_MODE=lms
+var ErrLocalAuthDisabled = errors.New("local login is disabled, sign in through the LMS")
+
 // authUsecaseImpl implements AuthUsecase.
-type authUsecaseImpl struct{}
+type authUsecaseImpl struct {
+	repo     auth.AuthRepository
+	sessions auth_session.AuthSessionRepository
+	config   *config.Main
+}
 
 // NewAuthUsecase creates a new auth usecase.
-// Parameters are retained for dependency wiring compatibility.
-func NewAuthUsecase(_ auth.AuthRepository, _ *config.Main) AuthUsecase {
-	return &authUsecaseImpl{}
+func NewAuthUsecase(repo auth.AuthRepository, sessions auth_session.AuthSessionRepository, config *config.Main) AuthUsecase {
+	return &authUsecaseImpl{repo: repo, sessions: sessions, config: config}
 }
 
 // GetProfile gets the current authenticated user's profile.
@@ -26,3 +46,163 @@ func (u *authUsecaseImpl) GetProfile(ctx context.Context) (*base.User, error) {
 	}
 	return user, nil
 }
+
+// Login checks the password and opens a session with an access and a refresh token. It runs
+// before any tenant is known, so the session is stored across schools.
+func (u *authUsecaseImpl) Login(ctx context.Context, email, password string) (*base.User, *entity.AuthTokens, error) {
+	if !u.config.JWT.LocalAuthEnabled() {
+		return nil, nil, ErrLocalAuthDisabled
+	}
+	ctx = tenant.System(ctx)
+	email = strings.TrimSpace(email)
+	if email == "" || password == "" {
+		return nil, nil, errors.New("email and password are required")
+	}
+
+	user, err := u.repo.Login(ctx, email, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	schoolID, err := u.userSchoolID(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, refreshHash, err := localauth.NewRefreshToken()
+	if err != nil {
+		return nil, nil, err
+	}
+	refreshExpiresAt := time.Now().Add(u.refreshTTL())
+
+	session := entity.NewAuthSession(user.Id, interceptor.GetDeviceInfoFromContext(ctx))
+	session.SchoolID = &schoolID
+	if err := u.sessions.CreateSession(ctx, session, refreshHash, refreshExpiresAt); err != nil {
+		return nil, nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	tokens, err := u.issueAccessToken(user, schoolID, session.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokens.RefreshToken = refreshToken
+	tokens.RefreshExpiresAt = refreshExpiresAt
+	return user, tokens, nil
+}
+
+// RefreshToken rotates a refresh token: the presented one is consumed and a new pair issued.
+// Presenting a consumed token again ends the session. Like Login it runs before any tenant
+// is known.
+func (u *authUsecaseImpl) RefreshToken(ctx context.Context, refreshToken string) (*entity.AuthTokens, error) {
+	if !u.config.JWT.LocalAuthEnabled() {
+		return nil, ErrLocalAuthDisabled
+	}
+	ctx = tenant.System(ctx)
+	if strings.TrimSpace(refreshToken) == "" {
+		return nil, entity.ErrInvalidRefreshToken
+	}
+
+	nextToken, nextHash, err := localauth.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshExpiresAt := time.Now().Add(u.refreshTTL())
+
+	session, err := u.sessions.RotateRefreshToken(ctx, localauth.HashRefreshToken(refreshToken), nextHash, refreshExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.repo.GetUserByID(ctx, session.UserID)
+	if err != nil || !user.IsActive {
+		_ = u.sessions.RevokeSession(ctx, session.ID, session.UserID, entity.AuthSessionRevokedUserInactive)
+		return nil, entity.ErrInvalidRefreshToken
+	}
+	schoolID, err := u.userSchoolID(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := u.issueAccessToken(user, schoolID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	tokens.RefreshToken = nextToken
+	tokens.RefreshExpiresAt = refreshExpiresAt
+	return tokens, nil
+}
+
+// Logout ends the session of the calling access token. Sessions are looked up across schools
+// since a superadmin may have narrowed the call to another school with X-School-Id.
+func (u *authUsecaseImpl) Logout(ctx context.Context) error {
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	sessionID, ok := interceptor.GetAuthSessionIDFromContext(ctx)
+	if !ok {
+		return errors.New("not logged in with a local session")
+	}
+	return u.sessions.RevokeSession(tenant.System(ctx), sessionID, user.Id, entity.AuthSessionRevokedLogout)
+}
+
+// ChangePassword sets a new password and ends every other session of the user.
+func (u *authUsecaseImpl) ChangePassword(ctx context.Context, currentPassword, newPassword string) error {
+	if !u.config.JWT.LocalAuthEnabled() {
+		return ErrLocalAuthDisabled
+	}
+	user, err := interceptor.GetUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	sessionID, ok := interceptor.GetAuthSessionIDFromContext(ctx)
+	if !ok {
+		return errors.New("not logged in with a local session")
+	}
+
+	if len(newPassword) < minPasswordLength || len(newPassword) > maxPasswordLength {
+		return fmt.Errorf("new password must be %d to %d characters", minPasswordLength, maxPasswordLength)
+	}
+	if newPassword == currentPassword {
+		return errors.New("new password must differ from the current one")
+	}
+
+	if err := u.repo.ChangePassword(ctx, user.Id, currentPassword, newPassword); err != nil {
+		return err
+	}
+	return u.sessions.RevokeUserSessions(tenant.System(ctx), user.Id, sessionID, entity.AuthSessionRevokedPasswordChange)
+}
+
+// userSchoolID is the school of a local user: users.school_id, falling back to
+// AUTH_LOCAL_SCHOOL_ID
+func (u *authUsecaseImpl) userSchoolID(ctx context.Context, user *base.User) (int64, error) {
+	schoolID, err := u.repo.GetUserSchoolID(ctx, user.Id)
+	if err != nil {
+		return 0, err
+	}
+	if schoolID == 0 {
+		schoolID = u.config.JWT.LocalSchoolID
+	}
+	return schoolID, nil
+}
+
+// issueAccessToken signs an access token for the session, scoped to schoolID
+func (u *authUsecaseImpl) issueAccessToken(user *base.User, schoolID int64, sessionID int64) (*entity.AuthTokens, error) {
+	claims := localauth.Claims{
+		UserID:      user.Id,
+		LMSSchoolID: schoolID,
+		Email:       user.Email,
+		FullName:    user.Nama,
+		Role:        interceptor.RoleNameFromProto(user.Role),
+		SessionID:   sessionID,
+	}
+	ttl := time.Duration(u.config.JWT.AccessTokenTTL) * time.Minute
+	accessToken, expiresAt, err := localauth.SignAccessToken(u.config.JWT.Secret, claims, ttl, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &entity.AuthTokens{AccessToken: accessToken, AccessExpiresAt: expiresAt, SessionID: sessionID}, nil
+}
+
+func (u *authUsecaseImpl) refreshTTL() time.Duration {
+	return time.Duration(u.config.JWT.RefreshTokenTTL) * time.Minute
+}
@@ -5,14 +5,23 @@ import (
 
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/repository/auth"
+	"cbt-test-mini-project/internal/repository/auth_session"
 )
 
 type AuthUsecase interface {
 	GetProfile(ctx context.Context) (*base.User, error)
+
+	// Standalone login, available when AUTH_MODE is local or both
+	Login(ctx context.Context, email, password string) (*base.User, *entity.AuthTokens, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*entity.AuthTokens, error)
+	Logout(ctx context.Context) error
+	// ChangePassword also ends every other session of the user
+	ChangePassword(ctx context.Context, currentPassword, newPassword string) error
 }
 
 // InitAuthUsecase initializes the auth usecase
-func InitAuthUsecase(repo auth.AuthRepository, config *config.Main) AuthUsecase {
-	return NewAuthUsecase(repo, config)
-}
\ No newline at end of file
+func InitAuthUsecase(repo auth.AuthRepository, sessions auth_session.AuthSessionRepository, config *config.Main) AuthUsecase {
+	return NewAuthUsecase(repo, sessions, config)
+}
@@ -0,0 +1,267 @@
+package exam_security
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+const (
+	defaultSyncWindowSeconds = 10
+	defaultMinSharedWrong    = 3
+	defaultCollusionLimit    = 50
+
+	// minDistractors is the number of wrong options assumed for a question whose option
+	// count is unknown when the class did not use enough distinct wrong answers to estimate it.
+	minDistractors = 3
+	// collusionPValueThreshold flags pairs whose shared wrong answers are very unlikely by chance.
+	collusionPValueThreshold = 0.001
+	// harppHoganThreshold is the EEIC/D ratio above which a pair is flagged.
+	harppHoganThreshold = 1.0
+	// minSynchronizedAnswers is the number of common questions answered together before timing
+	// alone can flag a pair.
+	minSynchronizedAnswers = 3
+	// minSynchronizedRatio flags pairs that answered at least this share of common questions together.
+	minSynchronizedRatio = 0.5
+)
+
+// AnalyzeCollusion compares the answer vectors of every pair of finished sessions of an
+// assignment and returns the pairs with suspicious overlap, most suspicious first.
+//
+// Two signals are combined:
+//   - identical wrong answers, reported as the Harpp-Hogan EEIC/D ratio and as the upper tail
+//     probability of a Poisson model whose mean is the expected number of matching wrong
+//     answers given how the rest of the class chose its distractors;
+//   - synchronized timing, the number of common questions answered within syncWindowSeconds.
+//
+// A non-nil scope limits the report to sessions of classes the teacher teaches.
+func (u *examSecurityUsecaseImpl) AnalyzeCollusion(ctx context.Context, lmsAssignmentID int64, syncWindowSeconds, minSharedWrong, limit int, scope *entity.TeacherScope) (*entity.CollusionReport, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	if syncWindowSeconds <= 0 {
+		syncWindowSeconds = defaultSyncWindowSeconds
+	}
+	if minSharedWrong <= 0 {
+		minSharedWrong = defaultMinSharedWrong
+	}
+	if limit <= 0 {
+		limit = defaultCollusionLimit
+	}
+
+	patterns, err := u.repo.ListAssignmentAnswerPatterns(ctx, lmsAssignmentID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entity.CollusionReport{
+		LMSAssignmentID: lmsAssignmentID,
+		SessionCount:    len(patterns),
+		GeneratedAt:     time.Now(),
+	}
+	if len(patterns) < 2 {
+		return report, nil
+	}
+
+	// Wrong-answer frequencies per question across the whole class
+	wrongCounts := make(map[entity.AnswerItemKey]map[string]int)
+	answerMaps := make([]map[entity.AnswerItemKey]entity.RecordedAnswer, len(patterns))
+	for i, pattern := range patterns {
+		answerMaps[i] = make(map[entity.AnswerItemKey]entity.RecordedAnswer, len(pattern.Answers))
+		for _, answer := range pattern.Answers {
+			answerMaps[i][answer.Item] = answer
+			if answer.IsCorrect {
+				continue
+			}
+			if wrongCounts[answer.Item] == nil {
+				wrongCounts[answer.Item] = make(map[string]int)
+			}
+			wrongCounts[answer.Item][answer.Response]++
+		}
+	}
+
+	syncWindow := float64(syncWindowSeconds)
+	var pairs []entity.CollusionPair
+	for i := 0; i < len(patterns); i++ {
+		for j := i + 1; j < len(patterns); j++ {
+			report.PairsAnalyzed++
+			pair := compareSessions(patterns[i], patterns[j], answerMaps[i], answerMaps[j], wrongCounts, syncWindow)
+			if pair.SharedWrong < minSharedWrong && pair.SynchronizedAnswers < minSynchronizedAnswers {
+				continue
+			}
+
+			pair.Flagged = (pair.SharedWrong >= minSharedWrong &&
+				(pair.SharedWrongPValue < collusionPValueThreshold || pair.HarppHoganRatio >= harppHoganThreshold)) ||
+				(pair.SynchronizedAnswers >= minSynchronizedAnswers && pair.SynchronizedRatio >= minSynchronizedRatio)
+			pairs = append(pairs, pair)
+		}
+	}
+
+	sort.SliceStable(pairs, func(a, b int) bool {
+		if pairs[a].Flagged != pairs[b].Flagged {
+			return pairs[a].Flagged
+		}
+		return pairs[a].Score > pairs[b].Score
+	})
+	if len(pairs) > limit {
+		pairs = pairs[:limit]
+	}
+	report.Pairs = pairs
+
+	return report, nil
+}
+
+func compareSessions(a, b entity.SessionAnswerPattern, answersA, answersB map[entity.AnswerItemKey]entity.RecordedAnswer, wrongCounts map[entity.AnswerItemKey]map[string]int, syncWindow float64) entity.CollusionPair {
+	pair := entity.CollusionPair{
+		SessionA: entity.SessionAnswerPattern{SessionID: a.SessionID, SessionToken: a.SessionToken, UserID: a.UserID, NamaPeserta: a.NamaPeserta},
+		SessionB: entity.SessionAnswerPattern{SessionID: b.SessionID, SessionToken: b.SessionToken, UserID: b.UserID, NamaPeserta: b.NamaPeserta},
+	}
+
+	var gaps []float64
+	for _, answerA := range a.Answers {
+		answerB, ok := answersB[answerA.Item]
+		if !ok {
+			continue
+		}
+		pair.CommonItems++
+
+		identical := answerA.Response == answerB.Response
+		if identical {
+			pair.IdenticalAnswers++
+		} else {
+			pair.Differences++
+		}
+
+		bothWrong := !answerA.IsCorrect && !answerB.IsCorrect
+		if bothWrong {
+			pair.ExpectedSharedWrong += chanceWrongMatch(wrongCounts[answerA.Item], distractorCount(answerA), answerA.Response, answerB.Response)
+		}
+		identicalWrong := identical && bothWrong
+		if identicalWrong {
+			pair.SharedWrong++
+		}
+
+		gap := math.Abs(answerA.AnsweredAt.Sub(answerB.AnsweredAt).Seconds())
+		gaps = append(gaps, gap)
+		synchronized := gap <= syncWindow
+		if synchronized {
+			pair.SynchronizedAnswers++
+		}
+
+		if identicalWrong || synchronized {
+			pair.Evidence = append(pair.Evidence, entity.CollusionEvidence{
+				Item:           answerA.Item,
+				ResponseA:      answerA.Response,
+				ResponseB:      answerB.Response,
+				IsCorrect:      answerA.IsCorrect && answerB.IsCorrect,
+				AnsweredAtA:    answerA.AnsweredAt,
+				AnsweredAtB:    answerB.AnsweredAt,
+				TimeGapSeconds: gap,
+				IdenticalWrong: identicalWrong,
+				Synchronized:   synchronized,
+			})
+		}
+	}
+
+	if pair.CommonItems == 0 {
+		pair.SharedWrongPValue = 1
+		return pair
+	}
+
+	pair.HarppHoganRatio = float64(pair.SharedWrong) / math.Max(float64(pair.Differences), 1)
+	pair.SharedWrongPValue = poissonUpperTail(pair.SharedWrong, pair.ExpectedSharedWrong)
+	pair.SynchronizedRatio = float64(pair.SynchronizedAnswers) / float64(pair.CommonItems)
+	pair.MedianTimeGapSeconds = median(gaps)
+
+	// Ranking score: surprise of the shared wrong answers (-log10 p, capped) plus the
+	// share of questions answered in sync, weighted so both signals are on a similar scale.
+	pair.Score = math.Min(-math.Log10(math.Max(pair.SharedWrongPValue, 1e-12)), 12) + 6*pair.SynchronizedRatio
+
+	sort.Slice(pair.Evidence, func(x, y int) bool {
+		return pair.Evidence[x].AnsweredAtA.Before(pair.Evidence[y].AnsweredAtA)
+	})
+	return pair
+}
+
+// distractorCount is the number of wrong options of a choice question, or minDistractors
+// when the question has no fixed options
+func distractorCount(answer entity.RecordedAnswer) int {
+	if answer.OptionCount > 1 {
+		return answer.OptionCount - 1
+	}
+	return minDistractors
+}
+
+// chanceWrongMatch estimates the probability that two students who both got a question
+// wrong picked the same wrong answer independently. The pair's own answers are left out of
+// the class distribution and it is Laplace-smoothed over at least the question's distractors.
+func chanceWrongMatch(counts map[string]int, distractors int, responseA, responseB string) float64 {
+	others := make(map[string]int, len(counts))
+	total := 0
+	for response, count := range counts {
+		if response == responseA {
+			count--
+		}
+		if response == responseB {
+			count--
+		}
+		if count > 0 {
+			others[response] = count
+			total += count
+		}
+	}
+
+	options := len(counts)
+	if options < distractors {
+		options = distractors
+	}
+
+	denominator := float64(total + options)
+	probability := float64(options-len(others)) / (denominator * denominator)
+	for _, count := range others {
+		share := float64(count+1) / denominator
+		probability += share * share
+	}
+	return probability
+}
+
+// poissonUpperTail returns P(X >= observed) for X ~ Poisson(mean).
+func poissonUpperTail(observed int, mean float64) float64 {
+	if observed <= 0 {
+		return 1
+	}
+	if mean <= 0 {
+		return 0
+	}
+
+	// Sum the tail directly so very small probabilities keep their precision.
+	logFactorial, _ := math.Lgamma(float64(observed) + 1)
+	term := math.Exp(-mean + float64(observed)*math.Log(mean) - logFactorial)
+	tail := 0.0
+	for k := observed; term > 0; k++ {
+		tail += term
+		if term < tail*1e-15 {
+			break
+		}
+		term *= mean / float64(k+1)
+	}
+	return math.Min(tail, 1)
+}
+
+// median returns the middle value, or the mean of the two middle values of an even count
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
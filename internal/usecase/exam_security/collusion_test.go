@@ -0,0 +1,150 @@
+package exam_security_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	examsecurityrepo "cbt-test-mini-project/internal/repository/exam_security"
+	"cbt-test-mini-project/internal/usecase/exam_security"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChanceWrongMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		counts      map[string]int
+		distractors int
+		responseA   string
+		responseB   string
+		want        float64
+	}{
+		{
+			// Only the pair chose a wrong answer: uniform over the distractors
+			name: "no other wrong answers", counts: map[string]int{"B": 2}, distractors: 3,
+			responseA: "B", responseB: "B", want: 1.0 / 3,
+		},
+		{
+			// Others: B=6, C=1 and an unused option, smoothed over 10: (1/10)^2 + (7/10)^2 + (2/10)^2
+			name: "popular distractor", counts: map[string]int{"B": 6, "C": 2, "D": 1}, distractors: 3,
+			responseA: "C", responseB: "D", want: 0.54,
+		},
+		{
+			// Five distinct wrong answers outnumber the distractors: 1/81 + 4*(2/9)^2
+			name: "more wrong answers than distractors", counts: map[string]int{"a": 2, "b": 1, "c": 1, "d": 1, "e": 1}, distractors: 3,
+			responseA: "a", responseB: "a", want: 17.0 / 81,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, exam_security.ChanceWrongMatch(tt.counts, tt.distractors, tt.responseA, tt.responseB), 1e-12)
+		})
+	}
+}
+
+func TestPoissonUpperTail(t *testing.T) {
+	tests := []struct {
+		name     string
+		observed int
+		mean     float64
+		want     float64
+	}{
+		{name: "nothing observed", observed: 0, mean: 2, want: 1},
+		{name: "zero mean", observed: 1, mean: 0, want: 0},
+		{name: "at least one", observed: 1, mean: 1, want: 1 - math.Exp(-1)},
+		{name: "at least two", observed: 2, mean: 0.5, want: 1 - math.Exp(-0.5)*1.5},
+		{name: "far tail keeps precision", observed: 10, mean: 0.01, want: math.Exp(-0.01) * math.Pow(0.01, 10) / 3628800 * (1 + 0.01/11 + 0.01*0.01/(11*12))},
+		{name: "mean far above observed", observed: 3, mean: 50, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := exam_security.PoissonUpperTail(tt.observed, tt.mean)
+			if tt.want == 0 {
+				assert.Zero(t, got)
+				return
+			}
+			assert.InEpsilon(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{name: "empty", values: nil, want: 0},
+		{name: "single", values: []float64{3}, want: 3},
+		{name: "odd count", values: []float64{5, 1, 3}, want: 3},
+		{name: "even count", values: []float64{4, 1, 3, 2}, want: 2.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := append([]float64(nil), tt.values...)
+			assert.Equal(t, tt.want, exam_security.Median(values))
+			assert.Equal(t, tt.values, values, "input must not be reordered")
+		})
+	}
+}
+
+// fakePatternRepo returns fixed answer patterns for an assignment
+type fakePatternRepo struct {
+	examsecurityrepo.ExamSecurityRepository
+	patterns []entity.SessionAnswerPattern
+}
+
+func (r *fakePatternRepo) ListAssignmentAnswerPatterns(ctx context.Context, lmsAssignmentID int64, scope *entity.TeacherScope) ([]entity.SessionAnswerPattern, error) {
+	return r.patterns, nil
+}
+
+// answeredTogether builds two sessions that both answered the first n questions correctly
+// within a second of each other
+func answeredTogether(n int) []entity.SessionAnswerPattern {
+	start := time.Date(2026, 5, 4, 8, 0, 0, 0, time.UTC)
+	a := entity.SessionAnswerPattern{SessionID: 1, SessionToken: "a"}
+	b := entity.SessionAnswerPattern{SessionID: 2, SessionToken: "b"}
+	for i := 0; i < 5; i++ {
+		item := entity.AnswerItemKey{QuestionType: entity.QuestionTypeMultipleChoice, QuestionID: i + 1}
+		at := start.Add(time.Duration(i) * time.Minute)
+		a.Answers = append(a.Answers, entity.RecordedAnswer{Item: item, Response: "A", IsCorrect: true, AnsweredAt: at, OptionCount: 4})
+		gap := time.Second
+		if i >= n {
+			gap = 30 * time.Second
+		}
+		b.Answers = append(b.Answers, entity.RecordedAnswer{Item: item, Response: "A", IsCorrect: true, AnsweredAt: at.Add(gap), OptionCount: 4})
+	}
+	return []entity.SessionAnswerPattern{a, b}
+}
+
+func TestAnalyzeCollusion_SynchronizedThreshold(t *testing.T) {
+	tests := []struct {
+		name           string
+		synchronized   int
+		minSharedWrong int
+		wantReported   bool
+	}{
+		{name: "timing flags regardless of the shared wrong minimum", synchronized: 3, minSharedWrong: 10, wantReported: true},
+		{name: "too few synchronized answers", synchronized: 2, minSharedWrong: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := exam_security.NewExamSecurityUsecase(&fakePatternRepo{patterns: answeredTogether(tt.synchronized)})
+
+			report, err := uc.AnalyzeCollusion(context.Background(), 7, 5, tt.minSharedWrong, 0, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.PairsAnalyzed)
+			if !tt.wantReported {
+				assert.Empty(t, report.Pairs)
+				return
+			}
+			require.Len(t, report.Pairs, 1)
+			assert.True(t, report.Pairs[0].Flagged)
+			assert.Equal(t, tt.synchronized, report.Pairs[0].SynchronizedAnswers)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package exam_security_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+	examsecurityrepo "cbt-test-mini-project/internal/repository/exam_security"
+	"cbt-test-mini-project/internal/usecase/exam_security"
+	"cbt-test-mini-project/util/nonce"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLeaseRepo keeps the device leases of one session in memory
+type fakeLeaseRepo struct {
+	examsecurityrepo.ExamSecurityRepository
+
+	sessionID int
+	leases    []entity.DeviceLease
+	// teacherID is the only teacher of the session's class
+	teacherID int
+}
+
+func (r *fakeLeaseRepo) GetSessionIDByToken(ctx context.Context, token string) (int, error) {
+	if token != "token-1" {
+		return 0, nil
+	}
+	return r.sessionID, nil
+}
+
+func (r *fakeLeaseRepo) TeachesSession(ctx context.Context, token string, userID int) (bool, error) {
+	return token == "token-1" && userID == r.teacherID, nil
+}
+
+func (r *fakeLeaseRepo) GetActiveDeviceLease(ctx context.Context, sessionID int) (*entity.DeviceLease, error) {
+	for i := range r.leases {
+		if r.leases[i].Status == entity.DeviceLeaseActive {
+			lease := r.leases[i]
+			return &lease, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeLeaseRepo) CreateDeviceLease(ctx context.Context, lease *entity.DeviceLease) (bool, error) {
+	if active, _ := r.GetActiveDeviceLease(ctx, lease.IDTestSession); active != nil {
+		return false, nil
+	}
+	lease.ID = int64(len(r.leases) + 1)
+	lease.Status = entity.DeviceLeaseActive
+	r.leases = append(r.leases, *lease)
+	return true, nil
+}
+
+func (r *fakeLeaseRepo) TouchDeviceLease(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (r *fakeLeaseRepo) SetDeviceLeaseSecret(ctx context.Context, id int64, secretHash string) (bool, error) {
+	for i := range r.leases {
+		if r.leases[i].ID == id && r.leases[i].Status == entity.DeviceLeaseActive && r.leases[i].SecretHash == "" {
+			r.leases[i].SecretHash = secretHash
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeLeaseRepo) RecordRejectedDevice(ctx context.Context, sessionID int, device entity.DeviceInfo, presentedHash, newHash string) (string, error) {
+	for i := range r.leases {
+		lease := &r.leases[i]
+		if lease.Status != entity.DeviceLeaseRejected || lease.DeviceID != device.DeviceID {
+			continue
+		}
+		lease.AttemptCount++
+		if presentedHash == "" || presentedHash != lease.SecretHash {
+			lease.SecretHash = newHash
+		}
+		return lease.SecretHash, nil
+	}
+	r.leases = append(r.leases, entity.DeviceLease{
+		ID:            int64(len(r.leases) + 1),
+		IDTestSession: sessionID,
+		DeviceID:      device.DeviceID,
+		Status:        entity.DeviceLeaseRejected,
+		AttemptCount:  1,
+		SecretHash:    newHash,
+	})
+	return newHash, nil
+}
+
+func (r *fakeLeaseRepo) TransferDeviceLease(ctx context.Context, sessionID int, rejectedID int64, approvedBy int, note string) (*entity.DeviceLease, error) {
+	var rejected *entity.DeviceLease
+	for i := range r.leases {
+		if r.leases[i].ID == rejectedID && r.leases[i].Status == entity.DeviceLeaseRejected {
+			r.leases[i].Status = entity.DeviceLeaseApproved
+			rejected = &r.leases[i]
+		}
+	}
+	if rejected == nil {
+		return nil, errors.New("no pending device transfer for this session")
+	}
+	issued := entity.DeviceLease{
+		IDTestSession: sessionID,
+		DeviceID:      rejected.DeviceID,
+		SecretHash:    rejected.SecretHash,
+		ApprovedBy:    &approvedBy,
+		Note:          note,
+	}
+	for i := range r.leases {
+		if r.leases[i].Status == entity.DeviceLeaseActive {
+			r.leases[i].Status = entity.DeviceLeaseTransferred
+		}
+	}
+	if _, err := r.CreateDeviceLease(ctx, &issued); err != nil {
+		return nil, err
+	}
+	return &issued, nil
+}
+
+func (r *fakeLeaseRepo) GetLatestRejectedDevice(ctx context.Context, sessionID int) (*entity.DeviceLease, error) {
+	for i := len(r.leases) - 1; i >= 0; i-- {
+		if r.leases[i].Status == entity.DeviceLeaseRejected {
+			lease := r.leases[i]
+			return &lease, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeLeaseRepo) ListDeviceLeases(ctx context.Context, sessionID int) ([]entity.DeviceLease, error) {
+	return append([]entity.DeviceLease(nil), r.leases...), nil
+}
+
+func device(id, secret string) entity.DeviceInfo {
+	return entity.DeviceInfo{DeviceID: id, UserAgent: "test", IPAddress: "10.0.0.1", LeaseSecret: secret}
+}
+
+func TestEnsureDeviceLease_FirstDeviceGetsSecret(t *testing.T) {
+	repo := &fakeLeaseRepo{sessionID: 7}
+	uc := exam_security.NewExamSecurityUsecase(repo)
+
+	secret, err := uc.EnsureDeviceLease(context.Background(), 7, device("laptop", ""))
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	require.Len(t, repo.leases, 1)
+	assert.Equal(t, nonce.Hash(secret), repo.leases[0].SecretHash)
+
+	again, err := uc.EnsureDeviceLease(context.Background(), 7, device("laptop", secret))
+	require.NoError(t, err)
+	assert.Empty(t, again, "a device holding the lease is not issued a new secret")
+}
+
+func TestEnsureDeviceLease_DeviceIDAloneDoesNotMatch(t *testing.T) {
+	repo := &fakeLeaseRepo{sessionID: 7}
+	uc := exam_security.NewExamSecurityUsecase(repo)
+
+	_, err := uc.EnsureDeviceLease(context.Background(), 7, device("laptop", ""))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		secret string
+	}{
+		{name: "no secret", secret: ""},
+		{name: "wrong secret", secret: "guessed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.EnsureDeviceLease(context.Background(), 7, device("laptop", tt.secret))
+			assert.ErrorIs(t, err, exam_security.ErrDeviceMismatch)
+		})
+	}
+}
+
+func TestEnsureDeviceLease_LegacyLeaseClaimedByItsDevice(t *testing.T) {
+	repo := &fakeLeaseRepo{sessionID: 7, leases: []entity.DeviceLease{
+		{ID: 1, IDTestSession: 7, DeviceID: "laptop", Status: entity.DeviceLeaseActive},
+	}}
+	uc := exam_security.NewExamSecurityUsecase(repo)
+
+	secret, err := uc.EnsureDeviceLease(context.Background(), 7, device("laptop", ""))
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	assert.Equal(t, nonce.Hash(secret), repo.leases[0].SecretHash)
+
+	_, err = uc.EnsureDeviceLease(context.Background(), 7, device("laptop", ""))
+	assert.ErrorIs(t, err, exam_security.ErrDeviceMismatch, "a claimed lease needs its secret")
+}
+
+func TestEnsureDeviceLease_RejectedDeviceKeepsItsSecret(t *testing.T) {
+	repo := &fakeLeaseRepo{sessionID: 7}
+	uc := exam_security.NewExamSecurityUsecase(repo)
+
+	_, err := uc.EnsureDeviceLease(context.Background(), 7, device("laptop", ""))
+	require.NoError(t, err)
+
+	waiting, err := uc.EnsureDeviceLease(context.Background(), 7, device("tablet", ""))
+	assert.ErrorIs(t, err, exam_security.ErrDeviceMismatch)
+	require.NotEmpty(t, waiting)
+
+	again, err := uc.EnsureDeviceLease(context.Background(), 7, device("tablet", waiting))
+	assert.ErrorIs(t, err, exam_security.ErrDeviceMismatch)
+	assert.Empty(t, again, "the waiting device keeps the secret it was issued")
+	assert.Equal(t, 2, repo.leases[1].AttemptCount)
+}
+
+func TestApproveDeviceTransfer(t *testing.T) {
+	repo := &fakeLeaseRepo{sessionID: 7}
+	uc := exam_security.NewExamSecurityUsecase(repo)
+	ctx := context.Background()
+
+	original, err := uc.EnsureDeviceLease(ctx, 7, device("laptop", ""))
+	require.NoError(t, err)
+	waiting, err := uc.EnsureDeviceLease(ctx, 7, device("tablet", ""))
+	require.ErrorIs(t, err, exam_security.ErrDeviceMismatch)
+
+	lease, err := uc.ApproveDeviceTransfer(ctx, "token-1", "", "battery died", nil, 99)
+	require.NoError(t, err)
+	assert.Equal(t, "tablet", lease.DeviceID)
+
+	statuses := map[string]entity.DeviceLeaseStatus{}
+	for _, l := range repo.leases[:2] {
+		statuses[l.DeviceID] = l.Status
+	}
+	assert.Equal(t, entity.DeviceLeaseTransferred, statuses["laptop"])
+	assert.Equal(t, entity.DeviceLeaseApproved, statuses["tablet"], "the approved request is closed")
+
+	_, err = uc.ApproveDeviceTransfer(ctx, "token-1", "", "", nil, 99)
+	assert.EqualError(t, err, "no pending device transfer for this session", "an approved request cannot be approved twice")
+
+	_, err = uc.EnsureDeviceLease(ctx, 7, device("tablet", waiting))
+	assert.NoError(t, err, "the approved device continues with the secret it waited with")
+	_, err = uc.EnsureDeviceLease(ctx, 7, device("laptop", original))
+	assert.ErrorIs(t, err, exam_security.ErrDeviceMismatch)
+}
+
+func TestApproveDeviceTransfer_ByDeviceID(t *testing.T) {
+	tests := []struct {
+		name     string
+		deviceID string
+		wantErr  string
+	}{
+		{name: "lease holder", deviceID: "laptop", wantErr: "device already holds the session lease"},
+		{name: "unknown device", deviceID: "phone", wantErr: "device has not tried to join this session"},
+		{name: "rejected device", deviceID: "tablet"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeLeaseRepo{sessionID: 7}
+			uc := exam_security.NewExamSecurityUsecase(repo)
+			ctx := context.Background()
+
+			_, err := uc.EnsureDeviceLease(ctx, 7, device("laptop", ""))
+			require.NoError(t, err)
+			_, _ = uc.EnsureDeviceLease(ctx, 7, device("tablet", ""))
+
+			lease, err := uc.ApproveDeviceTransfer(ctx, "token-1", tt.deviceID, "", nil, 99)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.deviceID, lease.DeviceID)
+		})
+	}
+}
+
+func TestDeviceLeases_TeacherScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   *entity.TeacherScope
+		wantErr string
+	}{
+		{name: "superadmin", scope: nil},
+		{name: "teacher of the class", scope: &entity.TeacherScope{UserID: 12}},
+		{name: "other teacher", scope: &entity.TeacherScope{UserID: 13}, wantErr: "session not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeLeaseRepo{sessionID: 7, teacherID: 12}
+			uc := exam_security.NewExamSecurityUsecase(repo)
+			ctx := context.Background()
+
+			_, err := uc.EnsureDeviceLease(ctx, 7, device("laptop", ""))
+			require.NoError(t, err)
+			_, _ = uc.EnsureDeviceLease(ctx, 7, device("tablet", ""))
+
+			leases, listErr := uc.ListDeviceLeases(ctx, "token-1", tt.scope)
+			_, approveErr := uc.ApproveDeviceTransfer(ctx, "token-1", "", "", tt.scope, 12)
+			if tt.wantErr != "" {
+				assert.EqualError(t, listErr, tt.wantErr)
+				assert.EqualError(t, approveErr, tt.wantErr)
+				assert.Equal(t, entity.DeviceLeaseRejected, repo.leases[1].Status)
+				return
+			}
+			require.NoError(t, listErr)
+			assert.Len(t, leases, 2)
+			assert.NoError(t, approveErr)
+		})
+	}
+}
@@ -0,0 +1,458 @@
+package exam_security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/exam_security"
+	"cbt-test-mini-project/util/nonce"
+	"cbt-test-mini-project/util/seb"
+	"cbt-test-mini-project/util/tenant"
+)
+
+// maxSebFileSize is the upload limit for .seb files
+const maxSebFileSize = 1 << 20
+
+// ErrDeviceMismatch is returned when a session is used from a device other than the leased one
+var ErrDeviceMismatch = errors.New("this test session is active on another device, ask your proctor to approve a device transfer")
+
+// ErrOtherSchool is returned when a request names a school other than the caller's
+var ErrOtherSchool = errors.New("lms_school_id is not your school")
+
+// examSecurityUsecaseImpl implements ExamSecurityUsecase
+type examSecurityUsecaseImpl struct {
+	repo exam_security.ExamSecurityRepository
+}
+
+// NewExamSecurityUsecase creates a new ExamSecurityUsecase instance
+func NewExamSecurityUsecase(repo exam_security.ExamSecurityRepository) ExamSecurityUsecase {
+	return &examSecurityUsecaseImpl{repo: repo}
+}
+
+// UploadSebConfig parses the .seb file, computes its Config Key and stores it for the assignment.
+// Browser Exam Keys depend on the SEB build and cannot be derived from the file, so they are
+// optional and copied from the SEB Config Tool by the uploader.
+func (u *examSecurityUsecaseImpl) UploadSebConfig(ctx context.Context, lmsAssignmentID int64, fileName string, raw []byte, browserExamKeys []string, uploadedBy int) (*entity.SebConfig, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("seb_file is required")
+	}
+	if len(raw) > maxSebFileSize {
+		return nil, errors.New("seb_file exceeds 1 MB")
+	}
+
+	configKey, err := seb.ConfigKey(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(browserExamKeys))
+	seen := map[string]struct{}{}
+	for _, key := range browserExamKeys {
+		if strings.TrimSpace(key) == "" {
+			continue
+		}
+		normalized, err := seb.NormalizeKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		keys = append(keys, normalized)
+	}
+
+	cfg := &entity.SebConfig{
+		LMSAssignmentID: lmsAssignmentID,
+		FileName:        strings.TrimSpace(fileName),
+		ConfigRaw:       raw,
+		ConfigKey:       configKey,
+		BrowserExamKeys: keys,
+	}
+	if uploadedBy > 0 {
+		cfg.CreatedBy = &uploadedBy
+	}
+
+	if err := u.repo.UpsertSebConfig(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// GetSebConfig returns the SEB config of an assignment
+func (u *examSecurityUsecaseImpl) GetSebConfig(ctx context.Context, lmsAssignmentID int64) (*entity.SebConfig, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+
+	cfg, err := u.repo.GetSebConfigByAssignment(ctx, lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, errors.New("seb config not found")
+	}
+	return cfg, nil
+}
+
+// DeleteSebConfig removes the SEB requirement from an assignment
+func (u *examSecurityUsecaseImpl) DeleteSebConfig(ctx context.Context, lmsAssignmentID int64) error {
+	if lmsAssignmentID <= 0 {
+		return errors.New("lms_assignment_id is required")
+	}
+
+	deleted, err := u.repo.DeleteSebConfig(ctx, lmsAssignmentID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.New("seb config not found")
+	}
+	return nil
+}
+
+// EnsureDeviceLease binds the session to the calling device on first use and rejects
+// every other device until a proctor approves a transfer. Devices are told apart by a lease
+// secret the server issues: a non-empty result is a new secret for the device, which sends
+// it back on every later call.
+func (u *examSecurityUsecaseImpl) EnsureDeviceLease(ctx context.Context, sessionID int, device entity.DeviceInfo) (string, error) {
+	if sessionID <= 0 {
+		return "", errors.New("invalid session")
+	}
+	if strings.TrimSpace(device.DeviceID) == "" {
+		return "", errors.New("device id is required")
+	}
+
+	lease, err := u.repo.GetActiveDeviceLease(ctx, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	if lease == nil {
+		secret, secretHash, err := nonce.New()
+		if err != nil {
+			return "", err
+		}
+		issued := &entity.DeviceLease{
+			IDTestSession: sessionID,
+			DeviceID:      device.DeviceID,
+			UserAgent:     device.UserAgent,
+			IPAddress:     device.IPAddress,
+			SecretHash:    secretHash,
+		}
+		created, err := u.repo.CreateDeviceLease(ctx, issued)
+		if err != nil {
+			return "", err
+		}
+		if created {
+			return secret, nil
+		}
+
+		// Another device won the race for the first lease
+		lease, err = u.repo.GetActiveDeviceLease(ctx, sessionID)
+		if err != nil {
+			return "", err
+		}
+		if lease == nil {
+			return "", errors.New("failed to issue device lease")
+		}
+	}
+
+	presentedHash := nonce.Hash(device.LeaseSecret)
+	if lease.SecretHash != "" && presentedHash == lease.SecretHash {
+		return "", u.repo.TouchDeviceLease(ctx, lease.ID)
+	}
+
+	// Leases issued before lease secrets existed go to the next call of their device
+	if lease.SecretHash == "" && lease.DeviceID == device.DeviceID {
+		secret, secretHash, err := nonce.New()
+		if err != nil {
+			return "", err
+		}
+		claimed, err := u.repo.SetDeviceLeaseSecret(ctx, lease.ID, secretHash)
+		if err != nil {
+			return "", err
+		}
+		if claimed {
+			return secret, nil
+		}
+	}
+
+	// The device keeps one secret while it waits, so an approved transfer goes to it
+	secret, secretHash, err := nonce.New()
+	if err != nil {
+		return "", err
+	}
+	stored, err := u.repo.RecordRejectedDevice(ctx, sessionID, device, presentedHash, secretHash)
+	if err != nil {
+		return "", err
+	}
+	if stored != secretHash {
+		secret = ""
+	}
+	return secret, ErrDeviceMismatch
+}
+
+// ApproveDeviceTransfer moves the session lease to a device that tried to join it. When
+// deviceID is empty the most recent rejected device is approved. A non-nil scope limits
+// a teacher to sessions of classes they teach.
+func (u *examSecurityUsecaseImpl) ApproveDeviceTransfer(ctx context.Context, sessionToken, deviceID, note string, scope *entity.TeacherScope, approvedBy int) (*entity.DeviceLease, error) {
+	sessionID, err := u.resolveScopedSessionID(ctx, sessionToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var rejected *entity.DeviceLease
+	deviceID = strings.TrimSpace(deviceID)
+	if deviceID == "" {
+		if rejected, err = u.repo.GetLatestRejectedDevice(ctx, sessionID); err != nil {
+			return nil, err
+		}
+		if rejected == nil {
+			return nil, errors.New("no pending device transfer for this session")
+		}
+	} else {
+		leases, err := u.repo.ListDeviceLeases(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range leases {
+			if leases[i].DeviceID != deviceID {
+				continue
+			}
+			switch leases[i].Status {
+			case entity.DeviceLeaseActive:
+				return nil, errors.New("device already holds the session lease")
+			case entity.DeviceLeaseRejected:
+				rejected = &leases[i]
+			}
+		}
+		if rejected == nil {
+			return nil, errors.New("device has not tried to join this session")
+		}
+	}
+
+	return u.repo.TransferDeviceLease(ctx, sessionID, rejected.ID, approvedBy, strings.TrimSpace(note))
+}
+
+// ListDeviceLeases returns the lease history of a session; a non-nil scope limits a teacher
+// to sessions of classes they teach
+func (u *examSecurityUsecaseImpl) ListDeviceLeases(ctx context.Context, sessionToken string, scope *entity.TeacherScope) ([]entity.DeviceLease, error) {
+	sessionID, err := u.resolveScopedSessionID(ctx, sessionToken, scope)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.ListDeviceLeases(ctx, sessionID)
+}
+
+// SetNetworkAllowlist replaces the CIDR allow-list of a school or an assignment.
+// An empty list removes the restriction.
+func (u *examSecurityUsecaseImpl) SetNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID int64, cidrs []string, label string, updatedBy int) ([]entity.NetworkAllowlistEntry, error) {
+	schoolID, assignmentID, err := allowlistScope(lmsSchoolID, lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchool(ctx, lmsSchoolID); err != nil {
+		return nil, err
+	}
+
+	entries := make([]entity.NetworkAllowlistEntry, 0, len(cidrs))
+	seen := map[string]struct{}{}
+	for _, raw := range cidrs {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		cidr, err := NormalizeCIDR(raw)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[cidr]; ok {
+			continue
+		}
+		seen[cidr] = struct{}{}
+
+		entry := entity.NetworkAllowlistEntry{
+			LMSSchoolID:     schoolID,
+			LMSAssignmentID: assignmentID,
+			CIDR:            cidr,
+			Label:           strings.TrimSpace(label),
+		}
+		if updatedBy > 0 {
+			entry.CreatedBy = &updatedBy
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := u.repo.ReplaceNetworkAllowlist(ctx, schoolID, assignmentID, entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// GetNetworkAllowlist returns the CIDR allow-list of a school or an assignment
+func (u *examSecurityUsecaseImpl) GetNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID int64) ([]entity.NetworkAllowlistEntry, error) {
+	schoolID, assignmentID, err := allowlistScope(lmsSchoolID, lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchool(ctx, lmsSchoolID); err != nil {
+		return nil, err
+	}
+	return u.repo.ListNetworkAllowlist(ctx, schoolID, assignmentID)
+}
+
+// GrantNetworkOverride lets a session be used from any network, e.g. for a student
+// taking the exam from home with permission. expiresInMinutes <= 0 never expires.
+func (u *examSecurityUsecaseImpl) GrantNetworkOverride(ctx context.Context, sessionToken, reason string, expiresInMinutes int, grantedBy int) (*entity.NetworkOverride, error) {
+	sessionID, err := u.resolveSessionID(ctx, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("reason is required")
+	}
+
+	override := &entity.NetworkOverride{
+		IDTestSession: sessionID,
+		Reason:        reason,
+		GrantedBy:     grantedBy,
+	}
+	if expiresInMinutes > 0 {
+		expiresAt := time.Now().Add(time.Duration(expiresInMinutes) * time.Minute)
+		override.ExpiresAt = &expiresAt
+	}
+
+	if err := u.repo.CreateNetworkOverride(ctx, override); err != nil {
+		return nil, err
+	}
+	return override, nil
+}
+
+// ListNetworkDenials returns the denied-access audit log of the caller's school; a non-nil
+// scope limits a teacher to sessions of classes they teach
+func (u *examSecurityUsecaseImpl) ListNetworkDenials(ctx context.Context, lmsSchoolID, lmsAssignmentID int64, scope *entity.TeacherScope, page, pageSize int) ([]entity.NetworkAccessDenial, *entity.PaginationResponse, error) {
+	if err := checkSchool(ctx, lmsSchoolID); err != nil {
+		return nil, nil, err
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	var schoolID, assignmentID *int64
+	if lmsSchoolID > 0 {
+		schoolID = &lmsSchoolID
+	}
+	if lmsAssignmentID > 0 {
+		assignmentID = &lmsAssignmentID
+	}
+
+	offset := (page - 1) * pageSize
+	denials, total, err := u.repo.ListNetworkDenials(ctx, schoolID, assignmentID, scope, pageSize, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	pagination := &entity.PaginationResponse{
+		TotalCount:  total,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+	}
+
+	return denials, pagination, nil
+}
+
+// NormalizeCIDR validates a CIDR (or a bare IP, treated as a single host) and
+// returns it in canonical network form.
+func NormalizeCIDR(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "/") {
+		ip := net.ParseIP(raw)
+		if ip == nil {
+			return "", fmt.Errorf("invalid IP or CIDR %q", raw)
+		}
+		if ip.To4() != nil {
+			return ip.To4().String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, network, err := net.ParseCIDR(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid IP or CIDR %q", raw)
+	}
+	return network.String(), nil
+}
+
+func allowlistScope(lmsSchoolID, lmsAssignmentID int64) (*int64, *int64, error) {
+	switch {
+	case lmsSchoolID > 0 && lmsAssignmentID > 0:
+		return nil, nil, errors.New("set either lms_school_id or lms_assignment_id, not both")
+	case lmsAssignmentID > 0:
+		return nil, &lmsAssignmentID, nil
+	case lmsSchoolID > 0:
+		return &lmsSchoolID, nil, nil
+	default:
+		return nil, nil, errors.New("lms_school_id or lms_assignment_id is required")
+	}
+}
+
+// checkSchool rejects a school other than the caller's; superadmins and internal jobs may
+// name any school
+func checkSchool(ctx context.Context, lmsSchoolID int64) error {
+	if lmsSchoolID <= 0 {
+		return nil
+	}
+	t, _ := tenant.FromContext(ctx)
+	if t.CrossTenant || t.SchoolID == lmsSchoolID {
+		return nil
+	}
+	return ErrOtherSchool
+}
+
+func (u *examSecurityUsecaseImpl) resolveSessionID(ctx context.Context, sessionToken string) (int, error) {
+	sessionToken = strings.TrimSpace(sessionToken)
+	if sessionToken == "" {
+		return 0, errors.New("session_token is required")
+	}
+
+	sessionID, err := u.repo.GetSessionIDByToken(ctx, sessionToken)
+	if err != nil {
+		return 0, err
+	}
+	if sessionID == 0 {
+		return 0, errors.New("session not found")
+	}
+	return sessionID, nil
+}
+
+// resolveScopedSessionID resolves a session token like resolveSessionID; with a scope,
+// sessions of classes the teacher does not teach are not found
+func (u *examSecurityUsecaseImpl) resolveScopedSessionID(ctx context.Context, sessionToken string, scope *entity.TeacherScope) (int, error) {
+	sessionID, err := u.resolveSessionID(ctx, sessionToken)
+	if err != nil || scope == nil {
+		return sessionID, err
+	}
+	teaches, err := u.repo.TeachesSession(ctx, strings.TrimSpace(sessionToken), scope.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if !teaches {
+		return 0, errors.New("session not found")
+	}
+	return sessionID, nil
+}
@@ -0,0 +1,8 @@
+package exam_security
+
+// Exported for the tests of the collusion statistics
+var (
+	ChanceWrongMatch = chanceWrongMatch
+	PoissonUpperTail = poissonUpperTail
+	Median           = median
+)
@@ -0,0 +1,22 @@
+package exam_security
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// ExamSecurityUsecase defines the interface for exam lockdown operations
+type ExamSecurityUsecase interface {
+	UploadSebConfig(ctx context.Context, lmsAssignmentID int64, fileName string, raw []byte, browserExamKeys []string, uploadedBy int) (*entity.SebConfig, error)
+	GetSebConfig(ctx context.Context, lmsAssignmentID int64) (*entity.SebConfig, error)
+	DeleteSebConfig(ctx context.Context, lmsAssignmentID int64) error
+	EnsureDeviceLease(ctx context.Context, sessionID int, device entity.DeviceInfo) (string, error)
+	ApproveDeviceTransfer(ctx context.Context, sessionToken, deviceID, note string, scope *entity.TeacherScope, approvedBy int) (*entity.DeviceLease, error)
+	ListDeviceLeases(ctx context.Context, sessionToken string, scope *entity.TeacherScope) ([]entity.DeviceLease, error)
+	SetNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID int64, cidrs []string, label string, updatedBy int) ([]entity.NetworkAllowlistEntry, error)
+	GetNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID int64) ([]entity.NetworkAllowlistEntry, error)
+	GrantNetworkOverride(ctx context.Context, sessionToken, reason string, expiresInMinutes int, grantedBy int) (*entity.NetworkOverride, error)
+	ListNetworkDenials(ctx context.Context, lmsSchoolID, lmsAssignmentID int64, scope *entity.TeacherScope, page, pageSize int) ([]entity.NetworkAccessDenial, *entity.PaginationResponse, error)
+	AnalyzeCollusion(ctx context.Context, lmsAssignmentID int64, syncWindowSeconds, minSharedWrong, limit int, scope *entity.TeacherScope) (*entity.CollusionReport, error)
+}
@@ -0,0 +1,86 @@
+package exam_security_test
+
+import (
+	"context"
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+	examsecurityrepo "cbt-test-mini-project/internal/repository/exam_security"
+	"cbt-test-mini-project/internal/usecase/exam_security"
+	"cbt-test-mini-project/util/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNetworkRepo records the network allow-list and denial reads
+type fakeNetworkRepo struct {
+	examsecurityrepo.ExamSecurityRepository
+	reads int
+	scope *entity.TeacherScope
+}
+
+func (r *fakeNetworkRepo) ListNetworkAllowlist(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64) ([]entity.NetworkAllowlistEntry, error) {
+	r.reads++
+	return nil, nil
+}
+
+func (r *fakeNetworkRepo) ListNetworkDenials(ctx context.Context, lmsSchoolID, lmsAssignmentID *int64, scope *entity.TeacherScope, limit, offset int) ([]entity.NetworkAccessDenial, int, error) {
+	r.reads++
+	r.scope = scope
+	return nil, 0, nil
+}
+
+func TestNetworkReads_StayInTheCallersSchool(t *testing.T) {
+	reads := map[string]func(uc exam_security.ExamSecurityUsecase, ctx context.Context, schoolID, assignmentID int64) error{
+		"allowlist": func(uc exam_security.ExamSecurityUsecase, ctx context.Context, schoolID, assignmentID int64) error {
+			_, err := uc.GetNetworkAllowlist(ctx, schoolID, assignmentID)
+			return err
+		},
+		"denials": func(uc exam_security.ExamSecurityUsecase, ctx context.Context, schoolID, assignmentID int64) error {
+			_, _, err := uc.ListNetworkDenials(ctx, schoolID, assignmentID, nil, 1, 20)
+			return err
+		},
+	}
+	callers := []struct {
+		name         string
+		ctx          context.Context
+		schoolID     int64
+		assignmentID int64
+		allowed      bool
+	}{
+		{name: "own school", ctx: tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 5}), schoolID: 5, allowed: true},
+		{name: "other school", ctx: tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 5}), schoolID: 6},
+		{name: "superadmin", ctx: tenant.WithTenant(context.Background(), tenant.Tenant{CrossTenant: true}), schoolID: 6, allowed: true},
+		{name: "assignment only", ctx: tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 5}), assignmentID: 42, allowed: true},
+	}
+
+	for name, read := range reads {
+		for _, caller := range callers {
+			t.Run(name+"/"+caller.name, func(t *testing.T) {
+				repo := &fakeNetworkRepo{}
+				uc := exam_security.NewExamSecurityUsecase(repo)
+
+				err := read(uc, caller.ctx, caller.schoolID, caller.assignmentID)
+				if caller.allowed {
+					assert.NoError(t, err)
+					assert.Equal(t, 1, repo.reads)
+					return
+				}
+				assert.ErrorIs(t, err, exam_security.ErrOtherSchool)
+				assert.Zero(t, repo.reads)
+			})
+		}
+	}
+}
+
+func TestListNetworkDenials_TeacherScope(t *testing.T) {
+	repo := &fakeNetworkRepo{}
+	uc := exam_security.NewExamSecurityUsecase(repo)
+	ctx := tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 5})
+
+	_, _, err := uc.ListNetworkDenials(ctx, 0, 0, &entity.TeacherScope{UserID: 9}, 1, 20)
+	require.NoError(t, err)
+	require.NotNil(t, repo.scope)
+	assert.Equal(t, 9, repo.scope.UserID)
+}
@@ -0,0 +1,490 @@
+package grading
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strings"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/grading"
+)
+
+// SetGradingConfig stores the blind-mode and double-marking settings of an assignment
+func (u *gradingUsecaseImpl) SetGradingConfig(ctx context.Context, lmsAssignmentID int64, blindMode, doubleMarking bool, discrepancyThreshold float64, updatedBy int) (*entity.GradingConfig, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	if discrepancyThreshold < 0 || discrepancyThreshold > 100 {
+		return nil, errors.New("discrepancy_threshold must be between 0 and 100")
+	}
+	if discrepancyThreshold == 0 {
+		discrepancyThreshold = entity.DefaultDiscrepancyThreshold
+	}
+
+	config := &entity.GradingConfig{
+		LMSAssignmentID:      lmsAssignmentID,
+		BlindMode:            blindMode,
+		DoubleMarking:        doubleMarking,
+		DiscrepancyThreshold: discrepancyThreshold,
+		UpdatedBy:            &updatedBy,
+	}
+	if err := u.repo.UpsertGradingConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// GetGradingConfig returns the grading settings of an assignment, or the defaults when none are stored
+func (u *gradingUsecaseImpl) GetGradingConfig(ctx context.Context, lmsAssignmentID int64) (*entity.GradingConfig, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	return u.gradingConfig(ctx, &lmsAssignmentID)
+}
+
+// ListPendingEssays returns the grading queue. Unprivileged viewers get blind-mode
+// assignments anonymized and never see marks submitted by other graders.
+func (u *gradingUsecaseImpl) ListPendingEssays(ctx context.Context, filter entity.PendingEssayFilter, page, pageSize, viewerID int, privileged bool) ([]entity.PendingEssay, *entity.PaginationResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	offset := (page - 1) * pageSize
+
+	essays, total, err := u.repo.ListPendingEssays(ctx, filter, pageSize, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !privileged {
+		configs := map[int64]*entity.GradingConfig{}
+		for i := range essays {
+			essay := &essays[i]
+			blind, err := u.isBlind(ctx, essay.Answer.LMSAssignmentID, configs)
+			if err != nil {
+				return nil, nil, err
+			}
+			if blind {
+				anonymizeAnswer(&essay.Answer)
+			}
+			hideOtherMarks(essay.Tasks, viewerID)
+		}
+	}
+
+	totalPages := (total + pageSize - 1) / pageSize
+	return essays, &entity.PaginationResponse{
+		TotalCount:  total,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+	}, nil
+}
+
+// AssignGraders hands the pending essays of an assignment to graders round-robin.
+// Essays that already have a grader in the requested marker slot are left alone.
+func (u *gradingUsecaseImpl) AssignGraders(ctx context.Context, lmsAssignmentID int64, soalID int, answerIDs, graderIDs []int, markerSlot, assignedBy int) ([]entity.GradingTask, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	if markerSlot == 0 {
+		markerSlot = 1
+	}
+	if markerSlot != 1 && markerSlot != 2 {
+		return nil, errors.New("marker_slot must be 1 or 2")
+	}
+
+	var graders []int
+	seen := map[int]bool{}
+	for _, id := range graderIDs {
+		if id <= 0 {
+			return nil, errors.New("grader_ids must be positive")
+		}
+		if !seen[id] {
+			seen[id] = true
+			graders = append(graders, id)
+		}
+	}
+	if len(graders) == 0 {
+		return nil, errors.New("grader_ids is required")
+	}
+
+	config, err := u.gradingConfig(ctx, &lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if markerSlot == 2 && !config.DoubleMarking {
+		return nil, errors.New("a second marker can only be assigned when double marking is enabled")
+	}
+
+	filter := entity.PendingEssayFilter{LMSAssignmentID: lmsAssignmentID, SoalID: soalID, AnswerIDs: answerIDs}
+	essays, _, err := u.repo.ListPendingEssays(ctx, filter, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []entity.GradingTask
+	next := 0
+	for _, essay := range essays {
+		if essay.Task(markerSlot) != nil {
+			continue
+		}
+
+		// The same grader must not mark an essay twice
+		busy := map[int]bool{}
+		for _, task := range essay.Tasks {
+			busy[task.GraderID] = true
+		}
+		for i := 0; i < len(graders); i++ {
+			candidate := (next + i) % len(graders)
+			if busy[graders[candidate]] {
+				continue
+			}
+			tasks = append(tasks, entity.GradingTask{
+				IDJawaban:       essay.Answer.AnswerID,
+				LMSAssignmentID: lmsAssignmentID,
+				GraderID:        graders[candidate],
+				MarkerSlot:      markerSlot,
+				AssignedBy:      &assignedBy,
+			})
+			next = (candidate + 1) % len(graders)
+			break
+		}
+	}
+
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	if err := u.repo.CreateGradingTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// SubmitEssayMark records a grader's mark. With single marking the mark becomes nilai_essay.
+// With double marking the answer is finalized with the average of both marks once they are
+// within the assignment's discrepancy threshold, and sent to moderation otherwise.
+// acceptSuggestion takes the scoring assistant's suggested score as the mark.
+func (u *gradingUsecaseImpl) SubmitEssayMark(ctx context.Context, answerID, graderID int, privileged bool, score float64, selections []entity.RubricSelection, acceptSuggestion bool, feedback string) (*entity.EssayMarkResult, error) {
+	answer, err := u.pendingAnswer(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := u.gradingConfig(ctx, answer.LMSAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rubricScores []entity.JawabanRubricScore
+	switch {
+	case acceptSuggestion && len(selections) > 0:
+		return nil, errors.New("choose either rubric selections or the suggested score")
+	case acceptSuggestion:
+		suggestion, err := u.repo.GetScoreSuggestion(ctx, answerID)
+		if err != nil {
+			return nil, err
+		}
+		if suggestion == nil {
+			return nil, errors.New("score suggestion not found for this essay answer")
+		}
+		score = suggestion.SuggestedScore
+	case len(selections) > 0:
+		if score, rubricScores, err = u.scoreWithRubric(ctx, answer.SoalID, selections, graderID); err != nil {
+			return nil, err
+		}
+	case score < 0 || score > 100:
+		return nil, errors.New("score must be between 0 and 100")
+	}
+
+	// The mark, the other grader's mark it is compared with and the final grade are read
+	// and written under one lock, so two marks arriving together cannot both miss each other
+	var result *entity.EssayMarkResult
+	err = u.repo.GradeEssayInTx(ctx, answerID, func(tx grading.EssayGradingTx) error {
+		if err := stillUngraded(ctx, tx); err != nil {
+			return err
+		}
+		tasks, err := tx.ListGradingTasks(ctx)
+		if err != nil {
+			return err
+		}
+		var own *entity.GradingTask
+		for i := range tasks {
+			if tasks[i].GraderID == graderID {
+				own = &tasks[i]
+			}
+		}
+
+		if !config.DoubleMarking {
+			if own == nil && len(tasks) > 0 && !privileged {
+				return errors.New("permission denied: essay answer is assigned to another grader")
+			}
+			if own != nil {
+				if err := submitTask(ctx, tx, own, score, feedback, rubricScores); err != nil {
+					return err
+				}
+			}
+			result, err = u.finalizeEssay(ctx, tx, answer, entity.EssayGradeWrite{
+				NilaiEssay: score, Feedback: feedback, RubricScores: rubricScores, GradedBy: &graderID,
+				AcceptedSuggestion: acceptSuggestion,
+			})
+			return err
+		}
+
+		if own == nil {
+			return errors.New("permission denied: essay answer is not assigned to you for double marking")
+		}
+		if own.Status == entity.GradingTaskSubmitted {
+			return errors.New("you have already marked this essay answer")
+		}
+		if err := submitTask(ctx, tx, own, score, feedback, rubricScores); err != nil {
+			return err
+		}
+
+		var other *entity.GradingTask
+		for i := range tasks {
+			if tasks[i].ID != own.ID && tasks[i].Status == entity.GradingTaskSubmitted && tasks[i].Score != nil {
+				other = &tasks[i]
+			}
+		}
+		if other == nil {
+			result = &entity.EssayMarkResult{AnswerID: answerID, Status: entity.EssayMarkAwaitingSecondMark}
+			return nil
+		}
+
+		discrepancy := math.Abs(score - *other.Score)
+		if discrepancy > config.DiscrepancyThreshold {
+			moderation := &entity.EssayModeration{
+				LMSAssignmentID: *answer.LMSAssignmentID,
+				Discrepancy:     discrepancy,
+			}
+			if err := tx.CreateEssayModeration(ctx, moderation); err != nil {
+				return err
+			}
+			result = &entity.EssayMarkResult{AnswerID: answerID, Status: entity.EssayMarkModeration, Discrepancy: &discrepancy}
+			return nil
+		}
+
+		// The rubric breakdown of either marker would not add up to the average, so none is kept
+		average := math.Round((score+*other.Score)/2*100) / 100
+		result, err = u.finalizeEssay(ctx, tx, answer, entity.EssayGradeWrite{
+			NilaiEssay: average, Feedback: joinFeedback(other.Feedback, feedback), GradedBy: &graderID,
+		})
+		if err != nil {
+			return err
+		}
+		result.Discrepancy = &discrepancy
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.withSessionStatus(ctx, result)
+}
+
+// ResolveModeration sets the final score of an essay whose two marks disagreed
+func (u *gradingUsecaseImpl) ResolveModeration(ctx context.Context, answerID, moderatorID int, score float64, selections []entity.RubricSelection, feedback, note string) (*entity.EssayMarkResult, error) {
+	answer, err := u.pendingAnswer(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rubricScores []entity.JawabanRubricScore
+	if len(selections) > 0 {
+		if score, rubricScores, err = u.scoreWithRubric(ctx, answer.SoalID, selections, moderatorID); err != nil {
+			return nil, err
+		}
+	} else if score < 0 || score > 100 {
+		return nil, errors.New("score must be between 0 and 100")
+	}
+
+	var result *entity.EssayMarkResult
+	err = u.repo.GradeEssayInTx(ctx, answerID, func(tx grading.EssayGradingTx) error {
+		if err := stillUngraded(ctx, tx); err != nil {
+			return err
+		}
+		moderation, err := tx.GetEssayModeration(ctx)
+		if err != nil {
+			return err
+		}
+		if moderation == nil || moderation.Status != entity.EssayModerationPending {
+			return errors.New("pending moderation not found")
+		}
+
+		// Storing the grade resolves the moderation with the moderator's score and note
+		result, err = u.finalizeEssay(ctx, tx, answer, entity.EssayGradeWrite{
+			NilaiEssay: score, Feedback: feedback, RubricScores: rubricScores, GradedBy: &moderatorID, ModerationNote: note,
+		})
+		if err != nil {
+			return err
+		}
+		result.Discrepancy = &moderation.Discrepancy
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.withSessionStatus(ctx, result)
+}
+
+// GetGradingProgress reports how far the grading of an assignment has come
+func (u *gradingUsecaseImpl) GetGradingProgress(ctx context.Context, lmsAssignmentID int64) (*entity.GradingProgress, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	return u.repo.GetGradingProgress(ctx, lmsAssignmentID)
+}
+
+// pendingAnswer loads an essay answer that has not been scored yet
+func (u *gradingUsecaseImpl) pendingAnswer(ctx context.Context, answerID int) (*entity.EssayAnswerForGrading, error) {
+	if answerID <= 0 {
+		return nil, errors.New("answer_id must be positive")
+	}
+
+	answer, err := u.repo.GetEssayAnswer(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+	if answer == nil {
+		return nil, errors.New("essay answer not found")
+	}
+	if answer.NilaiEssay != nil {
+		return nil, errors.New("essay answer is already graded")
+	}
+	return answer, nil
+}
+
+// stillUngraded fails when the answer was graded after pendingAnswer read it
+func stillUngraded(ctx context.Context, tx grading.EssayGradingTx) error {
+	grade, err := tx.GetEssayGrade(ctx)
+	if err != nil {
+		return err
+	}
+	if grade != nil && grade.NilaiEssay != nil {
+		return errors.New("essay answer is already graded")
+	}
+	return nil
+}
+
+// finalizeEssay stores nilai_essay, which also recalculates the session and moves it
+// to graded once no answered essay is left without a score.
+func (u *gradingUsecaseImpl) finalizeEssay(ctx context.Context, tx grading.EssayGradingTx, answer *entity.EssayAnswerForGrading, grade entity.EssayGradeWrite) (*entity.EssayMarkResult, error) {
+	grade.AnswerID = answer.AnswerID
+	token, err := u.gradeEssay(ctx, tx, grade)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.EssayMarkResult{
+		AnswerID:     answer.AnswerID,
+		Status:       entity.EssayMarkFinalized,
+		NilaiEssay:   &grade.NilaiEssay,
+		RubricScores: grade.RubricScores,
+		SessionToken: token,
+	}, nil
+}
+
+// withSessionStatus adds the session status to a finalized result; it is read after the
+// grade is committed, which is when the session has been recalculated
+func (u *gradingUsecaseImpl) withSessionStatus(ctx context.Context, result *entity.EssayMarkResult) (*entity.EssayMarkResult, error) {
+	if result.Status != entity.EssayMarkFinalized {
+		return result, nil
+	}
+	session, err := u.testSessionRepo.GetByToken(ctx, result.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if session != nil {
+		result.SessionStatus = session.Status
+	}
+	return result, nil
+}
+
+func submitTask(ctx context.Context, tx grading.EssayGradingTx, task *entity.GradingTask, score float64, feedback string, rubricScores []entity.JawabanRubricScore) error {
+	task.Score = &score
+	task.RubricScores = rubricScores
+	if text := strings.TrimSpace(feedback); text != "" {
+		task.Feedback = &text
+	}
+	return tx.SubmitGradingTask(ctx, task)
+}
+
+// gradingConfig returns the stored settings of an assignment or the defaults
+func (u *gradingUsecaseImpl) gradingConfig(ctx context.Context, lmsAssignmentID *int64) (*entity.GradingConfig, error) {
+	if lmsAssignmentID == nil {
+		return &entity.GradingConfig{DiscrepancyThreshold: entity.DefaultDiscrepancyThreshold}, nil
+	}
+
+	config, err := u.repo.GetGradingConfig(ctx, *lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = &entity.GradingConfig{LMSAssignmentID: *lmsAssignmentID, DiscrepancyThreshold: entity.DefaultDiscrepancyThreshold}
+	}
+	return config, nil
+}
+
+func (u *gradingUsecaseImpl) isBlind(ctx context.Context, lmsAssignmentID *int64, cache map[int64]*entity.GradingConfig) (bool, error) {
+	if lmsAssignmentID == nil {
+		return false, nil
+	}
+	config, ok := cache[*lmsAssignmentID]
+	if !ok {
+		var err error
+		if config, err = u.gradingConfig(ctx, lmsAssignmentID); err != nil {
+			return false, err
+		}
+		cache[*lmsAssignmentID] = config
+	}
+	return config.BlindMode, nil
+}
+
+// anonymizeAnswer replaces the student's identity with a pseudonym that stays the same
+// for every answer of the session, so graders can still tell answers apart.
+func anonymizeAnswer(answer *entity.EssayAnswerForGrading) {
+	pseudonym := pseudonymFor(answer.SessionToken)
+	answer.SessionID = 0
+	answer.SessionToken = pseudonym
+	answer.NamaPeserta = pseudonym
+	answer.UserID = nil
+}
+
+// pseudonymFor derives a pseudonym from the session token. 48 bits of SHA-256 keep
+// collisions within an assignment out of reach, and the token cannot be recovered from it.
+func pseudonymFor(sessionToken string) string {
+	if sessionToken == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte("blind-grading:" + sessionToken))
+	return "Peserta " + strings.ToUpper(hex.EncodeToString(sum[:6]))
+}
+
+// hideOtherMarks keeps graders from seeing each other's marks before the essay is final
+func hideOtherMarks(tasks []entity.GradingTask, viewerID int) {
+	for i := range tasks {
+		if tasks[i].GraderID == viewerID {
+			continue
+		}
+		tasks[i].Score = nil
+		tasks[i].Feedback = nil
+		tasks[i].RubricScores = nil
+	}
+}
+
+func joinFeedback(first *string, second string) string {
+	var parts []string
+	if first != nil && strings.TrimSpace(*first) != "" {
+		parts = append(parts, strings.TrimSpace(*first))
+	}
+	if text := strings.TrimSpace(second); text != "" {
+		parts = append(parts, text)
+	}
+	return strings.Join(parts, "\n\n")
+}
@@ -0,0 +1,337 @@
+package grading_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+	gradingrepo "cbt-test-mini-project/internal/repository/grading"
+	"cbt-test-mini-project/internal/repository/test_session"
+	"cbt-test-mini-project/internal/usecase/grading"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Fakes ---
+
+// fakeGradingRepo keeps the grading state of one essay answer. Writes made through
+// GradeEssayInTx are only kept when fn succeeds, like a committed transaction.
+type fakeGradingRepo struct {
+	gradingrepo.GradingRepository
+
+	answer     entity.EssayAnswerForGrading
+	config     *entity.GradingConfig
+	suggestion *entity.EssayScoreSuggestion
+	pending    []entity.PendingEssay
+
+	// gradedMeanwhile is a grade committed by someone else before the lock was taken
+	gradedMeanwhile *float64
+
+	tasks      []entity.GradingTask
+	moderation *entity.EssayModeration
+	grades     []entity.EssayGradeWrite
+	txCount    int
+}
+
+func (r *fakeGradingRepo) GetEssayAnswer(ctx context.Context, answerID int) (*entity.EssayAnswerForGrading, error) {
+	if answerID != r.answer.AnswerID {
+		return nil, nil
+	}
+	answer := r.answer
+	return &answer, nil
+}
+
+func (r *fakeGradingRepo) GetGradingConfig(ctx context.Context, lmsAssignmentID int64) (*entity.GradingConfig, error) {
+	return r.config, nil
+}
+
+func (r *fakeGradingRepo) GetScoreSuggestion(ctx context.Context, answerID int) (*entity.EssayScoreSuggestion, error) {
+	return r.suggestion, nil
+}
+
+func (r *fakeGradingRepo) ListPendingEssays(ctx context.Context, filter entity.PendingEssayFilter, limit, offset int) ([]entity.PendingEssay, int, error) {
+	return r.pending, len(r.pending), nil
+}
+
+func (r *fakeGradingRepo) GradeEssayInTx(ctx context.Context, answerID int, fn func(tx gradingrepo.EssayGradingTx) error) error {
+	r.txCount++
+	tx := &fakeGradingTx{repo: r, tasks: append([]entity.GradingTask(nil), r.tasks...), moderation: r.moderation}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	r.tasks, r.moderation = tx.tasks, tx.moderation
+	r.grades = append(r.grades, tx.grades...)
+	return nil
+}
+
+type fakeGradingTx struct {
+	repo       *fakeGradingRepo
+	tasks      []entity.GradingTask
+	moderation *entity.EssayModeration
+	grades     []entity.EssayGradeWrite
+}
+
+func (t *fakeGradingTx) GetEssayGrade(ctx context.Context) (*entity.EssayGrade, error) {
+	return &entity.EssayGrade{NilaiEssay: t.repo.gradedMeanwhile}, nil
+}
+
+func (t *fakeGradingTx) ListGradingTasks(ctx context.Context) ([]entity.GradingTask, error) {
+	return append([]entity.GradingTask(nil), t.tasks...), nil
+}
+
+func (t *fakeGradingTx) SubmitGradingTask(ctx context.Context, task *entity.GradingTask) error {
+	for i := range t.tasks {
+		if t.tasks[i].ID == task.ID {
+			task.Status = entity.GradingTaskSubmitted
+			t.tasks[i] = *task
+			return nil
+		}
+	}
+	return errors.New("grading task not found")
+}
+
+func (t *fakeGradingTx) GetEssayModeration(ctx context.Context) (*entity.EssayModeration, error) {
+	return t.moderation, nil
+}
+
+func (t *fakeGradingTx) CreateEssayModeration(ctx context.Context, moderation *entity.EssayModeration) error {
+	moderation.IDJawaban = t.repo.answer.AnswerID
+	moderation.Status = entity.EssayModerationPending
+	t.moderation = moderation
+	return nil
+}
+
+func (t *fakeGradingTx) SaveEssayGrade(ctx context.Context, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error) {
+	t.grades = append(t.grades, *grade)
+	if t.moderation != nil && t.moderation.Status == entity.EssayModerationPending {
+		t.moderation.Status = entity.EssayModerationResolved
+		t.moderation.FinalScore = &grade.NilaiEssay
+	}
+	return &entity.EssayGradeChange{SessionToken: t.repo.answer.SessionToken}, nil
+}
+
+type fakeSessionRepo struct {
+	test_session.TestSessionRepository
+}
+
+func (r *fakeSessionRepo) GetByToken(ctx context.Context, token string) (*entity.TestSession, error) {
+	return &entity.TestSession{SessionToken: token, Status: entity.TestStatusGraded}, nil
+}
+
+func newGradingRepo(doubleMarking bool, tasks ...entity.GradingTask) *fakeGradingRepo {
+	assignmentID := int64(7)
+	return &fakeGradingRepo{
+		answer: entity.EssayAnswerForGrading{AnswerID: 100, SessionToken: "token-a", LMSAssignmentID: &assignmentID, SoalID: 3},
+		config: &entity.GradingConfig{LMSAssignmentID: assignmentID, DoubleMarking: doubleMarking, DiscrepancyThreshold: 15},
+		tasks:  tasks,
+	}
+}
+
+func submittedTask(id int64, graderID, slot int, score float64) entity.GradingTask {
+	return entity.GradingTask{ID: id, IDJawaban: 100, GraderID: graderID, MarkerSlot: slot, Status: entity.GradingTaskSubmitted, Score: &score}
+}
+
+func assignedTask(id int64, graderID, slot int) entity.GradingTask {
+	return entity.GradingTask{ID: id, IDJawaban: 100, GraderID: graderID, MarkerSlot: slot, Status: entity.GradingTaskAssigned}
+}
+
+// --- Tests ---
+
+func TestSubmitEssayMark_SingleMarkingFinalizesInOneTransaction(t *testing.T) {
+	repo := newGradingRepo(false, assignedTask(1, 5, 1))
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	result, err := uc.SubmitEssayMark(context.Background(), 100, 5, false, 82, nil, false, "Baik")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, repo.txCount)
+	assert.Equal(t, entity.EssayMarkFinalized, result.Status)
+	assert.Equal(t, 82.0, *result.NilaiEssay)
+	assert.Equal(t, entity.TestStatusGraded, result.SessionStatus)
+	assert.Equal(t, entity.GradingTaskSubmitted, repo.tasks[0].Status)
+	require.Len(t, repo.grades, 1)
+	assert.Equal(t, 100, repo.grades[0].AnswerID)
+	assert.Equal(t, 5, *repo.grades[0].GradedBy)
+}
+
+func TestSubmitEssayMark_AcceptSuggestionUsesSuggestedScore(t *testing.T) {
+	repo := newGradingRepo(false)
+	repo.suggestion = &entity.EssayScoreSuggestion{IDJawaban: 100, SuggestedScore: 64}
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	result, err := uc.SubmitEssayMark(context.Background(), 100, 5, true, 0, nil, true, "")
+	require.NoError(t, err)
+	assert.Equal(t, 64.0, *result.NilaiEssay)
+	require.Len(t, repo.grades, 1)
+	assert.True(t, repo.grades[0].AcceptedSuggestion)
+}
+
+func TestSubmitEssayMark_AcceptedSuggestionIsRecordedNotInferred(t *testing.T) {
+	tests := []struct {
+		name         string
+		doubleMark   bool
+		tasks        []entity.GradingTask
+		score        float64
+		accept       bool
+		wantAccepted bool
+	}{
+		{name: "typed score equal to the suggestion", score: 64},
+		{name: "typed score", score: 70},
+		{name: "accepted with single marking", accept: true, wantAccepted: true},
+		{name: "accepted mark averaged with a second mark", doubleMark: true, tasks: []entity.GradingTask{assignedTask(1, 5, 1), submittedTask(2, 6, 2, 64)}, accept: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newGradingRepo(tt.doubleMark, tt.tasks...)
+			repo.suggestion = &entity.EssayScoreSuggestion{IDJawaban: 100, SuggestedScore: 64}
+			uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+			_, err := uc.SubmitEssayMark(context.Background(), 100, 5, true, tt.score, nil, tt.accept, "")
+			require.NoError(t, err)
+			require.Len(t, repo.grades, 1)
+			assert.Equal(t, tt.wantAccepted, repo.grades[0].AcceptedSuggestion)
+		})
+	}
+}
+
+func TestSubmitEssayMark_DoubleMarking(t *testing.T) {
+	tests := []struct {
+		name            string
+		other           *entity.GradingTask
+		score           float64
+		wantStatus      entity.EssayMarkStatus
+		wantNilai       float64
+		wantModeration  bool
+		wantDiscrepancy float64
+	}{
+		{
+			name:       "first mark waits for the second",
+			score:      70,
+			wantStatus: entity.EssayMarkAwaitingSecondMark,
+		},
+		{
+			name:            "marks within the threshold are averaged",
+			other:           ptr(submittedTask(2, 6, 2, 80)),
+			score:           70,
+			wantStatus:      entity.EssayMarkFinalized,
+			wantNilai:       75,
+			wantDiscrepancy: 10,
+		},
+		{
+			name:            "marks beyond the threshold go to moderation",
+			other:           ptr(submittedTask(2, 6, 2, 90)),
+			score:           70,
+			wantStatus:      entity.EssayMarkModeration,
+			wantModeration:  true,
+			wantDiscrepancy: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks := []entity.GradingTask{assignedTask(1, 5, 1)}
+			if tt.other != nil {
+				tasks = append(tasks, *tt.other)
+			} else {
+				tasks = append(tasks, assignedTask(2, 6, 2))
+			}
+			repo := newGradingRepo(true, tasks...)
+			uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+			result, err := uc.SubmitEssayMark(context.Background(), 100, 5, false, tt.score, nil, false, "")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStatus, result.Status)
+			assert.Equal(t, entity.GradingTaskSubmitted, repo.tasks[0].Status)
+			assert.Equal(t, tt.wantModeration, repo.moderation != nil)
+			if tt.wantStatus == entity.EssayMarkFinalized {
+				require.Len(t, repo.grades, 1)
+				assert.Equal(t, tt.wantNilai, repo.grades[0].NilaiEssay)
+			} else {
+				assert.Empty(t, repo.grades)
+			}
+			if tt.wantDiscrepancy > 0 {
+				assert.Equal(t, tt.wantDiscrepancy, *result.Discrepancy)
+			}
+		})
+	}
+}
+
+func TestSubmitEssayMark_RejectsSecondMarkByTheSameGrader(t *testing.T) {
+	repo := newGradingRepo(true, submittedTask(1, 5, 1, 70), assignedTask(2, 6, 2))
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	_, err := uc.SubmitEssayMark(context.Background(), 100, 5, false, 75, nil, false, "")
+	assert.EqualError(t, err, "you have already marked this essay answer")
+	assert.Equal(t, 70.0, *repo.tasks[0].Score)
+}
+
+func TestSubmitEssayMark_GradedWhileWaitingForTheLock(t *testing.T) {
+	repo := newGradingRepo(false, assignedTask(1, 5, 1))
+	graded := 90.0
+	repo.gradedMeanwhile = &graded
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	_, err := uc.SubmitEssayMark(context.Background(), 100, 5, false, 60, nil, false, "")
+	assert.EqualError(t, err, "essay answer is already graded")
+	assert.Empty(t, repo.grades)
+	assert.Equal(t, entity.GradingTaskAssigned, repo.tasks[0].Status)
+}
+
+func TestResolveModeration_ClosesModerationWithTheGrade(t *testing.T) {
+	repo := newGradingRepo(true, submittedTask(1, 5, 1, 70), submittedTask(2, 6, 2, 90))
+	repo.moderation = &entity.EssayModeration{IDJawaban: 100, LMSAssignmentID: 7, Status: entity.EssayModerationPending, Discrepancy: 20}
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	result, err := uc.ResolveModeration(context.Background(), 100, 9, 85, nil, "", "kunci jawaban mendukung nilai tinggi")
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.EssayMarkFinalized, result.Status)
+	assert.Equal(t, 20.0, *result.Discrepancy)
+	require.Len(t, repo.grades, 1)
+	assert.Equal(t, 9, *repo.grades[0].GradedBy)
+	assert.Equal(t, "kunci jawaban mendukung nilai tinggi", repo.grades[0].ModerationNote)
+	assert.Equal(t, entity.EssayModerationResolved, repo.moderation.Status)
+}
+
+func TestResolveModeration_RequiresPendingModeration(t *testing.T) {
+	repo := newGradingRepo(true)
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	_, err := uc.ResolveModeration(context.Background(), 100, 9, 85, nil, "", "")
+	assert.EqualError(t, err, "pending moderation not found")
+	assert.Empty(t, repo.grades)
+}
+
+func TestListPendingEssays_BlindModePseudonyms(t *testing.T) {
+	repo := newGradingRepo(false)
+	repo.config.BlindMode = true
+	userID := 11
+	essay := func(answerID int, token string) entity.PendingEssay {
+		return entity.PendingEssay{Answer: entity.EssayAnswerForGrading{
+			AnswerID: answerID, SessionID: 4, SessionToken: token, UserID: &userID, NamaPeserta: "Budi",
+			LMSAssignmentID: repo.answer.LMSAssignmentID,
+		}}
+	}
+	repo.pending = []entity.PendingEssay{essay(1, "token-a"), essay(2, "token-a"), essay(3, "token-b")}
+	uc := grading.NewGradingUsecase(repo, &fakeSessionRepo{})
+
+	essays, _, err := uc.ListPendingEssays(context.Background(), entity.PendingEssayFilter{}, 1, 20, 5, false)
+	require.NoError(t, err)
+	require.Len(t, essays, 3)
+
+	first := essays[0].Answer
+	assert.Regexp(t, regexp.MustCompile(`^Peserta [0-9A-F]{12}$`), first.NamaPeserta)
+	assert.Equal(t, first.NamaPeserta, first.SessionToken)
+	assert.Zero(t, first.SessionID)
+	assert.Nil(t, first.UserID)
+	assert.Equal(t, first.NamaPeserta, essays[1].Answer.NamaPeserta, "one session keeps one pseudonym")
+	assert.NotEqual(t, first.NamaPeserta, essays[2].Answer.NamaPeserta)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
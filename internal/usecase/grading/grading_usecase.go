@@ -0,0 +1,450 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/grading"
+	"cbt-test-mini-project/internal/repository/test_session"
+	"cbt-test-mini-project/util/audit"
+	"cbt-test-mini-project/util/textsim"
+)
+
+const (
+	// Peer matches below both thresholds are not stored
+	minReportedSimilarity  = 0.2
+	minReportedContainment = 0.4
+	// Answers with a peer match above either threshold are counted as flagged
+	flagSimilarity  = 0.5
+	flagContainment = 0.7
+	// exactCompareBelow skips the MinHash prefilter for answers with fewer shingles
+	exactCompareBelow = 32
+	// maxPassagesPerMatch keeps stored evidence readable
+	maxPassagesPerMatch = 20
+)
+
+// gradingUsecaseImpl implements GradingUsecase
+type gradingUsecaseImpl struct {
+	repo            grading.GradingRepository
+	testSessionRepo test_session.TestSessionRepository
+	hasher          *textsim.Hasher
+	// pairHasher compares answers with the answer key on word pairs, which tolerates rephrasing
+	pairHasher *textsim.Hasher
+}
+
+// NewGradingUsecase creates a new GradingUsecase instance
+func NewGradingUsecase(repo grading.GradingRepository, testSessionRepo test_session.TestSessionRepository) GradingUsecase {
+	return &gradingUsecaseImpl{
+		repo:            repo,
+		testSessionRepo: testSessionRepo,
+		hasher:          textsim.NewHasher(textsim.DefaultShingleSize, textsim.DefaultSignatureSize),
+		pairHasher:      textsim.NewHasher(2, 1),
+	}
+}
+
+// RunEssaySimilarityCheck compares every essay answer of an assignment with the other answers
+// to the same soal and with the soal's answer key, replacing previously stored results.
+func (u *gradingUsecaseImpl) RunEssaySimilarityCheck(ctx context.Context, lmsAssignmentID int64, soalID int) (*entity.EssaySimilarityRun, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+
+	answers, err := u.repo.ListAssignmentEssays(ctx, lmsAssignmentID, soalID)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &entity.EssaySimilarityRun{
+		LMSAssignmentID: lmsAssignmentID,
+		SoalID:          soalID,
+		AnswersCompared: len(answers),
+	}
+
+	// Answers are ordered by soal, so each soal is a contiguous group
+	var results []entity.EssaySimilarity
+	flagged := map[int]bool{}
+	for start := 0; start < len(answers); {
+		end := start
+		for end < len(answers) && answers[end].SoalID == answers[start].SoalID {
+			end++
+		}
+		group := answers[start:end]
+		start = end
+
+		docs := make([]*textsim.Document, len(group))
+		for i, answer := range group {
+			docs[i] = u.hasher.NewDocument(answer.JawabanEssay)
+		}
+
+		if key := group[0].JawabanEssayKey; key != nil && strings.TrimSpace(*key) != "" {
+			keyDoc := u.hasher.NewDocument(*key)
+			for i, answer := range group {
+				result := textsim.Compare(docs[i], keyDoc)
+				results = append(results, u.newSimilarity(ctx, lmsAssignmentID, answer, nil, entity.EssaySimilarityAnswerKey, docs[i], keyDoc, result))
+			}
+		}
+
+		for i := 0; i < len(group); i++ {
+			for j := i + 1; j < len(group); j++ {
+				run.PairsCompared++
+				if !worthComparing(docs[i], docs[j]) {
+					continue
+				}
+
+				forward := textsim.Compare(docs[i], docs[j])
+				backward := textsim.Compare(docs[j], docs[i])
+				if !reportable(forward) && !reportable(backward) {
+					continue
+				}
+
+				results = append(results,
+					u.newSimilarity(ctx, lmsAssignmentID, group[i], &group[j], entity.EssaySimilarityPeer, docs[i], docs[j], forward),
+					u.newSimilarity(ctx, lmsAssignmentID, group[j], &group[i], entity.EssaySimilarityPeer, docs[j], docs[i], backward),
+				)
+				if isFlagged(forward) {
+					flagged[group[i].AnswerID] = true
+				}
+				if isFlagged(backward) {
+					flagged[group[j].AnswerID] = true
+				}
+			}
+		}
+	}
+
+	if err := u.repo.ReplaceEssaySimilarities(ctx, lmsAssignmentID, soalID, results); err != nil {
+		return nil, err
+	}
+
+	run.SimilaritiesSaved = len(results)
+	run.FlaggedAnswers = len(flagged)
+	run.ComputedAt = time.Now()
+	return run, nil
+}
+
+// GetEssayGradingView returns an essay answer together with its stored similarity results.
+// Unprivileged viewers get students of blind-mode assignments anonymized.
+func (u *gradingUsecaseImpl) GetEssayGradingView(ctx context.Context, answerID int, privileged bool) (*entity.EssayGradingView, error) {
+	if answerID <= 0 {
+		return nil, errors.New("answer_id must be positive")
+	}
+
+	answer, err := u.repo.GetEssayAnswer(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+	if answer == nil {
+		return nil, errors.New("essay answer not found")
+	}
+
+	similarities, err := u.repo.ListEssaySimilarities(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	rubric, err := u.repo.GetEssayRubric(ctx, answer.SoalID)
+	if err != nil {
+		return nil, err
+	}
+
+	rubricScores, err := u.repo.ListRubricScores(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion, err := u.repo.GetScoreSuggestion(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !privileged {
+		blind, err := u.isBlind(ctx, answer.LMSAssignmentID, map[int64]*entity.GradingConfig{})
+		if err != nil {
+			return nil, err
+		}
+		if blind {
+			anonymizeAnswer(answer)
+			for i := range similarities {
+				similarities[i].MatchedSessionToken = pseudonymFor(similarities[i].MatchedSessionToken)
+				similarities[i].MatchedNamaPeserta = similarities[i].MatchedSessionToken
+			}
+		}
+	}
+
+	return &entity.EssayGradingView{
+		Answer:       *answer,
+		Similarities: similarities,
+		Rubric:       rubric,
+		RubricScores: rubricScores,
+		Suggestion:   suggestion,
+	}, nil
+}
+
+// SetEssayRubric replaces the rubric of an essay soal. An empty criteria list removes it and
+// returns the rubric without criteria.
+func (u *gradingUsecaseImpl) SetEssayRubric(ctx context.Context, soalID int, passingScore float64, criteria []entity.RubricCriterion, updatedBy int) (*entity.EssayRubric, error) {
+	if soalID <= 0 {
+		return nil, errors.New("id_soal is required")
+	}
+	if passingScore < 0 || passingScore > 100 {
+		return nil, errors.New("passing_score must be between 0 and 100")
+	}
+	if passingScore == 0 {
+		passingScore = entity.DefaultEssayPassingScore
+	}
+
+	questionType, err := u.repo.GetSoalQuestionType(ctx, soalID)
+	if err != nil {
+		return nil, err
+	}
+	if questionType == "" {
+		return nil, errors.New("soal not found")
+	}
+	if questionType != entity.QuestionTypeEssay {
+		return nil, errors.New("rubrics can only be attached to essay questions")
+	}
+
+	for i := range criteria {
+		criterion := &criteria[i]
+		criterion.Nama = strings.TrimSpace(criterion.Nama)
+		if criterion.Nama == "" {
+			return nil, fmt.Errorf("criterion %d: nama is required", i+1)
+		}
+		if len(criterion.Levels) == 0 {
+			return nil, fmt.Errorf("criterion %q: at least one level is required", criterion.Nama)
+		}
+		if criterion.Urutan == 0 {
+			criterion.Urutan = i + 1
+		}
+		for j := range criterion.Levels {
+			level := &criterion.Levels[j]
+			level.Label = strings.TrimSpace(level.Label)
+			if level.Label == "" {
+				return nil, fmt.Errorf("criterion %q: level %d label is required", criterion.Nama, j+1)
+			}
+			if level.Point < 0 {
+				return nil, fmt.Errorf("criterion %q: level %q point must not be negative", criterion.Nama, level.Label)
+			}
+			if level.Urutan == 0 {
+				level.Urutan = j + 1
+			}
+		}
+	}
+
+	rubric := &entity.EssayRubric{
+		IDSoal:       soalID,
+		PassingScore: passingScore,
+		Criteria:     criteria,
+		UpdatedBy:    &updatedBy,
+	}
+	if len(criteria) > 0 && rubric.MaxPoint() <= 0 {
+		return nil, errors.New("rubric must have at least one level with points")
+	}
+
+	if err := u.repo.ReplaceEssayRubric(ctx, rubric); err != nil {
+		return nil, err
+	}
+	return rubric, nil
+}
+
+// GetEssayRubric returns the rubric of an essay soal
+func (u *gradingUsecaseImpl) GetEssayRubric(ctx context.Context, soalID int) (*entity.EssayRubric, error) {
+	if soalID <= 0 {
+		return nil, errors.New("id_soal is required")
+	}
+
+	rubric, err := u.repo.GetEssayRubric(ctx, soalID)
+	if err != nil {
+		return nil, err
+	}
+	if rubric == nil {
+		return nil, errors.New("rubric not found")
+	}
+	return rubric, nil
+}
+
+// GradeEssay sets or replaces the grade of an essay answer, scored from one selected level per
+// rubric criterion when selections are given. A grade without selections clears the rubric
+// breakdown of an earlier rubric grade. The grade also closes the answer's double marking:
+// a pending moderation is resolved by gradedBy and unsubmitted grading tasks are dropped.
+func (u *gradingUsecaseImpl) GradeEssay(ctx context.Context, answerID int, score float64, selections []entity.RubricSelection, feedback string, gradedBy int) (float64, []entity.JawabanRubricScore, error) {
+	if answerID <= 0 {
+		return 0, nil, errors.New("answer_id must be positive")
+	}
+	if len(selections) == 0 {
+		if score < 0 || score > 100 {
+			return 0, nil, errors.New("score must be between 0 and 100")
+		}
+		grade := entity.EssayGradeWrite{AnswerID: answerID, NilaiEssay: score, Feedback: feedback, GradedBy: &gradedBy}
+		if _, err := u.gradeEssay(ctx, u.repo, grade); err != nil {
+			return 0, nil, err
+		}
+		return score, nil, nil
+	}
+
+	answer, err := u.repo.GetEssayAnswer(ctx, answerID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if answer == nil {
+		return 0, nil, errors.New("essay answer not found")
+	}
+
+	nilai, scores, err := u.scoreWithRubric(ctx, answer.SoalID, selections, gradedBy)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	grade := entity.EssayGradeWrite{AnswerID: answerID, NilaiEssay: nilai, Feedback: feedback, RubricScores: scores, GradedBy: &gradedBy}
+	if _, err := u.gradeEssay(ctx, u.repo, grade); err != nil {
+		return 0, nil, err
+	}
+	return nilai, grade.RubricScores, nil
+}
+
+// essayGradeSaver stores a final grade, either on its own or inside GradeEssayInTx
+type essayGradeSaver interface {
+	SaveEssayGrade(ctx context.Context, grade *entity.EssayGradeWrite) (*entity.EssayGradeChange, error)
+}
+
+// gradeEssay stores the grade of an essay answer with its rubric scores and records the
+// change in the audit log
+func (u *gradingUsecaseImpl) gradeEssay(ctx context.Context, saver essayGradeSaver, grade entity.EssayGradeWrite) (string, error) {
+	change, err := saver.SaveEssayGrade(ctx, &grade)
+	if err != nil {
+		return "", err
+	}
+	audit.Record(ctx, entity.AuditResourceEssayAnswer, grade.AnswerID, change.Before, change.After)
+	return change.SessionToken, nil
+}
+
+// scoreWithRubric turns one selected level per criterion into nilai_essay, the selected
+// points as a percentage of the rubric's maximum, without storing anything.
+func (u *gradingUsecaseImpl) scoreWithRubric(ctx context.Context, soalID int, selections []entity.RubricSelection, gradedBy int) (float64, []entity.JawabanRubricScore, error) {
+	rubric, err := u.repo.GetEssayRubric(ctx, soalID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if rubric == nil || len(rubric.Criteria) == 0 {
+		return 0, nil, errors.New("this essay question has no rubric")
+	}
+
+	selected := make(map[int64]entity.RubricSelection, len(selections))
+	for _, selection := range selections {
+		if _, exists := selected[selection.CriterionID]; exists {
+			return 0, nil, fmt.Errorf("criterion %d is selected more than once", selection.CriterionID)
+		}
+		selected[selection.CriterionID] = selection
+	}
+	if len(selected) != len(rubric.Criteria) {
+		return 0, nil, errors.New("select exactly one level for every rubric criterion")
+	}
+
+	var scores []entity.JawabanRubricScore
+	total := 0.0
+	for _, criterion := range rubric.Criteria {
+		selection, ok := selected[criterion.ID]
+		if !ok {
+			return 0, nil, fmt.Errorf("no level selected for criterion %q", criterion.Nama)
+		}
+
+		var level *entity.RubricLevel
+		for i := range criterion.Levels {
+			if criterion.Levels[i].ID == selection.LevelID {
+				level = &criterion.Levels[i]
+				break
+			}
+		}
+		if level == nil {
+			return 0, nil, fmt.Errorf("level %d does not belong to criterion %q", selection.LevelID, criterion.Nama)
+		}
+
+		score := entity.JawabanRubricScore{
+			IDCriterion:  criterion.ID,
+			IDLevel:      level.ID,
+			NamaKriteria: criterion.Nama,
+			LabelLevel:   level.Label,
+			Point:        level.Point,
+			MaxPoint:     criterion.MaxPoint(),
+			Urutan:       criterion.Urutan,
+			GradedBy:     &gradedBy,
+		}
+		if text := strings.TrimSpace(selection.Feedback); text != "" {
+			score.Feedback = &text
+		}
+		scores = append(scores, score)
+		total += level.Point
+	}
+
+	return math.Round(total/rubric.MaxPoint()*10000) / 100, scores, nil
+}
+
+func (u *gradingUsecaseImpl) newSimilarity(ctx context.Context, lmsAssignmentID int64, answer entity.EssayAnswerForGrading, matched *entity.EssayAnswerForGrading, source entity.EssaySimilaritySource, doc, other *textsim.Document, result textsim.Result) entity.EssaySimilarity {
+	similarity := entity.EssaySimilarity{
+		IDJawaban:       answer.AnswerID,
+		IDSoal:          answer.SoalID,
+		LMSAssignmentID: lmsAssignmentID,
+		Source:          source,
+		Similarity:      result.Jaccard,
+		Containment:     result.Containment,
+		MatchedPassages: []entity.MatchedPassage{},
+	}
+	if matched != nil {
+		similarity.MatchedIDJawaban = &matched.AnswerID
+		similarity.MatchedSessionToken = matched.SessionToken
+		similarity.MatchedNamaPeserta = matched.NamaPeserta
+	}
+
+	for i, passage := range result.Passages {
+		if i == maxPassagesPerMatch {
+			break
+		}
+		similarity.MatchedPassages = append(similarity.MatchedPassages, entity.MatchedPassage{
+			Text:         doc.Text[passage.Start:passage.End],
+			Start:        passage.Start,
+			End:          passage.End,
+			MatchedText:  other.Text[passage.MatchedStart:passage.MatchedEnd],
+			MatchedStart: passage.MatchedStart,
+			MatchedEnd:   passage.MatchedEnd,
+			Words:        passage.Words,
+		})
+	}
+	return similarity
+}
+
+// worthComparing uses the MinHash estimate to skip pairs that cannot reach the report
+// thresholds. Containment is bounded from the estimated intersection size, since a short
+// answer copied into a long one has a low Jaccard but a high containment.
+func worthComparing(a, b *textsim.Document) bool {
+	if a.Empty() || b.Empty() {
+		return false
+	}
+
+	smaller := a.Size()
+	if b.Size() < smaller {
+		smaller = b.Size()
+	}
+	// Too few shingles for a reliable estimate; exact comparison is cheap anyway
+	if smaller <= exactCompareBelow {
+		return true
+	}
+
+	estimate := textsim.EstimateJaccard(a, b)
+	if estimate >= minReportedSimilarity/2 {
+		return true
+	}
+	intersection := estimate * float64(a.Size()+b.Size()) / (1 + estimate)
+	return intersection/float64(smaller) >= minReportedContainment/2
+}
+
+func reportable(result textsim.Result) bool {
+	return result.Jaccard >= minReportedSimilarity || result.Containment >= minReportedContainment
+}
+
+func isFlagged(result textsim.Result) bool {
+	return result.Jaccard >= flagSimilarity || result.Containment >= flagContainment
+}
@@ -0,0 +1,27 @@
+package grading
+
+import (
+	"context"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// GradingUsecase defines the interface for essay grading operations
+type GradingUsecase interface {
+	RunEssaySimilarityCheck(ctx context.Context, lmsAssignmentID int64, soalID int) (*entity.EssaySimilarityRun, error)
+	GetEssayGradingView(ctx context.Context, answerID int, privileged bool) (*entity.EssayGradingView, error)
+	SetEssayRubric(ctx context.Context, soalID int, passingScore float64, criteria []entity.RubricCriterion, updatedBy int) (*entity.EssayRubric, error)
+	GetEssayRubric(ctx context.Context, soalID int) (*entity.EssayRubric, error)
+	GradeEssay(ctx context.Context, answerID int, score float64, selections []entity.RubricSelection, feedback string, gradedBy int) (float64, []entity.JawabanRubricScore, error)
+	SetGradingConfig(ctx context.Context, lmsAssignmentID int64, blindMode, doubleMarking bool, discrepancyThreshold float64, updatedBy int) (*entity.GradingConfig, error)
+	GetGradingConfig(ctx context.Context, lmsAssignmentID int64) (*entity.GradingConfig, error)
+	ListPendingEssays(ctx context.Context, filter entity.PendingEssayFilter, page, pageSize, viewerID int, privileged bool) ([]entity.PendingEssay, *entity.PaginationResponse, error)
+	AssignGraders(ctx context.Context, lmsAssignmentID int64, soalID int, answerIDs, graderIDs []int, markerSlot, assignedBy int) ([]entity.GradingTask, error)
+	SubmitEssayMark(ctx context.Context, answerID, graderID int, privileged bool, score float64, selections []entity.RubricSelection, acceptSuggestion bool, feedback string) (*entity.EssayMarkResult, error)
+	ResolveModeration(ctx context.Context, answerID, moderatorID int, score float64, selections []entity.RubricSelection, feedback, note string) (*entity.EssayMarkResult, error)
+	GetGradingProgress(ctx context.Context, lmsAssignmentID int64) (*entity.GradingProgress, error)
+	SetEssayKeywords(ctx context.Context, soalID int, keywords []entity.EssayKeyword) ([]entity.EssayKeyword, error)
+	GetEssayKeywords(ctx context.Context, soalID int) ([]entity.EssayKeyword, error)
+	GenerateScoreSuggestions(ctx context.Context, lmsAssignmentID int64, soalID int) (*entity.EssaySuggestionRun, error)
+	GetSuggestionAgreement(ctx context.Context, lmsAssignmentID int64, soalID int) (*entity.SuggestionAgreement, error)
+}
@@ -0,0 +1,226 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/textsim"
+)
+
+const (
+	// keywordShare is the weight of keyword coverage when a soal has both keywords and an answer key
+	keywordShare = 0.7
+	// fullReferenceOverlap is the share of the answer key's word pairs that earns the full
+	// reference part of the score; answers rarely repeat a key word for word
+	fullReferenceOverlap = 0.5
+	// maxKeywordMatches keeps stored highlights readable
+	maxKeywordMatches = 50
+)
+
+// SetEssayKeywords replaces the keywords the scoring assistant looks for in answers to an essay soal
+func (u *gradingUsecaseImpl) SetEssayKeywords(ctx context.Context, soalID int, keywords []entity.EssayKeyword) ([]entity.EssayKeyword, error) {
+	if soalID <= 0 {
+		return nil, errors.New("id_soal is required")
+	}
+
+	questionType, err := u.repo.GetSoalQuestionType(ctx, soalID)
+	if err != nil {
+		return nil, err
+	}
+	if questionType == "" {
+		return nil, errors.New("soal not found")
+	}
+	if questionType != entity.QuestionTypeEssay {
+		return nil, errors.New("keywords can only be attached to essay questions")
+	}
+
+	for i := range keywords {
+		keyword := &keywords[i]
+		keyword.Keyword = strings.TrimSpace(keyword.Keyword)
+		if keyword.Keyword == "" {
+			return nil, fmt.Errorf("keyword %d is required", i+1)
+		}
+		if keyword.Weight < 0 {
+			return nil, fmt.Errorf("keyword %q: weight must not be negative", keyword.Keyword)
+		}
+		if keyword.Weight == 0 {
+			keyword.Weight = 1
+		}
+		if keyword.Urutan == 0 {
+			keyword.Urutan = i + 1
+		}
+
+		seen := map[string]bool{strings.ToLower(keyword.Keyword): true}
+		synonyms := []string{}
+		for _, synonym := range keyword.Synonyms {
+			synonym = strings.TrimSpace(synonym)
+			if synonym == "" || seen[strings.ToLower(synonym)] {
+				continue
+			}
+			seen[strings.ToLower(synonym)] = true
+			synonyms = append(synonyms, synonym)
+		}
+		keyword.Synonyms = synonyms
+	}
+
+	if err := u.repo.ReplaceEssayKeywords(ctx, soalID, keywords); err != nil {
+		return nil, err
+	}
+	return keywords, nil
+}
+
+// GetEssayKeywords returns the keywords of an essay soal
+func (u *gradingUsecaseImpl) GetEssayKeywords(ctx context.Context, soalID int) ([]entity.EssayKeyword, error) {
+	if soalID <= 0 {
+		return nil, errors.New("id_soal is required")
+	}
+	return u.repo.ListEssayKeywords(ctx, soalID)
+}
+
+// GenerateScoreSuggestions scores the pending essays of an assignment against the keywords
+// and answer key of their soal. Answers to soal with neither are skipped.
+func (u *gradingUsecaseImpl) GenerateScoreSuggestions(ctx context.Context, lmsAssignmentID int64, soalID int) (*entity.EssaySuggestionRun, error) {
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+
+	essays, _, err := u.repo.ListPendingEssays(ctx, entity.PendingEssayFilter{LMSAssignmentID: lmsAssignmentID, SoalID: soalID}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &entity.EssaySuggestionRun{LMSAssignmentID: lmsAssignmentID, SoalID: soalID}
+	keywordsBySoal := map[int][]entity.EssayKeyword{}
+	var suggestions []entity.EssayScoreSuggestion
+	for _, essay := range essays {
+		answer := essay.Answer
+		keywords, ok := keywordsBySoal[answer.SoalID]
+		if !ok {
+			if keywords, err = u.repo.ListEssayKeywords(ctx, answer.SoalID); err != nil {
+				return nil, err
+			}
+			keywordsBySoal[answer.SoalID] = keywords
+		}
+
+		suggestion := u.suggestScore(ctx, answer, keywords)
+		if suggestion == nil {
+			run.SkippedNoKeyData++
+			continue
+		}
+		suggestions = append(suggestions, *suggestion)
+	}
+
+	if len(suggestions) > 0 {
+		if err := u.repo.UpsertScoreSuggestions(ctx, suggestions); err != nil {
+			return nil, err
+		}
+	}
+
+	run.AnswersScored = len(suggestions)
+	run.ComputedAt = time.Now()
+	return run, nil
+}
+
+// GetSuggestionAgreement reports how often teachers' final scores agree with the suggestions
+func (u *gradingUsecaseImpl) GetSuggestionAgreement(ctx context.Context, lmsAssignmentID int64, soalID int) (*entity.SuggestionAgreement, error) {
+	if lmsAssignmentID <= 0 && soalID <= 0 {
+		return nil, errors.New("lms_assignment_id or id_soal is required")
+	}
+	return u.repo.GetSuggestionAgreement(ctx, lmsAssignmentID, soalID, entity.SuggestionAgreementTolerance)
+}
+
+// suggestScore combines the weighted keyword coverage with the overlap between the answer
+// and the answer key into a 0-100 score. It returns nil when there is nothing to compare with.
+func (u *gradingUsecaseImpl) suggestScore(ctx context.Context, answer entity.EssayAnswerForGrading, keywords []entity.EssayKeyword) *entity.EssayScoreSuggestion {
+	hasKey := answer.JawabanEssayKey != nil && strings.TrimSpace(*answer.JawabanEssayKey) != ""
+	if len(keywords) == 0 && !hasKey {
+		return nil
+	}
+
+	suggestion := &entity.EssayScoreSuggestion{
+		IDJawaban:         answer.AnswerID,
+		IDSoal:            answer.SoalID,
+		KeywordMatches:    []entity.KeywordMatch{},
+		MissingKeywords:   []string{},
+		ReferencePassages: []entity.MatchedPassage{},
+	}
+	doc := u.pairHasher.NewDocument(answer.JawabanEssay)
+
+	if len(keywords) > 0 {
+		found, total := 0.0, 0.0
+		for _, keyword := range keywords {
+			total += keyword.Weight
+			matched := false
+			for _, term := range append([]string{keyword.Keyword}, keyword.Synonyms...) {
+				for _, occurrence := range doc.Find(term) {
+					matched = true
+					suggestion.KeywordMatches = append(suggestion.KeywordMatches, entity.KeywordMatch{
+						Keyword:     keyword.Keyword,
+						MatchedTerm: answer.JawabanEssay[occurrence.Start:occurrence.End],
+						Start:       occurrence.Start,
+						End:         occurrence.End,
+					})
+				}
+			}
+			if matched {
+				found += keyword.Weight
+			} else {
+				suggestion.MissingKeywords = append(suggestion.MissingKeywords, keyword.Keyword)
+			}
+		}
+		coverage := found / total
+		suggestion.KeywordCoverage = &coverage
+
+		sort.Slice(suggestion.KeywordMatches, func(i, j int) bool {
+			return suggestion.KeywordMatches[i].Start < suggestion.KeywordMatches[j].Start
+		})
+		if len(suggestion.KeywordMatches) > maxKeywordMatches {
+			suggestion.KeywordMatches = suggestion.KeywordMatches[:maxKeywordMatches]
+		}
+	}
+
+	if hasKey {
+		keyDoc := u.pairHasher.NewDocument(*answer.JawabanEssayKey)
+		result := textsim.Compare(keyDoc, doc)
+		overlap := result.Containment
+		suggestion.ReferenceOverlap = &overlap
+
+		// Passages are found from the key's side; store them from the answer's side for highlighting
+		for i, passage := range result.Passages {
+			if i == maxPassagesPerMatch {
+				break
+			}
+			suggestion.ReferencePassages = append(suggestion.ReferencePassages, entity.MatchedPassage{
+				Text:         answer.JawabanEssay[passage.MatchedStart:passage.MatchedEnd],
+				Start:        passage.MatchedStart,
+				End:          passage.MatchedEnd,
+				MatchedText:  keyDoc.Text[passage.Start:passage.End],
+				MatchedStart: passage.Start,
+				MatchedEnd:   passage.End,
+				Words:        passage.Words,
+			})
+		}
+	}
+
+	var fraction float64
+	switch {
+	case suggestion.KeywordCoverage != nil && suggestion.ReferenceOverlap != nil:
+		fraction = keywordShare*(*suggestion.KeywordCoverage) + (1-keywordShare)*referenceScore(*suggestion.ReferenceOverlap)
+	case suggestion.KeywordCoverage != nil:
+		fraction = *suggestion.KeywordCoverage
+	default:
+		fraction = referenceScore(*suggestion.ReferenceOverlap)
+	}
+	suggestion.SuggestedScore = math.Round(fraction * 100)
+	return suggestion
+}
+
+func referenceScore(overlap float64) float64 {
+	return math.Min(1, overlap/fullReferenceOverlap)
+}
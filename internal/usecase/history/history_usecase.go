@@ -1,9 +1,11 @@
 package history
 
 import (
+	"context"
+	"errors"
+
 	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/repository/history"
-	"errors"
 )
 
 // historyUsecaseImpl implements HistoryUsecase
@@ -17,7 +19,7 @@ func NewHistoryUsecase(repo history.HistoryRepository) HistoryUsecase {
 }
 
 // GetStudentHistory gets student history with aggregates
-func (u *historyUsecaseImpl) GetStudentHistory(userID int, tingkatan, idMataPelajaran *int, page, pageSize int) (*entity.StudentHistoryResponse, error) {
+func (u *historyUsecaseImpl) GetStudentHistory(ctx context.Context, userID int, tingkatan, idMataPelajaran *int, page, pageSize int) (*entity.StudentHistoryResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -25,7 +27,7 @@ func (u *historyUsecaseImpl) GetStudentHistory(userID int, tingkatan, idMataPela
 		pageSize = 20
 	}
 
-	histories, total, err := u.repo.GetStudentHistory(userID, tingkatan, idMataPelajaran, pageSize, (page-1)*pageSize)
+	histories, total, err := u.repo.GetStudentHistory(ctx, userID, tingkatan, idMataPelajaran, pageSize, (page-1)*pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +35,7 @@ func (u *historyUsecaseImpl) GetStudentHistory(userID int, tingkatan, idMataPela
 	// Get user from first completed test
 	var user *entity.User
 	if len(histories) > 0 {
-		if u, err := u.repo.GetUserFromSessionToken(histories[0].SessionToken); err == nil {
+		if u, err := u.repo.GetUserFromSessionToken(ctx, histories[0].SessionToken); err == nil {
 			user = u
 		}
 	}
@@ -61,24 +63,24 @@ func (u *historyUsecaseImpl) GetStudentHistory(userID int, tingkatan, idMataPela
 	}
 
 	response := &entity.StudentHistoryResponse{
-		User:              user,
-		Tingkatan:         tingkatan,
-		History:           histories,
-		RataRataNilai:     rataRataNilai,
+		User:               user,
+		Tingkatan:          tingkatan,
+		History:            histories,
+		RataRataNilai:      rataRataNilai,
 		TotalTestCompleted: totalCompleted,
-		Pagination:        *pagination,
+		Pagination:         *pagination,
 	}
 
 	return response, nil
 }
 
 // GetHistoryDetail gets detailed history for a session
-func (u *historyUsecaseImpl) GetHistoryDetail(sessionToken string) (*entity.HistoryDetailResponse, error) {
+func (u *historyUsecaseImpl) GetHistoryDetail(ctx context.Context, sessionToken string) (*entity.HistoryDetailResponse, error) {
 	if sessionToken == "" {
 		return nil, errors.New("session token cannot be empty")
 	}
 
-	session, answers, breakdowns, err := u.repo.GetHistoryDetail(sessionToken)
+	session, answers, breakdowns, err := u.repo.GetHistoryDetail(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +95,7 @@ func (u *historyUsecaseImpl) GetHistoryDetail(sessionToken string) (*entity.Hist
 }
 
 // ListStudentHistories lists all student histories with user info
-func (u *historyUsecaseImpl) ListStudentHistories(userID, tingkatan, idMataPelajaran *int, page, pageSize int) ([]entity.StudentHistoryWithUser, int, error) {
+func (u *historyUsecaseImpl) ListStudentHistories(ctx context.Context, userID, tingkatan, idMataPelajaran *int, scope *entity.TeacherScope, page, pageSize int) ([]entity.StudentHistoryWithUser, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -101,5 +103,5 @@ func (u *historyUsecaseImpl) ListStudentHistories(userID, tingkatan, idMataPelaj
 		pageSize = 20
 	}
 
-	return u.repo.ListStudentHistories(userID, tingkatan, idMataPelajaran, pageSize, (page-1)*pageSize)
-}
\ No newline at end of file
+	return u.repo.ListStudentHistories(ctx, userID, tingkatan, idMataPelajaran, scope, pageSize, (page-1)*pageSize)
+}
@@ -1,12 +1,14 @@
 package history
 
 import (
+	"context"
+
 	"cbt-test-mini-project/internal/entity"
 )
 
 // HistoryUsecase defines the interface for History usecase operations
 type HistoryUsecase interface {
-	GetStudentHistory(userID int, tingkatan, idMataPelajaran *int, page, pageSize int) (*entity.StudentHistoryResponse, error)
-	GetHistoryDetail(sessionToken string) (*entity.HistoryDetailResponse, error)
-	ListStudentHistories(userID, tingkatan, idMataPelajaran *int, page, pageSize int) ([]entity.StudentHistoryWithUser, int, error)
-}
\ No newline at end of file
+	GetStudentHistory(ctx context.Context, userID int, tingkatan, idMataPelajaran *int, page, pageSize int) (*entity.StudentHistoryResponse, error)
+	GetHistoryDetail(ctx context.Context, sessionToken string) (*entity.HistoryDetailResponse, error)
+	ListStudentHistories(ctx context.Context, userID, tingkatan, idMataPelajaran *int, scope *entity.TeacherScope, page, pageSize int) ([]entity.StudentHistoryWithUser, int, error)
+}
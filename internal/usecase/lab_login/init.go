@@ -0,0 +1,22 @@
+package lab_login
+
+import (
+	"context"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+)
+
+// LabLoginUsecase defines the interface for QR-card and PIN logins in exam labs
+type LabLoginUsecase interface {
+	// IssueCredentials replaces the credentials of the assignment's open sessions (only those
+	// of userIDs when given); the PINs and QR codes are only part of this result
+	IssueCredentials(ctx context.Context, lmsAssignmentID int64, userIDs []int, validMinutes int, scope *entity.TeacherScope, issuedBy int) ([]entity.IssuedLabCredential, error)
+
+	// RevokeCredentials revokes credentials and ends the lab logins made with them
+	RevokeCredentials(ctx context.Context, lmsAssignmentID int64, userIDs []int, scope *entity.TeacherScope) (int, error)
+
+	// LabLogin consumes a credential, by QR code or by assignment and PIN, and returns a token
+	// limited to its test session
+	LabLogin(ctx context.Context, qrCode string, lmsAssignmentID int64, pin string) (*base.User, *entity.LabLoginResult, error)
+}
@@ -0,0 +1,306 @@
+package lab_login
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/auth"
+	"cbt-test-mini-project/internal/repository/auth_session"
+	"cbt-test-mini-project/internal/repository/lab_login"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/localauth"
+	"cbt-test-mini-project/util/ratelimit"
+	"cbt-test-mini-project/util/tenant"
+)
+
+const (
+	// With 8 digits a client address guessing at its limit for a whole school day has about
+	// a 1 in 1000 chance of hitting one of 40 students' PINs
+	pinLength = 8
+	// Credentials last a school day unless valid_minutes says otherwise
+	defaultValidMinutes = 12 * 60
+	maxValidMinutes     = 7 * 24 * 60
+	// Wrong PINs allowed per assignment within pinFailureWindow, from one client address and
+	// from one device behind it. A lab shares its address, so that limit is the larger one;
+	// cycling device ids does not get past it.
+	maxDevicePinFailures = 10
+	maxSourcePinFailures = 50
+	pinFailureWindow     = 15 * time.Minute
+)
+
+// labLoginUsecaseImpl implements LabLoginUsecase
+type labLoginUsecaseImpl struct {
+	repo     lab_login.LabLoginRepository
+	users    auth.AuthRepository
+	sessions auth_session.AuthSessionRepository
+	config   *config.Main
+	// failures counts wrong PINs; fallback counts them while failures is unavailable
+	failures ratelimit.Store
+	fallback *ratelimit.MemoryStore
+}
+
+// NewLabLoginUsecase creates a new LabLoginUsecase instance. Wrong PINs are counted in
+// failures, the shared rate limit store, so every replica throttles the same clients; without
+// it they are counted per replica.
+func NewLabLoginUsecase(repo lab_login.LabLoginRepository, users auth.AuthRepository, sessions auth_session.AuthSessionRepository, config *config.Main, failures ratelimit.Store) LabLoginUsecase {
+	fallback := ratelimit.NewMemoryStore()
+	if failures == nil {
+		failures = fallback
+	}
+	return &labLoginUsecaseImpl{repo: repo, users: users, sessions: sessions, config: config, failures: failures, fallback: fallback}
+}
+
+// IssueCredentials creates one credential per open session with a PIN unique among the
+// assignment's usable credentials
+func (u *labLoginUsecaseImpl) IssueCredentials(ctx context.Context, lmsAssignmentID int64, userIDs []int, validMinutes int, scope *entity.TeacherScope, issuedBy int) ([]entity.IssuedLabCredential, error) {
+	if !u.config.JWT.LabLoginEnabled {
+		return nil, entity.ErrLabLoginDisabled
+	}
+	if lmsAssignmentID <= 0 {
+		return nil, errors.New("lms_assignment_id is required")
+	}
+	if validMinutes < 0 || validMinutes > maxValidMinutes {
+		return nil, fmt.Errorf("valid_minutes must be between 1 and %d", maxValidMinutes)
+	}
+	if validMinutes == 0 {
+		validMinutes = defaultValidMinutes
+	}
+
+	sessions, err := u.repo.ListOpenSessions(ctx, lmsAssignmentID, userIDs, scope)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, errors.New("no scheduled or ongoing sessions found for this assignment")
+	}
+
+	takenPins, err := u.repo.ListActivePinHashes(ctx, lmsAssignmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(time.Duration(validMinutes) * time.Minute)
+	issued := make([]entity.IssuedLabCredential, len(sessions))
+	credentials := make([]*entity.LabCredential, len(sessions))
+	nonces := make([]string, len(sessions))
+	for i, session := range sessions {
+		pin, pinHash, err := u.newUniquePin(lmsAssignmentID, takenPins)
+		if err != nil {
+			return nil, err
+		}
+		nonce, err := localauth.NewLabNonce()
+		if err != nil {
+			return nil, err
+		}
+
+		issued[i] = entity.IssuedLabCredential{
+			LabCredential: entity.LabCredential{
+				TestSessionID:   session.TestSessionID,
+				UserID:          session.UserID,
+				LMSAssignmentID: lmsAssignmentID,
+				SchoolID:        session.SchoolID,
+				PinHash:         pinHash,
+				QRNonceHash:     localauth.HashLabSecret(u.config.JWT.Secret, nonce),
+				ExpiresAt:       expiresAt,
+				CreatedBy:       issuedBy,
+			},
+			NamaPeserta: session.NamaPeserta,
+			PIN:         pin,
+		}
+		credentials[i] = &issued[i].LabCredential
+		nonces[i] = nonce
+	}
+
+	if err := u.repo.CreateCredentials(ctx, credentials); err != nil {
+		return nil, fmt.Errorf("failed to store lab credentials: %w", err)
+	}
+	for i := range issued {
+		issued[i].QRCode = localauth.SignLabCode(u.config.JWT.Secret, issued[i].ID, nonces[i])
+	}
+	return issued, nil
+}
+
+// RevokeCredentials revokes credentials and ends the lab logins made with them
+func (u *labLoginUsecaseImpl) RevokeCredentials(ctx context.Context, lmsAssignmentID int64, userIDs []int, scope *entity.TeacherScope) (int, error) {
+	if lmsAssignmentID <= 0 {
+		return 0, errors.New("lms_assignment_id is required")
+	}
+	return u.repo.RevokeCredentials(ctx, lmsAssignmentID, userIDs, scope)
+}
+
+// LabLogin runs before any tenant is known, so credentials are looked up across schools and
+// the token is scoped to the school of the credential.
+func (u *labLoginUsecaseImpl) LabLogin(ctx context.Context, qrCode string, lmsAssignmentID int64, pin string) (*base.User, *entity.LabLoginResult, error) {
+	if !u.config.JWT.LabLoginEnabled {
+		return nil, nil, entity.ErrLabLoginDisabled
+	}
+	ctx = tenant.System(ctx)
+
+	credential, err := u.findCredential(ctx, strings.TrimSpace(qrCode), lmsAssignmentID, strings.TrimSpace(pin))
+	if err != nil {
+		return nil, nil, err
+	}
+	if credential == nil || !credential.IsUsable(time.Now()) {
+		return nil, nil, entity.ErrInvalidLabCredential
+	}
+
+	session, err := u.repo.GetSession(ctx, credential.TestSessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session == nil || (session.Status != entity.TestStatusScheduled && session.Status != entity.TestStatusOngoing) {
+		return nil, nil, entity.ErrLabSessionClosed
+	}
+
+	user, err := u.users.GetUserByID(ctx, int32(credential.UserID))
+	if err != nil || !user.IsActive {
+		return nil, nil, entity.ErrInvalidLabCredential
+	}
+
+	consumed, err := u.repo.ConsumeCredential(ctx, credential.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !consumed {
+		return nil, nil, entity.ErrInvalidLabCredential
+	}
+
+	authSession := entity.NewAuthSession(user.Id, interceptor.GetDeviceInfoFromContext(ctx))
+	authSession.SchoolID = credential.SchoolID
+	if err := u.sessions.CreateSession(ctx, authSession, "", time.Time{}); err != nil {
+		return nil, nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	if err := u.repo.SetAuthSession(ctx, credential.ID, authSession.ID); err != nil {
+		return nil, nil, err
+	}
+
+	var schoolID int64
+	if credential.SchoolID != nil {
+		schoolID = *credential.SchoolID
+	}
+	claims := localauth.Claims{
+		UserID:           user.Id,
+		LMSSchoolID:      schoolID,
+		Email:            user.Email,
+		FullName:         user.Nama,
+		Role:             interceptor.RoleNameFromProto(user.Role),
+		SessionID:        authSession.ID,
+		ExamSessionToken: session.SessionToken,
+	}
+	ttl := time.Duration(u.config.JWT.LabTokenTTL) * time.Minute
+	token, expiresAt, err := localauth.SignAccessToken(u.config.JWT.Secret, claims, ttl, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, &entity.LabLoginResult{
+		AccessToken:   token,
+		ExpiresAt:     expiresAt,
+		SessionToken:  session.SessionToken,
+		AuthSessionID: authSession.ID,
+	}, nil
+}
+
+// findCredential resolves a QR code, or else a PIN of the assignment. Wrong PINs count
+// towards the throttle of the client address and of the device.
+func (u *labLoginUsecaseImpl) findCredential(ctx context.Context, qrCode string, lmsAssignmentID int64, pin string) (*entity.LabCredential, error) {
+	if qrCode != "" {
+		id, nonce, err := localauth.ParseLabCode(u.config.JWT.Secret, qrCode)
+		if err != nil {
+			return nil, entity.ErrInvalidLabCredential
+		}
+		credential, err := u.repo.GetCredential(ctx, id)
+		if err != nil || credential == nil {
+			return nil, entity.ErrInvalidLabCredential
+		}
+		if !hmac.Equal([]byte(credential.QRNonceHash), []byte(localauth.HashLabSecret(u.config.JWT.Secret, nonce))) {
+			return nil, entity.ErrInvalidLabCredential
+		}
+		return credential, nil
+	}
+
+	if lmsAssignmentID <= 0 || pin == "" {
+		return nil, errors.New("qr_code, or lms_assignment_id and pin, are required")
+	}
+	device := interceptor.GetDeviceInfoFromContext(ctx)
+	limits := pinFailureLimits(lmsAssignmentID, device.IPAddress, device.DeviceID)
+	for _, limit := range limits {
+		if u.pinFailures(ctx, limit.key) >= limit.max {
+			return nil, entity.ErrTooManyPinAttempts
+		}
+	}
+	credential, err := u.repo.GetCredentialByPin(ctx, lmsAssignmentID, u.pinHash(lmsAssignmentID, pin))
+	if err != nil {
+		return nil, err
+	}
+	if credential == nil {
+		for _, limit := range limits {
+			u.addPinFailure(ctx, limit.key, limit.max)
+		}
+		return nil, entity.ErrInvalidLabCredential
+	}
+	return credential, nil
+}
+
+type pinFailureLimit struct {
+	key string
+	max int
+}
+
+// pinFailureLimits are the counters a wrong PIN for the assignment adds to. The client IP
+// is the one NetworkAccessMiddleware resolved, which reads X-Forwarded-For only from trusted
+// proxies; the device id is the client's own, so it only splits the address's allowance.
+// There is no limit for the whole assignment, which any one client could exhaust.
+func pinFailureLimits(lmsAssignmentID int64, clientIP, deviceID string) []pinFailureLimit {
+	return []pinFailureLimit{
+		{key: fmt.Sprintf("lab_pin:%d:ip:%s", lmsAssignmentID, clientIP), max: maxSourcePinFailures},
+		{key: fmt.Sprintf("lab_pin:%d:ip:%s:device:%s", lmsAssignmentID, clientIP, deviceID), max: maxDevicePinFailures},
+	}
+}
+
+// pinFailures reads a wrong-PIN counter. A store error falls back to the counters of this
+// replica, as do addPinFailure's.
+func (u *labLoginUsecaseImpl) pinFailures(ctx context.Context, key string) int {
+	used, err := u.failures.Used(ctx, key, pinFailureWindow)
+	if err != nil {
+		slog.Warn("Rate limit store unavailable, counting wrong PINs locally", "error", err)
+		used, _ = u.fallback.Used(ctx, key, pinFailureWindow)
+	}
+	return used
+}
+
+// addPinFailure counts a wrong PIN; a counter already at limit stays there
+func (u *labLoginUsecaseImpl) addPinFailure(ctx context.Context, key string, limit int) {
+	if _, err := u.failures.Allow(ctx, key, limit, 1, pinFailureWindow); err != nil {
+		slog.Warn("Rate limit store unavailable, counting wrong PINs locally", "error", err)
+		_, _ = u.fallback.Allow(ctx, key, limit, 1, pinFailureWindow)
+	}
+}
+
+// newUniquePin draws PINs until one is not taken in the assignment, and marks it taken
+func (u *labLoginUsecaseImpl) newUniquePin(lmsAssignmentID int64, taken map[string]bool) (string, string, error) {
+	for attempt := 0; attempt < 100; attempt++ {
+		pin, err := localauth.NewPIN(pinLength)
+		if err != nil {
+			return "", "", err
+		}
+		hash := u.pinHash(lmsAssignmentID, pin)
+		if !taken[hash] {
+			taken[hash] = true
+			return pin, hash, nil
+		}
+	}
+	return "", "", errors.New("could not generate a unique PIN")
+}
+
+// pinHash binds a PIN to its assignment, so equal PINs of different exams never match
+func (u *labLoginUsecaseImpl) pinHash(lmsAssignmentID int64, pin string) string {
+	return localauth.HashLabSecret(u.config.JWT.Secret, fmt.Sprintf("%d:%s", lmsAssignmentID, pin))
+}
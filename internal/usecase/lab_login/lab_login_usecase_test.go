@@ -0,0 +1,95 @@
+package lab_login_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/internal/entity"
+	labloginrepo "cbt-test-mini-project/internal/repository/lab_login"
+	"cbt-test-mini-project/internal/usecase/lab_login"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/ratelimit"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeLabLoginRepo knows no PIN, so every PIN is wrong
+type fakeLabLoginRepo struct {
+	labloginrepo.LabLoginRepository
+	lookups int
+}
+
+func (r *fakeLabLoginRepo) GetCredentialByPin(ctx context.Context, lmsAssignmentID int64, pinHash string) (*entity.LabCredential, error) {
+	r.lookups++
+	return nil, nil
+}
+
+func newLabLogin(repo *fakeLabLoginRepo, store ratelimit.Store) lab_login.LabLoginUsecase {
+	cfg := &config.Main{}
+	cfg.JWT.LabLoginEnabled = true
+	cfg.JWT.Secret = "test-secret"
+	return lab_login.NewLabLoginUsecase(repo, nil, nil, cfg, store)
+}
+
+// fromDevice is a call whose client IP NetworkAccessMiddleware resolved to ip
+func fromDevice(deviceID, ip string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-device-id", deviceID))
+	return interceptor.AddClientIPToContext(ctx, ip)
+}
+
+func TestLabLogin_WrongPinsThrottlePerDevice(t *testing.T) {
+	store := ratelimit.NewMemoryStore()
+	repo := &fakeLabLoginRepo{}
+	// Two replicas sharing one store
+	replicas := []lab_login.LabLoginUsecase{newLabLogin(repo, store), newLabLogin(repo, store)}
+
+	for i := 0; i < 10; i++ {
+		_, _, err := replicas[i%2].LabLogin(fromDevice("pc-01", "10.0.0.5"), "", 42, "00000000")
+		assert.ErrorIs(t, err, entity.ErrInvalidLabCredential)
+	}
+
+	_, _, err := replicas[0].LabLogin(fromDevice("pc-01", "10.0.0.5"), "", 42, "00000000")
+	assert.ErrorIs(t, err, entity.ErrTooManyPinAttempts)
+	assert.Equal(t, 10, repo.lookups, "a throttled device does not get to try its PIN")
+
+	_, _, err = replicas[1].LabLogin(fromDevice("pc-02", "10.0.0.5"), "", 42, "00000000")
+	assert.ErrorIs(t, err, entity.ErrInvalidLabCredential, "another device behind the same lab IP is not throttled")
+
+	_, _, err = replicas[1].LabLogin(fromDevice("pc-01", "10.0.0.5"), "", 43, "00000000")
+	assert.ErrorIs(t, err, entity.ErrInvalidLabCredential, "the throttle is per assignment")
+}
+
+func TestLabLogin_WrongPinsThrottlePerClientAddress(t *testing.T) {
+	repo := &fakeLabLoginRepo{}
+	uc := newLabLogin(repo, nil)
+
+	for i := 0; i < 50; i++ {
+		_, _, err := uc.LabLogin(fromDevice(fmt.Sprintf("device-%d", i), "10.0.0.5"), "", 42, "00000000")
+		assert.ErrorIs(t, err, entity.ErrInvalidLabCredential)
+	}
+
+	_, _, err := uc.LabLogin(fromDevice("fresh-device", "10.0.0.5"), "", 42, "00000000")
+	assert.ErrorIs(t, err, entity.ErrTooManyPinAttempts, "cycling device ids does not get around the address limit")
+
+	_, _, err = uc.LabLogin(fromDevice("fresh-device", "10.0.0.9"), "", 42, "00000000")
+	assert.ErrorIs(t, err, entity.ErrInvalidLabCredential, "other clients of the assignment can still log in")
+}
+
+func TestLabLogin_ForwardedForIsNotTheClientAddress(t *testing.T) {
+	repo := &fakeLabLoginRepo{}
+	uc := newLabLogin(repo, nil)
+
+	// Without NetworkAccessMiddleware vouching for it, a spoofed X-Forwarded-For is ignored
+	for i := 0; i < 50; i++ {
+		md := metadata.Pairs("x-device-id", fmt.Sprintf("device-%d", i), "x-forwarded-for", fmt.Sprintf("10.1.0.%d", i))
+		_, _, err := uc.LabLogin(metadata.NewIncomingContext(context.Background(), md), "", 42, "00000000")
+		assert.ErrorIs(t, err, entity.ErrInvalidLabCredential)
+	}
+
+	md := metadata.Pairs("x-device-id", "fresh-device", "x-forwarded-for", "10.1.0.200")
+	_, _, err := uc.LabLogin(metadata.NewIncomingContext(context.Background(), md), "", 42, "00000000")
+	assert.ErrorIs(t, err, entity.ErrTooManyPinAttempts)
+}
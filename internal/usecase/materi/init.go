@@ -1,14 +1,19 @@
 package materi
 
 import (
+	"context"
+
 	"cbt-test-mini-project/internal/entity"
 )
 
 // MateriUsecase defines the interface for Materi usecase operations
 type MateriUsecase interface {
-	CreateMateri(idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, ownerUserID int, schoolID int64, labels []string, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error)
-	GetMateri(id int) (*entity.Materi, error)
-	UpdateMateri(id, idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error)
-	DeleteMateri(id int) error
-	ListMateri(idMataPelajaran, idTingkat int, page, pageSize int) ([]entity.Materi, *entity.PaginationResponse, error)
+	CreateMateri(ctx context.Context, idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, ownerUserID int, schoolID int64, labels []string, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error)
+	GetMateri(ctx context.Context, id int) (*entity.Materi, error)
+	UpdateMateri(ctx context.Context, id, idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error)
+	DeleteMateri(ctx context.Context, id int) error
+	ListMateri(ctx context.Context, idMataPelajaran, idTingkat int, scope *entity.TeacherScope, page, pageSize int) ([]entity.Materi, *entity.PaginationResponse, error)
+	ShareMateri(ctx context.Context, idMateri, userID int, permission entity.MateriPermission, grantedBy int) (*entity.MateriGrant, error)
+	RevokeMateriShare(ctx context.Context, idMateri, userID int) error
+	ListMateriShares(ctx context.Context, idMateri int) ([]entity.MateriGrant, error)
 }
@@ -1,9 +1,12 @@
 package materi
 
 import (
+	"context"
+	"errors"
+
 	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/repository/materi"
-	"errors"
+	"cbt-test-mini-project/util/audit"
 )
 
 // materiUsecaseImpl implements MateriUsecase
@@ -17,7 +20,7 @@ func NewMateriUsecase(repo materi.MateriRepository) MateriUsecase {
 }
 
 // CreateMateri creates a new materi
-func (u *materiUsecaseImpl) CreateMateri(idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, ownerUserID int, schoolID int64, labels []string, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error) {
+func (u *materiUsecaseImpl) CreateMateri(ctx context.Context, idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, ownerUserID int, schoolID int64, labels []string, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error) {
 	if nama == "" {
 		return nil, errors.New("nama cannot be empty")
 	}
@@ -66,20 +69,20 @@ func (u *materiUsecaseImpl) CreateMateri(idMataPelajaran int, nama string, idTin
 		LmsBookID:            lmsBookID,
 		LmsTeacherMaterialID: lmsTeacherMaterialID,
 	}
-	err := u.repo.Create(m)
+	err := u.repo.Create(ctx, m)
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.GetByID(m.ID) // To preload
+	return u.repo.GetByID(ctx, m.ID) // To preload
 }
 
 // GetMateri gets by ID
-func (u *materiUsecaseImpl) GetMateri(id int) (*entity.Materi, error) {
-	return u.repo.GetByID(id)
+func (u *materiUsecaseImpl) GetMateri(ctx context.Context, id int) (*entity.Materi, error) {
+	return u.repo.GetByID(ctx, id)
 }
 
 // UpdateMateri updates existing
-func (u *materiUsecaseImpl) UpdateMateri(id, idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error) {
+func (u *materiUsecaseImpl) UpdateMateri(ctx context.Context, id, idMataPelajaran int, nama string, idTingkat int, isActive bool, defaultDurasiMenit, defaultJumlahSoal int, randomizeQuestions bool, lmsModuleID, lmsBookID, lmsTeacherMaterialID *int64) (*entity.Materi, error) {
 	if nama == "" {
 		return nil, errors.New("nama cannot be empty")
 	}
@@ -110,10 +113,11 @@ func (u *materiUsecaseImpl) UpdateMateri(id, idMataPelajaran int, nama string, i
 		return nil, errors.New("only one of lms_module_id, lms_book_id, or lms_teacher_material_id can be set")
 	}
 
-	m, err := u.repo.GetByID(id)
+	m, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	before := *m
 
 	m.IDMataPelajaran = idMataPelajaran
 	m.IDTingkat = idTingkat
@@ -130,25 +134,35 @@ func (u *materiUsecaseImpl) UpdateMateri(id, idMataPelajaran int, nama string, i
 	m.MataPelajaran = entity.MataPelajaran{}
 	m.Tingkat = entity.Tingkat{}
 
-	err = u.repo.Update(m)
+	err = u.repo.Update(ctx, m)
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.GetByID(m.ID)
+	updated, err := u.repo.GetByID(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	audit.Record(ctx, entity.AuditResourceMateri, m.ID, &before, updated)
+	return updated, nil
 }
 
 // DeleteMateri soft deletes by setting is_active = false
-func (u *materiUsecaseImpl) DeleteMateri(id int) error {
-	m, err := u.repo.GetByID(id)
+func (u *materiUsecaseImpl) DeleteMateri(ctx context.Context, id int) error {
+	m, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	before := *m
 	m.IsActive = false
-	return u.repo.Update(m)
+	if err := u.repo.Update(ctx, m); err != nil {
+		return err
+	}
+	audit.Record(ctx, entity.AuditResourceMateri, id, &before, m)
+	return nil
 }
 
 // ListMateri lists with filters and pagination
-func (u *materiUsecaseImpl) ListMateri(idMataPelajaran, idTingkat int, page, pageSize int) ([]entity.Materi, *entity.PaginationResponse, error) {
+func (u *materiUsecaseImpl) ListMateri(ctx context.Context, idMataPelajaran, idTingkat int, scope *entity.TeacherScope, page, pageSize int) ([]entity.Materi, *entity.PaginationResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -168,7 +182,7 @@ func (u *materiUsecaseImpl) ListMateri(idMataPelajaran, idTingkat int, page, pag
 	if idTingkat > 0 {
 		tingPtr = &idTingkat
 	}
-	materis, total, err := u.repo.List(idPtr, tingPtr, pageSize, offset)
+	materis, total, err := u.repo.List(ctx, idPtr, tingPtr, scope, pageSize, offset)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -183,3 +197,47 @@ func (u *materiUsecaseImpl) ListMateri(idMataPelajaran, idTingkat int, page, pag
 
 	return materis, pagination, nil
 }
+
+// ShareMateri grants another teacher view or edit access to a materi
+func (u *materiUsecaseImpl) ShareMateri(ctx context.Context, idMateri, userID int, permission entity.MateriPermission, grantedBy int) (*entity.MateriGrant, error) {
+	if userID < 1 {
+		return nil, errors.New("user_id must be positive")
+	}
+	if !permission.IsGrantable() {
+		return nil, errors.New("permission must be view or edit")
+	}
+	m, err := u.repo.GetByID(ctx, idMateri)
+	if err != nil {
+		return nil, err
+	}
+	if m.OwnerUserID != nil && *m.OwnerUserID == userID {
+		return nil, errors.New("materi is already owned by this user")
+	}
+
+	grant := &entity.MateriGrant{
+		IDMateri:   idMateri,
+		UserID:     userID,
+		Permission: permission,
+		GrantedBy:  grantedBy,
+	}
+	if err := u.repo.UpsertGrant(ctx, grant); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+// RevokeMateriShare removes a teacher's grant on a materi
+func (u *materiUsecaseImpl) RevokeMateriShare(ctx context.Context, idMateri, userID int) error {
+	if userID < 1 {
+		return errors.New("user_id must be positive")
+	}
+	return u.repo.DeleteGrant(ctx, idMateri, userID)
+}
+
+// ListMateriShares lists the teachers a materi is shared with
+func (u *materiUsecaseImpl) ListMateriShares(ctx context.Context, idMateri int) ([]entity.MateriGrant, error) {
+	if _, err := u.repo.GetByID(ctx, idMateri); err != nil {
+		return nil, err
+	}
+	return u.repo.ListGrants(ctx, idMateri)
+}
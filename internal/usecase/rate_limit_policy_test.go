@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository"
+	"cbt-test-mini-project/internal/usecase"
+	"cbt-test-mini-project/util/tenant"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakePolicyRepo counts the writes that reach the repository
+type fakePolicyRepo struct {
+	repository.RateLimitPolicyRepository
+	writes int
+}
+
+func (r *fakePolicyRepo) SavePolicy(ctx context.Context, policy *entity.RateLimitPolicy) error {
+	r.writes++
+	return nil
+}
+
+func (r *fakePolicyRepo) DeletePolicy(ctx context.Context, id int) error {
+	r.writes++
+	return nil
+}
+
+func (r *fakePolicyRepo) SetMethodGroup(ctx context.Context, method, group string) error {
+	r.writes++
+	return nil
+}
+
+func (r *fakePolicyRepo) SetSchoolPlan(ctx context.Context, schoolID int64, plan string) error {
+	r.writes++
+	return nil
+}
+
+func TestRateLimitPolicyWrites_RequireCrossTenant(t *testing.T) {
+	writes := map[string]func(uc usecase.UserLimitUsecase, ctx context.Context) error{
+		"save policy": func(uc usecase.UserLimitUsecase, ctx context.Context) error {
+			return uc.SaveRateLimitPolicy(ctx, &entity.RateLimitPolicy{LimitType: entity.LimitTypeAPIRequestsPerHour})
+		},
+		"delete policy": func(uc usecase.UserLimitUsecase, ctx context.Context) error {
+			return uc.DeleteRateLimitPolicy(ctx, 1)
+		},
+		"set method group": func(uc usecase.UserLimitUsecase, ctx context.Context) error {
+			return uc.SetRateLimitMethodGroup(ctx, "/base.SoalService/*", "authoring")
+		},
+		"set school plan": func(uc usecase.UserLimitUsecase, ctx context.Context) error {
+			return uc.SetSchoolPlan(ctx, 5, "premium")
+		},
+	}
+	callers := []struct {
+		name    string
+		ctx     context.Context
+		allowed bool
+	}{
+		{name: "superadmin", ctx: tenant.WithTenant(context.Background(), tenant.Tenant{CrossTenant: true}), allowed: true},
+		{name: "school admin", ctx: tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 5})},
+		{name: "no tenant", ctx: context.Background()},
+	}
+
+	for name, write := range writes {
+		for _, caller := range callers {
+			t.Run(name+"/"+caller.name, func(t *testing.T) {
+				repo := &fakePolicyRepo{}
+				uc := usecase.NewUserLimitUsecase(nil, repo, nil)
+
+				err := write(uc, caller.ctx)
+				if caller.allowed {
+					assert.NoError(t, err)
+					assert.Equal(t, 1, repo.writes)
+					return
+				}
+				assert.ErrorIs(t, err, entity.ErrRateLimitPolicyCrossTenant)
+				assert.Zero(t, repo.writes)
+			})
+		}
+	}
+}
@@ -1,19 +1,24 @@
 package soal
 
 import (
+	"context"
+
 	"cbt-test-mini-project/internal/entity"
 )
 
 // SoalUsecase defines the interface for Soal usecase operations
 type SoalUsecase interface {
-	CreateSoal(idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, imageFilesBytes [][]byte) (*entity.Soal, error)
-	GetSoal(id int) (*entity.Soal, error)
-	UpdateSoal(id, idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, imageFilesBytes [][]byte) (*entity.Soal, error)
-	DeleteSoal(id int) error
-	ListSoal(idMateri, tingkatan, idMataPelajaran int, page, pageSize int) ([]entity.Soal, *entity.PaginationResponse, error)
-	ReorderSoal(idMateri int, urutanByID map[int]int) error
-	UploadImageToSoal(idSoal int, imageBytes []byte, namaFile string, urutan int, keterangan *string) (*entity.SoalGambar, error)
-	DeleteImageFromSoal(idGambar int) error
-	UpdateImageInSoal(idGambar int, urutan int, keterangan *string) error
-	GetQuestionCountsByTopic() (map[int]int, error)
-}
\ No newline at end of file
+	CreateSoal(ctx context.Context, idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, formatKonten entity.FormatKonten, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, shortAnswerBlanks []entity.ShortAnswerBlank, numericKey *entity.NumericAnswerKey, hotspotKey *entity.HotspotAnswerKey, gridKey *entity.GridAnswerKey, opsi []string, imageFilesBytes [][]byte) (*entity.Soal, error)
+	GetSoal(ctx context.Context, id int) (*entity.Soal, error)
+	UpdateSoal(ctx context.Context, id, idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, formatKonten entity.FormatKonten, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, shortAnswerBlanks []entity.ShortAnswerBlank, numericKey *entity.NumericAnswerKey, hotspotKey *entity.HotspotAnswerKey, gridKey *entity.GridAnswerKey, opsi []string, imageFilesBytes [][]byte) (*entity.Soal, error)
+	DeleteSoal(ctx context.Context, id int) error
+	ListSoal(ctx context.Context, idMateri, tingkatan, idMataPelajaran int, scope *entity.TeacherScope, page, pageSize int) ([]entity.Soal, *entity.PaginationResponse, error)
+	ReorderSoal(ctx context.Context, idMateri int, urutanByID map[int]int) error
+	UploadImageToSoal(ctx context.Context, idSoal int, imageBytes []byte, namaFile string, urutan int, keterangan *string) (*entity.SoalGambar, error)
+	DeleteImageFromSoal(ctx context.Context, idGambar int) error
+	UpdateImageInSoal(ctx context.Context, idGambar int, urutan int, keterangan *string) error
+	UploadMediaToSoal(ctx context.Context, idSoal int, mediaBytes []byte, namaFile string, jenis entity.MediaJenis, durasiDetik float64, maxPutar, urutan int, keterangan *string) (*entity.SoalMedia, error)
+	DeleteMediaFromSoal(ctx context.Context, idMedia int) error
+	UpdateMediaInSoal(ctx context.Context, idMedia, urutan, maxPutar int, durasiDetik float64, keterangan *string) error
+	GetQuestionCountsByTopic(ctx context.Context) (map[int]int, error)
+}
@@ -2,16 +2,20 @@ package soal
 
 import (
 	"bytes"
-	"cbt-test-mini-project/init/config"
-	"cbt-test-mini-project/internal/entity"
-	"cbt-test-mini-project/internal/repository/test_soal"
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
 	"time"
 
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/test_soal"
+	"cbt-test-mini-project/util/audit"
+	"cbt-test-mini-project/util/richtext"
+
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 )
@@ -28,7 +32,7 @@ func NewSoalUsecase(repo test_soal.SoalRepository, config *config.Main) SoalUsec
 }
 
 func normalizeQuestionType(questionType entity.QuestionType, pembahasan string) entity.QuestionType {
-	if questionType == entity.QuestionTypeMultipleChoice || questionType == entity.QuestionTypeEssay || questionType == entity.QuestionTypeMultipleChoicesComplex {
+	if questionType == entity.QuestionTypeMultipleChoice || questionType == entity.QuestionTypeEssay || questionType == entity.QuestionTypeMultipleChoicesComplex || questionType == entity.QuestionTypeShortAnswer || questionType == entity.QuestionTypeNumeric || questionType == entity.QuestionTypeHotspot || questionType == entity.QuestionTypeGrid {
 		return questionType
 	}
 	if strings.HasPrefix(strings.TrimSpace(pembahasan), "[ESSAY]") {
@@ -37,13 +41,64 @@ func normalizeQuestionType(questionType entity.QuestionType, pembahasan string)
 	return entity.QuestionTypeMultipleChoice
 }
 
-func validateComplexOptions(options []entity.JawabanOption) error {
+// resolveOpsi labels the options of a choice question. When opsi is given it replaces the
+// legacy opsi_a..opsi_d fields, so a question can have between MinSoalOpsi and MaxSoalOpsi options.
+func resolveOpsi(opsiA, opsiB, opsiC, opsiD string, opsi []string) ([]entity.SoalOpsi, error) {
+	texts := opsi
+	if len(texts) == 0 {
+		if opsiA == "" || opsiB == "" || opsiC == "" || opsiD == "" {
+			return nil, errors.New("all fields must be filled")
+		}
+		texts = []string{opsiA, opsiB, opsiC, opsiD}
+	}
+	if len(texts) < entity.MinSoalOpsi || len(texts) > entity.MaxSoalOpsi {
+		return nil, fmt.Errorf("a question must have between %d and %d options", entity.MinSoalOpsi, entity.MaxSoalOpsi)
+	}
+	for i, teks := range texts {
+		if strings.TrimSpace(teks) == "" {
+			return nil, fmt.Errorf("opsi %s must be filled", entity.OpsiLabel(i))
+		}
+	}
+	return entity.BuildSoalOpsi(texts), nil
+}
+
+// validateKonten checks that pertanyaan, the option texts and pembahasan are well-formed
+// in the content format, e.g. that every LaTeX formula is closed, and returns the format
+func validateKonten(formatKonten entity.FormatKonten, pertanyaan, pembahasan string, opsi []entity.SoalOpsi) (entity.FormatKonten, error) {
+	format, err := richtext.ParseFormat(string(formatKonten))
+	if err != nil {
+		return "", err
+	}
+	if err := richtext.Validate(format, pertanyaan); err != nil {
+		return "", fmt.Errorf("pertanyaan: %v", err)
+	}
+	for _, o := range opsi {
+		if err := richtext.Validate(format, o.Teks); err != nil {
+			return "", fmt.Errorf("opsi %s: %v", o.Label, err)
+		}
+	}
+	if err := richtext.Validate(format, pembahasan); err != nil {
+		return "", fmt.Errorf("pembahasan: %v", err)
+	}
+	return entity.FormatKonten(format), nil
+}
+
+func hasOpsi(opsi []entity.SoalOpsi, label entity.JawabanOption) bool {
+	for _, o := range opsi {
+		if o.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+func validateComplexOptions(options []entity.JawabanOption, opsi []entity.SoalOpsi) error {
 	if len(options) < 2 {
 		return errors.New("complex multiple-choice requires at least 2 correct answers")
 	}
 	seen := map[entity.JawabanOption]struct{}{}
 	for _, option := range options {
-		if option < entity.JawabanA || option > entity.JawabanD {
+		if !hasOpsi(opsi, option) {
 			return errors.New("invalid complex answer option")
 		}
 		if _, exists := seen[option]; exists {
@@ -54,10 +109,149 @@ func validateComplexOptions(options []entity.JawabanOption) error {
 	return nil
 }
 
-// saveImages saves multiple image files and returns list of SoalGambar entities
-func (u *soalUsecaseImpl) saveImages(imageFilesBytes [][]byte) ([]entity.SoalGambar, error) {
+// validateShortAnswerBlanks trims the accepted answers of each blank and checks that every
+// blank can be answered. With more than one blank the pertanyaan must mark each of them.
+func validateShortAnswerBlanks(pertanyaan string, blanks []entity.ShortAnswerBlank) ([]entity.ShortAnswerBlank, error) {
+	if len(blanks) == 0 {
+		return nil, errors.New("short answer requires at least 1 blank")
+	}
+	cleaned := make([]entity.ShortAnswerBlank, 0, len(blanks))
+	for i, blank := range blanks {
+		accepted := []string{}
+		seen := map[string]bool{}
+		for _, answer := range blank.AcceptedAnswers {
+			answer = strings.TrimSpace(answer)
+			if answer == "" || seen[blank.Normalize(answer)] {
+				continue
+			}
+			seen[blank.Normalize(answer)] = true
+			accepted = append(accepted, answer)
+		}
+		blank.AcceptedAnswers = accepted
+		blank.Regex = strings.TrimSpace(blank.Regex)
+		if len(accepted) == 0 && blank.Regex == "" {
+			return nil, fmt.Errorf("blank %d requires an accepted answer or a regex", i+1)
+		}
+		if blank.Regex != "" {
+			if _, err := blank.CompileRegex(); err != nil {
+				return nil, fmt.Errorf("blank %d: invalid regex: %v", i+1, err)
+			}
+		}
+		if len(blanks) > 1 && !strings.Contains(pertanyaan, entity.ClozeMarker(i+1)) {
+			return nil, fmt.Errorf("pertanyaan must mark blank %d as %s", i+1, entity.ClozeMarker(i+1))
+		}
+		cleaned = append(cleaned, blank)
+	}
+	return cleaned, nil
+}
+
+// validateNumericAnswerKey checks the tolerances and trims the units of a numeric answer key
+func validateNumericAnswerKey(key *entity.NumericAnswerKey) (*entity.NumericAnswerKey, error) {
+	if key == nil {
+		return nil, errors.New("numeric question requires an answer key")
+	}
+	cleaned := *key
+	if math.IsNaN(cleaned.Value) || math.IsInf(cleaned.Value, 0) {
+		return nil, errors.New("numeric answer value must be a finite number")
+	}
+	if cleaned.AbsTolerance < 0 || cleaned.RelTolerance < 0 {
+		return nil, errors.New("numeric tolerance must not be negative")
+	}
+	if cleaned.RelTolerance > 1 {
+		return nil, errors.New("relative tolerance is a fraction and must not exceed 1")
+	}
+	if cleaned.SignificantFigures < 0 || cleaned.SignificantFigures > 15 {
+		return nil, errors.New("significant figures must be between 0 and 15")
+	}
+	if cleaned.DecimalSeparator != "" && cleaned.DecimalSeparator != "," && cleaned.DecimalSeparator != "." {
+		return nil, errors.New(`decimal separator must be "," or "." or empty to accept both`)
+	}
+
+	cleaned.Unit = strings.TrimSpace(cleaned.Unit)
+	units := []string{}
+	for _, unit := range cleaned.AcceptedUnits {
+		unit = strings.TrimSpace(unit)
+		if unit != "" && unit != cleaned.Unit {
+			units = append(units, unit)
+		}
+	}
+	cleaned.AcceptedUnits = units
+	if cleaned.Unit == "" && (len(units) > 0 || cleaned.UnitRequired) {
+		return nil, errors.New("unit is required when accepted units are set or a unit is required")
+	}
+	return &cleaned, nil
+}
+
+// validateHotspotAnswerKey checks the regions of a hotspot answer key and that they are drawn
+// on one of the question's images, given by urutan
+func validateHotspotAnswerKey(key *entity.HotspotAnswerKey, imageUrutans []int) (*entity.HotspotAnswerKey, error) {
+	if key == nil {
+		return nil, errors.New("hotspot question requires an answer key")
+	}
+	cleaned := *key
+	cleaned.Regions = append([]entity.HotspotRegion(nil), key.Regions...)
+	if cleaned.ImageUrutan == 0 && len(imageUrutans) > 0 {
+		cleaned.ImageUrutan = imageUrutans[0]
+	}
+	if err := cleaned.Validate(); err != nil {
+		return nil, err
+	}
+	for i := range cleaned.Regions {
+		cleaned.Regions[i].Label = strings.TrimSpace(cleaned.Regions[i].Label)
+	}
+	for _, urutan := range imageUrutans {
+		if urutan == cleaned.ImageUrutan {
+			return &cleaned, nil
+		}
+	}
+	return nil, fmt.Errorf("hotspot image with urutan %d not found, upload the image with the question", cleaned.ImageUrutan)
+}
+
+// validateGridAnswerKey trims the columns and rows of a grid answer key and checks it
+func validateGridAnswerKey(key *entity.GridAnswerKey) (*entity.GridAnswerKey, error) {
+	if key == nil {
+		return nil, errors.New("grid question requires an answer key")
+	}
+	cleaned := entity.GridAnswerKey{AllOrNothing: key.AllOrNothing}
+	for _, column := range key.Columns {
+		cleaned.Columns = append(cleaned.Columns, strings.TrimSpace(column))
+	}
+	for _, row := range key.Rows {
+		cleaned.Rows = append(cleaned.Rows, entity.GridRow{Teks: strings.TrimSpace(row.Teks), KolomBenar: row.KolomBenar})
+	}
+	if err := cleaned.Validate(); err != nil {
+		return nil, err
+	}
+	return &cleaned, nil
+}
+
+// newImageUrutans returns the urutan saveImages gives each uploaded image, numbered after lastUrutan
+func newImageUrutans(imageFilesBytes [][]byte, lastUrutan int) []int {
+	var urutans []int
+	for i, imageBytes := range imageFilesBytes {
+		if len(imageBytes) > 0 {
+			urutans = append(urutans, lastUrutan+i+1)
+		}
+	}
+	return urutans
+}
+
+// saveGambar stores the images uploaded with a soal
+func (u *soalUsecaseImpl) saveGambar(ctx context.Context, idSoal int, gambar []entity.SoalGambar) error {
+	for i := range gambar {
+		gambar[i].IDSoal = idSoal
+		if err := u.repo.CreateGambar(ctx, &gambar[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveImages saves multiple image files and returns list of SoalGambar entities.
+// Images are numbered after lastUrutan, the highest urutan the soal already has.
+func (u *soalUsecaseImpl) saveImages(ctx context.Context, imageFilesBytes [][]byte, lastUrutan int) ([]entity.SoalGambar, error) {
 	var gambar []entity.SoalGambar
-	
+
 	if len(imageFilesBytes) == 0 {
 		return gambar, nil
 	}
@@ -81,14 +275,14 @@ func (u *soalUsecaseImpl) saveImages(imageFilesBytes [][]byte) ([]entity.SoalGam
 
 		// Upload to Cloudinary
 		resp, err := cld.Upload.Upload(context.Background(), bytes.NewReader(imageBytes), uploader.UploadParams{
-			Folder: "cbt/soal_images",
+			Folder:   "cbt/soal_images",
 			PublicID: fmt.Sprintf("%d_%d_%d", time.Now().Unix(), time.Now().Nanosecond(), i),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to upload image to Cloudinary: %v", err)
 		}
 
-		urutan := i + 1
+		urutan := lastUrutan + i + 1
 		gambar = append(gambar, entity.SoalGambar{
 			NamaFile: resp.PublicID,
 			FilePath: resp.SecureURL,
@@ -104,7 +298,7 @@ func (u *soalUsecaseImpl) saveImages(imageFilesBytes [][]byte) ([]entity.SoalGam
 }
 
 // CreateSoal creates a new soal with multiple images
-func (u *soalUsecaseImpl) CreateSoal(idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, imageFilesBytes [][]byte) (*entity.Soal, error) {
+func (u *soalUsecaseImpl) CreateSoal(ctx context.Context, idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, formatKonten entity.FormatKonten, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, shortAnswerBlanks []entity.ShortAnswerBlank, numericKey *entity.NumericAnswerKey, hotspotKey *entity.HotspotAnswerKey, gridKey *entity.GridAnswerKey, opsi []string, imageFilesBytes [][]byte) (*entity.Soal, error) {
 	questionType = normalizeQuestionType(questionType, pembahasan)
 	if pertanyaan == "" {
 		return nil, errors.New("pertanyaan must be filled")
@@ -112,23 +306,58 @@ func (u *soalUsecaseImpl) CreateSoal(idMateri, idTingkat int, pertanyaan, opsiA,
 	if point <= 0 {
 		point = 1
 	}
-	if questionType != entity.QuestionTypeEssay {
-		if opsiA == "" || opsiB == "" || opsiC == "" || opsiD == "" {
-			return nil, errors.New("all fields must be filled")
+	var opsiList []entity.SoalOpsi
+	if entity.IsChoiceQuestion(questionType) {
+		resolved, err := resolveOpsi(opsiA, opsiB, opsiC, opsiD, opsi)
+		if err != nil {
+			return nil, err
 		}
+		opsiList = resolved
+	}
+	formatKonten, err := validateKonten(formatKonten, pertanyaan, pembahasan, opsiList)
+	if err != nil {
+		return nil, err
 	}
 	if questionType == entity.QuestionTypeMultipleChoice {
-		if jawabanBenar < entity.JawabanA || jawabanBenar > entity.JawabanD {
+		if !hasOpsi(opsiList, jawabanBenar) {
 			return nil, errors.New("invalid jawaban benar")
 		}
 	}
 	if questionType == entity.QuestionTypeMultipleChoicesComplex {
-		if err := validateComplexOptions(jawabanBenarComplex); err != nil {
+		if err := validateComplexOptions(jawabanBenarComplex, opsiList); err != nil {
 			return nil, err
 		}
 	}
+	if questionType == entity.QuestionTypeShortAnswer {
+		blanks, err := validateShortAnswerBlanks(pertanyaan, shortAnswerBlanks)
+		if err != nil {
+			return nil, err
+		}
+		shortAnswerBlanks = blanks
+	}
+	if questionType == entity.QuestionTypeNumeric {
+		key, err := validateNumericAnswerKey(numericKey)
+		if err != nil {
+			return nil, err
+		}
+		numericKey = key
+	}
+	if questionType == entity.QuestionTypeHotspot {
+		key, err := validateHotspotAnswerKey(hotspotKey, newImageUrutans(imageFilesBytes, 0))
+		if err != nil {
+			return nil, err
+		}
+		hotspotKey = key
+	}
+	if questionType == entity.QuestionTypeGrid {
+		key, err := validateGridAnswerKey(gridKey)
+		if err != nil {
+			return nil, err
+		}
+		gridKey = key
+	}
 
-	gambar, err := u.saveImages(imageFilesBytes)
+	gambar, err := u.saveImages(ctx, imageFilesBytes, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -140,14 +369,12 @@ func (u *soalUsecaseImpl) CreateSoal(idMateri, idTingkat int, pertanyaan, opsiA,
 		Point:        point,
 		Urutan:       urutan,
 		QuestionType: questionType,
-		OpsiA:        opsiA,
-		OpsiB:        opsiB,
-		OpsiC:        opsiC,
-		OpsiD:        opsiD,
 		JawabanBenar: jawabanBenar,
 		Pembahasan:   &pembahasan,
+		FormatKonten: formatKonten,
 		Gambar:       gambar,
 	}
+	s.SetOpsi(opsiList)
 	if questionType == entity.QuestionTypeEssay {
 		essayKey := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(pembahasan), "[ESSAY]"))
 		if essayKey != "" {
@@ -165,20 +392,63 @@ func (u *soalUsecaseImpl) CreateSoal(idMateri, idTingkat int, pertanyaan, opsiA,
 		}
 		s.JawabanBenar = entity.JawabanA
 	}
-	err = u.repo.Create(s)
+	if questionType == entity.QuestionTypeShortAnswer {
+		if err := s.SetShortAnswerBlanks(shortAnswerBlanks); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+	}
+	if questionType == entity.QuestionTypeNumeric {
+		if err := s.SetNumericAnswerKey(numericKey); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+	}
+	if questionType == entity.QuestionTypeHotspot {
+		if err := s.SetHotspotAnswerKey(hotspotKey); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+	}
+	if questionType == entity.QuestionTypeGrid {
+		if err := s.SetGridAnswerKey(gridKey); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+	}
+	err = u.repo.Create(ctx, s)
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.GetByID(s.ID)
+	if err := u.saveGambar(ctx, s.ID, gambar); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, s.ID)
 }
 
 // GetSoal gets by ID
-func (u *soalUsecaseImpl) GetSoal(id int) (*entity.Soal, error) {
-	return u.repo.GetByID(id)
+func (u *soalUsecaseImpl) GetSoal(ctx context.Context, id int) (*entity.Soal, error) {
+	return u.repo.GetByID(ctx, id)
 }
 
 // UpdateSoal updates existing with multiple images
-func (u *soalUsecaseImpl) UpdateSoal(id, idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, imageFilesBytes [][]byte) (*entity.Soal, error) {
+func (u *soalUsecaseImpl) UpdateSoal(ctx context.Context, id, idMateri, idTingkat int, pertanyaan, opsiA, opsiB, opsiC, opsiD, pembahasan string, point float64, urutan int, questionType entity.QuestionType, formatKonten entity.FormatKonten, jawabanBenar entity.JawabanOption, jawabanBenarComplex []entity.JawabanOption, shortAnswerBlanks []entity.ShortAnswerBlank, numericKey *entity.NumericAnswerKey, hotspotKey *entity.HotspotAnswerKey, gridKey *entity.GridAnswerKey, opsi []string, imageFilesBytes [][]byte) (*entity.Soal, error) {
 	questionType = normalizeQuestionType(questionType, pembahasan)
 	if pertanyaan == "" {
 		return nil, errors.New("pertanyaan must be filled")
@@ -186,46 +456,84 @@ func (u *soalUsecaseImpl) UpdateSoal(id, idMateri, idTingkat int, pertanyaan, op
 	if point <= 0 {
 		point = 1
 	}
-	if questionType != entity.QuestionTypeEssay {
-		if opsiA == "" || opsiB == "" || opsiC == "" || opsiD == "" {
-			return nil, errors.New("all fields must be filled")
+	var opsiList []entity.SoalOpsi
+	if entity.IsChoiceQuestion(questionType) {
+		resolved, err := resolveOpsi(opsiA, opsiB, opsiC, opsiD, opsi)
+		if err != nil {
+			return nil, err
 		}
+		opsiList = resolved
+	}
+	formatKonten, err := validateKonten(formatKonten, pertanyaan, pembahasan, opsiList)
+	if err != nil {
+		return nil, err
 	}
 	if questionType == entity.QuestionTypeMultipleChoice {
-		if jawabanBenar < entity.JawabanA || jawabanBenar > entity.JawabanD {
+		if !hasOpsi(opsiList, jawabanBenar) {
 			return nil, errors.New("invalid jawaban benar")
 		}
 	}
 	if questionType == entity.QuestionTypeMultipleChoicesComplex {
-		if err := validateComplexOptions(jawabanBenarComplex); err != nil {
+		if err := validateComplexOptions(jawabanBenarComplex, opsiList); err != nil {
+			return nil, err
+		}
+	}
+	if questionType == entity.QuestionTypeShortAnswer {
+		blanks, err := validateShortAnswerBlanks(pertanyaan, shortAnswerBlanks)
+		if err != nil {
+			return nil, err
+		}
+		shortAnswerBlanks = blanks
+	}
+	if questionType == entity.QuestionTypeNumeric {
+		key, err := validateNumericAnswerKey(numericKey)
+		if err != nil {
+			return nil, err
+		}
+		numericKey = key
+	}
+	if questionType == entity.QuestionTypeGrid {
+		key, err := validateGridAnswerKey(gridKey)
+		if err != nil {
 			return nil, err
 		}
+		gridKey = key
 	}
 
-	s, err := u.repo.GetByID(id)
+	s, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	before := *s
 
-	gambar, err := u.saveImages(imageFilesBytes)
+	lastUrutan := 0
+	var imageUrutans []int
+	for _, g := range s.Gambar {
+		imageUrutans = append(imageUrutans, g.Urutan)
+		lastUrutan = max(lastUrutan, g.Urutan)
+	}
+	if questionType == entity.QuestionTypeHotspot {
+		key, err := validateHotspotAnswerKey(hotspotKey, append(imageUrutans, newImageUrutans(imageFilesBytes, lastUrutan)...))
+		if err != nil {
+			return nil, err
+		}
+		hotspotKey = key
+	}
+
+	gambar, err := u.saveImages(ctx, imageFilesBytes, lastUrutan)
 	if err != nil {
 		return nil, err
 	}
-	if len(gambar) > 0 {
-		s.Gambar = gambar
-	}
 
 	s.IDMateri = idMateri
 	s.IDTingkat = idTingkat
 	s.Pertanyaan = pertanyaan
 	s.Point = point
 	s.Urutan = urutan
-	s.OpsiA = opsiA
-	s.OpsiB = opsiB
-	s.OpsiC = opsiC
-	s.OpsiD = opsiD
+	s.SetOpsi(opsiList)
 	s.JawabanBenar = jawabanBenar
 	s.Pembahasan = &pembahasan
+	s.FormatKonten = formatKonten
 	s.QuestionType = questionType
 	switch questionType {
 	case entity.QuestionTypeEssay:
@@ -241,35 +549,116 @@ func (u *soalUsecaseImpl) UpdateSoal(id, idMateri, idTingkat int, pertanyaan, op
 		s.OpsiD = "-"
 		s.JawabanBenar = entity.JawabanA
 		s.JawabanBenarComplex = nil
+		s.JawabanShortAnswer = nil
+		s.JawabanNumeric = nil
+		s.JawabanHotspot = nil
+		s.JawabanGrid = nil
 	case entity.QuestionTypeMultipleChoicesComplex:
 		if err := s.SetJawabanBenarComplex(jawabanBenarComplex); err != nil {
 			return nil, err
 		}
 		s.JawabanEssayKey = nil
+		s.JawabanShortAnswer = nil
+		s.JawabanNumeric = nil
+		s.JawabanHotspot = nil
+		s.JawabanGrid = nil
+		s.JawabanBenar = entity.JawabanA
+	case entity.QuestionTypeShortAnswer:
+		if err := s.SetShortAnswerBlanks(shortAnswerBlanks); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+		s.JawabanEssayKey = nil
+		s.JawabanBenarComplex = nil
+		s.JawabanNumeric = nil
+		s.JawabanHotspot = nil
+		s.JawabanGrid = nil
+	case entity.QuestionTypeNumeric:
+		if err := s.SetNumericAnswerKey(numericKey); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+		s.JawabanEssayKey = nil
+		s.JawabanBenarComplex = nil
+		s.JawabanShortAnswer = nil
+		s.JawabanHotspot = nil
+		s.JawabanGrid = nil
+	case entity.QuestionTypeHotspot:
+		if err := s.SetHotspotAnswerKey(hotspotKey); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
+		s.JawabanBenar = entity.JawabanA
+		s.JawabanEssayKey = nil
+		s.JawabanBenarComplex = nil
+		s.JawabanShortAnswer = nil
+		s.JawabanNumeric = nil
+		s.JawabanGrid = nil
+	case entity.QuestionTypeGrid:
+		if err := s.SetGridAnswerKey(gridKey); err != nil {
+			return nil, err
+		}
+		s.OpsiA = "-"
+		s.OpsiB = "-"
+		s.OpsiC = "-"
+		s.OpsiD = "-"
 		s.JawabanBenar = entity.JawabanA
+		s.JawabanEssayKey = nil
+		s.JawabanBenarComplex = nil
+		s.JawabanShortAnswer = nil
+		s.JawabanNumeric = nil
+		s.JawabanHotspot = nil
 	default:
 		s.JawabanEssayKey = nil
 		s.JawabanBenarComplex = nil
+		s.JawabanShortAnswer = nil
+		s.JawabanNumeric = nil
+		s.JawabanHotspot = nil
+		s.JawabanGrid = nil
 	}
-	err = u.repo.Update(s)
+	err = u.repo.Update(ctx, s)
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.GetByID(s.ID)
+	if err := u.saveGambar(ctx, s.ID, gambar); err != nil {
+		return nil, err
+	}
+	updated, err := u.repo.GetByID(ctx, s.ID)
+	if err != nil {
+		return nil, err
+	}
+	audit.Record(ctx, entity.AuditResourceSoal, s.ID, &before, updated)
+	return updated, nil
 }
 
 // DeleteSoal soft deletes by setting is_active = false
-func (u *soalUsecaseImpl) DeleteSoal(id int) error {
-	s, err := u.repo.GetByID(id)
+func (u *soalUsecaseImpl) DeleteSoal(ctx context.Context, id int) error {
+	s, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
+	before := *s
 	s.IsActive = false
-	return u.repo.Update(s)
+	if err := u.repo.Update(ctx, s); err != nil {
+		return err
+	}
+	audit.Record(ctx, entity.AuditResourceSoal, id, &before, s)
+	return nil
 }
 
 // ListSoal lists with filters and pagination
-func (u *soalUsecaseImpl) ListSoal(idMateri, tingkatan, idMataPelajaran int, page, pageSize int) ([]entity.Soal, *entity.PaginationResponse, error) {
+func (u *soalUsecaseImpl) ListSoal(ctx context.Context, idMateri, tingkatan, idMataPelajaran int, scope *entity.TeacherScope, page, pageSize int) ([]entity.Soal, *entity.PaginationResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -288,7 +677,7 @@ func (u *soalUsecaseImpl) ListSoal(idMateri, tingkatan, idMataPelajaran int, pag
 	if idMataPelajaran > 0 {
 		idMataPtr = &idMataPelajaran
 	}
-	soals, total, err := u.repo.List(idMateriPtr, tingPtr, idMataPtr, pageSize, offset)
+	soals, total, err := u.repo.List(ctx, idMateriPtr, tingPtr, idMataPtr, scope, pageSize, offset)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -305,7 +694,7 @@ func (u *soalUsecaseImpl) ListSoal(idMateri, tingkatan, idMataPelajaran int, pag
 }
 
 // UploadImageToSoal uploads an image to a soal
-func (u *soalUsecaseImpl) UploadImageToSoal(idSoal int, imageBytes []byte, namaFile string, urutan int, keterangan *string) (*entity.SoalGambar, error) {
+func (u *soalUsecaseImpl) UploadImageToSoal(ctx context.Context, idSoal int, imageBytes []byte, namaFile string, urutan int, keterangan *string) (*entity.SoalGambar, error) {
 	if len(imageBytes) == 0 {
 		return nil, errors.New("image bytes cannot be empty")
 	}
@@ -343,7 +732,7 @@ func (u *soalUsecaseImpl) UploadImageToSoal(idSoal int, imageBytes []byte, namaF
 		PublicId:   &resp.PublicID,
 	}
 
-	err = u.repo.CreateGambar(gambar)
+	err = u.repo.CreateGambar(ctx, gambar)
 	if err != nil {
 		// Delete from Cloudinary if DB insert fails
 		_, delErr := cld.Upload.Destroy(context.Background(), uploader.DestroyParams{PublicID: resp.PublicID})
@@ -357,8 +746,8 @@ func (u *soalUsecaseImpl) UploadImageToSoal(idSoal int, imageBytes []byte, namaF
 }
 
 // DeleteImageFromSoal deletes an image from a soal
-func (u *soalUsecaseImpl) DeleteImageFromSoal(idGambar int) error {
-	gambar, err := u.repo.GetGambarByID(idGambar)
+func (u *soalUsecaseImpl) DeleteImageFromSoal(ctx context.Context, idGambar int) error {
+	gambar, err := u.repo.GetGambarByID(ctx, idGambar)
 	if err != nil {
 		return err
 	}
@@ -380,20 +769,177 @@ func (u *soalUsecaseImpl) DeleteImageFromSoal(idGambar int) error {
 		fmt.Printf("Warning: failed to delete image from Cloudinary %s: %v\n", publicID, err)
 	}
 
-	return u.repo.DeleteGambar(idGambar)
+	return u.repo.DeleteGambar(ctx, idGambar)
 }
 
 // UpdateImageInSoal updates an image in a soal
-func (u *soalUsecaseImpl) UpdateImageInSoal(idGambar int, urutan int, keterangan *string) error {
-	return u.repo.UpdateGambar(idGambar, urutan, keterangan)
+func (u *soalUsecaseImpl) UpdateImageInSoal(ctx context.Context, idGambar int, urutan int, keterangan *string) error {
+	return u.repo.UpdateGambar(ctx, idGambar, urutan, keterangan)
+}
+
+// detectMediaMime sniffs the MIME type of an uploaded clip. Audio in an MP4 or WebM container
+// is labelled as audio so players do not reserve room for a picture.
+func detectMediaMime(jenis entity.MediaJenis, mediaBytes []byte) string {
+	mimeType := http.DetectContentType(mediaBytes)
+	switch {
+	case mimeType == "application/ogg":
+		mimeType = "audio/ogg"
+	case mimeType == "application/octet-stream" && len(mediaBytes) > 1 && mediaBytes[0] == 0xFF && mediaBytes[1]&0xE0 == 0xE0:
+		// MP3 without an ID3 tag starts directly with a frame sync
+		mimeType = "audio/mpeg"
+	}
+	if jenis == entity.MediaJenisAudio && strings.HasPrefix(mimeType, "video/") {
+		mimeType = "audio/" + strings.TrimPrefix(mimeType, "video/")
+	}
+	return mimeType
+}
+
+// uploadedDuration reads the duration Cloudinary measured for an audio or video upload
+func uploadedDuration(resp *uploader.UploadResult) float64 {
+	raw, ok := resp.Response.(*interface{})
+	if !ok || raw == nil {
+		return 0
+	}
+	fields, ok := (*raw).(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	duration, _ := fields["duration"].(float64)
+	return duration
+}
+
+// UploadMediaToSoal uploads an audio or video clip to a soal. The duration measured on upload
+// is kept when there is one; otherwise durasiDetik as given by the teacher is used.
+func (u *soalUsecaseImpl) UploadMediaToSoal(ctx context.Context, idSoal int, mediaBytes []byte, namaFile string, jenis entity.MediaJenis, durasiDetik float64, maxPutar, urutan int, keterangan *string) (*entity.SoalMedia, error) {
+	if len(mediaBytes) == 0 {
+		return nil, errors.New("media bytes cannot be empty")
+	}
+	if len(mediaBytes) > entity.MaxSoalMediaSize {
+		return nil, fmt.Errorf("media is too large, the maximum is %d MB", entity.MaxSoalMediaSize>>20)
+	}
+	if !jenis.IsValid() {
+		return nil, errors.New("media jenis must be audio or video")
+	}
+	if err := entity.ValidateSoalMediaSettings(durasiDetik, maxPutar); err != nil {
+		return nil, err
+	}
+	if urutan < 1 {
+		urutan = 1
+	}
+
+	// Validate media type
+	mimeType := detectMediaMime(jenis, mediaBytes)
+	if !jenis.AllowsMime(mimeType) {
+		return nil, fmt.Errorf("invalid %s type: %s", jenis, mimeType)
+	}
+
+	if _, err := u.repo.GetByID(ctx, idSoal); err != nil {
+		return nil, err
+	}
+
+	// Initialize Cloudinary
+	cld, err := cloudinary.NewFromParams(u.config.Cloudinary.Name, u.config.Cloudinary.Key, u.config.Cloudinary.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Cloudinary: %v", err)
+	}
+
+	// Upload to Cloudinary; audio is stored as a video resource there
+	resp, err := cld.Upload.Upload(context.Background(), bytes.NewReader(mediaBytes), uploader.UploadParams{
+		Folder:       "cbt/soal_media",
+		PublicID:     namaFile,
+		ResourceType: "video",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload media to Cloudinary: %v", err)
+	}
+
+	if measured := uploadedDuration(resp); measured > 0 {
+		durasiDetik = math.Round(measured*100) / 100
+	}
+	if durasiDetik > entity.MaxSoalMediaDurasi {
+		u.destroyMedia(ctx, cld, resp.PublicID)
+		return nil, fmt.Errorf("media is longer than %d minutes", entity.MaxSoalMediaDurasi/60)
+	}
+
+	media := &entity.SoalMedia{
+		IDSoal:      idSoal,
+		Jenis:       jenis,
+		NamaFile:    resp.PublicID,
+		FilePath:    resp.SecureURL,
+		FileSize:    len(mediaBytes),
+		MimeType:    mimeType,
+		DurasiDetik: durasiDetik,
+		MaxPutar:    maxPutar,
+		Urutan:      urutan,
+		Keterangan:  keterangan,
+		CloudId:     &u.config.Cloudinary.Name,
+		PublicId:    &resp.PublicID,
+	}
+
+	err = u.repo.CreateMedia(ctx, media)
+	if err != nil {
+		// Delete from Cloudinary if DB insert fails
+		u.destroyMedia(ctx, cld, resp.PublicID)
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// destroyMedia removes a clip from Cloudinary, logging rather than failing when it cannot
+func (u *soalUsecaseImpl) destroyMedia(ctx context.Context, cld *cloudinary.Cloudinary, publicID string) {
+	_, err := cld.Upload.Destroy(context.Background(), uploader.DestroyParams{PublicID: publicID, ResourceType: "video"})
+	if err != nil {
+		fmt.Printf("Warning: failed to delete media from Cloudinary %s: %v\n", publicID, err)
+	}
+}
+
+// DeleteMediaFromSoal deletes an audio or video clip from a soal
+func (u *soalUsecaseImpl) DeleteMediaFromSoal(ctx context.Context, idMedia int) error {
+	media, err := u.repo.GetMediaByID(ctx, idMedia)
+	if err != nil {
+		return err
+	}
+
+	// Initialize Cloudinary
+	cld, err := cloudinary.NewFromParams(u.config.Cloudinary.Name, u.config.Cloudinary.Key, u.config.Cloudinary.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to initialize Cloudinary: %v", err)
+	}
+
+	publicID := media.NamaFile
+	if media.PublicId != nil {
+		publicID = *media.PublicId
+	}
+	u.destroyMedia(ctx, cld, publicID)
+
+	return u.repo.DeleteMedia(ctx, idMedia)
+}
+
+// UpdateMediaInSoal updates the order, play limit, duration and caption of a soal clip
+func (u *soalUsecaseImpl) UpdateMediaInSoal(ctx context.Context, idMedia, urutan, maxPutar int, durasiDetik float64, keterangan *string) error {
+	if err := entity.ValidateSoalMediaSettings(durasiDetik, maxPutar); err != nil {
+		return err
+	}
+	media, err := u.repo.GetMediaByID(ctx, idMedia)
+	if err != nil {
+		return err
+	}
+	if urutan < 1 {
+		urutan = media.Urutan
+	}
+	if durasiDetik == 0 {
+		durasiDetik = media.DurasiDetik
+	}
+	return u.repo.UpdateMedia(ctx, idMedia, urutan, maxPutar, durasiDetik, keterangan)
 }
 
 // GetQuestionCountsByTopic returns the count of questions per topic
-func (u *soalUsecaseImpl) GetQuestionCountsByTopic() (map[int]int, error) {
-	return u.repo.GetQuestionCountsByTopic()
+func (u *soalUsecaseImpl) GetQuestionCountsByTopic(ctx context.Context) (map[int]int, error) {
+	return u.repo.GetQuestionCountsByTopic(ctx)
 }
 
-func (u *soalUsecaseImpl) ReorderSoal(idMateri int, urutanByID map[int]int) error {
+func (u *soalUsecaseImpl) ReorderSoal(ctx context.Context, idMateri int, urutanByID map[int]int) error {
 	if idMateri < 1 {
 		return errors.New("id_materi must be positive")
 	}
@@ -405,5 +951,24 @@ func (u *soalUsecaseImpl) ReorderSoal(idMateri int, urutanByID map[int]int) erro
 			return errors.New("urutan must be greater than zero")
 		}
 	}
-	return u.repo.ReorderByMateri(idMateri, urutanByID)
-}
\ No newline at end of file
+
+	soalList, err := u.repo.GetByMateriID(ctx, idMateri)
+	if err != nil {
+		return err
+	}
+	// Soal of other materi are not moved, so they are left out
+	before := make(map[int]int, len(urutanByID))
+	after := make(map[int]int, len(urutanByID))
+	for _, soal := range soalList {
+		if urutan, ok := urutanByID[soal.ID]; ok {
+			before[soal.ID] = soal.Urutan
+			after[soal.ID] = urutan
+		}
+	}
+	if err := u.repo.ReorderByMateri(ctx, idMateri, urutanByID); err != nil {
+		return err
+	}
+	// One entry for the materi, with the urutan of each moved soal keyed by its ID
+	audit.Record(ctx, entity.AuditResourceMateri, idMateri, before, after)
+	return nil
+}
@@ -1,6 +1,8 @@
 package soal_drag_drop
 
 import (
+	"context"
+
 	"cbt-test-mini-project/init/config"
 	"cbt-test-mini-project/internal/entity"
 	repository "cbt-test-mini-project/internal/repository/soal_drag_drop"
@@ -8,16 +10,16 @@ import (
 
 // Usecase interface for soal_drag_drop business logic
 type Usecase interface {
-	Create(req *CreateRequest) (*entity.SoalDragDrop, error)
-	GetByID(id int) (*entity.SoalDragDrop, error)
-	GetByIDWithCorrectAnswers(id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error)
-	Update(id int, req *UpdateRequest) (*entity.SoalDragDrop, error)
-	Delete(id int) error
-	List(idMateri, idTingkat int, page, pageSize int) ([]entity.SoalDragDrop, int64, error)
-	GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDragDrop, error)
-	CheckDragDropAnswer(soalID int, userAnswer map[int]int) (bool, error)
-	CountByMateri(idMateri int) (int64, error)
-	ReorderSoalDragDrop(idMateri int, urutanByID map[int]int) error
+	Create(ctx context.Context, req *CreateRequest) (*entity.SoalDragDrop, error)
+	GetByID(ctx context.Context, id int) (*entity.SoalDragDrop, error)
+	GetByIDWithCorrectAnswers(ctx context.Context, id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error)
+	Update(ctx context.Context, id int, req *UpdateRequest) (*entity.SoalDragDrop, error)
+	Delete(ctx context.Context, id int) error
+	List(ctx context.Context, idMateri, idTingkat int, scope *entity.TeacherScope, page, pageSize int) ([]entity.SoalDragDrop, int64, error)
+	GetActiveByMateri(ctx context.Context, idMateri int, limit int) ([]entity.SoalDragDrop, error)
+	CheckDragDropAnswer(ctx context.Context, soalID int, userAnswer map[int]int) (bool, error)
+	CountByMateri(ctx context.Context, idMateri int) (int64, error)
+	ReorderSoalDragDrop(ctx context.Context, idMateri int, urutanByID map[int]int) error
 }
 
 // CreateRequest for creating a new drag-drop question
@@ -2,7 +2,6 @@ package soal_drag_drop
 
 import (
 	"bytes"
-	"cbt-test-mini-project/internal/entity"
 	"context"
 	"encoding/base64"
 	"errors"
@@ -11,12 +10,14 @@ import (
 	"strings"
 	"time"
 
+	"cbt-test-mini-project/internal/entity"
+
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 )
 
 // Helper to upload image string (Base64) to Cloudinary
-func (u *usecase) uploadImageToCloudinary(imageStr string) (string, error) {
+func (u *usecase) uploadImageToCloudinary(ctx context.Context, imageStr string) (string, error) {
 	// If it's already a URL (not base64), return as is
 	if !strings.HasPrefix(imageStr, "data:image") {
 		return imageStr, nil
@@ -50,7 +51,7 @@ func (u *usecase) uploadImageToCloudinary(imageStr string) (string, error) {
 	}
 
 	// Upload to Cloudinary
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	uploadParams := uploader.UploadParams{
@@ -66,7 +67,7 @@ func (u *usecase) uploadImageToCloudinary(imageStr string) (string, error) {
 }
 
 // Create creates a new drag-drop question
-func (u *usecase) Create(req *CreateRequest) (*entity.SoalDragDrop, error) {
+func (u *usecase) Create(ctx context.Context, req *CreateRequest) (*entity.SoalDragDrop, error) {
 	// Validate request
 	if req.Pertanyaan == "" {
 		return nil, errors.New("pertanyaan is required")
@@ -113,7 +114,7 @@ func (u *usecase) Create(req *CreateRequest) (*entity.SoalDragDrop, error) {
 	for i, item := range req.Items {
 		var finalImageURL *string
 		if item.ImageURL != nil && *item.ImageURL != "" {
-			uploadedURL, err := u.uploadImageToCloudinary(*item.ImageURL)
+			uploadedURL, err := u.uploadImageToCloudinary(ctx, *item.ImageURL)
 			if err != nil {
 				return nil, fmt.Errorf("failed to upload image for item %d: %v", i+1, err)
 			}
@@ -156,7 +157,7 @@ func (u *usecase) Create(req *CreateRequest) (*entity.SoalDragDrop, error) {
 		for i, slot := range req.Slots {
 			var finalImageURL *string
 			if slot.ImageURL != nil && *slot.ImageURL != "" {
-				uploadedURL, err := u.uploadImageToCloudinary(*slot.ImageURL)
+				uploadedURL, err := u.uploadImageToCloudinary(ctx, *slot.ImageURL)
 				if err != nil {
 					return nil, fmt.Errorf("failed to upload image for slot %d: %v", i+1, err)
 				}
@@ -181,25 +182,25 @@ func (u *usecase) Create(req *CreateRequest) (*entity.SoalDragDrop, error) {
 	}
 
 	// Create all in one transaction
-	if err := u.repo.Create(soal, items, slots, correctAnswers); err != nil {
+	if err := u.repo.Create(ctx, soal, items, slots, correctAnswers); err != nil {
 		return nil, err
 	}
 
-	return u.repo.GetByID(soal.ID)
+	return u.repo.GetByID(ctx, soal.ID)
 }
 
 // GetByID retrieves a drag-drop question by ID
-func (u *usecase) GetByID(id int) (*entity.SoalDragDrop, error) {
-	return u.repo.GetByID(id)
+func (u *usecase) GetByID(ctx context.Context, id int) (*entity.SoalDragDrop, error) {
+	return u.repo.GetByID(ctx, id)
 }
 
 // GetByIDWithCorrectAnswers retrieves with correct answers (admin only)
-func (u *usecase) GetByIDWithCorrectAnswers(id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error) {
-	return u.repo.GetByIDWithCorrectAnswers(id)
+func (u *usecase) GetByIDWithCorrectAnswers(ctx context.Context, id int) (*entity.SoalDragDrop, []entity.DragCorrectAnswer, error) {
+	return u.repo.GetByIDWithCorrectAnswers(ctx, id)
 }
 
 // Update updates a drag-drop question
-func (u *usecase) Update(id int, req *UpdateRequest) (*entity.SoalDragDrop, error) {
+func (u *usecase) Update(ctx context.Context, id int, req *UpdateRequest) (*entity.SoalDragDrop, error) {
 	// Validate request
 	if req.Pertanyaan == "" {
 		return nil, errors.New("pertanyaan is required")
@@ -230,7 +231,7 @@ func (u *usecase) Update(id int, req *UpdateRequest) (*entity.SoalDragDrop, erro
 	}
 
 	// Get existing question
-	existing, err := u.repo.GetByID(id)
+	existing, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +254,7 @@ func (u *usecase) Update(id int, req *UpdateRequest) (*entity.SoalDragDrop, erro
 	for i, item := range req.Items {
 		var finalImageURL *string
 		if item.ImageURL != nil && *item.ImageURL != "" {
-			uploadedURL, err := u.uploadImageToCloudinary(*item.ImageURL)
+			uploadedURL, err := u.uploadImageToCloudinary(ctx, *item.ImageURL)
 			if err != nil {
 				return nil, fmt.Errorf("failed to upload image for item %d: %v", i+1, err)
 			}
@@ -295,7 +296,7 @@ func (u *usecase) Update(id int, req *UpdateRequest) (*entity.SoalDragDrop, erro
 		for i, slot := range req.Slots {
 			var finalImageURL *string
 			if slot.ImageURL != nil && *slot.ImageURL != "" {
-				uploadedURL, err := u.uploadImageToCloudinary(*slot.ImageURL)
+				uploadedURL, err := u.uploadImageToCloudinary(ctx, *slot.ImageURL)
 				if err != nil {
 					return nil, fmt.Errorf("failed to upload image for slot %d: %v", i+1, err)
 				}
@@ -320,26 +321,26 @@ func (u *usecase) Update(id int, req *UpdateRequest) (*entity.SoalDragDrop, erro
 	}
 
 	// Update all in one transaction
-	if err := u.repo.Update(existing, items, slots, correctAnswers); err != nil {
+	if err := u.repo.Update(ctx, existing, items, slots, correctAnswers); err != nil {
 		return nil, err
 	}
 
-	return u.repo.GetByID(id)
+	return u.repo.GetByID(ctx, id)
 }
 
 // Delete deletes a drag-drop question
-func (u *usecase) Delete(id int) error {
-	existing, err := u.repo.GetByID(id)
+func (u *usecase) Delete(ctx context.Context, id int) error {
+	existing, err := u.repo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if existing == nil {
 		return errors.New("question not found")
 	}
-	return u.repo.Delete(id)
+	return u.repo.Delete(ctx, id)
 }
 
-func (u *usecase) ReorderSoalDragDrop(idMateri int, urutanByID map[int]int) error {
+func (u *usecase) ReorderSoalDragDrop(ctx context.Context, idMateri int, urutanByID map[int]int) error {
 	if idMateri < 1 {
 		return errors.New("id_materi must be positive")
 	}
@@ -351,11 +352,11 @@ func (u *usecase) ReorderSoalDragDrop(idMateri int, urutanByID map[int]int) erro
 			return errors.New("urutan must be greater than zero")
 		}
 	}
-	return u.repo.ReorderByMateri(idMateri, urutanByID)
+	return u.repo.ReorderByMateri(ctx, idMateri, urutanByID)
 }
 
 // List retrieves drag-drop questions with pagination
-func (u *usecase) List(idMateri, idTingkat int, page, pageSize int) ([]entity.SoalDragDrop, int64, error) {
+func (u *usecase) List(ctx context.Context, idMateri, idTingkat int, scope *entity.TeacherScope, page, pageSize int) ([]entity.SoalDragDrop, int64, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -365,17 +366,17 @@ func (u *usecase) List(idMateri, idTingkat int, page, pageSize int) ([]entity.So
 	if pageSize > 100 {
 		pageSize = 100
 	}
-	return u.repo.List(idMateri, idTingkat, page, pageSize)
+	return u.repo.List(ctx, idMateri, idTingkat, scope, page, pageSize)
 }
 
 // GetActiveByMateri retrieves active questions for test sessions
-func (u *usecase) GetActiveByMateri(idMateri int, limit int) ([]entity.SoalDragDrop, error) {
-	return u.repo.GetActiveByMateri(idMateri, limit)
+func (u *usecase) GetActiveByMateri(ctx context.Context, idMateri int, limit int) ([]entity.SoalDragDrop, error) {
+	return u.repo.GetActiveByMateri(ctx, idMateri, limit)
 }
 
 // CheckDragDropAnswer checks if user's answer is correct (all-or-nothing)
-func (u *usecase) CheckDragDropAnswer(soalID int, userAnswer map[int]int) (bool, error) {
-	correctAnswers, err := u.repo.GetCorrectAnswersBySoalID(soalID)
+func (u *usecase) CheckDragDropAnswer(ctx context.Context, soalID int, userAnswer map[int]int) (bool, error) {
+	correctAnswers, err := u.repo.GetCorrectAnswersBySoalID(ctx, soalID)
 	if err != nil {
 		return false, err
 	}
@@ -396,6 +397,6 @@ func (u *usecase) CheckDragDropAnswer(soalID int, userAnswer map[int]int) (bool,
 }
 
 // CountByMateri counts questions for a materi
-func (u *usecase) CountByMateri(idMateri int) (int64, error) {
-	return u.repo.CountByMateri(idMateri)
+func (u *usecase) CountByMateri(ctx context.Context, idMateri int) (int64, error) {
+	return u.repo.CountByMateri(ctx, idMateri)
 }
@@ -1,25 +1,32 @@
 package test_session
 
 import (
+	"context"
+
 	"cbt-test-mini-project/internal/entity"
 )
 
 // TestSessionUsecase defines the interface for TestSession usecase operations
 type TestSessionUsecase interface {
-	CreateTestSession(userID, tingkatan, idMataPelajaran, durasiMenit, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) (*entity.TestSession, error)
-	GetTestSession(sessionToken string) (*entity.TestSession, error)
-	GetTestQuestions(sessionToken string, nomorUrut int) (*entity.QuestionForStudent, error)
-	GetAllTestQuestions(sessionToken string) ([]entity.QuestionForStudent, error)
-	GetSessionAnswers(sessionToken string) ([]entity.JawabanSiswa, error)
-	SubmitAnswer(sessionToken string, nomorUrut int, jawaban entity.JawabanOption) error
-	SubmitComplexAnswer(sessionToken string, nomorUrut int, jawaban []entity.JawabanOption) error
-	SubmitDragDropAnswer(sessionToken string, nomorUrut int, answer map[int]int) error // NEW: for drag-drop
-	SubmitEssayAnswer(sessionToken string, nomorUrut int, jawabanEssay string) error
-	GradeEssayAnswer(answerID int, score float64, feedback string) error
-	ClearAnswer(sessionToken string, nomorUrut int) error
-	CompleteSession(sessionToken string) (*entity.TestSession, error)
-	GetTestResult(sessionToken string) (*entity.TestSession, []entity.JawabanDetail, error)
-	ListTestSessions(tingkatan, idMataPelajaran *int, status *entity.TestStatus, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error)
-	ListScheduledSessions(userID int, lmsClassID *int64, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error)
-	StartScheduledSession(userID int, sessionToken string) (*entity.TestSession, error)
+	CreateTestSession(ctx context.Context, userID, tingkatan, idMataPelajaran, durasiMenit, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) (*entity.TestSession, error)
+	GetTestSession(ctx context.Context, sessionToken string) (*entity.TestSession, error)
+	GetTestQuestions(ctx context.Context, sessionToken string, nomorUrut int) (*entity.QuestionForStudent, error)
+	GetAllTestQuestions(ctx context.Context, sessionToken string) ([]entity.QuestionForStudent, error)
+	GetSessionAnswers(ctx context.Context, sessionToken string) ([]entity.JawabanSiswa, error)
+	SubmitAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban entity.JawabanOption) error
+	SubmitComplexAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban []entity.JawabanOption) error
+	SubmitShortAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban []string) error
+	SubmitNumericAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban string) error
+	SubmitHotspotAnswer(ctx context.Context, sessionToken string, nomorUrut int, points []entity.HotspotPoint) error
+	SubmitGridAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban []int) error
+	RecordMediaPlay(ctx context.Context, sessionToken string, nomorUrut, idMedia int) (*entity.SessionMedia, error)
+	GetMediaStreamSource(ctx context.Context, sessionToken string, nomorUrut, idMedia int, streamNonce string) (*entity.SessionMedia, error)
+	SubmitDragDropAnswer(ctx context.Context, sessionToken string, nomorUrut int, answer map[int]int) error // NEW: for drag-drop
+	SubmitEssayAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawabanEssay string) error
+	ClearAnswer(ctx context.Context, sessionToken string, nomorUrut int) error
+	CompleteSession(ctx context.Context, sessionToken string) (*entity.TestSession, error)
+	GetTestResult(ctx context.Context, sessionToken string) (*entity.TestSession, []entity.JawabanDetail, error)
+	ListTestSessions(ctx context.Context, tingkatan, idMataPelajaran *int, status *entity.TestStatus, scope *entity.TeacherScope, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error)
+	ListScheduledSessions(ctx context.Context, userID int, lmsClassID *int64, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error)
+	StartScheduledSession(ctx context.Context, userID int, sessionToken string) (*entity.TestSession, error)
 }
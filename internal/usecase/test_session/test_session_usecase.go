@@ -1,9 +1,6 @@
 package test_session
 
 import (
-	"cbt-test-mini-project/internal/entity"
-	"cbt-test-mini-project/internal/repository/auth"
-	"cbt-test-mini-project/internal/repository/test_session"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
@@ -11,6 +8,11 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository/auth"
+	"cbt-test-mini-project/internal/repository/test_session"
+	"cbt-test-mini-project/util/nonce"
 )
 
 // EventPublisher defines the interface for publishing events
@@ -37,8 +39,8 @@ func NewTestSessionUsecase(repo test_session.TestSessionRepository, userRepo aut
 // ... existing code ...
 
 // CompleteSession completes the session and calculates score
-func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.TestSession, error) {
-	session, err := u.ensureSessionAttemptable(sessionToken)
+func (u *testSessionUsecaseImpl) CompleteSession(ctx context.Context, sessionToken string) (*entity.TestSession, error) {
+	session, err := u.ensureSessionAttemptable(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -48,13 +50,13 @@ func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.T
 	}
 
 	// Get all assigned questions
-	allQuestions, err := u.repo.GetAllQuestionsForSession(sessionToken)
+	allQuestions, err := u.repo.GetAllQuestionsForSession(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get all answers
-	answers, err := u.repo.GetSessionAnswers(sessionToken)
+	answers, err := u.repo.GetSessionAnswers(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +71,7 @@ func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.T
 	for _, question := range allQuestions {
 		if !answeredMap[question.NomorUrut] {
 			// Create unanswered record with NULL jawaban_dipilih
-			err := u.repo.CreateUnansweredRecord(question.ID, question.IDTestSession)
+			err := u.repo.CreateUnansweredRecord(ctx, question.ID, question.IDTestSession)
 			if err != nil {
 				// Log error but continue
 				continue
@@ -78,7 +80,7 @@ func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.T
 	}
 
 	// Get updated answers after filling in unanswered questions
-	answers, err = u.repo.GetSessionAnswers(sessionToken)
+	answers, err = u.repo.GetSessionAnswers(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -113,10 +115,26 @@ func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.T
 					scoreFraction = 1
 				}
 				pointTercapai += scoreFraction * point
-				if *ans.NilaiEssay >= 60 {
+				// is_correct is set against the rubric passing score when graded
+				if ans.IsCorrect {
 					jumlahBenar++
 				}
 			}
+		case entity.QuestionTypeGrid:
+			// Partial credit; only a fully correct grid counts as benar
+			if ans.NilaiParsial != nil {
+				scoreFraction := *ans.NilaiParsial / 100
+				if scoreFraction < 0 {
+					scoreFraction = 0
+				}
+				if scoreFraction > 1 {
+					scoreFraction = 1
+				}
+				pointTercapai += scoreFraction * point
+			}
+			if ans.IsCorrect {
+				jumlahBenar++
+			}
 		default:
 			if ans.IsCorrect {
 				jumlahBenar++
@@ -131,22 +149,23 @@ func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.T
 		nilaiAkhir = (pointTercapai / totalPoint) * 100
 	}
 
-	err = u.repo.CompleteSession(sessionToken, time.Now(), &nilaiAkhir, &jumlahBenar, &totalSoal)
+	err = u.repo.CompleteSession(ctx, sessionToken, time.Now(), &nilaiAkhir, &jumlahBenar, &totalSoal)
 	if err != nil {
 		return nil, err
 	}
 
-	hasEssay, err := u.repo.HasEssayQuestions(sessionToken)
+	// Blank essays score zero, so only answered essays hold the session in grading
+	hasPendingEssay, err := u.repo.HasPendingEssays(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
-	if hasEssay {
-		if err := u.repo.UpdateSessionStatus(sessionToken, entity.TestStatusGradingInProgress); err != nil {
+	if hasPendingEssay {
+		if err := u.repo.UpdateSessionStatus(ctx, sessionToken, entity.TestStatusGradingInProgress); err != nil {
 			return nil, err
 		}
 	}
 
-	updatedSession, err := u.repo.GetByToken(sessionToken)
+	updatedSession, err := u.repo.GetByToken(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -155,7 +174,7 @@ func (u *testSessionUsecaseImpl) CompleteSession(sessionToken string) (*entity.T
 }
 
 // CreateTestSession creates a new test session with random questions
-func (u *testSessionUsecaseImpl) CreateTestSession(userID, tingkatan, idMataPelajaran, durasiMenit, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) (*entity.TestSession, error) {
+func (u *testSessionUsecaseImpl) CreateTestSession(ctx context.Context, userID, tingkatan, idMataPelajaran, durasiMenit, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) (*entity.TestSession, error) {
 	fmt.Printf("=== USECASE CreateTestSession: userID=%d, tingkatan=%d, idMataPelajaran=%d ===\n", userID, tingkatan, idMataPelajaran)
 
 	if userID < 1 || tingkatan < 1 || idMataPelajaran < 1 || durasiMenit < 1 || jumlahSoal < 1 {
@@ -169,7 +188,7 @@ func (u *testSessionUsecaseImpl) CreateTestSession(userID, tingkatan, idMataPela
 
 	// Get user to set nama peserta
 	fmt.Printf("=== USECASE: Getting user by ID %d ===\n", userID)
-	user, err := u.userRepo.GetUserByID(context.Background(), int32(userID))
+	user, err := u.userRepo.GetUserByID(ctx, int32(userID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user by ID %d: %w", userID, err)
 	}
@@ -179,7 +198,7 @@ func (u *testSessionUsecaseImpl) CreateTestSession(userID, tingkatan, idMataPela
 	fmt.Printf("=== USECASE: Got user: %s ===\n", user.Nama)
 
 	// Generate unique session token
-	token, err := u.generateToken()
+	token, err := u.generateToken(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
@@ -198,7 +217,7 @@ func (u *testSessionUsecaseImpl) CreateTestSession(userID, tingkatan, idMataPela
 	}
 
 	fmt.Printf("=== USECASE: Creating session in DB ===\n")
-	err = u.repo.Create(session)
+	err = u.repo.Create(ctx, session)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create session: %w", err)
 	}
@@ -206,15 +225,15 @@ func (u *testSessionUsecaseImpl) CreateTestSession(userID, tingkatan, idMataPela
 
 	// Select and assign random questions
 	fmt.Printf("=== USECASE: Assigning random questions ===\n")
-	err = u.repo.AssignRandomQuestions(session.ID, idMataPelajaran, tingkatan, jumlahSoal, includeTypes, randomize)
+	err = u.repo.AssignRandomQuestions(ctx, session.ID, idMataPelajaran, tingkatan, jumlahSoal, includeTypes, randomize)
 	if err != nil {
 		// Cleanup session if question assignment fails
-		u.repo.Delete(session.ID)
+		u.repo.Delete(ctx, session.ID)
 		return nil, fmt.Errorf("failed to assign questions: %w", err)
 	}
 	fmt.Printf("=== USECASE: Questions assigned ===\n")
 
-	result, err := u.repo.GetByToken(token)
+	result, err := u.repo.GetByToken(ctx, token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get created session: %w", err)
 	}
@@ -223,8 +242,8 @@ func (u *testSessionUsecaseImpl) CreateTestSession(userID, tingkatan, idMataPela
 }
 
 // GetTestSession gets by token
-func (u *testSessionUsecaseImpl) GetTestSession(sessionToken string) (*entity.TestSession, error) {
-	session, err := u.repo.GetByToken(sessionToken)
+func (u *testSessionUsecaseImpl) GetTestSession(ctx context.Context, sessionToken string) (*entity.TestSession, error) {
+	session, err := u.repo.GetByToken(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +251,7 @@ func (u *testSessionUsecaseImpl) GetTestSession(sessionToken string) (*entity.Te
 	// Check timeout only if session is still ongoing
 	if session.Status == entity.TestStatusOngoing && time.Now().After(session.BatasWaktu()) {
 		// Just mark as timeout, don't complete yet (let auto-submit or manual completion handle scoring)
-		u.repo.UpdateSessionStatus(sessionToken, entity.TestStatusTimeout)
+		u.repo.UpdateSessionStatus(ctx, sessionToken, entity.TestStatusTimeout)
 		session.Status = entity.TestStatusTimeout
 	}
 
@@ -240,14 +259,14 @@ func (u *testSessionUsecaseImpl) GetTestSession(sessionToken string) (*entity.Te
 }
 
 // GetTestQuestions gets a single question for the student
-func (u *testSessionUsecaseImpl) GetTestQuestions(sessionToken string, nomorUrut int) (*entity.QuestionForStudent, error) {
-	_, err := u.ensureSessionAttemptable(sessionToken)
+func (u *testSessionUsecaseImpl) GetTestQuestions(ctx context.Context, sessionToken string, nomorUrut int) (*entity.QuestionForStudent, error) {
+	_, err := u.ensureSessionAttemptable(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get the TestSessionSoal to determine question type
-	tss, err := u.repo.GetTestSessionSoalByOrder(sessionToken, nomorUrut)
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
 	if err != nil {
 		return nil, err
 	}
@@ -257,10 +276,11 @@ func (u *testSessionUsecaseImpl) GetTestQuestions(sessionToken string, nomorUrut
 		QuestionType: tss.QuestionType,
 		Materi:       tss.Soal.Materi, // Will be nil for drag-drop, need to handle this
 		IsAnswered:   false,           // Will be set below
+		FormatKonten: tss.Soal.FormatKonten,
 	}
 
 	// Get existing answer if any
-	answers, _ := u.repo.GetSessionAnswers(sessionToken)
+	answers, _ := u.repo.GetSessionAnswers(ctx, sessionToken)
 	for _, ans := range answers {
 		if ans.TestSessionSoal.NomorUrut == nomorUrut {
 			question.IsAnswered = true
@@ -268,9 +288,15 @@ func (u *testSessionUsecaseImpl) GetTestQuestions(sessionToken string, nomorUrut
 		}
 	}
 
+	media, err := u.repo.GetSessionMedia(ctx, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	question.Media = groupSessionMedia(media)[nomorUrut]
+
 	if tss.QuestionType == entity.QuestionTypeMultipleChoice && tss.IDSoal != nil {
 		// Handle multiple choice question
-		soal, err := u.repo.GetQuestionByOrder(sessionToken, nomorUrut)
+		soal, err := u.repo.GetQuestionByOrder(ctx, sessionToken, nomorUrut)
 		if err != nil {
 			return nil, err
 		}
@@ -290,11 +316,12 @@ func (u *testSessionUsecaseImpl) GetTestQuestions(sessionToken string, nomorUrut
 		question.MCOpsiB = &soal.OpsiB
 		question.MCOpsiC = &soal.OpsiC
 		question.MCOpsiD = &soal.OpsiD
+		question.MCOpsi = soal.Options()
 		question.MCJawabanDipilih = jawabanDipilih
 		question.MCGambar = soal.Gambar
 
 	} else if tss.QuestionType == entity.QuestionTypeMultipleChoicesComplex && tss.IDSoal != nil {
-		soal, err := u.repo.GetQuestionByOrder(sessionToken, nomorUrut)
+		soal, err := u.repo.GetQuestionByOrder(ctx, sessionToken, nomorUrut)
 		if err != nil {
 			return nil, err
 		}
@@ -314,13 +341,14 @@ func (u *testSessionUsecaseImpl) GetTestQuestions(sessionToken string, nomorUrut
 		question.MCCOpsiB = &soal.OpsiB
 		question.MCCOpsiC = &soal.OpsiC
 		question.MCCOpsiD = &soal.OpsiD
+		question.MCCOpsi = soal.Options()
 		question.MCCJawabanDipilih = jawabanDipilihComplex
 		question.MCCJawabanBenar = soal.GetJawabanBenarComplex()
 		question.MCCGambar = soal.Gambar
 
 	} else if tss.QuestionType == entity.QuestionTypeDragDrop && tss.IDSoalDragDrop != nil {
 		// Handle drag-drop question
-		soalDD, err := u.repo.GetSoalDragDropByID(*tss.IDSoalDragDrop)
+		soalDD, err := u.repo.GetSoalDragDropByID(ctx, *tss.IDSoalDragDrop)
 		if err != nil {
 			return nil, err
 		}
@@ -356,25 +384,96 @@ func (u *testSessionUsecaseImpl) GetTestQuestions(sessionToken string, nomorUrut
 		question.EssayPertanyaan = &tss.Soal.Pertanyaan
 		question.EssayJawaban = jawabanEssay
 		question.EssayScore = nilaiEssay
+	} else if tss.QuestionType == entity.QuestionTypeShortAnswer && tss.Soal != nil {
+		var jawabanShortAnswer []string
+		for _, ans := range answers {
+			if ans.TestSessionSoal.NomorUrut == nomorUrut && ans.QuestionType == entity.QuestionTypeShortAnswer {
+				jawabanShortAnswer = ans.GetJawabanShortAnswer()
+				break
+			}
+		}
+
+		question.Materi = tss.Soal.Materi
+		question.SAID = &tss.Soal.ID
+		question.SAPertanyaan = &tss.Soal.Pertanyaan
+		question.SABlankCount = len(tss.Soal.GetShortAnswerBlanks())
+		question.SAJawaban = jawabanShortAnswer
+		question.SAGambar = tss.Soal.Gambar
+	} else if tss.QuestionType == entity.QuestionTypeNumeric && tss.Soal != nil {
+		var jawabanNumeric *string
+		for _, ans := range answers {
+			if ans.TestSessionSoal.NomorUrut == nomorUrut && ans.QuestionType == entity.QuestionTypeNumeric {
+				jawabanNumeric = ans.JawabanNumeric
+				break
+			}
+		}
+
+		question.Materi = tss.Soal.Materi
+		question.NUMID = &tss.Soal.ID
+		question.NUMPertanyaan = &tss.Soal.Pertanyaan
+		question.NUMJawaban = jawabanNumeric
+		question.NUMGambar = tss.Soal.Gambar
+	} else if tss.QuestionType == entity.QuestionTypeHotspot && tss.Soal != nil {
+		var jawabanHotspot []entity.HotspotPoint
+		for _, ans := range answers {
+			if ans.TestSessionSoal.NomorUrut == nomorUrut && ans.QuestionType == entity.QuestionTypeHotspot {
+				jawabanHotspot = ans.GetJawabanHotspot()
+				break
+			}
+		}
+
+		question.Materi = tss.Soal.Materi
+		question.HSID = &tss.Soal.ID
+		question.HSPertanyaan = &tss.Soal.Pertanyaan
+		question.HSGambar = tss.Soal.Gambar
+		if key := tss.Soal.GetHotspotAnswerKey(); key != nil {
+			question.HSImageUrutan = key.ImageUrutan
+			question.HSMaxPoints = key.PointLimit()
+		}
+		question.HSJawaban = jawabanHotspot
+	} else if tss.QuestionType == entity.QuestionTypeGrid && tss.Soal != nil {
+		var jawabanGrid []int
+		for _, ans := range answers {
+			if ans.TestSessionSoal.NomorUrut == nomorUrut && ans.QuestionType == entity.QuestionTypeGrid {
+				jawabanGrid = ans.GetJawabanGrid()
+				break
+			}
+		}
+
+		question.Materi = tss.Soal.Materi
+		question.GRIDID = &tss.Soal.ID
+		question.GRIDPertanyaan = &tss.Soal.Pertanyaan
+		if key := tss.Soal.GetGridAnswerKey(); key != nil {
+			question.GRIDRows = key.RowTexts()
+			question.GRIDColumns = key.Columns
+		}
+		question.GRIDJawaban = jawabanGrid
+		question.GRIDGambar = tss.Soal.Gambar
 	}
 
 	return question, nil
 }
 
 // GetAllTestQuestions gets all questions for the session
-func (u *testSessionUsecaseImpl) GetAllTestQuestions(sessionToken string) ([]entity.QuestionForStudent, error) {
-	_, err := u.ensureSessionAttemptable(sessionToken)
+func (u *testSessionUsecaseImpl) GetAllTestQuestions(ctx context.Context, sessionToken string) ([]entity.QuestionForStudent, error) {
+	_, err := u.ensureSessionAttemptable(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
 
-	sessionSoals, err := u.repo.GetAllQuestionsForSession(sessionToken)
+	sessionSoals, err := u.repo.GetAllQuestionsForSession(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get existing answers
-	answers, _ := u.repo.GetSessionAnswers(sessionToken)
+	answers, _ := u.repo.GetSessionAnswers(ctx, sessionToken)
+
+	media, err := u.repo.GetSessionMedia(ctx, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	mediaByNomor := groupSessionMedia(media)
 
 	var questions []entity.QuestionForStudent
 	for _, tss := range sessionSoals {
@@ -382,6 +481,8 @@ func (u *testSessionUsecaseImpl) GetAllTestQuestions(sessionToken string) ([]ent
 			NomorUrut:    tss.NomorUrut,
 			QuestionType: tss.QuestionType,
 			IsAnswered:   false, // Will be set below
+			FormatKonten: tss.Soal.FormatKonten,
+			Media:        mediaByNomor[tss.NomorUrut],
 		}
 
 		// Check if answered
@@ -409,6 +510,7 @@ func (u *testSessionUsecaseImpl) GetAllTestQuestions(sessionToken string) ([]ent
 			question.MCOpsiB = &tss.Soal.OpsiB
 			question.MCOpsiC = &tss.Soal.OpsiC
 			question.MCOpsiD = &tss.Soal.OpsiD
+			question.MCOpsi = tss.Soal.Options()
 			question.MCJawabanDipilih = jawabanDipilih
 			question.MCGambar = tss.Soal.Gambar
 
@@ -428,6 +530,7 @@ func (u *testSessionUsecaseImpl) GetAllTestQuestions(sessionToken string) ([]ent
 			question.MCCOpsiB = &tss.Soal.OpsiB
 			question.MCCOpsiC = &tss.Soal.OpsiC
 			question.MCCOpsiD = &tss.Soal.OpsiD
+			question.MCCOpsi = tss.Soal.Options()
 			question.MCCJawabanDipilih = jawabanDipilihComplex
 			question.MCCJawabanBenar = tss.Soal.GetJawabanBenarComplex()
 			question.MCCGambar = tss.Soal.Gambar
@@ -465,6 +568,71 @@ func (u *testSessionUsecaseImpl) GetAllTestQuestions(sessionToken string) ([]ent
 			question.EssayPertanyaan = &tss.Soal.Pertanyaan
 			question.EssayJawaban = jawabanEssay
 			question.EssayScore = nilaiEssay
+		} else if tss.QuestionType == entity.QuestionTypeShortAnswer && tss.Soal != nil && tss.Soal.ID > 0 {
+			var jawabanShortAnswer []string
+			for _, ans := range answers {
+				if ans.TestSessionSoal.NomorUrut == tss.NomorUrut && ans.QuestionType == entity.QuestionTypeShortAnswer {
+					jawabanShortAnswer = ans.GetJawabanShortAnswer()
+					break
+				}
+			}
+
+			question.Materi = tss.Soal.Materi
+			question.SAID = &tss.Soal.ID
+			question.SAPertanyaan = &tss.Soal.Pertanyaan
+			question.SABlankCount = len(tss.Soal.GetShortAnswerBlanks())
+			question.SAJawaban = jawabanShortAnswer
+			question.SAGambar = tss.Soal.Gambar
+		} else if tss.QuestionType == entity.QuestionTypeNumeric && tss.Soal != nil && tss.Soal.ID > 0 {
+			var jawabanNumeric *string
+			for _, ans := range answers {
+				if ans.TestSessionSoal.NomorUrut == tss.NomorUrut && ans.QuestionType == entity.QuestionTypeNumeric {
+					jawabanNumeric = ans.JawabanNumeric
+					break
+				}
+			}
+
+			question.Materi = tss.Soal.Materi
+			question.NUMID = &tss.Soal.ID
+			question.NUMPertanyaan = &tss.Soal.Pertanyaan
+			question.NUMJawaban = jawabanNumeric
+			question.NUMGambar = tss.Soal.Gambar
+		} else if tss.QuestionType == entity.QuestionTypeHotspot && tss.Soal != nil && tss.Soal.ID > 0 {
+			var jawabanHotspot []entity.HotspotPoint
+			for _, ans := range answers {
+				if ans.TestSessionSoal.NomorUrut == tss.NomorUrut && ans.QuestionType == entity.QuestionTypeHotspot {
+					jawabanHotspot = ans.GetJawabanHotspot()
+					break
+				}
+			}
+
+			question.Materi = tss.Soal.Materi
+			question.HSID = &tss.Soal.ID
+			question.HSPertanyaan = &tss.Soal.Pertanyaan
+			question.HSGambar = tss.Soal.Gambar
+			if key := tss.Soal.GetHotspotAnswerKey(); key != nil {
+				question.HSImageUrutan = key.ImageUrutan
+				question.HSMaxPoints = key.PointLimit()
+			}
+			question.HSJawaban = jawabanHotspot
+		} else if tss.QuestionType == entity.QuestionTypeGrid && tss.Soal != nil && tss.Soal.ID > 0 {
+			var jawabanGrid []int
+			for _, ans := range answers {
+				if ans.TestSessionSoal.NomorUrut == tss.NomorUrut && ans.QuestionType == entity.QuestionTypeGrid {
+					jawabanGrid = ans.GetJawabanGrid()
+					break
+				}
+			}
+
+			question.Materi = tss.Soal.Materi
+			question.GRIDID = &tss.Soal.ID
+			question.GRIDPertanyaan = &tss.Soal.Pertanyaan
+			if key := tss.Soal.GetGridAnswerKey(); key != nil {
+				question.GRIDRows = key.RowTexts()
+				question.GRIDColumns = key.Columns
+			}
+			question.GRIDJawaban = jawabanGrid
+			question.GRIDGambar = tss.Soal.Gambar
 		}
 
 		questions = append(questions, *question)
@@ -474,32 +642,36 @@ func (u *testSessionUsecaseImpl) GetAllTestQuestions(sessionToken string) ([]ent
 }
 
 // GetSessionAnswers gets all answers for the session
-func (u *testSessionUsecaseImpl) GetSessionAnswers(sessionToken string) ([]entity.JawabanSiswa, error) {
-	_, err := u.GetTestSession(sessionToken)
+func (u *testSessionUsecaseImpl) GetSessionAnswers(ctx context.Context, sessionToken string) ([]entity.JawabanSiswa, error) {
+	_, err := u.GetTestSession(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
 
-	return u.repo.GetSessionAnswers(sessionToken)
+	return u.repo.GetSessionAnswers(ctx, sessionToken)
 }
 
 // SubmitAnswer submits or updates an answer
-func (u *testSessionUsecaseImpl) SubmitAnswer(sessionToken string, nomorUrut int, jawaban entity.JawabanOption) error {
-	_, err := u.ensureSessionWritable(sessionToken)
+func (u *testSessionUsecaseImpl) SubmitAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban entity.JawabanOption) error {
+	if !jawaban.IsValid() {
+		return errors.New("invalid jawaban option")
+	}
+
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
 	if err != nil {
 		return err
 	}
 
-	return u.repo.SubmitAnswer(sessionToken, nomorUrut, jawaban)
+	return u.repo.SubmitAnswer(ctx, sessionToken, nomorUrut, jawaban)
 }
 
-func (u *testSessionUsecaseImpl) SubmitComplexAnswer(sessionToken string, nomorUrut int, jawaban []entity.JawabanOption) error {
+func (u *testSessionUsecaseImpl) SubmitComplexAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban []entity.JawabanOption) error {
 	if len(jawaban) == 0 {
 		return errors.New("jawaban complex cannot be empty")
 	}
 	seen := map[entity.JawabanOption]struct{}{}
 	for _, option := range jawaban {
-		if option < entity.JawabanA || option > entity.JawabanD {
+		if !option.IsValid() {
 			return errors.New("invalid jawaban complex option")
 		}
 		if _, exists := seen[option]; exists {
@@ -508,12 +680,12 @@ func (u *testSessionUsecaseImpl) SubmitComplexAnswer(sessionToken string, nomorU
 		seen[option] = struct{}{}
 	}
 
-	_, err := u.ensureSessionWritable(sessionToken)
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
 	if err != nil {
 		return err
 	}
 
-	tss, err := u.repo.GetTestSessionSoalByOrder(sessionToken, nomorUrut)
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
 	if err != nil {
 		return err
 	}
@@ -521,18 +693,218 @@ func (u *testSessionUsecaseImpl) SubmitComplexAnswer(sessionToken string, nomorU
 		return errors.New("this is not a complex multiple-choice question")
 	}
 
-	return u.repo.SubmitComplexAnswer(sessionToken, nomorUrut, jawaban)
+	return u.repo.SubmitComplexAnswer(ctx, sessionToken, nomorUrut, jawaban)
+}
+
+// SubmitShortAnswer submits the responses to a short-answer or cloze question, one per
+// blank. The answer counts as correct only when every blank is accepted.
+func (u *testSessionUsecaseImpl) SubmitShortAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban []string) error {
+	answered := false
+	for _, response := range jawaban {
+		if strings.TrimSpace(response) != "" {
+			answered = true
+			break
+		}
+	}
+	if !answered {
+		return errors.New("jawaban short answer cannot be empty")
+	}
+
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
+	if err != nil {
+		return err
+	}
+
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeShortAnswer || tss.Soal == nil {
+		return errors.New("this is not a short-answer question")
+	}
+
+	blanks := tss.Soal.GetShortAnswerBlanks()
+	if len(blanks) == 0 {
+		return errors.New("short answer blanks are not configured")
+	}
+	if len(jawaban) > len(blanks) {
+		return fmt.Errorf("this question has %d blank(s), got %d responses", len(blanks), len(jawaban))
+	}
+
+	isCorrect := true
+	for _, correct := range tss.Soal.CheckShortAnswer(jawaban) {
+		isCorrect = isCorrect && correct
+	}
+
+	return u.repo.SubmitShortAnswer(ctx, sessionToken, nomorUrut, jawaban, isCorrect)
+}
+
+// SubmitNumericAnswer submits the response to a numeric question. Responses that cannot be
+// read as a number are rejected so the student can fix the input.
+func (u *testSessionUsecaseImpl) SubmitNumericAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban string) error {
+	if strings.TrimSpace(jawaban) == "" {
+		return errors.New("jawaban numeric cannot be empty")
+	}
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
+	if err != nil {
+		return err
+	}
+
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeNumeric || tss.Soal == nil {
+		return errors.New("this is not a numeric question")
+	}
+
+	key := tss.Soal.GetNumericAnswerKey()
+	if key == nil {
+		return errors.New("numeric answer key is not configured")
+	}
+	response, err := entity.ParseNumericResponse(jawaban, key.DecimalSeparator)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.SubmitNumericAnswer(ctx, sessionToken, nomorUrut, jawaban, key.Check(*response).IsCorrect())
+}
+
+// SubmitHotspotAnswer submits the points a student placed on a hotspot image. The answer is
+// scored against the regions of the key here so the key never reaches the client.
+func (u *testSessionUsecaseImpl) SubmitHotspotAnswer(ctx context.Context, sessionToken string, nomorUrut int, points []entity.HotspotPoint) error {
+	if len(points) == 0 {
+		return errors.New("jawaban hotspot cannot be empty")
+	}
+	for _, p := range points {
+		if !p.InBounds() {
+			return errors.New("jawaban hotspot points must lie within the image")
+		}
+	}
+
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
+	if err != nil {
+		return err
+	}
+
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeHotspot || tss.Soal == nil {
+		return errors.New("this is not a hotspot question")
+	}
+
+	key := tss.Soal.GetHotspotAnswerKey()
+	if key == nil {
+		return errors.New("hotspot answer key is not configured")
+	}
+	if len(points) > key.PointLimit() {
+		return fmt.Errorf("jawaban hotspot allows at most %d points", key.PointLimit())
+	}
+
+	isCorrect, _ := key.Check(points)
+	return u.repo.SubmitHotspotAnswer(ctx, sessionToken, nomorUrut, points, isCorrect)
+}
+
+// SubmitGridAnswer submits the column picked for each row of a grid question. Rows are
+// scored on their own; the earned percentage is kept for CompleteSession.
+func (u *testSessionUsecaseImpl) SubmitGridAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawaban []int) error {
+	if !entity.IsGridAnswered(jawaban) {
+		return errors.New("jawaban grid cannot be empty")
+	}
+
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
+	if err != nil {
+		return err
+	}
+
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
+	if err != nil {
+		return err
+	}
+	if tss.QuestionType != entity.QuestionTypeGrid || tss.Soal == nil {
+		return errors.New("this is not a grid question")
+	}
+
+	key := tss.Soal.GetGridAnswerKey()
+	if key == nil {
+		return errors.New("grid answer key is not configured")
+	}
+	if err := key.ValidateJawaban(jawaban); err != nil {
+		return err
+	}
+
+	isCorrect, fraction, _ := key.Check(jawaban)
+	return u.repo.SubmitGridAnswer(ctx, sessionToken, nomorUrut, jawaban, isCorrect, fraction*100)
+}
+
+// groupSessionMedia groups session clips by the nomor_urut of their question
+func groupSessionMedia(media []entity.SessionMedia) map[int][]entity.SessionMedia {
+	byNomor := make(map[int][]entity.SessionMedia)
+	for _, m := range media {
+		byNomor[m.NomorUrut] = append(byNomor[m.NomorUrut], m)
+	}
+	return byNomor
+}
+
+// RecordMediaPlay counts a play of a question clip before the student streams it. The
+// returned clip carries the stream nonce of the play.
+func (u *testSessionUsecaseImpl) RecordMediaPlay(ctx context.Context, sessionToken string, nomorUrut, idMedia int) (*entity.SessionMedia, error) {
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	streamNonce, streamNonceHash, err := nonce.New()
+	if err != nil {
+		return nil, err
+	}
+	media, err := u.repo.RecordMediaPlay(ctx, sessionToken, nomorUrut, idMedia, streamNonceHash)
+	if err != nil {
+		return nil, err
+	}
+	media.StreamNonce = streamNonce
+	return media, nil
+}
+
+// GetMediaStreamSource returns the clip to stream for a student. A clip with a play limit is
+// only served once per recorded play, for the stream nonce of that play.
+func (u *testSessionUsecaseImpl) GetMediaStreamSource(ctx context.Context, sessionToken string, nomorUrut, idMedia int, streamNonce string) (*entity.SessionMedia, error) {
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := u.repo.GetSessionMediaByID(ctx, sessionToken, nomorUrut, idMedia)
+	if err != nil {
+		return nil, err
+	}
+	if media.MaxPutar == 0 {
+		return media, nil
+	}
+	if !media.CanStream(time.Now()) {
+		return nil, entity.ErrMediaPlayNotRecorded
+	}
+	claimed, err := u.repo.ClaimMediaStream(ctx, sessionToken, nomorUrut, idMedia, nonce.Hash(streamNonce))
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, entity.ErrMediaPlayNotRecorded
+	}
+	return media, nil
 }
 
 // SubmitDragDropAnswer submits a drag-drop answer with all-or-nothing scoring
-func (u *testSessionUsecaseImpl) SubmitDragDropAnswer(sessionToken string, nomorUrut int, answer map[int]int) error {
-	_, err := u.ensureSessionWritable(sessionToken)
+func (u *testSessionUsecaseImpl) SubmitDragDropAnswer(ctx context.Context, sessionToken string, nomorUrut int, answer map[int]int) error {
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
 	if err != nil {
 		return err
 	}
 
 	// Get the TestSessionSoal to find the drag-drop question
-	tss, err := u.repo.GetTestSessionSoalByOrder(sessionToken, nomorUrut)
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
 	if err != nil {
 		return err
 	}
@@ -543,28 +915,28 @@ func (u *testSessionUsecaseImpl) SubmitDragDropAnswer(sessionToken string, nomor
 	}
 
 	// Get correct answers
-	correctAnswers, err := u.repo.GetDragDropCorrectAnswers(*tss.IDSoalDragDrop)
+	correctAnswers, err := u.repo.GetDragDropCorrectAnswers(ctx, *tss.IDSoalDragDrop)
 	if err != nil {
 		return err
 	}
 
 	// All-or-nothing scoring: check if all answers are correct
-	isCorrect := u.checkDragDropAnswer(correctAnswers, answer)
+	isCorrect := u.checkDragDropAnswer(ctx, correctAnswers, answer)
 
-	return u.repo.SubmitDragDropAnswer(sessionToken, nomorUrut, answer, isCorrect)
+	return u.repo.SubmitDragDropAnswer(ctx, sessionToken, nomorUrut, answer, isCorrect)
 }
 
-func (u *testSessionUsecaseImpl) SubmitEssayAnswer(sessionToken string, nomorUrut int, jawabanEssay string) error {
+func (u *testSessionUsecaseImpl) SubmitEssayAnswer(ctx context.Context, sessionToken string, nomorUrut int, jawabanEssay string) error {
 	if strings.TrimSpace(jawabanEssay) == "" {
 		return errors.New("jawaban essay cannot be empty")
 	}
 
-	_, err := u.ensureSessionWritable(sessionToken)
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
 	if err != nil {
 		return err
 	}
 
-	tss, err := u.repo.GetTestSessionSoalByOrder(sessionToken, nomorUrut)
+	tss, err := u.repo.GetTestSessionSoalByOrder(ctx, sessionToken, nomorUrut)
 	if err != nil {
 		return err
 	}
@@ -572,23 +944,11 @@ func (u *testSessionUsecaseImpl) SubmitEssayAnswer(sessionToken string, nomorUru
 		return errors.New("this is not an essay question")
 	}
 
-	return u.repo.SubmitEssayAnswer(sessionToken, nomorUrut, jawabanEssay)
-}
-
-func (u *testSessionUsecaseImpl) GradeEssayAnswer(answerID int, score float64, feedback string) error {
-	if answerID < 1 {
-		return errors.New("answer_id must be positive")
-	}
-	if score < 0 || score > 100 {
-		return errors.New("score must be between 0 and 100")
-	}
-
-	_, err := u.repo.GradeEssayAnswer(answerID, score, feedback)
-	return err
+	return u.repo.SubmitEssayAnswer(ctx, sessionToken, nomorUrut, jawabanEssay)
 }
 
 // checkDragDropAnswer implements all-or-nothing scoring
-func (u *testSessionUsecaseImpl) checkDragDropAnswer(correctAnswers []entity.DragCorrectAnswer, userAnswer map[int]int) bool {
+func (u *testSessionUsecaseImpl) checkDragDropAnswer(ctx context.Context, correctAnswers []entity.DragCorrectAnswer, userAnswer map[int]int) bool {
 	if len(userAnswer) != len(correctAnswers) {
 		return false // Not all items answered
 	}
@@ -602,18 +962,18 @@ func (u *testSessionUsecaseImpl) checkDragDropAnswer(correctAnswers []entity.Dra
 }
 
 // ClearAnswer clears an answer
-func (u *testSessionUsecaseImpl) ClearAnswer(sessionToken string, nomorUrut int) error {
-	_, err := u.ensureSessionWritable(sessionToken)
+func (u *testSessionUsecaseImpl) ClearAnswer(ctx context.Context, sessionToken string, nomorUrut int) error {
+	_, err := u.ensureSessionWritable(ctx, sessionToken)
 	if err != nil {
 		return err
 	}
 
-	return u.repo.ClearAnswer(sessionToken, nomorUrut)
+	return u.repo.ClearAnswer(ctx, sessionToken, nomorUrut)
 }
 
 // GetTestResult gets the test result
-func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.TestSession, []entity.JawabanDetail, error) {
-	session, err := u.repo.GetByToken(sessionToken)
+func (u *testSessionUsecaseImpl) GetTestResult(ctx context.Context, sessionToken string) (*entity.TestSession, []entity.JawabanDetail, error) {
+	session, err := u.repo.GetByToken(ctx, sessionToken)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -623,14 +983,14 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 	}
 
 	// Get all assigned questions (for proper ordering)
-	allQuestions, err := u.repo.GetAllQuestionsForSession(sessionToken)
+	allQuestions, err := u.repo.GetAllQuestionsForSession(ctx, sessionToken)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Get detailed answers
 	var details []entity.JawabanDetail
-	answers, err := u.repo.GetSessionAnswers(sessionToken)
+	answers, err := u.repo.GetSessionAnswers(ctx, sessionToken)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -641,6 +1001,11 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 		answersMap[ans.TestSessionSoal.NomorUrut] = &answers[i]
 	}
 
+	rubricScores, err := u.repo.GetRubricScoresBySession(ctx, sessionToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Process all questions in order
 	// Process all questions in order
 	for _, question := range allQuestions {
@@ -677,7 +1042,7 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 			}
 
 			// Fetch correct answers (needed for result view)
-			correctAnswersList, err := u.repo.GetDragDropCorrectAnswers(dd.ID)
+			correctAnswersList, err := u.repo.GetDragDropCorrectAnswers(ctx, dd.ID)
 			if err == nil {
 				detail.CorrectDragAnswer = make(map[int]int)
 				for _, ca := range correctAnswersList {
@@ -700,6 +1065,7 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 			// Use loaded Soal info directly
 
 			detail.Pertanyaan = question.Soal.Pertanyaan
+			detail.FormatKonten = question.Soal.FormatKonten
 			switch question.QuestionType {
 			case entity.QuestionTypeEssay:
 				detail.OpsiA = ""
@@ -712,13 +1078,27 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 				detail.OpsiB = question.Soal.OpsiB
 				detail.OpsiC = question.Soal.OpsiC
 				detail.OpsiD = question.Soal.OpsiD
+				detail.Opsi = question.Soal.Options()
 				detail.JawabanBenar = ""
 				detail.JawabanBenarComplex = question.Soal.GetJawabanBenarComplex()
+			case entity.QuestionTypeShortAnswer:
+				detail.JawabanBenar = ""
+				detail.ShortAnswerBlanks = question.Soal.GetShortAnswerBlanks()
+			case entity.QuestionTypeNumeric:
+				detail.JawabanBenar = ""
+				detail.NumericAnswerKey = question.Soal.GetNumericAnswerKey()
+			case entity.QuestionTypeHotspot:
+				detail.JawabanBenar = ""
+				detail.HotspotAnswerKey = question.Soal.GetHotspotAnswerKey()
+			case entity.QuestionTypeGrid:
+				detail.JawabanBenar = ""
+				detail.GridAnswerKey = question.Soal.GetGridAnswerKey()
 			default:
 				detail.OpsiA = question.Soal.OpsiA
 				detail.OpsiB = question.Soal.OpsiB
 				detail.OpsiC = question.Soal.OpsiC
 				detail.OpsiD = question.Soal.OpsiD
+				detail.Opsi = question.Soal.Options()
 				detail.JawabanBenar = question.Soal.JawabanBenar
 			}
 			detail.Pembahasan = question.Soal.Pembahasan
@@ -732,11 +1112,36 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 					detail.NilaiEssay = ans.NilaiEssay
 					detail.FeedbackTeacher = ans.FeedbackTeacher
 					detail.IsAnswered = ans.JawabanEssay != nil && strings.TrimSpace(*ans.JawabanEssay) != ""
-					detail.IsCorrect = ans.NilaiEssay != nil && *ans.NilaiEssay >= 60
+					detail.IsCorrect = ans.NilaiEssay != nil && ans.IsCorrect
+					detail.RubricScores = rubricScores[question.NomorUrut]
 				case entity.QuestionTypeMultipleChoicesComplex:
 					detail.JawabanDipilihComplex = ans.GetJawabanDipilihComplex()
 					detail.IsCorrect = ans.IsCorrect
 					detail.IsAnswered = len(detail.JawabanDipilihComplex) > 0
+				case entity.QuestionTypeShortAnswer:
+					detail.JawabanShortAnswer = ans.GetJawabanShortAnswer()
+					detail.ShortAnswerBlankCorrect = question.Soal.CheckShortAnswer(detail.JawabanShortAnswer)
+					detail.IsCorrect = ans.IsCorrect
+					detail.IsAnswered = len(detail.JawabanShortAnswer) > 0
+				case entity.QuestionTypeNumeric:
+					detail.JawabanNumeric = ans.JawabanNumeric
+					detail.IsCorrect = ans.IsCorrect
+					detail.IsAnswered = ans.JawabanNumeric != nil && strings.TrimSpace(*ans.JawabanNumeric) != ""
+				case entity.QuestionTypeHotspot:
+					detail.JawabanHotspot = ans.GetJawabanHotspot()
+					if key := question.Soal.GetHotspotAnswerKey(); key != nil {
+						_, detail.HotspotPointCorrect = key.Check(detail.JawabanHotspot)
+					}
+					detail.IsCorrect = ans.IsCorrect
+					detail.IsAnswered = len(detail.JawabanHotspot) > 0
+				case entity.QuestionTypeGrid:
+					detail.JawabanGrid = ans.GetJawabanGrid()
+					if key := question.Soal.GetGridAnswerKey(); key != nil {
+						_, _, detail.GridRowCorrect = key.Check(detail.JawabanGrid)
+					}
+					detail.NilaiParsial = ans.NilaiParsial
+					detail.IsCorrect = ans.IsCorrect
+					detail.IsAnswered = entity.IsGridAnswered(detail.JawabanGrid)
 				default:
 					detail.JawabanDipilih = ans.JawabanDipilih
 					detail.IsCorrect = ans.IsCorrect
@@ -755,8 +1160,8 @@ func (u *testSessionUsecaseImpl) GetTestResult(sessionToken string) (*entity.Tes
 	return session, details, nil
 }
 
-// ListTestSessions lists sessions for admin
-func (u *testSessionUsecaseImpl) ListTestSessions(tingkatan, idMataPelajaran *int, status *entity.TestStatus, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error) {
+// ListTestSessions lists sessions for staff; teachers pass a scope limiting it to their classes
+func (u *testSessionUsecaseImpl) ListTestSessions(ctx context.Context, tingkatan, idMataPelajaran *int, status *entity.TestStatus, scope *entity.TeacherScope, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -765,7 +1170,7 @@ func (u *testSessionUsecaseImpl) ListTestSessions(tingkatan, idMataPelajaran *in
 	}
 
 	offset := (page - 1) * pageSize
-	sessions, total, err := u.repo.List(tingkatan, idMataPelajaran, status, pageSize, offset)
+	sessions, total, err := u.repo.List(ctx, tingkatan, idMataPelajaran, status, scope, pageSize, offset)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -781,7 +1186,7 @@ func (u *testSessionUsecaseImpl) ListTestSessions(tingkatan, idMataPelajaran *in
 	return sessions, pagination, nil
 }
 
-func (u *testSessionUsecaseImpl) ListScheduledSessions(userID int, lmsClassID *int64, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error) {
+func (u *testSessionUsecaseImpl) ListScheduledSessions(ctx context.Context, userID int, lmsClassID *int64, page, pageSize int) ([]entity.TestSession, *entity.PaginationResponse, error) {
 	if userID < 1 {
 		return nil, nil, errors.New("invalid user id")
 	}
@@ -793,7 +1198,7 @@ func (u *testSessionUsecaseImpl) ListScheduledSessions(userID int, lmsClassID *i
 	}
 
 	offset := (page - 1) * pageSize
-	sessions, total, err := u.repo.ListScheduledByUser(userID, lmsClassID, pageSize, offset)
+	sessions, total, err := u.repo.ListScheduledByUser(ctx, userID, lmsClassID, pageSize, offset)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -809,7 +1214,7 @@ func (u *testSessionUsecaseImpl) ListScheduledSessions(userID int, lmsClassID *i
 	return sessions, pagination, nil
 }
 
-func (u *testSessionUsecaseImpl) StartScheduledSession(userID int, sessionToken string) (*entity.TestSession, error) {
+func (u *testSessionUsecaseImpl) StartScheduledSession(ctx context.Context, userID int, sessionToken string) (*entity.TestSession, error) {
 	if userID < 1 {
 		return nil, errors.New("invalid user id")
 	}
@@ -817,7 +1222,7 @@ func (u *testSessionUsecaseImpl) StartScheduledSession(userID int, sessionToken
 		return nil, errors.New("session token is required")
 	}
 
-	session, err := u.repo.GetByToken(sessionToken)
+	session, err := u.repo.GetByToken(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -831,12 +1236,12 @@ func (u *testSessionUsecaseImpl) StartScheduledSession(userID int, sessionToken
 			return nil, fmt.Errorf("session is scheduled to start at %s", session.WaktuMulai.Format(time.RFC3339))
 		}
 
-		started, err := u.repo.StartScheduledSession(sessionToken, now)
+		started, err := u.repo.StartScheduledSession(ctx, sessionToken, now)
 		if err != nil {
 			return nil, err
 		}
 		if started {
-			session, err = u.repo.GetByToken(sessionToken)
+			session, err = u.repo.GetByToken(ctx, sessionToken)
 			if err != nil {
 				return nil, err
 			}
@@ -850,8 +1255,8 @@ func (u *testSessionUsecaseImpl) StartScheduledSession(userID int, sessionToken
 	return session, nil
 }
 
-func (u *testSessionUsecaseImpl) ensureSessionAttemptable(sessionToken string) (*entity.TestSession, error) {
-	session, err := u.repo.GetByToken(sessionToken)
+func (u *testSessionUsecaseImpl) ensureSessionAttemptable(ctx context.Context, sessionToken string) (*entity.TestSession, error) {
+	session, err := u.repo.GetByToken(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -862,12 +1267,12 @@ func (u *testSessionUsecaseImpl) ensureSessionAttemptable(sessionToken string) (
 			return nil, fmt.Errorf("session is scheduled to start at %s", session.WaktuMulai.Format(time.RFC3339))
 		}
 
-		started, err := u.repo.StartScheduledSession(sessionToken, now)
+		started, err := u.repo.StartScheduledSession(ctx, sessionToken, now)
 		if err != nil {
 			return nil, err
 		}
 		if started {
-			session, err = u.repo.GetByToken(sessionToken)
+			session, err = u.repo.GetByToken(ctx, sessionToken)
 			if err != nil {
 				return nil, err
 			}
@@ -875,7 +1280,7 @@ func (u *testSessionUsecaseImpl) ensureSessionAttemptable(sessionToken string) (
 	}
 
 	if session.Status == entity.TestStatusOngoing && session.DurasiMenit > 0 && time.Now().After(session.BatasWaktu()) {
-		u.repo.UpdateSessionStatus(sessionToken, entity.TestStatusTimeout)
+		u.repo.UpdateSessionStatus(ctx, sessionToken, entity.TestStatusTimeout)
 		session.Status = entity.TestStatusTimeout
 	}
 
@@ -886,8 +1291,8 @@ func (u *testSessionUsecaseImpl) ensureSessionAttemptable(sessionToken string) (
 	return session, nil
 }
 
-func (u *testSessionUsecaseImpl) ensureSessionWritable(sessionToken string) (*entity.TestSession, error) {
-	session, err := u.ensureSessionAttemptable(sessionToken)
+func (u *testSessionUsecaseImpl) ensureSessionWritable(ctx context.Context, sessionToken string) (*entity.TestSession, error) {
+	session, err := u.ensureSessionAttemptable(ctx, sessionToken)
 	if err != nil {
 		return nil, err
 	}
@@ -901,7 +1306,7 @@ func (u *testSessionUsecaseImpl) ensureSessionWritable(sessionToken string) (*en
 
 // Helper functions
 
-func (u *testSessionUsecaseImpl) generateToken() (string, error) {
+func (u *testSessionUsecaseImpl) generateToken(ctx context.Context) (string, error) {
 	bytes := make([]byte, 16)
 	_, err := rand.Read(bytes)
 	if err != nil {
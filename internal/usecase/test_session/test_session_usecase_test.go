@@ -8,6 +8,7 @@ import (
 	base "cbt-test-mini-project/gen/proto"
 	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/usecase/test_session"
+	"cbt-test-mini-project/util/nonce"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -87,166 +88,225 @@ func (m *MockUserRepo) ListUsers(ctx context.Context, role int32, statusFilter i
 	return args.Get(0).([]*base.User), args.Get(1).(int), args.Error(2)
 }
 
+func (m *MockUserRepo) ChangePassword(ctx context.Context, id int32, currentPassword, newPassword string) error {
+	args := m.Called(ctx, id, currentPassword, newPassword)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetUserSchoolID(ctx context.Context, id int32) (int64, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 type MockTestSessionRepo struct {
 	mock.Mock
 }
 
-func (m *MockTestSessionRepo) Create(session *entity.TestSession) error {
-	args := m.Called(session)
+func (m *MockTestSessionRepo) Create(ctx context.Context, session *entity.TestSession) error {
+	args := m.Called(ctx, session)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) Update(session *entity.TestSession) error {
-	args := m.Called(session)
+func (m *MockTestSessionRepo) Update(ctx context.Context, session *entity.TestSession) error {
+	args := m.Called(ctx, session)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) GetByToken(token string) (*entity.TestSession, error) {
-	args := m.Called(token)
+func (m *MockTestSessionRepo) GetByToken(ctx context.Context, token string) (*entity.TestSession, error) {
+	args := m.Called(ctx, token)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.TestSession), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) AssignRandomQuestions(sessionID, idMataPelajaran, idTingkat, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) error {
-	args := m.Called(sessionID, idMataPelajaran, idTingkat, jumlahSoal, includeTypes, randomize)
+func (m *MockTestSessionRepo) AssignRandomQuestions(ctx context.Context, sessionID, idMataPelajaran, idTingkat, jumlahSoal int, includeTypes []entity.QuestionType, randomize bool) error {
+	args := m.Called(ctx, sessionID, idMataPelajaran, idTingkat, jumlahSoal, includeTypes, randomize)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) GetTestSessionSoalByOrder(token string, nomorUrut int) (*entity.TestSessionSoal, error) {
-	args := m.Called(token, nomorUrut)
+func (m *MockTestSessionRepo) GetTestSessionSoalByOrder(ctx context.Context, token string, nomorUrut int) (*entity.TestSessionSoal, error) {
+	args := m.Called(ctx, token, nomorUrut)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.TestSessionSoal), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) GetQuestionByOrder(token string, nomorUrut int) (*entity.Soal, error) {
-	args := m.Called(token, nomorUrut)
+func (m *MockTestSessionRepo) GetQuestionByOrder(ctx context.Context, token string, nomorUrut int) (*entity.Soal, error) {
+	args := m.Called(ctx, token, nomorUrut)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.Soal), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) SubmitAnswer(token string, nomorUrut int, jawaban entity.JawabanOption) error {
-	args := m.Called(token, nomorUrut, jawaban)
+func (m *MockTestSessionRepo) SubmitAnswer(ctx context.Context, token string, nomorUrut int, jawaban entity.JawabanOption) error {
+	args := m.Called(ctx, token, nomorUrut, jawaban)
+	return args.Error(0)
+}
+
+func (m *MockTestSessionRepo) SubmitComplexAnswer(ctx context.Context, token string, nomorUrut int, jawaban []entity.JawabanOption) error {
+	args := m.Called(ctx, token, nomorUrut, jawaban)
+	return args.Error(0)
+}
+
+func (m *MockTestSessionRepo) SubmitShortAnswer(ctx context.Context, token string, nomorUrut int, jawaban []string, isCorrect bool) error {
+	args := m.Called(ctx, token, nomorUrut, jawaban, isCorrect)
+	return args.Error(0)
+}
+
+func (m *MockTestSessionRepo) SubmitNumericAnswer(ctx context.Context, token string, nomorUrut int, jawaban string, isCorrect bool) error {
+	args := m.Called(ctx, token, nomorUrut, jawaban, isCorrect)
+	return args.Error(0)
+}
+
+func (m *MockTestSessionRepo) SubmitHotspotAnswer(ctx context.Context, token string, nomorUrut int, points []entity.HotspotPoint, isCorrect bool) error {
+	args := m.Called(ctx, token, nomorUrut, points, isCorrect)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) SubmitComplexAnswer(token string, nomorUrut int, jawaban []entity.JawabanOption) error {
-	args := m.Called(token, nomorUrut, jawaban)
+func (m *MockTestSessionRepo) SubmitGridAnswer(ctx context.Context, token string, nomorUrut int, jawaban []int, isCorrect bool, nilaiParsial float64) error {
+	args := m.Called(ctx, token, nomorUrut, jawaban, isCorrect, nilaiParsial)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) GetAllQuestionsForSession(token string) ([]entity.TestSessionSoal, error) {
-	args := m.Called(token)
+func (m *MockTestSessionRepo) GetSessionMedia(ctx context.Context, token string) ([]entity.SessionMedia, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.SessionMedia), args.Error(1)
+}
+
+func (m *MockTestSessionRepo) GetSessionMediaByID(ctx context.Context, token string, nomorUrut, idMedia int) (*entity.SessionMedia, error) {
+	args := m.Called(ctx, token, nomorUrut, idMedia)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SessionMedia), args.Error(1)
+}
+
+func (m *MockTestSessionRepo) RecordMediaPlay(ctx context.Context, token string, nomorUrut, idMedia int, streamNonceHash string) (*entity.SessionMedia, error) {
+	args := m.Called(ctx, token, nomorUrut, idMedia, streamNonceHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SessionMedia), args.Error(1)
+}
+
+func (m *MockTestSessionRepo) ClaimMediaStream(ctx context.Context, token string, nomorUrut, idMedia int, streamNonceHash string) (bool, error) {
+	args := m.Called(ctx, token, nomorUrut, idMedia, streamNonceHash)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTestSessionRepo) GetAllQuestionsForSession(ctx context.Context, token string) ([]entity.TestSessionSoal, error) {
+	args := m.Called(ctx, token)
 	return args.Get(0).([]entity.TestSessionSoal), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) GetSessionQuestions(token string) ([]entity.TestSessionSoal, error) {
-	args := m.Called(token)
+func (m *MockTestSessionRepo) GetSessionQuestions(ctx context.Context, token string) ([]entity.TestSessionSoal, error) {
+	args := m.Called(ctx, token)
 	return args.Get(0).([]entity.TestSessionSoal), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) GetSessionAnswers(token string) ([]entity.JawabanSiswa, error) {
-	args := m.Called(token)
+func (m *MockTestSessionRepo) GetSessionAnswers(ctx context.Context, token string) ([]entity.JawabanSiswa, error) {
+	args := m.Called(ctx, token)
 	return args.Get(0).([]entity.JawabanSiswa), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) CreateUnansweredRecord(soalID, sessionID int) error {
-	args := m.Called(soalID, sessionID)
+func (m *MockTestSessionRepo) CreateUnansweredRecord(ctx context.Context, soalID, sessionID int) error {
+	args := m.Called(ctx, soalID, sessionID)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) CompleteSession(token string, endTime time.Time, score *float64, correct *int, total *int) error {
-	args := m.Called(token, endTime, score, correct, total)
+func (m *MockTestSessionRepo) CompleteSession(ctx context.Context, token string, endTime time.Time, score *float64, correct *int, total *int) error {
+	args := m.Called(ctx, token, endTime, score, correct, total)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) UpdateSessionStatus(token string, status entity.TestStatus) error {
-	args := m.Called(token, status)
+func (m *MockTestSessionRepo) UpdateSessionStatus(ctx context.Context, token string, status entity.TestStatus) error {
+	args := m.Called(ctx, token, status)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) List(tingkatan, idMataPelajaran *int, status *entity.TestStatus, limit, offset int) ([]entity.TestSession, int, error) {
-	args := m.Called(tingkatan, idMataPelajaran, status, limit, offset)
+func (m *MockTestSessionRepo) List(ctx context.Context, tingkatan, idMataPelajaran *int, status *entity.TestStatus, scope *entity.TeacherScope, limit, offset int) ([]entity.TestSession, int, error) {
+	args := m.Called(ctx, tingkatan, idMataPelajaran, status, scope, limit, offset)
 	return args.Get(0).([]entity.TestSession), args.Get(1).(int), args.Error(2)
 }
 
-func (m *MockTestSessionRepo) ListScheduledByUser(userID int, lmsClassID *int64, limit, offset int) ([]entity.TestSession, int, error) {
-	args := m.Called(userID, lmsClassID, limit, offset)
+func (m *MockTestSessionRepo) ListScheduledByUser(ctx context.Context, userID int, lmsClassID *int64, limit, offset int) ([]entity.TestSession, int, error) {
+	args := m.Called(ctx, userID, lmsClassID, limit, offset)
 	return args.Get(0).([]entity.TestSession), args.Get(1).(int), args.Error(2)
 }
 
-func (m *MockTestSessionRepo) StartScheduledSession(token string, startedAt time.Time) (bool, error) {
-	args := m.Called(token, startedAt)
+func (m *MockTestSessionRepo) StartScheduledSession(ctx context.Context, token string, startedAt time.Time) (bool, error) {
+	args := m.Called(ctx, token, startedAt)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) GetSoalDragDropByID(id int) (*entity.SoalDragDrop, error) {
-	args := m.Called(id)
+func (m *MockTestSessionRepo) GetSoalDragDropByID(ctx context.Context, id int) (*entity.SoalDragDrop, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.SoalDragDrop), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) GetDragDropCorrectAnswers(soalID int) ([]entity.DragCorrectAnswer, error) {
-	args := m.Called(soalID)
+func (m *MockTestSessionRepo) GetDragDropCorrectAnswers(ctx context.Context, soalID int) ([]entity.DragCorrectAnswer, error) {
+	args := m.Called(ctx, soalID)
 	return args.Get(0).([]entity.DragCorrectAnswer), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) SubmitDragDropAnswer(token string, nomorUrut int, answer map[int]int, isCorrect bool) error {
-	args := m.Called(token, nomorUrut, answer, isCorrect)
+func (m *MockTestSessionRepo) SubmitDragDropAnswer(ctx context.Context, token string, nomorUrut int, answer map[int]int, isCorrect bool) error {
+	args := m.Called(ctx, token, nomorUrut, answer, isCorrect)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) SubmitEssayAnswer(token string, nomorUrut int, jawabanEssay string) error {
+func (m *MockTestSessionRepo) SubmitEssayAnswer(ctx context.Context, token string, nomorUrut int, jawabanEssay string) error {
 	return nil
 }
 
-func (m *MockTestSessionRepo) GradeEssayAnswer(answerID int, score float64, feedback string) (string, error) {
-	return "", nil
+func (m *MockTestSessionRepo) HasPendingEssays(ctx context.Context, token string) (bool, error) {
+	return false, nil
 }
 
-func (m *MockTestSessionRepo) HasEssayQuestions(token string) (bool, error) {
-	return false, nil
+func (m *MockTestSessionRepo) GetRubricScoresBySession(ctx context.Context, token string) (map[int][]entity.JawabanRubricScore, error) {
+	return nil, nil
 }
 
-func (m *MockTestSessionRepo) ClearAnswer(token string, nomorUrut int) error {
-	args := m.Called(token, nomorUrut)
+func (m *MockTestSessionRepo) ClearAnswer(ctx context.Context, token string, nomorUrut int) error {
+	args := m.Called(ctx, token, nomorUrut)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) Delete(id int) error {
-	args := m.Called(id)
+func (m *MockTestSessionRepo) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockTestSessionRepo) CreateSessionForLMSUserIfMissing(lmsAssignmentID, lmsClassID, lmsUserID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time, status entity.TestStatus) (bool, error) {
-	args := m.Called(lmsAssignmentID, lmsClassID, lmsUserID, idMataPelajaran, idTingkat, durasiMenit, totalSoal, scheduledTime, status)
+func (m *MockTestSessionRepo) CreateSessionForLMSUserIfMissing(ctx context.Context, lmsAssignmentID, lmsClassID, lmsUserID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time, status entity.TestStatus) (bool, error) {
+	args := m.Called(ctx, lmsAssignmentID, lmsClassID, lmsUserID, idMataPelajaran, idTingkat, durasiMenit, totalSoal, scheduledTime, status)
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) BackfillSessionsForJoinedStudent(lmsClassID, lmsUserID int64) (int, error) {
-	args := m.Called(lmsClassID, lmsUserID)
+func (m *MockTestSessionRepo) BackfillSessionsForJoinedStudent(ctx context.Context, lmsClassID, lmsUserID int64) (int, error) {
+	args := m.Called(ctx, lmsClassID, lmsUserID)
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) BackfillMissingSessions(lmsClassID *int64, lmsAssignmentID *int64) (int, error) {
-	args := m.Called(lmsClassID, lmsAssignmentID)
+func (m *MockTestSessionRepo) BackfillMissingSessions(ctx context.Context, lmsSchoolID, lmsClassID, lmsAssignmentID *int64) (int, error) {
+	args := m.Called(ctx, lmsSchoolID, lmsClassID, lmsAssignmentID)
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) UpdateScheduledSessionsByAssignment(lmsAssignmentID int64, lmsClassID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time) (int64, error) {
-	args := m.Called(lmsAssignmentID, lmsClassID, idMataPelajaran, idTingkat, durasiMenit, totalSoal, scheduledTime)
+func (m *MockTestSessionRepo) UpdateScheduledSessionsByAssignment(ctx context.Context, lmsAssignmentID int64, lmsClassID int64, idMataPelajaran, idTingkat, durasiMenit int, totalSoal *int, scheduledTime time.Time) (int64, error) {
+	args := m.Called(ctx, lmsAssignmentID, lmsClassID, idMataPelajaran, idTingkat, durasiMenit, totalSoal, scheduledTime)
 	return args.Get(0).(int64), args.Error(1)
 }
 
-func (m *MockTestSessionRepo) DeleteSessionsByAssignment(lmsAssignmentID int64) (int64, error) {
-	args := m.Called(lmsAssignmentID)
+func (m *MockTestSessionRepo) DeleteSessionsByAssignment(ctx context.Context, lmsAssignmentID int64) (int64, error) {
+	args := m.Called(ctx, lmsAssignmentID)
 	return args.Get(0).(int64), args.Error(1)
 }
 
@@ -287,38 +347,38 @@ func TestCompleteSession_Integration(t *testing.T) {
 	// Mock Expectations
 
 	// 1. GetByToken (initial check)
-	mockRepo.On("GetByToken", token).Return(session, nil).Once()
+	mockRepo.On("GetByToken", mock.Anything, token).Return(session, nil).Once()
 
 	// 2. GetAllQuestionsForSession
 	questions := []entity.TestSessionSoal{
 		{ID: 1, IDTestSession: 1, NomorUrut: 1, QuestionType: "multiple_choice"},
 	}
-	mockRepo.On("GetAllQuestionsForSession", token).Return(questions, nil)
+	mockRepo.On("GetAllQuestionsForSession", mock.Anything, token).Return(questions, nil)
 
 	// 3. GetSessionAnswers (1st call - before filling unanswered)
 	answers1 := []entity.JawabanSiswa{} // empty
-	mockRepo.On("GetSessionAnswers", token).Return(answers1, nil).Once()
+	mockRepo.On("GetSessionAnswers", mock.Anything, token).Return(answers1, nil).Once()
 
 	// 4. CreateUnansweredRecord (since answer is empty)
-	mockRepo.On("CreateUnansweredRecord", 1, 1).Return(nil)
+	mockRepo.On("CreateUnansweredRecord", mock.Anything, 1, 1).Return(nil)
 
 	// 5. GetSessionAnswers (2nd call - after filling)
 	answers2 := []entity.JawabanSiswa{
 		{TestSessionSoal: entity.TestSessionSoal{ID: 1, NomorUrut: 1}, IsCorrect: true},
 	}
-	mockRepo.On("GetSessionAnswers", token).Return(answers2, nil).Once()
+	mockRepo.On("GetSessionAnswers", mock.Anything, token).Return(answers2, nil).Once()
 
 	// 6. CompleteSession (update DB)
 	// We use Matcher because time.Now() is variable
-	mockRepo.On("CompleteSession", token, mock.AnythingOfType("time.Time"), mock.MatchedBy(func(s *float64) bool { return *s == 100.0 }), mock.MatchedBy(func(c *int) bool { return *c == 1 }), mock.MatchedBy(func(tot *int) bool { return *tot == 1 })).Return(nil)
+	mockRepo.On("CompleteSession", mock.Anything, token, mock.AnythingOfType("time.Time"), mock.MatchedBy(func(s *float64) bool { return *s == 100.0 }), mock.MatchedBy(func(c *int) bool { return *c == 1 }), mock.MatchedBy(func(tot *int) bool { return *tot == 1 })).Return(nil)
 
 	// 7. GetByToken (retrieve for return value)
 	completedSession := *session
 	completedSession.Status = entity.TestStatusCompleted
-	mockRepo.On("GetByToken", token).Return(&completedSession, nil).Once()
+	mockRepo.On("GetByToken", mock.Anything, token).Return(&completedSession, nil).Once()
 
 	// Execute
-	res, err := usecase.CompleteSession(token)
+	res, err := usecase.CompleteSession(context.Background(), token)
 
 	// Verify
 	assert.NoError(t, err)
@@ -343,10 +403,10 @@ func TestSubmitAnswer_RejectsTimedOutSession(t *testing.T) {
 		DurasiMenit:  30,
 	}
 
-	mockRepo.On("GetByToken", token).Return(session, nil).Once()
-	mockRepo.On("UpdateSessionStatus", token, entity.TestStatusTimeout).Return(nil).Once()
+	mockRepo.On("GetByToken", mock.Anything, token).Return(session, nil).Once()
+	mockRepo.On("UpdateSessionStatus", mock.Anything, token, entity.TestStatusTimeout).Return(nil).Once()
 
-	err := usecase.SubmitAnswer(token, 1, entity.JawabanA)
+	err := usecase.SubmitAnswer(context.Background(), token, 1, entity.JawabanA)
 	assert.Error(t, err)
 	assert.Equal(t, "session has timed out", err.Error())
 
@@ -369,12 +429,100 @@ func TestClearAnswer_RejectsTimedOutSession(t *testing.T) {
 		DurasiMenit:  20,
 	}
 
-	mockRepo.On("GetByToken", token).Return(session, nil).Once()
-	mockRepo.On("UpdateSessionStatus", token, entity.TestStatusTimeout).Return(nil).Once()
+	mockRepo.On("GetByToken", mock.Anything, token).Return(session, nil).Once()
+	mockRepo.On("UpdateSessionStatus", mock.Anything, token, entity.TestStatusTimeout).Return(nil).Once()
 
-	err := usecase.ClearAnswer(token, 3)
+	err := usecase.ClearAnswer(context.Background(), token, 3)
 	assert.Error(t, err)
 	assert.Equal(t, "session has timed out", err.Error())
 
 	mockRepo.AssertExpectations(t)
 }
+
+func ongoingSession(token string) *entity.TestSession {
+	userID := 1
+	return &entity.TestSession{
+		SessionToken: token,
+		UserID:       &userID,
+		Status:       entity.TestStatusOngoing,
+		WaktuMulai:   time.Now().Add(-5 * time.Minute),
+		DurasiMenit:  60,
+	}
+}
+
+func TestRecordMediaPlay_ReturnsStreamNonceAndStoresItsHash(t *testing.T) {
+	mockRepo := new(MockTestSessionRepo)
+	usecase := test_session.NewTestSessionUsecase(mockRepo, new(MockUserRepo), nil)
+
+	token := "media-token"
+	var storedHash string
+	mockRepo.On("GetByToken", mock.Anything, token).Return(ongoingSession(token), nil)
+	mockRepo.On("RecordMediaPlay", mock.Anything, token, 1, 5, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { storedHash = args.String(4) }).
+		Return(&entity.SessionMedia{IDMedia: 5, NomorUrut: 1, MaxPutar: 2, JumlahPutar: 1}, nil)
+
+	media, err := usecase.RecordMediaPlay(context.Background(), token, 1, 5)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, media.StreamNonce)
+	assert.Equal(t, nonce.Hash(media.StreamNonce), storedHash)
+	assert.NotEqual(t, media.StreamNonce, storedHash)
+}
+
+func TestGetMediaStreamSource_LimitedClipNeedsUnusedNonce(t *testing.T) {
+	token := "media-token"
+	played := time.Now().Add(-time.Minute)
+	limited := &entity.SessionMedia{IDMedia: 5, NomorUrut: 1, DurasiDetik: 30, MaxPutar: 2, JumlahPutar: 1, TerakhirDiputar: &played}
+
+	tests := []struct {
+		name    string
+		claimed bool
+		wantErr error
+	}{
+		{name: "first stream of the play", claimed: true},
+		{name: "nonce already used or replaced", claimed: false, wantErr: entity.ErrMediaPlayNotRecorded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTestSessionRepo)
+			usecase := test_session.NewTestSessionUsecase(mockRepo, new(MockUserRepo), nil)
+			mockRepo.On("GetByToken", mock.Anything, token).Return(ongoingSession(token), nil)
+			mockRepo.On("GetSessionMediaByID", mock.Anything, token, 1, 5).Return(limited, nil)
+			mockRepo.On("ClaimMediaStream", mock.Anything, token, 1, 5, nonce.Hash("play-nonce")).Return(tt.claimed, nil)
+
+			media, err := usecase.GetMediaStreamSource(context.Background(), token, 1, 5, "play-nonce")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 5, media.IDMedia)
+		})
+	}
+}
+
+func TestGetMediaStreamSource_ExpiredPlayIsNotClaimed(t *testing.T) {
+	mockRepo := new(MockTestSessionRepo)
+	usecase := test_session.NewTestSessionUsecase(mockRepo, new(MockUserRepo), nil)
+
+	token := "media-token"
+	played := time.Now().Add(-time.Hour)
+	mockRepo.On("GetByToken", mock.Anything, token).Return(ongoingSession(token), nil)
+	mockRepo.On("GetSessionMediaByID", mock.Anything, token, 1, 5).
+		Return(&entity.SessionMedia{IDMedia: 5, NomorUrut: 1, DurasiDetik: 30, MaxPutar: 2, JumlahPutar: 1, TerakhirDiputar: &played}, nil)
+
+	_, err := usecase.GetMediaStreamSource(context.Background(), token, 1, 5, "play-nonce")
+	assert.ErrorIs(t, err, entity.ErrMediaPlayNotRecorded)
+	mockRepo.AssertNotCalled(t, "ClaimMediaStream", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetMediaStreamSource_UnlimitedClipNeedsNoNonce(t *testing.T) {
+	mockRepo := new(MockTestSessionRepo)
+	usecase := test_session.NewTestSessionUsecase(mockRepo, new(MockUserRepo), nil)
+
+	token := "media-token"
+	mockRepo.On("GetByToken", mock.Anything, token).Return(ongoingSession(token), nil)
+	mockRepo.On("GetSessionMediaByID", mock.Anything, token, 1, 5).Return(&entity.SessionMedia{IDMedia: 5, NomorUrut: 1}, nil)
+
+	_, err := usecase.GetMediaStreamSource(context.Background(), token, 1, 5, "")
+	assert.NoError(t, err)
+}
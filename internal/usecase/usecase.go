@@ -3,13 +3,18 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
-	"go.elastic.co/apm"
-	"gorm.io/gorm"
-
 	"cbt-test-mini-project/internal/entity"
 	"cbt-test-mini-project/internal/repository"
+	"cbt-test-mini-project/util/audit"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/ratelimit"
+	"cbt-test-mini-project/util/tenant"
+
+	"go.elastic.co/apm"
+	"gorm.io/gorm"
 )
 
 // UserLimitUsecase defines the interface for user limit business logic
@@ -20,17 +25,35 @@ type UserLimitUsecase interface {
 	GetUsageHistory(ctx context.Context, userID int, limitType string, days int) ([]*entity.UserLimitUsage, error)
 	ResetLimit(ctx context.Context, userID int, limitType string) error
 	SetUserLimit(ctx context.Context, userID int, limitType string, limitValue int) (*entity.UserLimit, error)
+	ClearUserLimitOverride(ctx context.Context, userID int, limitType string) (*entity.UserLimit, error)
+
+	ListRateLimitPolicies(ctx context.Context) (*entity.RateLimitPolicySet, error)
+	SaveRateLimitPolicy(ctx context.Context, policy *entity.RateLimitPolicy) error
+	DeleteRateLimitPolicy(ctx context.Context, id int) error
+	SetRateLimitMethodGroup(ctx context.Context, method, group string) error
+	SetSchoolPlan(ctx context.Context, schoolID int64, plan string) error
 }
 
+var (
+	// methodGroupPattern keeps group names short enough for user_limits bucket names
+	methodGroupPattern = regexp.MustCompile(`^[a-z0-9_]{1,24}$`)
+	// methodPattern matches "/base.Service/Method" and "/base.Service/*"
+	methodPattern = regexp.MustCompile(`^/[A-Za-z0-9_.]+/([A-Za-z0-9_]+|\*)$`)
+)
+
 // userLimitUsecase implements UserLimitUsecase
 type userLimitUsecase struct {
 	userLimitRepo repository.UserLimitRepository
+	policyRepo    repository.RateLimitPolicyRepository
+	counters      ratelimit.Store // Redis counters of the rate limiter, nil without Redis
 }
 
-// NewUserLimitUsecase creates a new user limit usecase
-func NewUserLimitUsecase(userLimitRepo repository.UserLimitRepository) UserLimitUsecase {
+// NewUserLimitUsecase creates a new user limit usecase; counters may be nil
+func NewUserLimitUsecase(userLimitRepo repository.UserLimitRepository, policyRepo repository.RateLimitPolicyRepository, counters ratelimit.Store) UserLimitUsecase {
 	return &userLimitUsecase{
 		userLimitRepo: userLimitRepo,
+		policyRepo:    policyRepo,
+		counters:      counters,
 	}
 }
 
@@ -104,6 +127,19 @@ func (u *userLimitUsecase) GetUserLimits(ctx context.Context, userID int) ([]*en
 		}
 	}
 
+	// With Redis the rate limiter counts there and current_used only follows the periods
+	// Redis was down, so the usage shown is read from Redis
+	if u.counters != nil {
+		for _, limit := range limits {
+			used, err := u.counters.Used(ctx, ratelimit.UserKey(userID, limit.LimitType), ratelimit.Window(limit.LimitType))
+			if err != nil {
+				apm.CaptureError(ctx, err).Send()
+				break
+			}
+			limit.CurrentUsed = used
+		}
+	}
+
 	return limits, nil
 }
 
@@ -135,12 +171,20 @@ func (u *userLimitUsecase) ResetLimit(ctx context.Context, userID int, limitType
 		return fmt.Errorf("failed to get limit: %w", err)
 	}
 
+	before := *limit
 	limit.CurrentUsed = 0
 	limit.ResetAt = u.getNextResetTime(limitType)
 
 	if err := u.userLimitRepo.UpdateLimit(ctx, limit); err != nil {
 		return fmt.Errorf("failed to reset limit: %w", err)
 	}
+	audit.Record(ctx, entity.AuditResourceUserLimit, userLimitResourceID(userID, limitType), &before, limit)
+
+	if u.counters != nil {
+		if err := u.counters.Reset(ctx, ratelimit.UserKey(userID, limitType), ratelimit.Window(limitType)); err != nil {
+			return fmt.Errorf("failed to reset rate limit counter: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -167,7 +211,9 @@ func (u *userLimitUsecase) SetUserLimit(ctx context.Context, userID int, limitTy
 		return nil, fmt.Errorf("failed to get limit: %w", err)
 	}
 
+	before := *limit
 	limit.LimitValue = limitValue
+	limit.IsOverride = true
 	if limit.CurrentUsed > limitValue {
 		limit.CurrentUsed = limitValue
 	}
@@ -175,14 +221,172 @@ func (u *userLimitUsecase) SetUserLimit(ctx context.Context, userID int, limitTy
 	if err := u.userLimitRepo.UpdateLimit(ctx, limit); err != nil {
 		return nil, fmt.Errorf("failed to update limit: %w", err)
 	}
+	audit.Record(ctx, entity.AuditResourceUserLimit, userLimitResourceID(userID, limitType), &before, limit)
 
 	return limit, nil
 }
 
+// ClearUserLimitOverride makes a user's limit follow the rate limit policies again
+func (u *userLimitUsecase) ClearUserLimitOverride(ctx context.Context, userID int, limitType string) (*entity.UserLimit, error) {
+	span, ctx := apm.StartSpan(ctx, "clear_user_limit_override", "usecase")
+	if span != nil {
+		defer span.End()
+	}
+
+	limit, err := u.userLimitRepo.GetOrCreateLimit(ctx, userID, limitType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limit: %w", err)
+	}
+	before := *limit
+	limit.IsOverride = false
+	if err := u.userLimitRepo.UpdateLimit(ctx, limit); err != nil {
+		return nil, fmt.Errorf("failed to update limit: %w", err)
+	}
+	audit.Record(ctx, entity.AuditResourceUserLimit, userLimitResourceID(userID, limitType), &before, limit)
+	return limit, nil
+}
+
+// userLimitResourceID names a user's limit in the audit log, e.g. "42:api_requests_per_hour"
+func userLimitResourceID(userID int, limitType string) string {
+	return fmt.Sprintf("%d:%s", userID, limitType)
+}
+
+// ListRateLimitPolicies returns the policies with the method groups and school plans they use
+func (u *userLimitUsecase) ListRateLimitPolicies(ctx context.Context) (*entity.RateLimitPolicySet, error) {
+	span, ctx := apm.StartSpan(ctx, "list_rate_limit_policies", "usecase")
+	if span != nil {
+		defer span.End()
+	}
+
+	set, err := u.policyRepo.LoadPolicySet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rate limit policies: %w", err)
+	}
+	return set, nil
+}
+
+// SaveRateLimitPolicy creates or updates a policy. Replicas pick it up within 30 seconds.
+func (u *userLimitUsecase) SaveRateLimitPolicy(ctx context.Context, policy *entity.RateLimitPolicy) error {
+	span, ctx := apm.StartSpan(ctx, "save_rate_limit_policy", "usecase")
+	if span != nil {
+		defer span.End()
+	}
+
+	if err := requireCrossTenant(ctx); err != nil {
+		return err
+	}
+
+	if policy.MethodGroup == "" {
+		policy.MethodGroup = entity.RateLimitGroupDefault
+	}
+	if !methodGroupPattern.MatchString(policy.MethodGroup) {
+		return fmt.Errorf("method_group must be 1-24 lowercase letters, digits or underscores")
+	}
+	if !entity.IsLimitType(policy.LimitType) {
+		return fmt.Errorf("unknown limit_type %q", policy.LimitType)
+	}
+	if policy.LimitValue != nil && *policy.LimitValue < 0 {
+		return fmt.Errorf("limit_value cannot be negative")
+	}
+	if policy.Burst < 0 {
+		return fmt.Errorf("burst cannot be negative")
+	}
+	if policy.Role != nil {
+		switch *policy.Role {
+		case interceptor.RoleStudent, interceptor.RoleTeacher, interceptor.RoleSuperadmin:
+		default:
+			return fmt.Errorf("unknown role %q", *policy.Role)
+		}
+	}
+	if policy.SchoolPlan != nil && *policy.SchoolPlan == "" {
+		return fmt.Errorf("school_plan cannot be empty")
+	}
+
+	if err := u.policyRepo.SavePolicy(ctx, policy); err != nil {
+		if err == entity.ErrRateLimitPolicyNotFound {
+			return err
+		}
+		return fmt.Errorf("failed to save rate limit policy: %w", err)
+	}
+	return nil
+}
+
+// DeleteRateLimitPolicy deletes a policy
+func (u *userLimitUsecase) DeleteRateLimitPolicy(ctx context.Context, id int) error {
+	span, ctx := apm.StartSpan(ctx, "delete_rate_limit_policy", "usecase")
+	if span != nil {
+		defer span.End()
+	}
+
+	if err := requireCrossTenant(ctx); err != nil {
+		return err
+	}
+
+	if id <= 0 {
+		return fmt.Errorf("id must be greater than 0")
+	}
+	return u.policyRepo.DeletePolicy(ctx, id)
+}
+
+// SetRateLimitMethodGroup puts a method, or every method of a service, in a group
+func (u *userLimitUsecase) SetRateLimitMethodGroup(ctx context.Context, method, group string) error {
+	span, ctx := apm.StartSpan(ctx, "set_rate_limit_method_group", "usecase")
+	if span != nil {
+		defer span.End()
+	}
+
+	if err := requireCrossTenant(ctx); err != nil {
+		return err
+	}
+
+	if !methodPattern.MatchString(method) {
+		return fmt.Errorf("method must look like /base.Service/Method or /base.Service/*")
+	}
+	if group != "" && !methodGroupPattern.MatchString(group) {
+		return fmt.Errorf("method_group must be 1-24 lowercase letters, digits or underscores")
+	}
+	if err := u.policyRepo.SetMethodGroup(ctx, method, group); err != nil {
+		return fmt.Errorf("failed to set method group: %w", err)
+	}
+	return nil
+}
+
+// SetSchoolPlan sets the plan policies match a school by; an empty plan removes it
+func (u *userLimitUsecase) SetSchoolPlan(ctx context.Context, schoolID int64, plan string) error {
+	span, ctx := apm.StartSpan(ctx, "set_school_plan", "usecase")
+	if span != nil {
+		defer span.End()
+	}
+
+	if err := requireCrossTenant(ctx); err != nil {
+		return err
+	}
+
+	if schoolID <= 0 {
+		return fmt.Errorf("school_id must be greater than 0")
+	}
+	if len(plan) > 50 {
+		return fmt.Errorf("plan cannot be longer than 50 characters")
+	}
+	if err := u.policyRepo.SetSchoolPlan(ctx, schoolID, plan); err != nil {
+		return fmt.Errorf("failed to set school plan: %w", err)
+	}
+	return nil
+}
+
+// requireCrossTenant refuses callers limited to one school. Policies, method groups and
+// plans are shared by every school, so a school admin could otherwise raise its own limits.
+func requireCrossTenant(ctx context.Context) error {
+	if t, _ := tenant.FromContext(ctx); !t.CrossTenant {
+		return entity.ErrRateLimitPolicyCrossTenant
+	}
+	return nil
+}
+
 // getNextResetTime calculates the next reset time for a limit type
 func (u *userLimitUsecase) getNextResetTime(limitType string) time.Time {
 	now := time.Now()
-	switch limitType {
+	switch entity.BaseLimitType(limitType) {
 	case entity.LimitTypeAPIRequestsPerHour:
 		return now.Add(time.Hour)
 	case entity.LimitTypeAPIRequestsPerDay, entity.LimitTypeTestSessionsPerDay, entity.LimitTypeQuestionsPerDay:
@@ -3,9 +3,10 @@ package user_limit
 import (
 	"cbt-test-mini-project/internal/repository"
 	"cbt-test-mini-project/internal/usecase"
+	"cbt-test-mini-project/util/ratelimit"
 )
 
 // Init initializes the user limit usecase
-func Init(userLimitRepo repository.UserLimitRepository) usecase.UserLimitUsecase {
-	return usecase.NewUserLimitUsecase(userLimitRepo)
-}
\ No newline at end of file
+func Init(userLimitRepo repository.UserLimitRepository, policyRepo repository.RateLimitPolicyRepository, counters ratelimit.Store) usecase.UserLimitUsecase {
+	return usecase.NewUserLimitUsecase(userLimitRepo, policyRepo, counters)
+}
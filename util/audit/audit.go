@@ -0,0 +1,122 @@
+// Package audit collects the audit log entries of a request. The audit interceptor opens a
+// Trail for every mutating call; usecases add entries with Record, which keeps only the
+// fields that changed. Entries are written once the call is done, together with who made it.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// Trail holds the entries recorded during one request
+type Trail struct {
+	mu      sync.Mutex
+	entries []*entity.AuditLog
+}
+
+type contextKey struct{}
+
+// WithTrail returns a copy of ctx that collects entries in a new Trail
+func WithTrail(ctx context.Context) (context.Context, *Trail) {
+	trail := &Trail{}
+	return context.WithValue(ctx, contextKey{}, trail), trail
+}
+
+// Entries returns the entries recorded so far
+func (t *Trail) Entries() []*entity.AuditLog {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*entity.AuditLog(nil), t.entries...)
+}
+
+// Record adds a change of a resource to the request's trail. before is nil for a creation
+// and after nil for a deletion. Nothing is recorded outside an audited request or when no
+// field changed.
+func Record(ctx context.Context, resourceType string, resourceID interface{}, before, after interface{}) {
+	trail, ok := ctx.Value(contextKey{}).(*Trail)
+	if !ok {
+		return
+	}
+	beforeJSON, afterJSON, err := Changes(before, after)
+	if err != nil || (beforeJSON == nil && afterJSON == nil) {
+		return
+	}
+
+	trail.mu.Lock()
+	defer trail.mu.Unlock()
+	trail.entries = append(trail.entries, &entity.AuditLog{
+		ResourceType: resourceType,
+		ResourceID:   fmt.Sprint(resourceID),
+		Before:       beforeJSON,
+		After:        afterJSON,
+	})
+}
+
+// Changes returns the top-level JSON fields of before and after whose values differ. A nil
+// side yields nil, the other side all of its fields.
+func Changes(before, after interface{}) (json.RawMessage, json.RawMessage, error) {
+	beforeFields, err := fields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := fields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if beforeFields != nil && afterFields != nil {
+		for key, value := range beforeFields {
+			if other, ok := afterFields[key]; ok && reflect.DeepEqual(value, other) {
+				delete(beforeFields, key)
+				delete(afterFields, key)
+			}
+		}
+		if len(beforeFields) == 0 && len(afterFields) == 0 {
+			return nil, nil, nil
+		}
+	}
+	beforeJSON, err := marshalFields(beforeFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterJSON, err := marshalFields(afterFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return beforeJSON, afterJSON, nil
+}
+
+// fields turns v into its JSON object fields; values that are not objects become "value"
+func fields(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]interface{}
+	if json.Unmarshal(raw, &out) == nil && out != nil {
+		return out, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"value": value}, nil
+}
+
+func marshalFields(f map[string]interface{}) (json.RawMessage, error) {
+	if f == nil {
+		return nil, nil
+	}
+	return json.Marshal(f)
+}
@@ -0,0 +1,78 @@
+package audit_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/audit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangesKeepsOnlyChangedFields(t *testing.T) {
+	nilai := 80.0
+	before := &entity.EssayGrade{}
+	after := &entity.EssayGrade{NilaiEssay: &nilai, IsCorrect: false}
+
+	beforeJSON, afterJSON, err := audit.Changes(before, after)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"nilai_essay": null}`, string(beforeJSON))
+	assert.JSONEq(t, `{"nilai_essay": 80}`, string(afterJSON))
+
+	beforeJSON, afterJSON, err = audit.Changes(after, after)
+	assert.NoError(t, err)
+	assert.Nil(t, beforeJSON)
+	assert.Nil(t, afterJSON)
+
+	_, afterJSON, err = audit.Changes(nil, map[int]int{3: 1})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"3": 1}`, string(afterJSON))
+}
+
+func TestRecordNeedsTrail(t *testing.T) {
+	audit.Record(context.Background(), entity.AuditResourceSoal, 1, nil, map[string]int{"urutan": 2})
+
+	ctx, trail := audit.WithTrail(context.Background())
+	audit.Record(ctx, entity.AuditResourceSoal, 1, map[string]int{"urutan": 2}, map[string]int{"urutan": 2})
+	assert.Empty(t, trail.Entries(), "nothing changed")
+
+	audit.Record(ctx, entity.AuditResourceSoal, 1, map[string]int{"urutan": 1}, map[string]int{"urutan": 2})
+	entries := trail.Entries()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "1", entries[0].ResourceID)
+		assert.JSONEq(t, `{"urutan": 2}`, string(entries[0].After))
+	}
+}
+
+func TestPayloadRedactsSecrets(t *testing.T) {
+	payload := audit.Payload(&base.ChangePasswordRequest{CurrentPassword: "old", NewPassword: "new"})
+	assert.JSONEq(t, `{"current_password": "[redacted]", "new_password": "[redacted]"}`, string(payload))
+
+	payload = audit.Payload(&base.UploadImageToSoalRequest{IdSoal: 5, ImageBytes: []byte("abc")})
+	assert.Contains(t, string(payload), `"[3 bytes]"`)
+}
+
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "sha256:" + hex.EncodeToString(sum[:16])
+}
+
+func TestPayloadHashesTokens(t *testing.T) {
+	payload := audit.Payload(&base.SubmitAnswerRequest{SessionToken: "tok-123", NomorUrut: 4})
+	assert.NotContains(t, string(payload), "tok-123")
+	assert.JSONEq(t, `{"session_token": "`+tokenHash("tok-123")+`", "nomor_urut": 4}`, string(payload))
+
+	payload = audit.Payload(&base.RefreshTokenRequest{RefreshToken: "r"})
+	assert.JSONEq(t, `{"refresh_token": "[redacted]"}`, string(payload))
+}
+
+func TestResourceID(t *testing.T) {
+	assert.Equal(t, "7", audit.ResourceID(&base.SetUserLimitRequest{UserId: 7, LimitType: "x"}))
+	assert.Equal(t, tokenHash("tok-123"), audit.ResourceID(&base.GetTestSessionRequest{SessionToken: "tok-123"}))
+	assert.Equal(t, "9", audit.ResourceID(&base.CreateApiKeyResponse{ApiKey: &base.ApiKey{Id: 9}, Key: "secret"}))
+	assert.Equal(t, "", audit.ResourceID(&base.ListApiKeysRequest{}))
+}
@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// secretFields are never written to the audit log; a field matches by its whole name or
+// its last words, e.g. new_password
+var secretFields = []string{"password", "refresh_token", "access_token", "pin", "qr_code", "secret", "api_key", "config_key"}
+
+// Payload is the request as JSON with secrets redacted, tokens hashed and file contents
+// replaced by their size
+func Payload(msg proto.Message) json.RawMessage {
+	if msg == nil {
+		return nil
+	}
+	raw, err := json.Marshal(payloadMessage(msg.ProtoReflect()))
+	if err != nil {
+		return nil
+	}
+	return raw
+}
+
+func payloadMessage(m protoreflect.Message) map[string]interface{} {
+	out := map[string]interface{}{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if isSecret(name) {
+			out[name] = "[redacted]"
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			items := make([]interface{}, list.Len())
+			for i := range items {
+				items[i] = payloadValue(fd, list.Get(i))
+			}
+			out[name] = items
+		case fd.IsMap():
+			entries := map[string]interface{}{}
+			v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				entries[k.String()] = payloadValue(fd.MapValue(), mv)
+				return true
+			})
+			out[name] = entries
+		default:
+			out[name] = payloadValue(fd, v)
+		}
+		return true
+	})
+	return out
+}
+
+func payloadValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return payloadMessage(v.Message())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("[%d bytes]", len(v.Bytes()))
+	case protoreflect.StringKind:
+		if isToken(string(fd.Name())) {
+			return hashToken(v.String())
+		}
+		return v.String()
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	default:
+		return v.Interface()
+	}
+}
+
+func isSecret(name string) bool {
+	for _, secret := range secretFields {
+		if name == secret || strings.HasSuffix(name, "_"+secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// isToken reports whether a field holds a bearer token such as session_token. A token still
+// names its resource, so the audit log keeps its hash rather than dropping it.
+func isToken(name string) bool {
+	return !isSecret(name) && (name == "token" || strings.HasSuffix(name, "_token"))
+}
+
+// hashToken returns a stable, non-reversible stand-in for a token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "sha256:" + hex.EncodeToString(sum[:16])
+}
+
+// ResourceID is the id a request or response names: its "id" field, else the first set
+// field ending in "_id" or the hash of one ending in "_token", else the "id" of a nested
+// message such as the created resource of a response
+func ResourceID(msg proto.Message) string {
+	if msg == nil {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
+		return ""
+	}
+	fieldList := m.Descriptor().Fields()
+	if fd := fieldList.ByName("id"); fd != nil && !fd.IsList() && m.Has(fd) {
+		return fmt.Sprint(m.Get(fd).Interface())
+	}
+	for i := 0; i < fieldList.Len(); i++ {
+		fd := fieldList.Get(i)
+		name := string(fd.Name())
+		if fd.IsList() || fd.IsMap() || fd.Kind() == protoreflect.MessageKind || !m.Has(fd) {
+			continue
+		}
+		if strings.HasSuffix(name, "_id") {
+			return fmt.Sprint(m.Get(fd).Interface())
+		}
+		if isToken(name) && fd.Kind() == protoreflect.StringKind {
+			return hashToken(m.Get(fd).String())
+		}
+	}
+	for i := 0; i < fieldList.Len(); i++ {
+		fd := fieldList.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		nested := m.Get(fd).Message()
+		if idField := nested.Descriptor().Fields().ByName("id"); idField != nil && !idField.IsList() && nested.Has(idField) {
+			return fmt.Sprint(nested.Get(idField).Interface())
+		}
+	}
+	return ""
+}
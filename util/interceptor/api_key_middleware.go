@@ -0,0 +1,153 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/apikey"
+	"cbt-test-mini-project/util/tenant"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader carries a service API key, on HTTP and as gRPC metadata
+const APIKeyHeader = "x-api-key"
+
+// errInvalidAPIKey is returned for unknown, revoked and expired keys alike
+var errInvalidAPIKey = errors.New("invalid or expired api key")
+
+// apiKeyTouchInterval throttles last_used_at writes for busy keys
+const apiKeyTouchInterval = time.Minute
+
+// APIKeyStore is the part of the api_keys repository the middleware needs
+type APIKeyStore interface {
+	GetAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error)
+	TouchAPIKey(ctx context.Context, id int) error
+}
+
+// APIKeyMiddleware authenticates machine clients by API key, as an alternative to a user JWT
+type APIKeyMiddleware struct {
+	store APIKeyStore
+	now   func() time.Time
+}
+
+// NewAPIKeyMiddleware creates a new API key middleware
+func NewAPIKeyMiddleware(store APIKeyStore) *APIKeyMiddleware {
+	return &APIKeyMiddleware{store: store, now: time.Now}
+}
+
+// UnaryServerInterceptor authenticates calls carrying x-api-key metadata. Calls without it
+// fall through to the JWT middleware. A key acts as a superadmin limited to its rpc: scopes
+// and its school, and can never manage API keys itself.
+func (m *APIKeyMiddleware) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		raw := apiKeyFromMetadata(ctx)
+		if raw == "" {
+			return handler(ctx, req)
+		}
+
+		key, err := m.authenticate(ctx, raw)
+		if err == errInvalidAPIKey {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if strings.HasPrefix(info.FullMethod, "/base.ApiKeyService/") || !key.AllowsMethod(info.FullMethod) {
+			return nil, status.Error(codes.PermissionDenied, "api key is not allowed to call this method")
+		}
+
+		return handler(withAPIKey(ctx, key), req)
+	}
+}
+
+// RequireScope guards a plain HTTP handler with an API key granted scope
+func (m *APIKeyMiddleware) RequireScope(scope string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		raw := strings.TrimSpace(r.Header.Get(APIKeyHeader))
+		if raw == "" {
+			writeAPIKeyError(w, http.StatusUnauthorized, "missing "+http.CanonicalHeaderKey(APIKeyHeader)+" header")
+			return
+		}
+
+		key, err := m.authenticate(r.Context(), raw)
+		if err == errInvalidAPIKey {
+			writeAPIKeyError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if err != nil {
+			writeAPIKeyError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if !key.HasScope(scope) {
+			writeAPIKeyError(w, http.StatusForbidden, "api key lacks scope "+scope)
+			return
+		}
+
+		next(w, r.WithContext(withAPIKey(r.Context(), key)))
+	}
+}
+
+// authenticate resolves an active key from its plaintext
+func (m *APIKeyMiddleware) authenticate(ctx context.Context, raw string) (*entity.APIKey, error) {
+	key, err := m.store.GetAPIKeyByHash(tenant.System(ctx), apikey.Hash(raw))
+	if err != nil {
+		slog.Error("Failed to look up API key", "error", err)
+		return nil, errors.New("failed to verify api key")
+	}
+	now := m.now()
+	if key == nil || !key.IsActive(now) {
+		return nil, errInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyTouchInterval {
+		if err := m.store.TouchAPIKey(tenant.System(ctx), key.ID); err != nil {
+			slog.Warn("Failed to record API key use", "error", err, "key_prefix", key.KeyPrefix)
+		}
+	}
+	return key, nil
+}
+
+// withAPIKey sets the key's identity: a synthetic superadmin scoped to the key's school
+func withAPIKey(ctx context.Context, key *entity.APIKey) context.Context {
+	ctx = context.WithValue(ctx, "api_key", key)
+	ctx = AddUserToContext(ctx, &base.User{Nama: "API key " + key.Name, Role: base.UserRole_ADMIN})
+	ctx = AddRoleNameToContext(ctx, RoleSuperadmin)
+	if key.LMSSchoolID != nil {
+		return tenant.WithTenant(ctx, tenant.Tenant{SchoolID: *key.LMSSchoolID})
+	}
+	return tenant.WithTenant(ctx, tenant.Tenant{CrossTenant: true})
+}
+
+// APIKeyFromContext returns the key that authenticated the call, if any
+func APIKeyFromContext(ctx context.Context) (*entity.APIKey, bool) {
+	key, ok := ctx.Value("api_key").(*entity.APIKey)
+	return key, ok && key != nil
+}
+
+func apiKeyFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(APIKeyHeader); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func writeAPIKeyError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
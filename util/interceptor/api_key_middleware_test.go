@@ -0,0 +1,138 @@
+package interceptor_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/apikey"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// --- Fakes ---
+
+type fakeAPIKeyStore struct {
+	keys    map[string]*entity.APIKey
+	touched []int
+}
+
+func (f *fakeAPIKeyStore) GetAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	return f.keys[hash], nil
+}
+
+func (f *fakeAPIKeyStore) TouchAPIKey(ctx context.Context, id int) error {
+	f.touched = append(f.touched, id)
+	return nil
+}
+
+func newAPIKeyStore() *fakeAPIKeyStore {
+	school := int64(42)
+	past := time.Now().Add(-time.Hour)
+	return &fakeAPIKeyStore{keys: map[string]*entity.APIKey{
+		apikey.Hash("sync-key"):    {ID: 1, Name: "lms", Scopes: []string{entity.APIKeyScopeSyncRead}, LMSSchoolID: &school},
+		apikey.Hash("rpc-key"):     {ID: 2, Name: "integration", Scopes: []string{"rpc:base.ClassSyncService"}},
+		apikey.Hash("all-key"):     {ID: 3, Name: "ops", Scopes: []string{entity.APIKeyScopeRPCAll}},
+		apikey.Hash("revoked-key"): {ID: 4, Name: "old", Scopes: []string{entity.APIKeyScopeRPCAll}, RevokedAt: &past},
+		apikey.Hash("expired-key"): {ID: 5, Name: "old", Scopes: []string{entity.APIKeyScopeRPCAll}, ExpiresAt: &past},
+	}}
+}
+
+func callWithAPIKey(m *interceptor.APIKeyMiddleware, key, method string) (context.Context, error) {
+	ctx := context.Background()
+	if key != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", key))
+	}
+	var seen context.Context
+	_, err := m.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		seen = ctx
+		return nil, nil
+	})
+	return seen, err
+}
+
+// --- Tests ---
+
+func TestAPIKeyInterceptor_WithoutKeyFallsThrough(t *testing.T) {
+	ctx, err := callWithAPIKey(interceptor.NewAPIKeyMiddleware(newAPIKeyStore()), "", "/base.ClassSyncService/ListClasses")
+	assert.NoError(t, err)
+	_, ok := interceptor.APIKeyFromContext(ctx)
+	assert.False(t, ok)
+}
+
+func TestAPIKeyInterceptor_ScopesAndStatus(t *testing.T) {
+	m := interceptor.NewAPIKeyMiddleware(newAPIKeyStore())
+
+	cases := []struct {
+		key, method string
+		want        codes.Code
+	}{
+		{"rpc-key", "/base.ClassSyncService/ListClasses", codes.OK},
+		{"rpc-key", "/base.SoalService/ListSoal", codes.PermissionDenied},
+		{"all-key", "/base.SoalService/ListSoal", codes.OK},
+		{"all-key", "/base.ApiKeyService/CreateApiKey", codes.PermissionDenied},
+		{"sync-key", "/base.ClassSyncService/ListClasses", codes.PermissionDenied},
+		{"revoked-key", "/base.SoalService/ListSoal", codes.Unauthenticated},
+		{"expired-key", "/base.SoalService/ListSoal", codes.Unauthenticated},
+		{"unknown-key", "/base.SoalService/ListSoal", codes.Unauthenticated},
+	}
+	for _, c := range cases {
+		_, err := callWithAPIKey(m, c.key, c.method)
+		assert.Equal(t, c.want, status.Code(err), "%s calling %s", c.key, c.method)
+	}
+}
+
+func TestAPIKeyInterceptor_SetsSuperadminAndTenant(t *testing.T) {
+	ctx, err := callWithAPIKey(interceptor.NewAPIKeyMiddleware(newAPIKeyStore()), "all-key", "/base.SoalService/ListSoal")
+	assert.NoError(t, err)
+
+	key, ok := interceptor.APIKeyFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, 3, key.ID)
+	assert.Equal(t, interceptor.RoleSuperadmin, interceptor.GetRoleNameFromContext(ctx))
+	current, _ := tenant.FromContext(ctx)
+	assert.True(t, current.CrossTenant)
+}
+
+func TestAPIKeyRequireScope(t *testing.T) {
+	store := newAPIKeyStore()
+	m := interceptor.NewAPIKeyMiddleware(store)
+
+	var school int64
+	handler := m.RequireScope(entity.APIKeyScopeSyncRead, func(w http.ResponseWriter, r *http.Request) {
+		current, _ := tenant.FromContext(r.Context())
+		school = current.SchoolID
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		key  string
+		want int
+	}{
+		{"", http.StatusUnauthorized},
+		{"unknown-key", http.StatusUnauthorized},
+		{"revoked-key", http.StatusUnauthorized},
+		{"rpc-key", http.StatusForbidden},
+		{"sync-key", http.StatusOK},
+	}
+	for _, c := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/v1/sync/classes", nil)
+		if c.key != "" {
+			req.Header.Set("X-API-Key", c.key)
+		}
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		assert.Equal(t, c.want, rec.Code, "key %q", c.key)
+	}
+	assert.Equal(t, int64(42), school)
+	assert.Contains(t, store.touched, 1)
+}
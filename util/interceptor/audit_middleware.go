@@ -0,0 +1,196 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/audit"
+	"cbt-test-mini-project/util/tenant"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestIDHeader identifies a call in logs and audit entries; one is generated when the
+// client sends none, and it is returned as a response header either way
+const RequestIDHeader = "x-request-id"
+
+// auditWriteTimeout bounds writing the entries of one call
+const auditWriteTimeout = 2 * time.Second
+
+// auditReadPrefixes mark methods that only read and are not audited
+var auditReadPrefixes = []string{"Get", "List", "Analyze", "HealthCheck"}
+
+// auditSkippedMethods change data but are too frequent to audit; answers have their own
+// history in jawaban_siswa and the session log
+var auditSkippedMethods = map[string]bool{
+	base.TestSessionService_SubmitAnswer_FullMethodName:         true,
+	base.TestSessionService_SubmitComplexAnswer_FullMethodName:  true,
+	base.TestSessionService_SubmitDragDropAnswer_FullMethodName: true,
+	base.TestSessionService_SubmitEssayAnswer_FullMethodName:    true,
+	base.TestSessionService_SubmitGridAnswer_FullMethodName:     true,
+	base.TestSessionService_SubmitHotspotAnswer_FullMethodName:  true,
+	base.TestSessionService_SubmitNumericAnswer_FullMethodName:  true,
+	base.TestSessionService_SubmitShortAnswer_FullMethodName:    true,
+	base.TestSessionService_ClearAnswer_FullMethodName:          true,
+	base.TestSessionService_RecordMediaPlay_FullMethodName:      true,
+	base.AuthService_RefreshToken_FullMethodName:                true,
+}
+
+// AuditWriter is the part of the audit_logs repository the middleware needs
+type AuditWriter interface {
+	Append(ctx context.Context, entries []*entity.AuditLog) error
+}
+
+// AuditMiddleware writes an audit entry for every authenticated call that changes data.
+// Usecases record their own before/after entries with audit.Record; calls without any get
+// one entry with the redacted request. Failed calls are audited too, with their status, so
+// calls the authorization or validation rejected still leave an entry. Calls turned away by
+// the rate limiter do not, or the limiter could not shield the audit table from a flood.
+type AuditMiddleware struct {
+	writer AuditWriter
+	now    func() time.Time
+}
+
+// NewAuditMiddleware creates a new audit middleware
+func NewAuditMiddleware(writer AuditWriter) *AuditMiddleware {
+	return &AuditMiddleware{writer: writer, now: time.Now}
+}
+
+// UnaryServerInterceptor returns the gRPC interceptor
+func (m *AuditMiddleware) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := requestIDFromContext(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	if !isAudited(ctx, info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	ctx, trail := audit.WithTrail(ctx)
+	resp, err := handler(ctx, req)
+	if status.Code(err) == codes.ResourceExhausted {
+		return resp, err
+	}
+
+	entries := trail.Entries()
+	if err != nil || len(entries) == 0 {
+		reqMsg, _ := req.(proto.Message)
+		resourceID := audit.ResourceID(reqMsg)
+		if resourceID == "" && err == nil {
+			respMsg, _ := resp.(proto.Message)
+			resourceID = audit.ResourceID(respMsg)
+		}
+		entries = []*entity.AuditLog{{
+			ResourceType: resourceTypeOf(info.FullMethod),
+			ResourceID:   resourceID,
+			Request:      audit.Payload(reqMsg),
+		}}
+	}
+
+	m.write(ctx, info.FullMethod, requestID, status.Code(err).String(), entries)
+	return resp, err
+}
+
+// write fills in who made the call and stores the entries; a failed write is logged but
+// does not fail the call, which already happened. The school is the caller's, else the
+// key's; entries of cross-tenant callers get the school of the row they changed from the
+// audit_logs insert trigger.
+func (m *AuditMiddleware) write(ctx context.Context, method, requestID, code string, entries []*entity.AuditLog) {
+	var actorID *int
+	var apiKeyID *int
+	var schoolID *int64
+	if key, ok := APIKeyFromContext(ctx); ok {
+		id := key.ID
+		apiKeyID = &id
+		schoolID = key.LMSSchoolID
+	} else if user, err := GetUserFromContext(ctx); err == nil && user.Id > 0 {
+		id := int(user.Id)
+		actorID = &id
+	}
+	if t, ok := tenant.FromContext(ctx); ok && !t.CrossTenant && t.SchoolID > 0 {
+		schoolID = &t.SchoolID
+	}
+
+	now := m.now()
+	for _, entry := range entries {
+		entry.OccurredAt = now
+		entry.ActorID = actorID
+		entry.ActorRole = GetRoleNameFromContext(ctx)
+		entry.APIKeyID = apiKeyID
+		entry.SchoolID = schoolID
+		entry.Method = method
+		entry.RequestID = requestID
+		entry.ClientIP = GetClientIPFromContext(ctx)
+		entry.Status = code
+	}
+
+	writeCtx, cancel := context.WithTimeout(tenant.System(context.WithoutCancel(ctx)), auditWriteTimeout)
+	defer cancel()
+	if err := m.writer.Append(writeCtx, entries); err != nil {
+		slog.Error("Failed to write audit log", "method", method, "request_id", requestID, "error", err)
+	}
+}
+
+// isAudited reports whether a call is made by a known caller and may change data
+func isAudited(ctx context.Context, method string) bool {
+	if auditSkippedMethods[method] {
+		return false
+	}
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range auditReadPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	if _, ok := APIKeyFromContext(ctx); ok {
+		return true
+	}
+	_, err := GetUserFromContext(ctx)
+	return err == nil
+}
+
+// resourceTypeOf names the resource of a service, e.g. "/base.SoalDragDropService/X" is
+// "soal_drag_drop"
+func resourceTypeOf(method string) string {
+	service := strings.TrimPrefix(method, "/")
+	if i := strings.Index(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	service = service[strings.LastIndex(service, ".")+1:]
+	service = strings.TrimSuffix(service, "Service")
+
+	var b strings.Builder
+	for i, r := range service {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// requestIDFromContext returns the client's request ID or a new one
+func requestIDFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if id := firstMetadataValue(md, RequestIDHeader); id != "" && len(id) <= 100 {
+		return id
+	}
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(raw)
+}
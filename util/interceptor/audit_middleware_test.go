@@ -0,0 +1,146 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/audit"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type fakeAuditWriter struct {
+	entries []*entity.AuditLog
+}
+
+func (f *fakeAuditWriter) Append(ctx context.Context, entries []*entity.AuditLog) error {
+	f.entries = append(f.entries, entries...)
+	return nil
+}
+
+func callAudited(m *interceptor.AuditMiddleware, ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) error {
+	_, err := m.UnaryServerInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return err
+}
+
+func teacherContext() context.Context {
+	ctx := context.WithValue(context.Background(), "user", &base.User{Id: 7})
+	ctx = interceptor.AddRoleNameToContext(ctx, interceptor.RoleTeacher)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(interceptor.RequestIDHeader, "req-1"))
+	return tenant.WithTenant(ctx, tenant.Tenant{SchoolID: 3})
+}
+
+func TestAuditWritesRecordedChanges(t *testing.T) {
+	writer := &fakeAuditWriter{}
+	m := interceptor.NewAuditMiddleware(writer)
+
+	err := callAudited(m, teacherContext(), base.SoalService_ReorderSoal_FullMethodName, &base.ReorderSoalRequest{IdMateri: 4},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			audit.Record(ctx, entity.AuditResourceMateri, 4, map[int]int{10: 1}, map[int]int{10: 2})
+			return &base.MessageStatusResponse{}, nil
+		})
+	assert.NoError(t, err)
+
+	if assert.Len(t, writer.entries, 1) {
+		entry := writer.entries[0]
+		assert.Equal(t, 7, *entry.ActorID)
+		assert.Equal(t, interceptor.RoleTeacher, entry.ActorRole)
+		assert.Equal(t, int64(3), *entry.SchoolID)
+		assert.Equal(t, base.SoalService_ReorderSoal_FullMethodName, entry.Method)
+		assert.Equal(t, entity.AuditResourceMateri, entry.ResourceType)
+		assert.Equal(t, "4", entry.ResourceID)
+		assert.JSONEq(t, `{"10": 1}`, string(entry.Before))
+		assert.JSONEq(t, `{"10": 2}`, string(entry.After))
+		assert.Equal(t, "req-1", entry.RequestID)
+		assert.Equal(t, "OK", entry.Status)
+	}
+}
+
+func TestAuditWritesRequestOfFailedCall(t *testing.T) {
+	writer := &fakeAuditWriter{}
+	m := interceptor.NewAuditMiddleware(writer)
+
+	err := callAudited(m, teacherContext(), base.AuthService_ChangePassword_FullMethodName, &base.ChangePasswordRequest{CurrentPassword: "a", NewPassword: "b"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "wrong password")
+		})
+	assert.Error(t, err)
+
+	if assert.Len(t, writer.entries, 1) {
+		entry := writer.entries[0]
+		assert.Equal(t, "auth", entry.ResourceType)
+		assert.Equal(t, "PermissionDenied", entry.Status)
+		assert.NotContains(t, string(entry.Request), `"b"`)
+	}
+}
+
+func TestAuditSkipsReadsAndAnonymousCalls(t *testing.T) {
+	writer := &fakeAuditWriter{}
+	m := interceptor.NewAuditMiddleware(writer)
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	assert.NoError(t, callAudited(m, teacherContext(), base.SoalService_GetSoal_FullMethodName, &base.GetSoalRequest{}, ok))
+	assert.NoError(t, callAudited(m, teacherContext(), base.TestSessionService_SubmitAnswer_FullMethodName, &base.SubmitAnswerRequest{}, ok))
+	assert.NoError(t, callAudited(m, context.Background(), base.AuthService_Logout_FullMethodName, &emptypb.Empty{}, ok))
+	assert.Empty(t, writer.entries)
+
+	assert.NoError(t, callAudited(m, teacherContext(), base.SoalDragDropService_DeleteSoalDragDrop_FullMethodName, &base.DeleteSoalDragDropRequest{Id: 5}, ok))
+	if assert.Len(t, writer.entries, 1) {
+		assert.Equal(t, "soal_drag_drop", writer.entries[0].ResourceType)
+		assert.Equal(t, "5", writer.entries[0].ResourceID)
+	}
+}
+
+func TestAuditRecordsAPIKey(t *testing.T) {
+	writer := &fakeAuditWriter{}
+	m := interceptor.NewAuditMiddleware(writer)
+	ctx := context.WithValue(context.Background(), "api_key", &entity.APIKey{ID: 11})
+	ctx = interceptor.AddUserToContext(ctx, &base.User{Role: base.UserRole_ADMIN})
+
+	err := callAudited(m, ctx, base.MateriService_DeleteMateri_FullMethodName, &base.DeleteMateriRequest{Id: 2},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, errors.New("boom") })
+	assert.Error(t, err)
+	if assert.Len(t, writer.entries, 1) {
+		assert.Nil(t, writer.entries[0].ActorID)
+		assert.Equal(t, 11, *writer.entries[0].APIKeyID)
+		assert.Nil(t, writer.entries[0].SchoolID)
+		assert.Equal(t, "Unknown", writer.entries[0].Status)
+	}
+}
+
+func TestAuditRecordsSchoolOfAPIKey(t *testing.T) {
+	writer := &fakeAuditWriter{}
+	m := interceptor.NewAuditMiddleware(writer)
+	schoolID := int64(9)
+	ctx := context.WithValue(context.Background(), "api_key", &entity.APIKey{ID: 11, LMSSchoolID: &schoolID})
+	ctx = tenant.WithTenant(ctx, tenant.Tenant{CrossTenant: true})
+
+	err := callAudited(m, ctx, base.MateriService_DeleteMateri_FullMethodName, &base.DeleteMateriRequest{Id: 2},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return &emptypb.Empty{}, nil })
+	assert.NoError(t, err)
+	if assert.Len(t, writer.entries, 1) {
+		assert.Equal(t, int64(9), *writer.entries[0].SchoolID)
+	}
+}
+
+func TestAuditSkipsRateLimitedCalls(t *testing.T) {
+	writer := &fakeAuditWriter{}
+	m := interceptor.NewAuditMiddleware(writer)
+
+	err := callAudited(m, teacherContext(), base.MateriService_DeleteMateri_FullMethodName, &base.DeleteMateriRequest{Id: 2},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		})
+	assert.Error(t, err)
+	assert.Empty(t, writer.entries)
+}
@@ -0,0 +1,227 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SessionOwnerLookup resolves who a test session belongs to
+type SessionOwnerLookup interface {
+	// GetSessionOwnerByToken returns the session's user id, 0 when the session does not exist or has no user
+	GetSessionOwnerByToken(ctx context.Context, token string) (int, error)
+}
+
+// TeachingLookup resolves whether a teacher teaches the class an assignment, essay answer or
+// session belongs to
+type TeachingLookup interface {
+	TeachesAssignment(ctx context.Context, lmsAssignmentID int64, userID int) (bool, error)
+	// TeachesAnswers also counts essays the user was assigned to mark
+	TeachesAnswers(ctx context.Context, answerIDs []int, userID int) (bool, error)
+	TeachesSession(ctx context.Context, token string, userID int) (bool, error)
+}
+
+// MateriPermissionLookup resolves what a user may do with the materi a resource belongs to
+type MateriPermissionLookup interface {
+	// GetPermission returns the materi id (0 when the resource does not exist) and the user's permission on it
+	GetPermission(ctx context.Context, resource entity.MateriResource, id int, userID int) (int, entity.MateriPermission, error)
+}
+
+// AuthorizationMiddleware enforces MethodPolicies. It runs after JWTMiddleware,
+// which puts the caller and their role in the context.
+type AuthorizationMiddleware struct {
+	policies map[string]MethodPolicy
+	sessions SessionOwnerLookup
+	teaching TeachingLookup
+	materi   MateriPermissionLookup
+}
+
+// NewAuthorizationMiddleware creates an authorization middleware for the given policy table
+func NewAuthorizationMiddleware(policies map[string]MethodPolicy, sessions SessionOwnerLookup, teaching TeachingLookup, materi MateriPermissionLookup) *AuthorizationMiddleware {
+	return &AuthorizationMiddleware{policies: policies, sessions: sessions, teaching: teaching, materi: materi}
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor that rejects calls the policy does not allow
+func (m *AuthorizationMiddleware) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := m.Authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Authorize checks the caller in ctx against the policy of method
+func (m *AuthorizationMiddleware) Authorize(ctx context.Context, method string, req interface{}) error {
+	policy, ok := m.policies[method]
+	if !ok {
+		slog.Warn("Denied call to method without authorization policy", "method", method)
+		return status.Error(codes.PermissionDenied, "method is not available")
+	}
+	if policy.GatewayOnly && !FromGateway(ctx) {
+		return status.Error(codes.PermissionDenied, "method is not available")
+	}
+	if policy.Public {
+		return nil
+	}
+
+	user, err := GetUserFromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if !HasRole(ctx, policy.Roles...) {
+		return status.Error(codes.PermissionDenied, "your role is not allowed to access this endpoint")
+	}
+
+	switch policy.Ownership {
+	case OwnerSession:
+		token := ""
+		if r, ok := req.(interface{ GetSessionToken() string }); ok {
+			token = r.GetSessionToken()
+		}
+		if token == "" {
+			return status.Error(codes.InvalidArgument, "session_token is required")
+		}
+		ownerID, err := m.sessions.GetSessionOwnerByToken(ctx, token)
+		if err != nil {
+			slog.Error("Session owner lookup failed", "error", err, "method", method)
+			return status.Error(codes.Internal, "failed to verify session ownership")
+		}
+		if ownerID == 0 || ownerID != int(user.Id) {
+			return status.Error(codes.PermissionDenied, "you do not have permission to access this session")
+		}
+	case OwnerAssignment, OwnerAssignmentFilter:
+		if HasRole(ctx, RoleTeacher) {
+			if err := m.authorizeTeaching(ctx, method, policy.Ownership, req, int(user.Id)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Superadmins manage every materi; teachers need ownership or a grant
+	if policy.MateriAccess != entity.MateriPermissionNone && HasRole(ctx, RoleTeacher) {
+		for _, ref := range materiRefs(policy, req) {
+			idMateri, permission, err := m.materi.GetPermission(ctx, ref.resource, ref.id, int(user.Id))
+			if err != nil {
+				slog.Error("Materi permission lookup failed", "error", err, "method", method)
+				return status.Error(codes.Internal, "failed to verify materi access")
+			}
+			if idMateri == 0 {
+				return status.Errorf(codes.NotFound, "%s %d not found", ref.resource, ref.id)
+			}
+			if !permission.Allows(policy.MateriAccess) {
+				return status.Error(codes.PermissionDenied, "you do not have access to this materi")
+			}
+		}
+	}
+	return nil
+}
+
+// authorizeTeaching checks that a teacher teaches the classes of every assignment, essay
+// answer and session the request references
+func (m *AuthorizationMiddleware) authorizeTeaching(ctx context.Context, method string, ownership Ownership, req interface{}, userID int) error {
+	var checks []func() (bool, error)
+	if r, ok := req.(interface{ GetLmsAssignmentId() int64 }); ok && r.GetLmsAssignmentId() != 0 {
+		checks = append(checks, func() (bool, error) {
+			return m.teaching.TeachesAssignment(ctx, r.GetLmsAssignmentId(), userID)
+		})
+	}
+	var answerIDs []int
+	if r, ok := req.(interface{ GetAnswerId() int32 }); ok && r.GetAnswerId() != 0 {
+		answerIDs = append(answerIDs, int(r.GetAnswerId()))
+	}
+	if r, ok := req.(interface{ GetAnswerIds() []int32 }); ok {
+		for _, id := range r.GetAnswerIds() {
+			answerIDs = append(answerIDs, int(id))
+		}
+	}
+	if len(answerIDs) > 0 {
+		checks = append(checks, func() (bool, error) {
+			return m.teaching.TeachesAnswers(ctx, answerIDs, userID)
+		})
+	}
+	if r, ok := req.(interface{ GetSessionToken() string }); ok && r.GetSessionToken() != "" {
+		checks = append(checks, func() (bool, error) {
+			return m.teaching.TeachesSession(ctx, r.GetSessionToken(), userID)
+		})
+	}
+
+	if len(checks) == 0 {
+		if ownership == OwnerAssignmentFilter {
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "only superadmin can act on every assignment")
+	}
+	for _, check := range checks {
+		teaches, err := check()
+		if err != nil {
+			slog.Error("Teaching lookup failed", "error", err, "method", method)
+			return status.Error(codes.Internal, "failed to verify class access")
+		}
+		if !teaches {
+			return status.Error(codes.PermissionDenied, "you do not teach this assignment")
+		}
+	}
+	return nil
+}
+
+type materiRef struct {
+	resource entity.MateriResource
+	id       int
+}
+
+// materiRefs collects the materi-owned records a request points at. An update that moves a
+// question to another materi references both, so access is checked on each.
+func materiRefs(policy MethodPolicy, req interface{}) []materiRef {
+	var refs []materiRef
+	add := func(resource entity.MateriResource, id int32) {
+		if id > 0 {
+			refs = append(refs, materiRef{resource: resource, id: int(id)})
+		}
+	}
+	if r, ok := req.(interface{ GetId() int32 }); ok && policy.Resource != "" {
+		add(policy.Resource, r.GetId())
+	}
+	if r, ok := req.(interface{ GetIdMateri() int32 }); ok {
+		add(entity.MateriResourceMateri, r.GetIdMateri())
+	}
+	if r, ok := req.(interface{ GetIdSoal() int32 }); ok {
+		add(entity.MateriResourceSoal, r.GetIdSoal())
+	}
+	if r, ok := req.(interface{ GetIdGambar() int32 }); ok {
+		add(entity.MateriResourceSoalGambar, r.GetIdGambar())
+	}
+	if r, ok := req.(interface{ GetIdMedia() int32 }); ok {
+		add(entity.MateriResourceSoalMedia, r.GetIdMedia())
+	}
+	return refs
+}
+
+// TeacherScopeFromContext returns the repository scope for the caller: teachers are limited to
+// their own or shared materi and the classes they teach, everyone else is unrestricted (nil).
+func TeacherScopeFromContext(ctx context.Context) *entity.TeacherScope {
+	if !HasRole(ctx, RoleTeacher) {
+		return nil
+	}
+	user, err := GetUserFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return &entity.TeacherScope{UserID: int(user.Id)}
+}
+
+// HasRole reports whether the caller's role is one of roles
+func HasRole(ctx context.Context, roles ...string) bool {
+	role := GetRoleNameFromContext(ctx)
+	for _, allowed := range roles {
+		if role == allowed {
+			return true
+		}
+	}
+	return false
+}
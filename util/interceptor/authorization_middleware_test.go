@@ -0,0 +1,439 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/interceptor"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// --- Fakes ---
+
+type fakeSessionOwners map[string]int
+
+func (f fakeSessionOwners) GetSessionOwnerByToken(ctx context.Context, token string) (int, error) {
+	return f[token], nil
+}
+
+// fakeTeaching lists the assignments, answers and sessions of classes the caller teaches
+type fakeTeaching struct {
+	assignments map[int64]bool
+	answers     map[int]bool
+	sessions    map[string]bool
+}
+
+func (f fakeTeaching) TeachesAssignment(ctx context.Context, lmsAssignmentID int64, userID int) (bool, error) {
+	return f.assignments[lmsAssignmentID], nil
+}
+
+func (f fakeTeaching) TeachesAnswers(ctx context.Context, answerIDs []int, userID int) (bool, error) {
+	for _, id := range answerIDs {
+		if !f.answers[id] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (f fakeTeaching) TeachesSession(ctx context.Context, token string, userID int) (bool, error) {
+	return f.sessions[token], nil
+}
+
+// fakeMateriPermissions is the caller's permission per resource id; a missing id does not exist
+type fakeMateriPermissions map[entity.MateriResource]map[int]entity.MateriPermission
+
+func (f fakeMateriPermissions) GetPermission(ctx context.Context, resource entity.MateriResource, id int, userID int) (int, entity.MateriPermission, error) {
+	permission, ok := f[resource][id]
+	if !ok {
+		return 0, entity.MateriPermissionNone, nil
+	}
+	return id, permission, nil
+}
+
+// request carries the fields ownership rules read
+type request struct {
+	sessionToken    string
+	lmsAssignmentID int64
+	id              int32
+	idMateri        int32
+	answerID        int32
+	answerIDs       []int32
+}
+
+func (r request) GetSessionToken() string   { return r.sessionToken }
+func (r request) GetLmsAssignmentId() int64 { return r.lmsAssignmentID }
+func (r request) GetId() int32              { return r.id }
+func (r request) GetIdMateri() int32        { return r.idMateri }
+func (r request) GetAnswerId() int32        { return r.answerID }
+func (r request) GetAnswerIds() []int32     { return r.answerIDs }
+
+const callerID = 7
+
+// callerContext is a call forwarded by the REST gateway, like most calls
+func callerContext(role string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), interceptor.GatewayMetadata())
+	ctx = interceptor.AddUserToContext(ctx, &base.User{Id: callerID})
+	return interceptor.AddRoleNameToContext(ctx, role)
+}
+
+func newAuthorizationMiddleware() *interceptor.AuthorizationMiddleware {
+	materi := fakeMateriPermissions{
+		entity.MateriResourceMateri: {
+			1: entity.MateriPermissionOwner,
+			2: entity.MateriPermissionEdit,
+			3: entity.MateriPermissionView,
+			4: entity.MateriPermissionNone,
+		},
+		entity.MateriResourceSoal: {
+			10: entity.MateriPermissionEdit,
+			11: entity.MateriPermissionView,
+		},
+	}
+	teaching := fakeTeaching{
+		assignments: map[int64]bool{1: true, 10: true},
+		answers:     map[int]bool{100: true, 101: true},
+		sessions:    map[string]bool{"own": true, "taught": true},
+	}
+	return interceptor.NewAuthorizationMiddleware(interceptor.MethodPolicies, fakeSessionOwners{"own": callerID, "other": callerID + 1}, teaching, materi)
+}
+
+var (
+	student    = []string{interceptor.RoleStudent}
+	teacher    = []string{interceptor.RoleTeacher}
+	superadmin = []string{interceptor.RoleSuperadmin}
+	staff      = []string{interceptor.RoleTeacher, interceptor.RoleSuperadmin}
+	everyone   = []string{interceptor.RoleStudent, interceptor.RoleTeacher, interceptor.RoleSuperadmin}
+)
+
+// expectedRoles is the intended access of every method, kept apart from MethodPolicies
+// so a change to the table has to be made twice
+var publicMethods = []string{
+	base.Base_HealthCheck_FullMethodName,
+	base.AuthService_Login_FullMethodName,
+	base.AuthService_RefreshToken_FullMethodName,
+	base.LabLoginService_LabLogin_FullMethodName,
+}
+
+var expectedRoles = map[string][]string{
+	"/grpc.health.v1.Health/Check":                 everyone,
+	base.AuthService_GetProfile_FullMethodName:     everyone,
+	base.AuthService_Logout_FullMethodName:         everyone,
+	base.AuthService_ChangePassword_FullMethodName: everyone,
+
+	base.MataPelajaranService_GetMataPelajaran_FullMethodName:  everyone,
+	base.MataPelajaranService_ListMataPelajaran_FullMethodName: everyone,
+	base.TingkatService_GetTingkat_FullMethodName:              everyone,
+	base.TingkatService_ListTingkat_FullMethodName:             everyone,
+
+	base.MateriService_CreateMateri_FullMethodName:           staff,
+	base.MateriService_CreateMateriSuperadmin_FullMethodName: superadmin,
+	base.MateriService_CreateMateriTeacher_FullMethodName:    teacher,
+	base.MateriService_GetMateri_FullMethodName:              everyone,
+	base.MateriService_UpdateMateri_FullMethodName:           staff,
+	base.MateriService_DeleteMateri_FullMethodName:           staff,
+	base.MateriService_ListMateri_FullMethodName:             everyone,
+	base.MateriService_ShareMateri_FullMethodName:            staff,
+	base.MateriService_RevokeMateriShare_FullMethodName:      staff,
+	base.MateriService_ListMateriShares_FullMethodName:       staff,
+
+	base.SoalService_CreateSoal_FullMethodName:               staff,
+	base.SoalService_GetSoal_FullMethodName:                  staff,
+	base.SoalService_UpdateSoal_FullMethodName:               staff,
+	base.SoalService_DeleteSoal_FullMethodName:               staff,
+	base.SoalService_ListSoal_FullMethodName:                 staff,
+	base.SoalService_UploadImageToSoal_FullMethodName:        staff,
+	base.SoalService_DeleteImageFromSoal_FullMethodName:      staff,
+	base.SoalService_UpdateImageInSoal_FullMethodName:        staff,
+	base.SoalService_UploadMediaToSoal_FullMethodName:        staff,
+	base.SoalService_DeleteMediaFromSoal_FullMethodName:      staff,
+	base.SoalService_UpdateMediaInSoal_FullMethodName:        staff,
+	base.SoalService_GetQuestionCountsByTopic_FullMethodName: everyone,
+	base.SoalService_ReorderSoal_FullMethodName:              staff,
+
+	base.SoalDragDropService_CreateSoalDragDrop_FullMethodName:  staff,
+	base.SoalDragDropService_GetSoalDragDrop_FullMethodName:     staff,
+	base.SoalDragDropService_UpdateSoalDragDrop_FullMethodName:  staff,
+	base.SoalDragDropService_DeleteSoalDragDrop_FullMethodName:  staff,
+	base.SoalDragDropService_ListSoalDragDrop_FullMethodName:    staff,
+	base.SoalDragDropService_ReorderSoalDragDrop_FullMethodName: staff,
+
+	base.TestSessionService_CreateTestSession_FullMethodName:       everyone,
+	base.TestSessionService_GetTestSession_FullMethodName:          everyone,
+	base.TestSessionService_GetTestQuestions_FullMethodName:        everyone,
+	base.TestSessionService_SubmitAnswer_FullMethodName:            everyone,
+	base.TestSessionService_SubmitComplexAnswer_FullMethodName:     everyone,
+	base.TestSessionService_SubmitShortAnswer_FullMethodName:       everyone,
+	base.TestSessionService_SubmitNumericAnswer_FullMethodName:     everyone,
+	base.TestSessionService_SubmitHotspotAnswer_FullMethodName:     everyone,
+	base.TestSessionService_SubmitGridAnswer_FullMethodName:        everyone,
+	base.TestSessionService_SubmitDragDropAnswer_FullMethodName:    everyone,
+	base.TestSessionService_SubmitEssayAnswer_FullMethodName:       everyone,
+	base.TestSessionService_ClearAnswer_FullMethodName:             everyone,
+	base.TestSessionService_CompleteSession_FullMethodName:         everyone,
+	base.TestSessionService_RecordMediaPlay_FullMethodName:         everyone,
+	base.TestSessionService_GetMediaStreamSource_FullMethodName:    everyone,
+	base.TestSessionService_GetTestResult_FullMethodName:           everyone,
+	base.TestSessionService_GradeEssayAnswer_FullMethodName:        superadmin,
+	base.TestSessionService_ListMyScheduledSessions_FullMethodName: student,
+	base.TestSessionService_StartScheduledSession_FullMethodName:   student,
+	base.TestSessionService_ListTestSessions_FullMethodName:        staff,
+
+	base.HistoryService_GetStudentHistory_FullMethodName:    everyone,
+	base.HistoryService_GetHistoryDetail_FullMethodName:     everyone,
+	base.HistoryService_ListStudentHistories_FullMethodName: staff,
+
+	base.UserLimitService_GetUserLimits_FullMethodName:            superadmin,
+	base.UserLimitService_SetUserLimit_FullMethodName:             superadmin,
+	base.UserLimitService_ResetUserLimit_FullMethodName:           superadmin,
+	base.UserLimitService_GetUserLimitUsageHistory_FullMethodName: superadmin,
+	base.UserLimitService_ListRateLimitPolicies_FullMethodName:    superadmin,
+	base.UserLimitService_SaveRateLimitPolicy_FullMethodName:      superadmin,
+	base.UserLimitService_DeleteRateLimitPolicy_FullMethodName:    superadmin,
+	base.UserLimitService_SetRateLimitMethodGroup_FullMethodName:  superadmin,
+	base.UserLimitService_SetSchoolPlan_FullMethodName:            superadmin,
+
+	base.ClassSyncService_ListClasses_FullMethodName:       superadmin,
+	base.ClassSyncService_ListClassStudents_FullMethodName: superadmin,
+
+	base.ExamSecurityService_UploadSebConfig_FullMethodName:          staff,
+	base.ExamSecurityService_GetSebConfig_FullMethodName:             staff,
+	base.ExamSecurityService_DeleteSebConfig_FullMethodName:          staff,
+	base.ExamSecurityService_ListDeviceLeases_FullMethodName:         staff,
+	base.ExamSecurityService_ApproveDeviceTransfer_FullMethodName:    staff,
+	base.ExamSecurityService_SetNetworkAllowlist_FullMethodName:      staff,
+	base.ExamSecurityService_GetNetworkAllowlist_FullMethodName:      staff,
+	base.ExamSecurityService_GrantNetworkOverride_FullMethodName:     superadmin,
+	base.ExamSecurityService_ListNetworkAccessDenials_FullMethodName: staff,
+	base.ExamSecurityService_AnalyzeCollusion_FullMethodName:         staff,
+
+	base.GradingService_RunEssaySimilarityCheck_FullMethodName:  staff,
+	base.GradingService_GetEssayGradingView_FullMethodName:      staff,
+	base.GradingService_SetEssayRubric_FullMethodName:           staff,
+	base.GradingService_GetEssayRubric_FullMethodName:           staff,
+	base.GradingService_SetGradingConfig_FullMethodName:         staff,
+	base.GradingService_GetGradingConfig_FullMethodName:         staff,
+	base.GradingService_ListPendingEssays_FullMethodName:        staff,
+	base.GradingService_AssignGraders_FullMethodName:            staff,
+	base.GradingService_SubmitEssayMark_FullMethodName:          staff,
+	base.GradingService_ResolveModeration_FullMethodName:        superadmin,
+	base.GradingService_GetGradingProgress_FullMethodName:       staff,
+	base.GradingService_SetEssayKeywords_FullMethodName:         staff,
+	base.GradingService_GetEssayKeywords_FullMethodName:         staff,
+	base.GradingService_GenerateScoreSuggestions_FullMethodName: staff,
+	base.GradingService_GetSuggestionAgreement_FullMethodName:   staff,
+
+	base.ApiKeyService_CreateApiKey_FullMethodName: superadmin,
+	base.ApiKeyService_ListApiKeys_FullMethodName:  superadmin,
+	base.ApiKeyService_RevokeApiKey_FullMethodName: superadmin,
+
+	base.LabLoginService_IssueLabCredentials_FullMethodName:  staff,
+	base.LabLoginService_RevokeLabCredentials_FullMethodName: staff,
+
+	base.AuditLogService_ListAuditLogs_FullMethodName: superadmin,
+}
+
+// --- Tests ---
+
+func TestMethodPolicies_CoverEveryRegisteredMethod(t *testing.T) {
+	services := []grpc.ServiceDesc{
+		base.Base_ServiceDesc,
+		base.AuthService_ServiceDesc,
+		base.MataPelajaranService_ServiceDesc,
+		base.MateriService_ServiceDesc,
+		base.TingkatService_ServiceDesc,
+		base.SoalService_ServiceDesc,
+		base.SoalDragDropService_ServiceDesc,
+		base.TestSessionService_ServiceDesc,
+		base.HistoryService_ServiceDesc,
+		base.UserLimitService_ServiceDesc,
+		base.ClassSyncService_ServiceDesc,
+		base.ExamSecurityService_ServiceDesc,
+		base.GradingService_ServiceDesc,
+		base.ApiKeyService_ServiceDesc,
+		base.LabLoginService_ServiceDesc,
+		base.AuditLogService_ServiceDesc,
+	}
+
+	for _, service := range services {
+		for _, method := range service.Methods {
+			fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+			_, ok := interceptor.MethodPolicies[fullMethod]
+			assert.True(t, ok, "%s has no authorization policy", fullMethod)
+		}
+	}
+}
+
+func TestAuthorize_PerMethodRoles(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	req := request{sessionToken: "own", lmsAssignmentID: 1}
+
+	for method, allowed := range expectedRoles {
+		for _, role := range everyone {
+			err := m.Authorize(callerContext(role), method, req)
+			if assert.Contains(t, interceptor.MethodPolicies, method) && contains(allowed, role) {
+				assert.NoError(t, err, "%s should be allowed for %s", method, role)
+			} else {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err), "%s should be denied for %s", method, role)
+			}
+		}
+	}
+	assert.Len(t, interceptor.MethodPolicies, len(expectedRoles)+len(publicMethods), "every policy needs an expectation")
+}
+
+func TestAuthorize_PublicMethodNeedsNoUser(t *testing.T) {
+	m := newAuthorizationMiddleware()
+
+	for _, method := range publicMethods {
+		err := m.Authorize(context.Background(), method, request{})
+		assert.NoError(t, err, method)
+	}
+}
+
+func TestAuthorize_UnknownMethodIsDenied(t *testing.T) {
+	m := newAuthorizationMiddleware()
+
+	err := m.Authorize(callerContext(interceptor.RoleSuperadmin), "/base.SoalService/DropAllSoal", request{})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthorize_MissingUserIsUnauthenticated(t *testing.T) {
+	m := newAuthorizationMiddleware()
+
+	err := m.Authorize(context.Background(), base.AuthService_GetProfile_FullMethodName, request{})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthorize_GatewayOnlyMethodRefusesDirectCalls(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	method := base.TestSessionService_GetMediaStreamSource_FullMethodName
+	direct := interceptor.AddRoleNameToContext(interceptor.AddUserToContext(context.Background(), &base.User{Id: callerID}), interceptor.RoleStudent)
+	forged := metadata.NewIncomingContext(direct, metadata.Pairs(interceptor.GatewayKeyHeader, "guessed"))
+
+	assert.NoError(t, m.Authorize(callerContext(interceptor.RoleStudent), method, request{sessionToken: "own"}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(direct, method, request{sessionToken: "own"})))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(forged, method, request{sessionToken: "own"})))
+}
+
+func TestAuthorize_SessionOwnership(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	method := base.TestSessionService_SubmitAnswer_FullMethodName
+
+	for _, role := range everyone {
+		ctx := callerContext(role)
+		assert.NoError(t, m.Authorize(ctx, method, request{sessionToken: "own"}))
+		assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(ctx, method, request{sessionToken: "other"})), role)
+		assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(ctx, method, request{sessionToken: "missing"})), role)
+		assert.Equal(t, codes.InvalidArgument, status.Code(m.Authorize(ctx, method, request{})), role)
+	}
+}
+
+func TestAuthorize_AssignmentOwnership(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	method := base.ExamSecurityService_SetNetworkAllowlist_FullMethodName
+
+	assert.NoError(t, m.Authorize(callerContext(interceptor.RoleTeacher), method, request{lmsAssignmentID: 10}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(callerContext(interceptor.RoleTeacher), method, request{lmsAssignmentID: 20})))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(callerContext(interceptor.RoleTeacher), method, request{})))
+	assert.NoError(t, m.Authorize(callerContext(interceptor.RoleSuperadmin), method, request{lmsAssignmentID: 20}))
+	assert.NoError(t, m.Authorize(callerContext(interceptor.RoleSuperadmin), method, request{}))
+}
+
+func TestAuthorize_AssignmentOwnershipChecksEveryReference(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	teacherCtx := callerContext(interceptor.RoleTeacher)
+
+	cases := []struct {
+		name    string
+		method  string
+		req     request
+		allowed bool
+	}{
+		{"seb config of a taught assignment", base.ExamSecurityService_GetSebConfig_FullMethodName, request{lmsAssignmentID: 10}, true},
+		{"seb config of another class", base.ExamSecurityService_DeleteSebConfig_FullMethodName, request{lmsAssignmentID: 20}, false},
+		{"leases of a taught session", base.ExamSecurityService_ListDeviceLeases_FullMethodName, request{sessionToken: "taught"}, true},
+		{"leases of another class", base.ExamSecurityService_ApproveDeviceTransfer_FullMethodName, request{sessionToken: "other"}, false},
+		{"mark a taught answer", base.GradingService_SubmitEssayMark_FullMethodName, request{answerID: 100}, true},
+		{"mark another class's answer", base.GradingService_SubmitEssayMark_FullMethodName, request{answerID: 200}, false},
+		{"assign graders to taught answers", base.GradingService_AssignGraders_FullMethodName, request{lmsAssignmentID: 10, answerIDs: []int32{100, 101}}, true},
+		{"assign graders with one foreign answer", base.GradingService_AssignGraders_FullMethodName, request{lmsAssignmentID: 10, answerIDs: []int32{100, 200}}, false},
+		{"taught answers under a foreign assignment", base.GradingService_AssignGraders_FullMethodName, request{lmsAssignmentID: 20, answerIDs: []int32{100}}, false},
+		{"collusion without an assignment", base.ExamSecurityService_AnalyzeCollusion_FullMethodName, request{}, false},
+		{"pending essays of every taught class", base.GradingService_ListPendingEssays_FullMethodName, request{}, true},
+		{"pending essays of another class", base.GradingService_ListPendingEssays_FullMethodName, request{lmsAssignmentID: 20}, false},
+		{"denials of every taught class", base.ExamSecurityService_ListNetworkAccessDenials_FullMethodName, request{}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := m.Authorize(teacherCtx, tc.method, tc.req)
+			if tc.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestAuthorize_MateriAccess(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	teacherCtx := callerContext(interceptor.RoleTeacher)
+	get := base.MateriService_GetMateri_FullMethodName
+	update := base.MateriService_UpdateMateri_FullMethodName
+	del := base.MateriService_DeleteMateri_FullMethodName
+	share := base.MateriService_ShareMateri_FullMethodName
+
+	// owner
+	assert.NoError(t, m.Authorize(teacherCtx, del, request{id: 1}))
+	assert.NoError(t, m.Authorize(teacherCtx, share, request{idMateri: 1}))
+	// co-owner
+	assert.NoError(t, m.Authorize(teacherCtx, update, request{id: 2}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(teacherCtx, del, request{id: 2})))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(teacherCtx, share, request{idMateri: 2})))
+	// shared read-only
+	assert.NoError(t, m.Authorize(teacherCtx, get, request{id: 3}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(teacherCtx, update, request{id: 3})))
+	// not shared, missing
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(teacherCtx, get, request{id: 4})))
+	assert.Equal(t, codes.NotFound, status.Code(m.Authorize(teacherCtx, get, request{id: 99})))
+
+	// superadmins and students (read) are not scoped by ownership
+	assert.NoError(t, m.Authorize(callerContext(interceptor.RoleSuperadmin), del, request{id: 4}))
+	assert.NoError(t, m.Authorize(callerContext(interceptor.RoleStudent), get, request{id: 4}))
+}
+
+func TestAuthorize_SoalAccessChecksEveryReferencedMateri(t *testing.T) {
+	m := newAuthorizationMiddleware()
+	teacherCtx := callerContext(interceptor.RoleTeacher)
+	update := base.SoalService_UpdateSoal_FullMethodName
+
+	assert.NoError(t, m.Authorize(teacherCtx, update, request{id: 10, idMateri: 2}))
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(teacherCtx, update, request{id: 11, idMateri: 2})), "soal in a read-only materi")
+	assert.Equal(t, codes.PermissionDenied, status.Code(m.Authorize(teacherCtx, update, request{id: 10, idMateri: 3})), "moving soal into a read-only materi")
+	assert.NoError(t, m.Authorize(teacherCtx, base.SoalService_GetSoal_FullMethodName, request{id: 11}))
+}
+
+func TestTeacherScopeFromContext(t *testing.T) {
+	assert.Equal(t, &entity.TeacherScope{UserID: callerID}, interceptor.TeacherScopeFromContext(callerContext(interceptor.RoleTeacher)))
+	assert.Nil(t, interceptor.TeacherScopeFromContext(callerContext(interceptor.RoleSuperadmin)))
+	assert.Nil(t, interceptor.TeacherScopeFromContext(context.Background()))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
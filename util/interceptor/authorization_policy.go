@@ -0,0 +1,182 @@
+package interceptor
+
+import (
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+)
+
+// Normalized role names, as returned by GetRoleNameFromContext
+const (
+	RoleStudent    = "student"
+	RoleTeacher    = "teacher"
+	RoleSuperadmin = "superadmin"
+)
+
+// Ownership is the resource rule a method applies on top of its allowed roles
+type Ownership int
+
+const (
+	// OwnerNone applies no resource rule
+	OwnerNone Ownership = iota
+	// OwnerSession requires the request's session_token to belong to the caller
+	OwnerSession
+	// OwnerAssignment lets teachers act only on the lms_assignment_id, answer_id(s) and
+	// session_token of classes they teach; requests without any of them are for superadmins
+	OwnerAssignment
+	// OwnerAssignmentFilter checks the same references but lets teachers omit them; the
+	// usecase then limits the results to classes they teach
+	OwnerAssignmentFilter
+)
+
+// MethodPolicy is who may call a gRPC method
+type MethodPolicy struct {
+	// Public methods are callable without a token
+	Public    bool
+	Roles     []string
+	Ownership Ownership
+	// MateriAccess is the permission a teacher needs on every materi the request references
+	// (id_materi, id_soal, id_gambar, id_media, and id as Resource); superadmins are not checked
+	MateriAccess entity.MateriPermission
+	// Resource is what the request's id field refers to
+	Resource entity.MateriResource
+	// GatewayOnly methods back endpoints of the REST gateway and are refused on calls the
+	// gateway did not forward
+	GatewayOnly bool
+}
+
+var (
+	allRoles   = []string{RoleStudent, RoleTeacher, RoleSuperadmin}
+	staffRoles = []string{RoleTeacher, RoleSuperadmin}
+	adminRoles = []string{RoleSuperadmin}
+
+	view  = entity.MateriPermissionView
+	edit  = entity.MateriPermissionEdit
+	owner = entity.MateriPermissionOwner
+)
+
+// MethodPolicies lists every gRPC method the server exposes. A method missing from
+// the table is denied to everyone, so a new RPC has to be added here before it can
+// be called.
+var MethodPolicies = map[string]MethodPolicy{
+	base.Base_HealthCheck_FullMethodName: {Public: true},
+	"/grpc.health.v1.Health/Check":       {Roles: allRoles},
+
+	base.AuthService_GetProfile_FullMethodName:     {Roles: allRoles},
+	base.AuthService_Login_FullMethodName:          {Public: true},
+	base.AuthService_RefreshToken_FullMethodName:   {Public: true},
+	base.AuthService_Logout_FullMethodName:         {Roles: allRoles},
+	base.AuthService_ChangePassword_FullMethodName: {Roles: allRoles},
+
+	base.MataPelajaranService_GetMataPelajaran_FullMethodName:  {Roles: allRoles},
+	base.MataPelajaranService_ListMataPelajaran_FullMethodName: {Roles: allRoles},
+	base.TingkatService_GetTingkat_FullMethodName:              {Roles: allRoles},
+	base.TingkatService_ListTingkat_FullMethodName:             {Roles: allRoles},
+
+	base.MateriService_CreateMateri_FullMethodName:           {Roles: staffRoles},
+	base.MateriService_CreateMateriSuperadmin_FullMethodName: {Roles: adminRoles},
+	base.MateriService_CreateMateriTeacher_FullMethodName:    {Roles: []string{RoleTeacher}},
+	base.MateriService_GetMateri_FullMethodName:              {Roles: allRoles, MateriAccess: view, Resource: entity.MateriResourceMateri},
+	base.MateriService_UpdateMateri_FullMethodName:           {Roles: staffRoles, MateriAccess: edit, Resource: entity.MateriResourceMateri},
+	base.MateriService_DeleteMateri_FullMethodName:           {Roles: staffRoles, MateriAccess: owner, Resource: entity.MateriResourceMateri},
+	base.MateriService_ListMateri_FullMethodName:             {Roles: allRoles},
+	base.MateriService_ShareMateri_FullMethodName:            {Roles: staffRoles, MateriAccess: owner},
+	base.MateriService_RevokeMateriShare_FullMethodName:      {Roles: staffRoles, MateriAccess: owner},
+	base.MateriService_ListMateriShares_FullMethodName:       {Roles: staffRoles, MateriAccess: owner},
+
+	// Soal responses carry the answer keys, students only see questions through their sessions
+	base.SoalService_CreateSoal_FullMethodName:               {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_GetSoal_FullMethodName:                  {Roles: staffRoles, MateriAccess: view, Resource: entity.MateriResourceSoal},
+	base.SoalService_UpdateSoal_FullMethodName:               {Roles: staffRoles, MateriAccess: edit, Resource: entity.MateriResourceSoal},
+	base.SoalService_DeleteSoal_FullMethodName:               {Roles: staffRoles, MateriAccess: edit, Resource: entity.MateriResourceSoal},
+	base.SoalService_ListSoal_FullMethodName:                 {Roles: staffRoles, MateriAccess: view},
+	base.SoalService_UploadImageToSoal_FullMethodName:        {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_DeleteImageFromSoal_FullMethodName:      {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_UpdateImageInSoal_FullMethodName:        {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_UploadMediaToSoal_FullMethodName:        {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_DeleteMediaFromSoal_FullMethodName:      {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_UpdateMediaInSoal_FullMethodName:        {Roles: staffRoles, MateriAccess: edit},
+	base.SoalService_GetQuestionCountsByTopic_FullMethodName: {Roles: allRoles},
+	base.SoalService_ReorderSoal_FullMethodName:              {Roles: staffRoles, MateriAccess: edit},
+
+	base.SoalDragDropService_CreateSoalDragDrop_FullMethodName:  {Roles: staffRoles, MateriAccess: edit},
+	base.SoalDragDropService_GetSoalDragDrop_FullMethodName:     {Roles: staffRoles, MateriAccess: view, Resource: entity.MateriResourceSoalDragDrop},
+	base.SoalDragDropService_UpdateSoalDragDrop_FullMethodName:  {Roles: staffRoles, MateriAccess: edit, Resource: entity.MateriResourceSoalDragDrop},
+	base.SoalDragDropService_DeleteSoalDragDrop_FullMethodName:  {Roles: staffRoles, MateriAccess: edit, Resource: entity.MateriResourceSoalDragDrop},
+	base.SoalDragDropService_ListSoalDragDrop_FullMethodName:    {Roles: staffRoles, MateriAccess: view},
+	base.SoalDragDropService_ReorderSoalDragDrop_FullMethodName: {Roles: staffRoles, MateriAccess: edit},
+
+	base.TestSessionService_CreateTestSession_FullMethodName:       {Roles: allRoles},
+	base.TestSessionService_GetTestSession_FullMethodName:          {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_GetTestQuestions_FullMethodName:        {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitAnswer_FullMethodName:            {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitComplexAnswer_FullMethodName:     {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitShortAnswer_FullMethodName:       {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitNumericAnswer_FullMethodName:     {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitHotspotAnswer_FullMethodName:     {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitGridAnswer_FullMethodName:        {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitDragDropAnswer_FullMethodName:    {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_SubmitEssayAnswer_FullMethodName:       {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_ClearAnswer_FullMethodName:             {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_CompleteSession_FullMethodName:         {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_RecordMediaPlay_FullMethodName:         {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_GetMediaStreamSource_FullMethodName:    {Roles: allRoles, Ownership: OwnerSession, GatewayOnly: true},
+	base.TestSessionService_GetTestResult_FullMethodName:           {Roles: allRoles, Ownership: OwnerSession},
+	base.TestSessionService_GradeEssayAnswer_FullMethodName:        {Roles: adminRoles},
+	base.TestSessionService_ListMyScheduledSessions_FullMethodName: {Roles: []string{RoleStudent}},
+	base.TestSessionService_StartScheduledSession_FullMethodName:   {Roles: []string{RoleStudent}},
+	base.TestSessionService_ListTestSessions_FullMethodName:        {Roles: staffRoles},
+
+	base.HistoryService_GetStudentHistory_FullMethodName:    {Roles: allRoles},
+	base.HistoryService_GetHistoryDetail_FullMethodName:     {Roles: allRoles, Ownership: OwnerSession},
+	base.HistoryService_ListStudentHistories_FullMethodName: {Roles: staffRoles},
+
+	base.UserLimitService_GetUserLimits_FullMethodName:            {Roles: adminRoles},
+	base.UserLimitService_SetUserLimit_FullMethodName:             {Roles: adminRoles},
+	base.UserLimitService_ResetUserLimit_FullMethodName:           {Roles: adminRoles},
+	base.UserLimitService_GetUserLimitUsageHistory_FullMethodName: {Roles: adminRoles},
+	base.UserLimitService_ListRateLimitPolicies_FullMethodName:    {Roles: adminRoles},
+	base.UserLimitService_SaveRateLimitPolicy_FullMethodName:      {Roles: adminRoles},
+	base.UserLimitService_DeleteRateLimitPolicy_FullMethodName:    {Roles: adminRoles},
+	base.UserLimitService_SetRateLimitMethodGroup_FullMethodName:  {Roles: adminRoles},
+	base.UserLimitService_SetSchoolPlan_FullMethodName:            {Roles: adminRoles},
+
+	base.ClassSyncService_ListClasses_FullMethodName:       {Roles: adminRoles},
+	base.ClassSyncService_ListClassStudents_FullMethodName: {Roles: adminRoles},
+
+	base.ExamSecurityService_UploadSebConfig_FullMethodName:          {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.ExamSecurityService_GetSebConfig_FullMethodName:             {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.ExamSecurityService_DeleteSebConfig_FullMethodName:          {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.ExamSecurityService_ListDeviceLeases_FullMethodName:         {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.ExamSecurityService_ApproveDeviceTransfer_FullMethodName:    {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.ExamSecurityService_SetNetworkAllowlist_FullMethodName:      {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.ExamSecurityService_GetNetworkAllowlist_FullMethodName:      {Roles: staffRoles, Ownership: OwnerAssignmentFilter},
+	base.ExamSecurityService_GrantNetworkOverride_FullMethodName:     {Roles: adminRoles},
+	base.ExamSecurityService_ListNetworkAccessDenials_FullMethodName: {Roles: staffRoles, Ownership: OwnerAssignmentFilter},
+	base.ExamSecurityService_AnalyzeCollusion_FullMethodName:         {Roles: staffRoles, Ownership: OwnerAssignment},
+
+	base.GradingService_RunEssaySimilarityCheck_FullMethodName:  {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_GetEssayGradingView_FullMethodName:      {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_SetEssayRubric_FullMethodName:           {Roles: staffRoles, MateriAccess: edit},
+	base.GradingService_GetEssayRubric_FullMethodName:           {Roles: staffRoles, MateriAccess: view},
+	base.GradingService_SetGradingConfig_FullMethodName:         {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_GetGradingConfig_FullMethodName:         {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_ListPendingEssays_FullMethodName:        {Roles: staffRoles, Ownership: OwnerAssignmentFilter},
+	base.GradingService_AssignGraders_FullMethodName:            {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_SubmitEssayMark_FullMethodName:          {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_ResolveModeration_FullMethodName:        {Roles: adminRoles},
+	base.GradingService_GetGradingProgress_FullMethodName:       {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_SetEssayKeywords_FullMethodName:         {Roles: staffRoles, MateriAccess: edit},
+	base.GradingService_GetEssayKeywords_FullMethodName:         {Roles: staffRoles, MateriAccess: view},
+	base.GradingService_GenerateScoreSuggestions_FullMethodName: {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.GradingService_GetSuggestionAgreement_FullMethodName:   {Roles: staffRoles, Ownership: OwnerAssignment},
+
+	base.ApiKeyService_CreateApiKey_FullMethodName: {Roles: adminRoles},
+	base.ApiKeyService_ListApiKeys_FullMethodName:  {Roles: adminRoles},
+	base.ApiKeyService_RevokeApiKey_FullMethodName: {Roles: adminRoles},
+
+	base.LabLoginService_IssueLabCredentials_FullMethodName:  {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.LabLoginService_RevokeLabCredentials_FullMethodName: {Roles: staffRoles, Ownership: OwnerAssignment},
+	base.LabLoginService_LabLogin_FullMethodName:             {Public: true},
+
+	base.AuditLogService_ListAuditLogs_FullMethodName: {Roles: adminRoles},
+}
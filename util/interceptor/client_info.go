@@ -0,0 +1,60 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// GetClientIPFromContext returns the client IP resolved by NetworkAccessMiddleware, which
+// reads X-Forwarded-For only from trusted proxies, falling back to the peer address.
+// Forwarded headers are never read here: without the middleware nothing vouches for them.
+func GetClientIPFromContext(ctx context.Context) string {
+	if clientIP, ok := ctx.Value("client_ip").(string); ok && clientIP != "" {
+		return clientIP
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
+
+// DeviceLeaseHeader carries the lease secret the server issued to a device. It is sent in
+// a response header when issued and must be sent back on every later call of the session.
+const DeviceLeaseHeader = "x-device-lease"
+
+// GetDeviceInfoFromContext identifies the calling device. Clients should send a stable
+// X-Device-Id; without it a fingerprint of user agent and client IP is used.
+func GetDeviceInfoFromContext(ctx context.Context) entity.DeviceInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	info := entity.DeviceInfo{
+		DeviceID:    firstMetadataValue(md, "x-device-id"),
+		UserAgent:   firstMetadataValue(md, "grpcgateway-user-agent"),
+		IPAddress:   GetClientIPFromContext(ctx),
+		LeaseSecret: firstMetadataValue(md, DeviceLeaseHeader),
+	}
+	if info.UserAgent == "" {
+		info.UserAgent = firstMetadataValue(md, "user-agent")
+	}
+
+	if len(info.DeviceID) > 128 {
+		info.DeviceID = info.DeviceID[:128]
+	}
+	if info.DeviceID == "" {
+		sum := sha256.Sum256([]byte(info.UserAgent + "|" + info.IPAddress))
+		info.DeviceID = "fp:" + hex.EncodeToString(sum[:16])
+	}
+	return info
+}
@@ -0,0 +1,6 @@
+package interceptor
+
+// HMACSecrets exposes the LMS HMAC secrets of a middleware to the tests
+func HMACSecrets(m *JWTMiddleware) []string {
+	return m.hmacSecrets()
+}
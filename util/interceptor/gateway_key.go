@@ -0,0 +1,37 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// GatewayKeyHeader carries the key of the REST gateway. The gateway runs in the same process
+// as the gRPC server, so a key generated at start-up is known to both and to no client.
+// Gateway-only methods and headers the gateway sets itself are trusted only on calls with it.
+const GatewayKeyHeader = "x-gateway-key"
+
+var gatewayKey = newGatewayKey()
+
+func newGatewayKey() string {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		panic("interceptor: failed to generate gateway key: " + err.Error())
+	}
+	return hex.EncodeToString(raw)
+}
+
+// GatewayMetadata returns the metadata the gateway adds to every call it forwards
+func GatewayMetadata() metadata.MD {
+	return metadata.Pairs(GatewayKeyHeader, gatewayKey)
+}
+
+// FromGateway reports whether a call was forwarded by the REST gateway of this process
+func FromGateway(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	key := firstMetadataValue(md, GatewayKeyHeader)
+	return key != "" && subtle.ConstantTimeCompare([]byte(key), []byte(gatewayKey)) == 1
+}
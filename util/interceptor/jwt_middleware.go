@@ -1,14 +1,21 @@
 package interceptor
 
 import (
-	base "cbt-test-mini-project/gen/proto"
-	"cbt-test-mini-project/init/config"
-	authRepo "cbt-test-mini-project/internal/repository/auth"
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/init/config"
+	authRepo "cbt-test-mini-project/internal/repository/auth"
+	"cbt-test-mini-project/util/jwks"
+	"cbt-test-mini-project/util/localauth"
+	"cbt-test-mini-project/util/tenant"
 
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
@@ -19,25 +26,66 @@ import (
 
 // JWTClaims represents the JWT claims
 type JWTClaims struct {
-	LMSUserID int64  `json:"lms_user_id,omitempty"`
-	UserID    int32  `json:"user_id,omitempty"`
-	Email     string `json:"email"`
-	FullName  string `json:"full_name,omitempty"`
-	Nama      string `json:"nama,omitempty"`
-	RoleName  string `json:"role_name,omitempty"`
-	Type      string `json:"type,omitempty"`
+	LMSUserID   int64  `json:"lms_user_id,omitempty"`
+	UserID      int32  `json:"user_id,omitempty"`
+	LMSSchoolID int64  `json:"lms_school_id,omitempty"`
+	Email       string `json:"email"`
+	FullName    string `json:"full_name,omitempty"`
+	Nama        string `json:"nama,omitempty"`
+	RoleName    string `json:"role_name,omitempty"`
+	Type        string `json:"type,omitempty"`
+	// ExamSessionToken is set for lab logins, which may only take this test session
+	ExamSessionToken string `json:"-"`
 	jwt.RegisteredClaims
 }
 
+// placeholderJWTSecrets are the JWT_SECRET fallback in init/config and the value in .env.example;
+// anyone can sign local tokens with them
+var placeholderJWTSecrets = map[string]bool{
+	"": true,
+	"your-super-secret-jwt-key-change-this-in-production": true,
+	"change-this-in-production":                           true,
+}
+
+// AuthSessionChecker reports whether a local login session is still open
+type AuthSessionChecker interface {
+	IsSessionActive(ctx context.Context, sessionID int64, userID int32) (bool, error)
+}
+
 // JWTMiddleware validates JWT tokens for protected endpoints
 type JWTMiddleware struct {
 	config   *config.Main
 	authRepo authRepo.AuthRepository
+	sessions AuthSessionChecker
+	keySet   *jwks.KeySet
 }
 
-// NewJWTMiddleware creates a new JWT middleware
-func NewJWTMiddleware(config *config.Main, authRepo authRepo.AuthRepository) *JWTMiddleware {
-	return &JWTMiddleware{config: config, authRepo: authRepo}
+// NewJWTMiddleware creates a new JWT middleware. When an LMS JWKS is configured it is
+// loaded up front so a bad path or URL shows in the startup logs. Local and lab logins sign
+// tokens with JWT_SECRET, so they refuse to start with a placeholder secret, or with LMS HMAC
+// tokens that have no LMS_JWT_SECRET of their own.
+func NewJWTMiddleware(config *config.Main, authRepo authRepo.AuthRepository, sessions AuthSessionChecker) (*JWTMiddleware, error) {
+	if signsLocalTokens(config) {
+		localSecret := strings.TrimSpace(config.JWT.Secret)
+		if placeholderJWTSecrets[localSecret] {
+			return nil, errors.New("local login needs a random JWT_SECRET, the placeholder value lets anyone sign tokens")
+		}
+		lmsSecret := strings.TrimSpace(config.JWT.LMSTokenSecret)
+		if config.JWT.LMSAuthEnabled() && config.JWT.LMSAllowHMAC && (lmsSecret == "" || lmsSecret == localSecret) {
+			return nil, errors.New("LMS HMAC tokens need an LMS_JWT_SECRET separate from JWT_SECRET while local or lab login is on")
+		}
+	}
+
+	m := &JWTMiddleware{config: config, authRepo: authRepo, sessions: sessions}
+	if source := strings.TrimSpace(config.JWT.LMSJWKS); source != "" {
+		m.keySet = jwks.New(source,
+			time.Duration(config.JWT.LMSJWKSRefreshMinutes)*time.Minute,
+			time.Duration(config.JWT.LMSJWKSGraceMinutes)*time.Minute)
+		if err := m.keySet.Refresh(context.Background()); err != nil {
+			slog.Error("Failed to load LMS JWKS", "error", err, "source", source)
+		}
+	}
+	return m, nil
 }
 
 // UnaryServerInterceptor returns a gRPC unary server interceptor for JWT validation
@@ -48,36 +96,142 @@ func (m *JWTMiddleware) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
+		// Already authenticated by APIKeyMiddleware
+		if _, ok := APIKeyFromContext(ctx); ok {
+			return handler(ctx, req)
+		}
+
 		// Extract token from metadata
 		token, err := ExtractTokenFromContext(ctx)
 		if err != nil {
 			return nil, status.Error(codes.Unauthenticated, err.Error())
 		}
 
-		// Validate LMS token first to keep role context from source of truth
-		claims, err := m.validateLMSToken(token)
-		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid LMS access token: "+err.Error())
+		// Tokens from AuthService.Login carry the CBT issuer; everything else must be an LMS token
+		var claims *JWTClaims
+		var user *base.User
+		var sessionID int64
+		if localauth.IsLocalToken(token) {
+			claims, user, sessionID, err = m.authenticateLocalToken(ctx, token)
+		} else {
+			claims, user, err = m.authenticateLMSToken(ctx, token)
 		}
-
-		// Map/provision local user context
-		user, err := m.resolveUserFromClaims(ctx, claims)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, err
+		}
+		if claims.ExamSessionToken != "" {
+			if err := checkLabScope(info.FullMethod, req, claims.ExamSessionToken); err != nil {
+				return nil, err
+			}
 		}
 
+		// The token's role is the source of truth; the stored role can lag behind the LMS,
+		// so user.Role is overwritten to keep both role sources in agreement
+		roleName := normalizeRoleName(claims.RoleName)
+		user.Role = ProtoRole(roleName)
+
 		// Add user info to context
 		ctx = AddUserToContext(ctx, user)
-		ctx = AddRoleNameToContext(ctx, normalizeRoleName(claims.RoleName))
+		ctx = AddRoleNameToContext(ctx, roleName)
+		ctx = tenant.WithTenant(ctx, resolveTenant(ctx, claims, roleName))
+		if sessionID != 0 {
+			ctx = context.WithValue(ctx, "auth_session_id", sessionID)
+		}
 
 		return handler(ctx, req)
 	}
 }
 
+// authenticateLMSToken validates an LMS token first to keep role context from source of truth,
+// then maps it to the local user
+func (m *JWTMiddleware) authenticateLMSToken(ctx context.Context, token string) (*JWTClaims, *base.User, error) {
+	if !m.config.JWT.LMSAuthEnabled() {
+		return nil, nil, status.Error(codes.Unauthenticated, "LMS tokens are not accepted, log in with AuthService.Login")
+	}
+
+	claims, err := m.validateLMSToken(ctx, token)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, "invalid LMS access token: "+err.Error())
+	}
+
+	user, err := m.resolveUserFromClaims(ctx, claims)
+	if err != nil {
+		return nil, nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return claims, user, nil
+}
+
+// authenticateLocalToken validates a token issued by AuthService.Login or LabLoginService.LabLogin.
+// Its session must still be open, and the stored user decides the role so demotions apply
+// immediately.
+func (m *JWTMiddleware) authenticateLocalToken(ctx context.Context, token string) (*JWTClaims, *base.User, int64, error) {
+	if !signsLocalTokens(m.config) {
+		return nil, nil, 0, status.Error(codes.Unauthenticated, "local tokens are not accepted, sign in through the LMS")
+	}
+
+	local, err := localauth.ParseAccessToken(m.config.JWT.Secret, token)
+	if err != nil {
+		return nil, nil, 0, status.Error(codes.Unauthenticated, "invalid access token: "+err.Error())
+	}
+	if local.Type == localauth.TokenTypeLab && !m.config.JWT.LabLoginEnabled {
+		return nil, nil, 0, status.Error(codes.Unauthenticated, "lab login is not enabled")
+	}
+	if local.Type == localauth.TokenTypeAccess && !m.config.JWT.LocalAuthEnabled() {
+		return nil, nil, 0, status.Error(codes.Unauthenticated, "local tokens are not accepted, sign in through the LMS")
+	}
+
+	// The tenant comes from this token, so the session is looked up across schools
+	active, err := m.sessions.IsSessionActive(tenant.System(ctx), local.SessionID, local.UserID)
+	if err != nil {
+		slog.Error("Failed to check login session", "error", err, "session_id", local.SessionID)
+		return nil, nil, 0, status.Error(codes.Internal, "failed to verify session")
+	}
+	if !active {
+		return nil, nil, 0, status.Error(codes.Unauthenticated, "session has ended, please log in again")
+	}
+
+	user, err := m.authRepo.GetUserByID(ctx, local.UserID)
+	if err != nil || !user.IsActive {
+		return nil, nil, 0, status.Error(codes.Unauthenticated, "user is inactive")
+	}
+
+	// Local ADMIN accounts administer their own school; only SUPERADMIN accounts work across schools
+	roleName := RoleNameFromProto(user.Role)
+	if user.Role == base.UserRole_ADMIN {
+		roleName = "school_admin"
+	}
+	claims := &JWTClaims{
+		UserID:      local.UserID,
+		LMSSchoolID: local.LMSSchoolID,
+		Email:       user.Email,
+		FullName:    user.Nama,
+		RoleName:    roleName,
+		Type:        local.Type,
+		// Lab tokens only carry this claim
+		ExamSessionToken: local.ExamSessionToken,
+	}
+	return claims, user, local.SessionID, nil
+}
+
+// checkLabScope keeps a lab token to TestSessionService calls on the session it was issued for
+func checkLabScope(method string, req interface{}, sessionToken string) error {
+	if !strings.HasPrefix(method, "/base.TestSessionService/") {
+		return status.Error(codes.PermissionDenied, "lab login can only take its test session")
+	}
+	r, ok := req.(interface{ GetSessionToken() string })
+	if !ok || r.GetSessionToken() != sessionToken {
+		return status.Error(codes.PermissionDenied, "lab login can only take its test session")
+	}
+	return nil
+}
+
 // shouldSkipAuth determines if authentication should be skipped for the method
 func (m *JWTMiddleware) shouldSkipAuth(method string) bool {
 	skipMethods := []string{
 		"/base.Base/HealthCheck",
+		"/base.AuthService/Login",
+		"/base.AuthService/RefreshToken",
+		"/base.LabLoginService/LabLogin",
 	}
 
 	for _, skip := range skipMethods {
@@ -106,7 +260,6 @@ func ExtractTokenFromContext(ctx context.Context) (string, error) {
 	return "", errors.New("missing authorization header")
 }
 
-
 func tokenFromAuthorizationMetadata(md metadata.MD) string {
 	for _, key := range []string{"authorization", "grpcgateway-authorization", "x-authorization", "x-access-token"} {
 		values := md.Get(key)
@@ -175,14 +328,7 @@ func (m *JWTMiddleware) resolveUserFromClaims(ctx context.Context, claims *JWTCl
 		name = "LMS User"
 	}
 
-	roleCode := int32(base.UserRole_SISWA)
-	switch normalizeRoleName(claims.RoleName) {
-	case "superadmin":
-		roleCode = int32(base.UserRole_ADMIN)
-	case "teacher":
-		roleCode = 3
-	}
-
+	roleCode := int32(ProtoRole(claims.RoleName))
 	user, syncErr := m.authRepo.FindOrCreateByLMSID(ctx, lmsUserID, claims.Email, name, roleCode)
 	if syncErr != nil {
 		return nil, fmt.Errorf("failed to provision local user from token: %w", syncErr)
@@ -193,59 +339,98 @@ func (m *JWTMiddleware) resolveUserFromClaims(ctx context.Context, claims *JWTCl
 	return user, nil
 }
 
+// resolveTenant scopes the request to the school in the token. Superadmins work across
+// schools unless the x-school-id header narrows them to one; school admins are superadmins
+// for role checks but stay inside their own school.
+func resolveTenant(ctx context.Context, claims *JWTClaims, roleName string) tenant.Tenant {
+	if roleName != RoleSuperadmin || strings.EqualFold(strings.TrimSpace(claims.RoleName), "school_admin") {
+		return tenant.Tenant{SchoolID: claims.LMSSchoolID}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("x-school-id") {
+			if schoolID, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil && schoolID > 0 {
+				return tenant.Tenant{SchoolID: schoolID}
+			}
+		}
+	}
+	return tenant.Tenant{CrossTenant: true}
+}
+
 func normalizeRoleName(role string) string {
 	switch strings.ToLower(strings.TrimSpace(role)) {
 	case "admin", "superadmin", "school_admin":
-		return "superadmin"
+		return RoleSuperadmin
 	case "teacher", "guru":
-		return "teacher"
+		return RoleTeacher
 	case "student", "siswa":
-		return "student"
+		return RoleStudent
 	default:
-		return "student"
+		return RoleStudent
 	}
 }
 
-func (m *JWTMiddleware) validateLMSToken(tokenString string) (*JWTClaims, error) {
-	secrets := make([]string, 0, 4)
-	seen := map[string]struct{}{}
-	appendSecret := func(secret string) {
-		secret = strings.TrimSpace(secret)
-		if secret == "" {
-			return
-		}
-		if _, ok := seen[secret]; ok {
-			return
-		}
-		seen[secret] = struct{}{}
-		secrets = append(secrets, secret)
+// ProtoRole maps a role name (LMS claim or stored user role) to the proto role;
+// superadmins keep the ADMIN value clients already compare against
+func ProtoRole(roleName string) base.UserRole {
+	switch normalizeRoleName(roleName) {
+	case RoleSuperadmin:
+		return base.UserRole_ADMIN
+	case RoleTeacher:
+		return base.UserRole_TEACHER
+	default:
+		return base.UserRole_SISWA
 	}
+}
 
-	appendSecret(m.config.JWT.LMSTokenSecret)
-	appendSecret(os.Getenv("LMS_JWT_SECRET"))
-	appendSecret(os.Getenv("JWT_ACCESS_SECRET"))
-	appendSecret(m.config.JWT.Secret)
-	if len(secrets) == 0 {
+// RoleNameFromProto maps a proto role back to its role name
+func RoleNameFromProto(role base.UserRole) string {
+	switch role {
+	case base.UserRole_ADMIN, base.UserRole_SUPERADMIN:
+		return RoleSuperadmin
+	case base.UserRole_TEACHER:
+		return RoleTeacher
+	default:
+		return RoleStudent
+	}
+}
+
+func (m *JWTMiddleware) validateLMSToken(ctx context.Context, tokenString string) (*JWTClaims, error) {
+	secrets := m.hmacSecrets()
+	methods := make([]string, 0, len(jwks.Algorithms)+3)
+	if m.keySet != nil {
+		methods = append(methods, jwks.Algorithms...)
+	}
+	if len(secrets) > 0 {
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if len(methods) == 0 {
 		return nil, errors.New("missing JWT secret configuration")
 	}
 
-	var token *jwt.Token
-	var err error
-	for _, secret := range secrets {
-		token, err = jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, errors.New("unexpected signing method")
+	options := []jwt.ParserOption{jwt.WithValidMethods(methods)}
+	if m.config.JWT.LMSIssuer != "" {
+		options = append(options, jwt.WithIssuer(m.config.JWT.LMSIssuer))
+	}
+	if m.config.JWT.LMSAudience != "" {
+		options = append(options, jwt.WithAudience(m.config.JWT.LMSAudience))
+	}
+
+	token, err := jwt.NewParser(options...).Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			keys := make([]jwt.VerificationKey, 0, len(secrets))
+			for _, secret := range secrets {
+				keys = append(keys, []byte(secret))
 			}
-			return []byte(secret), nil
-		})
-		if err == nil && token != nil && token.Valid {
-			break
+			return jwt.VerificationKeySet{Keys: keys}, nil
 		}
+		// WithValidMethods only lets asymmetric methods through when the key set exists
+		kid, _ := token.Header["kid"].(string)
+		return m.keySet.Key(ctx, kid, token.Method.Alg())
+	})
+	if err != nil {
+		return nil, err
 	}
 	if token == nil || !token.Valid {
-		if err != nil {
-			return nil, err
-		}
 		return nil, errors.New("invalid token")
 	}
 
@@ -273,6 +458,14 @@ func (m *JWTMiddleware) validateLMSToken(tokenString string) (*JWTClaims, error)
 			}
 		}
 	}
+	if schoolIDRaw, has := mapClaims["lms_school_id"]; has {
+		switch typed := schoolIDRaw.(type) {
+		case float64:
+			claims.LMSSchoolID = int64(typed)
+		case int64:
+			claims.LMSSchoolID = typed
+		}
+	}
 	if email, has := mapClaims["email"].(string); has {
 		claims.Email = email
 	}
@@ -314,24 +507,46 @@ func (m *JWTMiddleware) validateLMSToken(tokenString string) (*JWTClaims, error)
 		return nil, errors.New("invalid token type")
 	}
 
-	if m.config.JWT.LMSIssuer != "" && claims.Issuer != m.config.JWT.LMSIssuer {
-		return nil, errors.New("invalid token issuer")
+	return claims, nil
+}
+
+// signsLocalTokens reports whether the service signs its own tokens with JWT_SECRET
+func signsLocalTokens(config *config.Main) bool {
+	return config.JWT.LocalAuthEnabled() || config.JWT.LabLoginEnabled
+}
+
+// hmacSecrets lists the shared secrets LMS tokens may be signed with, or none once
+// HMAC is switched off in favour of the JWKS. While JWT_SECRET signs local and lab tokens
+// it is never one of them, or a local token could pass as an LMS identity.
+func (m *JWTMiddleware) hmacSecrets() []string {
+	if !m.config.JWT.LMSAllowHMAC {
+		return nil
 	}
 
-	if m.config.JWT.LMSAudience != "" {
-		audOk := false
-		for _, aud := range claims.Audience {
-			if aud == m.config.JWT.LMSAudience {
-				audOk = true
-				break
-			}
+	localSecret := ""
+	if signsLocalTokens(m.config) {
+		localSecret = strings.TrimSpace(m.config.JWT.Secret)
+	}
+
+	secrets := make([]string, 0, 4)
+	seen := map[string]struct{}{}
+	appendSecret := func(secret string) {
+		secret = strings.TrimSpace(secret)
+		if secret == "" || secret == localSecret {
+			return
 		}
-		if !audOk {
-			return nil, errors.New("invalid token audience")
+		if _, ok := seen[secret]; ok {
+			return
 		}
+		seen[secret] = struct{}{}
+		secrets = append(secrets, secret)
 	}
 
-	return claims, nil
+	appendSecret(m.config.JWT.LMSTokenSecret)
+	appendSecret(os.Getenv("LMS_JWT_SECRET"))
+	appendSecret(os.Getenv("JWT_ACCESS_SECRET"))
+	appendSecret(m.config.JWT.Secret)
+	return secrets
 }
 
 // AddUserToContext adds user information to the context
@@ -355,7 +570,13 @@ func GetUserFromContext(ctx context.Context) (*base.User, error) {
 func GetRoleNameFromContext(ctx context.Context) string {
 	roleName, ok := ctx.Value("role_name").(string)
 	if !ok {
-		return "student"
+		return RoleStudent
 	}
 	return normalizeRoleName(roleName)
 }
+
+// GetAuthSessionIDFromContext returns the local login session of the call, if any
+func GetAuthSessionIDFromContext(ctx context.Context) (int64, bool) {
+	sessionID, ok := ctx.Value("auth_session_id").(int64)
+	return sessionID, ok && sessionID != 0
+}
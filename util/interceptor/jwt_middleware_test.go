@@ -0,0 +1,87 @@
+package interceptor_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/util/interceptor"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJWTMiddleware_RefusesPlaceholderSecretForLocalLogin(t *testing.T) {
+	for _, secret := range []string{"", "change-this-in-production", "your-super-secret-jwt-key-change-this-in-production"} {
+		var cfg config.Main
+		cfg.JWT.AuthMode = config.AuthModeLocal
+		cfg.JWT.Secret = secret
+
+		_, err := interceptor.NewJWTMiddleware(&cfg, nil, nil)
+		assert.Error(t, err, "secret %q", secret)
+	}
+
+	var cfg config.Main
+	cfg.JWT.AuthMode = config.AuthModeLMS
+	cfg.JWT.LabLoginEnabled = true
+	_, err := interceptor.NewJWTMiddleware(&cfg, nil, nil)
+	assert.Error(t, err, "lab login signs tokens with JWT_SECRET too")
+}
+
+func TestNewJWTMiddleware_AcceptsRandomSecret(t *testing.T) {
+	var cfg config.Main
+	cfg.JWT.AuthMode = config.AuthModeBoth
+	cfg.JWT.Secret = "9f2c4e1a7b3d8f6e0a5c2b9d4e7f1a3c"
+
+	_, err := interceptor.NewJWTMiddleware(&cfg, nil, nil)
+	assert.NoError(t, err)
+
+	cfg = config.Main{}
+	cfg.JWT.AuthMode = config.AuthModeLMS
+	_, err = interceptor.NewJWTMiddleware(&cfg, nil, nil)
+	assert.NoError(t, err, "LMS-only mode does not sign tokens")
+}
+
+func TestNewJWTMiddleware_RequiresSeparateLMSSecretForLocalLogin(t *testing.T) {
+	const localSecret = "9f2c4e1a7b3d8f6e0a5c2b9d4e7f1a3c"
+	for _, lmsSecret := range []string{"", localSecret, " " + localSecret} {
+		var cfg config.Main
+		cfg.JWT.AuthMode = config.AuthModeBoth
+		cfg.JWT.Secret = localSecret
+		cfg.JWT.LMSAllowHMAC = true
+		cfg.JWT.LMSTokenSecret = lmsSecret
+
+		_, err := interceptor.NewJWTMiddleware(&cfg, nil, nil)
+		assert.Error(t, err, "LMS secret %q", lmsSecret)
+	}
+
+	var cfg config.Main
+	cfg.JWT.AuthMode = config.AuthModeBoth
+	cfg.JWT.Secret = localSecret
+	cfg.JWT.LMSAllowHMAC = true
+	cfg.JWT.LMSTokenSecret = "4b8e2d6f1a9c3e7b5d0f2a8c6e4b1d9f"
+	_, err := interceptor.NewJWTMiddleware(&cfg, nil, nil)
+	assert.NoError(t, err)
+}
+
+func TestHMACSecrets_LeavesOutTheLocalSecret(t *testing.T) {
+	t.Setenv("LMS_JWT_SECRET", "")
+	t.Setenv("JWT_ACCESS_SECRET", "")
+
+	var cfg config.Main
+	cfg.JWT.AuthMode = config.AuthModeLMS
+	cfg.JWT.Secret = "9f2c4e1a7b3d8f6e0a5c2b9d4e7f1a3c"
+	cfg.JWT.LMSAllowHMAC = true
+	cfg.JWT.LMSTokenSecret = "4b8e2d6f1a9c3e7b5d0f2a8c6e4b1d9f"
+
+	m, err := interceptor.NewJWTMiddleware(&cfg, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{cfg.JWT.LMSTokenSecret, cfg.JWT.Secret}, interceptor.HMACSecrets(m), "LMS-only mode signs nothing with JWT_SECRET")
+
+	cfg.JWT.LabLoginEnabled = true
+	m, err = interceptor.NewJWTMiddleware(&cfg, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{cfg.JWT.LMSTokenSecret}, interceptor.HMACSecrets(m))
+
+	t.Setenv("JWT_ACCESS_SECRET", cfg.JWT.Secret)
+	assert.Equal(t, []string{cfg.JWT.LMSTokenSecret}, interceptor.HMACSecrets(m))
+}
@@ -0,0 +1,184 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"strings"
+
+	"cbt-test-mini-project/init/config"
+	"cbt-test-mini-project/internal/entity"
+	examSecurityRepo "cbt-test-mini-project/internal/repository/exam_security"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// testSessionWriteMethods are the calls restricted by the network allow-lists
+var testSessionWriteMethods = map[string]bool{
+	"/base.TestSessionService/StartScheduledSession": true,
+	"/base.TestSessionService/SubmitAnswer":          true,
+	"/base.TestSessionService/SubmitComplexAnswer":   true,
+	"/base.TestSessionService/SubmitShortAnswer":     true,
+	"/base.TestSessionService/SubmitNumericAnswer":   true,
+	"/base.TestSessionService/SubmitHotspotAnswer":   true,
+	"/base.TestSessionService/SubmitGridAnswer":      true,
+	"/base.TestSessionService/RecordMediaPlay":       true,
+	"/base.TestSessionService/SubmitDragDropAnswer":  true,
+	"/base.TestSessionService/SubmitEssayAnswer":     true,
+	"/base.TestSessionService/ClearAnswer":           true,
+	"/base.TestSessionService/CompleteSession":       true,
+}
+
+// NetworkAccessMiddleware resolves the client IP and restricts TestSessionService writes
+// to the networks allow-listed for the session's school or assignment
+type NetworkAccessMiddleware struct {
+	repo           examSecurityRepo.ExamSecurityRepository
+	trustedProxies TrustedProxies
+}
+
+// NewNetworkAccessMiddleware creates a new network access middleware
+func NewNetworkAccessMiddleware(cfg *config.Main, repo examSecurityRepo.ExamSecurityRepository) *NetworkAccessMiddleware {
+	return &NetworkAccessMiddleware{repo: repo, trustedProxies: ParseTrustedProxies(cfg.Network.TrustedProxies)}
+}
+
+// TrustedProxies are the networks whose forwarded headers are believed
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses comma separated CIDRs (NETWORK_TRUSTED_PROXIES), skipping
+// invalid ones with a warning
+func ParseTrustedProxies(raw string) TrustedProxies {
+	var proxies TrustedProxies
+	for _, cidr := range strings.Split(raw, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if _, network, err := net.ParseCIDR(cidr); err == nil {
+			proxies = append(proxies, network)
+		} else {
+			slog.Warn("Ignoring invalid trusted proxy CIDR", "cidr", cidr)
+		}
+	}
+	return proxies
+}
+
+// Contains reports whether ip belongs to a trusted proxy
+func (t TrustedProxies) Contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range t {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor for network access control
+func (m *NetworkAccessMiddleware) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		clientIP := m.resolveClientIP(ctx)
+		ctx = AddClientIPToContext(ctx, clientIP)
+
+		if !testSessionWriteMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		tokenReq, ok := req.(interface{ GetSessionToken() string })
+		if !ok || strings.TrimSpace(tokenReq.GetSessionToken()) == "" {
+			return handler(ctx, req)
+		}
+
+		policy, err := m.repo.GetNetworkPolicyBySessionToken(ctx, strings.TrimSpace(tokenReq.GetSessionToken()))
+		if err != nil {
+			slog.Error("Network policy lookup failed", "error", err, "method", info.FullMethod)
+			return nil, status.Error(codes.Internal, "failed to check network access")
+		}
+		if policy == nil || policy.OverrideActive {
+			return handler(ctx, req)
+		}
+
+		cidrs := policy.EffectiveCIDRs()
+		if len(cidrs) == 0 || ipInCIDRs(clientIP, cidrs) {
+			return handler(ctx, req)
+		}
+
+		sessionID := policy.SessionID
+		denial := &entity.NetworkAccessDenial{
+			IDTestSession:   &sessionID,
+			UserID:          policy.UserID,
+			LMSSchoolID:     policy.LMSSchoolID,
+			LMSAssignmentID: policy.LMSAssignmentID,
+			Method:          info.FullMethod,
+			ClientIP:        clientIP,
+		}
+		if err := m.repo.RecordNetworkDenial(ctx, denial); err != nil {
+			slog.Error("Failed to record network access denial", "error", err, "session_id", sessionID)
+		}
+
+		return nil, status.Error(codes.PermissionDenied, "this exam can only be taken from an approved school network")
+	}
+}
+
+// resolveClientIP returns the peer address, or the right-most untrusted X-Forwarded-For
+// entry when the peer is a trusted proxy (such as the local REST gateway)
+func (m *NetworkAccessMiddleware) resolveClientIP(ctx context.Context) string {
+	peerIP := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(peerIP); err == nil {
+			peerIP = host
+		}
+	}
+	if peerIP != "" && !m.trustedProxies.Contains(peerIP) {
+		return peerIP
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get("x-forwarded-for") {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		if !m.trustedProxies.Contains(hops[i]) {
+			return hops[i]
+		}
+	}
+	if len(hops) > 0 {
+		return hops[0]
+	}
+	return peerIP
+}
+
+func ipInCIDRs(ip string, cidrs []string) bool {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddClientIPToContext stores the resolved client IP in the context
+func AddClientIPToContext(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, "client_ip", clientIP)
+}
@@ -0,0 +1,20 @@
+package interceptor_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/util/interceptor"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrustedProxies_Contains(t *testing.T) {
+	proxies := interceptor.ParseTrustedProxies(" 10.0.0.0/8, not-a-cidr,,::1/128")
+	assert.Len(t, proxies, 2)
+
+	assert.True(t, proxies.Contains("10.1.2.3"))
+	assert.True(t, proxies.Contains("::1"))
+	assert.False(t, proxies.Contains("192.168.1.1"))
+	assert.False(t, proxies.Contains("10.1.2.3:8080"), "addresses are passed without a port")
+	assert.False(t, interceptor.ParseTrustedProxies("").Contains("127.0.0.1"))
+}
@@ -4,59 +4,89 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/internal/repository"
+	"cbt-test-mini-project/util/ratelimit"
+	"cbt-test-mini-project/util/tenant"
+
 	"go.elastic.co/apm"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+)
 
-	base "cbt-test-mini-project/gen/proto"
-	"cbt-test-mini-project/internal/entity"
-	"cbt-test-mini-project/internal/repository"
+const (
+	// storeTimeout bounds a Redis round trip so a slow Redis cannot stall requests
+	storeTimeout = 200 * time.Millisecond
+	// storeRetryDelay is how long requests count in the database after a Redis error
+	storeRetryDelay = 10 * time.Second
+	// policyTTL is how long policy edits take to reach every replica
+	policyTTL = 30 * time.Second
+	// examTTL is how long an "ongoing exam" lookup is trusted
+	examTTL = 30 * time.Second
 )
 
+// MethodCosts weighs expensive methods against the request limits; other methods cost 1
+var MethodCosts = map[string]int{
+	base.SoalService_UploadImageToSoal_FullMethodName:           5,
+	base.SoalService_UploadMediaToSoal_FullMethodName:           10,
+	base.ExamSecurityService_UploadSebConfig_FullMethodName:     5,
+	base.ExamSecurityService_AnalyzeCollusion_FullMethodName:    20,
+	base.GradingService_RunEssaySimilarityCheck_FullMethodName:  20,
+	base.GradingService_GenerateScoreSuggestions_FullMethodName: 10,
+}
+
 // cachedLimit stores rate limit data with expiration time
 type cachedLimit struct {
 	limit    *entity.UserLimit
 	cachedAt time.Time
 }
 
-// RateLimitMiddleware implements rate limiting per user with in-memory caching
+// cachedPolicies is a policy snapshot with its load time
+type cachedPolicies struct {
+	policies *ratelimit.Policies
+	loadedAt time.Time
+}
+
+// cachedExam remembers whether a user was in an exam
+type cachedExam struct {
+	ongoing   bool
+	checkedAt time.Time
+}
+
+// RateLimitMiddleware implements rate limiting per user. The policy of a call comes from
+// rate_limit_policies by the caller's role, school and the method's group. Counters live in
+// Redis when a store is given, so every replica enforces the same limit, and in user_limits
+// without Redis or while it is unreachable.
 type RateLimitMiddleware struct {
 	userLimitRepo repository.UserLimitRepository
+	policyRepo    repository.RateLimitPolicyRepository
+	store         ratelimit.Store
 	cache         sync.Map // Key: "user_id:limit_type", Value: *cachedLimit
 	cacheTTL      time.Duration
-	usageBuffer   sync.Map // Key: "user_id:limit_type", Value: *int64 (atomic counter)
+	exams         sync.Map // Key: user_id, Value: *cachedExam
+	policies      atomic.Pointer[cachedPolicies]
+	policyMu      sync.Mutex
+	// storeDownUntil (unix nanoseconds) skips Redis for a while after it failed
+	storeDownUntil atomic.Int64
 }
 
-// NewRateLimitMiddleware creates a new rate limit middleware with caching
-func NewRateLimitMiddleware(userLimitRepo repository.UserLimitRepository) *RateLimitMiddleware {
-	m := &RateLimitMiddleware{
+// NewRateLimitMiddleware creates a new rate limit middleware; policyRepo and store may be nil
+func NewRateLimitMiddleware(userLimitRepo repository.UserLimitRepository, policyRepo repository.RateLimitPolicyRepository, store ratelimit.Store) *RateLimitMiddleware {
+	return &RateLimitMiddleware{
 		userLimitRepo: userLimitRepo,
-		cacheTTL:      1 * time.Minute, // Cache rate limits for 1 minute
-	}
-	
-	// Start background goroutine to flush usage buffer periodically
-	go m.flushUsageBuffer()
-	
-	return m
-}
-
-// flushUsageBuffer periodically flushes buffered usage counts to database
-func (m *RateLimitMiddleware) flushUsageBuffer() {
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
-	
-	for range ticker.C {
-		m.usageBuffer.Range(func(key, value interface{}) bool {
-			// Just clear the buffer for now - actual usage is tracked in cache
-			m.usageBuffer.Delete(key)
-			return true
-		})
+		policyRepo:    policyRepo,
+		store:         store,
+		cacheTTL:      1 * time.Minute, // Cache limit values for 1 minute
 	}
 }
 
@@ -67,18 +97,9 @@ func getCacheKey(userID int, limitType string) string {
 
 // UnaryServerInterceptor implements rate limiting for unary gRPC calls
 func (m *RateLimitMiddleware) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// Skip rate limiting for critical test operations to allow reloads
-	exemptMethods := []string{
-		"/base.TestSessionService/CreateTestSession", // Handler manages limit increment
-		"/base.TestSessionService/GetTestQuestions",
-		"/base.TestSessionService/GetTestSession",
-		"/base.TestSessionService/SubmitAnswer",
-	}
-	
-	for _, method := range exemptMethods {
-		if info.FullMethod == method {
-			return handler(ctx, req)
-		}
+	// The handler counts test sessions against test_sessions_per_day itself
+	if info.FullMethod == base.TestSessionService_CreateTestSession_FullMethodName {
+		return handler(ctx, req)
 	}
 
 	// Extract user from context (set by JWT middleware)
@@ -88,30 +109,28 @@ func (m *RateLimitMiddleware) UnaryServerInterceptor(ctx context.Context, req in
 		return handler(ctx, req)
 	}
 
+	// Service API keys have no user limits
+	if _, isAPIKey := APIKeyFromContext(ctx); isAPIKey {
+		return handler(ctx, req)
+	}
+
 	userID := int(user.Id)
+	t, _ := tenant.FromContext(ctx)
+	policy := m.getPolicies(ctx).Resolve(info.FullMethod, GetRoleNameFromContext(ctx), t.SchoolID)
+	if policy.ExamExempt && m.inExam(ctx, userID) {
+		return handler(ctx, req)
+	}
 
-	// Check rate limit with caching
-	allowed, remaining, resetTime, err := m.checkRateLimitCached(ctx, userID, info.FullMethod)
+	result, err := m.checkRateLimit(ctx, userID, policy, methodCost(info.FullMethod))
 	if err != nil {
 		slog.Error("Rate limit check failed", "error", err, "user_id", userID)
 		// Allow request on error to avoid blocking users
 		return handler(ctx, req)
 	}
 
-	if !allowed {
-		// Return rate limit exceeded error
-		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("Rate limit exceeded. Try again in %d seconds", int(time.Until(resetTime).Seconds())))
-	}
-
-	// Add rate limit headers to response metadata
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		newMD := metadata.MD{}
-		for k, v := range md {
-			newMD[k] = v
-		}
-		newMD["x-ratelimit-remaining"] = []string{fmt.Sprintf("%d", remaining)}
-		newMD["x-ratelimit-reset"] = []string{fmt.Sprintf("%d", resetTime.Unix())}
-		ctx = metadata.NewIncomingContext(ctx, newMD)
+	setRateLimitHeaders(ctx, result)
+	if !result.Allowed {
+		return nil, status.Error(codes.ResourceExhausted, fmt.Sprintf("Rate limit exceeded. Try again in %d seconds", secondsUntil(result.ResetAt)))
 	}
 
 	// Record usage asynchronously only for important endpoints
@@ -122,89 +141,149 @@ func (m *RateLimitMiddleware) UnaryServerInterceptor(ctx context.Context, req in
 	return handler(ctx, req)
 }
 
-// checkRateLimitCached checks rate limit using cache-first approach
-func (m *RateLimitMiddleware) checkRateLimitCached(ctx context.Context, userID int, method string) (allowed bool, remaining int, resetTime time.Time, err error) {
-	span, _ := apm.StartSpan(ctx, "rate_limit_check_cached", "middleware")
-	defer func() {
-		if span != nil {
-			span.End()
+// checkRateLimit counts cost against the policy's bucket in Redis, or in the database when
+// there is no Redis or it just failed
+func (m *RateLimitMiddleware) checkRateLimit(ctx context.Context, userID int, policy entity.RateLimitPolicy, cost int) (ratelimit.Result, error) {
+	span, ctx := apm.StartSpan(ctx, "rate_limit_check", "middleware")
+	if span != nil {
+		defer span.End()
+	}
+
+	limitType := policy.Bucket()
+	limit, err := m.getLimit(ctx, userID, limitType)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+	limitValue := ratelimit.Limit(policy, limit)
+
+	if m.store != nil && time.Now().UnixNano() >= m.storeDownUntil.Load() {
+		storeCtx, cancel := context.WithTimeout(ctx, storeTimeout)
+		result, err := m.store.Allow(storeCtx, ratelimit.UserKey(userID, limitType), limitValue, cost, ratelimit.Window(limitType))
+		cancel()
+		if err == nil {
+			return result, nil
 		}
-	}()
+		m.storeDownUntil.Store(time.Now().Add(storeRetryDelay).UnixNano())
+		slog.Warn("Rate limit store unavailable, counting in the database", "error", err)
+	}
 
-	// Determine limit type based on method
-	limitType := m.getLimitTypeForMethod(method)
-	cacheKey := getCacheKey(userID, limitType)
+	updated, err := m.userLimitRepo.ConsumeLimit(ctx, userID, limitType, cost, policy)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+	if updated == nil {
+		resetAt := limit.ResetAt
+		if !resetAt.After(time.Now()) {
+			resetAt = time.Now().Add(ratelimit.Window(limitType))
+		}
+		return ratelimit.Result{Allowed: false, Limit: limitValue, ResetAt: resetAt}, nil
+	}
+	m.cache.Store(getCacheKey(userID, limitType), &cachedLimit{limit: updated, cachedAt: time.Now()})
+	limitValue = ratelimit.Limit(policy, updated)
+	return ratelimit.Result{
+		Allowed:   true,
+		Limit:     limitValue,
+		Remaining: limitValue - updated.CurrentUsed,
+		ResetAt:   updated.ResetAt,
+	}, nil
+}
 
-	// Try to get from cache first
-	if cached, ok := m.cache.Load(cacheKey); ok {
-		cl := cached.(*cachedLimit)
-		
-		// Check if cache is still valid
-		if time.Since(cl.cachedAt) < m.cacheTTL {
-			now := time.Now()
-			
-			// Check if limit needs to be reset
-			if now.After(cl.limit.ResetAt) {
-				cl.limit.CurrentUsed = 0
-				cl.limit.ResetAt = m.getNextResetTime(limitType)
-				cl.cachedAt = now
-			}
-			
-			// Check if limit exceeded
-			if cl.limit.CurrentUsed >= cl.limit.LimitValue {
-				return false, 0, cl.limit.ResetAt, nil
+// getPolicies returns the policy snapshot, reloading it every policyTTL. A failed reload
+// keeps the previous snapshot.
+func (m *RateLimitMiddleware) getPolicies(ctx context.Context) *ratelimit.Policies {
+	cached := m.policies.Load()
+	if cached != nil && time.Since(cached.loadedAt) < policyTTL {
+		return cached.policies
+	}
+
+	m.policyMu.Lock()
+	defer m.policyMu.Unlock()
+	if latest := m.policies.Load(); latest != cached {
+		return latest.policies
+	}
+
+	set := &entity.RateLimitPolicySet{}
+	if m.policyRepo != nil {
+		loaded, err := m.policyRepo.LoadPolicySet(ctx)
+		if err != nil {
+			slog.Error("Failed to load rate limit policies", "error", err)
+			if cached != nil {
+				m.policies.Store(&cachedPolicies{policies: cached.policies, loadedAt: time.Now()})
+				return cached.policies
 			}
-			
-			// Increment in memory (will be synced to DB periodically)
-			cl.limit.CurrentUsed++
-			cl.limit.UpdatedAt = now
-			
-			remaining = cl.limit.LimitValue - cl.limit.CurrentUsed
-			return true, remaining, cl.limit.ResetAt, nil
+		} else {
+			set = loaded
 		}
 	}
+	policies := ratelimit.NewPolicies(*set)
+	m.policies.Store(&cachedPolicies{policies: policies, loadedAt: time.Now()})
+	return policies
+}
 
-	// Cache miss or expired - fetch from database
-	userLimit, err := m.userLimitRepo.GetOrCreateLimit(ctx, userID, limitType)
+// inExam reports whether the user has an ongoing test session, cached for examTTL
+func (m *RateLimitMiddleware) inExam(ctx context.Context, userID int) bool {
+	if cached, ok := m.exams.Load(userID); ok {
+		ce := cached.(*cachedExam)
+		if time.Since(ce.checkedAt) < examTTL {
+			return ce.ongoing
+		}
+	}
+	ongoing, err := m.userLimitRepo.HasOngoingTestSession(ctx, userID)
 	if err != nil {
-		return false, 0, time.Now(), err
+		slog.Error("Failed to check ongoing exam", "error", err, "user_id", userID)
+		return false
 	}
+	m.exams.Store(userID, &cachedExam{ongoing: ongoing, checkedAt: time.Now()})
+	return ongoing
+}
 
-	// Check if limit needs to be reset
-	now := time.Now()
-	if now.After(userLimit.ResetAt) {
-		// Reset the counter
-		userLimit.CurrentUsed = 0
-		userLimit.ResetAt = m.getNextResetTime(limitType)
-		if err := m.userLimitRepo.UpdateLimit(ctx, userLimit); err != nil {
-			return false, 0, time.Now(), err
+// getLimit returns the user's limit row, cached for cacheTTL
+func (m *RateLimitMiddleware) getLimit(ctx context.Context, userID int, limitType string) (*entity.UserLimit, error) {
+	cacheKey := getCacheKey(userID, limitType)
+	if cached, ok := m.cache.Load(cacheKey); ok {
+		cl := cached.(*cachedLimit)
+		if time.Since(cl.cachedAt) < m.cacheTTL {
+			return cl.limit, nil
 		}
 	}
 
-	// Check if limit exceeded
-	if userLimit.CurrentUsed >= userLimit.LimitValue {
-		// Update cache even on exceeded to avoid repeated DB hits
-		m.cache.Store(cacheKey, &cachedLimit{
-			limit:    userLimit,
-			cachedAt: now,
-		})
-		return false, 0, userLimit.ResetAt, nil
+	limit, err := m.userLimitRepo.GetOrCreateLimit(ctx, userID, limitType)
+	if err != nil {
+		return nil, err
 	}
+	m.cache.Store(cacheKey, &cachedLimit{limit: limit, cachedAt: time.Now()})
+	return limit, nil
+}
 
-	// Increment usage
-	userLimit.CurrentUsed++
-	if err := m.userLimitRepo.UpdateLimit(ctx, userLimit); err != nil {
-		return false, 0, time.Now(), err
+// setRateLimitHeaders reports the limit as RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset (seconds), plus Retry-After when denied. The gateway forwards them.
+func setRateLimitHeaders(ctx context.Context, result ratelimit.Result) {
+	reset := strconv.Itoa(secondsUntil(result.ResetAt))
+	md := metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(result.Limit),
+		"ratelimit-remaining", strconv.Itoa(result.Remaining),
+		"ratelimit-reset", reset,
+	)
+	if !result.Allowed {
+		md.Set("retry-after", reset)
 	}
+	// Fails only outside a real gRPC call, e.g. in tests
+	_ = grpc.SetHeader(ctx, md)
+}
 
-	// Store in cache
-	m.cache.Store(cacheKey, &cachedLimit{
-		limit:    userLimit,
-		cachedAt: now,
-	})
+func secondsUntil(t time.Time) int {
+	seconds := int(math.Ceil(time.Until(t).Seconds()))
+	if seconds < 0 {
+		return 0
+	}
+	return seconds
+}
 
-	remaining = userLimit.LimitValue - userLimit.CurrentUsed
-	return true, remaining, userLimit.ResetAt, nil
+func methodCost(method string) int {
+	if cost, ok := MethodCosts[method]; ok {
+		return cost
+	}
+	return 1
 }
 
 // shouldRecordUsage determines if usage should be recorded for analytics
@@ -220,7 +299,7 @@ func (m *RateLimitMiddleware) shouldRecordUsage(method string) bool {
 		"/base.SoalService/GetSoal",
 		"/base.SoalService/ListSoal",
 	}
-	
+
 	for _, pattern := range skipPatterns {
 		if strings.Contains(method, pattern) || method == pattern {
 			return false
@@ -229,58 +308,6 @@ func (m *RateLimitMiddleware) shouldRecordUsage(method string) bool {
 	return true
 }
 
-// getLimitTypeForMethod determines the limit type based on gRPC method
-func (m *RateLimitMiddleware) getLimitTypeForMethod(method string) string {
-	// Extract service and method name
-	parts := strings.Split(method, "/")
-	if len(parts) < 3 {
-		return entity.LimitTypeAPIRequestsPerHour
-	}
-
-	service := parts[1]
-	methodName := parts[2]
-
-	// Define limits based on service and method
-	switch service {
-	case "base.TestSessionService":
-		switch methodName {
-		case "CreateTestSession":
-			return entity.LimitTypeTestSessionsPerDay
-		default:
-			return entity.LimitTypeAPIRequestsPerHour
-		}
-	case "base.SoalService":
-		switch methodName {
-		case "CreateSoal":
-			return entity.LimitTypeQuestionsPerDay
-		default:
-			return entity.LimitTypeAPIRequestsPerHour
-		}
-	default:
-		return entity.LimitTypeAPIRequestsPerHour
-	}
-}
-
-// getNextResetTime calculates the next reset time based on limit type
-func (m *RateLimitMiddleware) getNextResetTime(limitType string) time.Time {
-	now := time.Now()
-	switch limitType {
-	case entity.LimitTypeAPIRequestsPerHour:
-		return now.Add(time.Hour)
-	case entity.LimitTypeAPIRequestsPerDay, entity.LimitTypeTestSessionsPerDay, entity.LimitTypeQuestionsPerDay:
-		return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
-	case entity.LimitTypeTestSessionsPerWeek:
-		// Reset every Monday
-		daysUntilMonday := (8 - int(now.Weekday())) % 7
-		if daysUntilMonday == 0 {
-			daysUntilMonday = 7
-		}
-		return time.Date(now.Year(), now.Month(), now.Day()+daysUntilMonday, 0, 0, 0, 0, now.Location())
-	default:
-		return now.Add(time.Hour)
-	}
-}
-
 // recordUsage records the usage for analytics (now async)
 func (m *RateLimitMiddleware) recordUsage(ctx context.Context, userID int, action, _ string, resourceID *int) {
 	usage := &entity.UserLimitUsage{
@@ -293,4 +320,4 @@ func (m *RateLimitMiddleware) recordUsage(ctx context.Context, userID int, actio
 	if err := m.userLimitRepo.RecordUsage(ctx, usage); err != nil {
 		slog.Error("Failed to record usage", "error", err, "user_id", userID, "action", action)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,203 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/ratelimit"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// --- Fakes ---
+
+type fakeUserLimitRepo struct {
+	mu       sync.Mutex
+	limit    int
+	used     int
+	consumed []int
+	inExam   bool
+}
+
+func (f *fakeUserLimitRepo) GetOrCreateLimit(ctx context.Context, userID int, limitType string) (*entity.UserLimit, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &entity.UserLimit{UserID: userID, LimitType: limitType, LimitValue: f.limit, CurrentUsed: f.used, ResetAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (f *fakeUserLimitRepo) UpdateLimit(ctx context.Context, limit *entity.UserLimit) error {
+	return nil
+}
+
+func (f *fakeUserLimitRepo) IncrementUsageAtomic(ctx context.Context, userID int, limitType string, resourceID *int) error {
+	return nil
+}
+
+func (f *fakeUserLimitRepo) ConsumeLimit(ctx context.Context, userID int, limitType string, cost int, policy entity.RateLimitPolicy) (*entity.UserLimit, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.consumed = append(f.consumed, cost)
+	if f.used+cost > ratelimit.Limit(policy, &entity.UserLimit{LimitValue: f.limit}) {
+		return nil, nil
+	}
+	f.used += cost
+	return &entity.UserLimit{UserID: userID, LimitType: limitType, LimitValue: f.limit, CurrentUsed: f.used, ResetAt: time.Now().Add(time.Hour)}, nil
+}
+
+func (f *fakeUserLimitRepo) HasOngoingTestSession(ctx context.Context, userID int) (bool, error) {
+	return f.inExam, nil
+}
+
+func (f *fakeUserLimitRepo) GetLimitsByUser(ctx context.Context, userID int) ([]*entity.UserLimit, error) {
+	return nil, nil
+}
+
+func (f *fakeUserLimitRepo) RecordUsage(ctx context.Context, usage *entity.UserLimitUsage) error {
+	return nil
+}
+
+func (f *fakeUserLimitRepo) GetUsageHistory(ctx context.Context, userID int, limitType string, since time.Time) ([]*entity.UserLimitUsage, error) {
+	return nil, nil
+}
+
+type fakePolicyRepo struct {
+	set entity.RateLimitPolicySet
+}
+
+func (f *fakePolicyRepo) LoadPolicySet(ctx context.Context) (*entity.RateLimitPolicySet, error) {
+	return &f.set, nil
+}
+
+func (f *fakePolicyRepo) SavePolicy(ctx context.Context, policy *entity.RateLimitPolicy) error {
+	return nil
+}
+
+func (f *fakePolicyRepo) DeletePolicy(ctx context.Context, id int) error {
+	return nil
+}
+
+func (f *fakePolicyRepo) SetMethodGroup(ctx context.Context, method, group string) error {
+	return nil
+}
+
+func (f *fakePolicyRepo) SetSchoolPlan(ctx context.Context, schoolID int64, plan string) error {
+	return nil
+}
+
+type fakeRateLimitStore struct {
+	err   error
+	used  map[string]int
+	calls int
+}
+
+func (f *fakeRateLimitStore) Allow(ctx context.Context, key string, limit, cost int, window time.Duration) (ratelimit.Result, error) {
+	f.calls++
+	if f.err != nil {
+		return ratelimit.Result{}, f.err
+	}
+	if f.used[key]+cost > limit {
+		return ratelimit.Result{Limit: limit, ResetAt: time.Now().Add(time.Minute)}, nil
+	}
+	f.used[key] += cost
+	return ratelimit.Result{Allowed: true, Limit: limit, Remaining: limit - f.used[key], ResetAt: time.Now().Add(window)}, nil
+}
+
+func (f *fakeRateLimitStore) Used(ctx context.Context, key string, window time.Duration) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	return f.used[key], nil
+}
+
+func (f *fakeRateLimitStore) Reset(ctx context.Context, key string, window time.Duration) error {
+	delete(f.used, key)
+	return nil
+}
+
+func callRateLimited(m *interceptor.RateLimitMiddleware, method string) error {
+	ctx := context.WithValue(context.Background(), "user", &base.User{Id: 7})
+	ctx = interceptor.AddRoleNameToContext(ctx, interceptor.RoleStudent)
+	_, err := m.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+// --- Tests ---
+
+func TestRateLimitCountsInStore(t *testing.T) {
+	repo := &fakeUserLimitRepo{limit: 12}
+	store := &fakeRateLimitStore{used: map[string]int{}}
+	m := interceptor.NewRateLimitMiddleware(repo, nil, store)
+
+	assert.NoError(t, callRateLimited(m, base.SoalService_UploadMediaToSoal_FullMethodName))
+	assert.Equal(t, 10, store.used[ratelimit.UserKey(7, entity.LimitTypeAPIRequestsPerHour)], "expensive methods cost more")
+	assert.NoError(t, callRateLimited(m, base.SoalService_GetSoal_FullMethodName))
+
+	err := callRateLimited(m, base.SoalService_UploadImageToSoal_FullMethodName)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Empty(t, repo.consumed, "the database is not counted while the store works")
+}
+
+func TestRateLimitFallsBackToDatabase(t *testing.T) {
+	repo := &fakeUserLimitRepo{limit: 2}
+	store := &fakeRateLimitStore{err: errors.New("connection refused")}
+	m := interceptor.NewRateLimitMiddleware(repo, nil, store)
+
+	assert.NoError(t, callRateLimited(m, base.SoalService_GetSoal_FullMethodName))
+	assert.NoError(t, callRateLimited(m, base.SoalService_GetSoal_FullMethodName))
+	err := callRateLimited(m, base.SoalService_GetSoal_FullMethodName)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	assert.Equal(t, 1, store.calls, "the store is skipped for a while after failing")
+	assert.Equal(t, []int{1, 1, 1}, repo.consumed)
+}
+
+func TestRateLimitWithoutStore(t *testing.T) {
+	repo := &fakeUserLimitRepo{limit: 5}
+	m := interceptor.NewRateLimitMiddleware(repo, nil, nil)
+
+	err := callRateLimited(m, base.SoalService_UploadMediaToSoal_FullMethodName)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []int{10}, repo.consumed)
+}
+
+func TestRateLimitAppliesPolicyOfGroup(t *testing.T) {
+	examLimit, defaultLimit := 2, 1
+	student := interceptor.RoleStudent
+	policies := &fakePolicyRepo{set: entity.RateLimitPolicySet{
+		MethodGroups: []entity.RateLimitMethodGroup{
+			{Method: base.TestSessionService_SubmitAnswer_FullMethodName, MethodGroup: "exam"},
+		},
+		Policies: []entity.RateLimitPolicy{
+			{ID: 1, MethodGroup: "exam", Role: &student, LimitType: entity.LimitTypeAPIRequestsPerHour, LimitValue: &examLimit, Burst: 1, ExamExempt: true, Enabled: true},
+			{ID: 2, MethodGroup: entity.RateLimitGroupDefault, LimitType: entity.LimitTypeAPIRequestsPerHour, LimitValue: &defaultLimit, Enabled: true},
+		},
+	}}
+
+	store := &fakeRateLimitStore{used: map[string]int{}}
+	m := interceptor.NewRateLimitMiddleware(&fakeUserLimitRepo{limit: 100}, policies, store)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, callRateLimited(m, base.TestSessionService_SubmitAnswer_FullMethodName), "limit plus burst")
+	}
+	err := callRateLimited(m, base.TestSessionService_SubmitAnswer_FullMethodName)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 3, store.used[ratelimit.UserKey(7, "api_requests_per_hour.exam")])
+
+	// Other methods count separately, under the default group
+	assert.NoError(t, callRateLimited(m, base.SoalService_GetSoal_FullMethodName))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(callRateLimited(m, base.SoalService_GetSoal_FullMethodName)))
+
+	// During an ongoing exam the exam group is not limited
+	inExam := interceptor.NewRateLimitMiddleware(&fakeUserLimitRepo{limit: 100, inExam: true}, policies, store)
+	assert.NoError(t, callRateLimited(inExam, base.TestSessionService_SubmitAnswer_FullMethodName))
+}
@@ -0,0 +1,96 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	examSecurityRepo "cbt-test-mini-project/internal/repository/exam_security"
+	"cbt-test-mini-project/util/seb"
+	"cbt-test-mini-project/util/tenant"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// SEBMiddleware enforces Safe Exam Browser on sessions whose assignment has an SEB config
+type SEBMiddleware struct {
+	repo examSecurityRepo.ExamSecurityRepository
+}
+
+// NewSEBMiddleware creates a new Safe Exam Browser middleware
+func NewSEBMiddleware(repo examSecurityRepo.ExamSecurityRepository) *SEBMiddleware {
+	return &SEBMiddleware{repo: repo}
+}
+
+// Verify checks the X-SafeExamBrowser-* headers of a TestSessionService call against the
+// keys stored for the session's assignment. Calls without a session token, sessions
+// without an SEB config and result review are allowed through.
+func (m *SEBMiddleware) Verify(ctx context.Context, fullMethod string, req interface{}) error {
+	if m == nil || m.repo == nil || !m.shouldVerify(fullMethod) {
+		return nil
+	}
+
+	tokenReq, ok := req.(interface{ GetSessionToken() string })
+	if !ok {
+		return nil
+	}
+	token := strings.TrimSpace(tokenReq.GetSessionToken())
+	if token == "" {
+		return nil
+	}
+
+	// Runs ahead of the JWT middleware, so there is no tenant yet to scope the lookup
+	cfg, err := m.repo.GetActiveSebConfigBySessionToken(tenant.System(ctx), token)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to load safe exam browser config")
+	}
+	if cfg == nil {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	// Only the gateway sets the request URL from the HTTP request; a direct gRPC caller could
+	// send the URL that matches hashes captured elsewhere
+	var requestURL string
+	if FromGateway(ctx) {
+		requestURL = firstMetadataValue(md, strings.ToLower(seb.HeaderRequestURL))
+	}
+	configKeyHash := firstMetadataValue(md, strings.ToLower(seb.HeaderConfigKeyHash))
+	if requestURL == "" || configKeyHash == "" {
+		return status.Error(codes.PermissionDenied, "this exam must be taken using Safe Exam Browser")
+	}
+
+	if !seb.VerifyHash(requestURL, cfg.ConfigKey, configKeyHash) {
+		return status.Error(codes.PermissionDenied, "safe exam browser configuration does not match this exam")
+	}
+
+	if len(cfg.BrowserExamKeys) == 0 {
+		return nil
+	}
+
+	requestHash := firstMetadataValue(md, strings.ToLower(seb.HeaderRequestHash))
+	for _, browserExamKey := range cfg.BrowserExamKeys {
+		if seb.VerifyHash(requestURL, browserExamKey, requestHash) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "safe exam browser version is not allowed for this exam")
+}
+
+// shouldVerify limits SEB enforcement to exam-taking calls; results are reviewed outside SEB
+func (m *SEBMiddleware) shouldVerify(method string) bool {
+	if !strings.HasPrefix(method, "/base.TestSessionService/") {
+		return false
+	}
+	return method != "/base.TestSessionService/GetTestResult"
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	for _, value := range md.Get(key) {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
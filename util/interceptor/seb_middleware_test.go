@@ -0,0 +1,81 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	base "cbt-test-mini-project/gen/proto"
+	"cbt-test-mini-project/internal/entity"
+	examsecurityrepo "cbt-test-mini-project/internal/repository/exam_security"
+	"cbt-test-mini-project/util/interceptor"
+	"cbt-test-mini-project/util/seb"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSebConfigs returns the SEB config of every session
+type fakeSebConfigs struct {
+	examsecurityrepo.ExamSecurityRepository
+	config *entity.SebConfig
+}
+
+func (f fakeSebConfigs) GetActiveSebConfigBySessionToken(ctx context.Context, token string) (*entity.SebConfig, error) {
+	return f.config, nil
+}
+
+func TestSEBMiddleware_TrustsRequestURLOnlyFromGateway(t *testing.T) {
+	const (
+		configKey      = "0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0"
+		browserExamKey = "a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"
+		requestURL     = "https://cbt.example.sch.id/v1/test-session/tok/numeric"
+		method         = "/base.TestSessionService/SubmitNumericAnswer"
+	)
+	sebHeaders := func(url, key string) metadata.MD {
+		return metadata.Pairs(
+			seb.HeaderRequestURL, requestURL,
+			seb.HeaderConfigKeyHash, seb.HashForURL(url, key),
+			seb.HeaderRequestHash, seb.HashForURL(url, browserExamKey),
+		)
+	}
+
+	tests := []struct {
+		name        string
+		config      *entity.SebConfig
+		method      string
+		gateway     bool
+		md          metadata.MD
+		wantCode    codes.Code
+		wantMessage string
+	}{
+		{name: "gateway with matching hashes", config: &entity.SebConfig{ConfigKey: configKey}, method: method, gateway: true, md: sebHeaders(requestURL, configKey), wantCode: codes.OK},
+		{name: "direct call with matching hashes", config: &entity.SebConfig{ConfigKey: configKey}, method: method, md: sebHeaders(requestURL, configKey), wantCode: codes.PermissionDenied, wantMessage: "must be taken using Safe Exam Browser"},
+		{name: "gateway with hashes for another key", config: &entity.SebConfig{ConfigKey: configKey}, method: method, gateway: true, md: sebHeaders(requestURL, browserExamKey), wantCode: codes.PermissionDenied, wantMessage: "does not match"},
+		{name: "gateway with hashes for another URL", config: &entity.SebConfig{ConfigKey: configKey}, method: method, gateway: true, md: sebHeaders("https://other.example/", configKey), wantCode: codes.PermissionDenied, wantMessage: "does not match"},
+		{name: "gateway with an allowed browser exam key", config: &entity.SebConfig{ConfigKey: configKey, BrowserExamKeys: []string{browserExamKey}}, method: method, gateway: true, md: sebHeaders(requestURL, configKey), wantCode: codes.OK},
+		{name: "gateway with another browser exam key", config: &entity.SebConfig{ConfigKey: configKey, BrowserExamKeys: []string{configKey}}, method: method, gateway: true, md: sebHeaders(requestURL, configKey), wantCode: codes.PermissionDenied, wantMessage: "version is not allowed"},
+		{name: "no SEB config", method: method, wantCode: codes.OK},
+		{name: "result review skips SEB", config: &entity.SebConfig{ConfigKey: configKey}, method: "/base.TestSessionService/GetTestResult", wantCode: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			if tt.md != nil {
+				md = tt.md.Copy()
+			}
+			if tt.gateway {
+				md = metadata.Join(md, interceptor.GatewayMetadata())
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			m := interceptor.NewSEBMiddleware(fakeSebConfigs{config: tt.config})
+
+			err := m.Verify(ctx, tt.method, &base.SubmitNumericAnswerRequest{SessionToken: "tok"})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantMessage != "" {
+				assert.Contains(t, status.Convert(err).Message(), tt.wantMessage)
+			}
+		})
+	}
+}
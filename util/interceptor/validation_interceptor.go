@@ -7,6 +7,8 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	"cbt-test-mini-project/util/richtext"
+
 	"github.com/microcosm-cc/bluemonday"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -38,7 +40,7 @@ func NewValidationError(err error) error {
 // NewValidationInterceptor creates a new validation interceptor
 func NewValidationInterceptor() *ValidationInterceptor {
 	return &ValidationInterceptor{
-		sanitizer:    bluemonday.UGCPolicy(), // User Generated Content policy
+		sanitizer:    bluemonday.UGCPolicy(),    // User Generated Content policy
 		strictPolicy: bluemonday.StrictPolicy(), // Strict policy for sensitive fields
 	}
 }
@@ -46,7 +48,7 @@ func NewValidationInterceptor() *ValidationInterceptor {
 // Do performs validation and sanitization on the input message
 func (v *ValidationInterceptor) Do(ctx context.Context, input protoreflect.ProtoMessage) error {
 	// Sanitize and validate the message
-	if err := v.validateAndSanitizeMessage(input, ""); err != nil {
+	if err := v.validateAndSanitizeMessage(input, "", richtext.FormatPlain); err != nil {
 		return err
 	}
 
@@ -62,11 +64,14 @@ func (v *ValidationInterceptor) SetNext(next Interceptor) {
 	v.next = next
 }
 
-// validateAndSanitizeMessage validates and sanitizes all fields in a protobuf message
-func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.ProtoMessage, path string) error {
+// validateAndSanitizeMessage validates and sanitizes all fields in a protobuf message.
+// format is the content format of question texts, set by a content_format field of the
+// message or inherited from the enclosing message.
+func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.ProtoMessage, path string, format richtext.Format) error {
 	var errors []string
 
 	m := msg.ProtoReflect()
+	format = v.contentFormat(m, format)
 
 	m.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
 		fieldName := string(fd.Name())
@@ -88,11 +93,11 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 				list := val.List()
 				for i := 0; i < list.Len(); i++ {
 					s := list.Get(i).String()
-					if err := v.validateStringField(s, fieldName); err != nil {
+					sanitized, err := v.sanitizeField(s, fieldName, format)
+					if err != nil {
 						errors = append(errors, fmt.Sprintf("%s[%d]: %s", currentPath, i, err.Error()))
 						continue
 					}
-					sanitized := v.sanitizeText(s, fieldName)
 					if sanitized != s {
 						list.Set(i, protoreflect.ValueOfString(sanitized))
 					}
@@ -105,7 +110,7 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 				list := val.List()
 				for i := 0; i < list.Len(); i++ {
 					pm := list.Get(i).Message().Interface()
-					if err := v.validateAndSanitizeMessage(pm, fmt.Sprintf("%s[%d]", currentPath, i)); err != nil {
+					if err := v.validateAndSanitizeMessage(pm, fmt.Sprintf("%s[%d]", currentPath, i), format); err != nil {
 						errors = append(errors, err.Error())
 					}
 				}
@@ -118,7 +123,7 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 		// Handle maps
 		if fd.IsMap() {
 			mapVal := val.Map()
-			
+
 			mapVal.Range(func(k protoreflect.MapKey, val protoreflect.Value) bool {
 				// Validate map key if string
 				mapKeyFd := fd.MapKey()
@@ -148,7 +153,7 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 					}
 				} else if valueFd.Kind() == protoreflect.MessageKind {
 					if val.Message().IsValid() {
-						if err := v.validateAndSanitizeMessage(val.Message().Interface(), fmt.Sprintf("%s[%s]", currentPath, k.String())); err != nil {
+						if err := v.validateAndSanitizeMessage(val.Message().Interface(), fmt.Sprintf("%s[%s]", currentPath, k.String()), format); err != nil {
 							errors = append(errors, err.Error())
 						}
 					}
@@ -162,11 +167,11 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 		switch fd.Kind() {
 		case protoreflect.StringKind:
 			s := val.String()
-			if err := v.validateStringField(s, fieldName); err != nil {
+			sanitized, err := v.sanitizeField(s, fieldName, format)
+			if err != nil {
 				errors = append(errors, fmt.Sprintf("%s: %s", currentPath, err.Error()))
 				break
 			}
-			sanitized := v.sanitizeText(s, fieldName)
 			if sanitized != s {
 				m.Set(fd, protoreflect.ValueOfString(sanitized))
 			}
@@ -175,7 +180,7 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 			// Nested message
 			nested := val.Message()
 			if nested.IsValid() {
-				if err := v.validateAndSanitizeMessage(nested.Interface(), currentPath); err != nil {
+				if err := v.validateAndSanitizeMessage(nested.Interface(), currentPath, format); err != nil {
 					errors = append(errors, err.Error())
 				}
 			}
@@ -209,6 +214,58 @@ func (v *ValidationInterceptor) validateAndSanitizeMessage(msg protoreflect.Prot
 	return nil
 }
 
+// contentFormat returns the format chosen by the message's content_format enum field,
+// e.g. CONTENT_FORMAT_MARKDOWN_LATEX, or inherited when the message has no such field
+func (v *ValidationInterceptor) contentFormat(m protoreflect.Message, inherited richtext.Format) richtext.Format {
+	fd := m.Descriptor().Fields().ByName("content_format")
+	if fd == nil || fd.Kind() != protoreflect.EnumKind {
+		return inherited
+	}
+	value := fd.Enum().Values().ByNumber(m.Get(fd).Enum())
+	if value == nil {
+		return richtext.FormatPlain
+	}
+	format, err := richtext.ParseFormat(strings.TrimPrefix(string(value.Name()), "CONTENT_FORMAT_"))
+	if err != nil {
+		return richtext.FormatPlain
+	}
+	return format
+}
+
+// sanitizeField validates a string field and returns its sanitized value. Question texts
+// in Markdown+LaTeX or MathML are validated against their format and kept as written,
+// because the HTML sanitizer would mangle formulas such as a<b; they are sanitized when
+// rendered instead.
+func (v *ValidationInterceptor) sanitizeField(value, fieldName string, format richtext.Format) (string, error) {
+	if format.IsRich() && v.isContentField(fieldName) {
+		if err := v.validateLength(value, fieldName); err != nil {
+			return value, err
+		}
+		if err := richtext.Validate(format, value); err != nil {
+			return value, ValidationError{Field: fieldName, Message: err.Error()}
+		}
+		return value, nil
+	}
+	if err := v.validateStringField(value, fieldName); err != nil {
+		return value, err
+	}
+	return v.sanitizeText(value, fieldName), nil
+}
+
+// isContentField determines if a field holds question text written in the content format
+func (v *ValidationInterceptor) isContentField(fieldName string) bool {
+	contentFields := []string{
+		"pertanyaan", "pembahasan", "opsi", "opsi_a", "opsi_b", "opsi_c", "opsi_d",
+	}
+
+	for _, content := range contentFields {
+		if fieldName == content {
+			return true
+		}
+	}
+	return false
+}
+
 // shouldSkipField determines if a field should be skipped during validation
 func (v *ValidationInterceptor) shouldSkipField(fieldName string) bool {
 	skipFields := []string{
@@ -292,13 +349,13 @@ func (v *ValidationInterceptor) validateLength(value, fieldName string) error {
 	length := utf8.RuneCountInString(value)
 
 	limits := map[string]int{
-		"email":     255,
-		"password":  128,
-		"full_name": 100,
-		"phone":     20,
-		"address":   500,
-		"name":      100,
-		"code":      50,
+		"email":       255,
+		"password":    128,
+		"full_name":   100,
+		"phone":       20,
+		"address":     500,
+		"name":        100,
+		"code":        50,
 		"description": 1000,
 	}
 
@@ -433,4 +490,4 @@ func (v *ValidationInterceptor) isSensitiveField(fieldName string) bool {
 	}
 
 	return false
-}
\ No newline at end of file
+}
@@ -0,0 +1,8 @@
+package jwks
+
+import "time"
+
+// SetClock replaces the clock a key set reads, for tests that step through refreshes.
+func SetClock(s *KeySet, now func() time.Time) {
+	s.now = now
+}
@@ -0,0 +1,247 @@
+// Package jwks verifies asymmetric JWTs against a JSON Web Key Set (RFC 7517)
+// read from a local file or an HTTPS URL. Keys are cached and re-read when a
+// lookup finds them stale or meets an unknown kid; a key that disappears from the document stays
+// trusted for a grace window so tokens signed just before a rotation keep
+// working until they expire.
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrKeyNotFound = errors.New("no key in the JWKS matches the token")
+	ErrMissingKID  = errors.New("token has no kid and the JWKS does not hold exactly one key")
+)
+
+// maxDocumentSize bounds the JWKS document read from the source.
+const maxDocumentSize = 1 << 20
+
+// minRefreshRetry limits how often the document is re-read after a failed read or for an
+// unknown kid, so an unreachable source or tokens with made-up kids cannot turn every
+// request into a fetch.
+const minRefreshRetry = 30 * time.Second
+
+// KeySet is a cached JWKS. It is safe for concurrent use.
+type KeySet struct {
+	source  string
+	refresh time.Duration
+	grace   time.Duration
+	client  *http.Client
+	now     func() time.Time
+
+	mu        sync.RWMutex
+	keys      map[string]*cachedKey
+	fetchedAt time.Time
+	triedAt   time.Time
+	// fetching is the read in progress; requests that need a refresh meanwhile wait for it
+	// instead of starting their own.
+	fetching *fetchCall
+}
+
+type cachedKey struct {
+	key crypto.PublicKey
+	alg string
+	// retiredAt is when the key was first missing from the document, zero while it is listed.
+	retiredAt time.Time
+}
+
+// fetchCall is one read of the document shared by every request waiting on it.
+type fetchCall struct {
+	done chan struct{}
+	err  error
+}
+
+// New returns a key set read from source, a file path (optionally file://) or an
+// http(s) URL. The document is re-read every refresh; keys removed from it are
+// still accepted for grace.
+func New(source string, refresh, grace time.Duration) *KeySet {
+	return &KeySet{
+		source:  strings.TrimSpace(source),
+		refresh: refresh,
+		grace:   grace,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		now:     time.Now,
+		keys:    map[string]*cachedKey{},
+	}
+}
+
+// Key returns the verification key for a token's kid and alg header values.
+func (s *KeySet) Key(ctx context.Context, kid, alg string) (crypto.PublicKey, error) {
+	s.mu.RLock()
+	key, found := s.lookup(kid)
+	needsRefresh := s.needsRefresh(found)
+	s.mu.RUnlock()
+
+	if needsRefresh {
+		refreshErr := s.load(ctx, func() bool { return s.needsRefresh(found) })
+		s.mu.RLock()
+		key, found = s.lookup(kid)
+		s.mu.RUnlock()
+		// A failed refresh keeps serving the cached keys, the error only matters
+		// when there is nothing cached to fall back on.
+		if !found && refreshErr != nil {
+			return nil, refreshErr
+		}
+	}
+	if !found {
+		if kid == "" {
+			return nil, ErrMissingKID
+		}
+		return nil, ErrKeyNotFound
+	}
+	if key.alg != "" && key.alg != alg {
+		return nil, fmt.Errorf("key %q is for %s, token is signed with %s", kid, key.alg, alg)
+	}
+	if err := checkAlgorithm(key.key, alg); err != nil {
+		return nil, err
+	}
+	return key.key, nil
+}
+
+// lookup finds the key for kid, or the only key when kid is empty. Retired keys
+// past their grace window are skipped. The caller holds mu.
+func (s *KeySet) lookup(kid string) (*cachedKey, bool) {
+	if kid == "" {
+		if len(s.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range s.keys {
+			return key, s.usable(key)
+		}
+	}
+	key, ok := s.keys[kid]
+	if !ok || !s.usable(key) {
+		return nil, false
+	}
+	return key, true
+}
+
+func (s *KeySet) usable(key *cachedKey) bool {
+	return key.retiredAt.IsZero() || s.now().Sub(key.retiredAt) < s.grace
+}
+
+// needsRefresh reports whether the cache is due for a refresh, or a kid was not found,
+// and the document has not been read recently. A kid that is not found also waits for a
+// read in progress. The caller holds mu.
+func (s *KeySet) needsRefresh(found bool) bool {
+	now := s.now()
+	if now.Sub(s.fetchedAt) < s.refresh && found {
+		return false
+	}
+	if !found && s.fetching != nil {
+		return true
+	}
+	return now.Sub(s.triedAt) >= min(s.refresh, minRefreshRetry)
+}
+
+// Refresh reads the document now and merges it into the cache: listed keys are
+// current, keys no longer listed start their grace window and are dropped once it
+// has passed.
+func (s *KeySet) Refresh(ctx context.Context) error {
+	return s.load(ctx, func() bool { return true })
+}
+
+// load reads the document without holding mu, so lookups keep being served from the
+// cache meanwhile. Only one read runs at a time: a caller arriving during a read waits
+// for its result. due is checked under mu before a new read starts.
+func (s *KeySet) load(ctx context.Context, due func() bool) error {
+	s.mu.Lock()
+	if call := s.fetching; call != nil {
+		s.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if !due() {
+		s.mu.Unlock()
+		return nil
+	}
+	call := &fetchCall{done: make(chan struct{})}
+	s.fetching = call
+	s.triedAt = s.now()
+	s.mu.Unlock()
+
+	// The read is shared, so it must not fail because the caller that started it left
+	listed, err := s.fetch(context.WithoutCancel(ctx))
+
+	s.mu.Lock()
+	if err == nil {
+		s.merge(listed)
+	}
+	s.fetching = nil
+	s.mu.Unlock()
+
+	call.err = err
+	close(call.done)
+	return err
+}
+
+func (s *KeySet) fetch(ctx context.Context) (map[string]*cachedKey, error) {
+	data, err := s.read(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read JWKS %s: %w", s.source, err)
+	}
+	listed, err := parseKeySet(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse JWKS %s: %w", s.source, err)
+	}
+	return listed, nil
+}
+
+// merge replaces the cached keys with the listed ones, starting or ending the grace
+// window of keys no longer listed. The caller holds mu.
+func (s *KeySet) merge(listed map[string]*cachedKey) {
+	now := s.now()
+	for kid, key := range s.keys {
+		if _, ok := listed[kid]; ok {
+			continue
+		}
+		if key.retiredAt.IsZero() {
+			key.retiredAt = now
+		}
+		if now.Sub(key.retiredAt) >= s.grace {
+			delete(s.keys, kid)
+		}
+	}
+	for kid, key := range listed {
+		s.keys[kid] = key
+	}
+	s.fetchedAt = now
+}
+
+func (s *KeySet) read(ctx context.Context) ([]byte, error) {
+	if s.source == "" {
+		return nil, errors.New("no JWKS source configured")
+	}
+	if !strings.HasPrefix(s.source, "http://") && !strings.HasPrefix(s.source, "https://") {
+		return os.ReadFile(strings.TrimPrefix(s.source, "file://"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize))
+}
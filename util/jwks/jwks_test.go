@@ -0,0 +1,259 @@
+package jwks_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cbt-test-mini-project/util/jwks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves a JWKS document that tests can swap or break between requests
+type jwksServer struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	keys  []map[string]string
+	fail  bool
+	reads atomic.Int32
+	// hold, when set, blocks every request until it is closed
+	hold     chan struct{}
+	received chan struct{}
+}
+
+func newJWKSServer(t *testing.T, keys ...map[string]string) *jwksServer {
+	s := &jwksServer{keys: keys, received: make(chan struct{}, 16)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.reads.Add(1)
+		s.received <- struct{}{}
+		s.mu.Lock()
+		hold, fail, keys := s.hold, s.fail, s.keys
+		s.mu.Unlock()
+		if hold != nil {
+			<-hold
+		}
+		if fail {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) set(fail bool, keys ...map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail, s.keys = fail, keys
+}
+
+func (s *jwksServer) setHold(hold chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hold = hold
+}
+
+// ecJWK returns a new P-256 public key in JWK form
+func ecJWK(t *testing.T, kid, alg string) map[string]string {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	jwk := map[string]string{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   encode(priv.PublicKey.X.FillBytes(make([]byte, 32))),
+		"y":   encode(priv.PublicKey.Y.FillBytes(make([]byte, 32))),
+	}
+	if alg != "" {
+		jwk["alg"] = alg
+	}
+	return jwk
+}
+
+// clock is a settable time source
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newKeySet(source string, refresh, grace time.Duration) (*jwks.KeySet, *clock) {
+	c := &clock{now: time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)}
+	set := jwks.New(source, refresh, grace)
+	jwks.SetClock(set, c.Now)
+	return set, c
+}
+
+func TestKey_KidRotationAndGrace(t *testing.T) {
+	server := newJWKSServer(t, ecJWK(t, "old", "ES256"))
+	set, clock := newKeySet(server.URL, time.Hour, 10*time.Minute)
+	ctx := context.Background()
+
+	_, err := set.Key(ctx, "old", "ES256")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, server.reads.Load())
+
+	server.set(false, ecJWK(t, "new", "ES256"))
+
+	_, err = set.Key(ctx, "new", "ES256")
+	assert.ErrorIs(t, err, jwks.ErrKeyNotFound, "an unknown kid right after a read does not re-read")
+	assert.EqualValues(t, 1, server.reads.Load())
+
+	clock.Advance(31 * time.Second)
+	_, err = set.Key(ctx, "new", "ES256")
+	require.NoError(t, err, "an unknown kid re-reads the rotated document")
+	assert.EqualValues(t, 2, server.reads.Load())
+
+	_, err = set.Key(ctx, "old", "ES256")
+	assert.NoError(t, err, "the removed key is trusted during the grace window")
+
+	clock.Advance(10 * time.Minute)
+	_, err = set.Key(ctx, "old", "ES256")
+	assert.ErrorIs(t, err, jwks.ErrKeyNotFound, "the removed key expires with the grace window")
+	_, err = set.Key(ctx, "new", "ES256")
+	assert.NoError(t, err)
+}
+
+func TestKey_StaleRefreshBacksOff(t *testing.T) {
+	server := newJWKSServer(t, ecJWK(t, "k1", "ES256"))
+	set, clock := newKeySet(server.URL, time.Minute, time.Hour)
+	ctx := context.Background()
+
+	_, err := set.Key(ctx, "k1", "ES256")
+	require.NoError(t, err)
+
+	server.set(true)
+	clock.Advance(2 * time.Minute)
+	_, err = set.Key(ctx, "k1", "ES256")
+	assert.NoError(t, err, "a failed refresh keeps serving cached keys")
+	assert.EqualValues(t, 2, server.reads.Load())
+
+	for i := 0; i < 5; i++ {
+		_, err = set.Key(ctx, "k1", "ES256")
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, server.reads.Load(), "a stale cache does not re-read on every request after a failure")
+
+	clock.Advance(31 * time.Second)
+	_, err = set.Key(ctx, "k1", "ES256")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, server.reads.Load())
+}
+
+func TestKey_ErrorsWithoutCachedKeys(t *testing.T) {
+	server := newJWKSServer(t)
+	server.set(true)
+	set, _ := newKeySet(server.URL, time.Hour, time.Hour)
+
+	_, err := set.Key(context.Background(), "k1", "ES256")
+	assert.ErrorContains(t, err, "read JWKS")
+}
+
+func TestKey_AlgorithmMismatch(t *testing.T) {
+	server := newJWKSServer(t, ecJWK(t, "pinned", "ES256"), ecJWK(t, "open", ""))
+	set, _ := newKeySet(server.URL, time.Hour, time.Hour)
+
+	tests := []struct {
+		name    string
+		kid     string
+		alg     string
+		wantErr string
+	}{
+		{name: "pinned alg", kid: "pinned", alg: "ES256"},
+		{name: "other than pinned alg", kid: "pinned", alg: "ES384", wantErr: `key "pinned" is for ES256, token is signed with ES384`},
+		{name: "alg for the key's curve", kid: "open", alg: "ES256"},
+		{name: "alg for another curve", kid: "open", alg: "ES512", wantErr: "algorithm ES512 does not match the key"},
+		{name: "RSA alg on an EC key", kid: "open", alg: "RS256", wantErr: "algorithm RS256 does not match the key"},
+		{name: "HMAC alg on an EC key", kid: "open", alg: "HS256", wantErr: "algorithm HS256 does not match the key"},
+		{name: "no kid with several keys", kid: "", alg: "ES256", wantErr: jwks.ErrMissingKID.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := set.Key(context.Background(), tt.kid, tt.alg)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, key)
+		})
+	}
+}
+
+func TestKey_ConcurrentRequestsShareOneRead(t *testing.T) {
+	server := newJWKSServer(t, ecJWK(t, "k1", "ES256"))
+	hold := make(chan struct{})
+	server.setHold(hold)
+	set, _ := newKeySet(server.URL, time.Hour, time.Hour)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := set.Key(context.Background(), "k1", "ES256")
+			errs <- err
+		}()
+	}
+	<-server.received
+	close(hold)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, 1, server.reads.Load())
+}
+
+func TestKey_CachedKeysServedDuringRead(t *testing.T) {
+	server := newJWKSServer(t, ecJWK(t, "k1", "ES256"))
+	set, clock := newKeySet(server.URL, time.Hour, time.Hour)
+	ctx := context.Background()
+
+	_, err := set.Key(ctx, "k1", "ES256")
+	require.NoError(t, err)
+	<-server.received
+
+	hold := make(chan struct{})
+	server.setHold(hold)
+	clock.Advance(31 * time.Second)
+	done := make(chan error, 1)
+	go func() {
+		_, err := set.Key(ctx, "unknown", "ES256")
+		done <- err
+	}()
+	<-server.received
+
+	_, err = set.Key(ctx, "k1", "ES256")
+	assert.NoError(t, err, "a known kid does not wait for the read in progress")
+
+	close(hold)
+	assert.ErrorIs(t, <-done, jwks.ErrKeyNotFound)
+}
@@ -0,0 +1,96 @@
+package localauth_test
+
+import (
+	"testing"
+	"time"
+
+	"cbt-test-mini-project/util/localauth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const secret = "test-secret"
+
+func TestAccessToken_RoundTrip(t *testing.T) {
+	token, expiresAt, err := localauth.SignAccessToken(secret, localauth.Claims{UserID: 7, Role: "teacher", SessionID: 3}, time.Hour, time.Now())
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+	assert.True(t, localauth.IsLocalToken(token))
+
+	claims, err := localauth.ParseAccessToken(secret, token)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(7), claims.UserID)
+		assert.Equal(t, int64(3), claims.SessionID)
+		assert.Equal(t, localauth.TokenTypeAccess, claims.Type)
+	}
+}
+
+func TestAccessToken_Rejected(t *testing.T) {
+	valid := localauth.Claims{UserID: 7, SessionID: 3}
+
+	expired, _, _ := localauth.SignAccessToken(secret, valid, time.Hour, time.Now().Add(-2*time.Hour))
+	_, err := localauth.ParseAccessToken(secret, expired)
+	assert.Error(t, err, "expired")
+
+	other, _, _ := localauth.SignAccessToken("other-secret", valid, time.Hour, time.Now())
+	_, err = localauth.ParseAccessToken(secret, other)
+	assert.Error(t, err, "wrong secret")
+
+	noSession, _, _ := localauth.SignAccessToken(secret, localauth.Claims{UserID: 7}, time.Hour, time.Now())
+	_, err = localauth.ParseAccessToken(secret, noSession)
+	assert.Error(t, err, "no session")
+
+	lms, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": "lms-erlangga", "user_id": 7, "sid": 3, "type": "access", "exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(secret))
+	assert.False(t, localauth.IsLocalToken(lms))
+	_, err = localauth.ParseAccessToken(secret, lms)
+	assert.Error(t, err, "LMS issuer")
+}
+
+func TestRefreshToken_HashMatches(t *testing.T) {
+	token, hash, err := localauth.NewRefreshToken()
+	assert.NoError(t, err)
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, localauth.HashRefreshToken(token))
+
+	next, _, _ := localauth.NewRefreshToken()
+	assert.NotEqual(t, token, next)
+}
+
+func TestLabToken_CarriesExamSession(t *testing.T) {
+	token, _, err := localauth.SignAccessToken(secret, localauth.Claims{UserID: 7, SessionID: 3, ExamSessionToken: "abc"}, time.Hour, time.Now())
+	assert.NoError(t, err)
+
+	claims, err := localauth.ParseAccessToken(secret, token)
+	if assert.NoError(t, err) {
+		assert.Equal(t, localauth.TokenTypeLab, claims.Type)
+		assert.Equal(t, "abc", claims.ExamSessionToken)
+	}
+}
+
+func TestLabCode_SignAndParse(t *testing.T) {
+	nonce, err := localauth.NewLabNonce()
+	assert.NoError(t, err)
+
+	code := localauth.SignLabCode(secret, 12, nonce)
+	id, gotNonce, err := localauth.ParseLabCode(secret, code)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 12, id)
+		assert.Equal(t, nonce, gotNonce)
+	}
+
+	_, _, err = localauth.ParseLabCode("other-secret", code)
+	assert.ErrorIs(t, err, localauth.ErrInvalidLabCode)
+
+	forged := localauth.SignLabCode(secret, 13, nonce)
+	_, _, err = localauth.ParseLabCode(secret, forged[:len(forged)-1]+"x")
+	assert.ErrorIs(t, err, localauth.ErrInvalidLabCode)
+}
+
+func TestNewPIN_Digits(t *testing.T) {
+	pin, err := localauth.NewPIN(6)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^[0-9]{6}$`, pin)
+}
@@ -0,0 +1,30 @@
+package nonce
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// New returns a random one-time value for a client and the hash to store. Only the client
+// ever holds the value itself.
+func New() (value, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	value = base64.RawURLEncoding.EncodeToString(buf)
+	return value, Hash(value), nil
+}
+
+// Hash returns the hex SHA-256 a value is looked up by; an empty value hashes to ""
+func Hash(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
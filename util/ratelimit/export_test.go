@@ -0,0 +1,14 @@
+package ratelimit
+
+import "time"
+
+// Exported for the tests of the sliding window maths
+var (
+	Evaluate       = evaluate
+	WindowPosition = windowPosition
+)
+
+// SetMemoryClock replaces the clock of a memory store
+func SetMemoryClock(s *MemoryStore, now func() time.Time) {
+	s.now = now
+}
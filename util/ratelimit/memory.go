@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps the windows in process memory. Limits are then per replica, so it is
+// only meant for running without Redis or while Redis is unavailable.
+type MemoryStore struct {
+	mu      sync.Mutex
+	windows map[string]*memoryWindows
+	now     func() time.Time
+}
+
+// memoryWindows are the counts of a key's current and previous fixed window
+type memoryWindows struct {
+	window   time.Duration
+	index    int64
+	current  int64
+	previous int64
+}
+
+// NewMemoryStore creates an empty in-process store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{windows: map[string]*memoryWindows{}, now: time.Now}
+}
+
+// Allow adds cost to key unless that would exceed limit within window
+func (s *MemoryStore) Allow(ctx context.Context, key string, limit, cost int, window time.Duration) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	index, elapsed := windowPosition(now, window)
+	s.prune(now)
+	w, ok := s.windows[key]
+	if !ok || w.window != window {
+		w = &memoryWindows{window: window, index: index}
+		s.windows[key] = w
+	}
+	w.advance(index)
+
+	weight := float64(window-elapsed) / float64(window)
+	allowed := float64(w.previous)*weight+float64(w.current)+float64(cost) <= float64(limit)
+	if allowed {
+		w.current += int64(cost)
+	}
+	return evaluate(now, limit, cost, allowed, w.current, w.previous, window, elapsed), nil
+}
+
+// Used returns the weighted count of key within window without adding to it
+func (s *MemoryStore) Used(ctx context.Context, key string, window time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w, ok := s.windows[key]
+	if !ok || w.window != window {
+		return 0, nil
+	}
+	index, elapsed := windowPosition(s.now(), window)
+	w.advance(index)
+	return weightedUsed(w.current, w.previous, window, elapsed), nil
+}
+
+// Reset forgets the requests counted for key in window
+func (s *MemoryStore) Reset(ctx context.Context, key string, window time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.windows, key)
+	return nil
+}
+
+// advance moves the windows to index; counts older than the previous window are dropped
+func (w *memoryWindows) advance(index int64) {
+	switch index {
+	case w.index:
+	case w.index + 1:
+		w.previous, w.current = w.current, 0
+	default:
+		w.previous, w.current = 0, 0
+	}
+	w.index = index
+}
+
+// prune drops keys whose counts no longer reach into the sliding window, so the map does
+// not grow with every key ever seen. The caller holds mu.
+func (s *MemoryStore) prune(now time.Time) {
+	for key, w := range s.windows {
+		if index, _ := windowPosition(now, w.window); index > w.index+1 {
+			delete(s.windows, key)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"strings"
+
+	"cbt-test-mini-project/internal/entity"
+)
+
+// Policies picks the rate limit policy of a call from a loaded entity.RateLimitPolicySet
+type Policies struct {
+	groups   map[string]string // method, or "/base.<Service>/*", to method group
+	policies map[string][]entity.RateLimitPolicy
+	plans    map[int64]string
+}
+
+// NewPolicies indexes set, leaving out disabled policies
+func NewPolicies(set entity.RateLimitPolicySet) *Policies {
+	p := &Policies{
+		groups:   make(map[string]string, len(set.MethodGroups)),
+		policies: map[string][]entity.RateLimitPolicy{},
+		plans:    make(map[int64]string, len(set.SchoolPlans)),
+	}
+	for _, group := range set.MethodGroups {
+		p.groups[group.Method] = group.MethodGroup
+	}
+	for _, policy := range set.Policies {
+		if policy.Enabled {
+			p.policies[policy.MethodGroup] = append(p.policies[policy.MethodGroup], policy)
+		}
+	}
+	for _, plan := range set.SchoolPlans {
+		p.plans[plan.SchoolID] = plan.Plan
+	}
+	return p
+}
+
+// Group is the method group of a full gRPC method name
+func (p *Policies) Group(method string) string {
+	if group, ok := p.groups[method]; ok {
+		return group
+	}
+	if i := strings.LastIndexByte(method, '/'); i > 0 {
+		if group, ok := p.groups[method[:i]+"/*"]; ok {
+			return group
+		}
+	}
+	return entity.RateLimitGroupDefault
+}
+
+// Resolve returns the most specific policy of the method's group matching role and school,
+// else of the default group. Without any match the result counts requests per hour in the
+// default group at the user's own limit.
+func (p *Policies) Resolve(method, role string, schoolID int64) entity.RateLimitPolicy {
+	plan, hasPlan := p.plans[schoolID]
+	for _, group := range []string{p.Group(method), entity.RateLimitGroupDefault} {
+		var best *entity.RateLimitPolicy
+		for i := range p.policies[group] {
+			policy := &p.policies[group][i]
+			if policy.Role != nil && *policy.Role != role {
+				continue
+			}
+			if policy.SchoolPlan != nil && (!hasPlan || *policy.SchoolPlan != plan) {
+				continue
+			}
+			if policy.SchoolID != nil && *policy.SchoolID != schoolID {
+				continue
+			}
+			if best == nil || policy.Specificity() > best.Specificity() ||
+				(policy.Specificity() == best.Specificity() && policy.ID < best.ID) {
+				best = policy
+			}
+		}
+		if best != nil {
+			return *best
+		}
+	}
+	return entity.RateLimitPolicy{
+		MethodGroup: entity.RateLimitGroupDefault,
+		LimitType:   entity.LimitTypeAPIRequestsPerHour,
+		Enabled:     true,
+	}
+}
+
+// Limit is the number of requests a policy allows a user with the given user_limits row
+func Limit(policy entity.RateLimitPolicy, userLimit *entity.UserLimit) int {
+	if userLimit != nil && userLimit.IsOverride {
+		return userLimit.LimitValue
+	}
+	limit := 0
+	switch {
+	case policy.LimitValue != nil:
+		limit = *policy.LimitValue
+	case userLimit != nil:
+		limit = userLimit.LimitValue
+	}
+	return limit + policy.Burst
+}
@@ -0,0 +1,50 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/internal/entity"
+	"cbt-test-mini-project/util/ratelimit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoliciesResolveMostSpecific(t *testing.T) {
+	student, teacher, premium := "student", "teacher", "premium"
+	school := int64(42)
+	policies := ratelimit.NewPolicies(entity.RateLimitPolicySet{
+		MethodGroups: []entity.RateLimitMethodGroup{
+			{Method: "/base.SoalService/*", MethodGroup: "authoring"},
+			{Method: "/base.SoalService/GetSoal", MethodGroup: entity.RateLimitGroupDefault},
+		},
+		SchoolPlans: []entity.SchoolPlan{{SchoolID: school, Plan: premium}},
+		Policies: []entity.RateLimitPolicy{
+			{ID: 1, MethodGroup: "authoring", LimitType: entity.LimitTypeQuestionsPerDay, Enabled: true},
+			{ID: 2, MethodGroup: "authoring", Role: &teacher, LimitType: entity.LimitTypeQuestionsPerDay, Enabled: true},
+			{ID: 3, MethodGroup: "authoring", Role: &teacher, SchoolPlan: &premium, LimitType: entity.LimitTypeQuestionsPerDay, Enabled: true},
+			{ID: 4, MethodGroup: "authoring", SchoolID: &school, LimitType: entity.LimitTypeQuestionsPerDay, Enabled: false},
+			{ID: 5, MethodGroup: entity.RateLimitGroupDefault, Role: &student, LimitType: entity.LimitTypeAPIRequestsPerDay, Enabled: true},
+		},
+	})
+
+	assert.Equal(t, "authoring", policies.Group("/base.SoalService/CreateSoal"))
+	assert.Equal(t, entity.RateLimitGroupDefault, policies.Group("/base.SoalService/GetSoal"))
+
+	assert.Equal(t, 3, policies.Resolve("/base.SoalService/CreateSoal", teacher, school).ID, "plan beats role")
+	assert.Equal(t, 2, policies.Resolve("/base.SoalService/CreateSoal", teacher, 7).ID)
+	assert.Equal(t, 1, policies.Resolve("/base.SoalService/CreateSoal", student, school).ID, "disabled policies are skipped")
+	assert.Equal(t, 5, policies.Resolve("/base.SoalService/GetSoal", student, 7).ID)
+
+	builtin := policies.Resolve("/base.SoalService/GetSoal", teacher, 7)
+	assert.Equal(t, 0, builtin.ID)
+	assert.Equal(t, entity.LimitTypeAPIRequestsPerHour, builtin.Bucket())
+}
+
+func TestLimit(t *testing.T) {
+	ten := 10
+	policy := entity.RateLimitPolicy{LimitValue: &ten, Burst: 5}
+
+	assert.Equal(t, 15, ratelimit.Limit(policy, &entity.UserLimit{LimitValue: 100}))
+	assert.Equal(t, 100, ratelimit.Limit(policy, &entity.UserLimit{LimitValue: 100, IsOverride: true}))
+	assert.Equal(t, 105, ratelimit.Limit(entity.RateLimitPolicy{Burst: 5}, &entity.UserLimit{LimitValue: 100}))
+}
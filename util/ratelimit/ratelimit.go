@@ -0,0 +1,182 @@
+// Package ratelimit counts requests in sliding windows kept in Redis, so every replica
+// enforces the same limit, or in process memory without Redis. The window is approximated
+// from two fixed windows: the previous window's count is weighted by how much of it still
+// overlaps the sliding window.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"cbt-test-mini-project/internal/entity"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Result of one Allow call
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAt is when the window has room again: for an allowed call when the current
+	// window ends, for a denied call when the request would have been allowed
+	ResetAt time.Time
+}
+
+// RetryAfter is how long a denied caller should wait
+func (r Result) RetryAfter(now time.Time) time.Duration {
+	if r.Allowed || !r.ResetAt.After(now) {
+		return 0
+	}
+	return r.ResetAt.Sub(now)
+}
+
+// Store counts weighted requests per key
+type Store interface {
+	// Allow adds cost to key unless that would exceed limit within window
+	Allow(ctx context.Context, key string, limit, cost int, window time.Duration) (Result, error)
+	// Used returns the weighted count of key within window without adding to it
+	Used(ctx context.Context, key string, window time.Duration) (int, error)
+	// Reset forgets the requests counted for key in window
+	Reset(ctx context.Context, key string, window time.Duration) error
+}
+
+// UserKey is the key of a user's limit type
+func UserKey(userID int, limitType string) string {
+	return fmt.Sprintf("user:%d:%s", userID, limitType)
+}
+
+// Window is the sliding window a user limit type, or a bucket of one, is counted over
+func Window(limitType string) time.Duration {
+	switch entity.BaseLimitType(limitType) {
+	case entity.LimitTypeAPIRequestsPerDay, entity.LimitTypeTestSessionsPerDay, entity.LimitTypeQuestionsPerDay:
+		return 24 * time.Hour
+	case entity.LimitTypeTestSessionsPerWeek:
+		return 7 * 24 * time.Hour
+	default:
+		return time.Hour
+	}
+}
+
+// slidingWindowScript returns {allowed, current, previous}. KEYS: current and previous
+// window. ARGV: limit, cost, window and time elapsed in the current window, in ms.
+var slidingWindowScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local cost = tonumber(ARGV[2])
+local window = tonumber(ARGV[3])
+local elapsed = tonumber(ARGV[4])
+local current = tonumber(redis.call('GET', KEYS[1]) or '0')
+local previous = tonumber(redis.call('GET', KEYS[2]) or '0')
+if previous * (window - elapsed) / window + current + cost > limit then
+	return {0, current, previous}
+end
+current = redis.call('INCRBY', KEYS[1], cost)
+redis.call('PEXPIRE', KEYS[1], window * 2)
+return {1, current, previous}
+`)
+
+// RedisStore keeps the windows in Redis
+type RedisStore struct {
+	client redis.Cmdable
+	prefix string
+}
+
+// NewRedisStore creates a store whose keys start with "ratelimit:"
+func NewRedisStore(client redis.Cmdable) *RedisStore {
+	return &RedisStore{client: client, prefix: "ratelimit:"}
+}
+
+// Allow adds cost to key unless that would exceed limit within window
+func (s *RedisStore) Allow(ctx context.Context, key string, limit, cost int, window time.Duration) (Result, error) {
+	now := time.Now()
+	index, elapsed := windowPosition(now, window)
+	keys := []string{s.windowKey(key, index), s.windowKey(key, index-1)}
+
+	values, err := slidingWindowScript.Run(ctx, s.client, keys, limit, cost, window.Milliseconds(), elapsed.Milliseconds()).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	if len(values) != 3 {
+		return Result{}, fmt.Errorf("unexpected rate limit script result %v", values)
+	}
+	return evaluate(now, limit, cost, values[0] == 1, values[1], values[2], window, elapsed), nil
+}
+
+// Used returns the weighted count of key within window without adding to it
+func (s *RedisStore) Used(ctx context.Context, key string, window time.Duration) (int, error) {
+	index, elapsed := windowPosition(time.Now(), window)
+	values, err := s.client.MGet(ctx, s.windowKey(key, index), s.windowKey(key, index-1)).Result()
+	if err != nil {
+		return 0, err
+	}
+	counts := make([]int64, len(values))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		text, _ := value.(string)
+		if counts[i], err = strconv.ParseInt(text, 10, 64); err != nil {
+			return 0, fmt.Errorf("unexpected rate limit count %v", value)
+		}
+	}
+	return weightedUsed(counts[0], counts[1], window, elapsed), nil
+}
+
+// Reset forgets the requests counted for key in the current and previous window
+func (s *RedisStore) Reset(ctx context.Context, key string, window time.Duration) error {
+	index, _ := windowPosition(time.Now(), window)
+	return s.client.Del(ctx, s.windowKey(key, index), s.windowKey(key, index-1)).Err()
+}
+
+// windowKey braces the key so both windows of a key share a Redis Cluster slot
+func (s *RedisStore) windowKey(key string, index int64) string {
+	return fmt.Sprintf("%s{%s}:%d", s.prefix, key, index)
+}
+
+// windowPosition returns the fixed window now falls in and how far into it now is
+func windowPosition(now time.Time, window time.Duration) (int64, time.Duration) {
+	ms := window.Milliseconds()
+	if ms <= 0 {
+		ms = 1
+	}
+	nowMs := now.UnixMilli()
+	return nowMs / ms, time.Duration(nowMs%ms) * time.Millisecond
+}
+
+// weightedUsed is the count of the sliding window: the current window's count plus the part
+// of the previous window's count that still overlaps it, rounded up
+func weightedUsed(current, previous int64, window, elapsed time.Duration) int {
+	weight := float64(window-elapsed) / float64(window)
+	return int(math.Ceil(float64(previous)*weight)) + int(current)
+}
+
+// evaluate turns the counts of the two windows into a Result. current already includes
+// cost when the call was allowed.
+func evaluate(now time.Time, limit, cost int, allowed bool, current, previous int64, window, elapsed time.Duration) Result {
+	used := weightedUsed(current, previous, window, elapsed)
+	res := Result{Allowed: allowed, Limit: limit, Remaining: limit - used}
+	if res.Remaining < 0 {
+		res.Remaining = 0
+	}
+
+	untilWindowEnd := window - elapsed
+	if allowed {
+		res.ResetAt = now.Add(untilWindowEnd)
+		return res
+	}
+
+	// Room left once the previous window has decayed enough
+	room := float64(int64(limit) - current - int64(cost))
+	if room >= 0 && previous > 0 {
+		decayed := time.Duration(float64(window) * (1 - room/float64(previous)))
+		if decayed > elapsed {
+			res.ResetAt = now.Add(decayed - elapsed)
+			return res
+		}
+	}
+	res.ResetAt = now.Add(untilWindowEnd)
+	return res
+}
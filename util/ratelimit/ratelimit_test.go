@@ -0,0 +1,168 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cbt-test-mini-project/util/ratelimit"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// base is the start of an hour, a day and a minute window alike
+var base = time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+func TestWindowPosition(t *testing.T) {
+	hourIndex := base.UnixMilli() / time.Hour.Milliseconds()
+	tests := []struct {
+		name        string
+		now         time.Time
+		window      time.Duration
+		wantIndex   int64
+		wantElapsed time.Duration
+	}{
+		{name: "start of a window", now: base, window: time.Hour, wantIndex: hourIndex, wantElapsed: 0},
+		{name: "into a window", now: base.Add(15*time.Minute + 500*time.Millisecond), window: time.Hour, wantIndex: hourIndex, wantElapsed: 15*time.Minute + 500*time.Millisecond},
+		{name: "last millisecond", now: base.Add(time.Hour - time.Millisecond), window: time.Hour, wantIndex: hourIndex, wantElapsed: time.Hour - time.Millisecond},
+		{name: "next window", now: base.Add(time.Hour), window: time.Hour, wantIndex: hourIndex + 1, wantElapsed: 0},
+		{name: "day window", now: base.Add(25 * time.Hour), window: 24 * time.Hour, wantIndex: base.UnixMilli()/(24*time.Hour).Milliseconds() + 1, wantElapsed: time.Hour},
+		{name: "zero window", now: base.Add(time.Second), window: 0, wantIndex: base.Add(time.Second).UnixMilli(), wantElapsed: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, elapsed := ratelimit.WindowPosition(tt.now, tt.window)
+			assert.Equal(t, tt.wantIndex, index)
+			assert.Equal(t, tt.wantElapsed, elapsed)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	now := base.Add(15 * time.Minute)
+	tests := []struct {
+		name          string
+		limit, cost   int
+		allowed       bool
+		current       int64
+		previous      int64
+		elapsed       time.Duration
+		wantRemaining int
+		wantResetAt   time.Time
+	}{
+		{
+			name: "allowed resets at the end of the window", limit: 10, cost: 1, allowed: true,
+			current: 4, previous: 6, elapsed: 30 * time.Minute,
+			// ceil(6 * 0.5) + 4 = 7 used
+			wantRemaining: 3, wantResetAt: now.Add(30 * time.Minute),
+		},
+		{
+			name: "previous window rounds up", limit: 10, cost: 1, allowed: true,
+			current: 1, previous: 1, elapsed: 59 * time.Minute,
+			wantRemaining: 8, wantResetAt: now.Add(time.Minute),
+		},
+		{
+			name: "denied until the previous window decays", limit: 10, cost: 1, allowed: false,
+			current: 5, previous: 10, elapsed: 15 * time.Minute,
+			// 4 of the previous window's 10 still fit once 36 minutes have passed: 10*0.4 + 5 + 1 = 10
+			wantRemaining: 0, wantResetAt: now.Add(21 * time.Minute),
+		},
+		{
+			name: "denied by the current window alone", limit: 5, cost: 1, allowed: false,
+			current: 5, previous: 3, elapsed: 15 * time.Minute,
+			wantRemaining: 0, wantResetAt: now.Add(45 * time.Minute),
+		},
+		{
+			name: "denied without a previous window", limit: 5, cost: 2, allowed: false,
+			current: 4, previous: 0, elapsed: 15 * time.Minute,
+			wantRemaining: 1, wantResetAt: now.Add(45 * time.Minute),
+		},
+		{
+			name: "denied cost larger than the limit", limit: 3, cost: 5, allowed: false,
+			current: 0, previous: 2, elapsed: 30 * time.Minute,
+			wantRemaining: 2, wantResetAt: now.Add(30 * time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ratelimit.Evaluate(now, tt.limit, tt.cost, tt.allowed, tt.current, tt.previous, time.Hour, tt.elapsed)
+			assert.Equal(t, tt.allowed, res.Allowed)
+			assert.Equal(t, tt.limit, res.Limit)
+			assert.Equal(t, tt.wantRemaining, res.Remaining)
+			assert.Equal(t, tt.wantResetAt, res.ResetAt)
+		})
+	}
+}
+
+func TestResultRetryAfter(t *testing.T) {
+	now := base
+	assert.Zero(t, ratelimit.Result{Allowed: true, ResetAt: now.Add(time.Minute)}.RetryAfter(now))
+	assert.Equal(t, time.Minute, ratelimit.Result{ResetAt: now.Add(time.Minute)}.RetryAfter(now))
+	assert.Zero(t, ratelimit.Result{ResetAt: now.Add(-time.Second)}.RetryAfter(now))
+}
+
+func TestMemoryStore_SlidingWindow(t *testing.T) {
+	now := base.Add(50 * time.Minute)
+	store := ratelimit.NewMemoryStore()
+	ratelimit.SetMemoryClock(store, func() time.Time { return now })
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		res, err := store.Allow(ctx, "user:1", 10, 1, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 9-i, res.Remaining)
+	}
+	res, err := store.Allow(ctx, "user:1", 10, 1, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, now.Add(10*time.Minute), res.ResetAt, "nothing decays before the window ends")
+
+	// 15 minutes into the next window three quarters of the previous one still count
+	now = base.Add(time.Hour + 15*time.Minute)
+	used, err := store.Used(ctx, "user:1", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 8, used)
+
+	for i := 0; i < 2; i++ {
+		res, err = store.Allow(ctx, "user:1", 10, 1, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+	res, err = store.Allow(ctx, "user:1", 10, 1, time.Hour)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+
+	// The denied call's ResetAt is the first moment it is allowed
+	now = res.ResetAt.Add(-time.Millisecond)
+	res2, err := store.Allow(ctx, "user:1", 10, 1, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, res2.Allowed)
+	now = res.ResetAt
+	res2, err = store.Allow(ctx, "user:1", 10, 1, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, res2.Allowed)
+
+	// Two windows later nothing is left
+	now = base.Add(3 * time.Hour)
+	used, err = store.Used(ctx, "user:1", time.Hour)
+	require.NoError(t, err)
+	assert.Zero(t, used)
+}
+
+func TestMemoryStore_Reset(t *testing.T) {
+	store := ratelimit.NewMemoryStore()
+	ctx := context.Background()
+
+	_, err := store.Allow(ctx, "user:1", 1, 1, time.Hour)
+	require.NoError(t, err)
+	res, err := store.Allow(ctx, "user:1", 1, 1, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	require.NoError(t, store.Reset(ctx, "user:1", time.Hour))
+	res, err = store.Allow(ctx, "user:1", 1, 1, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
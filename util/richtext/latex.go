@@ -0,0 +1,213 @@
+package richtext
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxFormulaLength is the longest LaTeX formula accepted, in bytes
+const MaxFormulaLength = 4000
+
+// maxGroupDepth bounds brace nesting so a formula cannot exhaust the client's typesetter
+const maxGroupDepth = 64
+
+// deniedCommands can define macros, read or write files, or emit links and
+// raw HTML attributes in KaTeX or MathJax, so they are never accepted
+var deniedCommands = map[string]bool{
+	"def": true, "gdef": true, "edef": true, "xdef": true, "let": true, "futurelet": true,
+	"newcommand": true, "renewcommand": true, "providecommand": true,
+	"newenvironment": true, "renewenvironment": true,
+	"input": true, "include": true, "openin": true, "openout": true, "read": true, "write": true, "immediate": true,
+	"catcode": true, "csname": true, "endcsname": true, "expandafter": true, "loop": true, "repeat": true,
+	"href": true, "url": true, "includegraphics": true, "special": true, "require": true,
+	"htmlClass": true, "htmlId": true, "htmlStyle": true, "htmlData": true, "class": true, "cssId": true, "style": true,
+}
+
+// allowedEnvironments are the \begin environments supported in math mode
+var allowedEnvironments = map[string]bool{
+	"matrix": true, "pmatrix": true, "bmatrix": true, "Bmatrix": true, "vmatrix": true, "Vmatrix": true,
+	"smallmatrix": true, "array": true, "subarray": true, "cases": true, "rcases": true, "dcases": true,
+	"aligned": true, "alignedat": true, "gathered": true, "split": true,
+	"align": true, "align*": true, "gather": true, "gather*": true, "equation": true, "equation*": true,
+}
+
+// segment is a run of Markdown text or a LaTeX formula
+type segment struct {
+	text    string
+	math    bool
+	display bool
+}
+
+// mathDelimiters pairs each opening delimiter with its closing one, longest first
+var mathDelimiters = []struct {
+	open, close string
+	display     bool
+}{
+	{"$$", "$$", true},
+	{`\[`, `\]`, true},
+	{`\(`, `\)`, false},
+	{"$", "$", false},
+}
+
+// splitMath cuts text into Markdown and formulas delimited by $...$, $$...$$,
+// \(...\) or \[...\], and validates each formula. \$ is a literal dollar sign.
+func splitMath(text string) ([]segment, error) {
+	var segments []segment
+	var plain strings.Builder
+	for i := 0; i < len(text); {
+		if strings.HasPrefix(text[i:], `\$`) {
+			plain.WriteByte('$')
+			i += 2
+			continue
+		}
+		opened := false
+		for _, d := range mathDelimiters {
+			if !strings.HasPrefix(text[i:], d.open) {
+				continue
+			}
+			start := i + len(d.open)
+			end := indexUnescaped(text[start:], d.close)
+			if end < 0 {
+				return nil, fmt.Errorf("formula opened with %s at position %d is not closed", d.open, i)
+			}
+			formula := text[start : start+end]
+			if err := validateLatex(formula); err != nil {
+				return nil, fmt.Errorf("formula at position %d: %v", i, err)
+			}
+			if plain.Len() > 0 {
+				segments = append(segments, segment{text: plain.String()})
+				plain.Reset()
+			}
+			segments = append(segments, segment{text: formula, math: true, display: d.display})
+			i = start + end + len(d.close)
+			opened = true
+			break
+		}
+		if !opened {
+			plain.WriteByte(text[i])
+			i++
+		}
+	}
+	if plain.Len() > 0 {
+		segments = append(segments, segment{text: plain.String()})
+	}
+	return segments, nil
+}
+
+// indexUnescaped finds delim in s, skipping backslash escapes such as \$ and \\
+func indexUnescaped(s, delim string) int {
+	for i := 0; i < len(s); i++ {
+		if strings.HasPrefix(s[i:], delim) {
+			return i
+		}
+		if s[i] == '\\' {
+			i++
+		}
+	}
+	return -1
+}
+
+// validateLatex checks that a formula has balanced groups, matching \begin/\end
+// and \left/\right pairs, arguments for ^ and _, and no denied commands
+func validateLatex(formula string) error {
+	if strings.TrimSpace(formula) == "" {
+		return fmt.Errorf("formula is empty")
+	}
+	if len(formula) > MaxFormulaLength {
+		return fmt.Errorf("formula exceeds %d characters", MaxFormulaLength)
+	}
+
+	depth := 0
+	leftRight := 0
+	var environments []string
+	for i := 0; i < len(formula); i++ {
+		switch c := formula[i]; c {
+		case '{':
+			depth++
+			if depth > maxGroupDepth {
+				return fmt.Errorf("groups are nested deeper than %d", maxGroupDepth)
+			}
+		case '}':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected } at position %d", i)
+			}
+		case '^', '_':
+			next := strings.TrimLeft(formula[i+1:], " \t\n")
+			if next == "" || next[0] == '}' || next[0] == '&' || next[0] == '^' || next[0] == '_' {
+				return fmt.Errorf("missing argument for %c at position %d", c, i)
+			}
+		case '\\':
+			name := commandName(formula[i+1:])
+			if name == "" {
+				// Control symbol such as \{ \, or \\
+				i++
+				continue
+			}
+			i += len(name)
+			if deniedCommands[name] {
+				return fmt.Errorf(`command \%s is not allowed`, name)
+			}
+			switch name {
+			case "left":
+				leftRight++
+			case "right":
+				leftRight--
+				if leftRight < 0 {
+					return fmt.Errorf(`\right at position %d has no matching \left`, i-len(name))
+				}
+			case "begin", "end":
+				env, n, err := braceArgument(formula[i+1:])
+				if err != nil {
+					return fmt.Errorf(`\%s: %v`, name, err)
+				}
+				i += n
+				if name == "begin" {
+					if !allowedEnvironments[env] {
+						return fmt.Errorf("environment %q is not supported", env)
+					}
+					environments = append(environments, env)
+					continue
+				}
+				if len(environments) == 0 || environments[len(environments)-1] != env {
+					return fmt.Errorf(`\end{%s} has no matching \begin`, env)
+				}
+				environments = environments[:len(environments)-1]
+			}
+		}
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("missing } to close %d group(s)", depth)
+	}
+	if leftRight > 0 {
+		return fmt.Errorf(`missing \right for %d \left`, leftRight)
+	}
+	if len(environments) > 0 {
+		return fmt.Errorf(`missing \end{%s}`, environments[len(environments)-1])
+	}
+	return nil
+}
+
+// commandName returns the letters of a control word, or "" for a control symbol
+func commandName(s string) string {
+	n := 0
+	for n < len(s) && (s[n] >= 'a' && s[n] <= 'z' || s[n] >= 'A' && s[n] <= 'Z') {
+		n++
+	}
+	return s[:n]
+}
+
+// braceArgument reads a {name} argument, returning the name and the bytes consumed
+func braceArgument(s string) (string, int, error) {
+	trimmed := strings.TrimLeft(s, " ")
+	skipped := len(s) - len(trimmed)
+	if !strings.HasPrefix(trimmed, "{") {
+		return "", 0, fmt.Errorf("missing environment name")
+	}
+	end := strings.IndexByte(trimmed, '}')
+	if end < 0 {
+		return "", 0, fmt.Errorf("environment name is not closed")
+	}
+	return strings.TrimSpace(trimmed[1:end]), skipped + end + 1, nil
+}
@@ -0,0 +1,121 @@
+package richtext
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Placeholders keep formulas out of Markdown processing. They use private-use runes,
+// which are removed from the text around the formulas first.
+const (
+	placeholderOpen  = "\uE000"
+	placeholderClose = "\uE001"
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(placeholderOpen + `(\d+)` + placeholderClose)
+	codePattern        = regexp.MustCompile("`([^`]+)`")
+	strongPattern      = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*`)
+	emStarPattern      = regexp.MustCompile(`\*(\S(?:.*?\S)?)\*`)
+	emUnderPattern     = regexp.MustCompile(`(^|[^\p{L}\p{N}])_(\S(?:.*?\S)?)_([^\p{L}\p{N}]|$)`)
+	bulletPattern      = regexp.MustCompile(`^\s*[-*+]\s+`)
+	orderedPattern     = regexp.MustCompile(`^\s*\d+[.)]\s+`)
+	blankLinePattern   = regexp.MustCompile(`\n\s*\n`)
+)
+
+// renderMarkdown renders the supported Markdown subset: paragraphs, line breaks,
+// bullet and numbered lists, **bold**, *italic* or _italic_ and `code`
+func renderMarkdown(segments []segment) string {
+	var source strings.Builder
+	var formulas []segment
+	for _, s := range segments {
+		if s.math {
+			fmt.Fprintf(&source, "%s%d%s", placeholderOpen, len(formulas), placeholderClose)
+			formulas = append(formulas, s)
+			continue
+		}
+		source.WriteString(strings.NewReplacer(placeholderOpen, "", placeholderClose, "").Replace(s.text))
+	}
+
+	var out strings.Builder
+	for _, block := range splitBlocks(source.String()) {
+		lines := strings.Split(block, "\n")
+		switch {
+		case allMatch(lines, bulletPattern):
+			writeList(&out, "ul", lines, bulletPattern)
+		case allMatch(lines, orderedPattern):
+			writeList(&out, "ol", lines, orderedPattern)
+		default:
+			out.WriteString("<p>")
+			for i, line := range lines {
+				if i > 0 {
+					out.WriteString("<br>")
+				}
+				out.WriteString(renderInline(strings.TrimSpace(line)))
+			}
+			out.WriteString("</p>")
+		}
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(out.String(), func(match string) string {
+		n, _ := strconv.Atoi(placeholderPattern.FindStringSubmatch(match)[1])
+		class := "math math-inline"
+		if formulas[n].display {
+			class = "math math-display"
+		}
+		return `<span class="` + class + `">` + html.EscapeString(formulas[n].text) + "</span>"
+	})
+}
+
+// splitBlocks splits text on blank lines, dropping empty blocks
+func splitBlocks(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	var blocks []string
+	for _, block := range blankLinePattern.Split(text, -1) {
+		if block = strings.Trim(block, "\n"); strings.TrimSpace(block) != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+func allMatch(lines []string, pattern *regexp.Regexp) bool {
+	for _, line := range lines {
+		if !pattern.MatchString(line) {
+			return false
+		}
+	}
+	return true
+}
+
+func writeList(out *strings.Builder, tag string, lines []string, marker *regexp.Regexp) {
+	out.WriteString("<" + tag + ">")
+	for _, line := range lines {
+		out.WriteString("<li>" + renderInline(strings.TrimSpace(marker.ReplaceAllString(line, ""))) + "</li>")
+	}
+	out.WriteString("</" + tag + ">")
+}
+
+// renderInline escapes a line and applies code spans, bold and italic. Code spans
+// are not formatted further.
+func renderInline(line string) string {
+	var out strings.Builder
+	last := 0
+	for _, loc := range codePattern.FindAllStringSubmatchIndex(line, -1) {
+		out.WriteString(renderEmphasis(line[last:loc[0]]))
+		out.WriteString("<code>" + html.EscapeString(line[loc[2]:loc[3]]) + "</code>")
+		last = loc[1]
+	}
+	out.WriteString(renderEmphasis(line[last:]))
+	return out.String()
+}
+
+func renderEmphasis(text string) string {
+	text = html.EscapeString(text)
+	text = strongPattern.ReplaceAllString(text, "<strong>$1</strong>")
+	text = emStarPattern.ReplaceAllString(text, "<em>$1</em>")
+	return emUnderPattern.ReplaceAllString(text, "$1<em>$2</em>$3")
+}
@@ -0,0 +1,138 @@
+package richtext
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// mathMLElements are the presentation MathML elements accepted inside <math>
+var mathMLElements = []string{
+	"math", "semantics", "annotation", "mrow", "mi", "mn", "mo", "mtext", "mspace", "ms",
+	"mfrac", "msqrt", "mroot", "mstyle", "merror", "mpadded", "mphantom", "mfenced", "menclose",
+	"msub", "msup", "msubsup", "munder", "mover", "munderover", "mmultiscripts", "mprescripts", "none",
+	"mtable", "mtr", "mlabeledtr", "mtd",
+}
+
+// mathMLAttributes are the attributes accepted on any MathML element
+var mathMLAttributes = []string{
+	"display", "mathvariant", "mathsize", "dir", "displaystyle", "scriptlevel",
+	"fence", "separator", "stretchy", "symmetric", "largeop", "movablelimits", "accent", "accentunder",
+	"form", "lspace", "rspace", "minsize", "maxsize", "linethickness", "bevelled",
+	"open", "close", "separators", "notation", "width", "height", "depth",
+	"rowalign", "columnalign", "columnspacing", "rowspacing", "columnlines", "rowlines", "frame",
+	"rowspan", "columnspan", "encoding",
+}
+
+var (
+	allowedMathMLElements   = toSet(mathMLElements)
+	allowedMathMLAttributes = toSet(mathMLAttributes)
+	mathOpenPattern         = regexp.MustCompile(`(?i)<math[\s>/]`)
+	tagPattern              = regexp.MustCompile(`<(/?[A-Za-z][A-Za-z0-9-]*(\s[^<>]*)?/?|!--.*?--|[!?][^<>]*)>`)
+)
+
+func toSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
+
+// splitMathML cuts text into plain text and <math>...</math> fragments. Plain text
+// may not contain other markup; each fragment must be well-formed MathML and is
+// returned re-serialized from its parsed tokens.
+func splitMathML(text string) ([]segment, error) {
+	var segments []segment
+	for text != "" {
+		loc := mathOpenPattern.FindStringIndex(text)
+		if loc == nil {
+			loc = []int{len(text), len(text)}
+		}
+		if plain := text[:loc[0]]; plain != "" {
+			if tag := tagPattern.FindStringIndex(plain); tag != nil {
+				return nil, fmt.Errorf("markup outside <math> is not allowed: %q", plain[tag[0]:min(len(plain), tag[0]+20)])
+			}
+			segments = append(segments, segment{text: plain})
+		}
+		if loc[0] == len(text) {
+			break
+		}
+		n, sanitized, err := readMathElement(text[loc[0]:])
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment{text: sanitized, math: true})
+		text = text[loc[0]+n:]
+	}
+	return segments, nil
+}
+
+// readMathElement parses the <math> element at the start of s. It returns the element's
+// length in bytes and the element written back from the parsed tokens, so nothing but
+// allowed elements, allowed attributes and escaped text can reach the output.
+func readMathElement(s string) (int, string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	decoder.Entity = xml.HTMLEntity
+	var out strings.Builder
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return 0, "", errors.New("<math> is not closed")
+		}
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid MathML: %v", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			if !allowedMathMLElements[name] {
+				return 0, "", fmt.Errorf("MathML element <%s> is not allowed", name)
+			}
+			if name == "math" && depth > 0 {
+				return 0, "", errors.New("<math> cannot be nested")
+			}
+			out.WriteString("<" + name)
+			for _, attr := range t.Attr {
+				// Namespace declarations are dropped, HTML5 places <math> in the MathML namespace
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				if !allowedMathMLAttributes[attr.Name.Local] {
+					return 0, "", fmt.Errorf("attribute %q on <%s> is not allowed", attr.Name.Local, name)
+				}
+				out.WriteString(" " + attr.Name.Local + `="` + html.EscapeString(attr.Value) + `"`)
+			}
+			out.WriteString(">")
+			depth++
+		case xml.EndElement:
+			out.WriteString("</" + t.Name.Local + ">")
+			depth--
+			if depth == 0 {
+				return int(decoder.InputOffset()), out.String(), nil
+			}
+		case xml.CharData:
+			out.WriteString(html.EscapeString(string(t)))
+		case xml.ProcInst, xml.Directive:
+			return 0, "", errors.New("processing instructions and directives are not allowed in MathML")
+		}
+	}
+}
+
+// renderMathML escapes the plain text between the sanitized MathML fragments
+func renderMathML(segments []segment) string {
+	var out strings.Builder
+	for _, s := range segments {
+		if s.math {
+			out.WriteString(s.text)
+			continue
+		}
+		out.WriteString(strings.ReplaceAll(html.EscapeString(s.text), "\n", "<br>"))
+	}
+	return out.String()
+}
@@ -0,0 +1,96 @@
+// Package richtext validates and renders question text written in one of the
+// supported content formats: plain text, Markdown with LaTeX math, or text
+// with embedded MathML. Sources are stored as typed; Render turns them into
+// sanitized HTML for the API response.
+package richtext
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// Format is the content format of a question's text
+type Format string
+
+const (
+	FormatPlain         Format = "plain"
+	FormatMarkdownLatex Format = "markdown_latex"
+	FormatMathML        Format = "mathml"
+)
+
+// ParseFormat normalizes a format name; an empty name is plain text
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(name))) {
+	case "", FormatPlain:
+		return FormatPlain, nil
+	case FormatMarkdownLatex, "markdown", "latex":
+		return FormatMarkdownLatex, nil
+	case FormatMathML:
+		return FormatMathML, nil
+	}
+	return "", fmt.Errorf("unknown content format %q", name)
+}
+
+// IsRich reports whether text in the format is markup that must not be HTML-sanitized on input
+func (f Format) IsRich() bool {
+	return f == FormatMarkdownLatex || f == FormatMathML
+}
+
+// Validate checks that text is well-formed in the format. Plain text is always valid.
+func Validate(format Format, text string) error {
+	switch format {
+	case FormatMarkdownLatex:
+		_, err := splitMath(text)
+		return err
+	case FormatMathML:
+		_, err := splitMathML(text)
+		return err
+	}
+	return nil
+}
+
+// Render returns the sanitized HTML of text. LaTeX is kept as source inside
+// <span class="math math-inline"> or <span class="math math-display"> for the
+// client to typeset; MathML keeps only presentation elements and attributes.
+func Render(format Format, text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	switch format {
+	case FormatMarkdownLatex:
+		segments, err := splitMath(text)
+		if err != nil {
+			return "", err
+		}
+		return htmlPolicy.Sanitize(renderMarkdown(segments)), nil
+	case FormatMathML:
+		segments, err := splitMathML(text)
+		if err != nil {
+			return "", err
+		}
+		return renderMathML(segments), nil
+	}
+	return strings.ReplaceAll(html.EscapeString(text), "\n", "<br>"), nil
+}
+
+// RenderOrEscape renders text like Render, falling back to escaped plain text when the
+// text is not valid in the format, e.g. when it was saved before formats were validated
+func RenderOrEscape(format Format, text string) string {
+	rendered, err := Render(format, text)
+	if err != nil {
+		rendered, _ = Render(FormatPlain, text)
+	}
+	return rendered
+}
+
+// htmlPolicy allows the markup renderMarkdown produces and nothing else
+var htmlPolicy = func() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("p", "br", "strong", "em", "code", "ul", "ol", "li")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^math math-(inline|display)$`)).OnElements("span")
+	return p
+}()
@@ -0,0 +1,141 @@
+package richtext_test
+
+import (
+	"testing"
+
+	"cbt-test-mini-project/util/richtext"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_MarkdownLatex(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "paragraphs and emphasis", text: "Hitung **luas** dan _keliling_.\n\nGunakan `pi`.", want: "<p>Hitung <strong>luas</strong> dan <em>keliling</em>.</p><p>Gunakan <code>pi</code>.</p>"},
+		{name: "lists", text: "- satu\n- dua\n\n1. tiga\n2. empat", want: "<ul><li>satu</li><li>dua</li></ul><ol><li>tiga</li><li>empat</li></ol>"},
+		{name: "line breaks", text: "baris 1\nbaris 2", want: "<p>baris 1<br>baris 2</p>"},
+		{name: "inline formula", text: `Jika $x^2 = 4$ maka`, want: `<p>Jika <span class="math math-inline">x^2 = 4</span> maka</p>`},
+		{name: "display formula", text: `$$\frac{a}{b}$$`, want: `<p><span class="math math-display">\frac{a}{b}</span></p>`},
+		{name: "bracket delimiters", text: `\(a+b\) dan \[c\]`, want: `<p><span class="math math-inline">a+b</span> dan <span class="math math-display">c</span></p>`},
+		{name: "escaped dollar", text: `Harga \$5`, want: `<p>Harga $5</p>`},
+		{name: "formula is not formatted as Markdown", text: `$a*b*c$`, want: `<p><span class="math math-inline">a*b*c</span></p>`},
+		{name: "script tag", text: "<script>alert(1)</script>", want: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"},
+		{name: "javascript href", text: `<a href="javascript:alert(1)">klik</a>`, want: "<p>&lt;a href=&#34;javascript:alert(1)&#34;&gt;klik&lt;/a&gt;</p>"},
+		{name: "Markdown link is text", text: "[klik](javascript:alert(1))", want: "<p>[klik](javascript:alert(1))</p>"},
+		{name: "event attribute", text: "<img src=x onerror=alert(1)>", want: "<p>&lt;img src=x onerror=alert(1)&gt;</p>"},
+		{name: "markup inside a formula", text: `$</span><script>alert(1)</script>$`, want: `<p><span class="math math-inline">&lt;/span&gt;&lt;script&gt;alert(1)&lt;/script&gt;</span></p>`},
+		{name: "placeholder runes in text", text: "a\uE0000\uE001b $x$", want: `<p>a0b <span class="math math-inline">x</span></p>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := richtext.Render(richtext.FormatMarkdownLatex, tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate_Latex(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr string
+	}{
+		{name: "matrix", text: `$\begin{pmatrix} 1 & 2 \\ 3 & 4 \end{pmatrix}$`},
+		{name: "left right", text: `$\left( \frac{1}{2} \right)$`},
+		{name: "unclosed formula", text: `harga $5`, wantErr: "formula opened with $ at position 6 is not closed"},
+		{name: "href", text: `$\href{javascript:alert(1)}{x}$`, wantErr: `formula at position 0: command \href is not allowed`},
+		{name: "macro definition", text: `$\def\x{y}$`, wantErr: `formula at position 0: command \def is not allowed`},
+		{name: "html class", text: `$\htmlClass{evil}{x}$`, wantErr: `formula at position 0: command \htmlClass is not allowed`},
+		{name: "unsupported environment", text: `$\begin{verbatim}x\end{verbatim}$`, wantErr: `formula at position 0: environment "verbatim" is not supported`},
+		{name: "unbalanced braces", text: `${x$`, wantErr: "formula at position 0: missing } to close 1 group(s)"},
+		{name: "empty formula", text: `$$ $$`, wantErr: "formula at position 0: formula is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := richtext.Validate(richtext.FormatMarkdownLatex, tt.text)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRender_MathML(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr string
+	}{
+		{name: "round trip", text: `x = <math><mfrac><mn>1</mn><mi>y</mi></mfrac></math>`, want: `x = <math><mfrac><mn>1</mn><mi>y</mi></mfrac></math>`},
+		{name: "allowed attributes", text: `<math display="block"><mo stretchy="false">(</mo></math>`, want: `<math display="block"><mo stretchy="false">(</mo></math>`},
+		{name: "namespace declaration dropped", text: `<math xmlns="http://www.w3.org/1998/Math/MathML"><mi>x</mi></math>`, want: `<math><mi>x</mi></math>`},
+		{name: "prefixed element written without prefix", text: `<math><m:mi xmlns:m="http://www.w3.org/1998/Math/MathML">x</m:mi></math>`, want: `<math><mi>x</mi></math>`},
+		{name: "entities", text: `<math><mo>&le;</mo><mi>&lt;b&gt;</mi></math>`, want: `<math><mo>≤</mo><mi>&lt;b&gt;</mi></math>`},
+		{name: "CDATA is escaped text", text: `<math><mi><![CDATA[<script>alert(1)</script>]]></mi></math>`, want: `<math><mi>&lt;script&gt;alert(1)&lt;/script&gt;</mi></math>`},
+		{name: "text outside math is escaped", text: "a < b & c\n<math><mi>x</mi></math>", want: "a &lt; b &amp; c<br><math><mi>x</mi></math>"},
+		{name: "script element", text: `<math><script>alert(1)</script></math>`, wantErr: "MathML element <script> is not allowed"},
+		{name: "namespaced script", text: `<math><svg:script xmlns:svg="http://www.w3.org/2000/svg">alert(1)</svg:script></math>`, wantErr: "MathML element <script> is not allowed"},
+		{name: "foreign object", text: `<math><annotation-xml><svg></svg></annotation-xml></math>`, wantErr: "MathML element <annotation-xml> is not allowed"},
+		{name: "event attribute", text: `<math><mi onclick="alert(1)">x</mi></math>`, wantErr: `attribute "onclick" on <mi> is not allowed`},
+		{name: "xlink href", text: `<math><mi xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="javascript:alert(1)">x</mi></math>`, wantErr: `attribute "href" on <mi> is not allowed`},
+		{name: "href", text: `<math href="javascript:alert(1)"><mi>x</mi></math>`, wantErr: `attribute "href" on <math> is not allowed`},
+		{name: "doctype", text: `<math><!DOCTYPE math [<!ENTITY x "y">]><mi>x</mi></math>`, wantErr: "processing instructions and directives are not allowed in MathML"},
+		{name: "markup outside math", text: `<b>x</b><math><mi>x</mi></math>`, wantErr: `markup outside <math> is not allowed: "<b>x</b>"`},
+		{name: "nested math", text: `<math><math><mi>x</mi></math></math>`, wantErr: "<math> cannot be nested"},
+		{name: "unclosed math", text: `<math><mi>x</mi>`, wantErr: "invalid MathML: XML syntax error on line 1: unexpected EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := richtext.Render(richtext.FormatMathML, tt.text)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRender_Plain(t *testing.T) {
+	got, err := richtext.Render(richtext.FormatPlain, "<b>x</b>\ny")
+	require.NoError(t, err)
+	assert.Equal(t, "&lt;b&gt;x&lt;/b&gt;<br>y", got)
+}
+
+func TestRenderOrEscape_FallsBackToPlain(t *testing.T) {
+	assert.Equal(t, "harga $5 &lt;b&gt;", richtext.RenderOrEscape(richtext.FormatMarkdownLatex, "harga $5 <b>"))
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    richtext.Format
+		wantErr bool
+	}{
+		{name: "", want: richtext.FormatPlain},
+		{name: " Markdown ", want: richtext.FormatMarkdownLatex},
+		{name: "latex", want: richtext.FormatMarkdownLatex},
+		{name: "mathml", want: richtext.FormatMathML},
+		{name: "html", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := richtext.ParseFormat(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
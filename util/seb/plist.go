@@ -0,0 +1,207 @@
+package seb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parsePlist decodes an XML property list whose root element is a <dict>.
+func parsePlist(data []byte) (map[string]any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, ErrInvalidConfig
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local == "plist" {
+			continue
+		}
+		if start.Name.Local != "dict" {
+			return nil, fmt.Errorf("%w: root element must be a dict", ErrInvalidConfig)
+		}
+
+		value, err := decodePlistValue(decoder, start)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+		}
+		return value.(map[string]any), nil
+	}
+}
+
+func decodePlistValue(decoder *xml.Decoder, start xml.StartElement) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		return decodePlistDict(decoder)
+	case "array":
+		return decodePlistArray(decoder)
+	case "true":
+		return true, decoder.Skip()
+	case "false":
+		return false, decoder.Skip()
+	}
+
+	var text string
+	if err := decoder.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		return strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	case "real":
+		return strconv.ParseFloat(strings.TrimSpace(text), 64)
+	case "data":
+		cleaned := strings.Map(func(r rune) rune {
+			if r == ' ' || r == '\n' || r == '\r' || r == '\t' {
+				return -1
+			}
+			return r
+		}, text)
+		return base64.StdEncoding.DecodeString(cleaned)
+	case "date":
+		return time.Parse(time.RFC3339, strings.TrimSpace(text))
+	default:
+		return nil, fmt.Errorf("unsupported plist element <%s>", start.Name.Local)
+	}
+}
+
+func decodePlistDict(decoder *xml.Decoder) (map[string]any, error) {
+	result := map[string]any{}
+	var key *string
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch typed := token.(type) {
+		case xml.StartElement:
+			if typed.Name.Local == "key" {
+				var name string
+				if err := decoder.DecodeElement(&name, &typed); err != nil {
+					return nil, err
+				}
+				key = &name
+				continue
+			}
+			if key == nil {
+				return nil, errors.New("dict value without key")
+			}
+			value, err := decodePlistValue(decoder, typed)
+			if err != nil {
+				return nil, err
+			}
+			result[*key] = value
+			key = nil
+		case xml.EndElement:
+			return result, nil
+		}
+	}
+}
+
+func decodePlistArray(decoder *xml.Decoder) ([]any, error) {
+	result := []any{}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch typed := token.(type) {
+		case xml.StartElement:
+			value, err := decodePlistValue(decoder, typed)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		case xml.EndElement:
+			return result, nil
+		}
+	}
+}
+
+// writeCanonicalJSON serialises settings the way SEB does before hashing:
+// no whitespace, dictionary keys sorted case-insensitively, <data> as base64
+// and <date> as ISO 8601.
+func writeCanonicalJSON(buf *bytes.Buffer, value any) error {
+	switch typed := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			left, right := strings.ToLower(keys[i]), strings.ToLower(keys[j])
+			if left == right {
+				return keys[i] < keys[j]
+			}
+			return left < right
+		})
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeJSONString(buf, key)
+			buf.WriteByte(':')
+			if err := writeCanonicalJSON(buf, typed[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range typed {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case string:
+		writeJSONString(buf, typed)
+	case bool:
+		buf.WriteString(strconv.FormatBool(typed))
+	case int64:
+		buf.WriteString(strconv.FormatInt(typed, 10))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(typed, 'f', -1, 64))
+	case []byte:
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(typed))
+	case time.Time:
+		writeJSONString(buf, typed.UTC().Format(time.RFC3339))
+	default:
+		return fmt.Errorf("unsupported plist value type %T", value)
+	}
+	return nil
+}
+
+func writeJSONString(buf *bytes.Buffer, value string) {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	// json.Encoder always appends a newline.
+	buf.Truncate(buf.Len() - 1)
+}
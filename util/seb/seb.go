@@ -0,0 +1,146 @@
+// Package seb implements the parts of the Safe Exam Browser (SEB) protocol the
+// CBT backend needs: reading an exported .seb settings file, deriving its
+// Config Key and verifying the X-SafeExamBrowser-* request headers.
+package seb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// HeaderConfigKeyHash carries SHA256(request URL + Config Key).
+	HeaderConfigKeyHash = "X-SafeExamBrowser-ConfigKeyHash"
+	// HeaderRequestHash carries SHA256(request URL + Browser Exam Key).
+	HeaderRequestHash = "X-SafeExamBrowser-RequestHash"
+	// HeaderRequestURL is set by the REST gateway with the absolute URL the
+	// browser requested, which both hashes are computed over.
+	HeaderRequestURL = "X-Seb-Request-Url"
+)
+
+var (
+	ErrEncryptedConfig = errors.New("encrypted .seb files are not supported, export the configuration unencrypted")
+	ErrInvalidConfig   = errors.New("invalid .seb configuration file")
+)
+
+// maxDecompressedSize guards against gzip bombs in uploaded files.
+const maxDecompressedSize = 8 << 20
+
+// ParseConfig decodes a .seb file into its settings dictionary. Plain XML
+// plists and gzip-compressed ("plnd" prefixed or bare gzip) files are
+// supported; password/certificate encrypted files are rejected.
+func ParseConfig(raw []byte) (map[string]any, error) {
+	data := bytes.TrimSpace(raw)
+	if len(data) == 0 {
+		return nil, ErrInvalidConfig
+	}
+
+	// SEB wraps the plist in up to three layers: gzip, a "plnd" prefix and gzip again
+	for i := 0; i <= 3; i++ {
+		switch {
+		case len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b:
+			decompressed, err := gunzip(data)
+			if err != nil {
+				return nil, err
+			}
+			data = bytes.TrimSpace(decompressed)
+		case bytes.HasPrefix(data, []byte("plnd")):
+			data = data[4:]
+		case bytes.HasPrefix(data, []byte("pswd")), bytes.HasPrefix(data, []byte("pwcc")),
+			bytes.HasPrefix(data, []byte("pkhs")), bytes.HasPrefix(data, []byte("phsk")):
+			return nil, ErrEncryptedConfig
+		default:
+			return parsePlist(data)
+		}
+	}
+
+	return nil, ErrInvalidConfig
+}
+
+// ConfigKey returns the hex encoded Config Key of a .seb file as SEB computes
+// it: SHA256 over the canonical JSON form of the settings dictionary with
+// "originatorVersion" removed.
+func ConfigKey(raw []byte) (string, error) {
+	settings, err := ParseConfig(raw)
+	if err != nil {
+		return "", err
+	}
+	return ConfigKeyFromSettings(settings)
+}
+
+// ConfigKeyFromSettings computes the Config Key of an already parsed settings dictionary.
+func ConfigKeyFromSettings(settings map[string]any) (string, error) {
+	filtered := make(map[string]any, len(settings))
+	for key, value := range settings {
+		if key == "originatorVersion" {
+			continue
+		}
+		filtered[key] = value
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonicalJSON(&buf, filtered); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// HashForURL returns hex(SHA256(url + key)), the value SEB sends in its headers.
+func HashForURL(requestURL, key string) string {
+	sum := sha256.Sum256([]byte(stripFragment(requestURL) + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyHash reports whether headerHash matches the hash SEB would send for
+// requestURL using key. Comparison is constant time.
+func VerifyHash(requestURL, key, headerHash string) bool {
+	headerHash = strings.ToLower(strings.TrimSpace(headerHash))
+	if requestURL == "" || key == "" || headerHash == "" {
+		return false
+	}
+	expected := HashForURL(requestURL, strings.ToLower(key))
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(headerHash)) == 1
+}
+
+// NormalizeKey validates a hex encoded SHA256 key (Config Key or Browser Exam Key).
+func NormalizeKey(key string) (string, error) {
+	key = strings.ToLower(strings.TrimSpace(key))
+	decoded, err := hex.DecodeString(key)
+	if err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid key %q: expected 64 hex characters", key)
+	}
+	return key, nil
+}
+
+func stripFragment(requestURL string) string {
+	if idx := strings.Index(requestURL, "#"); idx >= 0 {
+		return requestURL[:idx]
+	}
+	return requestURL
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidConfig
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+	if err != nil {
+		return nil, ErrInvalidConfig
+	}
+	if len(decompressed) > maxDecompressedSize {
+		return nil, errors.New(".seb configuration is too large")
+	}
+	return decompressed, nil
+}
@@ -0,0 +1,181 @@
+package seb_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"cbt-test-mini-project/util/seb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const settingsPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>originatorVersion</key>
+	<string>SEB_Win_3.7.0</string>
+	<key>sendBrowserExamKey</key>
+	<true/>
+	<key>allowQuit</key>
+	<false/>
+	<key>URLFilterRules</key>
+	<array>
+		<dict>
+			<key>expression</key>
+			<string>example.sch.id/&lt;ujian&gt;</string>
+			<key>active</key>
+			<true/>
+		</dict>
+	</array>
+	<key>browserWindowWebView</key>
+	<integer>3</integer>
+	<key>zoom</key>
+	<real>1.5</real>
+	<key>certificate</key>
+	<data>
+	aGVs
+	bG8=
+	</data>
+	<key>created</key>
+	<date>2026-03-01T07:30:00Z</date>
+</dict>
+</plist>`
+
+// settingsJSON is settingsPlist in SEB's canonical form: keys sorted case-insensitively,
+// no whitespace, originatorVersion left out
+const settingsJSON = `{"allowQuit":false,"browserWindowWebView":3,"certificate":"aGVsbG8=",` +
+	`"created":"2026-03-01T07:30:00Z","sendBrowserExamKey":true,` +
+	`"URLFilterRules":[{"active":true,"expression":"example.sch.id/<ujian>"}],"zoom":1.5}`
+
+func gzipped(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestParseConfig(t *testing.T) {
+	settings, err := seb.ParseConfig([]byte(settingsPlist))
+	require.NoError(t, err)
+
+	assert.Equal(t, "SEB_Win_3.7.0", settings["originatorVersion"])
+	assert.Equal(t, true, settings["sendBrowserExamKey"])
+	assert.Equal(t, false, settings["allowQuit"])
+	assert.Equal(t, int64(3), settings["browserWindowWebView"])
+	assert.Equal(t, 1.5, settings["zoom"])
+	assert.Equal(t, []byte("hello"), settings["certificate"])
+	assert.Equal(t, time.Date(2026, 3, 1, 7, 30, 0, 0, time.UTC), settings["created"])
+	assert.Equal(t, []any{map[string]any{"expression": "example.sch.id/<ujian>", "active": true}}, settings["URLFilterRules"])
+}
+
+func TestParseConfig_Containers(t *testing.T) {
+	plain := []byte(settingsPlist)
+	want, err := seb.ParseConfig(plain)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		raw  []byte
+	}{
+		{name: "gzip", raw: gzipped(t, plain)},
+		{name: "plnd prefix", raw: append([]byte("plnd"), plain...)},
+		{name: "gzip around plnd", raw: gzipped(t, append([]byte("plnd"), gzipped(t, plain)...))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := seb.ParseConfig(tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestParseConfig_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     []byte
+		wantErr error
+	}{
+		{name: "empty", raw: []byte("  "), wantErr: seb.ErrInvalidConfig},
+		{name: "password encrypted", raw: []byte("pswd\x00\x01"), wantErr: seb.ErrEncryptedConfig},
+		{name: "certificate encrypted", raw: gzipped(t, []byte("pkhs\x00\x01")), wantErr: seb.ErrEncryptedConfig},
+		{name: "root array", raw: []byte(`<plist><array><string>x</string></array></plist>`), wantErr: seb.ErrInvalidConfig},
+		{name: "value without key", raw: []byte(`<plist><dict><string>x</string></dict></plist>`), wantErr: seb.ErrInvalidConfig},
+		{name: "unknown element", raw: []byte(`<plist><dict><key>a</key><uid>1</uid></dict></plist>`), wantErr: seb.ErrInvalidConfig},
+		{name: "bad integer", raw: []byte(`<plist><dict><key>a</key><integer>x</integer></dict></plist>`), wantErr: seb.ErrInvalidConfig},
+		{name: "truncated", raw: []byte(`<plist><dict><key>a</key>`), wantErr: seb.ErrInvalidConfig},
+		{name: "broken gzip", raw: []byte{0x1f, 0x8b, 0x00}, wantErr: seb.ErrInvalidConfig},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := seb.ParseConfig(tt.raw)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestConfigKey(t *testing.T) {
+	sum := sha256.Sum256([]byte(settingsJSON))
+	want := hex.EncodeToString(sum[:])
+
+	key, err := seb.ConfigKey([]byte(settingsPlist))
+	require.NoError(t, err)
+	assert.Equal(t, want, key)
+
+	// Another SEB version exporting the same settings in another order gives the same key
+	reordered := `<plist><dict>
+		<key>zoom</key><real>1.5</real>
+		<key>URLFilterRules</key><array><dict><key>active</key><true/><key>expression</key><string>example.sch.id/&lt;ujian&gt;</string></dict></array>
+		<key>created</key><date>2026-03-01T07:30:00Z</date>
+		<key>certificate</key><data>aGVsbG8=</data>
+		<key>browserWindowWebView</key><integer>3</integer>
+		<key>allowQuit</key><false/>
+		<key>sendBrowserExamKey</key><true/>
+		<key>originatorVersion</key><string>SEB_Mac_3.3</string>
+	</dict></plist>`
+	key, err = seb.ConfigKey([]byte(reordered))
+	require.NoError(t, err)
+	assert.Equal(t, want, key)
+
+	changed, err := seb.ConfigKeyFromSettings(map[string]any{"allowQuit": true})
+	require.NoError(t, err)
+	assert.NotEqual(t, want, changed)
+}
+
+func TestVerifyHash(t *testing.T) {
+	const key = "0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0"
+	url := "https://cbt.example.sch.id/v1/test-session/tok"
+	sum := sha256.Sum256([]byte(url + key))
+	header := hex.EncodeToString(sum[:])
+
+	assert.Equal(t, header, seb.HashForURL(url, key))
+	assert.True(t, seb.VerifyHash(url, key, header))
+	assert.True(t, seb.VerifyHash(url+"#soal-3", key, header), "the fragment is not sent to the server")
+	assert.True(t, seb.VerifyHash(url, key, " "+bytesToUpper(header)+" "))
+	assert.False(t, seb.VerifyHash(url+"?page=2", key, header))
+	assert.False(t, seb.VerifyHash(url, key, ""))
+	assert.False(t, seb.VerifyHash("", key, header))
+}
+
+func TestNormalizeKey(t *testing.T) {
+	key, err := seb.NormalizeKey(" 0F1E2D3C4B5A69788796A5B4C3D2E1F00F1E2D3C4B5A69788796A5B4C3D2E1F0 ")
+	require.NoError(t, err)
+	assert.Equal(t, "0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0", key)
+
+	for _, bad := range []string{"", "abc", "zz1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0"} {
+		_, err := seb.NormalizeKey(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func bytesToUpper(s string) string {
+	return string(bytes.ToUpper([]byte(s)))
+}
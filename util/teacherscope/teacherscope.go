@@ -0,0 +1,23 @@
+// Package teacherscope builds the SQL conditions that limit teachers to the classes they
+// teach. Teaching assignments are synced from the LMS into class_teachers, keyed by LMS ids.
+package teacherscope
+
+import "fmt"
+
+// TaughtClassCondition limits column, an LMS class id, to classes taught by the CBT user
+// bound to $n
+func TaughtClassCondition(column string, n int) string {
+	return fmt.Sprintf(`%s IN (
+		SELECT ct.lms_class_id FROM class_teachers ct
+		JOIN users u ON u.lms_user_id = ct.lms_user_id
+		WHERE u.id = $%d)`, column, n)
+}
+
+// TaughtAssignmentCondition limits column, an LMS assignment id, to assignments with a
+// session in a class taught by the CBT user bound to $n
+func TaughtAssignmentCondition(column string, n int) string {
+	return fmt.Sprintf(`%s IN (
+		SELECT tsa.lms_assignment_id FROM test_session tsa
+		WHERE tsa.lms_assignment_id IS NOT NULL AND tsa.deleted_at IS NULL AND %s)`,
+		column, TaughtClassCondition("tsa.lms_class_id", n))
+}
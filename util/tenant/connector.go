@@ -0,0 +1,218 @@
+package tenant
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+)
+
+// applyQuery sets the session settings the row-level security policies read. They are
+// session-wide so they hold for every statement until the next request changes them.
+const applyQuery = `SELECT set_config('app.current_school_id', $1, false), set_config('app.cross_tenant', $2, false)`
+
+// Connector wraps a driver connector so every statement runs with the tenant of the
+// context it was issued with. Use it with sql.OpenDB.
+type Connector struct {
+	base driver.Connector
+}
+
+// NewConnector wraps base, which must hand out connections that support contexts.
+func NewConnector(base driver.Connector) *Connector {
+	return &Connector{base: base}
+}
+
+// Connect opens a connection with no tenant applied yet.
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	raw, err := c.base.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	execer, ok := raw.(driver.ExecerContext)
+	if !ok {
+		raw.Close()
+		return nil, errors.New("tenant: driver connection does not support ExecContext")
+	}
+	return &conn{Conn: raw, execer: execer}, nil
+}
+
+// Driver returns the wrapped driver.
+func (c *Connector) Driver() driver.Driver {
+	return c.base.Driver()
+}
+
+// conn remembers the settings last applied so they are only sent when the tenant changes.
+type conn struct {
+	driver.Conn
+	execer driver.ExecerContext
+
+	applied   bool
+	school    string
+	cross     string
+	inTx      bool
+	changedTx bool
+}
+
+// apply brings the session settings in line with the tenant of ctx.
+func (c *conn) apply(ctx context.Context) error {
+	school, cross := settings(ctx)
+	if c.applied && c.school == school && c.cross == cross {
+		return nil
+	}
+	args := []driver.NamedValue{{Ordinal: 1, Value: school}, {Ordinal: 2, Value: cross}}
+	if _, err := c.execer.ExecContext(ctx, applyQuery, args); err != nil {
+		c.applied = false
+		return err
+	}
+	c.applied, c.school, c.cross = true, school, cross
+	if c.inTx {
+		c.changedTx = true
+	}
+	return nil
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.apply(ctx); err != nil {
+		return nil, err
+	}
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.apply(ctx); err != nil {
+		return nil, err
+	}
+	return c.execer.ExecContext(ctx, query, args)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var (
+		st  driver.Stmt
+		err error
+	)
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		st, err = preparer.PrepareContext(ctx, query)
+	} else {
+		st, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{Stmt: st, conn: c}, nil
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// BeginTx applies the tenant before the transaction starts so a rollback cannot undo it.
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	beginner, ok := c.Conn.(driver.ConnBeginTx)
+	if !ok {
+		return nil, errors.New("tenant: driver connection does not support BeginTx")
+	}
+	if err := c.apply(ctx); err != nil {
+		return nil, err
+	}
+	t, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.inTx, c.changedTx = true, false
+	return &tx{Tx: t, conn: c}, nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *conn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// tx forgets the applied settings when a rollback undoes a change made inside it.
+type tx struct {
+	driver.Tx
+	conn *conn
+}
+
+func (t *tx) Commit() error {
+	t.conn.inTx = false
+	return t.Tx.Commit()
+}
+
+func (t *tx) Rollback() error {
+	if t.conn.changedTx {
+		t.conn.applied = false
+	}
+	t.conn.inTx = false
+	return t.Tx.Rollback()
+}
+
+// stmt applies the tenant of each execution before running the prepared statement.
+type stmt struct {
+	driver.Stmt
+	conn *conn
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if err := s.conn.apply(ctx); err != nil {
+		return nil, err
+	}
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+	return s.Stmt.Exec(namedToValues(args))
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if err := s.conn.apply(ctx); err != nil {
+		return nil, err
+	}
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+	return s.Stmt.Query(namedToValues(args))
+}
+
+func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return s.conn.CheckNamedValue(nv)
+}
+
+func namedToValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return values
+}
@@ -0,0 +1,146 @@
+package tenant_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"testing"
+
+	"cbt-test-mini-project/util/tenant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Fakes ---
+
+// fakeSession records the statements a connection received, and the settings each ran with
+type fakeSession struct {
+	school, cross string
+	applied       int
+	statements    []string
+}
+
+type fakeConnector struct{ session *fakeSession }
+
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{s: c.session}, nil
+}
+func (c fakeConnector) Driver() driver.Driver { return nil }
+
+type fakeConn struct {
+	s     *fakeSession
+	saved [2]string
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.saved = [2]string{c.s.school, c.s.cross}
+	return fakeTx{c}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if strings.Contains(query, "set_config") {
+		c.s.applied++
+		c.s.school, c.s.cross = args[0].Value.(string), args[1].Value.(string)
+		return driver.RowsAffected(0), nil
+	}
+	c.s.statements = append(c.s.statements, query+" school="+c.s.school+" cross="+c.s.cross)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if _, err := c.ExecContext(ctx, query, args); err != nil {
+		return nil, err
+	}
+	return emptyRows{}, nil
+}
+
+type fakeTx struct{ c *fakeConn }
+
+func (t fakeTx) Commit() error { return nil }
+
+// Rollback undoes settings changed inside the transaction, as PostgreSQL does
+func (t fakeTx) Rollback() error {
+	t.c.s.school, t.c.s.cross = t.c.saved[0], t.c.saved[1]
+	return nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string              { return nil }
+func (emptyRows) Close() error                   { return nil }
+func (emptyRows) Next(dest []driver.Value) error { return io.EOF }
+
+func openDB(t *testing.T) (*sql.DB, *fakeSession) {
+	session := &fakeSession{}
+	db := sql.OpenDB(tenant.NewConnector(fakeConnector{session: session}))
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db, session
+}
+
+// --- Tests ---
+
+func TestConnector_AppliesTenantOfEachStatement(t *testing.T) {
+	db, session := openDB(t)
+	schoolA := tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 7})
+	schoolB := tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 9})
+
+	_, err := db.ExecContext(schoolA, "UPDATE materi")
+	require.NoError(t, err)
+	_, err = db.ExecContext(schoolA, "UPDATE soal")
+	require.NoError(t, err)
+	_, err = db.ExecContext(schoolB, "UPDATE materi")
+	require.NoError(t, err)
+	_, err = db.ExecContext(tenant.System(context.Background()), "UPDATE materi")
+	require.NoError(t, err)
+	_, err = db.ExecContext(context.Background(), "UPDATE materi")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"UPDATE materi school=7 cross=off",
+		"UPDATE soal school=7 cross=off",
+		"UPDATE materi school=9 cross=off",
+		"UPDATE materi school= cross=on",
+		"UPDATE materi school= cross=off",
+	}, session.statements)
+	assert.Equal(t, 4, session.applied, "unchanged tenant is not sent again")
+}
+
+func TestConnector_ReappliesAfterRollbackUndoesSettings(t *testing.T) {
+	db, session := openDB(t)
+	schoolA := tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 7})
+	schoolB := tenant.WithTenant(context.Background(), tenant.Tenant{SchoolID: 9})
+
+	tx, err := db.BeginTx(schoolA, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(schoolB, "UPDATE materi")
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+
+	_, err = db.ExecContext(schoolB, "UPDATE soal")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"UPDATE materi school=9 cross=off",
+		"UPDATE soal school=9 cross=off",
+	}, session.statements)
+}
+
+func TestConnector_QueryWithoutTenantSeesNoSchool(t *testing.T) {
+	db, session := openDB(t)
+
+	rows, err := db.QueryContext(tenant.WithTenant(context.Background(), tenant.Tenant{}), "SELECT * FROM test_session")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+
+	assert.Equal(t, []string{"SELECT * FROM test_session school= cross=off"}, session.statements)
+}
@@ -0,0 +1,75 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// protectedRelations are the tables with a tenant_isolation policy, under both the legacy
+// and the English schema. Whichever name is a compatibility view is checked for its owner
+// instead, and tables that do not exist are skipped.
+var protectedRelations = []string{
+	// Tables that carry a school (17-Mar-2026-TenantRowLevelSecurity.sql)
+	"materi", "soal", "soal_drag_drop", "test_session",
+	"materials", "questions", "drag_drop_questions", "exam_sessions",
+	// Their child tables
+	"soal_gambar", "soal_opsi", "soal_media", "drag_item", "drag_slot", "drag_correct_answer",
+	"test_session_soal", "jawaban_siswa",
+	"question_images", "drag_items", "drag_slots", "drag_correct_answers",
+	"exam_session_questions", "student_answers",
+	// Exam security and grading (23-Mar-2026-SecurityGradingTenantIsolation.sql)
+	"assignment_seb_config", "network_allowlist", "network_access_denied_log",
+	"test_session_device_lease", "grading_config", "essay_grading_task", "essay_moderation",
+	"jawaban_rubric_score", "essay_score_suggestion", "essay_similarity",
+	// The rest (27-Mar-2026-TenantIsolationRemainingTables.sql)
+	"materi_grants", "class_teachers", "soal_rubric", "soal_rubric_criterion",
+	"soal_rubric_level", "soal_essay_keyword", "sesi_media_putar", "lab_login_credentials",
+	"auth_sessions", "auth_refresh_tokens", "api_keys",
+}
+
+// CheckEnforced returns an error describing why row-level security would not isolate
+// tenants for the connected role, or nil when it does. Superusers, BYPASSRLS roles and
+// views owned by them skip policies entirely.
+func CheckEnforced(ctx context.Context, db *sql.DB) error {
+	ctx = System(ctx)
+
+	var bypass bool
+	err := db.QueryRowContext(ctx, `SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`).Scan(&bypass)
+	if err != nil {
+		return err
+	}
+	if bypass {
+		return errors.New("database role is a superuser or has BYPASSRLS, row-level security is not applied")
+	}
+
+	for _, name := range protectedRelations {
+		var kind string
+		var forced, ownerBypass bool
+		err := db.QueryRowContext(ctx, `
+			SELECT c.relkind::text,
+			       (c.relrowsecurity AND c.relforcerowsecurity),
+			       (r.rolsuper OR r.rolbypassrls)
+			FROM pg_class c
+			JOIN pg_roles r ON r.oid = c.relowner
+			WHERE c.oid = to_regclass($1)
+		`, name).Scan(&kind, &forced, &ownerBypass)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if kind == "v" {
+			if ownerBypass {
+				return errors.New("view " + name + " is owned by a role that bypasses row-level security")
+			}
+			continue
+		}
+		if !forced {
+			return errors.New("row-level security is not forced on " + name + ", run the tenant isolation migrations")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package tenant
+
+import (
+	"context"
+	"strconv"
+)
+
+// Tenant is the school a request is allowed to see. CrossTenant lifts the school filter
+// and is reserved for superadmins and internal jobs.
+type Tenant struct {
+	SchoolID    int64
+	CrossTenant bool
+}
+
+type contextKey struct{}
+
+// WithTenant returns a copy of ctx that carries t.
+func WithTenant(ctx context.Context, t Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tenant carried by ctx.
+func FromContext(ctx context.Context) (Tenant, bool) {
+	t, ok := ctx.Value(contextKey{}).(Tenant)
+	return t, ok
+}
+
+// System marks ctx as cross-tenant, for work that runs on behalf of the service itself
+// such as LMS sync and lookups made before the caller is authenticated.
+func System(ctx context.Context) context.Context {
+	return WithTenant(ctx, Tenant{CrossTenant: true})
+}
+
+// settings are the values of the app.current_school_id and app.cross_tenant settings the
+// row-level security policies read. A context without a tenant sees no rows.
+func settings(ctx context.Context) (school, cross string) {
+	t, _ := FromContext(ctx)
+	if t.CrossTenant {
+		return "", "on"
+	}
+	if t.SchoolID > 0 {
+		return strconv.FormatInt(t.SchoolID, 10), "off"
+	}
+	return "", "off"
+}
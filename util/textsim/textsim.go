@@ -0,0 +1,279 @@
+// Package textsim compares free-text answers using word shingles. MinHash
+// signatures give a cheap Jaccard estimate to skip clearly unrelated pairs;
+// candidates are then compared exactly and the shared passages extracted.
+package textsim
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// DefaultShingleSize is the number of words per shingle. Student essays are
+// short, so three words keep paraphrases apart without missing copied phrases.
+const DefaultShingleSize = 3
+
+// DefaultSignatureSize is the number of MinHash permutations per document.
+const DefaultSignatureSize = 128
+
+type token struct {
+	word  string
+	start int
+	end   int
+}
+
+// Document is a tokenized text ready for comparison.
+type Document struct {
+	Text      string
+	tokens    []token
+	shingles  []uint64
+	set       map[uint64]struct{}
+	signature []uint64
+	k         int
+}
+
+// Passage is a run of words shared by two documents, as byte offsets into each text.
+type Passage struct {
+	Start        int
+	End          int
+	MatchedStart int
+	MatchedEnd   int
+	Words        int
+}
+
+// Result describes how similar document A is to document B.
+type Result struct {
+	// Jaccard is |A ∩ B| / |A ∪ B| over shingles.
+	Jaccard float64
+	// Containment is the share of A's shingles that also appear in B.
+	Containment float64
+	// Passages are the maximal runs of A that also appear in B.
+	Passages []Passage
+}
+
+// Hasher builds documents with a fixed shingle size and MinHash family so
+// their signatures are comparable.
+type Hasher struct {
+	k     int
+	seedA []uint64
+	seedB []uint64
+}
+
+// NewHasher creates a Hasher; non-positive arguments fall back to the defaults.
+func NewHasher(shingleSize, signatureSize int) *Hasher {
+	if shingleSize <= 0 {
+		shingleSize = DefaultShingleSize
+	}
+	if signatureSize <= 0 {
+		signatureSize = DefaultSignatureSize
+	}
+
+	h := &Hasher{k: shingleSize, seedA: make([]uint64, signatureSize), seedB: make([]uint64, signatureSize)}
+	// Deterministic splitmix64 stream so stored signatures stay stable across runs.
+	state := uint64(0x9e3779b97f4a7c15)
+	next := func() uint64 {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		return z ^ (z >> 31)
+	}
+	for i := 0; i < signatureSize; i++ {
+		h.seedA[i] = next() | 1
+		h.seedB[i] = next()
+	}
+	return h
+}
+
+// NewDocument tokenizes text and computes its shingles and MinHash signature.
+func (h *Hasher) NewDocument(text string) *Document {
+	doc := &Document{Text: text, tokens: tokenize(text), set: map[uint64]struct{}{}, k: h.k}
+
+	count := len(doc.tokens) - h.k + 1
+	if len(doc.tokens) > 0 && count < 1 {
+		// Shorter than one shingle: the whole answer is a single shingle.
+		count = 1
+	}
+	for i := 0; i < count; i++ {
+		end := i + h.k
+		if end > len(doc.tokens) {
+			end = len(doc.tokens)
+		}
+		hash := hashWords(doc.tokens[i:end])
+		doc.shingles = append(doc.shingles, hash)
+		doc.set[hash] = struct{}{}
+	}
+
+	doc.signature = make([]uint64, len(h.seedA))
+	for i := range doc.signature {
+		doc.signature[i] = math.MaxUint64
+	}
+	for hash := range doc.set {
+		for i := range doc.signature {
+			if v := hash*h.seedA[i] + h.seedB[i]; v < doc.signature[i] {
+				doc.signature[i] = v
+			}
+		}
+	}
+	return doc
+}
+
+// Empty reports whether the document has no words.
+func (d *Document) Empty() bool {
+	return len(d.set) == 0
+}
+
+// Size returns the number of distinct shingles of the document.
+func (d *Document) Size() int {
+	return len(d.set)
+}
+
+// EstimateJaccard estimates the Jaccard similarity of two documents from their signatures.
+func EstimateJaccard(a, b *Document) float64 {
+	if a.Empty() || b.Empty() || len(a.signature) != len(b.signature) {
+		return 0
+	}
+	equal := 0
+	for i := range a.signature {
+		if a.signature[i] == b.signature[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a.signature))
+}
+
+// Compare computes the exact shingle overlap of a with b and the shared passages.
+func Compare(a, b *Document) Result {
+	if a.Empty() || b.Empty() {
+		return Result{}
+	}
+
+	shared := 0
+	for hash := range a.set {
+		if _, ok := b.set[hash]; ok {
+			shared++
+		}
+	}
+	union := len(a.set) + len(b.set) - shared
+
+	result := Result{
+		Jaccard:     float64(shared) / float64(union),
+		Containment: float64(shared) / float64(len(a.set)),
+	}
+	if shared > 0 {
+		result.Passages = passages(a, b)
+	}
+	return result
+}
+
+// passages merges consecutive shared shingles of a into word runs and locates
+// each run in b by extending from the first occurrence of its opening shingle.
+func passages(a, b *Document) []Passage {
+	firstInB := make(map[uint64]int, len(b.shingles))
+	for i, hash := range b.shingles {
+		if _, ok := firstInB[hash]; !ok {
+			firstInB[hash] = i
+		}
+	}
+
+	var result []Passage
+	for i := 0; i < len(a.shingles); {
+		j, ok := firstInB[a.shingles[i]]
+		if !ok {
+			i++
+			continue
+		}
+
+		startA, startB := i, j
+		endA, endB := i+a.k, j+b.k
+		if endA > len(a.tokens) {
+			endA = len(a.tokens)
+		}
+		if endB > len(b.tokens) {
+			endB = len(b.tokens)
+		}
+		for endA < len(a.tokens) && endB < len(b.tokens) && a.tokens[endA].word == b.tokens[endB].word {
+			endA++
+			endB++
+		}
+
+		result = append(result, Passage{
+			Start:        a.tokens[startA].start,
+			End:          a.tokens[endA-1].end,
+			MatchedStart: b.tokens[startB].start,
+			MatchedEnd:   b.tokens[endB-1].end,
+			Words:        endA - startA,
+		})
+
+		// Continue after the run; the last shingle overlapping it is already covered.
+		i = endA - a.k + 1
+		if i <= startA {
+			i = startA + 1
+		}
+	}
+	return result
+}
+
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWord && start < 0 {
+			start = i
+		}
+		if !isWord && start >= 0 {
+			tokens = append(tokens, token{word: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{word: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
+
+func hashWords(tokens []token) uint64 {
+	hasher := fnv.New64a()
+	for i, t := range tokens {
+		if i > 0 {
+			hasher.Write([]byte{' '})
+		}
+		hasher.Write([]byte(t.word))
+	}
+	return hasher.Sum64()
+}
+
+// Occurrence is a place where a term appears in a document, as byte offsets.
+type Occurrence struct {
+	Start int
+	End   int
+}
+
+// Find returns the non-overlapping occurrences of a word or phrase in the document.
+// Like shingling, matching ignores case and punctuation.
+func (d *Document) Find(term string) []Occurrence {
+	words := tokenize(term)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var result []Occurrence
+	for i := 0; i+len(words) <= len(d.tokens); {
+		matched := true
+		for j, word := range words {
+			if d.tokens[i+j].word != word.word {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			i++
+			continue
+		}
+		result = append(result, Occurrence{Start: d.tokens[i].start, End: d.tokens[i+len(words)-1].end})
+		i += len(words)
+	}
+	return result
+}
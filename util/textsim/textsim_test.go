@@ -0,0 +1,138 @@
+package textsim_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"cbt-test-mini-project/util/textsim"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name            string
+		a, b            string
+		wantJaccard     float64
+		wantContainment float64
+		wantPassages    []string
+	}{
+		{
+			name: "identical", a: "air mendidih pada suhu seratus derajat", b: "air mendidih pada suhu seratus derajat",
+			wantJaccard: 1, wantContainment: 1, wantPassages: []string{"air mendidih pada suhu seratus derajat"},
+		},
+		{
+			name: "case and punctuation are ignored", a: "Fotosintesis menghasilkan oksigen.", b: "fotosintesis, MENGHASILKAN oksigen!",
+			wantJaccard: 1, wantContainment: 1, wantPassages: []string{"Fotosintesis menghasilkan oksigen"},
+		},
+		{
+			// a has 5 shingles, b has 4, and 2 are shared
+			name: "shared passage", a: "air mendidih pada suhu seratus derajat celsius", b: "pada suhu seratus derajat air membeku",
+			wantJaccard: 2.0 / 7, wantContainment: 2.0 / 5, wantPassages: []string{"pada suhu seratus derajat"},
+		},
+		{
+			name: "answer shorter than a shingle", a: "Oksigen", b: "oksigen",
+			wantJaccard: 1, wantContainment: 1, wantPassages: []string{"Oksigen"},
+		},
+		{name: "unrelated", a: "gaya sama dengan massa kali percepatan", b: "energi tidak dapat diciptakan atau dimusnahkan"},
+		{name: "empty", a: "", b: "apa saja"},
+		{name: "only punctuation", a: "?!...", b: "?!..."},
+	}
+	hasher := textsim.NewHasher(0, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := hasher.NewDocument(tt.a), hasher.NewDocument(tt.b)
+			result := textsim.Compare(a, b)
+			assert.InDelta(t, tt.wantJaccard, result.Jaccard, 1e-12)
+			assert.InDelta(t, tt.wantContainment, result.Containment, 1e-12)
+
+			var passages []string
+			for _, p := range result.Passages {
+				passages = append(passages, tt.a[p.Start:p.End])
+				assert.True(t, strings.EqualFold(
+					strings.Join(strings.Fields(strings.Map(keepWords, tt.a[p.Start:p.End])), " "),
+					strings.Join(strings.Fields(strings.Map(keepWords, tt.b[p.MatchedStart:p.MatchedEnd])), " "),
+				), "passage must match the same words in b")
+			}
+			assert.Equal(t, tt.wantPassages, passages)
+		})
+	}
+}
+
+func keepWords(r rune) rune {
+	if strings.ContainsRune(".,!?", r) {
+		return ' '
+	}
+	return r
+}
+
+func TestCompare_PassageWordCount(t *testing.T) {
+	hasher := textsim.NewHasher(3, 16)
+	a := hasher.NewDocument("menurut saya hukum newton kedua menyatakan bahwa gaya sebanding dengan percepatan")
+	b := hasher.NewDocument("hukum newton kedua menyatakan bahwa gaya sebanding dengan percepatan benda")
+
+	result := textsim.Compare(a, b)
+	require.Len(t, result.Passages, 1)
+	assert.Equal(t, 9, result.Passages[0].Words)
+}
+
+// words returns n distinct words starting at from
+func words(from, n int) string {
+	list := make([]string, n)
+	for i := range list {
+		list[i] = fmt.Sprintf("kata%d", from+i)
+	}
+	return strings.Join(list, " ")
+}
+
+func TestEstimateJaccard(t *testing.T) {
+	hasher := textsim.NewHasher(0, 0)
+	a := hasher.NewDocument(words(0, 100))
+	b := hasher.NewDocument(words(50, 100))
+
+	// 48 of 148 distinct shingles are shared
+	exact := textsim.Compare(a, b).Jaccard
+	assert.InDelta(t, 48.0/148, exact, 1e-12)
+	assert.InDelta(t, exact, textsim.EstimateJaccard(a, b), 0.15)
+
+	assert.Equal(t, 1.0, textsim.EstimateJaccard(a, hasher.NewDocument(words(0, 100))))
+	assert.Zero(t, textsim.EstimateJaccard(a, hasher.NewDocument("")))
+	assert.Zero(t, textsim.EstimateJaccard(a, textsim.NewHasher(0, 64).NewDocument(words(0, 100))), "signatures of different sizes are not comparable")
+}
+
+func TestNewHasher_StableSignatures(t *testing.T) {
+	// Stored signatures are compared with ones computed later, so the MinHash family
+	// must not depend on the run
+	a := textsim.NewHasher(0, 0).NewDocument(words(0, 40))
+	b := textsim.NewHasher(textsim.DefaultShingleSize, textsim.DefaultSignatureSize).NewDocument(words(0, 40))
+	assert.Equal(t, 1.0, textsim.EstimateJaccard(a, b))
+	assert.Equal(t, 38, a.Size())
+}
+
+func TestFind(t *testing.T) {
+	text := "Hukum Newton kedua: F = m a. Menurut hukum newton, a a a."
+	doc := textsim.NewHasher(0, 0).NewDocument(text)
+
+	tests := []struct {
+		name string
+		term string
+		want []string
+	}{
+		{name: "phrase ignores case and punctuation", term: "hukum Newton", want: []string{"Hukum Newton", "hukum newton"}},
+		{name: "single word", term: "kedua", want: []string{"kedua"}},
+		{name: "occurrences do not overlap", term: "a a", want: []string{"a a"}},
+		{name: "missing", term: "gravitasi"},
+		{name: "no words", term: "..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, o := range doc.Find(tt.term) {
+				got = append(got, text[o.Start:o.End])
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}